@@ -527,8 +527,27 @@ func (b *SimulatedBackend) EstimateGas(ctx context.Context, call vbgloble.CallMs
 // state is modified during execution, make sure to copy it if necessary.
 func (b *SimulatedBackend) callContract(ctx context.Context, call vbgloble.CallMsg, block *types.Block, stateDB *state.StateDB) (*core.ExecutionResult, error) {
 	// Ensure message is initialized properly.
-	if call.GasPrice == nil {
-		call.GasPrice = big.NewInt(1)
+	if call.GasPrice != nil && (call.GasFeeCap != nil || call.GasTipCap != nil) {
+		return nil, errors.New("both gasPrice and (maxFeePerGas or maxPriorityFeePerGas) specified")
+	}
+	if baseFee := block.BaseFee(); baseFee == nil || call.GasPrice != nil {
+		// Either EIP-1559 is not active or the legacy gas field was given
+		if call.GasPrice == nil {
+			call.GasPrice = big.NewInt(1)
+		}
+		call.GasFeeCap, call.GasTipCap = call.GasPrice, call.GasPrice
+	} else {
+		// EIP-1559 fields (or none) were given, backfill the effective gas price
+		if call.GasFeeCap == nil {
+			call.GasFeeCap = new(big.Int)
+		}
+		if call.GasTipCap == nil {
+			call.GasTipCap = new(big.Int)
+		}
+		call.GasPrice = new(big.Int)
+		if call.GasFeeCap.BitLen() > 0 || call.GasTipCap.BitLen() > 0 {
+			call.GasPrice = math.BigMin(new(big.Int).Add(call.GasTipCap, baseFee), call.GasFeeCap)
+		}
 	}
 	if call.Gas == 0 {
 		call.Gas = 50000000
@@ -546,7 +565,7 @@ func (b *SimulatedBackend) callContract(ctx context.Context, call vbgloble.CallM
 	evmContext := core.NewEVMBlockContext(block.Header(), b.blockchain, nil)
 	// Create a new environment which holds all relevant information
 	// about the transaction and calling mechanisms.
-	vmEnv := vm.NewEVM(evmContext, txContext, stateDB, b.config, vm.Config{})
+	vmEnv := vm.NewEVM(evmContext, txContext, stateDB, b.config, vm.Config{NoBaseFee: true})
 	gasPool := new(core.GasPool).AddGas(math.MaxUint64)
 
 	return core.NewStateTransition(vmEnv, msg, gasPool).TransitionDb()
@@ -558,7 +577,7 @@ func (b *SimulatedBackend) SendTransaction(ctx context.Context, tx *types.Transa
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
-	sender, err := types.Sender(types.NewEIP155Signer(b.config.ChainID), tx)
+	sender, err := types.Sender(types.LatestSigner(b.config), tx)
 	if err != nil {
 		panic(fmt.Errorf("invalid transaction: %v", err))
 	}
@@ -711,9 +730,14 @@ func (m callMsg) Nonce() uint64        { return 0 }
 func (m callMsg) CheckNonce() bool     { return false }
 func (m callMsg) To() *common.Address  { return m.CallMsg.To }
 func (m callMsg) GasPrice() *big.Int   { return m.CallMsg.GasPrice }
+func (m callMsg) GasFeeCap() *big.Int  { return m.CallMsg.GasFeeCap }
+func (m callMsg) GasTipCap() *big.Int  { return m.CallMsg.GasTipCap }
 func (m callMsg) Gas() uint64          { return m.CallMsg.Gas }
 func (m callMsg) Value() *big.Int      { return m.CallMsg.Value }
 func (m callMsg) Data() []byte         { return m.CallMsg.Data }
+func (m callMsg) AccessList() types.AccessList {
+	return m.CallMsg.AccessList
+}
 
 // filterBackend implements filters.Backend to support filtering for logs without
 // taking bloom-bits acceleration structures into account.
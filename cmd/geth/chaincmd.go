@@ -34,9 +34,11 @@ import (
 	"github.com/vbgloble/go-VGB/core/state"
 	"github.com/vbgloble/go-VGB/core/types"
 	"github.com/vbgloble/go-VGB/VBG/downloader"
+	"github.com/vbgloble/go-VGB/VBGdb"
 	"github.com/vbgloble/go-VGB/event"
 	"github.com/vbgloble/go-VGB/log"
 	"github.com/vbgloble/go-VGB/metrics"
+	"github.com/vbgloble/go-VGB/params"
 	"github.com/vbgloble/go-VGB/trie"
 	"gopkg.in/urfave/cli.v1"
 )
@@ -49,6 +51,10 @@ var (
 		ArgsUsage: "<genesisPath>",
 		Flags: []cli.Flag{
 			utils.DataDirFlag,
+			utils.AncientFlag,
+			utils.DBEngineFlag,
+			utils.StateDataDirFlag,
+			utils.StateDBEngineFlag,
 		},
 		Category: "BLOCKCHAIN COMMANDS",
 		Description: `
@@ -77,6 +83,9 @@ The dumpgenesis command dumps the genesis block configuration in JSON format to
 		ArgsUsage: "<filename> (<filename 2> ... <filename N>) ",
 		Flags: []cli.Flag{
 			utils.DataDirFlag,
+			utils.DBEngineFlag,
+			utils.StateDataDirFlag,
+			utils.StateDBEngineFlag,
 			utils.CacheFlag,
 			utils.SyncModeFlag,
 			utils.GCModeFlag,
@@ -94,6 +103,8 @@ The dumpgenesis command dumps the genesis block configuration in JSON format to
 			utils.MetricsInfluxDBPasswordFlag,
 			utils.MetricsInfluxDBTagsFlag,
 			utils.TxLookupLimitFlag,
+			utils.HistoryLimitFlag,
+			utils.ParallelImportFlag,
 		},
 		Category: "BLOCKCHAIN COMMANDS",
 		Description: `
@@ -110,6 +121,9 @@ processing will proceed even if an individual RLP-file import failure occurs.`,
 		ArgsUsage: "<filename> [<blockNumFirst> <blockNumLast>]",
 		Flags: []cli.Flag{
 			utils.DataDirFlag,
+			utils.DBEngineFlag,
+			utils.StateDataDirFlag,
+			utils.StateDBEngineFlag,
 			utils.CacheFlag,
 			utils.SyncModeFlag,
 		},
@@ -120,6 +134,49 @@ Optional second and third arguments control the first and
 last block to write. In this mode, the file will be appended
 if already existing. If the file ends with .gz, the output will
 be gzipped.`,
+	}
+	exportHistoryCommand = cli.Command{
+		Action:    utils.MigrateFlags(exportHistory),
+		Name:      "export-history",
+		Usage:     "Export frozen blockchain history into Era1 archives",
+		ArgsUsage: "<dir> <blockNumFirst> <blockNumLast>",
+		Flags: []cli.Flag{
+			utils.DataDirFlag,
+			utils.AncientFlag,
+			utils.DBEngineFlag,
+			utils.StateDataDirFlag,
+			utils.StateDBEngineFlag,
+			utils.CacheFlag,
+			utils.SyncModeFlag,
+		},
+		Category: "BLOCKCHAIN COMMANDS",
+		Description: `
+Exports the frozen chain history between the first and last block into the
+given directory, as a series of Era1 archives of 8192 blocks each. The first
+block must be aligned to an epoch boundary. The accumulator roots of the
+exported epochs are listed in accumulators.txt within the directory.`,
+	}
+	importHistoryCommand = cli.Command{
+		Action:    utils.MigrateFlags(importHistory),
+		Name:      "import-history",
+		Usage:     "Import blockchain history from Era1 archives",
+		ArgsUsage: "<dir> [<accumulators>]",
+		Flags: []cli.Flag{
+			utils.DataDirFlag,
+			utils.AncientFlag,
+			utils.DBEngineFlag,
+			utils.StateDataDirFlag,
+			utils.StateDBEngineFlag,
+			utils.CacheFlag,
+			utils.SyncModeFlag,
+			utils.TxLookupLimitFlag,
+		},
+		Category: "BLOCKCHAIN COMMANDS",
+		Description: `
+Imports the Era1 archives of the given directory into a freshly initialized
+database. Every epoch is checked against the list of known accumulator roots,
+read from the optional second argument or from accumulators.txt within the
+directory otherwise. The imported blocks are written into the ancient store.`,
 	}
 	importPreimagesCommand = cli.Command{
 		Action:    utils.MigrateFlags(importPreimages),
@@ -128,6 +185,9 @@ be gzipped.`,
 		ArgsUsage: "<datafile>",
 		Flags: []cli.Flag{
 			utils.DataDirFlag,
+			utils.DBEngineFlag,
+			utils.StateDataDirFlag,
+			utils.StateDBEngineFlag,
 			utils.CacheFlag,
 			utils.SyncModeFlag,
 		},
@@ -142,6 +202,9 @@ be gzipped.`,
 		ArgsUsage: "<dumpfile>",
 		Flags: []cli.Flag{
 			utils.DataDirFlag,
+			utils.DBEngineFlag,
+			utils.StateDataDirFlag,
+			utils.StateDBEngineFlag,
 			utils.CacheFlag,
 			utils.SyncModeFlag,
 		},
@@ -156,6 +219,9 @@ The export-preimages command export hash preimages to an RLP encoded stream`,
 		ArgsUsage: "<sourceChaindataDir>",
 		Flags: []cli.Flag{
 			utils.DataDirFlag,
+			utils.DBEngineFlag,
+			utils.StateDataDirFlag,
+			utils.StateDBEngineFlag,
 			utils.CacheFlag,
 			utils.SyncModeFlag,
 			utils.FakePoWFlag,
@@ -189,6 +255,9 @@ Remove blockchain and state databases`,
 		ArgsUsage: "[<blockHash> | <blockNum>]...",
 		Flags: []cli.Flag{
 			utils.DataDirFlag,
+			utils.DBEngineFlag,
+			utils.StateDataDirFlag,
+			utils.StateDBEngineFlag,
 			utils.CacheFlag,
 			utils.SyncModeFlag,
 			utils.IterativeOutputFlag,
@@ -208,6 +277,9 @@ Use "vbgloble dump 0" to dump the genesis block.`,
 		ArgsUsage: " ",
 		Flags: []cli.Flag{
 			utils.DataDirFlag,
+			utils.DBEngineFlag,
+			utils.StateDataDirFlag,
+			utils.StateDBEngineFlag,
 			utils.AncientFlag,
 			utils.CacheFlag,
 			utils.RopstenFlag,
@@ -244,7 +316,17 @@ func initGenesis(ctx *cli.Context) error {
 	defer stack.Close()
 
 	for _, name := range []string{"chaindata", "lightchaindata"} {
-		chaindb, err := stack.OpenDatabase(name, 0, 0, "")
+		// The full node database is opened the same way the node does, so its
+		// state lands in the separate state store if one is configured
+		var (
+			chaindb VBGdb.Database
+			err     error
+		)
+		if name == "chaindata" {
+			chaindb, err = stack.OpenDatabaseWithFreezer(name, 0, 0, ctx.GlobalString(utils.AncientFlag.Name), "", false)
+		} else {
+			chaindb, err = stack.OpenDatabase(name, 0, 0, "", false)
+		}
 		if err != nil {
 			utils.Fatalf("Failed to open database: %v", err)
 		}
@@ -403,6 +485,72 @@ func exportChain(ctx *cli.Context) error {
 	return nil
 }
 
+func exportHistory(ctx *cli.Context) error {
+	if len(ctx.Args()) != 3 {
+		utils.Fatalf("Arguments required: <dir> <blockNumFirst> <blockNumLast>")
+	}
+	first, ferr := strconv.ParseUint(ctx.Args().Get(1), 10, 64)
+	last, lerr := strconv.ParseUint(ctx.Args().Get(2), 10, 64)
+	if ferr != nil || lerr != nil {
+		utils.Fatalf("Export error in parsing parameters: block number not an integer\n")
+	}
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	db := utils.MakeChainDatabase(ctx, stack, true)
+	defer db.Close()
+
+	start := time.Now()
+	if err := utils.ExportHistory(db, ctx.Args().First(), first, last, historyNetwork(db)); err != nil {
+		utils.Fatalf("Export error: %v\n", err)
+	}
+	fmt.Printf("Export done in %v\n", time.Since(start))
+	return nil
+}
+
+func importHistory(ctx *cli.Context) error {
+	if len(ctx.Args()) < 1 {
+		utils.Fatalf("This command requires an argument.")
+	}
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	chain, db := utils.MakeChain(ctx, stack, false)
+	defer db.Close()
+	defer chain.Stop()
+
+	dir := ctx.Args().First()
+	known, err := utils.ReadAccumulators(dir, ctx.Args().Get(1))
+	if err != nil {
+		utils.Fatalf("Failed to load known accumulators: %v", err)
+	}
+	start := time.Now()
+	if err := utils.ImportHistory(chain, dir, historyNetwork(db), known); err != nil {
+		utils.Fatalf("Import error: %v", err)
+	}
+	fmt.Printf("Import done in %v\n", time.Since(start))
+	return nil
+}
+
+// historyNetwork returns the network name used to tag the history archives of
+// the chain stored in the given database.
+func historyNetwork(db VBGdb.Database) string {
+	switch rawdb.ReadCanonicalHash(db, 0) {
+	case params.MainnetGenesisHash:
+		return "mainnet"
+	case params.RopstenGenesisHash:
+		return "ropsten"
+	case params.RinkebyGenesisHash:
+		return "rinkeby"
+	case params.GoerliGenesisHash:
+		return "goerli"
+	case params.YoloV2GenesisHash:
+		return "yolov2"
+	default:
+		return "private"
+	}
+}
+
 // importPreimages imports preimage data from the specified file.
 func importPreimages(ctx *cli.Context) error {
 	if len(ctx.Args()) < 1 {
@@ -412,7 +560,7 @@ func importPreimages(ctx *cli.Context) error {
 	stack, _ := makeConfigNode(ctx)
 	defer stack.Close()
 
-	db := utils.MakeChainDatabase(ctx, stack)
+	db := utils.MakeChainDatabase(ctx, stack, false)
 	start := time.Now()
 
 	if err := utils.ImportPreimages(db, ctx.Args().First()); err != nil {
@@ -431,7 +579,7 @@ func exportPreimages(ctx *cli.Context) error {
 	stack, _ := makeConfigNode(ctx)
 	defer stack.Close()
 
-	db := utils.MakeChainDatabase(ctx, stack)
+	db := utils.MakeChainDatabase(ctx, stack, true)
 	start := time.Now()
 
 	if err := utils.ExportPreimages(db, ctx.Args().First()); err != nil {
@@ -462,8 +610,9 @@ func copyDb(ctx *cli.Context) error {
 	}
 	dl := downloader.New(0, chainDb, syncBloom, new(event.TypeMux), chain, nil, nil)
 
-	// Create a source peer to satisfy downloader requests from
-	db, err := rawdb.NewLevelDBDatabaseWithFreezer(ctx.Args().First(), ctx.GlobalInt(utils.CacheFlag.Name)/2, 256, ctx.Args().Get(1), "")
+	// Create a source peer to satisfy downloader requests from, reusing
+	// whichever engine the source database was created with
+	db, err := rawdb.NewDiskDatabaseWithFreezer("", ctx.Args().First(), ctx.GlobalInt(utils.CacheFlag.Name)/2, 256, ctx.Args().Get(1), "", true)
 	if err != nil {
 		return err
 	}
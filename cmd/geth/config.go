@@ -32,6 +32,7 @@ import (
 	"github.com/vbgloble/go-VGB/log"
 	"github.com/vbgloble/go-VGB/node"
 	"github.com/vbgloble/go-VGB/params"
+	"github.com/vbgloble/go-VGB/statediff"
 	"github.com/naoina/toml"
 )
 
@@ -173,6 +174,13 @@ func makeFullNode(ctx *cli.Context) (*node.Node, VBGapi.Backend) {
 	if cfg.VBGstats.URL != "" {
 		utils.RegisterVBGStatsService(stack, backend, cfg.VBGstats.URL)
 	}
+	// Add the state diff service if requested.
+	if ctx.GlobalBool(utils.StateDiffFlag.Name) {
+		utils.RegisterStateDiffService(stack, backend, statediff.Config{
+			File:   ctx.GlobalString(utils.StateDiffFileFlag.Name),
+			Proofs: ctx.GlobalBool(utils.StateDiffProofsFlag.Name),
+		})
+	}
 	return stack, backend
 }
 
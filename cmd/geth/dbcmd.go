@@ -0,0 +1,660 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of go-VGB.
+//
+// go-VGB is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-VGB is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-VGB. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/vbgloble/go-VGB/cmd/utils"
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/common/hexutil"
+	"github.com/vbgloble/go-VGB/core/rawdb"
+	"github.com/vbgloble/go-VGB/VBGdb"
+	"github.com/vbgloble/go-VGB/log"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var (
+	// dbFlags are the flags needed by every database subcommand to locate and
+	// open the chain database.
+	dbFlags = []cli.Flag{
+		utils.DataDirFlag,
+		utils.AncientFlag,
+		utils.DBEngineFlag,
+		utils.StateDataDirFlag,
+		utils.StateDBEngineFlag,
+		utils.SyncModeFlag,
+		utils.RopstenFlag,
+		utils.RinkebyFlag,
+		utils.GoerliFlag,
+		utils.YoloV2Flag,
+		utils.LegacyTestnetFlag,
+	}
+
+	dbIterateLimitFlag = cli.IntFlag{
+		Name:  "limit",
+		Usage: "Maximum number of entries to print (0 = unlimited)",
+	}
+	dbRebuildFlag = cli.BoolFlag{
+		Name:  "rebuild",
+		Usage: "Rebuild the key-value store indices from the healthy frozen blocks",
+	}
+	dbTruncateFlag = cli.BoolFlag{
+		Name:  "truncate",
+		Usage: "Truncate the ancient store to the last item before the first corruption",
+	}
+)
+
+var (
+	dbCommand = cli.Command{
+		Name:        "db",
+		Usage:       "Low level database operations",
+		ArgsUsage:   "",
+		Category:    "DATABASE COMMANDS",
+		Description: "",
+		Subcommands: []cli.Command{
+			{
+				Name:        "inspect",
+				Usage:       "Inspect the storage size for each type of data in the database",
+				ArgsUsage:   " ",
+				Action:      utils.MigrateFlags(inspect),
+				Category:    "DATABASE COMMANDS",
+				Flags:       append(dbFlags, utils.CacheFlag),
+				Description: "This command iterates the entire database and reports the size of each data type.",
+			},
+			{
+				Name:        "stats",
+				Usage:       "Print the internal statistics of the key-value store",
+				ArgsUsage:   " ",
+				Action:      utils.MigrateFlags(dbStats),
+				Category:    "DATABASE COMMANDS",
+				Flags:       dbFlags,
+				Description: "This command prints the compaction and io statistics of the key-value store.",
+			},
+			{
+				Name:      "compact",
+				Usage:     "Compact the key-value store, or a key range of it",
+				ArgsUsage: "[<hex-encoded start> [<hex-encoded limit>]]",
+				Action:    utils.MigrateFlags(dbCompact),
+				Category:  "DATABASE COMMANDS",
+				Flags:     append(dbFlags, utils.CacheFlag, utils.CacheDatabaseFlag),
+				Description: `
+gVBG db compact [<start> [<limit>]]
+compacts the given key range of the key-value store, or all of it if no range
+is specified. An empty start or limit argument denotes an open bound.
+
+WARNING: This operation may take a very long time to finish.`,
+			},
+			{
+				Name:        "get",
+				Usage:       "Show the value of a database key",
+				ArgsUsage:   "<hex-encoded key>",
+				Action:      utils.MigrateFlags(dbGet),
+				Category:    "DATABASE COMMANDS",
+				Flags:       dbFlags,
+				Description: "This command looks up the specified key in the key-value store.",
+			},
+			{
+				Name:      "put",
+				Usage:     "Set the value of a database key (WARNING: may corrupt your database)",
+				ArgsUsage: "<hex-encoded key> <hex-encoded value>",
+				Action:    utils.MigrateFlags(dbPut),
+				Category:  "DATABASE COMMANDS",
+				Flags:     dbFlags,
+				Description: `
+This command sets the given key to the given value in the key-value store.
+
+WARNING: This is a low-level operation which may cause database corruption!`,
+			},
+			{
+				Name:      "delete",
+				Usage:     "Delete a database key (WARNING: may corrupt your database)",
+				ArgsUsage: "<hex-encoded key>",
+				Action:    utils.MigrateFlags(dbDelete),
+				Category:  "DATABASE COMMANDS",
+				Flags:     dbFlags,
+				Description: `
+This command deletes the specified key from the key-value store.
+
+WARNING: This is a low-level operation which may cause database corruption!`,
+			},
+			{
+				Name:      "iterate",
+				Usage:     "Print the entries of the key-value store with a given key prefix",
+				ArgsUsage: "<hex-encoded prefix> [<hex-encoded start>]",
+				Action:    utils.MigrateFlags(dbIterate),
+				Category:  "DATABASE COMMANDS",
+				Flags:     append(dbFlags, dbIterateLimitFlag),
+				Description: `
+gVBG db iterate <prefix> [<start>]
+prints all key-value pairs whose key starts with the given prefix, beginning at
+the optional start position (relative to the prefix).`,
+			},
+			{
+				Name:      "freezer-dump",
+				Usage:     "Dump the items of an ancient freezer table",
+				ArgsUsage: "<table> <start> [<end>]",
+				Action:    utils.MigrateFlags(dbFreezerDump),
+				Category:  "DATABASE COMMANDS",
+				Flags:     dbFlags,
+				Description: `
+gVBG db freezer-dump <table> <start> [<end>]
+prints the raw items with index start up to and excluding end (or only item
+start if no end is given) from the given ancient freezer table.`,
+			},
+			{
+				Name:      "check-consistency",
+				Usage:     "Check the ancient freezer and the key-value store for consistency",
+				ArgsUsage: " ",
+				Action:    utils.MigrateFlags(dbCheckConsistency),
+				Category:  "DATABASE COMMANDS",
+				Flags:     dbFlags,
+				Description: `
+gVBG db check-consistency
+verifies that every frozen block is fully present in all freezer tables with a
+matching hash, and that the canonical chain continues from the freezer into the
+key-value store without gaps up to the head header.`,
+			},
+			{
+				Name:      "freezer-check",
+				Usage:     "Verify the integrity of every item in the ancient freezer",
+				ArgsUsage: " ",
+				Action:    utils.MigrateFlags(dbFreezerCheck),
+				Category:  "DATABASE COMMANDS",
+				Flags:     append(dbFlags, dbRebuildFlag, dbTruncateFlag),
+				Description: `
+gVBG db freezer-check [--truncate] [--rebuild]
+retrieves every item of every ancient freezer table, verifying its checksum and
+encoding, and reports the ranges of corrupted items. With --truncate, the freezer
+is truncated to the last item before the first corruption, discarding all blocks
+built on top. With --rebuild, the block hash to number mappings in the key-value
+store are regenerated from the healthy range of frozen blocks.`,
+			},
+			{
+				Name:        "canonical",
+				Usage:       "Show the canonical hash of a block number",
+				ArgsUsage:   "<number>",
+				Action:      utils.MigrateFlags(dbCanonical),
+				Category:    "DATABASE COMMANDS",
+				Flags:       dbFlags,
+				Description: "This command looks up the canonical block hash of the given number.",
+			},
+			{
+				Name:        "heads",
+				Usage:       "Show the head markers of the database",
+				ArgsUsage:   " ",
+				Action:      utils.MigrateFlags(dbHeads),
+				Category:    "DATABASE COMMANDS",
+				Flags:       dbFlags,
+				Description: "This command prints the head header, block and fast block markers along with other chain progress markers.",
+			},
+		},
+	}
+)
+
+// parseHexArg decodes a hex command line argument, allowing an empty string
+// to denote an absent value.
+func parseHexArg(ctx *cli.Context, index int, name string) ([]byte, error) {
+	arg := ctx.Args().Get(index)
+	if arg == "" {
+		return nil, nil
+	}
+	data, err := hexutil.Decode(arg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %v", name, err)
+	}
+	return data, nil
+}
+
+// showDatabaseStats prints the internal compaction and io statistics of the
+// key-value store backing the database.
+func showDatabaseStats(db VBGdb.Stater) {
+	if stats, err := db.Stat("leveldb.stats"); err != nil {
+		log.Warn("Failed to read database stats", "err", err)
+	} else {
+		fmt.Println(stats)
+	}
+	if ioStats, err := db.Stat("leveldb.iostats"); err != nil {
+		log.Warn("Failed to read database iostats", "err", err)
+	} else {
+		fmt.Println(ioStats)
+	}
+}
+
+func dbStats(ctx *cli.Context) error {
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	db := utils.MakeChainDatabase(ctx, stack, true)
+	defer db.Close()
+
+	showDatabaseStats(db)
+	return nil
+}
+
+func dbCompact(ctx *cli.Context) error {
+	if ctx.NArg() > 2 {
+		return fmt.Errorf("too many arguments: %v", ctx.Command.ArgsUsage)
+	}
+	start, err := parseHexArg(ctx, 0, "start")
+	if err != nil {
+		return err
+	}
+	limit, err := parseHexArg(ctx, 1, "limit")
+	if err != nil {
+		return err
+	}
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	db := utils.MakeChainDatabase(ctx, stack, false)
+	defer db.Close()
+
+	log.Info("Stats before compaction")
+	showDatabaseStats(db)
+
+	log.Info("Compacting database", "start", hexutil.Bytes(start), "limit", hexutil.Bytes(limit))
+	begin := time.Now()
+	if err := db.Compact(start, limit); err != nil {
+		log.Error("Database compaction failed", "err", err)
+		return err
+	}
+	log.Info("Database compaction finished", "elapsed", common.PrettyDuration(time.Since(begin)))
+
+	log.Info("Stats after compaction")
+	showDatabaseStats(db)
+	return nil
+}
+
+func dbGet(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return fmt.Errorf("required arguments: %v", ctx.Command.ArgsUsage)
+	}
+	key, err := parseHexArg(ctx, 0, "key")
+	if err != nil {
+		return err
+	}
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	db := utils.MakeChainDatabase(ctx, stack, true)
+	defer db.Close()
+
+	data, err := db.Get(key)
+	if err != nil {
+		log.Error("Failed to retrieve key", "key", hexutil.Bytes(key), "err", err)
+		return err
+	}
+	fmt.Printf("key %#x: %#x\n", key, data)
+	return nil
+}
+
+func dbPut(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return fmt.Errorf("required arguments: %v", ctx.Command.ArgsUsage)
+	}
+	key, err := parseHexArg(ctx, 0, "key")
+	if err != nil {
+		return err
+	}
+	value, err := parseHexArg(ctx, 1, "value")
+	if err != nil {
+		return err
+	}
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	db := utils.MakeChainDatabase(ctx, stack, false)
+	defer db.Close()
+
+	if data, err := db.Get(key); err == nil {
+		fmt.Printf("Previous value: %#x\n", data)
+	}
+	return db.Put(key, value)
+}
+
+func dbDelete(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return fmt.Errorf("required arguments: %v", ctx.Command.ArgsUsage)
+	}
+	key, err := parseHexArg(ctx, 0, "key")
+	if err != nil {
+		return err
+	}
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	db := utils.MakeChainDatabase(ctx, stack, false)
+	defer db.Close()
+
+	if data, err := db.Get(key); err == nil {
+		fmt.Printf("Previous value: %#x\n", data)
+	}
+	return db.Delete(key)
+}
+
+func dbIterate(ctx *cli.Context) error {
+	if ctx.NArg() < 1 || ctx.NArg() > 2 {
+		return fmt.Errorf("required arguments: %v", ctx.Command.ArgsUsage)
+	}
+	prefix, err := parseHexArg(ctx, 0, "prefix")
+	if err != nil {
+		return err
+	}
+	start, err := parseHexArg(ctx, 1, "start")
+	if err != nil {
+		return err
+	}
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	db := utils.MakeChainDatabase(ctx, stack, true)
+	defer db.Close()
+
+	var (
+		limit = ctx.Int(dbIterateLimitFlag.Name)
+		count int
+	)
+	it := db.NewIterator(prefix, start)
+	defer it.Release()
+
+	for it.Next() {
+		if limit > 0 && count == limit {
+			fmt.Printf("Exiting after %d entries\n", count)
+			break
+		}
+		fmt.Printf("%#x: %#x\n", it.Key(), it.Value())
+		count++
+	}
+	return it.Error()
+}
+
+func dbFreezerDump(ctx *cli.Context) error {
+	if ctx.NArg() < 2 || ctx.NArg() > 3 {
+		return fmt.Errorf("required arguments: %v", ctx.Command.ArgsUsage)
+	}
+	kind := ctx.Args().Get(0)
+	if _, ok := rawdb.FreezerNoSnappy[kind]; !ok {
+		var tables []string
+		for table := range rawdb.FreezerNoSnappy {
+			tables = append(tables, table)
+		}
+		sort.Strings(tables)
+		return fmt.Errorf("unknown freezer table %q, available: %v", kind, tables)
+	}
+	start, err := strconv.ParseUint(ctx.Args().Get(1), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid start index: %v", err)
+	}
+	end := start + 1
+	if ctx.NArg() == 3 {
+		if end, err = strconv.ParseUint(ctx.Args().Get(2), 10, 64); err != nil {
+			return fmt.Errorf("invalid end index: %v", err)
+		}
+	}
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	db := utils.MakeChainDatabase(ctx, stack, true)
+	defer db.Close()
+
+	frozen, err := db.Ancients()
+	if err != nil {
+		return err
+	}
+	if end > frozen {
+		log.Warn("Dump range exceeds frozen items, truncating", "end", end, "frozen", frozen)
+		end = frozen
+	}
+	for number := start; number < end; number++ {
+		blob, err := db.Ancient(kind, number)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve %s item %d: %v", kind, number, err)
+		}
+		fmt.Printf("%d: %#x\n", number, blob)
+	}
+	return nil
+}
+
+func dbCheckConsistency(ctx *cli.Context) error {
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	db := utils.MakeChainDatabase(ctx, stack, true)
+	defer db.Close()
+
+	// Databases without a freezer (e.g. light clients) report an error, treat
+	// them as having no frozen items
+	frozen, err := db.Ancients()
+	if err != nil {
+		frozen = 0
+	}
+	tail, err := db.Tail()
+	if err != nil {
+		tail = 0
+	}
+	var (
+		failures int
+		start    = time.Now()
+		logged   = time.Now()
+		parent   common.Hash
+	)
+	report := func(number uint64, msg string, kv ...interface{}) {
+		failures++
+		log.Error(msg, append([]interface{}{"number", number}, kv...)...)
+	}
+	// Ensure every freezer table contains exactly the frozen items
+	if frozen > tail {
+		for kind := range rawdb.FreezerNoSnappy {
+			if ok, _ := db.HasAncient(kind, frozen-1); !ok {
+				report(frozen-1, "Freezer table truncated", "table", kind)
+			}
+			if ok, _ := db.HasAncient(kind, frozen); ok {
+				report(frozen, "Freezer table overflows", "table", kind)
+			}
+		}
+	}
+	// Verify the canonical chain across the freezer and the key-value store
+	head := rawdb.ReadHeadHeaderHash(db)
+	if head == (common.Hash{}) {
+		return errors.New("missing head header marker")
+	}
+	headNumber := rawdb.ReadHeaderNumber(db, head)
+	if headNumber == nil {
+		return fmt.Errorf("missing head header number for %x", head)
+	}
+	for number := uint64(0); number <= *headNumber; number++ {
+		hash := rawdb.ReadCanonicalHash(db, number)
+		if hash == (common.Hash{}) {
+			report(number, "Missing canonical hash", "frozen", number < frozen)
+			parent = common.Hash{}
+			continue
+		}
+		header := rawdb.ReadHeader(db, hash, number)
+		switch {
+		case header == nil:
+			report(number, "Missing canonical header", "hash", hash, "frozen", number < frozen)
+		case header.Hash() != hash:
+			report(number, "Canonical hash mismatch", "have", header.Hash(), "want", hash, "frozen", number < frozen)
+		case number > 0 && parent != (common.Hash{}) && header.ParentHash != parent:
+			report(number, "Canonical chain broken", "parent", header.ParentHash, "want", parent, "frozen", number < frozen)
+		}
+		// Frozen blocks must be complete, live ones may legitimately lack
+		// bodies and receipts above the head block during sync. Bodies and
+		// receipts below the history tail are expected to be pruned.
+		if number < frozen {
+			if number >= tail && len(rawdb.ReadBodyRLP(db, hash, number)) == 0 {
+				report(number, "Missing frozen body", "hash", hash)
+			}
+			if number >= tail && len(rawdb.ReadReceiptsRLP(db, hash, number)) == 0 {
+				report(number, "Missing frozen receipts", "hash", hash)
+			}
+			if len(rawdb.ReadTdRLP(db, hash, number)) == 0 {
+				report(number, "Missing frozen total difficulty", "hash", hash)
+			}
+		}
+		parent = hash
+
+		if time.Since(logged) > 8*time.Second {
+			log.Info("Checking database consistency", "number", number, "head", *headNumber, "frozen", frozen, "failures", failures, "elapsed", common.PrettyDuration(time.Since(start)))
+			logged = time.Now()
+		}
+	}
+	// Ensure the block head markers point to known blocks
+	for name, hash := range map[string]common.Hash{
+		"block":     rawdb.ReadHeadBlockHash(db),
+		"fastblock": rawdb.ReadHeadFastBlockHash(db),
+	} {
+		if hash == (common.Hash{}) {
+			continue
+		}
+		number := rawdb.ReadHeaderNumber(db, hash)
+		if number == nil {
+			failures++
+			log.Error("Unknown head marker", "marker", name, "hash", hash)
+			continue
+		}
+		if rawdb.ReadCanonicalHash(db, *number) != hash {
+			report(*number, "Non-canonical head marker", "marker", name, "hash", hash)
+		}
+	}
+	if failures > 0 {
+		return fmt.Errorf("database inconsistent, %d failures", failures)
+	}
+	log.Info("Database is consistent", "head", *headNumber, "frozen", frozen, "tail", tail, "elapsed", common.PrettyDuration(time.Since(start)))
+	return nil
+}
+
+func dbFreezerCheck(ctx *cli.Context) error {
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	// Verify the freezer on a read-only database, only reopening for writing
+	// if a repair was requested
+	db := utils.MakeChainDatabase(ctx, stack, true)
+	corruptions, err := rawdb.CheckFreezer(db)
+	if err != nil {
+		db.Close()
+		return err
+	}
+	frozen, err := db.Ancients()
+	db.Close()
+	if err != nil {
+		return err
+	}
+	healthy := frozen
+	for _, c := range corruptions {
+		log.Error("Corrupted freezer items", "table", c.Table, "first", c.First, "last", c.Last, "err", c.Err)
+		if c.First < healthy {
+			healthy = c.First
+		}
+	}
+	if !ctx.Bool(dbTruncateFlag.Name) && !ctx.Bool(dbRebuildFlag.Name) {
+		if len(corruptions) > 0 {
+			return fmt.Errorf("freezer corrupted, %d ranges, healthy up to item %d", len(corruptions), healthy)
+		}
+		log.Info("Freezer is healthy", "frozen", frozen)
+		return nil
+	}
+	db = utils.MakeChainDatabase(ctx, stack, false)
+	defer db.Close()
+
+	if len(corruptions) > 0 {
+		if !ctx.Bool(dbTruncateFlag.Name) {
+			return fmt.Errorf("freezer corrupted, %d ranges, healthy up to item %d (use --%s)", len(corruptions), healthy, dbTruncateFlag.Name)
+		}
+		if err := rawdb.TruncateFreezer(db, healthy); err != nil {
+			return err
+		}
+	}
+	if ctx.Bool(dbRebuildFlag.Name) && healthy > 0 {
+		return rawdb.RebuildFromFreezer(db, 0, healthy)
+	}
+	return nil
+}
+
+func dbCanonical(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return fmt.Errorf("required arguments: %v", ctx.Command.ArgsUsage)
+	}
+	number, err := strconv.ParseUint(ctx.Args().Get(0), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid block number: %v", err)
+	}
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	db := utils.MakeChainDatabase(ctx, stack, true)
+	defer db.Close()
+
+	hash := rawdb.ReadCanonicalHash(db, number)
+	if hash == (common.Hash{}) {
+		return fmt.Errorf("no canonical block #%d", number)
+	}
+	fmt.Printf("%d: %#x\n", number, hash)
+	return nil
+}
+
+func dbHeads(ctx *cli.Context) error {
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	db := utils.MakeChainDatabase(ctx, stack, true)
+	defer db.Close()
+
+	printHead := func(name string, hash common.Hash) {
+		if hash == (common.Hash{}) {
+			fmt.Printf("%-16s <none>\n", name+":")
+			return
+		}
+		if number := rawdb.ReadHeaderNumber(db, hash); number != nil {
+			fmt.Printf("%-16s #%d [%#x]\n", name+":", *number, hash)
+		} else {
+			fmt.Printf("%-16s [%#x] (unknown number)\n", name+":", hash)
+		}
+	}
+	printHead("Head header", rawdb.ReadHeadHeaderHash(db))
+	printHead("Head block", rawdb.ReadHeadBlockHash(db))
+	printHead("Head fast block", rawdb.ReadHeadFastBlockHash(db))
+
+	if frozen, err := db.Ancients(); err == nil {
+		fmt.Printf("%-16s %d\n", "Frozen blocks:", frozen)
+	}
+	if tail, err := db.Tail(); err == nil && tail > 0 {
+		fmt.Printf("%-16s #%d\n", "History tail:", tail)
+	}
+	if pivot := rawdb.ReadLastPivotNumber(db); pivot != nil {
+		fmt.Printf("%-16s #%d\n", "Last pivot:", *pivot)
+	}
+	if tail := rawdb.ReadTxIndexTail(db); tail != nil {
+		fmt.Printf("%-16s #%d\n", "Tx index tail:", *tail)
+	}
+	if root := rawdb.ReadSnapshotRoot(db); root != (common.Hash{}) {
+		fmt.Printf("%-16s %#x\n", "Snapshot root:", root)
+	}
+	if version := rawdb.ReadDatabaseVersion(db); version != nil {
+		fmt.Printf("%-16s %d\n", "DB version:", *version)
+	}
+	if engine := rawdb.ReadDatabaseEngine(db); engine != "" {
+		fmt.Printf("%-16s %s\n", "DB engine:", engine)
+	}
+	return nil
+}
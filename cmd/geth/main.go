@@ -65,6 +65,10 @@ var (
 		utils.LegacyBootnodesV5Flag,
 		utils.DataDirFlag,
 		utils.AncientFlag,
+		utils.DBEngineFlag,
+		utils.StateDataDirFlag,
+		utils.StateDBEngineFlag,
+		utils.ReadOnlyFlag,
 		utils.KeyStoreDirFlag,
 		utils.ExternalSignerFlag,
 		utils.NoUSBFlag,
@@ -88,11 +92,21 @@ var (
 		utils.TxPoolAccountQueueFlag,
 		utils.TxPoolGlobalQueueFlag,
 		utils.TxPoolLifetimeFlag,
+		utils.TxPoolPolicyFlag,
+		utils.TxPoolStoreFlag,
+		utils.TxPoolStoreIntervalFlag,
+		utils.TxPoolStoreSizeFlag,
 		utils.SyncModeFlag,
 		utils.ExitWhenSyncedFlag,
 		utils.GCModeFlag,
 		utils.SnapshotFlag,
 		utils.TxLookupLimitFlag,
+		utils.HistoryLimitFlag,
+		utils.ParallelImportFlag,
+		utils.FinalityDepthFlag,
+		utils.FinalitySignersFlag,
+		utils.FinalityThresholdFlag,
+		utils.BloomFilterSizeFlag,
 		utils.LightServeFlag,
 		utils.LegacyLightServFlag,
 		utils.LightIngressFlag,
@@ -107,6 +121,7 @@ var (
 		utils.WhitelistFlag,
 		utils.CacheFlag,
 		utils.CacheDatabaseFlag,
+		utils.CacheStateDBFlag,
 		utils.CacheTrieFlag,
 		utils.CacheTrieJournalFlag,
 		utils.CacheTrieRejournalFlag,
@@ -132,6 +147,7 @@ var (
 		utils.LegacyMinerExtraDataFlag,
 		utils.MinerRecommitIntervalFlag,
 		utils.MinerNoVerfiyFlag,
+		utils.MinerOrderingFlag,
 		utils.NATFlag,
 		utils.NoDiscoverFlag,
 		utils.DiscoveryV5Flag,
@@ -147,6 +163,10 @@ var (
 		utils.GoerliFlag,
 		utils.YoloV2Flag,
 		utils.VMEnableDebugFlag,
+		utils.TraceIndexFlag,
+		utils.StateDiffFlag,
+		utils.StateDiffFileFlag,
+		utils.StateDiffProofsFlag,
 		utils.NetworkIdFlag,
 		utils.VBGStatsURLFlag,
 		utils.FakePoWFlag,
@@ -224,6 +244,9 @@ func init() {
 		initCommand,
 		importCommand,
 		exportCommand,
+		exportHistoryCommand,
+		importHistoryCommand,
+		verifyChainCommand,
 		importPreimagesCommand,
 		exportPreimagesCommand,
 		copydbCommand,
@@ -231,6 +254,7 @@ func init() {
 		dumpCommand,
 		dumpGenesisCommand,
 		inspectCommand,
+		dbCommand,
 		// See accountcmd.go:
 		accountCommand,
 		walletCommand,
@@ -245,6 +269,8 @@ func init() {
 		licenseCommand,
 		// See config.go
 		dumpConfigCommand,
+		// See snapshot.go
+		snapshotCommand,
 		// See cmd/utils/flags_legacy.go
 		utils.ShowDeprecated,
 	}
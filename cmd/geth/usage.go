@@ -36,6 +36,10 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			configFileFlag,
 			utils.DataDirFlag,
 			utils.AncientFlag,
+			utils.DBEngineFlag,
+			utils.StateDataDirFlag,
+			utils.StateDBEngineFlag,
+			utils.ReadOnlyFlag,
 			utils.KeyStoreDirFlag,
 			utils.NoUSBFlag,
 			utils.SmartCardDaemonPathFlag,
@@ -48,6 +52,12 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.ExitWhenSyncedFlag,
 			utils.GCModeFlag,
 			utils.TxLookupLimitFlag,
+			utils.HistoryLimitFlag,
+			utils.ParallelImportFlag,
+			utils.FinalityDepthFlag,
+			utils.FinalitySignersFlag,
+			utils.FinalityThresholdFlag,
+			utils.BloomFilterSizeFlag,
 			utils.VBGStatsURLFlag,
 			utils.IdentityFlag,
 			utils.LightKDFFlag,
@@ -101,6 +111,10 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.TxPoolAccountQueueFlag,
 			utils.TxPoolGlobalQueueFlag,
 			utils.TxPoolLifetimeFlag,
+			utils.TxPoolPolicyFlag,
+			utils.TxPoolStoreFlag,
+			utils.TxPoolStoreIntervalFlag,
+			utils.TxPoolStoreSizeFlag,
 		},
 	},
 	{
@@ -108,6 +122,7 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 		Flags: []cli.Flag{
 			utils.CacheFlag,
 			utils.CacheDatabaseFlag,
+			utils.CacheStateDBFlag,
 			utils.CacheTrieFlag,
 			utils.CacheTrieJournalFlag,
 			utils.CacheTrieRejournalFlag,
@@ -183,6 +198,7 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.MinerExtraDataFlag,
 			utils.MinerRecommitIntervalFlag,
 			utils.MinerNoVerfiyFlag,
+			utils.MinerOrderingFlag,
 		},
 	},
 	{
@@ -197,10 +213,19 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 		Name: "VIRTUAL MACHINE",
 		Flags: []cli.Flag{
 			utils.VMEnableDebugFlag,
+			utils.TraceIndexFlag,
 			utils.EVMInterpreterFlag,
 			utils.EWASMInterpreterFlag,
 		},
 	},
+	{
+		Name: "STATE DIFF",
+		Flags: []cli.Flag{
+			utils.StateDiffFlag,
+			utils.StateDiffFileFlag,
+			utils.StateDiffProofsFlag,
+		},
+	},
 	{
 		Name: "LOGGING AND DEBUGGING",
 		Flags: append([]cli.Flag{
@@ -0,0 +1,394 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of go-VGB.
+//
+// go-VGB is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-VGB is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-VGB. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/vbgloble/go-VGB/cmd/utils"
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/consensus/misc"
+	"github.com/vbgloble/go-VGB/core"
+	"github.com/vbgloble/go-VGB/core/state"
+	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/core/vm"
+	"github.com/vbgloble/go-VGB/VBGdb"
+	"github.com/vbgloble/go-VGB/internal/VBGapi"
+	"github.com/vbgloble/go-VGB/log"
+	"github.com/vbgloble/go-VGB/rlp"
+	"github.com/vbgloble/go-VGB/trie"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var (
+	verifyFromFlag = cli.Uint64Flag{
+		Name:  "from",
+		Usage: "Number of the first block to verify",
+		Value: 1,
+	}
+	verifyToFlag = cli.Uint64Flag{
+		Name:  "to",
+		Usage: "Number of the last block to verify (0 = current head)",
+	}
+	verifyWorkersFlag = cli.IntFlag{
+		Name:  "workers",
+		Usage: "Number of block segments to verify concurrently",
+		Value: runtime.NumCPU(),
+	}
+	verifyReexecFlag = cli.Uint64Flag{
+		Name:  "reexec",
+		Usage: "Maximum number of blocks to reexecute to regenerate a missing segment start state",
+		Value: 128,
+	}
+	verifyReportFlag = cli.StringFlag{
+		Name:  "report",
+		Usage: "File to write the verification report to",
+		Value: "verify-chain.json",
+	}
+)
+
+var verifyChainCommand = cli.Command{
+	Action:    utils.MigrateFlags(verifyChain),
+	Name:      "verify-chain",
+	Usage:     "Re-execute a range of blocks and verify the results against the chain",
+	ArgsUsage: " ",
+	Flags: []cli.Flag{
+		utils.DataDirFlag,
+		utils.AncientFlag,
+		utils.DBEngineFlag,
+		utils.StateDataDirFlag,
+		utils.StateDBEngineFlag,
+		utils.CacheFlag,
+		utils.SyncModeFlag,
+		utils.RopstenFlag,
+		utils.RinkebyFlag,
+		utils.GoerliFlag,
+		utils.YoloV2Flag,
+		utils.LegacyTestnetFlag,
+		verifyFromFlag,
+		verifyToFlag,
+		verifyWorkersFlag,
+		verifyReexecFlag,
+		verifyReportFlag,
+	},
+	Category: "BLOCKCHAIN COMMANDS",
+	Description: `
+The verify-chain command re-executes the blocks between --from and --to and
+compares the resulting gas usage, logs bloom, receipt root and state root of
+every block against the stored chain.
+
+The range is split into segments which are verified concurrently. The state
+preceding each segment is regenerated from the nearest available state, by
+re-executing at most --reexec blocks. The outcome is written to the --report
+file; if a block diverges, the report details the first divergent transaction
+along with its expected and computed receipts and a structured execution trace.
+Segments which can't be verified, e.g. as their start state can't be regenerated,
+are listed in the report too, and a divergence past them is not the first one.`,
+}
+
+// verifyReport is the outcome of a chain verification. The divergence is only
+// the first one in the range if no unverified segment precedes it.
+type verifyReport struct {
+	From       uint64            `json:"from"`
+	To         uint64            `json:"to"`
+	Divergence *verifyDivergence `json:"divergence"`
+	Unverified []*verifyFailure  `json:"unverified,omitempty"`
+}
+
+// verifyFailure describes a segment of blocks which couldn't be verified.
+type verifyFailure struct {
+	From  uint64 `json:"from"`
+	To    uint64 `json:"to"`
+	Error string `json:"error"`
+}
+
+// verifyDivergence describes the first block whose re-execution didn't match the
+// stored chain.
+type verifyDivergence struct {
+	Number      uint64             `json:"number"`
+	Hash        common.Hash        `json:"hash"`
+	Error       string             `json:"error"`
+	Transaction *verifyTransaction `json:"transaction,omitempty"`
+}
+
+// verifyTransaction describes the first transaction of a divergent block whose
+// receipt didn't match the stored one.
+type verifyTransaction struct {
+	Index      int                     `json:"index"`
+	Hash       common.Hash             `json:"hash"`
+	Error      string                  `json:"error,omitempty"`
+	Expected   *types.Receipt          `json:"expected"`
+	Computed   *types.Receipt          `json:"computed"`
+	Trace      *VBGapi.ExecutionResult `json:"trace,omitempty"`
+	TraceError string                  `json:"traceError,omitempty"`
+}
+
+func verifyChain(ctx *cli.Context) error {
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	chain, db := utils.MakeChain(ctx, stack, true)
+	defer db.Close()
+	defer chain.Stop()
+
+	var (
+		from    = ctx.Uint64(verifyFromFlag.Name)
+		to      = ctx.Uint64(verifyToFlag.Name)
+		workers = uint64(ctx.Int(verifyWorkersFlag.Name))
+		reexec  = ctx.Uint64(verifyReexecFlag.Name)
+		head    = chain.CurrentBlock().NumberU64()
+	)
+	if to == 0 {
+		to = head
+	}
+	if from == 0 || from > to || to > head {
+		return fmt.Errorf("invalid block range [%d, %d], head is %d", from, to, head)
+	}
+	if workers == 0 {
+		workers = 1
+	}
+	if total := to - from + 1; workers > total {
+		workers = total
+	}
+	// Split the range into segments and verify them concurrently. Whenever a
+	// divergence is found, segments past it are abandoned.
+	var (
+		size     = (to - from + workers) / workers
+		first    = to + 1 // Number of the first divergent block found
+		verified uint64   // Number of blocks verified so far
+		start    = time.Now()
+
+		lock        sync.Mutex
+		divergences = make(map[uint64]*verifyDivergence)
+		failures    []*verifyFailure
+
+		wg   sync.WaitGroup
+		done = make(chan struct{})
+	)
+	for begin := from; begin <= to; begin += size {
+		end := begin + size - 1
+		if end > to {
+			end = to
+		}
+		wg.Add(1)
+		go func(begin, end uint64) {
+			defer wg.Done()
+
+			divergence, err := verifySegment(chain, db, begin, end, reexec, &first, &verified)
+			lock.Lock()
+			defer lock.Unlock()
+
+			if err != nil {
+				failures = append(failures, &verifyFailure{From: begin, To: end, Error: err.Error()})
+			}
+			if divergence != nil {
+				divergences[divergence.Number] = divergence
+			}
+		}(begin, end)
+	}
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	log.Info("Verifying chain", "from", from, "to", to, "segments", (to-from+size)/size)
+	for wait := true; wait; {
+		select {
+		case <-done:
+			wait = false
+		case <-time.After(8 * time.Second):
+			log.Info("Verifying chain", "verified", atomic.LoadUint64(&verified), "total", to-from+1, "elapsed", common.PrettyDuration(time.Since(start)))
+		}
+	}
+	// Report the earliest divergence along with all the segments which failed,
+	// counting the blocks left unverified before the divergence
+	sort.Slice(failures, func(i, j int) bool { return failures[i].From < failures[j].From })
+	report := &verifyReport{From: from, To: to, Divergence: divergences[first], Unverified: failures}
+
+	var unverified uint64 // Number of unverified blocks preceding the divergence
+	for _, failure := range failures {
+		log.Error("Failed to verify chain segment", "from", failure.From, "to", failure.To, "err", failure.Error)
+		if failure.From < first {
+			end := failure.To
+			if end >= first {
+				end = first - 1
+			}
+			unverified += end - failure.From + 1
+		}
+	}
+	blob, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(ctx.String(verifyReportFlag.Name), blob, 0644); err != nil {
+		return err
+	}
+	switch {
+	case report.Divergence != nil && unverified > 0:
+		log.Error("Chain diverged after unverified blocks", "number", report.Divergence.Number, "hash", report.Divergence.Hash, "err", report.Divergence.Error, "unverified", unverified, "report", ctx.String(verifyReportFlag.Name))
+		return fmt.Errorf("chain diverged at block %d, %d blocks before it unverified", report.Divergence.Number, unverified)
+
+	case report.Divergence != nil:
+		log.Error("Chain diverged", "number", report.Divergence.Number, "hash", report.Divergence.Hash, "err", report.Divergence.Error, "report", ctx.String(verifyReportFlag.Name))
+		return fmt.Errorf("chain diverged at block %d", report.Divergence.Number)
+
+	case unverified > 0:
+		return fmt.Errorf("chain verification failed, %d blocks unverified", unverified)
+	}
+	log.Info("Chain verified", "from", from, "to", to, "report", ctx.String(verifyReportFlag.Name), "elapsed", common.PrettyDuration(time.Since(start)))
+	return nil
+}
+
+// verifySegment re-executes the blocks in the range [begin, end] on top of the
+// state preceding it, stopping at the first divergence or once a divergence is
+// found before the block to verify next.
+func verifySegment(chain *core.BlockChain, db VBGdb.Database, begin, end, reexec uint64, first *uint64, verified *uint64) (*verifyDivergence, error) {
+	parent := chain.GetBlockByNumber(begin - 1)
+	if parent == nil {
+		return nil, fmt.Errorf("block #%d not found", begin-1)
+	}
+	// The database is opened read-only, keep all regenerated state in memory
+	database := state.NewVolatileDatabase(db, &trie.Config{Cache: 16})
+	statedb, err := chain.StateAtBlock(parent, reexec, database)
+	if err != nil {
+		return nil, err
+	}
+	proot := parent.Root()
+	for number := begin; number <= end && number < atomic.LoadUint64(first); number++ {
+		block := chain.GetBlockByNumber(number)
+		if block == nil {
+			return nil, fmt.Errorf("block #%d not found", number)
+		}
+		receipts, _, usedGas, err := chain.Processor().Process(block, statedb, vm.Config{})
+		if err == nil {
+			err = chain.Validator().ValidateState(block, statedb, receipts, usedGas)
+		}
+		if err != nil {
+			for old := atomic.LoadUint64(first); number < old; old = atomic.LoadUint64(first) {
+				if atomic.CompareAndSwapUint64(first, old, number) {
+					break
+				}
+			}
+			divergence := &verifyDivergence{Number: number, Hash: block.Hash(), Error: err.Error()}
+			divergence.Transaction = locateDivergence(chain, block, proot, database)
+			return divergence, nil
+		}
+		// Commit the state, only keeping the latest root referenced
+		root, err := statedb.Commit(chain.Config().IsEIP158(block.Number()))
+		if err != nil {
+			return nil, err
+		}
+		if err := statedb.Reset(root); err != nil {
+			return nil, fmt.Errorf("state reset after block %d failed: %v", number, err)
+		}
+		database.TrieDB().Reference(root, common.Hash{})
+		database.TrieDB().Dereference(proot)
+		proot = root
+
+		atomic.AddUint64(verified, 1)
+	}
+	return nil, nil
+}
+
+// locateDivergence re-executes the transactions of a divergent block one by one
+// on top of its parent state, comparing their receipts against the stored ones,
+// and traces the first mismatching transaction. Nil is returned if the stored
+// receipts are unavailable or all of them match.
+func locateDivergence(chain *core.BlockChain, block *types.Block, root common.Hash, database state.Database) *verifyTransaction {
+	stored := chain.GetReceiptsByHash(block.Hash())
+	if len(stored) != len(block.Transactions()) {
+		return nil
+	}
+	statedb, err := state.New(root, database, nil)
+	if err != nil {
+		return nil
+	}
+	var (
+		config  = chain.Config()
+		header  = block.Header()
+		gp      = new(core.GasPool).AddGas(block.GasLimit())
+		usedGas = new(uint64)
+	)
+	if config.DAOForkSupport && config.DAOForkBlock != nil && config.DAOForkBlock.Cmp(block.Number()) == 0 {
+		misc.ApplyDAOHardFork(statedb)
+	}
+	for i, tx := range block.Transactions() {
+		pre := statedb.Copy()
+
+		statedb.Prepare(tx.Hash(), block.Hash(), i)
+		receipt, err := core.ApplyTransaction(config, chain, nil, gp, statedb, header, tx, usedGas, vm.Config{})
+		if err == nil && receiptsEqual(receipt, stored[i]) {
+			continue
+		}
+		divergence := &verifyTransaction{Index: i, Hash: tx.Hash(), Expected: stored[i], Computed: receipt}
+		if err != nil {
+			divergence.Error = err.Error()
+		}
+		if divergence.Trace, err = traceTransaction(chain, block, tx, pre); err != nil {
+			divergence.TraceError = err.Error()
+		}
+		return divergence
+	}
+	return nil
+}
+
+// receiptsEqual reports whVBGer the consensus fields of two receipts match.
+func receiptsEqual(a, b *types.Receipt) bool {
+	ablob, err := rlp.EncodeToBytes(a)
+	if err != nil {
+		return false
+	}
+	bblob, err := rlp.EncodeToBytes(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(ablob, bblob)
+}
+
+// traceTransaction executes a transaction of a block on top of the given state
+// with the structured logger enabled.
+func traceTransaction(chain *core.BlockChain, block *types.Block, tx *types.Transaction, statedb *state.StateDB) (*VBGapi.ExecutionResult, error) {
+	msg, err := tx.AsMessage(types.MakeSigner(chain.Config(), block.Number()), block.BaseFee())
+	if err != nil {
+		return nil, err
+	}
+	var (
+		tracer = vm.NewStructLogger(nil)
+		vmenv  = vm.NewEVM(core.NewEVMBlockContext(block.Header(), chain, nil), core.NewEVMTxContext(msg), statedb, chain.Config(), vm.Config{Debug: true, Tracer: tracer})
+	)
+	result, err := core.ApplyMessage(vmenv, msg, new(core.GasPool).AddGas(msg.Gas()))
+	if err != nil {
+		return nil, fmt.Errorf("tracing failed: %v", err)
+	}
+	returnVal := fmt.Sprintf("%x", result.Return())
+	if len(result.Revert()) > 0 {
+		returnVal = fmt.Sprintf("%x", result.Revert())
+	}
+	return &VBGapi.ExecutionResult{
+		Gas:         result.UsedGas,
+		Failed:      result.Failed(),
+		ReturnValue: returnVal,
+		StructLogs:  VBGapi.FormatLogs(tracer.StructLogs()),
+	}, nil
+}
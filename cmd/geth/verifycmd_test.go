@@ -0,0 +1,169 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of go-VGB.
+//
+// go-VGB is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-VGB is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-VGB. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/consensus/VBGash"
+	"github.com/vbgloble/go-VGB/core"
+	"github.com/vbgloble/go-VGB/core/rawdb"
+	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/core/vm"
+	"github.com/vbgloble/go-VGB/crypto"
+	"github.com/vbgloble/go-VGB/params"
+)
+
+// Tests that verify-chain regenerates and verifies the state of a chain deploying
+// and calling a contract, without writing to the database it opens read-only.
+func TestVerifyChain(t *testing.T) {
+	datadir := tmpdir(t)
+	defer os.RemoveAll(datadir)
+
+	makeVerifyChain(t, datadir, params.TestChainConfig, nil)
+
+	// Verify the chain in two segments, regenerating the state of the second
+	status, result := runVerifyChain(t, datadir, "--workers", "2")
+	if status != 0 {
+		t.Fatalf("verify-chain failed with status %d", status)
+	}
+	if result.From != 1 || result.To != 10 {
+		t.Errorf("range mismatch: have [%d, %d], want [%d, %d]", result.From, result.To, 1, 10)
+	}
+	if result.Divergence != nil {
+		t.Errorf("unexpected divergence at block %d: %s", result.Divergence.Number, result.Divergence.Error)
+	}
+	if len(result.Unverified) != 0 {
+		t.Errorf("unexpected unverified segments: %v", result.Unverified)
+	}
+}
+
+// Tests that a divergence found past a segment which failed to verify is reported
+// along with the failure, not as the first divergence.
+func TestVerifyChainUnverified(t *testing.T) {
+	datadir := tmpdir(t)
+	defer os.RemoveAll(datadir)
+
+	// Generate the chain before Istanbul, but store a config activating it at the
+	// last block, changing the gas cost of its storage write
+	config := *params.TestChainConfig
+	config.IstanbulBlock, config.EIP2718Block = nil, nil
+
+	stored := config
+	stored.IstanbulBlock = big.NewInt(10)
+
+	makeVerifyChain(t, datadir, &config, &stored)
+
+	// Verify the last blocks in two segments, the first lacking its start state
+	status, result := runVerifyChain(t, datadir, "--from", "8", "--workers", "2", "--reexec", "0")
+	if status == 0 {
+		t.Fatalf("verify-chain succeeded on divergent chain")
+	}
+	if result.Divergence == nil || result.Divergence.Number != 10 {
+		t.Fatalf("divergence mismatch: have %+v, want block 10", result.Divergence)
+	}
+	if len(result.Unverified) != 1 || result.Unverified[0].From != 8 || result.Unverified[0].To != 9 {
+		t.Fatalf("unverified segments mismatch: have %+v, want [8, 9]", result.Unverified)
+	}
+}
+
+// makeVerifyChain creates a chain in the datadir deploying a contract and calling
+// it in every later block, only persisting the states the default cache flushes:
+// the genesis and the last two blocks. If set, the stored chain config is replaced
+// afterwards.
+func makeVerifyChain(t *testing.T, datadir string, config *params.ChainConfig, stored *params.ChainConfig) {
+	var (
+		key, _  = crypto.GenerateKey()
+		addr    = crypto.PubkeyToAddress(key.PublicKey)
+		signer  = types.HomesteadSigner{}
+		genesis = &core.Genesis{
+			Config: config,
+			Alloc:  core.GenesisAlloc{addr: {Balance: big.NewInt(params.VBGer)}},
+		}
+		// Init code returning a runtime which stores 1 into slot 0
+		code     = common.FromHex("0x6005600c60003960056000f36001600055")
+		contract = crypto.CreateAddress(addr, 0)
+	)
+	path := filepath.Join(datadir, "gVBG", "chaindata")
+	db, err := rawdb.NewLevelDBDatabaseWithFreezer(path, 0, 0, filepath.Join(path, "ancient"), "", false)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	gblock := genesis.MustCommit(db)
+
+	gendb := rawdb.NewMemoryDatabase()
+	blocks, _ := core.GenerateChain(genesis.Config, genesis.MustCommit(gendb), VBGash.NewFaker(), gendb, 10, func(i int, gen *core.BlockGen) {
+		var tx *types.Transaction
+		if i == 0 {
+			tx, _ = types.SignTx(types.NewContractCreation(gen.TxNonce(addr), new(big.Int), 100000, new(big.Int), code), signer, key)
+		} else {
+			tx, _ = types.SignTx(types.NewTransaction(gen.TxNonce(addr), contract, new(big.Int), 100000, new(big.Int), nil), signer, key)
+		}
+		gen.AddTx(tx)
+	})
+	chain, err := core.NewBlockChain(db, nil, genesis.Config, VBGash.NewFaker(), vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create chain: %v", err)
+	}
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+	chain.Stop()
+
+	if stored != nil {
+		rawdb.WriteChainConfig(db, gblock.Hash(), stored)
+	}
+}
+
+// verifyResult is the part of a verify-chain report checked by the tests, leaving
+// out the receipts of a divergence which don't round-trip through JSON.
+type verifyResult struct {
+	From       uint64 `json:"from"`
+	To         uint64 `json:"to"`
+	Divergence *struct {
+		Number uint64 `json:"number"`
+		Error  string `json:"error"`
+	} `json:"divergence"`
+	Unverified []*verifyFailure `json:"unverified"`
+}
+
+// runVerifyChain runs verify-chain on the given datadir, returning its exit status
+// and the report it wrote.
+func runVerifyChain(t *testing.T, datadir string, args ...string) (int, *verifyResult) {
+	report := filepath.Join(datadir, "report.json")
+
+	gvbg := runGVBG(t, append([]string{"--datadir", datadir, "--nousb", "verify-chain", "--report", report}, args...)...)
+	gvbg.WaitExit()
+
+	blob, err := ioutil.ReadFile(report)
+	if err != nil {
+		t.Fatalf("failed to read report: %v\n%s", err, gvbg.StderrText())
+	}
+	result := new(verifyResult)
+	if err := json.Unmarshal(blob, result); err != nil {
+		t.Fatalf("failed to parse report: %v", err)
+	}
+	return gvbg.ExitStatus(), result
+}
@@ -18,29 +18,42 @@
 package utils
 
 import (
+	"bufio"
 	"compress/gzip"
+	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"math"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/common/hexutil"
 	"github.com/vbgloble/go-VGB/core"
 	"github.com/vbgloble/go-VGB/core/rawdb"
 	"github.com/vbgloble/go-VGB/core/types"
 	"github.com/vbgloble/go-VGB/crypto"
 	"github.com/vbgloble/go-VGB/VBGdb"
 	"github.com/vbgloble/go-VGB/internal/debug"
+	"github.com/vbgloble/go-VGB/internal/era"
 	"github.com/vbgloble/go-VGB/log"
 	"github.com/vbgloble/go-VGB/node"
 	"github.com/vbgloble/go-VGB/rlp"
+	"github.com/vbgloble/go-VGB/trie"
 )
 
 const (
 	importBatchSize = 2500
+
+	// accumulatorsFile is the name of the file listing the accumulator roots of
+	// the exported history epochs, one hex encoded root per line.
+	accumulatorsFile = "accumulators.txt"
 )
 
 // Fatalf formats a message to standard error and exits the program.
@@ -312,3 +325,251 @@ func ExportPreimages(db VBGdb.Database, fn string) error {
 	log.Info("Exported preimages", "file", fn)
 	return nil
 }
+
+// ExportHistory exports the frozen blockchain history into the specified directory,
+// as a series of Era1 archives of era.MaxEra1Size blocks each. The accumulator
+// roots of the exported epochs are listed alongside, so that the archives can be
+// verified on import.
+func ExportHistory(db VBGdb.Database, dir string, first, last uint64, network string) error {
+	log.Info("Exporting blockchain history", "dir", dir)
+
+	step := uint64(era.MaxEra1Size)
+	if first%step != 0 {
+		return fmt.Errorf("first block #%d not aligned to the epoch size %d", first, step)
+	}
+	if first > last {
+		return fmt.Errorf("invalid block range: first #%d above last #%d", first, last)
+	}
+	// Only immutable chain segments are exported, ensure they are available
+	frozen, err := db.Ancients()
+	if err != nil {
+		return fmt.Errorf("ancient store unavailable: %v", err)
+	}
+	if last >= frozen {
+		return fmt.Errorf("block #%d not frozen yet, %d frozen blocks", last, frozen)
+	}
+	if tail, err := db.Tail(); err == nil && first < tail {
+		return fmt.Errorf("history below block #%d was pruned", tail)
+	}
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return fmt.Errorf("error creating output directory: %v", err)
+	}
+	var (
+		start    = time.Now()
+		reported = time.Now()
+		roots    []string
+	)
+	for batch := first; batch <= last; batch += step {
+		root, err := exportEpoch(db, dir, batch, last, network)
+		if err != nil {
+			return err
+		}
+		roots = append(roots, root.Hex())
+
+		if time.Since(reported) >= 8*time.Second {
+			log.Info("Exporting blocks", "exported", batch+step-first, "elapsed", common.PrettyDuration(time.Since(start)))
+			reported = time.Now()
+		}
+	}
+	// Append the accumulators of the exported epochs to the known list
+	var known []string
+	if blob, err := ioutil.ReadFile(filepath.Join(dir, accumulatorsFile)); err == nil {
+		known = strings.Fields(string(blob))
+	}
+	offset := int(first / step)
+	if len(known) < offset {
+		return fmt.Errorf("accumulators of epochs below %d unknown", offset)
+	}
+	known = append(known[:offset], roots...)
+	if err := ioutil.WriteFile(filepath.Join(dir, accumulatorsFile), []byte(strings.Join(known, "\n")+"\n"), os.ModePerm); err != nil {
+		return err
+	}
+	log.Info("Exported blockchain history", "dir", dir, "epochs", len(roots), "elapsed", common.PrettyDuration(time.Since(start)))
+	return nil
+}
+
+// exportEpoch writes the Era1 archive of the epoch starting at the given block,
+// returning its accumulator root.
+func exportEpoch(db VBGdb.Database, dir string, first, last uint64, network string) (common.Hash, error) {
+	var (
+		step     = uint64(era.MaxEra1Size)
+		epoch    = int(first / step)
+		filename = filepath.Join(dir, era.Filename(network, epoch, common.Hash{}))
+	)
+	f, err := os.Create(filename)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("could not create era file: %v", err)
+	}
+	defer f.Close()
+
+	w := era.NewBuilder(f)
+	for number := first; number < first+step && number <= last; number++ {
+		hash := rawdb.ReadCanonicalHash(db, number)
+		header := rawdb.ReadHeader(db, hash, number)
+		if header == nil {
+			return common.Hash{}, fmt.Errorf("export failed on #%d: header not found", number)
+		}
+		body := rawdb.ReadBodyRLP(db, hash, number)
+		if len(body) == 0 {
+			return common.Hash{}, fmt.Errorf("export failed on #%d: body not found", number)
+		}
+		receipts := rawdb.ReadRawReceipts(db, hash, number)
+		if receipts == nil {
+			return common.Hash{}, fmt.Errorf("export failed on #%d: receipts not found", number)
+		}
+		td := rawdb.ReadTd(db, hash, number)
+		if td == nil {
+			return common.Hash{}, fmt.Errorf("export failed on #%d: total difficulty not found", number)
+		}
+		// Receipts are archived in their consensus encoding
+		blob, err := rlp.EncodeToBytes(receipts)
+		if err != nil {
+			return common.Hash{}, err
+		}
+		if err := w.AddRLP(rawdb.ReadHeaderRLP(db, hash, number), body, blob, number, hash, td, header.Difficulty); err != nil {
+			return common.Hash{}, err
+		}
+	}
+	root, err := w.Finalize()
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("export failed to finalize %d: %v", epoch, err)
+	}
+	// Set correct filename with root
+	if err := os.Rename(filename, filepath.Join(dir, era.Filename(network, epoch, root))); err != nil {
+		return common.Hash{}, err
+	}
+	return root, nil
+}
+
+// ReadAccumulators loads the list of known epoch accumulator roots from the
+// given file, or from the default list within the archive directory if empty.
+func ReadAccumulators(dir string, fn string) ([]common.Hash, error) {
+	if fn == "" {
+		fn = filepath.Join(dir, accumulatorsFile)
+	}
+	f, err := os.Open(fn)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var roots []common.Hash
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		blob, err := hexutil.Decode(line)
+		if err != nil || len(blob) != common.HashLength {
+			return nil, fmt.Errorf("invalid accumulator root %q", line)
+		}
+		roots = append(roots, common.BytesToHash(blob))
+	}
+	return roots, scanner.Err()
+}
+
+// ImportHistory imports the Era1 archives found in the specified directory into
+// the chain. Every epoch is validated against the list of known accumulator
+// roots, and the bodies and receipts against the roots of their headers.
+func ImportHistory(chain *core.BlockChain, dir string, network string, known []common.Hash) error {
+	if chain.CurrentFastBlock().NumberU64() != 0 || chain.CurrentBlock().NumberU64() != 0 {
+		return errors.New("history import only supported when starting from genesis")
+	}
+	files, err := era.ReadDir(dir, network)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no %s era1 archives found in %s", network, dir)
+	}
+	if len(files) > len(known) {
+		return fmt.Errorf("no known accumulator for epoch %d", len(known))
+	}
+	start := time.Now()
+	for i, file := range files {
+		if err := importEpoch(chain, file, uint64(i), known[i]); err != nil {
+			return fmt.Errorf("error importing %s: %v", file, err)
+		}
+		log.Info("Imported history epoch", "epoch", i, "file", filepath.Base(file), "elapsed", common.PrettyDuration(time.Since(start)))
+	}
+	log.Info("Imported blockchain history", "epochs", len(files), "head", chain.CurrentFastBlock().NumberU64(), "elapsed", common.PrettyDuration(time.Since(start)))
+	return nil
+}
+
+// importEpoch validates a single Era1 archive against its known accumulator root
+// and inserts its blocks and receipts into the ancient store.
+func importEpoch(chain *core.BlockChain, file string, epoch uint64, root common.Hash) error {
+	e, err := era.Open(file)
+	if err != nil {
+		return err
+	}
+	defer e.Close()
+
+	if e.Start() != epoch*uint64(era.MaxEra1Size) {
+		return fmt.Errorf("epoch %d starts at block #%d", epoch, e.Start())
+	}
+	// Ensure the archive matches the known accumulator, and that its contents
+	// hash to the same accumulator
+	if have, err := e.Accumulator(); err != nil {
+		return err
+	} else if have != root {
+		return fmt.Errorf("accumulator mismatch: have %x, want %x", have, root)
+	}
+	if err := e.Verify(); err != nil {
+		return err
+	}
+	var (
+		headers  []*types.Header
+		blocks   []*types.Block
+		receipts []types.Receipts
+	)
+	for number := e.Start(); number < e.Start()+e.Count(); number++ {
+		block, err := e.GetBlockByNumber(number)
+		if err != nil {
+			return fmt.Errorf("error reading block #%d: %v", number, err)
+		}
+		if number == 0 {
+			if block.Hash() != chain.Genesis().Hash() {
+				return fmt.Errorf("genesis mismatch: have %x, want %x", block.Hash(), chain.Genesis().Hash())
+			}
+			continue
+		}
+		receipt, err := e.GetReceiptsByNumber(number)
+		if err != nil {
+			return fmt.Errorf("error reading receipts #%d: %v", number, err)
+		}
+		// Headers are authenticated by the accumulator, the rest by the headers
+		if hash := types.DeriveSha(block.Transactions(), trie.NewStackTrie(nil)); hash != block.TxHash() {
+			return fmt.Errorf("transaction root mismatch in block #%d: have %x, want %x", number, hash, block.TxHash())
+		}
+		if hash := types.CalcUncleHash(block.Uncles()); hash != block.UncleHash() {
+			return fmt.Errorf("uncle root mismatch in block #%d: have %x, want %x", number, hash, block.UncleHash())
+		}
+		if hash := types.DeriveSha(receipt, trie.NewStackTrie(nil)); hash != block.ReceiptHash() {
+			return fmt.Errorf("receipt root mismatch in block #%d: have %x, want %x", number, hash, block.ReceiptHash())
+		}
+		headers = append(headers, block.Header())
+		blocks = append(blocks, block)
+		receipts = append(receipts, receipt)
+	}
+	if len(blocks) == 0 {
+		return nil
+	}
+	if n, err := chain.InsertHeaderChain(headers, 0); err != nil {
+		return fmt.Errorf("error inserting header #%d: %v", headers[n].Number, err)
+	}
+	// The archived total difficulty must match the locally computed one
+	last := blocks[len(blocks)-1]
+	td, err := e.GetTotalDifficultyByNumber(last.NumberU64())
+	if err != nil {
+		return err
+	}
+	if local := chain.GetTd(last.Hash(), last.NumberU64()); local == nil || local.Cmp(td) != 0 {
+		return fmt.Errorf("total difficulty mismatch at #%d: have %v, want %v", last.NumberU64(), local, td)
+	}
+	if n, err := chain.InsertReceiptChain(blocks, receipts, math.MaxUint64); err != nil {
+		return fmt.Errorf("error inserting block #%d: %v", blocks[n].NumberU64(), err)
+	}
+	return nil
+}
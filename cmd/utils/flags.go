@@ -63,6 +63,7 @@ import (
 	"github.com/vbgloble/go-VGB/p2p/nat"
 	"github.com/vbgloble/go-VGB/p2p/netutil"
 	"github.com/vbgloble/go-VGB/params"
+	"github.com/vbgloble/go-VGB/statediff"
 	pcsclite "github.com/gballet/go-libpcsclite"
 	"gopkg.in/urfave/cli.v1"
 )
@@ -113,6 +114,22 @@ var (
 		Name:  "datadir.ancient",
 		Usage: "Data directory for ancient chain segments (default = inside chaindata)",
 	}
+	DBEngineFlag = cli.StringFlag{
+		Name:  "db.engine",
+		Usage: "Backing database implementation to use ('leveldb' or 'pebble', default = engine of existing database or leveldb)",
+	}
+	StateDataDirFlag = DirectoryFlag{
+		Name:  "datadir.state",
+		Usage: "Data directory for the state trie, snapshot and contract codes (default = inside chaindata)",
+	}
+	StateDBEngineFlag = cli.StringFlag{
+		Name:  "db.state.engine",
+		Usage: "Backing database implementation of the separate state store ('leveldb' or 'pebble', default = db.engine)",
+	}
+	ReadOnlyFlag = cli.BoolFlag{
+		Name:  "readonly",
+		Usage: "Open the databases read-only, serving existing chain data without syncing, mining or accepting transactions (the node owning them must be stopped)",
+	}
 	KeyStoreDirFlag = DirectoryFlag{
 		Name:  "keystore",
 		Usage: "Directory for the keystore (default = inside the datadir)",
@@ -187,7 +204,7 @@ var (
 	defaultSyncMode = VBG.DefaultConfig.SyncMode
 	SyncModeFlag    = TextMarshalerFlag{
 		Name:  "syncmode",
-		Usage: `Blockchain sync mode ("fast", "full", or "light")`,
+		Usage: `Blockchain sync mode ("fast", "full", "snap" or "light")`,
 		Value: &defaultSyncMode,
 	}
 	GCModeFlag = cli.StringFlag{
@@ -204,6 +221,34 @@ var (
 		Usage: "Number of recent blocks to maintain transactions index by-hash for (default = index all blocks)",
 		Value: 0,
 	}
+	HistoryLimitFlag = cli.Uint64Flag{
+		Name:  "history.blocks",
+		Usage: "Number of recent blocks to maintain bodies and receipts for (default = keep all blocks)",
+		Value: 0,
+	}
+	ParallelImportFlag = cli.BoolFlag{
+		Name:  "parallel.import",
+		Usage: "Execute the transactions of imported blocks in parallel (experimental)",
+	}
+	FinalityDepthFlag = cli.Uint64Flag{
+		Name:  "finality.depth",
+		Usage: "Number of confirmations after which blocks are final and can't be reorged (default = never final)",
+		Value: 0,
+	}
+	FinalitySignersFlag = cli.StringFlag{
+		Name:  "finality.signers",
+		Usage: "Comma separated accounts trusted to sign finality checkpoints",
+	}
+	FinalityThresholdFlag = cli.IntFlag{
+		Name:  "finality.threshold",
+		Usage: "Number of signers required on a finality checkpoint (default = majority of the signers)",
+		Value: 0,
+	}
+	BloomFilterSizeFlag = cli.Uint64Flag{
+		Name:  "bloomfilter.size",
+		Usage: "Megabytes of memory allocated to bloom-filter for pruning",
+		Value: 2048,
+	}
 	LightKDFFlag = cli.BoolFlag{
 		Name:  "lightkdf",
 		Usage: "Reduce key-derivation RAM & CPU usage at some expense of KDF strength",
@@ -343,6 +388,24 @@ var (
 		Usage: "Maximum amount of time non-executable transaction are queued",
 		Value: VBG.DefaultConfig.TxPool.Lifetime,
 	}
+	TxPoolPolicyFlag = cli.StringFlag{
+		Name:  "txpool.policy",
+		Usage: "JSON file with the transaction admission policy (reloaded via admin.reloadTxPolicy)",
+	}
+	TxPoolStoreFlag = cli.StringFlag{
+		Name:  "txpool.store",
+		Usage: "Disk store for remote transactions to survive node restarts (disabled if empty)",
+	}
+	TxPoolStoreIntervalFlag = cli.DurationFlag{
+		Name:  "txpool.storeinterval",
+		Usage: "Time interval to regenerate the remote transaction store",
+		Value: VBG.DefaultConfig.TxPool.StoreInterval,
+	}
+	TxPoolStoreSizeFlag = cli.Uint64Flag{
+		Name:  "txpool.storesize",
+		Usage: "Maximum size of the remote transaction store in megabytes",
+		Value: VBG.DefaultConfig.TxPool.StoreSize,
+	}
 	// Performance tuning settings
 	CacheFlag = cli.IntFlag{
 		Name:  "cache",
@@ -354,6 +417,11 @@ var (
 		Usage: "Percentage of cache memory allowance to use for database io",
 		Value: 50,
 	}
+	CacheStateDBFlag = cli.IntFlag{
+		Name:  "cache.statedb",
+		Usage: "Percentage of database cache allowance to use for the separate state store",
+		Value: 50,
+	}
 	CacheTrieFlag = cli.IntFlag{
 		Name:  "cache.trie",
 		Usage: "Percentage of cache memory allowance to use for trie caching (default = 15% full mode, 30% archive mode)",
@@ -434,6 +502,11 @@ var (
 		Name:  "miner.noverify",
 		Usage: "Disable remote sealing verification",
 	}
+	MinerOrderingFlag = cli.StringFlag{
+		Name:  "miner.ordering",
+		Usage: "Transaction ordering strategy when filling blocks (price, fifo, fair)",
+		Value: VBG.DefaultConfig.Miner.Ordering,
+	}
 	// Account settings
 	UnlockedAccountFlag = cli.StringFlag{
 		Name:  "unlock",
@@ -454,6 +527,22 @@ var (
 		Name:  "vmdebug",
 		Usage: "Record information useful for VM and contract debugging",
 	}
+	TraceIndexFlag = cli.BoolFlag{
+		Name:  "trace.index",
+		Usage: "Persist transaction traces for the trace_ RPC namespace (requires archive state or indexing from genesis)",
+	}
+	StateDiffFlag = cli.BoolFlag{
+		Name:  "statediff",
+		Usage: "Enable the state diff service, streaming the state changes of every imported block",
+	}
+	StateDiffFileFlag = cli.StringFlag{
+		Name:  "statediff.file",
+		Usage: "File to append the state diffs to, one JSON object per line (empty = no file sink)",
+	}
+	StateDiffProofsFlag = cli.BoolFlag{
+		Name:  "statediff.proofs",
+		Usage: "Attach Merkle proofs of the changed accounts and storage slots to the state diffs",
+	}
 	InsecureUnlockAllowedFlag = cli.BoolFlag{
 		Name:  "allow-insecure-unlock",
 		Usage: "Allow insecure account unlocking when account-related RPCs are exposed by http",
@@ -1202,6 +1291,12 @@ func SetP2PConfig(ctx *cli.Context, cfg *p2p.Config) {
 		cfg.NoDiscovery = true
 		cfg.DiscoveryV5 = false
 	}
+	if ctx.GlobalBool(ReadOnlyFlag.Name) {
+		// Read-only nodes don't sync, there's no point in looking for peers.
+		cfg.MaxPeers = 0
+		cfg.NoDiscovery = true
+		cfg.DiscoveryV5 = false
+	}
 }
 
 // SetNodeConfig applies node-related command line flags to the config.
@@ -1222,6 +1317,26 @@ func SetNodeConfig(ctx *cli.Context, cfg *node.Config) {
 	if ctx.GlobalIsSet(KeyStoreDirFlag.Name) {
 		cfg.KeyStoreDir = ctx.GlobalString(KeyStoreDirFlag.Name)
 	}
+	if ctx.GlobalIsSet(DBEngineFlag.Name) {
+		engine := ctx.GlobalString(DBEngineFlag.Name)
+		if engine != rawdb.DBLeveldb && engine != rawdb.DBPebble {
+			Fatalf("Invalid choice for db.engine '%s', allowed '%s' or '%s'", engine, rawdb.DBLeveldb, rawdb.DBPebble)
+		}
+		cfg.DBEngine = engine
+	}
+	if ctx.GlobalIsSet(StateDataDirFlag.Name) {
+		cfg.StateDBDir = ctx.GlobalString(StateDataDirFlag.Name)
+	}
+	if ctx.GlobalIsSet(StateDBEngineFlag.Name) {
+		engine := ctx.GlobalString(StateDBEngineFlag.Name)
+		if engine != rawdb.DBLeveldb && engine != rawdb.DBPebble {
+			Fatalf("Invalid choice for db.state.engine '%s', allowed '%s' or '%s'", engine, rawdb.DBLeveldb, rawdb.DBPebble)
+		}
+		cfg.StateDBEngine = engine
+	}
+	if ctx.GlobalIsSet(CacheStateDBFlag.Name) {
+		cfg.StateDBCache = ctx.GlobalInt(CacheStateDBFlag.Name)
+	}
 	if ctx.GlobalIsSet(LightKDFFlag.Name) {
 		cfg.UseLightweightKDF = ctx.GlobalBool(LightKDFFlag.Name)
 	}
@@ -1304,6 +1419,29 @@ func setGPO(ctx *cli.Context, cfg *gasprice.Config, light bool) {
 	}
 }
 
+// setFinality configures the finalization of blocks from the command line flags.
+func setFinality(ctx *cli.Context, cfg *VBG.Config) {
+	if ctx.GlobalIsSet(FinalityDepthFlag.Name) {
+		cfg.FinalityDepth = ctx.GlobalUint64(FinalityDepthFlag.Name)
+	}
+	if ctx.GlobalIsSet(FinalitySignersFlag.Name) {
+		cfg.FinalitySigners = nil
+		for _, account := range strings.Split(ctx.GlobalString(FinalitySignersFlag.Name), ",") {
+			if trimmed := strings.TrimSpace(account); !common.IsHexAddress(trimmed) {
+				Fatalf("Invalid account in --finality.signers: %s", trimmed)
+			} else {
+				cfg.FinalitySigners = append(cfg.FinalitySigners, common.HexToAddress(trimmed))
+			}
+		}
+	}
+	if ctx.GlobalIsSet(FinalityThresholdFlag.Name) {
+		cfg.FinalityThreshold = ctx.GlobalInt(FinalityThresholdFlag.Name)
+	}
+	if cfg.FinalityThreshold > len(cfg.FinalitySigners) {
+		Fatalf("--%s exceeds the number of finality signers (%d)", FinalityThresholdFlag.Name, len(cfg.FinalitySigners))
+	}
+}
+
 func setTxPool(ctx *cli.Context, cfg *core.TxPoolConfig) {
 	if ctx.GlobalIsSet(TxPoolLocalsFlag.Name) {
 		locals := strings.Split(ctx.GlobalString(TxPoolLocalsFlag.Name), ",")
@@ -1345,6 +1483,21 @@ func setTxPool(ctx *cli.Context, cfg *core.TxPoolConfig) {
 	if ctx.GlobalIsSet(TxPoolLifetimeFlag.Name) {
 		cfg.Lifetime = ctx.GlobalDuration(TxPoolLifetimeFlag.Name)
 	}
+	if ctx.GlobalIsSet(TxPoolPolicyFlag.Name) {
+		cfg.Policy = ctx.GlobalString(TxPoolPolicyFlag.Name)
+		if _, err := core.LoadTxPolicy(cfg.Policy); err != nil {
+			Fatalf("Option %q: %v", TxPoolPolicyFlag.Name, err)
+		}
+	}
+	if ctx.GlobalIsSet(TxPoolStoreFlag.Name) {
+		cfg.Store = ctx.GlobalString(TxPoolStoreFlag.Name)
+	}
+	if ctx.GlobalIsSet(TxPoolStoreIntervalFlag.Name) {
+		cfg.StoreInterval = ctx.GlobalDuration(TxPoolStoreIntervalFlag.Name)
+	}
+	if ctx.GlobalIsSet(TxPoolStoreSizeFlag.Name) {
+		cfg.StoreSize = ctx.GlobalUint64(TxPoolStoreSizeFlag.Name)
+	}
 }
 
 func setVBGash(ctx *cli.Context, cfg *VBG.Config) {
@@ -1408,6 +1561,12 @@ func setMiner(ctx *cli.Context, cfg *miner.Config) {
 	if ctx.GlobalIsSet(MinerNoVerfiyFlag.Name) {
 		cfg.Noverify = ctx.GlobalBool(MinerNoVerfiyFlag.Name)
 	}
+	if ctx.GlobalIsSet(MinerOrderingFlag.Name) {
+		cfg.Ordering = ctx.GlobalString(MinerOrderingFlag.Name)
+		if _, err := miner.NewOrderingStrategy(cfg.Ordering); err != nil {
+			Fatalf("Option %q: %v", MinerOrderingFlag.Name, err)
+		}
+	}
 }
 
 func setWhitelist(ctx *cli.Context, cfg *VBG.Config) {
@@ -1495,6 +1654,13 @@ func SetVBGConfig(ctx *cli.Context, stack *node.Node, cfg *VBG.Config) {
 	// Ancient tx indices pruning is not available for les server now
 	// since light client relies on the server for transaction status query.
 	CheckExclusive(ctx, LegacyLightServFlag, LightServeFlag, TxLookupLimitFlag)
+	// Light clients rely on the server for historical bodies and receipts too
+	CheckExclusive(ctx, LegacyLightServFlag, LightServeFlag, HistoryLimitFlag)
+	// Read-only nodes can't produce or serve anything requiring chain updates
+	CheckExclusive(ctx, ReadOnlyFlag, MiningEnabledFlag)
+	CheckExclusive(ctx, ReadOnlyFlag, DeveloperFlag)
+	CheckExclusive(ctx, ReadOnlyFlag, LegacyLightServFlag, LightServeFlag)
+	CheckExclusive(ctx, ReadOnlyFlag, SyncModeFlag, "light")
 	var ks *keystore.KeyStore
 	if keystores := stack.AccountManager().Backends(keystore.KeyStoreType); len(keystores) > 0 {
 		ks = keystores[0].(*keystore.KeyStore)
@@ -1520,6 +1686,7 @@ func SetVBGConfig(ctx *cli.Context, stack *node.Node, cfg *VBG.Config) {
 	if ctx.GlobalIsSet(AncientFlag.Name) {
 		cfg.DatabaseFreezer = ctx.GlobalString(AncientFlag.Name)
 	}
+	cfg.DatabaseReadOnly = ctx.GlobalBool(ReadOnlyFlag.Name)
 
 	if gcmode := ctx.GlobalString(GCModeFlag.Name); gcmode != "full" && gcmode != "archive" {
 		Fatalf("--%s must be either 'full' or 'archive'", GCModeFlag.Name)
@@ -1539,6 +1706,13 @@ func SetVBGConfig(ctx *cli.Context, stack *node.Node, cfg *VBG.Config) {
 	if ctx.GlobalIsSet(TxLookupLimitFlag.Name) {
 		cfg.TxLookupLimit = ctx.GlobalUint64(TxLookupLimitFlag.Name)
 	}
+	if ctx.GlobalIsSet(HistoryLimitFlag.Name) {
+		cfg.HistoryLimit = ctx.GlobalUint64(HistoryLimitFlag.Name)
+	}
+	if ctx.GlobalIsSet(ParallelImportFlag.Name) {
+		cfg.ParallelImport = ctx.GlobalBool(ParallelImportFlag.Name)
+	}
+	setFinality(ctx, cfg)
 	if ctx.GlobalIsSet(CacheFlag.Name) || ctx.GlobalIsSet(CacheTrieFlag.Name) {
 		cfg.TrieCleanCache = ctx.GlobalInt(CacheFlag.Name) * ctx.GlobalInt(CacheTrieFlag.Name) / 100
 	}
@@ -1558,6 +1732,9 @@ func SetVBGConfig(ctx *cli.Context, stack *node.Node, cfg *VBG.Config) {
 		cfg.TrieCleanCache += cfg.SnapshotCache
 		cfg.SnapshotCache = 0 // Disabled
 	}
+	if cfg.SyncMode == downloader.SnapSync && cfg.SnapshotCache == 0 {
+		Fatalf("Snap sync requires snapshots to be enabled (--%s)", SnapshotFlag.Name)
+	}
 	if ctx.GlobalIsSet(DocRootFlag.Name) {
 		cfg.DocRoot = ctx.GlobalString(DocRootFlag.Name)
 	}
@@ -1566,6 +1743,10 @@ func SetVBGConfig(ctx *cli.Context, stack *node.Node, cfg *VBG.Config) {
 		cfg.EnablePreimageRecording = ctx.GlobalBool(VMEnableDebugFlag.Name)
 	}
 
+	if ctx.GlobalIsSet(TraceIndexFlag.Name) {
+		cfg.TraceIndex = ctx.GlobalBool(TraceIndexFlag.Name)
+	}
+
 	if ctx.GlobalIsSet(EWASMInterpreterFlag.Name) {
 		cfg.EWASMInterpreter = ctx.GlobalString(EWASMInterpreterFlag.Name)
 	}
@@ -1655,7 +1836,7 @@ func SetVBGConfig(ctx *cli.Context, stack *node.Node, cfg *VBG.Config) {
 		if ctx.GlobalIsSet(DataDirFlag.Name) {
 			// Check if we have an already initialized chain and fall back to
 			// that if so. Otherwise we need to generate a new genesis spec.
-			chaindb := MakeChainDatabase(ctx, stack)
+			chaindb := MakeChainDatabase(ctx, stack, false)
 			if rawdb.ReadCanonicalHash(chaindb, 0) != (common.Hash{}) {
 				cfg.Genesis = nil // fallback to db content
 			}
@@ -1717,6 +1898,18 @@ func RegisterVBGStatsService(stack *node.Node, backend VBGapi.Backend, url strin
 	}
 }
 
+// RegisterStateDiffService configures the state diff service and adds it to the
+// given node.
+func RegisterStateDiffService(stack *node.Node, backend VBGapi.Backend, cfg statediff.Config) {
+	full, ok := backend.(statediff.Backend)
+	if !ok {
+		Fatalf("The state diff service requires a full node")
+	}
+	if _, err := statediff.New(stack, full, cfg); err != nil {
+		Fatalf("Failed to register the state diff service: %v", err)
+	}
+}
+
 // RegisterGraphQLService is a utility function to construct a new service and register it against a node.
 func RegisterGraphQLService(stack *node.Node, backend VBGapi.Backend, cfg node.Config) {
 	if err := graphql.New(stack, backend, cfg.GraphQLCors, cfg.GraphQLVirtualHosts); err != nil {
@@ -1770,7 +1963,7 @@ func SplitTagsFlag(tagsFlag string) map[string]string {
 }
 
 // MakeChainDatabase open an LevelDB using the flags passed to the client and will hard crash if it fails.
-func MakeChainDatabase(ctx *cli.Context, stack *node.Node) VBGdb.Database {
+func MakeChainDatabase(ctx *cli.Context, stack *node.Node, readonly bool) VBGdb.Database {
 	var (
 		cache   = ctx.GlobalInt(CacheFlag.Name) * ctx.GlobalInt(CacheDatabaseFlag.Name) / 100
 		handles = makeDatabaseHandles()
@@ -1780,10 +1973,10 @@ func MakeChainDatabase(ctx *cli.Context, stack *node.Node) VBGdb.Database {
 	)
 	if ctx.GlobalString(SyncModeFlag.Name) == "light" {
 		name := "lightchaindata"
-		chainDb, err = stack.OpenDatabase(name, cache, handles, "")
+		chainDb, err = stack.OpenDatabase(name, cache, handles, "", readonly)
 	} else {
 		name := "chaindata"
-		chainDb, err = stack.OpenDatabaseWithFreezer(name, cache, handles, ctx.GlobalString(AncientFlag.Name), "")
+		chainDb, err = stack.OpenDatabaseWithFreezer(name, cache, handles, ctx.GlobalString(AncientFlag.Name), "", readonly)
 	}
 	if err != nil {
 		Fatalf("Could not open database: %v", err)
@@ -1810,9 +2003,16 @@ func MakeGenesis(ctx *cli.Context) *core.Genesis {
 
 // MakeChain creates a chain manager from set command line flags.
 func MakeChain(ctx *cli.Context, stack *node.Node, readOnly bool) (chain *core.BlockChain, chainDb VBGdb.Database) {
-	var err error
-	chainDb = MakeChainDatabase(ctx, stack)
-	config, _, err := core.SetupGenesisBlock(chainDb, MakeGenesis(ctx))
+	var (
+		config *params.ChainConfig
+		err    error
+	)
+	chainDb = MakeChainDatabase(ctx, stack, readOnly)
+	if readOnly {
+		config, _, err = core.LoadChainConfig(chainDb, MakeGenesis(ctx))
+	} else {
+		config, _, err = core.SetupGenesisBlock(chainDb, MakeGenesis(ctx))
+	}
 	if err != nil {
 		Fatalf("%v", err)
 	}
@@ -1845,6 +2045,9 @@ func MakeChain(ctx *cli.Context, stack *node.Node, readOnly bool) (chain *core.B
 		TrieTimeLimit:       VBG.DefaultConfig.TrieTimeout,
 		SnapshotLimit:       VBG.DefaultConfig.SnapshotCache,
 		Preimages:           ctx.GlobalBool(CachePreimagesFlag.Name),
+		ReadOnly:            readOnly,
+		ParallelImport:      ctx.GlobalBool(ParallelImportFlag.Name),
+		FinalityDepth:       ctx.GlobalUint64(FinalityDepthFlag.Name),
 	}
 	if cache.TrieDirtyDisabled && !cache.Preimages {
 		cache.Preimages = true
@@ -1859,6 +2062,9 @@ func MakeChain(ctx *cli.Context, stack *node.Node, readOnly bool) (chain *core.B
 	if ctx.GlobalIsSet(CacheFlag.Name) || ctx.GlobalIsSet(CacheGCFlag.Name) {
 		cache.TrieDirtyLimit = ctx.GlobalInt(CacheFlag.Name) * ctx.GlobalInt(CacheGCFlag.Name) / 100
 	}
+	if ctx.GlobalIsSet(HistoryLimitFlag.Name) && !readOnly {
+		cache.HistoryLimit = ctx.GlobalUint64(HistoryLimitFlag.Name)
+	}
 	vmcfg := vm.Config{EnablePreimageRecording: ctx.GlobalBool(VMEnableDebugFlag.Name)}
 	var limit *uint64
 	if ctx.GlobalIsSet(TxLookupLimitFlag.Name) && !readOnly {
@@ -0,0 +1,124 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package utils
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/consensus/VBGash"
+	"github.com/vbgloble/go-VGB/core"
+	"github.com/vbgloble/go-VGB/core/rawdb"
+	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/core/vm"
+	"github.com/vbgloble/go-VGB/crypto"
+	"github.com/vbgloble/go-VGB/params"
+)
+
+// Tests that frozen chain history can be exported into Era1 archives and that
+// those can be imported into a fresh database.
+func TestHistoryExportImport(t *testing.T) {
+	var (
+		key, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		address = crypto.PubkeyToAddress(key.PublicKey)
+		funds   = big.NewInt(1000000000)
+		gspec   = &core.Genesis{Config: params.TestChainConfig, Alloc: core.GenesisAlloc{address: {Balance: funds}}}
+		signer  = types.NewEIP155Signer(gspec.Config.ChainID)
+	)
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	db, err := rawdb.NewDatabaseWithFreezer(rawdb.NewMemoryDatabase(), dir+"/ancient", "", false)
+	if err != nil {
+		t.Fatalf("failed to create temp freezer db: %v", err)
+	}
+	defer db.Close()
+	genesis := gspec.MustCommit(db)
+
+	blocks, _ := core.GenerateChain(gspec.Config, genesis, VBGash.NewFaker(), db, 64, func(i int, block *core.BlockGen) {
+		tx, err := types.SignTx(types.NewTransaction(block.TxNonce(address), common.Address{0x00}, big.NewInt(1000), params.TxGas, nil, nil), signer, key)
+		if err != nil {
+			panic(err)
+		}
+		block.AddTx(tx)
+	})
+	chain, err := core.NewBlockChain(db, nil, gspec.Config, VBGash.NewFaker(), vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create tester chain: %v", err)
+	}
+	if n, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("block %d: failed to insert into chain: %v", n, err)
+	}
+	chain.Stop()
+	db.(interface{ Freeze(threshold uint64) }).Freeze(16)
+
+	// Export the frozen history, ensuring unfrozen blocks are rejected
+	archive := dir + "/era"
+	if err := ExportHistory(db, archive, 0, 64, "private"); err == nil {
+		t.Fatalf("exported unfrozen blocks")
+	}
+	if err := ExportHistory(db, archive, 0, 48, "private"); err != nil {
+		t.Fatalf("failed to export history: %v", err)
+	}
+	known, err := ReadAccumulators(archive, "")
+	if err != nil {
+		t.Fatalf("failed to read accumulators: %v", err)
+	}
+	if len(known) != 1 {
+		t.Fatalf("accumulator count mismatch: have %d, want %d", len(known), 1)
+	}
+	// Import the history into a fresh database and ensure it matches
+	importdb, err := rawdb.NewDatabaseWithFreezer(rawdb.NewMemoryDatabase(), dir+"/import", "", false)
+	if err != nil {
+		t.Fatalf("failed to create temp freezer db: %v", err)
+	}
+	defer importdb.Close()
+	gspec.MustCommit(importdb)
+
+	imported, err := core.NewBlockChain(importdb, nil, gspec.Config, VBGash.NewFaker(), vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create import chain: %v", err)
+	}
+	defer imported.Stop()
+
+	if err := ImportHistory(imported, archive, "private", []common.Hash{{0x01}}); err == nil {
+		t.Fatalf("imported history with unknown accumulator")
+	}
+	if err := ImportHistory(imported, archive, "private", known); err != nil {
+		t.Fatalf("failed to import history: %v", err)
+	}
+	if head := imported.CurrentFastBlock().NumberU64(); head != 48 {
+		t.Fatalf("fast head mismatch: have %d, want %d", head, 48)
+	}
+	for _, block := range blocks[:48] {
+		have := imported.GetBlockByNumber(block.NumberU64())
+		if have == nil || have.Hash() != block.Hash() {
+			t.Fatalf("block #%d mismatch", block.NumberU64())
+		}
+		want := chain.GetReceiptsByHash(block.Hash())
+		receipts := imported.GetReceiptsByHash(block.Hash())
+		if len(receipts) != len(want) || receipts[0].TxHash != want[0].TxHash {
+			t.Fatalf("block #%d receipts mismatch", block.NumberU64())
+		}
+	}
+}
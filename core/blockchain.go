@@ -23,6 +23,7 @@ import (
 	"io"
 	"math/big"
 	mrand "math/rand"
+	"runtime"
 	"sort"
 	"sync"
 	"sync/atomic"
@@ -51,6 +52,7 @@ var (
 	headBlockGauge     = metrics.NewRegisteredGauge("chain/head/block", nil)
 	headHeaderGauge    = metrics.NewRegisteredGauge("chain/head/header", nil)
 	headFastBlockGauge = metrics.NewRegisteredGauge("chain/head/receipt", nil)
+	headFinalizedGauge = metrics.NewRegisteredGauge("chain/head/finalized", nil)
 
 	accountReadTimer   = metrics.NewRegisteredTimer("chain/account/reads", nil)
 	accountHashTimer   = metrics.NewRegisteredTimer("chain/account/hashes", nil)
@@ -80,6 +82,11 @@ var (
 	blockPrefetchInterruptMeter = metrics.NewRegisteredMeter("chain/prefetch/interrupts", nil)
 
 	errInsertionInterrupted = errors.New("insertion is interrupted")
+	errChainReadOnly        = errors.New("blockchain is read only")
+
+	// ErrFinalizedReorg is returned if a block would reorg the canonical chain
+	// below the finalized block.
+	ErrFinalizedReorg = errors.New("reorg below finalized block")
 )
 
 const (
@@ -130,6 +137,10 @@ type CacheConfig struct {
 	TrieTimeLimit       time.Duration // Time limit after which to flush the current in-memory trie to disk
 	SnapshotLimit       int           // Memory allowance (MB) to use for caching snapshot entries in memory
 	Preimages           bool          // WhVBGer to store preimage of trie key to the disk
+	HistoryLimit        uint64        // Number of recent blocks to retain bodies and receipts for (0 = entire chain)
+	ReadOnly            bool          // WhVBGer the database is opened read-only, disabling all chain mutations
+	ParallelImport      bool          // WhVBGer to execute the transactions of imported blocks in parallel
+	FinalityDepth       uint64        // Number of confirmations after which blocks are finalized (0 = never)
 
 	SnapshotWait bool // Wait for snapshot construction on startup. TODO(karalabe): This is a dirty hack for testing, nuke it
 }
@@ -188,6 +199,7 @@ type BlockChain struct {
 
 	currentBlock     atomic.Value // Current head of the block chain
 	currentFastBlock atomic.Value // Current head of the fast-sync chain (may be above the block chain!)
+	currentFinalized atomic.Value // Latest finalized header of the block chain (nil if none)
 
 	stateCache    state.Database // State database to reuse between imports (contains state cache)
 	bodyCache     *lru.Cache     // Cache for the most recent block bodies
@@ -206,6 +218,7 @@ type BlockChain struct {
 	validator  Validator  // Block and state validator interface
 	prefetcher Prefetcher // Block state prefetcher interface
 	processor  Processor  // Block transaction processor interface
+	importer   Processor  // Block transaction processor used for importing blocks
 	vmConfig   vm.Config
 
 	badBlocks          *lru.Cache                     // Bad block cache
@@ -254,12 +267,17 @@ func NewBlockChain(db VBGdb.Database, cacheConfig *CacheConfig, chainConfig *par
 	bc.validator = NewBlockValidator(chainConfig, bc, engine)
 	bc.prefetcher = newStatePrefetcher(chainConfig, bc, engine)
 	bc.processor = NewStateProcessor(chainConfig, bc, engine)
+	bc.importer = bc.processor
+	if cacheConfig.ParallelImport {
+		bc.importer = NewParallelStateProcessor(chainConfig, bc, engine, runtime.NumCPU())
+	}
 
 	var err error
 	bc.hc, err = NewHeaderChain(db, chainConfig, engine, bc.insertStopped)
 	if err != nil {
 		return nil, err
 	}
+	bc.hc.finalized = bc.CurrentFinalizedHeader
 	bc.genesisBlock = bc.GetBlockByNumber(0)
 	if bc.genesisBlock == nil {
 		return nil, ErrNoGenesis
@@ -269,10 +287,13 @@ func NewBlockChain(db VBGdb.Database, cacheConfig *CacheConfig, chainConfig *par
 	bc.currentBlock.Store(nilBlock)
 	bc.currentFastBlock.Store(nilBlock)
 
+	var nilHeader *types.Header
+	bc.currentFinalized.Store(nilHeader)
+
 	// Initialize the chain with ancient data if it isn't empty.
 	var txIndexBlock uint64
 
-	if bc.empty() {
+	if bc.empty() && !cacheConfig.ReadOnly {
 		rawdb.InitDatabaseFromFreezer(bc.db)
 		// If ancient database is not empty, reconstruct all missing
 		// indices in the background.
@@ -286,7 +307,9 @@ func NewBlockChain(db VBGdb.Database, cacheConfig *CacheConfig, chainConfig *par
 	}
 	// Make sure the state associated with the block is available
 	head := bc.CurrentBlock()
-	if _, err := state.New(head.Root(), bc.stateCache, bc.snaps); err != nil {
+	if _, err := state.New(head.Root(), bc.stateCache, bc.snaps); err != nil && cacheConfig.ReadOnly {
+		log.Warn("Head state missing, serving chain data only", "number", head.Number(), "hash", head.Hash())
+	} else if err != nil {
 		// Head state is missing, before the state recovery, find out the
 		// disk layer point of snapshot(if it's enabled). Make sure the
 		// rewound point is lower than disk layer.
@@ -313,7 +336,7 @@ func NewBlockChain(db VBGdb.Database, cacheConfig *CacheConfig, chainConfig *par
 		}
 	}
 	// Ensure that a previous crash in SVBGead doesn't leave extra ancients
-	if frozen, err := bc.db.Ancients(); err == nil && frozen > 0 {
+	if frozen, err := bc.db.Ancients(); err == nil && frozen > 0 && !cacheConfig.ReadOnly {
 		var (
 			needRewind bool
 			low        uint64
@@ -355,6 +378,10 @@ func NewBlockChain(db VBGdb.Database, cacheConfig *CacheConfig, chainConfig *par
 			headerByNumber := bc.GVBGeaderByNumber(header.Number.Uint64())
 			// make sure the headerByNumber (if present) is in our current canonical chain
 			if headerByNumber != nil && headerByNumber.Hash() == header.Hash() {
+				if cacheConfig.ReadOnly {
+					log.Error("Found bad hash, unable to rewind read only chain", "number", header.Number, "hash", header.Hash())
+					continue
+				}
 				log.Error("Found bad hash, rewinding chain", "number", header.Number, "hash", header.ParentHash)
 				if err := bc.SVBGead(header.Number.Uint64() - 1); err != nil {
 					return nil, err
@@ -364,7 +391,7 @@ func NewBlockChain(db VBGdb.Database, cacheConfig *CacheConfig, chainConfig *par
 		}
 	}
 	// Load any existing snapshot, regenerating it if loading failed
-	if bc.cacheConfig.SnapshotLimit > 0 {
+	if bc.cacheConfig.SnapshotLimit > 0 && !bc.cacheConfig.ReadOnly {
 		// If the chain was rewound past the snapshot persistent layer (causing
 		// a recovery block number to be persisted to disk), check if we're still
 		// in recovery mode and in that case, don't invalidate the snapshot on a
@@ -376,18 +403,23 @@ func NewBlockChain(db VBGdb.Database, cacheConfig *CacheConfig, chainConfig *par
 			log.Warn("Enabling snapshot recovery", "chainhead", head.NumberU64(), "diskbase", *layer)
 			recover = true
 		}
-		bc.snaps = snapshot.New(bc.db, bc.stateCache.TrieDB(), bc.cacheConfig.SnapshotLimit, head.Root(), !bc.cacheConfig.SnapshotWait, recover)
+		bc.snaps, _ = snapshot.New(bc.db, bc.stateCache.TrieDB(), bc.cacheConfig.SnapshotLimit, head.Root(), !bc.cacheConfig.SnapshotWait, true, recover)
 	}
 	// Take ownership of this particular state
 	go bc.update()
-	if txLookupLimit != nil {
+	if txLookupLimit != nil && !bc.cacheConfig.ReadOnly {
 		bc.txLookupLimit = *txLookupLimit
 
 		bc.wg.Add(1)
 		go bc.maintainTxIndex(txIndexBlock)
 	}
+	// If old chain history needs to be expired, start the pruner
+	if bc.cacheConfig.HistoryLimit > 0 && !bc.cacheConfig.ReadOnly {
+		bc.wg.Add(1)
+		go bc.maintainHistory()
+	}
 	// If periodic cache journal is required, spin it up.
-	if bc.cacheConfig.TrieCleanRejournal > 0 {
+	if bc.cacheConfig.TrieCleanRejournal > 0 && !bc.cacheConfig.ReadOnly {
 		if bc.cacheConfig.TrieCleanRejournal < time.Minute {
 			log.Warn("Sanitizing invalid trie cache journal time", "provided", bc.cacheConfig.TrieCleanRejournal, "updated", time.Minute)
 			bc.cacheConfig.TrieCleanRejournal = time.Minute
@@ -428,6 +460,9 @@ func (bc *BlockChain) loadLastState() error {
 	head := rawdb.ReadHeadBlockHash(bc.db)
 	if head == (common.Hash{}) {
 		// Corrupt or empty database, init from scratch
+		if bc.cacheConfig.ReadOnly {
+			return errors.New("empty database")
+		}
 		log.Warn("Empty database, resetting chain")
 		return bc.Reset()
 	}
@@ -435,6 +470,9 @@ func (bc *BlockChain) loadLastState() error {
 	currentBlock := bc.GetBlockByHash(head)
 	if currentBlock == nil {
 		// Corrupt or empty database, init from scratch
+		if bc.cacheConfig.ReadOnly {
+			return fmt.Errorf("head block missing: %x", head)
+		}
 		log.Warn("Head block missing, resetting chain", "hash", head)
 		return bc.Reset()
 	}
@@ -461,6 +499,13 @@ func (bc *BlockChain) loadLastState() error {
 			headFastBlockGauge.Update(int64(block.NumberU64()))
 		}
 	}
+	// Restore the last known finalized block, if it's still canonical
+	if head := rawdb.ReadFinalizedBlockHash(bc.db); head != (common.Hash{}) {
+		if header := bc.GVBGeaderByHash(head); header != nil && bc.GetCanonicalHash(header.Number.Uint64()) == head {
+			bc.currentFinalized.Store(header)
+			headFinalizedGauge.Update(int64(header.Number.Uint64()))
+		}
+	}
 	// Issue a status log for the user
 	currentFastBlock := bc.CurrentFastBlock()
 
@@ -471,6 +516,9 @@ func (bc *BlockChain) loadLastState() error {
 	log.Info("Loaded most recent local header", "number", currentHeader.Number, "hash", currentHeader.Hash(), "td", headerTd, "age", common.PrettyAge(time.Unix(int64(currentHeader.Time), 0)))
 	log.Info("Loaded most recent local full block", "number", currentBlock.Number(), "hash", currentBlock.Hash(), "td", blockTd, "age", common.PrettyAge(time.Unix(int64(currentBlock.Time()), 0)))
 	log.Info("Loaded most recent local fast block", "number", currentFastBlock.Number(), "hash", currentFastBlock.Hash(), "td", fastTd, "age", common.PrettyAge(time.Unix(int64(currentFastBlock.Time()), 0)))
+	if finalized := bc.CurrentFinalizedHeader(); finalized != nil {
+		log.Info("Loaded most recent finalized block", "number", finalized.Number, "hash", finalized.Hash(), "age", common.PrettyAge(time.Unix(int64(finalized.Time), 0)))
+	}
 	if pivot := rawdb.ReadLastPivotNumber(bc.db); pivot != nil {
 		log.Info("Loaded last fast-sync pivot marker", "number", *pivot)
 	}
@@ -494,6 +542,9 @@ func (bc *BlockChain) SVBGead(head uint64) error {
 //
 // The mVBGod returns the block number where the requested root cap was found.
 func (bc *BlockChain) SVBGeadBeyondRoot(head uint64, root common.Hash) (uint64, error) {
+	if bc.cacheConfig.ReadOnly {
+		return 0, errChainReadOnly
+	}
 	bc.chainmu.Lock()
 	defer bc.chainmu.Unlock()
 
@@ -568,6 +619,14 @@ func (bc *BlockChain) SVBGeadBeyondRoot(head uint64, root common.Hash) (uint64,
 			bc.currentFastBlock.Store(newHeadFastBlock)
 			headFastBlockGauge.Update(int64(newHeadFastBlock.NumberU64()))
 		}
+		// Rewind the finalized block along with the head, the rewind being an
+		// explicit request to revert it
+		if finalized := bc.CurrentFinalizedHeader(); finalized != nil && header.Number.Uint64() < finalized.Number.Uint64() {
+			log.Warn("Rewinding finalized block", "number", header.Number, "hash", header.Hash(), "finalized", finalized.Number)
+			rawdb.WriteFinalizedBlockHash(db, header.Hash())
+			bc.currentFinalized.Store(header)
+			headFinalizedGauge.Update(int64(header.Number.Uint64()))
+		}
 		head := bc.CurrentBlock().NumberU64()
 
 		// If sVBGead underflown the freezer threshold and the block processing
@@ -626,6 +685,9 @@ func (bc *BlockChain) SVBGeadBeyondRoot(head uint64, root common.Hash) (uint64,
 // FastSyncCommitHead sets the current head block to the one defined by the hash
 // irrelevant what the chain contents were prior.
 func (bc *BlockChain) FastSyncCommitHead(hash common.Hash) error {
+	if bc.cacheConfig.ReadOnly {
+		return errChainReadOnly
+	}
 	// Make sure that both the block as well at its state trie exists
 	block := bc.GetBlockByHash(hash)
 	if block == nil {
@@ -674,6 +736,39 @@ func (bc *BlockChain) CurrentFastBlock() *types.Block {
 	return bc.currentFastBlock.Load().(*types.Block)
 }
 
+// CurrentFinalizedHeader retrieves the latest finalized header of the canonical
+// chain, or nil if no block was finalized yet.
+func (bc *BlockChain) CurrentFinalizedHeader() *types.Header {
+	return bc.currentFinalized.Load().(*types.Header)
+}
+
+// SetFinalized marks the given canonical header and all its ancestors final,
+// rejecting any subsequent reorg which would revert them. The finalized block
+// may also be moved backwards, re-allowing reorgs down to it.
+func (bc *BlockChain) SetFinalized(header *types.Header) error {
+	if bc.cacheConfig.ReadOnly {
+		return errChainReadOnly
+	}
+	bc.chainmu.Lock()
+	defer bc.chainmu.Unlock()
+
+	if bc.GetCanonicalHash(header.Number.Uint64()) != header.Hash() {
+		return fmt.Errorf("block #%d [%x…] is not canonical", header.Number, header.Hash().Bytes()[:4])
+	}
+	bc.writeFinalized(header)
+	log.Info("Updated finalized block", "number", header.Number, "hash", header.Hash())
+	return nil
+}
+
+// writeFinalized persists the finalized block marker and updates it in memory.
+//
+// Note, this function assumes that the `mu` mutex is held!
+func (bc *BlockChain) writeFinalized(header *types.Header) {
+	rawdb.WriteFinalizedBlockHash(bc.db, header.Hash())
+	bc.currentFinalized.Store(header)
+	headFinalizedGauge.Update(int64(header.Number.Uint64()))
+}
+
 // Validator returns the current validator.
 func (bc *BlockChain) Validator() Validator {
 	return bc.validator
@@ -699,6 +794,76 @@ func (bc *BlockChain) StateCache() state.Database {
 	return bc.stateCache
 }
 
+// StateAtBlock returns the state of the given block from the provided database.
+// If it's not locally available, up to reexec ancestor blocks are attempted to
+// be reexecuted on top of the nearest available state to regenerate it. The
+// root of a regenerated state is left referenced in the database.
+func (bc *BlockChain) StateAtBlock(block *types.Block, reexec uint64, database state.Database) (*state.StateDB, error) {
+	statedb, err := state.New(block.Root(), database, nil)
+	if err == nil {
+		return statedb, nil
+	}
+	// Otherwise try to reexec blocks until we find a state or reach our limit
+	origin := block.NumberU64()
+	for i := uint64(0); i < reexec; i++ {
+		if block.NumberU64() == 0 {
+			break
+		}
+		block = bc.GetBlock(block.ParentHash(), block.NumberU64()-1)
+		if block == nil {
+			break
+		}
+		if statedb, err = state.New(block.Root(), database, nil); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		switch err.(type) {
+		case *trie.MissingNodeError:
+			return nil, fmt.Errorf("required historical state unavailable (reexec=%d)", reexec)
+		default:
+			return nil, err
+		}
+	}
+	// State was available at historical point, regenerate
+	var (
+		start  = time.Now()
+		logged time.Time
+		proot  common.Hash
+	)
+	for block.NumberU64() < origin {
+		// Print progress logs if long enough time elapsed
+		if time.Since(logged) > 8*time.Second {
+			log.Info("Regenerating historical state", "block", block.NumberU64()+1, "target", origin, "remaining", origin-block.NumberU64()-1, "elapsed", time.Since(start))
+			logged = time.Now()
+		}
+		// Retrieve the next block to regenerate and process it
+		number := block.NumberU64() + 1
+		if block = bc.GetBlockByNumber(number); block == nil {
+			return nil, fmt.Errorf("block #%d not found", number)
+		}
+		if _, _, _, err := bc.processor.Process(block, statedb, vm.Config{}); err != nil {
+			return nil, fmt.Errorf("processing block %d failed: %v", block.NumberU64(), err)
+		}
+		// Finalize the state so any modifications are written to the trie
+		root, err := statedb.Commit(bc.chainConfig.IsEIP158(block.Number()))
+		if err != nil {
+			return nil, err
+		}
+		if err := statedb.Reset(root); err != nil {
+			return nil, fmt.Errorf("state reset after block %d failed: %v", block.NumberU64(), err)
+		}
+		database.TrieDB().Reference(root, common.Hash{})
+		if proot != (common.Hash{}) {
+			database.TrieDB().Dereference(proot)
+		}
+		proot = root
+	}
+	nodes, imgs := database.TrieDB().Size()
+	log.Info("Historical state regenerated", "block", block.NumberU64(), "elapsed", time.Since(start), "nodes", nodes, "preimages", imgs)
+	return statedb, nil
+}
+
 // Reset purges the entire blockchain, restoring it to its genesis state.
 func (bc *BlockChain) Reset() error {
 	return bc.ResetWithGenesisBlock(bc.genesisBlock)
@@ -731,6 +896,11 @@ func (bc *BlockChain) ResetWithGenesisBlock(genesis *types.Block) error {
 	bc.hc.SetCurrentHeader(bc.genesisBlock.Header())
 	bc.currentFastBlock.Store(bc.genesisBlock)
 	headFastBlockGauge.Update(int64(bc.genesisBlock.NumberU64()))
+
+	var nilHeader *types.Header
+	rawdb.DeleteFinalizedBlockHash(bc.db)
+	bc.currentFinalized.Store(nilHeader)
+	headFinalizedGauge.Update(0)
 	return nil
 }
 
@@ -799,6 +969,16 @@ func (bc *BlockChain) writeHeadBlock(block *types.Block) {
 	}
 	bc.currentBlock.Store(block)
 	headBlockGauge.Update(int64(block.NumberU64()))
+
+	// Finalize the ancestor which gained enough confirmations, if configured
+	if depth := bc.cacheConfig.FinalityDepth; depth > 0 && block.NumberU64() > depth {
+		number := block.NumberU64() - depth
+		if finalized := bc.CurrentFinalizedHeader(); finalized == nil || finalized.Number.Uint64() < number {
+			if header := bc.GVBGeaderByNumber(number); header != nil {
+				bc.writeFinalized(header)
+			}
+		}
+	}
 }
 
 // Genesis retrieves the chain's genesis block.
@@ -1001,6 +1181,11 @@ func (bc *BlockChain) Stop() {
 	bc.StopInsert()
 	bc.wg.Wait()
 
+	// Read only chains have nothing to persist
+	if bc.cacheConfig.ReadOnly {
+		log.Info("Blockchain stopped")
+		return
+	}
 	// Ensure that the entirety of the state snapshot is journalled to disk.
 	var snapBase common.Hash
 	if bc.snaps != nil {
@@ -1135,6 +1320,9 @@ type numberHash struct {
 // InsertReceiptChain attempts to complete an already existing header chain with
 // transaction and receipt data.
 func (bc *BlockChain) InsertReceiptChain(blockChain types.Blocks, receiptChain []types.Receipts, ancientLimit uint64) (int, error) {
+	if bc.cacheConfig.ReadOnly {
+		return 0, errChainReadOnly
+	}
 	// We don't require the chainMu here since we want to maximize the
 	// concurrency of header insertion and receipt insertion.
 	bc.wg.Add(1)
@@ -1665,6 +1853,9 @@ func (bc *BlockChain) InsertChain(chain types.Blocks) (int, error) {
 	if len(chain) == 0 {
 		return 0, nil
 	}
+	if bc.cacheConfig.ReadOnly {
+		return 0, errChainReadOnly
+	}
 
 	bc.blockProcFeed.Send(true)
 	defer bc.blockProcFeed.Send(false)
@@ -1886,7 +2077,7 @@ func (bc *BlockChain) insertChain(chain types.Blocks, verifySeals bool) (int, er
 		}
 		// Process block using the parent state as reference point
 		substart := time.Now()
-		receipts, logs, usedGas, err := bc.processor.Process(block, statedb, bc.vmConfig)
+		receipts, logs, usedGas, err := bc.importer.Process(block, statedb, bc.vmConfig)
 		if err != nil {
 			bc.reportBlock(block, receipts, err)
 			atomic.StoreUint32(&followupInterrupt, 1)
@@ -2215,6 +2406,12 @@ func (bc *BlockChain) reorg(oldBlock, newBlock *types.Block) error {
 			return fmt.Errorf("invalid new chain")
 		}
 	}
+	// Refuse to revert any finalized block
+	if finalized := bc.CurrentFinalizedHeader(); finalized != nil && len(oldChain) > 0 && commonBlock.NumberU64() < finalized.Number.Uint64() {
+		log.Warn("Rejected reorg below finalized block", "number", commonBlock.Number(), "hash", commonBlock.Hash(),
+			"finalized", finalized.Number, "drop", len(oldChain), "add", len(newChain), "addfrom", newChain[0].Hash())
+		return fmt.Errorf("%w: fork point #%d, finalized #%d", ErrFinalizedReorg, commonBlock.NumberU64(), finalized.Number)
+	}
 	// Ensure the user sees large reorgs
 	if len(oldChain) > 0 && len(newChain) > 0 {
 		logFn := log.Info
@@ -2292,6 +2489,55 @@ func (bc *BlockChain) update() {
 	}
 }
 
+// maintainHistory is responsible for discarding the bodies and receipts of blocks
+// older than the configured history limit. Only frozen blocks are ever pruned,
+// so the effective history retained never drops below the immutability threshold.
+func (bc *BlockChain) maintainHistory() {
+	defer bc.wg.Done()
+
+	// prune discards the history below HEAD-limit that already reached the freezer
+	prune := func(head uint64) {
+		if head < bc.cacheConfig.HistoryLimit {
+			return
+		}
+		frozen, err := bc.db.Ancients()
+		if err != nil {
+			return // No freezer backing the database
+		}
+		target := head - bc.cacheConfig.HistoryLimit + 1
+		if target > frozen {
+			target = frozen
+		}
+		if tail, err := bc.db.Tail(); err != nil || tail >= target {
+			return
+		}
+		start := time.Now()
+		if err := bc.db.TruncateTail(target); err != nil {
+			log.Error("Failed to prune chain history", "tail", target, "err", err)
+			return
+		}
+		log.Info("Pruned ancient chain history", "tail", target, "elapsed", common.PrettyDuration(time.Since(start)))
+	}
+	prune(bc.CurrentBlock().NumberU64())
+
+	// Move the history window along with the chain head
+	headCh := make(chan ChainHeadEvent, 1) // Buffered to avoid locking up the event feed
+	sub := bc.SubscribeChainHeadEvent(headCh)
+	if sub == nil {
+		return
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case head := <-headCh:
+			prune(head.Block.NumberU64())
+		case <-bc.quit:
+			return
+		}
+	}
+}
+
 // maintainTxIndex is responsible for the construction and deletion of the
 // transaction index.
 //
@@ -2427,6 +2673,9 @@ Error: %v
 // of the header retrieval mechanisms already need to verify nonces, as well as
 // because nonces can be verified sparsely, not needing to check each.
 func (bc *BlockChain) InsertHeaderChain(chain []*types.Header, checkFreq int) (int, error) {
+	if bc.cacheConfig.ReadOnly {
+		return 0, errChainReadOnly
+	}
 	start := time.Now()
 	if i, err := bc.hc.ValidateHeaderChain(chain, checkFreq); err != nil {
 		return i, err
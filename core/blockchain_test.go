@@ -17,6 +17,7 @@
 package core
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"math/big"
@@ -650,7 +651,7 @@ func TestFastVsFullChains(t *testing.T) {
 		t.Fatalf("failed to create temp freezer dir: %v", err)
 	}
 	defer os.Remove(frdir)
-	ancientDb, err := rawdb.NewDatabaseWithFreezer(rawdb.NewMemoryDatabase(), frdir, "")
+	ancientDb, err := rawdb.NewDatabaseWithFreezer(rawdb.NewMemoryDatabase(), frdir, "", false)
 	if err != nil {
 		t.Fatalf("failed to create temp freezer db: %v", err)
 	}
@@ -724,7 +725,7 @@ func TestLightVsFastVsFullChainHeads(t *testing.T) {
 			t.Fatalf("failed to create temp freezer dir: %v", err)
 		}
 		defer os.Remove(dir)
-		db, err := rawdb.NewDatabaseWithFreezer(rawdb.NewMemoryDatabase(), dir, "")
+		db, err := rawdb.NewDatabaseWithFreezer(rawdb.NewMemoryDatabase(), dir, "", false)
 		if err != nil {
 			t.Fatalf("failed to create temp freezer db: %v", err)
 		}
@@ -1591,7 +1592,7 @@ func TestBlockchainRecovery(t *testing.T) {
 	}
 	defer os.Remove(frdir)
 
-	ancientDb, err := rawdb.NewDatabaseWithFreezer(rawdb.NewMemoryDatabase(), frdir, "")
+	ancientDb, err := rawdb.NewDatabaseWithFreezer(rawdb.NewMemoryDatabase(), frdir, "", false)
 	if err != nil {
 		t.Fatalf("failed to create temp freezer db: %v", err)
 	}
@@ -1648,7 +1649,7 @@ func TestIncompleteAncientReceiptChainInsertion(t *testing.T) {
 		t.Fatalf("failed to create temp freezer dir: %v", err)
 	}
 	defer os.Remove(frdir)
-	ancientDb, err := rawdb.NewDatabaseWithFreezer(rawdb.NewMemoryDatabase(), frdir, "")
+	ancientDb, err := rawdb.NewDatabaseWithFreezer(rawdb.NewMemoryDatabase(), frdir, "", false)
 	if err != nil {
 		t.Fatalf("failed to create temp freezer db: %v", err)
 	}
@@ -1847,7 +1848,7 @@ func testInsertKnownChainData(t *testing.T, typ string) {
 		t.Fatalf("failed to create temp freezer dir: %v", err)
 	}
 	defer os.Remove(dir)
-	chaindb, err := rawdb.NewDatabaseWithFreezer(rawdb.NewMemoryDatabase(), dir, "")
+	chaindb, err := rawdb.NewDatabaseWithFreezer(rawdb.NewMemoryDatabase(), dir, "", false)
 	if err != nil {
 		t.Fatalf("failed to create temp freezer db: %v", err)
 	}
@@ -2127,7 +2128,7 @@ func TestTransactionIndices(t *testing.T) {
 		t.Fatalf("failed to create temp freezer dir: %v", err)
 	}
 	defer os.Remove(frdir)
-	ancientDb, err := rawdb.NewDatabaseWithFreezer(rawdb.NewMemoryDatabase(), frdir, "")
+	ancientDb, err := rawdb.NewDatabaseWithFreezer(rawdb.NewMemoryDatabase(), frdir, "", false)
 	if err != nil {
 		t.Fatalf("failed to create temp freezer db: %v", err)
 	}
@@ -2155,7 +2156,7 @@ func TestTransactionIndices(t *testing.T) {
 	// Init block chain with external ancients, check all needed indices has been indexed.
 	limit := []uint64{0, 32, 64, 128}
 	for _, l := range limit {
-		ancientDb, err = rawdb.NewDatabaseWithFreezer(rawdb.NewMemoryDatabase(), frdir, "")
+		ancientDb, err = rawdb.NewDatabaseWithFreezer(rawdb.NewMemoryDatabase(), frdir, "", false)
 		if err != nil {
 			t.Fatalf("failed to create temp freezer db: %v", err)
 		}
@@ -2175,7 +2176,7 @@ func TestTransactionIndices(t *testing.T) {
 	}
 
 	// Reconstruct a block chain which only reserves HEAD-64 tx indices
-	ancientDb, err = rawdb.NewDatabaseWithFreezer(rawdb.NewMemoryDatabase(), frdir, "")
+	ancientDb, err = rawdb.NewDatabaseWithFreezer(rawdb.NewMemoryDatabase(), frdir, "", false)
 	if err != nil {
 		t.Fatalf("failed to create temp freezer db: %v", err)
 	}
@@ -2195,6 +2196,109 @@ func TestTransactionIndices(t *testing.T) {
 	}
 }
 
+// Tests that bodies and receipts of frozen blocks older than the history limit
+// are discarded, while the header chain and transaction lookups are retained.
+func TestHistoryPruning(t *testing.T) {
+	// Configure and generate a sample block chain
+	var (
+		key, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		address = crypto.PubkeyToAddress(key.PublicKey)
+		funds   = big.NewInt(1000000000)
+		gspec   = &Genesis{Config: params.TestChainConfig, Alloc: GenesisAlloc{address: {Balance: funds}}}
+		signer  = types.NewEIP155Signer(gspec.Config.ChainID)
+	)
+	frdir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatalf("failed to create temp freezer dir: %v", err)
+	}
+	defer os.RemoveAll(frdir)
+	db, err := rawdb.NewDatabaseWithFreezer(rawdb.NewMemoryDatabase(), frdir, "", false)
+	if err != nil {
+		t.Fatalf("failed to create temp freezer db: %v", err)
+	}
+	defer db.Close()
+	gspec.MustCommit(db)
+
+	gendb := rawdb.NewMemoryDatabase()
+	blocks, _ := GenerateChain(gspec.Config, gspec.MustCommit(gendb), VBGash.NewFaker(), gendb, 65, func(i int, block *BlockGen) {
+		tx, err := types.SignTx(types.NewTransaction(block.TxNonce(address), common.Address{0x00}, big.NewInt(1000), params.TxGas, nil, nil), signer, key)
+		if err != nil {
+			panic(err)
+		}
+		block.AddTx(tx)
+	})
+	cacheConfig := &CacheConfig{
+		TrieCleanLimit: 256,
+		TrieDirtyLimit: 256,
+		TrieTimeLimit:  5 * time.Minute,
+		SnapshotWait:   true,
+		HistoryLimit:   32,
+	}
+	chain, err := NewBlockChain(db, cacheConfig, params.TestChainConfig, VBGash.NewFaker(), vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create tester chain: %v", err)
+	}
+	if n, err := chain.InsertChain(blocks[:64]); err != nil {
+		t.Fatalf("block %d: failed to insert into chain: %v", n, err)
+	}
+	// Freeze all but the last 16 blocks, and import a new head to trigger pruning
+	db.(interface{ Freeze(threshold uint64) }).Freeze(16)
+	if frozen, _ := db.Ancients(); frozen != 49 {
+		t.Fatalf("frozen item count mismatch: have %d, want %d", frozen, 49)
+	}
+	if n, err := chain.InsertChain(blocks[64:]); err != nil {
+		t.Fatalf("block %d: failed to insert into chain: %v", n, err)
+	}
+	// The history below HEAD-limit should be gone, bounded by the frozen items
+	tail := uint64(65 - 32 + 1)
+	for i := 0; ; i++ {
+		if have, _ := db.Tail(); have == tail {
+			break
+		}
+		if i == 100 {
+			have, _ := db.Tail()
+			t.Fatalf("history tail mismatch: have %d, want %d", have, tail)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	chain.Stop()
+
+	// Reopen the chain and ensure the history tail was persisted
+	chain, err = NewBlockChain(db, cacheConfig, params.TestChainConfig, VBGash.NewFaker(), vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to recreate tester chain: %v", err)
+	}
+	defer chain.Stop()
+
+	for _, block := range blocks {
+		number, hash := block.NumberU64(), block.Hash()
+		if chain.GVBGeaderByNumber(number) == nil {
+			t.Fatalf("block %d: header missing", number)
+		}
+		if rawdb.ReadTxLookupEntry(db, block.Transactions()[0].Hash()) == nil {
+			t.Fatalf("block %d: transaction lookup missing", number)
+		}
+		if number < tail {
+			if chain.GetBlockByNumber(number) != nil || chain.HasBlock(hash, number) {
+				t.Fatalf("block %d: pruned body still available", number)
+			}
+			if chain.GetReceiptsByHash(hash) != nil {
+				t.Fatalf("block %d: pruned receipts still available", number)
+			}
+			if _, err := db.Ancient("bodies", number); err == nil {
+				t.Fatalf("block %d: pruned body retrievable from freezer", number)
+			}
+			continue
+		}
+		if chain.GetBlockByNumber(number) == nil || !chain.HasBlock(hash, number) {
+			t.Fatalf("block %d: body missing", number)
+		}
+		if chain.GetReceiptsByHash(hash) == nil {
+			t.Fatalf("block %d: receipts missing", number)
+		}
+	}
+}
+
 func TestSkipStaleTxIndicesInFastSync(t *testing.T) {
 	// Configure and generate a sample block chain
 	var (
@@ -2254,7 +2358,7 @@ func TestSkipStaleTxIndicesInFastSync(t *testing.T) {
 		t.Fatalf("failed to create temp freezer dir: %v", err)
 	}
 	defer os.Remove(frdir)
-	ancientDb, err := rawdb.NewDatabaseWithFreezer(rawdb.NewMemoryDatabase(), frdir, "")
+	ancientDb, err := rawdb.NewDatabaseWithFreezer(rawdb.NewMemoryDatabase(), frdir, "", false)
 	if err != nil {
 		t.Fatalf("failed to create temp freezer db: %v", err)
 	}
@@ -3029,3 +3133,150 @@ func TestInitThenFailCreateContract(t *testing.T) {
 		}
 	}
 }
+
+// Tests that the state of a block is regenerated from the nearest available
+// ancestor state, as long as it's within the re-execution limit.
+func TestStateAtBlock(t *testing.T) {
+	var (
+		key, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		address = crypto.PubkeyToAddress(key.PublicKey)
+		gspec   = &Genesis{Config: params.TestChainConfig, Alloc: GenesisAlloc{address: {Balance: big.NewInt(params.VBGer)}}}
+		db      = rawdb.NewMemoryDatabase()
+		genesis = gspec.MustCommit(db)
+		signer  = types.LatestSigner(gspec.Config)
+	)
+	blocks, _ := GenerateChain(gspec.Config, genesis, VBGash.NewFaker(), db, 8, func(i int, b *BlockGen) {
+		tx, _ := types.SignTx(types.NewTransaction(b.TxNonce(address), common.Address{0x01}, big.NewInt(1000), params.TxGas, big.NewInt(params.GWei), nil), signer, key)
+		b.AddTx(tx)
+	})
+	// Import the chain without flushing any state but the genesis to disk
+	chaindb := rawdb.NewMemoryDatabase()
+	gspec.MustCommit(chaindb)
+
+	chain, _ := NewBlockChain(chaindb, nil, gspec.Config, VBGash.NewFaker(), vm.Config{}, nil, nil)
+	defer chain.Stop()
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+	database := state.NewDatabase(chaindb)
+	if _, err := chain.StateAtBlock(blocks[5], 5, database); err == nil {
+		t.Fatalf("state regenerated beyond the reexec limit")
+	}
+	statedb, err := chain.StateAtBlock(blocks[5], 6, database)
+	if err != nil {
+		t.Fatalf("failed to regenerate state: %v", err)
+	}
+	if root := statedb.IntermediateRoot(true); root != blocks[5].Root() {
+		t.Fatalf("regenerated state root mismatch: have %x, want %x", root, blocks[5].Root())
+	}
+	if balance := statedb.GetBalance(common.Address{0x01}); balance.Cmp(big.NewInt(6000)) != 0 {
+		t.Fatalf("regenerated balance mismatch: have %v, want %v", balance, 6000)
+	}
+}
+
+// Tests that blocks are finalized after the configured number of confirmations,
+// that reorgs reverting a finalized block are rejected and that the finalized
+// block is persisted across restarts and rewound along with the chain head.
+func TestFinalizedReorg(t *testing.T) {
+	var (
+		db      = rawdb.NewMemoryDatabase()
+		gspec   = &Genesis{Config: params.TestChainConfig}
+		genesis = gspec.MustCommit(db)
+		config  = *defaultCacheConfig
+	)
+	config.FinalityDepth = 4
+
+	blocks, _ := GenerateChain(gspec.Config, genesis, VBGash.NewFaker(), db, 10, func(i int, b *BlockGen) {})
+	chain, _ := NewBlockChain(db, &config, gspec.Config, VBGash.NewFaker(), vm.Config{}, nil, nil)
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+	if finalized := chain.CurrentFinalizedHeader(); finalized == nil || finalized.Hash() != blocks[5].Hash() {
+		t.Fatalf("finalized block mismatch: have %v, want %d", finalized, 6)
+	}
+	// Ensure a heavier fork reverting a finalized block is rejected
+	fork, _ := GenerateChain(gspec.Config, blocks[3], VBGash.NewFaker(), db, 8, func(i int, b *BlockGen) {
+		b.SetCoinbase(common.Address{0x01})
+	})
+	if _, err := chain.InsertChain(fork); !errors.Is(err, ErrFinalizedReorg) {
+		t.Fatalf("finalized block reorg error mismatch: have %v, want %v", err, ErrFinalizedReorg)
+	}
+	if head := chain.CurrentBlock(); head.Hash() != blocks[9].Hash() {
+		t.Fatalf("head block mismatch: have %d, want %d", head.NumberU64(), 10)
+	}
+	// Ensure a heavier fork above the finalized block is accepted
+	fork, _ = GenerateChain(gspec.Config, blocks[6], VBGash.NewFaker(), db, 6, func(i int, b *BlockGen) {
+		b.SetCoinbase(common.Address{0x02})
+	})
+	if _, err := chain.InsertChain(fork); err != nil {
+		t.Fatalf("failed to reorg above finalized block: %v", err)
+	}
+	if finalized := chain.CurrentFinalizedHeader(); finalized == nil || finalized.Hash() != fork[1].Hash() {
+		t.Fatalf("finalized block mismatch: have %v, want %d", finalized, 9)
+	}
+	chain.Stop()
+
+	// Ensure the finalized block is restored and rewound along with the head
+	chain, _ = NewBlockChain(db, &config, gspec.Config, VBGash.NewFaker(), vm.Config{}, nil, nil)
+	defer chain.Stop()
+
+	if finalized := chain.CurrentFinalizedHeader(); finalized == nil || finalized.Hash() != fork[1].Hash() {
+		t.Fatalf("restored finalized block mismatch: have %v, want %d", finalized, 9)
+	}
+	if err := chain.SVBGead(5); err != nil {
+		t.Fatalf("failed to rewind chain: %v", err)
+	}
+	if finalized := chain.CurrentFinalizedHeader(); finalized == nil || finalized.Hash() != blocks[4].Hash() {
+		t.Fatalf("rewound finalized block mismatch: have %v, want %d", finalized, 5)
+	}
+}
+
+// Tests that header chain imports, as done by fast and light sync, are rejected
+// if they would revert the finalized block too.
+func TestFinalizedHeaderReorg(t *testing.T) {
+	var (
+		db      = rawdb.NewMemoryDatabase()
+		gspec   = &Genesis{Config: params.TestChainConfig}
+		genesis = gspec.MustCommit(db)
+	)
+	blocks, _ := GenerateChain(gspec.Config, genesis, VBGash.NewFaker(), db, 10, func(i int, b *BlockGen) {})
+	chain, _ := NewBlockChain(db, nil, gspec.Config, VBGash.NewFaker(), vm.Config{}, nil, nil)
+	defer chain.Stop()
+
+	headers := func(blocks []*types.Block) []*types.Header {
+		headers := make([]*types.Header, len(blocks))
+		for i, block := range blocks {
+			headers[i] = block.Header()
+		}
+		return headers
+	}
+	if _, err := chain.InsertHeaderChain(headers(blocks), 1); err != nil {
+		t.Fatalf("failed to insert header chain: %v", err)
+	}
+	if err := chain.SetFinalized(blocks[5].Header()); err != nil {
+		t.Fatalf("failed to set finalized block: %v", err)
+	}
+	// Ensure a heavier header fork reverting a finalized block is rejected
+	fork, _ := GenerateChain(gspec.Config, blocks[3], VBGash.NewFaker(), db, 8, func(i int, b *BlockGen) {
+		b.SetCoinbase(common.Address{0x01})
+	})
+	if _, err := chain.InsertHeaderChain(headers(fork), 1); !errors.Is(err, ErrFinalizedReorg) {
+		t.Fatalf("finalized header reorg error mismatch: have %v, want %v", err, ErrFinalizedReorg)
+	}
+	if head := chain.CurrentHeader(); head.Hash() != blocks[9].Hash() {
+		t.Fatalf("head header mismatch: have %d, want %d", head.Number, 10)
+	}
+	if hash := chain.GetCanonicalHash(5); hash != blocks[4].Hash() {
+		t.Fatalf("canonical hash #5 mismatch: have %x, want %x", hash, blocks[4].Hash())
+	}
+	// Ensure a heavier header fork above the finalized block is accepted
+	fork, _ = GenerateChain(gspec.Config, blocks[6], VBGash.NewFaker(), db, 6, func(i int, b *BlockGen) {
+		b.SetCoinbase(common.Address{0x02})
+	})
+	if _, err := chain.InsertHeaderChain(headers(fork), 1); err != nil {
+		t.Fatalf("failed to reorg headers above finalized block: %v", err)
+	}
+	if head := chain.CurrentHeader(); head.Hash() != fork[5].Hash() {
+		t.Fatalf("head header mismatch: have %d, want %d", head.Number, 13)
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/core/state"
+	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/core/vm"
+	"github.com/vbgloble/go-VGB/crypto"
+	"github.com/vbgloble/go-VGB/log"
+	"github.com/vbgloble/go-VGB/params"
+)
+
+// maxBundlesPerBlock is the maximum number of bundles tracked for a single
+// target block, protecting the pool against being flooded.
+const maxBundlesPerBlock = 256
+
+var (
+	// ErrEmptyBundle is returned if a bundle contains no transactions.
+	ErrEmptyBundle = errors.New("empty bundle")
+
+	// ErrBundleTimestamp is returned if a bundle's timestamp range is empty.
+	ErrBundleTimestamp = errors.New("invalid bundle timestamp range")
+
+	// ErrBundleStale is returned if a bundle targets a block already in the chain.
+	ErrBundleStale = errors.New("bundle targets past block")
+
+	// ErrBundlePoolFull is returned if the maximum number of bundles for the
+	// target block is already reached.
+	ErrBundlePoolFull = errors.New("bundle pool full")
+
+	// ErrBundleReverted is returned if a bundle transaction reverts without being
+	// listed as allowed to.
+	ErrBundleReverted = errors.New("bundle transaction reverted")
+)
+
+// Bundle is an ordered group of transactions which must be included togVBGer,
+// contiguously and in order, in a specific block, or not at all.
+type Bundle struct {
+	Txs               types.Transactions
+	BlockNumber       uint64        // Block the bundle is targeting
+	MinTimestamp      uint64        // Earliest acceptable block timestamp, zero if unrestricted
+	MaxTimestamp      uint64        // Latest acceptable block timestamp, zero if unrestricted
+	RevertingTxHashes []common.Hash // Transactions allowed to revert without invalidating the bundle
+}
+
+// Hash returns the keccak256 hash of the concatenated transaction hashes.
+func (b *Bundle) Hash() common.Hash {
+	hashes := make([]byte, 0, len(b.Txs)*common.HashLength)
+	for _, tx := range b.Txs {
+		hashes = append(hashes, tx.Hash().Bytes()...)
+	}
+	return crypto.Keccak256Hash(hashes)
+}
+
+// eligible returns whVBGer the bundle may be included in a block with the given
+// number and timestamp.
+func (b *Bundle) eligible(number, timestamp uint64) bool {
+	if b.BlockNumber != number {
+		return false
+	}
+	if b.MinTimestamp != 0 && timestamp < b.MinTimestamp {
+		return false
+	}
+	if b.MaxTimestamp != 0 && timestamp > b.MaxTimestamp {
+		return false
+	}
+	return true
+}
+
+// canRevert returns whVBGer the transaction with the given hash is allowed to
+// revert without invalidating the bundle.
+func (b *Bundle) canRevert(hash common.Hash) bool {
+	for _, allowed := range b.RevertingTxHashes {
+		if allowed == hash {
+			return true
+		}
+	}
+	return false
+}
+
+// BundleResult is the outcome of applying a bundle on top of a state.
+type BundleResult struct {
+	Receipts     types.Receipts
+	GasUsed      uint64
+	CoinbaseDiff *big.Int // Balance change of the coinbase, fees and direct payments
+	GasFees      *big.Int // Portion of the coinbase diff paid as transaction tips
+}
+
+// GasPrice returns the effective price per gas the bundle pays the coinbase.
+func (r *BundleResult) GasPrice() *big.Int {
+	if r.GasUsed == 0 {
+		return new(big.Int)
+	}
+	return new(big.Int).Div(r.CoinbaseDiff, new(big.Int).SetUint64(r.GasUsed))
+}
+
+// ApplyBundle applies all transactions of a bundle in order on top of statedb,
+// the first one at the given transaction index. If any of them fails, or reverts
+// without being allowed to, an error is returned.
+//
+// Note, the state is finalised after every transaction, so it cannot be reverted
+// to a snapshot on failure. Callers needing the bundle to be atomic must apply it
+// on a copy of the state and gas pool, discarding them on error.
+func ApplyBundle(config *params.ChainConfig, bc ChainContext, author *common.Address, gp *GasPool, statedb *state.StateDB, header *types.Header, bundle *Bundle, index int, usedGas *uint64, cfg vm.Config) (*BundleResult, error) {
+	var (
+		balance = statedb.GetBalance(*author)
+		result  = &BundleResult{GasFees: new(big.Int)}
+	)
+	for i, tx := range bundle.Txs {
+		statedb.Prepare(tx.Hash(), common.Hash{}, index+i)
+
+		receipt, err := ApplyTransaction(config, bc, author, gp, statedb, header, tx, usedGas, cfg)
+		if err == nil && receipt.Status == types.ReceiptStatusFailed && !bundle.canRevert(tx.Hash()) {
+			err = ErrBundleReverted
+		}
+		if err != nil {
+			return nil, fmt.Errorf("tx %d [%x]: %w", i, tx.Hash(), err)
+		}
+		tip, _ := tx.EffectiveGasTip(header.BaseFee)
+		result.GasFees.Add(result.GasFees, new(big.Int).Mul(tip, new(big.Int).SetUint64(receipt.GasUsed)))
+		result.Receipts = append(result.Receipts, receipt)
+		result.GasUsed += receipt.GasUsed
+	}
+	result.CoinbaseDiff = new(big.Int).Sub(statedb.GetBalance(*author), balance)
+	return result, nil
+}
+
+// BundlePool holds transaction bundles submitted for inclusion by the local
+// miner until the block they are targeting is part of the chain.
+type BundlePool struct {
+	chain  blockChain
+	signer types.Signer
+
+	mu      sync.Mutex
+	bundles map[uint64]map[common.Hash]*Bundle // Bundles grouped by target block
+}
+
+// NewBundlePool creates a new bundle pool tracking the head of the given chain.
+func NewBundlePool(chainconfig *params.ChainConfig, chain blockChain) *BundlePool {
+	return &BundlePool{
+		chain:   chain,
+		signer:  types.LatestSigner(chainconfig),
+		bundles: make(map[uint64]map[common.Hash]*Bundle),
+	}
+}
+
+// Add validates a bundle and stores it until its target block is reached.
+func (p *BundlePool) Add(bundle *Bundle) error {
+	if len(bundle.Txs) == 0 {
+		return ErrEmptyBundle
+	}
+	if bundle.MaxTimestamp != 0 && bundle.MaxTimestamp < bundle.MinTimestamp {
+		return ErrBundleTimestamp
+	}
+	for i, tx := range bundle.Txs {
+		if _, err := types.Sender(p.signer, tx); err != nil {
+			return fmt.Errorf("tx %d [%x]: %w", i, tx.Hash(), ErrInvalidSender)
+		}
+	}
+	head := p.chain.CurrentBlock().NumberU64()
+	if bundle.BlockNumber <= head {
+		return ErrBundleStale
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.prune(head)
+
+	hash := bundle.Hash()
+	bundles := p.bundles[bundle.BlockNumber]
+	if bundles == nil {
+		bundles = make(map[common.Hash]*Bundle)
+		p.bundles[bundle.BlockNumber] = bundles
+	}
+	if bundles[hash] != nil {
+		return ErrAlreadyKnown
+	}
+	if len(bundles) >= maxBundlesPerBlock {
+		return ErrBundlePoolFull
+	}
+	bundles[hash] = bundle
+	log.Debug("Added transaction bundle", "hash", hash, "number", bundle.BlockNumber, "txs", len(bundle.Txs))
+	return nil
+}
+
+// Bundles returns all bundles eligible for inclusion in a block with the given
+// number and timestamp, dropping any targeting earlier blocks.
+func (p *BundlePool) Bundles(number, timestamp uint64) []*Bundle {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if number > 0 {
+		p.prune(number - 1)
+	}
+	var bundles []*Bundle
+	for _, bundle := range p.bundles[number] {
+		if bundle.eligible(number, timestamp) {
+			bundles = append(bundles, bundle)
+		}
+	}
+	return bundles
+}
+
+// prune drops all bundles targeting blocks up to and including number.
+func (p *BundlePool) prune(number uint64) {
+	for target := range p.bundles {
+		if target <= number {
+			delete(p.bundles, target)
+		}
+	}
+}
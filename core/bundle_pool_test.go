@@ -0,0 +1,113 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/core/rawdb"
+	"github.com/vbgloble/go-VGB/core/state"
+	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/core/vm"
+	"github.com/vbgloble/go-VGB/crypto"
+	"github.com/vbgloble/go-VGB/event"
+	"github.com/vbgloble/go-VGB/params"
+)
+
+// Tests that bundles are validated on submission and only returned for blocks
+// they are eligible for.
+func TestBundlePool(t *testing.T) {
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	pool := NewBundlePool(params.TestChainConfig, &testBlockChain{statedb, 10000000, new(event.Feed)})
+
+	key, _ := crypto.GenerateKey()
+	txs := types.Transactions{transaction(0, 21000, key), transaction(1, 21000, key)}
+
+	if err := pool.Add(&Bundle{BlockNumber: 1}); err != ErrEmptyBundle {
+		t.Fatalf("empty bundle error mismatch: have %v, want %v", err, ErrEmptyBundle)
+	}
+	if err := pool.Add(&Bundle{Txs: txs, BlockNumber: 0}); err != ErrBundleStale {
+		t.Fatalf("stale bundle error mismatch: have %v, want %v", err, ErrBundleStale)
+	}
+	if err := pool.Add(&Bundle{Txs: txs, BlockNumber: 1, MinTimestamp: 10, MaxTimestamp: 5}); err != ErrBundleTimestamp {
+		t.Fatalf("timestamp range error mismatch: have %v, want %v", err, ErrBundleTimestamp)
+	}
+	if err := pool.Add(&Bundle{Txs: txs, BlockNumber: 1, MinTimestamp: 5, MaxTimestamp: 10}); err != nil {
+		t.Fatalf("failed to add bundle: %v", err)
+	}
+	if err := pool.Add(&Bundle{Txs: txs, BlockNumber: 1}); err != ErrAlreadyKnown {
+		t.Fatalf("duplicate bundle error mismatch: have %v, want %v", err, ErrAlreadyKnown)
+	}
+	if err := pool.Add(&Bundle{Txs: txs[:1], BlockNumber: 2}); err != nil {
+		t.Fatalf("failed to add bundle: %v", err)
+	}
+	for i, tt := range []struct {
+		number, timestamp uint64
+		bundles           int
+	}{
+		{1, 4, 0},  // Too early
+		{1, 5, 1},  // Lower bound
+		{1, 10, 1}, // Upper bound
+		{1, 11, 0}, // Too late
+		{2, 0, 1},  // Unrestricted
+		{1, 7, 0},  // Pruned by the previous request
+	} {
+		if bundles := pool.Bundles(tt.number, tt.timestamp); len(bundles) != tt.bundles {
+			t.Errorf("test %d: eligible bundles mismatch: have %d, want %d", i, len(bundles), tt.bundles)
+		}
+	}
+}
+
+// Tests that bundles are rejected if any transaction fails, and that the payment
+// to the coinbase is reported otherwise.
+func TestApplyBundle(t *testing.T) {
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+
+	key, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	statedb.AddBalance(sender, big.NewInt(params.VBGer))
+
+	var (
+		coinbase = common.Address{0xc0}
+		header   = &types.Header{Number: big.NewInt(1), GasLimit: 10000000, Difficulty: big.NewInt(1), Coinbase: coinbase}
+		gp       = new(GasPool).AddGas(header.GasLimit)
+		gasUsed  uint64
+	)
+	// Apply a bundle with a nonce gap on a copy and ensure it's rejected
+	failing := &Bundle{Txs: types.Transactions{transaction(0, 21000, key), transaction(2, 21000, key)}}
+	if _, err := ApplyBundle(params.TestChainConfig, nil, &coinbase, new(GasPool).AddGas(header.GasLimit), statedb.Copy(), header, failing, 0, new(uint64), vm.Config{}); !errors.Is(err, ErrNonceTooHigh) {
+		t.Fatalf("failing bundle error mismatch: have %v, want %v", err, ErrNonceTooHigh)
+	}
+	// Apply a valid bundle and ensure the coinbase payment is reported
+	valid := &Bundle{Txs: types.Transactions{transaction(0, 21000, key), transaction(1, 21000, key)}}
+	result, err := ApplyBundle(params.TestChainConfig, nil, &coinbase, gp, statedb, header, valid, 0, &gasUsed, vm.Config{})
+	if err != nil {
+		t.Fatalf("failed to apply bundle: %v", err)
+	}
+	if len(result.Receipts) != 2 || result.GasUsed != 42000 || gasUsed != 42000 {
+		t.Fatalf("bundle result mismatch: receipts %d, gas %d, used %d", len(result.Receipts), result.GasUsed, gasUsed)
+	}
+	if want := big.NewInt(42000); result.CoinbaseDiff.Cmp(want) != 0 || result.GasFees.Cmp(want) != 0 {
+		t.Fatalf("coinbase payment mismatch: diff %v, fees %v, want %v", result.CoinbaseDiff, result.GasFees, want)
+	}
+	if price := result.GasPrice(); price.Cmp(common.Big1) != 0 {
+		t.Fatalf("bundle gas price mismatch: have %v, want 1", price)
+	}
+}
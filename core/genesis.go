@@ -62,6 +62,7 @@ type Genesis struct {
 	Number     uint64      `json:"number"`
 	GasUsed    uint64      `json:"gasUsed"`
 	ParentHash common.Hash `json:"parentHash"`
+	BaseFee    *big.Int    `json:"baseFeePerGas"`
 }
 
 // GenesisAlloc specifies the initial state that is part of the genesis block.
@@ -97,6 +98,7 @@ type genesisSpecMarshaling struct {
 	GasUsed    math.HexOrDecimal64
 	Number     math.HexOrDecimal64
 	Difficulty *math.HexOrDecimal256
+	BaseFee    *math.HexOrDecimal256
 	Alloc      map[common.UnprefixedAddress]GenesisAccount
 }
 
@@ -231,6 +233,27 @@ func SetupGenesisBlock(db VBGdb.Database, genesis *Genesis) (*params.ChainConfig
 	return newcfg, stored, nil
 }
 
+// LoadChainConfig retrieves the chain configuration of an already initialized
+// database without modifying it, making it suitable for read-only databases. If
+// a genesis is supplied, it must match the one stored.
+func LoadChainConfig(db VBGdb.Database, genesis *Genesis) (*params.ChainConfig, common.Hash, error) {
+	stored := rawdb.ReadCanonicalHash(db, 0)
+	if (stored == common.Hash{}) {
+		return nil, common.Hash{}, ErrNoGenesis
+	}
+	if genesis != nil {
+		hash := genesis.ToBlock(nil).Hash()
+		if hash != stored {
+			return nil, hash, &GenesisMismatchError{stored, hash}
+		}
+	}
+	if storedcfg := rawdb.ReadChainConfig(db, stored); storedcfg != nil {
+		return storedcfg, stored, nil
+	}
+	log.Warn("Found genesis block without chain config")
+	return genesis.configOrDefault(stored), stored, nil
+}
+
 func (g *Genesis) configOrDefault(ghash common.Hash) *params.ChainConfig {
 	switch {
 	case g != nil:
@@ -285,6 +308,13 @@ func (g *Genesis) ToBlock(db VBGdb.Database) *types.Block {
 	if g.Difficulty == nil {
 		head.Difficulty = params.GenesisDifficulty
 	}
+	if g.Config != nil && g.Config.IsEIP1559(common.Big0) {
+		if g.BaseFee != nil {
+			head.BaseFee = g.BaseFee
+		} else {
+			head.BaseFee = new(big.Int).SetUint64(params.InitialBaseFee)
+		}
+	}
 	statedb.Commit(false)
 	statedb.Database().TrieDB().Commit(root, true, nil)
 
@@ -69,6 +69,7 @@ type HeaderChain struct {
 	numberCache *lru.Cache // Cache for the most recent block numbers
 
 	procInterrupt func() bool
+	finalized     func() *types.Header // Latest finalized header, reorgs reverting which are rejected (optional)
 
 	rand   *mrand.Rand
 	engine consensus.Engine
@@ -175,6 +176,10 @@ func (hc *HeaderChain) WriteHeader(header *types.Header) (status WriteStatus, er
 		}
 	}
 	if reorg {
+		// Refuse to revert any finalized header
+		if err := hc.checkFinalized(header); err != nil {
+			return NonStatTy, err
+		}
 		// If the header can be added into canonical chain, adjust the
 		// header chain markers(canonical indexes and head header flag).
 		//
@@ -224,6 +229,32 @@ func (hc *HeaderChain) WriteHeader(header *types.Header) (status WriteStatus, er
 	return
 }
 
+// checkFinalized ensures that making the given header canonical doesn't revert
+// the finalized header, that is the latter is one of its ancestors.
+func (hc *HeaderChain) checkFinalized(header *types.Header) error {
+	if hc.finalized == nil {
+		return nil
+	}
+	finalized := hc.finalized()
+	if finalized == nil {
+		return nil
+	}
+	var (
+		number   = header.Number.Uint64()
+		fnumber  = finalized.Number.Uint64()
+		ancestor common.Hash
+	)
+	if number > fnumber {
+		maxNonCanonical := uint64(math.MaxUint64)
+		ancestor, _ = hc.GetAncestor(header.Hash(), number, number-fnumber, &maxNonCanonical)
+	}
+	if ancestor != finalized.Hash() {
+		log.Warn("Rejected header reorg below finalized block", "number", number, "hash", header.Hash(), "finalized", fnumber)
+		return fmt.Errorf("%w: header #%d [%x…], finalized #%d", ErrFinalizedReorg, number, header.Hash().Bytes()[:4], fnumber)
+	}
+	return nil
+}
+
 // WhCallback is a callback function for inserting individual headers.
 // A callback is used for two reasons: first, in a LightChain, status should be
 // processed and light chain events sent, while in a BlockChain this is not
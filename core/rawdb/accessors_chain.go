@@ -187,6 +187,29 @@ func WriteHeadFastBlockHash(db VBGdb.KeyValueWriter, hash common.Hash) {
 	}
 }
 
+// ReadFinalizedBlockHash retrieves the hash of the latest finalized block.
+func ReadFinalizedBlockHash(db VBGdb.KeyValueReader) common.Hash {
+	data, _ := db.Get(headFinalizedBlockKey)
+	if len(data) == 0 {
+		return common.Hash{}
+	}
+	return common.BytesToHash(data)
+}
+
+// WriteFinalizedBlockHash stores the hash of the latest finalized block.
+func WriteFinalizedBlockHash(db VBGdb.KeyValueWriter, hash common.Hash) {
+	if err := db.Put(headFinalizedBlockKey, hash.Bytes()); err != nil {
+		log.Crit("Failed to store last finalized block's hash", "err", err)
+	}
+}
+
+// DeleteFinalizedBlockHash removes the finalized block marker.
+func DeleteFinalizedBlockHash(db VBGdb.KeyValueWriter) {
+	if err := db.Delete(headFinalizedBlockKey); err != nil {
+		log.Crit("Failed to remove last finalized block's hash", "err", err)
+	}
+}
+
 // ReadLastPivotNumber retrieves the number of the last pivot block. If the node
 // full synced, the last pivot will always be nil.
 func ReadLastPivotNumber(db VBGdb.KeyValueReader) *uint64 {
@@ -415,7 +438,10 @@ func WriteBodyRLP(db VBGdb.KeyValueWriter, hash common.Hash, number uint64, rlp
 // HasBody verifies the existence of a block body corresponding to the hash.
 func HasBody(db VBGdb.Reader, hash common.Hash, number uint64) bool {
 	if has, err := db.Ancient(freezerHashTable, number); err == nil && common.BytesToHash(has) == hash {
-		return true
+		// Frozen bodies might have been discarded along with old chain history
+		if has, _ := db.HasAncient(freezerBodiesTable, number); has {
+			return true
+		}
 	}
 	if has, err := db.Has(blockBodyKey(number, hash)); !has || err != nil {
 		return false
@@ -520,7 +546,10 @@ func DeleteTd(db VBGdb.KeyValueWriter, hash common.Hash, number uint64) {
 // to a block.
 func HasReceipts(db VBGdb.Reader, hash common.Hash, number uint64) bool {
 	if has, err := db.Ancient(freezerHashTable, number); err == nil && common.BytesToHash(has) == hash {
-		return true
+		// Frozen receipts might have been discarded along with old chain history
+		if has, _ := db.HasAncient(freezerReceiptTable, number); has {
+			return true
+		}
 	}
 	if has, err := db.Has(blockReceiptsKey(number, hash)); !has || err != nil {
 		return false
@@ -728,3 +757,16 @@ func FindCommonAncestor(db VBGdb.Reader, a, b *types.Header) *types.Header {
 	}
 	return a
 }
+
+// ReadHeadBlock returns the current canonical head block.
+func ReadHeadBlock(db VBGdb.Reader) *types.Block {
+	headBlockHash := ReadHeadBlockHash(db)
+	if headBlockHash == (common.Hash{}) {
+		return nil
+	}
+	headBlockNumber := ReadHeaderNumber(db, headBlockHash)
+	if headBlockNumber == nil {
+		return nil
+	}
+	return ReadBlock(db, headBlockHash, *headBlockNumber)
+}
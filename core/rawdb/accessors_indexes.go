@@ -18,6 +18,7 @@ package rawdb
 
 import (
 	"bytes"
+	"encoding/binary"
 	"math/big"
 
 	"github.com/vbgloble/go-VGB/common"
@@ -175,3 +176,53 @@ func DeleteBloombits(db VBGdb.Database, bit uint, from uint64, to uint64) {
 		log.Crit("Failed to delete bloom bits", "err", it.Error())
 	}
 }
+
+// ReadBlockTraces retrieves the encoded transaction traces belonging to a block.
+func ReadBlockTraces(db VBGdb.KeyValueReader, hash common.Hash, number uint64) []byte {
+	data, _ := db.Get(blockTracesKey(number, hash))
+	return data
+}
+
+// WriteBlockTraces stores the encoded transaction traces belonging to a block.
+func WriteBlockTraces(db VBGdb.KeyValueWriter, hash common.Hash, number uint64, traces []byte) {
+	if err := db.Put(blockTracesKey(number, hash), traces); err != nil {
+		log.Crit("Failed to store block traces", "err", err)
+	}
+}
+
+// DeleteBlockTraces removes all transaction traces belonging to a block.
+func DeleteBlockTraces(db VBGdb.KeyValueWriter, hash common.Hash, number uint64) {
+	if err := db.Delete(blockTracesKey(number, hash)); err != nil {
+		log.Crit("Failed to delete block traces", "err", err)
+	}
+}
+
+// WriteTraceAddress marks the given address as appearing in the traces of the
+// block with the given number.
+func WriteTraceAddress(db VBGdb.KeyValueWriter, address common.Address, number uint64) {
+	if err := db.Put(traceAddressKey(address, number), nil); err != nil {
+		log.Crit("Failed to store trace address index", "err", err)
+	}
+}
+
+// ReadTraceAddressBlocks retrieves the numbers of all the blocks in the given
+// inclusive range whose traces contain the specified address. The result may
+// contain blocks which have since been reorged out of the canonical chain.
+func ReadTraceAddressBlocks(db VBGdb.Iteratee, address common.Address, from uint64, to uint64) []uint64 {
+	prefix := append(append([]byte{}, traceAddressPrefix...), address.Bytes()...)
+	it := db.NewIterator(prefix, encodeBlockNumber(from))
+	defer it.Release()
+
+	var numbers []uint64
+	for it.Next() {
+		if len(it.Key()) != len(prefix)+8 {
+			continue
+		}
+		number := binary.BigEndian.Uint64(it.Key()[len(prefix):])
+		if number > to {
+			break
+		}
+		numbers = append(numbers, number)
+	}
+	return numbers
+}
@@ -52,6 +52,35 @@ func WriteDatabaseVersion(db VBGdb.KeyValueWriter, version uint64) {
 	}
 }
 
+// ReadDatabaseEngine retrieves the name of the key-value engine the database
+// was created with, or an empty string for databases predating the marker.
+func ReadDatabaseEngine(db VBGdb.KeyValueReader) string {
+	data, _ := db.Get(databaseEngineKey)
+	return string(data)
+}
+
+// WriteDatabaseEngine stores the name of the key-value engine backing the database.
+func WriteDatabaseEngine(db VBGdb.KeyValueWriter, engine string) {
+	if err := db.Put(databaseEngineKey, []byte(engine)); err != nil {
+		log.Crit("Failed to store the database engine", "err", err)
+	}
+}
+
+// HasSeparateStateStore reports whVBGer the database keeps its state key space
+// in a dedicated key-value store.
+func HasSeparateStateStore(db VBGdb.KeyValueReader) bool {
+	ok, _ := db.Has(stateStoreKey)
+	return ok
+}
+
+// writeSeparateStateStore marks the database as keeping its state key space in
+// a dedicated key-value store.
+func writeSeparateStateStore(db VBGdb.KeyValueWriter) {
+	if err := db.Put(stateStoreKey, []byte{1}); err != nil {
+		log.Crit("Failed to store the state store marker", "err", err)
+	}
+}
+
 // ReadChainConfig retrieves the consensus settings based on the given genesis hash.
 func ReadChainConfig(db VBGdb.KeyValueReader, hash common.Hash) *params.ChainConfig {
 	data, _ := db.Get(configKey(hash))
@@ -94,3 +94,51 @@ func DeleteTrieNode(db VBGdb.KeyValueWriter, hash common.Hash) {
 		log.Crit("Failed to delete trie node", "err", err)
 	}
 }
+
+// ReadPruningTarget retrieves the state root of the offline state pruning that
+// is in progress, if any.
+func ReadPruningTarget(db VBGdb.KeyValueReader) common.Hash {
+	data, _ := db.Get(pruningTargetKey)
+	if len(data) != common.HashLength {
+		return common.Hash{}
+	}
+	return common.BytesToHash(data)
+}
+
+// WritePruningTarget stores the state root of the offline state pruning that is
+// about to start deleting data.
+func WritePruningTarget(db VBGdb.KeyValueWriter, root common.Hash) {
+	if err := db.Put(pruningTargetKey, root.Bytes()); err != nil {
+		log.Crit("Failed to store pruning target", "err", err)
+	}
+}
+
+// DeletePruningTarget deletes the state root of the finished offline state pruning.
+func DeletePruningTarget(db VBGdb.KeyValueWriter) {
+	if err := db.Delete(pruningTargetKey); err != nil {
+		log.Crit("Failed to remove pruning target", "err", err)
+	}
+}
+
+// ReadPruningProgress retrieves the last database key swept by the offline state
+// pruning that is in progress.
+func ReadPruningProgress(db VBGdb.KeyValueReader) []byte {
+	data, _ := db.Get(pruningProgressKey)
+	return data
+}
+
+// WritePruningProgress stores the last database key swept by the offline state
+// pruning that is in progress.
+func WritePruningProgress(db VBGdb.KeyValueWriter, key []byte) {
+	if err := db.Put(pruningProgressKey, key); err != nil {
+		log.Crit("Failed to store pruning progress", "err", err)
+	}
+}
+
+// DeletePruningProgress deletes the progress marker of the finished offline
+// state pruning.
+func DeletePruningProgress(db VBGdb.KeyValueWriter) {
+	if err := db.Delete(pruningProgressKey); err != nil {
+		log.Crit("Failed to remove pruning progress", "err", err)
+	}
+}
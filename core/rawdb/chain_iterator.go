@@ -38,44 +38,53 @@ func InitDatabaseFromFreezer(db VBGdb.Database) {
 	if err != nil || frozen == 0 {
 		return
 	}
+	start := time.Now()
+	hash, err := indexFrozenHashes(db, 0, frozen)
+	if err != nil {
+		log.Crit("Failed to init database from freezer", "err", err)
+	}
+	WriteHeadHeaderHash(db, hash)
+	WriteHeadFastBlockHash(db, hash)
+	log.Info("Initialized database from freezer", "blocks", frozen, "elapsed", common.PrettyDuration(time.Since(start)))
+}
+
+// indexFrozenHashes injects the block hash->number mappings of the frozen blocks
+// in the range [from, to) into the database, returning the hash of the last one.
+func indexFrozenHashes(db VBGdb.Database, from uint64, to uint64) (common.Hash, error) {
 	var (
 		batch  = db.NewBatch()
 		start  = time.Now()
 		logged = start.Add(-7 * time.Second) // Unindex during import is fast, don't double log
 		hash   common.Hash
 	)
-	for i := uint64(0); i < frozen; i++ {
+	for i := from; i < to; i++ {
 		// Since the freezer has all data in sequential order on a file,
 		// it would be 'neat' to read more data in one go, and let the
 		// freezerdb return N items (e.g up to 1000 items per go)
 		// That would require an API change in Ancients though
-		if h, err := db.Ancient(freezerHashTable, i); err != nil {
-			log.Crit("Failed to init database from freezer", "err", err)
-		} else {
-			hash = common.BytesToHash(h)
+		h, err := db.Ancient(freezerHashTable, i)
+		if err != nil {
+			return common.Hash{}, err
 		}
+		hash = common.BytesToHash(h)
 		WriteHeaderNumber(batch, hash, i)
 		// If enough data was accumulated in memory or we're at the last block, dump to disk
 		if batch.ValueSize() > VBGdb.IdealBatchSize {
 			if err := batch.Write(); err != nil {
-				log.Crit("Failed to write data to db", "err", err)
+				return common.Hash{}, err
 			}
 			batch.Reset()
 		}
 		// If we've spent too much time already, notify the user of what we're doing
 		if time.Since(logged) > 8*time.Second {
-			log.Info("Initializing database from freezer", "total", frozen, "number", i, "hash", hash, "elapsed", common.PrettyDuration(time.Since(start)))
+			log.Info("Initializing database from freezer", "total", to-from, "number", i, "hash", hash, "elapsed", common.PrettyDuration(time.Since(start)))
 			logged = time.Now()
 		}
 	}
 	if err := batch.Write(); err != nil {
-		log.Crit("Failed to write data to db", "err", err)
+		return common.Hash{}, err
 	}
-	batch.Reset()
-
-	WriteHeadHeaderHash(db, hash)
-	WriteHeadFastBlockHash(db, hash)
-	log.Info("Initialized database from freezer", "blocks", frozen, "elapsed", common.PrettyDuration(time.Since(start)))
+	return hash, nil
 }
 
 type blockTxHashes struct {
@@ -190,6 +199,10 @@ func iterateTransactions(db VBGdb.Database, from uint64, to uint64, reverse bool
 // There is a passed channel, the whole procedure will be interrupted if any
 // signal received.
 func indexTransactions(db VBGdb.Database, from uint64, to uint64, interrupt chan struct{}, hook func(uint64) bool) {
+	// Bodies below the history tail are pruned, their transactions can't be indexed
+	if tail, err := db.Tail(); err == nil && from < tail {
+		from = tail
+	}
 	// short circuit for invalid range
 	if from >= to {
 		return
@@ -281,6 +294,11 @@ func indexTransactionsForTesting(db VBGdb.Database, from uint64, to uint64, inte
 // There is a passed channel, the whole procedure will be interrupted if any
 // signal received.
 func unindexTransactions(db VBGdb.Database, from uint64, to uint64, interrupt chan struct{}, hook func(uint64) bool) {
+	// Bodies below the history tail are pruned, their transactions can't be
+	// resolved any more and their lookups are retained
+	if tail, err := db.Tail(); err == nil && from < tail {
+		from = tail
+	}
 	// short circuit for invalid range
 	if from >= to {
 		return
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"sync/atomic"
 	"time"
 
@@ -95,6 +96,11 @@ func (db *nofreezedb) AncientSize(kind string) (uint64, error) {
 	return 0, errNotSupported
 }
 
+// Tail returns an error as we don't have a backing chain freezer.
+func (db *nofreezedb) Tail() (uint64, error) {
+	return 0, errNotSupported
+}
+
 // AppendAncient returns an error as we don't have a backing chain freezer.
 func (db *nofreezedb) AppendAncient(number uint64, hash, header, body, receipts, td []byte) error {
 	return errNotSupported
@@ -105,6 +111,11 @@ func (db *nofreezedb) TruncateAncients(items uint64) error {
 	return errNotSupported
 }
 
+// TruncateTail returns an error as we don't have a backing chain freezer.
+func (db *nofreezedb) TruncateTail(items uint64) error {
+	return errNotSupported
+}
+
 // Sync returns an error as we don't have a backing chain freezer.
 func (db *nofreezedb) Sync() error {
 	return errNotSupported
@@ -120,10 +131,10 @@ func NewDatabase(db VBGdb.KeyValueStore) VBGdb.Database {
 
 // NewDatabaseWithFreezer creates a high level database on top of a given key-
 // value data store with a freezer moving immutable chain segments into cold
-// storage.
-func NewDatabaseWithFreezer(db VBGdb.KeyValueStore, freezer string, namespace string) (VBGdb.Database, error) {
+// storage. A read-only freezer is neither repaired nor fed with new data.
+func NewDatabaseWithFreezer(db VBGdb.KeyValueStore, freezer string, namespace string, readonly bool) (VBGdb.Database, error) {
 	// Create the idle freezer instance
-	frdb, err := newFreezer(freezer, namespace)
+	frdb, err := newFreezer(freezer, namespace, readonly)
 	if err != nil {
 		return nil, err
 	}
@@ -192,7 +203,9 @@ func NewDatabaseWithFreezer(db VBGdb.KeyValueStore, freezer string, namespace st
 		}
 	}
 	// Freezer is consistent with the key-value database, permit combining the two
-	go frdb.freeze(db)
+	if !readonly {
+		go frdb.freeze(db)
+	}
 
 	return &freezerdb{
 		KeyValueStore: db,
@@ -213,24 +226,100 @@ func NewMemoryDatabaseWithCap(size int) VBGdb.Database {
 	return NewDatabase(memorydb.NewWithCap(size))
 }
 
-// NewLevelDBDatabase creates a persistent key-value database without a freezer
-// moving immutable chain segments into cold storage.
-func NewLevelDBDatabase(file string, cache int, handles int, namespace string) (VBGdb.Database, error) {
-	db, err := leveldb.New(file, cache, handles, namespace)
+const (
+	// DBLeveldb is the name of the LevelDB key-value engine.
+	DBLeveldb = "leveldb"
+
+	// DBPebble is the name of the Pebble key-value engine.
+	DBPebble = "pebble"
+)
+
+// PreexistingDatabase checks the given data directory whVBGer a database is
+// already present and returns the name of its engine, or an empty string if
+// no database was found. Both engines maintain a CURRENT file, but only pebble
+// writes OPTIONS files.
+func PreexistingDatabase(path string) string {
+	if _, err := os.Stat(filepath.Join(path, "CURRENT")); err != nil {
+		return ""
+	}
+	if matches, err := filepath.Glob(filepath.Join(path, "OPTIONS*")); err == nil && len(matches) > 0 {
+		return DBPebble
+	}
+	return DBLeveldb
+}
+
+// NewKeyValueDatabase opens a persistent key-value store backed by the given
+// engine. An empty engine reuses the one of the pre-existing database, falling
+// back to LevelDB for new ones. Opening a database with a different engine than
+// it was created with is rejected. Read-only databases must already exist, and
+// are locked like writable ones: they can't be opened while in use by another
+// process, such as a running node.
+func NewKeyValueDatabase(engine string, file string, cache int, handles int, namespace string, readonly bool) (VBGdb.KeyValueStore, error) {
+	existing := PreexistingDatabase(file)
+	if readonly && existing == "" {
+		return nil, fmt.Errorf("no database found in %s to open read-only", file)
+	}
+	if engine == "" {
+		engine = existing
+	}
+	if engine == "" {
+		engine = DBLeveldb
+	}
+	if existing != "" && existing != engine {
+		return nil, fmt.Errorf("db.engine choice was %s but found pre-existing %s database in %s", engine, existing, file)
+	}
+	var (
+		kvdb VBGdb.KeyValueStore
+		err  error
+	)
+	switch engine {
+	case DBLeveldb:
+		kvdb, err = leveldb.New(file, cache, handles, namespace, readonly)
+	case DBPebble:
+		kvdb, err = newPebbleDatabase(file, cache, handles, namespace, readonly)
+	default:
+		return nil, fmt.Errorf("unknown db.engine %s", engine)
+	}
+	if err != nil {
+		if readonly {
+			return nil, fmt.Errorf("failed to open %s read-only, ensure no other process is using it: %v", file, err)
+		}
+		return nil, err
+	}
+	// Cross check the engine marker, recording it for new or legacy databases
+	switch stored := ReadDatabaseEngine(kvdb); stored {
+	case "":
+		if !readonly {
+			WriteDatabaseEngine(kvdb, engine)
+		}
+	case engine:
+	default:
+		kvdb.Close()
+		return nil, fmt.Errorf("db.engine choice was %s but database was created with %s", engine, stored)
+	}
+	log.Info("Opened key-value database", "engine", engine, "path", file, "readonly", readonly)
+	return kvdb, nil
+}
+
+// NewDiskDatabase creates a persistent key-value database backed by the given
+// engine, without a freezer moving immutable chain segments into cold storage.
+func NewDiskDatabase(engine string, file string, cache int, handles int, namespace string, readonly bool) (VBGdb.Database, error) {
+	db, err := NewKeyValueDatabase(engine, file, cache, handles, namespace, readonly)
 	if err != nil {
 		return nil, err
 	}
 	return NewDatabase(db), nil
 }
 
-// NewLevelDBDatabaseWithFreezer creates a persistent key-value database with a
-// freezer moving immutable chain segments into cold storage.
-func NewLevelDBDatabaseWithFreezer(file string, cache int, handles int, freezer string, namespace string) (VBGdb.Database, error) {
-	kvdb, err := leveldb.New(file, cache, handles, namespace)
+// NewDiskDatabaseWithFreezer creates a persistent key-value database backed by
+// the given engine, with a freezer moving immutable chain segments into cold
+// storage.
+func NewDiskDatabaseWithFreezer(engine string, file string, cache int, handles int, freezer string, namespace string, readonly bool) (VBGdb.Database, error) {
+	kvdb, err := NewKeyValueDatabase(engine, file, cache, handles, namespace, readonly)
 	if err != nil {
 		return nil, err
 	}
-	frdb, err := NewDatabaseWithFreezer(kvdb, freezer, namespace)
+	frdb, err := NewDatabaseWithFreezer(kvdb, freezer, namespace, readonly)
 	if err != nil {
 		kvdb.Close()
 		return nil, err
@@ -238,6 +327,18 @@ func NewLevelDBDatabaseWithFreezer(file string, cache int, handles int, freezer
 	return frdb, nil
 }
 
+// NewLevelDBDatabase creates a persistent key-value database without a freezer
+// moving immutable chain segments into cold storage.
+func NewLevelDBDatabase(file string, cache int, handles int, namespace string, readonly bool) (VBGdb.Database, error) {
+	return NewDiskDatabase(DBLeveldb, file, cache, handles, namespace, readonly)
+}
+
+// NewLevelDBDatabaseWithFreezer creates a persistent key-value database with a
+// freezer moving immutable chain segments into cold storage.
+func NewLevelDBDatabaseWithFreezer(file string, cache int, handles int, freezer string, namespace string, readonly bool) (VBGdb.Database, error) {
+	return NewDiskDatabaseWithFreezer(DBLeveldb, file, cache, handles, freezer, namespace, readonly)
+}
+
 type counter uint64
 
 func (c counter) String() string {
@@ -268,8 +369,125 @@ func (s *stat) Count() string {
 	return s.count.String()
 }
 
+// keyValueStats stores the sizes and counts of the different categories of data
+// found within a key-value store.
+type keyValueStats struct {
+	headers         stat
+	bodies          stat
+	receipts        stat
+	tds             stat
+	numHashPairings stat
+	hashNumPairings stat
+	tries           stat
+	codes           stat
+	txLookups       stat
+	accountSnaps    stat
+	storageSnaps    stat
+	preimages       stat
+	bloomBits       stat
+	blockTraces     stat
+	traceAddresses  stat
+	cliqueSnaps     stat
+
+	// Les statistic
+	chtTrieNodes   stat
+	bloomTrieNodes stat
+
+	// Meta- and unaccounted data
+	metadata    stat
+	unaccounted stat
+
+	total common.StorageSize
+}
+
+// add accounts a database entry into the statistic of its category.
+func (s *keyValueStats) add(key []byte, size common.StorageSize) {
+	s.total += size
+	switch {
+	case bytes.HasPrefix(key, headerPrefix) && len(key) == (len(headerPrefix)+8+common.HashLength):
+		s.headers.Add(size)
+	case bytes.HasPrefix(key, blockBodyPrefix) && len(key) == (len(blockBodyPrefix)+8+common.HashLength):
+		s.bodies.Add(size)
+	case bytes.HasPrefix(key, blockReceiptsPrefix) && len(key) == (len(blockReceiptsPrefix)+8+common.HashLength):
+		s.receipts.Add(size)
+	case bytes.HasPrefix(key, headerPrefix) && bytes.HasSuffix(key, headerTDSuffix):
+		s.tds.Add(size)
+	case bytes.HasPrefix(key, headerPrefix) && bytes.HasSuffix(key, headerHashSuffix):
+		s.numHashPairings.Add(size)
+	case bytes.HasPrefix(key, headerNumberPrefix) && len(key) == (len(headerNumberPrefix)+common.HashLength):
+		s.hashNumPairings.Add(size)
+	case len(key) == common.HashLength:
+		s.tries.Add(size)
+	case bytes.HasPrefix(key, codePrefix) && len(key) == len(codePrefix)+common.HashLength:
+		s.codes.Add(size)
+	case bytes.HasPrefix(key, txLookupPrefix) && len(key) == (len(txLookupPrefix)+common.HashLength):
+		s.txLookups.Add(size)
+	case bytes.HasPrefix(key, SnapshotAccountPrefix) && len(key) == (len(SnapshotAccountPrefix)+common.HashLength):
+		s.accountSnaps.Add(size)
+	case bytes.HasPrefix(key, SnapshotStoragePrefix) && len(key) == (len(SnapshotStoragePrefix)+2*common.HashLength):
+		s.storageSnaps.Add(size)
+	case bytes.HasPrefix(key, preimagePrefix) && len(key) == (len(preimagePrefix)+common.HashLength):
+		s.preimages.Add(size)
+	case bytes.HasPrefix(key, bloomBitsPrefix) && len(key) == (len(bloomBitsPrefix)+10+common.HashLength):
+		s.bloomBits.Add(size)
+	case bytes.HasPrefix(key, blockTracesPrefix) && len(key) == (len(blockTracesPrefix)+8+common.HashLength):
+		s.blockTraces.Add(size)
+	case bytes.HasPrefix(key, traceAddressPrefix) && len(key) == (len(traceAddressPrefix)+common.AddressLength+8):
+		s.traceAddresses.Add(size)
+	case bytes.HasPrefix(key, []byte("clique-")) && len(key) == 7+common.HashLength:
+		s.cliqueSnaps.Add(size)
+	case bytes.HasPrefix(key, []byte("cht-")) && len(key) == 4+common.HashLength:
+		s.chtTrieNodes.Add(size)
+	case bytes.HasPrefix(key, []byte("blt-")) && len(key) == 4+common.HashLength:
+		s.bloomTrieNodes.Add(size)
+	default:
+		for _, meta := range [][]byte{databaseVerisionKey, databaseEngineKey, stateStoreKey, headHeaderKey, headBlockKey, headFastBlockKey, fastTrieProgressKey, pruningTargetKey, pruningProgressKey, snapshotRootKey, snapshotJournalKey, snapshotGeneratorKey, snapshotRecoveryKey, snapshotSyncStatusKey} {
+			if bytes.Equal(key, meta) {
+				s.metadata.Add(size)
+				return
+			}
+		}
+		s.unaccounted.Add(size)
+	}
+}
+
+// rows returns the table rows of the key-value statistics, labelled with the
+// given database name. If sparse is set, empty categories are omitted.
+func (s *keyValueStats) rows(database string, sparse bool) [][]string {
+	var rows [][]string
+	for _, entry := range []struct {
+		category string
+		stat     *stat
+	}{
+		{"Headers", &s.headers},
+		{"Bodies", &s.bodies},
+		{"Receipt lists", &s.receipts},
+		{"Difficulties", &s.tds},
+		{"Block number->hash", &s.numHashPairings},
+		{"Block hash->number", &s.hashNumPairings},
+		{"Transaction index", &s.txLookups},
+		{"Bloombit index", &s.bloomBits},
+		{"Block traces", &s.blockTraces},
+		{"Trace address index", &s.traceAddresses},
+		{"Contract codes", &s.codes},
+		{"Trie nodes", &s.tries},
+		{"Trie preimages", &s.preimages},
+		{"Account snapshot", &s.accountSnaps},
+		{"Storage snapshot", &s.storageSnaps},
+		{"Clique snapshots", &s.cliqueSnaps},
+		{"Singleton metadata", &s.metadata},
+	} {
+		if sparse && entry.stat.count == 0 {
+			continue
+		}
+		rows = append(rows, []string{database, entry.category, entry.stat.Size(), entry.stat.Count()})
+	}
+	return rows
+}
+
 // InspectDatabase traverses the entire database and checks the size
-// of all different categories of data.
+// of all different categories of data. Databases keeping their state in a
+// dedicated key-value store are reported per store.
 func InspectDatabase(db VBGdb.Database) error {
 	it := db.NewIterator(nil, nil)
 	defer it.Release()
@@ -280,20 +498,9 @@ func InspectDatabase(db VBGdb.Database) error {
 		logged = time.Now()
 
 		// Key-value store statistics
-		headers         stat
-		bodies          stat
-		receipts        stat
-		tds             stat
-		numHashPairings stat
-		hashNumPairings stat
-		tries           stat
-		codes           stat
-		txLookups       stat
-		accountSnaps    stat
-		storageSnaps    stat
-		preimages       stat
-		bloomBits       stat
-		cliqueSnaps     stat
+		chain keyValueStats
+		state keyValueStats
+		split = HasSeparateStateStore(db)
 
 		// Ancient store statistics
 		ancientHeadersSize  common.StorageSize
@@ -302,14 +509,6 @@ func InspectDatabase(db VBGdb.Database) error {
 		ancientTdsSize      common.StorageSize
 		ancientHashesSize   common.StorageSize
 
-		// Les statistic
-		chtTrieNodes   stat
-		bloomTrieNodes stat
-
-		// Meta- and unaccounted data
-		metadata    stat
-		unaccounted stat
-
 		// Totals
 		total common.StorageSize
 	)
@@ -319,52 +518,10 @@ func InspectDatabase(db VBGdb.Database) error {
 			key  = it.Key()
 			size = common.StorageSize(len(key) + len(it.Value()))
 		)
-		total += size
-		switch {
-		case bytes.HasPrefix(key, headerPrefix) && len(key) == (len(headerPrefix)+8+common.HashLength):
-			headers.Add(size)
-		case bytes.HasPrefix(key, blockBodyPrefix) && len(key) == (len(blockBodyPrefix)+8+common.HashLength):
-			bodies.Add(size)
-		case bytes.HasPrefix(key, blockReceiptsPrefix) && len(key) == (len(blockReceiptsPrefix)+8+common.HashLength):
-			receipts.Add(size)
-		case bytes.HasPrefix(key, headerPrefix) && bytes.HasSuffix(key, headerTDSuffix):
-			tds.Add(size)
-		case bytes.HasPrefix(key, headerPrefix) && bytes.HasSuffix(key, headerHashSuffix):
-			numHashPairings.Add(size)
-		case bytes.HasPrefix(key, headerNumberPrefix) && len(key) == (len(headerNumberPrefix)+common.HashLength):
-			hashNumPairings.Add(size)
-		case len(key) == common.HashLength:
-			tries.Add(size)
-		case bytes.HasPrefix(key, codePrefix) && len(key) == len(codePrefix)+common.HashLength:
-			codes.Add(size)
-		case bytes.HasPrefix(key, txLookupPrefix) && len(key) == (len(txLookupPrefix)+common.HashLength):
-			txLookups.Add(size)
-		case bytes.HasPrefix(key, SnapshotAccountPrefix) && len(key) == (len(SnapshotAccountPrefix)+common.HashLength):
-			accountSnaps.Add(size)
-		case bytes.HasPrefix(key, SnapshotStoragePrefix) && len(key) == (len(SnapshotStoragePrefix)+2*common.HashLength):
-			storageSnaps.Add(size)
-		case bytes.HasPrefix(key, preimagePrefix) && len(key) == (len(preimagePrefix)+common.HashLength):
-			preimages.Add(size)
-		case bytes.HasPrefix(key, bloomBitsPrefix) && len(key) == (len(bloomBitsPrefix)+10+common.HashLength):
-			bloomBits.Add(size)
-		case bytes.HasPrefix(key, []byte("clique-")) && len(key) == 7+common.HashLength:
-			cliqueSnaps.Add(size)
-		case bytes.HasPrefix(key, []byte("cht-")) && len(key) == 4+common.HashLength:
-			chtTrieNodes.Add(size)
-		case bytes.HasPrefix(key, []byte("blt-")) && len(key) == 4+common.HashLength:
-			bloomTrieNodes.Add(size)
-		default:
-			var accounted bool
-			for _, meta := range [][]byte{databaseVerisionKey, headHeaderKey, headBlockKey, headFastBlockKey, fastTrieProgressKey} {
-				if bytes.Equal(key, meta) {
-					metadata.Add(size)
-					accounted = true
-					break
-				}
-			}
-			if !accounted {
-				unaccounted.Add(size)
-			}
+		if split && isStateKey(key) {
+			state.add(key, size)
+		} else {
+			chain.add(key, size)
 		}
 		count++
 		if count%1000 == 0 && time.Since(logged) > 8*time.Second {
@@ -372,6 +529,8 @@ func InspectDatabase(db VBGdb.Database) error {
 			logged = time.Now()
 		}
 	}
+	total = chain.total + state.total
+
 	// Inspect append-only file store then.
 	ancientSizes := []*common.StorageSize{&ancientHeadersSize, &ancientBodiesSize, &ancientReceiptsSize, &ancientHashesSize, &ancientTdsSize}
 	for i, category := range []string{freezerHeaderTable, freezerBodiesTable, freezerReceiptTable, freezerHashTable, freezerDifficultyTable} {
@@ -386,39 +545,35 @@ func InspectDatabase(db VBGdb.Database) error {
 		ancients = counter(count)
 	}
 	// Display the database statistic.
-	stats := [][]string{
-		{"Key-Value store", "Headers", headers.Size(), headers.Count()},
-		{"Key-Value store", "Bodies", bodies.Size(), bodies.Count()},
-		{"Key-Value store", "Receipt lists", receipts.Size(), receipts.Count()},
-		{"Key-Value store", "Difficulties", tds.Size(), tds.Count()},
-		{"Key-Value store", "Block number->hash", numHashPairings.Size(), numHashPairings.Count()},
-		{"Key-Value store", "Block hash->number", hashNumPairings.Size(), hashNumPairings.Count()},
-		{"Key-Value store", "Transaction index", txLookups.Size(), txLookups.Count()},
-		{"Key-Value store", "Bloombit index", bloomBits.Size(), bloomBits.Count()},
-		{"Key-Value store", "Contract codes", codes.Size(), codes.Count()},
-		{"Key-Value store", "Trie nodes", tries.Size(), tries.Count()},
-		{"Key-Value store", "Trie preimages", preimages.Size(), preimages.Count()},
-		{"Key-Value store", "Account snapshot", accountSnaps.Size(), accountSnaps.Count()},
-		{"Key-Value store", "Storage snapshot", storageSnaps.Size(), storageSnaps.Count()},
-		{"Key-Value store", "Clique snapshots", cliqueSnaps.Size(), cliqueSnaps.Count()},
-		{"Key-Value store", "Singleton metadata", metadata.Size(), metadata.Count()},
+	var stats [][]string
+	if split {
+		stats = append(stats, chain.rows("Chain store", true)...)
+		stats = append(stats, []string{"Chain store", "Subtotal", chain.total.String(), ""})
+		stats = append(stats, state.rows("State store", true)...)
+		stats = append(stats, []string{"State store", "Subtotal", state.total.String(), ""})
+	} else {
+		stats = append(stats, chain.rows("Key-Value store", false)...)
+	}
+	stats = append(stats, [][]string{
 		{"Ancient store", "Headers", ancientHeadersSize.String(), ancients.String()},
 		{"Ancient store", "Bodies", ancientBodiesSize.String(), ancients.String()},
 		{"Ancient store", "Receipt lists", ancientReceiptsSize.String(), ancients.String()},
 		{"Ancient store", "Difficulties", ancientTdsSize.String(), ancients.String()},
 		{"Ancient store", "Block number->hash", ancientHashesSize.String(), ancients.String()},
-		{"Light client", "CHT trie nodes", chtTrieNodes.Size(), chtTrieNodes.Count()},
-		{"Light client", "Bloom trie nodes", bloomTrieNodes.Size(), bloomTrieNodes.Count()},
-	}
+		{"Light client", "CHT trie nodes", chain.chtTrieNodes.Size(), chain.chtTrieNodes.Count()},
+		{"Light client", "Bloom trie nodes", chain.bloomTrieNodes.Size(), chain.bloomTrieNodes.Count()},
+	}...)
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SVBGeader([]string{"Database", "Category", "Size", "Items"})
 	table.SetFooter([]string{"", "Total", total.String(), " "})
 	table.AppendBulk(stats)
 	table.Render()
 
-	if unaccounted.size > 0 {
-		log.Error("Database contains unaccounted data", "size", unaccounted.size, "count", unaccounted.count)
+	if chain.unaccounted.size > 0 {
+		log.Error("Database contains unaccounted data", "size", chain.unaccounted.size, "count", chain.unaccounted.count)
+	}
+	if state.unaccounted.size > 0 {
+		log.Error("State store contains unaccounted data", "size", state.unaccounted.size, "count", state.unaccounted.count)
 	}
-
 	return nil
 }
@@ -0,0 +1,30 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+// +build arm64 amd64
+// +build !openbsd
+
+package rawdb
+
+import (
+	"github.com/vbgloble/go-VGB/VBGdb"
+	"github.com/vbgloble/go-VGB/VBGdb/pebble"
+)
+
+// newPebbleDatabase opens a persistent key-value store backed by pebble.
+func newPebbleDatabase(file string, cache int, handles int, namespace string, readonly bool) (VBGdb.KeyValueStore, error) {
+	return pebble.New(file, cache, handles, namespace, readonly)
+}
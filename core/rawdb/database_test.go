@@ -0,0 +1,98 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package rawdb
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// Tests that the key-value engine of a database is detected and recorded, and
+// that reopening it with a different engine is rejected.
+func TestDatabaseEngineMismatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, engine := range []string{DBLeveldb, DBPebble} {
+		path := filepath.Join(dir, engine)
+		if have := PreexistingDatabase(path); have != "" {
+			t.Fatalf("%s: unexpected pre-existing database: %s", engine, have)
+		}
+		db, err := NewKeyValueDatabase(engine, path, 0, 0, "", false)
+		if err != nil {
+			t.Fatalf("%s: failed to create database: %v", engine, err)
+		}
+		if have := ReadDatabaseEngine(db); have != engine {
+			t.Errorf("%s: recorded engine mismatch: have %s, want %s", engine, have, engine)
+		}
+		db.Close()
+
+		if have := PreexistingDatabase(path); have != engine {
+			t.Errorf("%s: detected engine mismatch: have %s, want %s", engine, have, engine)
+		}
+		other := DBPebble
+		if engine == DBPebble {
+			other = DBLeveldb
+		}
+		if _, err := NewKeyValueDatabase(other, path, 0, 0, "", false); err == nil {
+			t.Errorf("%s: reopened database with %s engine", engine, other)
+		}
+		// An unspecified engine must reuse the existing one
+		db, err = NewKeyValueDatabase("", path, 0, 0, "", false)
+		if err != nil {
+			t.Fatalf("%s: failed to reopen database: %v", engine, err)
+		}
+		db.Close()
+	}
+}
+
+// Tests that a database, along with its freezer, can't be opened read-only while
+// it is open by a writer, only once the writer closes it.
+func TestReadOnlyDatabaseLocked(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	var (
+		path    = filepath.Join(dir, "chaindata")
+		freezer = filepath.Join(path, "ancient")
+	)
+	db, err := NewLevelDBDatabaseWithFreezer(path, 0, 0, freezer, "", false)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	if _, err := NewLevelDBDatabaseWithFreezer(path, 0, 0, freezer, "", true); err == nil {
+		t.Fatalf("opened database read-only while in use")
+	}
+	if _, err := newFreezer(freezer, "", true); err == nil {
+		t.Fatalf("opened freezer read-only while in use")
+	}
+	db.Close()
+
+	db, err = NewLevelDBDatabaseWithFreezer(path, 0, 0, freezer, "", true)
+	if err != nil {
+		t.Fatalf("failed to open database read-only: %v", err)
+	}
+	db.Close()
+}
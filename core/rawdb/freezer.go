@@ -46,6 +46,10 @@ var (
 	// errSymlinkDatadir is returned if the ancient directory specified by user
 	// is a symbolic link.
 	errSymlinkDatadir = errors.New("symbolic link datadir is not supported")
+
+	// errReadOnly is returned if the user attempts to modify a freezer opened in
+	// read-only mode.
+	errReadOnly = errors.New("read only")
 )
 
 const (
@@ -72,6 +76,7 @@ type freezer struct {
 	// so take advantage of that (https://golang.org/pkg/sync/atomic/#pkg-note-BUG).
 	frozen    uint64 // Number of blocks already frozen
 	threshold uint64 // Number of recent blocks not to freeze (params.FullImmutabilityThreshold apart from tests)
+	readonly  bool   // WhVBGer the freezer rejects all modifications
 
 	tables       map[string]*freezerTable // Data tables for storing everything
 	instanceLock fileutil.Releaser        // File-system lock to prevent double opens
@@ -83,8 +88,9 @@ type freezer struct {
 }
 
 // newFreezer creates a chain freezer that moves ancient chain data into
-// append-only flat file containers.
-func newFreezer(datadir string, namespace string) (*freezer, error) {
+// append-only flat file containers. A read-only freezer never repairs or
+// truncates its tables, and refuses to create missing ones.
+func newFreezer(datadir string, namespace string, readonly bool) (*freezer, error) {
 	// Create the initial freezer object
 	var (
 		readMeter  = metrics.NewRegisteredMeter(namespace+"ancient/read", nil)
@@ -97,23 +103,31 @@ func newFreezer(datadir string, namespace string) (*freezer, error) {
 			log.Warn("Symbolic link ancient database is not supported", "path", datadir)
 			return nil, errSymlinkDatadir
 		}
+	} else if readonly {
+		return nil, fmt.Errorf("no ancient database found in %s to open read-only", datadir)
 	}
 	// Leveldb uses LOCK as the filelock filename. To prevent the
 	// name collision, we use FLOCK as the lock name.
+	// The lock is taken in read-only mode too, so the ancients can't be opened
+	// while in use by another process.
 	lock, _, err := fileutil.Flock(filepath.Join(datadir, "FLOCK"))
 	if err != nil {
+		if readonly {
+			return nil, fmt.Errorf("failed to open %s read-only, ensure no other process is using it: %v", datadir, err)
+		}
 		return nil, err
 	}
 	// Open all the supported data tables
 	freezer := &freezer{
 		threshold:    params.FullImmutabilityThreshold,
+		readonly:     readonly,
 		tables:       make(map[string]*freezerTable),
 		instanceLock: lock,
 		trigger:      make(chan chan struct{}),
 		quit:         make(chan struct{}),
 	}
-	for name, disableSnappy := range freezerNoSnappy {
-		table, err := newTable(datadir, name, readMeter, writeMeter, sizeGauge, disableSnappy)
+	for name, disableSnappy := range FreezerNoSnappy {
+		table, err := newTable(datadir, name, readMeter, writeMeter, sizeGauge, disableSnappy, readonly)
 		if err != nil {
 			for _, table := range freezer.tables {
 				table.Close()
@@ -123,14 +137,17 @@ func newFreezer(datadir string, namespace string) (*freezer, error) {
 		}
 		freezer.tables[name] = table
 	}
-	if err := freezer.repair(); err != nil {
+	if readonly {
+		// In read-only mode only align the exposed items, don't truncate
+		freezer.validate()
+	} else if err := freezer.repair(); err != nil {
 		for _, table := range freezer.tables {
 			table.Close()
 		}
 		lock.Release()
 		return nil, err
 	}
-	log.Info("Opened ancient database", "database", datadir)
+	log.Info("Opened ancient database", "database", datadir, "readonly", readonly)
 	return freezer, nil
 }
 
@@ -138,7 +155,9 @@ func newFreezer(datadir string, namespace string) (*freezer, error) {
 func (f *freezer) Close() error {
 	var errs []error
 	f.closeOnce.Do(func() {
-		f.quit <- struct{}{}
+		if !f.readonly {
+			f.quit <- struct{}{}
+		}
 		for _, table := range f.tables {
 			if err := table.Close(); err != nil {
 				errs = append(errs, err)
@@ -158,6 +177,9 @@ func (f *freezer) Close() error {
 // in the freezer.
 func (f *freezer) HasAncient(kind string, number uint64) (bool, error) {
 	if table := f.tables[kind]; table != nil {
+		if table.pruned(number) {
+			return false, errPruned
+		}
 		return table.has(number), nil
 	}
 	return false, nil
@@ -184,6 +206,18 @@ func (f *freezer) AncientSize(kind string) (uint64, error) {
 	return 0, errUnknownTable
 }
 
+// Tail returns the number of the first block whose body and receipts are still
+// retained by the freezer.
+func (f *freezer) Tail() (uint64, error) {
+	var tail uint64
+	for kind := range freezerPrunable {
+		if n := atomic.LoadUint64(&f.tables[kind].tail); n > tail {
+			tail = n
+		}
+	}
+	return tail, nil
+}
+
 // AppendAncient injects all binary blobs belong to block at the end of the
 // append-only immutable table files.
 //
@@ -191,6 +225,9 @@ func (f *freezer) AncientSize(kind string) (uint64, error) {
 // injection will be rejected. But if two injections with same number happen at
 // the same time, we can get into the trouble.
 func (f *freezer) AppendAncient(number uint64, hash, header, body, receipts, td []byte) (err error) {
+	if f.readonly {
+		return errReadOnly
+	}
 	// Ensure the binary blobs we are appending is continuous with freezer.
 	if atomic.LoadUint64(&f.frozen) != number {
 		return errOutOrderInsertion
@@ -233,6 +270,9 @@ func (f *freezer) AppendAncient(number uint64, hash, header, body, receipts, td
 
 // TruncateAncients discards any recent data above the provided threshold number.
 func (f *freezer) TruncateAncients(items uint64) error {
+	if f.readonly {
+		return errReadOnly
+	}
 	if atomic.LoadUint64(&f.frozen) <= items {
 		return nil
 	}
@@ -245,8 +285,28 @@ func (f *freezer) TruncateAncients(items uint64) error {
 	return nil
 }
 
+// TruncateTail discards the bodies and receipts of all blocks below the provided
+// threshold number. Hashes, headers and difficulties are retained.
+func (f *freezer) TruncateTail(tail uint64) error {
+	if f.readonly {
+		return errReadOnly
+	}
+	if atomic.LoadUint64(&f.frozen) < tail {
+		return errOutOfBounds
+	}
+	for kind := range freezerPrunable {
+		if err := f.tables[kind].truncateTail(tail); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Sync flushes all data tables to disk.
 func (f *freezer) Sync() error {
+	if f.readonly {
+		return nil
+	}
 	var errs []error
 	for _, table := range f.tables {
 		if err := table.Sync(); err != nil {
@@ -453,20 +513,55 @@ func (f *freezer) freeze(db VBGdb.KeyValueStore) {
 	}
 }
 
-// repair truncates all data tables to the same length.
+// repair truncates all data tables to the same length, and discards the same
+// tail from all prunable ones.
 func (f *freezer) repair() error {
-	min := uint64(math.MaxUint64)
-	for _, table := range f.tables {
-		items := atomic.LoadUint64(&table.items)
-		if min > items {
-			min = items
-		}
-	}
+	min := f.shortest()
 	for _, table := range f.tables {
 		if err := table.truncate(min); err != nil {
 			return err
 		}
 	}
 	atomic.StoreUint64(&f.frozen, min)
+
+	// Align the tails of the prunable tables, a crash might have interrupted
+	// a previous tail truncation midway
+	tail, _ := f.Tail()
+	for kind := range freezerPrunable {
+		if err := f.tables[kind].truncateTail(tail); err != nil {
+			return err
+		}
+	}
 	return nil
 }
+
+// validate exposes the items present in all data tables, without touching any
+// excess items a concurrent writer might have left behind. It is used instead
+// of repair in read-only mode.
+func (f *freezer) validate() {
+	atomic.StoreUint64(&f.frozen, f.shortest())
+}
+
+// shortest returns the number of items in the shortest data table, warning if
+// the tables disagree, as items past it are either discarded or hidden.
+func (f *freezer) shortest() uint64 {
+	var (
+		min    = uint64(math.MaxUint64)
+		max    uint64
+		counts []interface{}
+	)
+	for name, table := range f.tables {
+		items := atomic.LoadUint64(&table.items)
+		if min > items {
+			min = items
+		}
+		if max < items {
+			max = items
+		}
+		counts = append(counts, name, items)
+	}
+	if min != max {
+		log.Warn("Freezer tables out of sync, aligning to shortest", append([]interface{}{"items", min}, counts...)...)
+	}
+	return min
+}
@@ -0,0 +1,200 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package rawdb
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/VBGdb"
+	"github.com/vbgloble/go-VGB/log"
+)
+
+// FreezerCorruption is a range of consecutive items of an ancient table which
+// failed verification.
+type FreezerCorruption struct {
+	Table string // Name of the ancient table
+	First uint64 // Number of the first corrupted item
+	Last  uint64 // Number of the last corrupted item
+	Err   error  // Verification error of the first corrupted item
+}
+
+// CheckFreezer retrieves every item of every ancient table, verifying it against
+// its checksum and decompressing it, and returns the ranges of corrupted items.
+// Items discarded from the tail of the prunable tables are skipped.
+func CheckFreezer(db VBGdb.AncientReader) ([]FreezerCorruption, error) {
+	frozen, err := db.Ancients()
+	if err != nil {
+		return nil, err
+	}
+	tail, err := db.Tail()
+	if err != nil {
+		return nil, err
+	}
+	tables := make([]string, 0, len(FreezerNoSnappy))
+	for table := range FreezerNoSnappy {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+
+	var (
+		corruptions []FreezerCorruption
+		start       = time.Now()
+		logged      = time.Now()
+	)
+	for _, table := range tables {
+		var first uint64
+		if freezerPrunable[table] {
+			first = tail
+		}
+		corrupted := false // WhVBGer the previous item was corrupted too
+		for number := first; number < frozen; number++ {
+			if time.Since(logged) > 8*time.Second {
+				log.Info("Verifying ancient store", "table", table, "number", number, "frozen", frozen, "corruptions", len(corruptions), "elapsed", common.PrettyDuration(time.Since(start)))
+				logged = time.Now()
+			}
+			if _, err := db.Ancient(table, number); err != nil {
+				if corrupted {
+					corruptions[len(corruptions)-1].Last = number
+				} else {
+					corruptions = append(corruptions, FreezerCorruption{Table: table, First: number, Last: number, Err: err})
+				}
+				corrupted = true
+				continue
+			}
+			corrupted = false
+		}
+	}
+	log.Info("Verified ancient store", "frozen", frozen, "tail", tail, "corruptions", len(corruptions), "elapsed", common.PrettyDuration(time.Since(start)))
+	return corruptions, nil
+}
+
+// RebuildFromFreezer reinjects the block hash->number mappings of the frozen
+// blocks in the range [from, to) into the key-value store. Any head marker which
+// is missing or points to an unknown block is reset to the last rebuilt block,
+// the head block marker to the genesis.
+func RebuildFromFreezer(db VBGdb.Database, from uint64, to uint64) error {
+	frozen, err := db.Ancients()
+	if err != nil {
+		return err
+	}
+	if from >= to || to > frozen {
+		return fmt.Errorf("invalid rebuild range [%d, %d) of %d frozen blocks", from, to, frozen)
+	}
+	start := time.Now()
+	hash, err := indexFrozenHashes(db, from, to)
+	if err != nil {
+		return err
+	}
+	known := func(hash common.Hash) bool {
+		return hash != (common.Hash{}) && ReadHeaderNumber(db, hash) != nil
+	}
+	if !known(ReadHeadHeaderHash(db)) {
+		WriteHeadHeaderHash(db, hash)
+	}
+	if !known(ReadHeadFastBlockHash(db)) {
+		WriteHeadFastBlockHash(db, hash)
+	}
+	if !known(ReadHeadBlockHash(db)) {
+		genesis := ReadCanonicalHash(db, 0)
+		if genesis == (common.Hash{}) {
+			return errors.New("missing genesis block")
+		}
+		WriteHeaderNumber(db, genesis, 0)
+		WriteHeadBlockHash(db, genesis)
+	}
+	log.Info("Rebuilt database from freezer", "from", from, "to", to, "elapsed", common.PrettyDuration(time.Since(start)))
+	return nil
+}
+
+// TruncateFreezer discards all frozen blocks from the given number onwards, along
+// with every block in the key-value store building on top of them. Head markers
+// beyond the last retained block are rewound to it.
+func TruncateFreezer(db VBGdb.Database, items uint64) error {
+	frozen, err := db.Ancients()
+	if err != nil {
+		return err
+	}
+	if items == 0 {
+		return errors.New("can't discard the genesis block")
+	}
+	if items >= frozen {
+		return nil
+	}
+	// Rewind the head markers first, so they never point to discarded blocks
+	hash := ReadCanonicalHash(db, items-1)
+	if hash == (common.Hash{}) {
+		return fmt.Errorf("missing canonical hash of block %d", items-1)
+	}
+	WriteHeaderNumber(db, hash, items-1)
+
+	rewound := func(head common.Hash) bool {
+		number := ReadHeaderNumber(db, head)
+		return number == nil || *number >= items
+	}
+	if rewound(ReadHeadHeaderHash(db)) {
+		WriteHeadHeaderHash(db, hash)
+	}
+	if rewound(ReadHeadFastBlockHash(db)) {
+		WriteHeadFastBlockHash(db, hash)
+	}
+	if rewound(ReadHeadBlockHash(db)) {
+		WriteHeadBlockHash(db, hash)
+	}
+	// Delete all blocks from the key-value store above the freezer, along with
+	// the hash->number mappings of the discarded frozen ones
+	batch := db.NewBatch()
+	for number := frozen; ; number++ {
+		hashes := ReadAllHashes(db, number)
+		if len(hashes) == 0 {
+			break
+		}
+		for _, hash := range hashes {
+			DeleteBlock(batch, hash, number)
+		}
+		DeleteCanonicalHash(batch, number)
+		if batch.ValueSize() > VBGdb.IdealBatchSize {
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			batch.Reset()
+		}
+	}
+	for number := items; number < frozen; number++ {
+		// The hash itself might be corrupted, ignore it then
+		if blob, err := db.Ancient(freezerHashTable, number); err == nil && len(blob) == common.HashLength {
+			DeleteHeaderNumber(batch, common.BytesToHash(blob))
+		}
+		if batch.ValueSize() > VBGdb.IdealBatchSize {
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			batch.Reset()
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	if err := db.TruncateAncients(items); err != nil {
+		return err
+	}
+	log.Info("Truncated ancient store", "items", items, "discarded", frozen-items, "head", hash)
+	return nil
+}
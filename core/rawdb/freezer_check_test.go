@@ -0,0 +1,130 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package rawdb
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/core/types"
+)
+
+// Tests that corrupted frozen items are detected, and that the freezer can be
+// truncated to the last healthy block and the key-value store rebuilt from it.
+func TestFreezerCheckAndRepair(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	var (
+		kvdir  = filepath.Join(dir, "chaindata")
+		frdir  = filepath.Join(dir, "ancient")
+		hashes []common.Hash
+	)
+	db, err := NewLevelDBDatabaseWithFreezer(kvdir, 16, 16, frdir, "", false)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	// Freeze a short chain, keeping the head markers at its last block
+	var parent common.Hash
+	for i := 0; i < 10; i++ {
+		block := types.NewBlockWithHeader(&types.Header{
+			Number:      big.NewInt(int64(i)),
+			ParentHash:  parent,
+			Extra:       []byte("test block"),
+			UncleHash:   types.EmptyUncleHash,
+			TxHash:      types.EmptyRootHash,
+			ReceiptHash: types.EmptyRootHash,
+		})
+		WriteAncientBlock(db, block, nil, big.NewInt(int64(i+1)))
+		WriteHeaderNumber(db, block.Hash(), block.NumberU64())
+		parent = block.Hash()
+		hashes = append(hashes, parent)
+	}
+	WriteHeadHeaderHash(db, parent)
+	WriteHeadFastBlockHash(db, parent)
+	WriteHeadBlockHash(db, parent)
+
+	if corruptions, err := CheckFreezer(db); err != nil || len(corruptions) != 0 {
+		t.Fatalf("healthy freezer reported corrupted: %v, %v", corruptions, err)
+	}
+	db.Close()
+
+	// Corrupt the hash of block 6 and ensure it's detected
+	file, err := os.OpenFile(filepath.Join(frdir, "hashes.0000.rdat"), os.O_RDWR, 0644)
+	if err != nil {
+		t.Fatalf("failed to open hashes table: %v", err)
+	}
+	file.WriteAt([]byte{0xff}, 6*common.HashLength)
+	file.Close()
+
+	db, err = NewLevelDBDatabaseWithFreezer(kvdir, 16, 16, frdir, "", false)
+	if err != nil {
+		t.Fatalf("failed to reopen database: %v", err)
+	}
+	defer db.Close()
+
+	corruptions, err := CheckFreezer(db)
+	if err != nil {
+		t.Fatalf("failed to check freezer: %v", err)
+	}
+	if len(corruptions) != 1 {
+		t.Fatalf("corrupted range count mismatch: have %d, want %d", len(corruptions), 1)
+	}
+	if c := corruptions[0]; c.Table != freezerHashTable || c.First != 6 || c.Last != 6 || c.Err != errChecksumMismatch {
+		t.Fatalf("corrupted range mismatch: have %v", c)
+	}
+	// Truncate to the last healthy block and ensure everything is rewound
+	if err := TruncateFreezer(db, 6); err != nil {
+		t.Fatalf("failed to truncate freezer: %v", err)
+	}
+	if frozen, _ := db.Ancients(); frozen != 6 {
+		t.Fatalf("frozen items mismatch: have %d, want %d", frozen, 6)
+	}
+	for _, head := range []common.Hash{ReadHeadHeaderHash(db), ReadHeadFastBlockHash(db), ReadHeadBlockHash(db)} {
+		if head != hashes[5] {
+			t.Fatalf("head marker mismatch: have %x, want %x", head, hashes[5])
+		}
+	}
+	if number := ReadHeaderNumber(db, hashes[7]); number != nil {
+		t.Fatalf("discarded block still indexed: %d", *number)
+	}
+	// Drop the key-value indices and ensure they are rebuilt
+	for _, hash := range hashes {
+		DeleteHeaderNumber(db, hash)
+	}
+	if err := RebuildFromFreezer(db, 0, 6); err != nil {
+		t.Fatalf("failed to rebuild database: %v", err)
+	}
+	for i, hash := range hashes[:6] {
+		if number := ReadHeaderNumber(db, hash); number == nil || *number != uint64(i) {
+			t.Fatalf("block %d not reindexed", i)
+		}
+	}
+	if head := ReadHeadHeaderHash(db); head != hashes[5] {
+		t.Fatalf("head header mismatch: have %x, want %x", head, hashes[5])
+	}
+	if corruptions, err := CheckFreezer(db); err != nil || len(corruptions) != 0 {
+		t.Fatalf("repaired freezer reported corrupted: %v, %v", corruptions, err)
+	}
+}
@@ -17,12 +17,15 @@
 package rawdb
 
 import (
+	"bytes"
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"hash/crc32"
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"sync"
 	"sync/atomic"
 
@@ -43,6 +46,14 @@ var (
 
 	// errNotSupported is returned if the database doesn't support the required operation.
 	errNotSupported = errors.New("this operation is not supported")
+
+	// errPruned is returned if the item requested was once contained within the
+	// freezer table, but has since been discarded from its tail.
+	errPruned = errors.New("item pruned from ancient store")
+
+	// errChecksumMismatch is returned if the data of an item retrieved from the
+	// freezer table doesn't match the checksum recorded when it was appended.
+	errChecksumMismatch = errors.New("checksum mismatch")
 )
 
 // indexEntry contains the number/id of the file that the data resides in, aswell as the
@@ -55,6 +66,33 @@ type indexEntry struct {
 
 const indexEntrySize = 6
 
+const (
+	// checksumHeaderSize is the size of the checksum file header, holding the
+	// number of the first item covered by the file.
+	checksumHeaderSize = 8
+
+	// checksumSize is the size of a single item checksum (CRC32-C of the stored,
+	// possibly compressed, item data).
+	checksumSize = 4
+)
+
+// checksumTable is the polynomial table used to checksum freezer items.
+var checksumTable = crc32.MakeTable(crc32.Castagnoli)
+
+// itemChecksum calculates the serialized checksum of a stored item.
+func itemChecksum(blob []byte) []byte {
+	b := make([]byte, checksumSize)
+	binary.BigEndian.PutUint32(b, crc32.Checksum(blob, checksumTable))
+	return b
+}
+
+// checksumHeader serializes the checksum file header for the given first item.
+func checksumHeader(first uint64) []byte {
+	b := make([]byte, checksumHeaderSize)
+	binary.BigEndian.PutUint64(b, first)
+	return b
+}
+
 // unmarshallBinary deserializes binary b into the rawIndex entry.
 func (i *indexEntry) unmarshalBinary(b []byte) error {
 	i.filenum = uint32(binary.BigEndian.Uint16(b[:2]))
@@ -77,9 +115,13 @@ type freezerTable struct {
 	// WARNING: The `items` field is accessed atomically. On 32 bit platforms, only
 	// 64-bit aligned fields can be atomic. The struct is guaranteed to be so aligned,
 	// so take advantage of that (https://golang.org/pkg/sync/atomic/#pkg-note-BUG).
-	items uint64 // Number of items stored in the table (including items removed from tail)
+	items     uint64 // Number of items stored in the table (including items removed from tail)
+	tail      uint64 // Number of the first item not yet discarded from the tail
+	checksums uint64 // Number of items (including items removed from tail) covered by checksums
+	sumTail   uint64 // Number of the first item covered by the checksum file
 
 	noCompression bool   // if true, disables snappy compression. Note: does not work retroactively
+	readonly      bool   // if true, the table files are neither repaired nor modified
 	maxFileSize   uint32 // Max file size for data-files
 	name          string
 	path          string
@@ -89,6 +131,8 @@ type freezerTable struct {
 	headId uint32              // number of the currently active head file
 	tailId uint32              // number of the earliest file
 	index  *os.File            // File descriptor for the indexEntry file of the table
+	meta   *os.File            // File descriptor for the persisted tail marker of the table
+	sums   *os.File            // File descriptor for the item checksums of the table
 
 	// In the case that old items are deleted (from the tail), we use itemOffset
 	// to count how many historic items have gone missing.
@@ -104,8 +148,8 @@ type freezerTable struct {
 }
 
 // newTable opens a freezer table with default settings - 2G files
-func newTable(path string, name string, readMeter metrics.Meter, writeMeter metrics.Meter, sizeGauge metrics.Gauge, disableSnappy bool) (*freezerTable, error) {
-	return newCustomTable(path, name, readMeter, writeMeter, sizeGauge, 2*1000*1000*1000, disableSnappy)
+func newTable(path string, name string, readMeter metrics.Meter, writeMeter metrics.Meter, sizeGauge metrics.Gauge, disableSnappy bool, readonly bool) (*freezerTable, error) {
+	return newCustomTable(path, name, readMeter, writeMeter, sizeGauge, 2*1000*1000*1000, disableSnappy, readonly)
 }
 
 // openFreezerFileForAppend opens a freezer table file and seeks to the end
@@ -146,29 +190,55 @@ func truncateFreezerFile(file *os.File, size int64) error {
 	return nil
 }
 
-// newCustomTable opens a freezer table, creating the data and index files if they are
-// non existent. Both files are truncated to the shortest common length to ensure
-// they don't go out of sync.
-func newCustomTable(path string, name string, readMeter metrics.Meter, writeMeter metrics.Meter, sizeGauge metrics.Gauge, maxFilesize uint32, noCompression bool) (*freezerTable, error) {
+// newCustomTable opens a freezer table, creating the data, index and checksum files
+// if they are non existent. The files are truncated to the shortest common length to
+// ensure they don't go out of sync. Read-only tables must exist and are never truncated.
+func newCustomTable(path string, name string, readMeter metrics.Meter, writeMeter metrics.Meter, sizeGauge metrics.Gauge, maxFilesize uint32, noCompression bool, readonly bool) (*freezerTable, error) {
 	// Ensure the containing directory exists and open the indexEntry file
-	if err := os.MkdirAll(path, 0755); err != nil {
+	opener := openFreezerFileForAppend
+	if readonly {
+		opener = openFreezerFileForReadOnly
+	} else if err := os.MkdirAll(path, 0755); err != nil {
 		return nil, err
 	}
-	var idxName string
+	var idxName, sumName string
 	if noCompression {
 		// Raw idx
 		idxName = fmt.Sprintf("%s.ridx", name)
+		sumName = fmt.Sprintf("%s.rsum", name)
 	} else {
 		// Compressed idx
 		idxName = fmt.Sprintf("%s.cidx", name)
+		sumName = fmt.Sprintf("%s.csum", name)
+	}
+	offsets, err := opener(filepath.Join(path, idxName))
+	if err != nil {
+		return nil, err
+	}
+	meta, err := opener(filepath.Join(path, fmt.Sprintf("%s.meta", name)))
+	if readonly && os.IsNotExist(err) {
+		meta, err = nil, nil // Table predates tail pruning, nothing was discarded
 	}
-	offsets, err := openFreezerFileForAppend(filepath.Join(path, idxName))
 	if err != nil {
+		offsets.Close()
+		return nil, err
+	}
+	sums, err := opener(filepath.Join(path, sumName))
+	if readonly && os.IsNotExist(err) {
+		sums, err = nil, nil // Table predates checksums, items can't be verified
+	}
+	if err != nil {
+		offsets.Close()
+		if meta != nil {
+			meta.Close()
+		}
 		return nil, err
 	}
 	// Create the table and repair any past inconsistency
 	tab := &freezerTable{
 		index:         offsets,
+		meta:          meta,
+		sums:          sums,
 		files:         make(map[uint32]*os.File),
 		readMeter:     readMeter,
 		writeMeter:    writeMeter,
@@ -177,6 +247,7 @@ func newCustomTable(path string, name string, readMeter metrics.Meter, writeMete
 		path:          path,
 		logger:        log.New("database", path, "table", name),
 		noCompression: noCompression,
+		readonly:      readonly,
 		maxFileSize:   maxFilesize,
 	}
 	if err := tab.repair(); err != nil {
@@ -206,19 +277,26 @@ func (t *freezerTable) repair() error {
 		return err
 	}
 	if stat.Size() == 0 {
+		if t.readonly {
+			return fmt.Errorf("freezer table %s not initialized", t.name)
+		}
 		if _, err := t.index.Write(buffer); err != nil {
 			return err
 		}
 	}
-	// Ensure the index is a multiple of indexEntrySize bytes
-	if overflow := stat.Size() % indexEntrySize; overflow != 0 {
-		truncateFreezerFile(t.index, stat.Size()-overflow) // New file can't trigger this path
-	}
-	// Retrieve the file sizes and prepare for truncation
-	if stat, err = t.index.Stat(); err != nil {
-		return err
+	// Ensure the index is a multiple of indexEntrySize bytes, a read-only table
+	// just ignores a partially written entry
+	offsetsSize := stat.Size() - stat.Size()%indexEntrySize
+	if !t.readonly {
+		if overflow := stat.Size() % indexEntrySize; overflow != 0 {
+			truncateFreezerFile(t.index, stat.Size()-overflow) // New file can't trigger this path
+		}
+		// Retrieve the file sizes and prepare for truncation
+		if stat, err = t.index.Stat(); err != nil {
+			return err
+		}
+		offsetsSize = stat.Size()
 	}
-	offsetsSize := stat.Size()
 
 	// Open the head file
 	var (
@@ -235,9 +313,18 @@ func (t *freezerTable) repair() error {
 	t.tailId = firstIndex.filenum
 	t.itemOffset = firstIndex.offset
 
-	t.index.ReadAt(buffer, offsetsSize-indexEntrySize)
-	lastIndex.unmarshalBinary(buffer)
-	t.head, err = t.openFile(lastIndex.filenum, openFreezerFileForAppend)
+	// If the index only holds the tail marker entry, the head file is empty
+	if offsetsSize == indexEntrySize {
+		lastIndex = indexEntry{filenum: t.tailId, offset: 0}
+	} else {
+		t.index.ReadAt(buffer, offsetsSize-indexEntrySize)
+		lastIndex.unmarshalBinary(buffer)
+	}
+	opener := openFreezerFileForAppend
+	if t.readonly {
+		opener = openFreezerFileForReadOnly
+	}
+	t.head, err = t.openFile(lastIndex.filenum, opener)
 	if err != nil {
 		return err
 	}
@@ -249,6 +336,14 @@ func (t *freezerTable) repair() error {
 	// Keep truncating both files until they come in sync
 	contentExp = int64(lastIndex.offset)
 
+	if t.readonly {
+		// Data not yet indexed is invisible, but missing indexed data can't be
+		// recovered without truncating
+		if contentExp > contentSize {
+			return fmt.Errorf("freezer table %s index points beyond data file: indexed %d, stored %d", t.name, contentExp, contentSize)
+		}
+		contentSize = contentExp
+	}
 	for contentExp != contentSize {
 		// Truncate the head file to the last offset pointer
 		if contentExp < contentSize {
@@ -265,9 +360,14 @@ func (t *freezerTable) repair() error {
 				return err
 			}
 			offsetsSize -= indexEntrySize
-			t.index.ReadAt(buffer, offsetsSize-indexEntrySize)
+
 			var newLastIndex indexEntry
-			newLastIndex.unmarshalBinary(buffer)
+			if offsetsSize == indexEntrySize {
+				newLastIndex = indexEntry{filenum: t.tailId, offset: 0}
+			} else {
+				t.index.ReadAt(buffer, offsetsSize-indexEntrySize)
+				newLastIndex.unmarshalBinary(buffer)
+			}
 			// We might have slipped back into an earlier head-file here
 			if newLastIndex.filenum != lastIndex.filenum {
 				// Release earlier opened file
@@ -287,25 +387,116 @@ func (t *freezerTable) repair() error {
 		}
 	}
 	// Ensure all reparation changes have been written to disk
-	if err := t.index.Sync(); err != nil {
-		return err
-	}
-	if err := t.head.Sync(); err != nil {
-		return err
+	if !t.readonly {
+		if err := t.index.Sync(); err != nil {
+			return err
+		}
+		if err := t.head.Sync(); err != nil {
+			return err
+		}
 	}
 	// Update the item and byte counters and return
 	t.items = uint64(t.itemOffset) + uint64(offsetsSize/indexEntrySize-1) // last indexEntry points to the end of the data file
 	t.headBytes = uint32(contentSize)
 	t.headId = lastIndex.filenum
 
+	// Load the tail marker, items below the deleted offset are gone regardless
+	tail, err := readTailMarker(t.meta)
+	if err != nil {
+		return err
+	}
+	t.tail = uint64(t.itemOffset)
+	if tail > t.tail {
+		t.tail = tail
+	}
+	if t.tail > t.items {
+		t.tail = t.items
+	}
+
 	// Close opened files and preopen all files
 	if err := t.preopen(); err != nil {
 		return err
 	}
-	t.logger.Debug("Chain freezer table opened", "items", t.items, "size", common.StorageSize(t.headBytes))
+	// With the data accessible, bring the checksums in sync too
+	if err := t.repairChecksums(); err != nil {
+		return err
+	}
+	t.logger.Debug("Chain freezer table opened", "items", t.items, "tail", t.tail, "size", common.StorageSize(t.headBytes))
 	return nil
 }
 
+// repairChecksums aligns the checksum file with the index after a potential crash,
+// dropping the checksums of discarded or truncated items and generating missing
+// ones from the data files. The latter also introduces checksums to tables which
+// predate them. Read-only tables just load the checksums, leaving any items not
+// covered unverified.
+func (t *freezerTable) repairChecksums() error {
+	if t.sums == nil {
+		return nil
+	}
+	stat, err := t.sums.Stat()
+	if err != nil {
+		return err
+	}
+	var (
+		offset  = uint64(t.itemOffset)
+		first   = offset
+		entries uint64
+	)
+	if stat.Size() >= checksumHeaderSize {
+		header := make([]byte, checksumHeaderSize)
+		if _, err := t.sums.ReadAt(header, 0); err != nil {
+			return err
+		}
+		first = binary.BigEndian.Uint64(header)
+		entries = uint64(stat.Size()-checksumHeaderSize) / checksumSize
+	}
+	if t.readonly {
+		t.sumTail, t.checksums = first, first+entries
+		return nil
+	}
+	switch {
+	case stat.Size() < checksumHeaderSize || first > offset || first+entries < offset:
+		// Checksums are missing or don't overlap the index, start over
+		if t.sums, err = rewriteFile(t.sums, checksumHeader(offset), stat.Size()); err != nil {
+			return err
+		}
+		entries = 0
+
+	case first < offset:
+		// A tail truncation was interrupted after rewriting the index
+		if t.sums, err = rewriteFile(t.sums, checksumHeader(offset), checksumHeaderSize+int64(offset-first)*checksumSize); err != nil {
+			return err
+		}
+		entries -= offset - first
+	}
+	// Drop the checksums of any items truncated from the head
+	items := t.items
+	if offset+entries > items {
+		entries = items - offset
+	}
+	if err := truncateFreezerFile(t.sums, checksumHeaderSize+int64(entries)*checksumSize); err != nil {
+		return err
+	}
+	t.sumTail, t.checksums = offset, offset+entries
+
+	// Generate the checksums missing from the head
+	if t.checksums < items {
+		t.logger.Info("Generating freezer table checksums", "items", items-t.checksums)
+		for item := t.checksums; item < items; item++ {
+			blob, err := t.retrieve(item, false)
+			if err != nil {
+				return err
+			}
+			if _, err := t.sums.Write(itemChecksum(blob)); err != nil {
+				return err
+			}
+		}
+		t.checksums = items
+	}
+	return t.sums.Sync()
+}
+
 // preopen opens all files that the freezer will need. This mVBGod should be called from an init-context,
 // since it assumes that it doesn't have to bother with locking
 // The rationale for doing preopen is to not have to do it from within Retrieve, thus not needing to ever
@@ -319,13 +510,20 @@ func (t *freezerTable) preopen() (err error) {
 			return err
 		}
 	}
-	// Open head in read/write
-	t.head, err = t.openFile(t.headId, openFreezerFileForAppend)
+	// Open head in read/write, unless the table is read-only
+	if t.readonly {
+		t.head, err = t.openFile(t.headId, openFreezerFileForReadOnly)
+	} else {
+		t.head, err = t.openFile(t.headId, openFreezerFileForAppend)
+	}
 	return err
 }
 
 // truncate discards any recent data above the provided threshold number.
 func (t *freezerTable) truncate(items uint64) error {
+	if t.readonly {
+		return errReadOnly
+	}
 	t.lock.Lock()
 	defer t.lock.Unlock()
 
@@ -334,6 +532,9 @@ func (t *freezerTable) truncate(items uint64) error {
 	if existing <= items {
 		return nil
 	}
+	if items < uint64(t.itemOffset) {
+		return errors.New("truncation below tail")
+	}
 	// We need to truncate, save the old size for metrics tracking
 	oldSize, err := t.sizeNolock()
 	if err != nil {
@@ -345,16 +546,27 @@ func (t *freezerTable) truncate(items uint64) error {
 		log = t.logger.Warn // Only loud warn if we delete multiple items
 	}
 	log("Truncating freezer table", "items", existing, "limit", items)
-	if err := truncateFreezerFile(t.index, int64(items+1)*indexEntrySize); err != nil {
+
+	// The index file doesn't contain the items deleted from the tail, so
+	// offset the truncation point accordingly
+	length := items - uint64(t.itemOffset)
+	if err := truncateFreezerFile(t.index, int64(length+1)*indexEntrySize); err != nil {
 		return err
 	}
-	// Calculate the new expected size of the data file and truncate it
-	buffer := make([]byte, indexEntrySize)
-	if _, err := t.index.ReadAt(buffer, int64(items*indexEntrySize)); err != nil {
+	if err := truncateFreezerFile(t.sums, checksumHeaderSize+int64(length)*checksumSize); err != nil {
 		return err
 	}
-	var expected indexEntry
-	expected.unmarshalBinary(buffer)
+	atomic.StoreUint64(&t.checksums, items)
+
+	// Calculate the new expected size of the data file and truncate it
+	expected := indexEntry{filenum: t.tailId}
+	if length > 0 {
+		buffer := make([]byte, indexEntrySize)
+		if _, err := t.index.ReadAt(buffer, int64(length*indexEntrySize)); err != nil {
+			return err
+		}
+		expected.unmarshalBinary(buffer)
+	}
 
 	// We might need to truncate back to older files
 	if expected.filenum != t.headId {
@@ -374,6 +586,14 @@ func (t *freezerTable) truncate(items uint64) error {
 	if err := truncateFreezerFile(t.head, int64(expected.offset)); err != nil {
 		return err
 	}
+	// If hidden tail items were truncated too, pull the tail marker back so
+	// that newly appended items are not considered pruned
+	if atomic.LoadUint64(&t.tail) > items {
+		if err := writeTailMarker(t.meta, items); err != nil {
+			return err
+		}
+		atomic.StoreUint64(&t.tail, items)
+	}
 	// All data files truncated, set internal counters and return
 	atomic.StoreUint64(&t.items, items)
 	atomic.StoreUint32(&t.headBytes, expected.offset)
@@ -388,6 +608,182 @@ func (t *freezerTable) truncate(items uint64) error {
 	return nil
 }
 
+// truncateTail discards any data below the provided threshold number. The items
+// are hidden right away, but the data files backing them are only deleted once
+// every item they contain has been discarded.
+func (t *freezerTable) truncateTail(items uint64) error {
+	if t.readonly {
+		return errReadOnly
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	// If the tail is already past the threshold, don't do anything
+	if atomic.LoadUint64(&t.tail) >= items {
+		return nil
+	}
+	if atomic.LoadUint64(&t.items) < items {
+		return errors.New("truncation above head")
+	}
+	// Persist the new tail marker first, hiding the items from any reader
+	if err := writeTailMarker(t.meta, items); err != nil {
+		return err
+	}
+	atomic.StoreUint64(&t.tail, items)
+
+	// Find the data file containing the new first item. If all items are
+	// discarded, the head file is retained nonVBGeless.
+	newTailId := atomic.LoadUint32(&t.headId)
+	if items < atomic.LoadUint64(&t.items) {
+		entry, err := t.readIndex(items - uint64(t.itemOffset) + 1)
+		if err != nil {
+			return err
+		}
+		newTailId = entry.filenum
+	}
+	if newTailId == t.tailId {
+		return nil
+	}
+	oldSize, err := t.sizeNolock()
+	if err != nil {
+		return err
+	}
+	// Count the items stored in the data files to be dropped. Entry i+1 of the
+	// index marks the end of item i, and items never span data files.
+	var (
+		length = int(atomic.LoadUint64(&t.items) - uint64(t.itemOffset))
+		ierr   error
+	)
+	deleted := sort.Search(length, func(i int) bool {
+		entry, err := t.readIndex(uint64(i) + 1)
+		if err != nil {
+			ierr = err
+			return true
+		}
+		return entry.filenum >= newTailId
+	})
+	if ierr != nil {
+		return ierr
+	}
+	t.logger.Debug("Deleting freezer table tail", "items", deleted, "tail", items, "files", newTailId-t.tailId)
+
+	// Rewrite the index file without the deleted entries, carrying the number
+	// of deleted items and the new tail file in the first entry
+	if err := t.rewriteIndex(uint64(deleted), indexEntry{filenum: newTailId, offset: t.itemOffset + uint32(deleted)}); err != nil {
+		return err
+	}
+	t.tailId = newTailId
+	t.itemOffset += uint32(deleted)
+
+	// Drop the checksums of the deleted items too
+	sums, err := rewriteFile(t.sums, checksumHeader(uint64(t.itemOffset)), checksumHeaderSize+int64(deleted)*checksumSize)
+	t.sums = sums
+	if err != nil {
+		return err
+	}
+	t.sumTail = uint64(t.itemOffset)
+	t.releaseFilesBefore(newTailId, true)
+
+	// Retrieve the new size and update the total size counter
+	newSize, err := t.sizeNolock()
+	if err != nil {
+		return err
+	}
+	t.sizeGauge.Dec(int64(oldSize - newSize))
+
+	return nil
+}
+
+// readIndex reads the index entry at the given position of the index file.
+func (t *freezerTable) readIndex(pos uint64) (indexEntry, error) {
+	var (
+		entry  indexEntry
+		buffer = make([]byte, indexEntrySize)
+	)
+	if _, err := t.index.ReadAt(buffer, int64(pos*indexEntrySize)); err != nil {
+		return entry, err
+	}
+	entry.unmarshalBinary(buffer)
+	return entry, nil
+}
+
+// rewriteIndex replaces the index file with one lacking the given number of
+// leading items, starting with the provided tail entry.
+func (t *freezerTable) rewriteIndex(deleted uint64, tail indexEntry) error {
+	index, err := rewriteFile(t.index, tail.marshallBinary(), int64(deleted+1)*indexEntrySize)
+	t.index = index
+	return err
+}
+
+// rewriteFile replaces the content of a table file with the given header, followed
+// by the original content from the start position onwards. The new file is built
+// aside and moved into place atomically. The old descriptor is returned if the
+// file could not be replaced, otherwise it's closed and the new one returned.
+func rewriteFile(file *os.File, header []byte, start int64) (*os.File, error) {
+	name := file.Name()
+	stat, err := file.Stat()
+	if err != nil {
+		return file, err
+	}
+	tmp, err := openFreezerFileTruncated(name + ".tmp")
+	if err != nil {
+		return file, err
+	}
+	if _, err := tmp.Write(header); err != nil {
+		tmp.Close()
+		return file, err
+	}
+	if start < stat.Size() {
+		if _, err := io.Copy(tmp, io.NewSectionReader(file, start, stat.Size()-start)); err != nil {
+			tmp.Close()
+			return file, err
+		}
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return file, err
+	}
+	if err := tmp.Close(); err != nil {
+		return file, err
+	}
+	if err := os.Rename(name+".tmp", name); err != nil {
+		return file, err
+	}
+	// Swap the old descriptor for the new file
+	file.Close()
+	return openFreezerFileForAppend(name)
+}
+
+// readTailMarker loads the persisted tail marker of a freezer table, returning
+// zero if none was written yet.
+func readTailMarker(meta *os.File) (uint64, error) {
+	if meta == nil {
+		return 0, nil
+	}
+	stat, err := meta.Stat()
+	if err != nil {
+		return 0, err
+	}
+	if stat.Size() < 8 {
+		return 0, nil
+	}
+	buffer := make([]byte, 8)
+	if _, err := meta.ReadAt(buffer, 0); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(buffer), nil
+}
+
+// writeTailMarker persists the tail marker of a freezer table.
+func writeTailMarker(meta *os.File, tail uint64) error {
+	buffer := make([]byte, 8)
+	binary.BigEndian.PutUint64(buffer, tail)
+	if _, err := meta.WriteAt(buffer, 0); err != nil {
+		return err
+	}
+	return meta.Sync()
+}
+
 // Close closes all opened files.
 func (t *freezerTable) Close() error {
 	t.lock.Lock()
@@ -399,6 +795,17 @@ func (t *freezerTable) Close() error {
 	}
 	t.index = nil
 
+	if t.meta != nil {
+		if err := t.meta.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if t.sums != nil {
+		if err := t.sums.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	for _, f := range t.files {
 		if err := f.Close(); err != nil {
 			errs = append(errs, err)
@@ -440,6 +847,19 @@ func (t *freezerTable) releaseFile(num uint32) {
 	}
 }
 
+// releaseFilesBefore closes all open files with a lower number, and optionally also deletes the files
+func (t *freezerTable) releaseFilesBefore(num uint32, remove bool) {
+	for fnum, f := range t.files {
+		if fnum < num {
+			delete(t.files, fnum)
+			f.Close()
+			if remove {
+				os.Remove(f.Name())
+			}
+		}
+	}
+}
+
 // releaseFilesAfter closes all open files with a higher number, and optionally also deletes the files
 func (t *freezerTable) releaseFilesAfter(num uint32, remove bool) {
 	for fnum, f := range t.files {
@@ -460,6 +880,9 @@ func (t *freezerTable) releaseFilesAfter(num uint32, remove bool) {
 // Note, this mVBGod will *not* flush any data to disk so be sure to explicitly
 // fsync before irreversibly deleting data from the database.
 func (t *freezerTable) Append(item uint64, blob []byte) error {
+	if t.readonly {
+		return errReadOnly
+	}
 	// Read lock prevents competition with truncate
 	t.lock.RLock()
 	// Ensure the table is still accessible
@@ -511,12 +934,14 @@ func (t *freezerTable) Append(item uint64, blob []byte) error {
 		filenum: atomic.LoadUint32(&t.headId),
 		offset:  newOffset,
 	}
-	// Write indexEntry
+	// Write indexEntry and checksum
 	t.index.Write(idx.marshallBinary())
+	t.sums.Write(itemChecksum(blob))
 
-	t.writeMeter.Mark(int64(bLen + indexEntrySize))
-	t.sizeGauge.Inc(int64(bLen + indexEntrySize))
+	t.writeMeter.Mark(int64(bLen + indexEntrySize + checksumSize))
+	t.sizeGauge.Inc(int64(bLen + indexEntrySize + checksumSize))
 
+	atomic.AddUint64(&t.checksums, 1)
 	atomic.AddUint64(&t.items, 1)
 	return nil
 }
@@ -555,52 +980,77 @@ func (t *freezerTable) getBounds(item uint64) (uint32, uint32, uint32, error) {
 }
 
 // Retrieve looks up the data offset of an item with the given number and retrieves
-// the raw binary blob from the data file.
+// the raw binary blob from the data file, verified against its checksum.
 func (t *freezerTable) Retrieve(item uint64) ([]byte, error) {
+	// Ensure the item was not deleted from the tail
+	if atomic.LoadUint64(&t.tail) > item {
+		return nil, errPruned
+	}
+	blob, err := t.retrieve(item, true)
+	if err != nil {
+		return nil, err
+	}
+	if t.noCompression {
+		return blob, nil
+	}
+	return snappy.Decode(nil, blob)
+}
+
+// retrieve reads the stored, possibly compressed, binary blob of an item from
+// the data file, optionally verifying it against its checksum if it has one.
+// Items hidden by the tail marker are still accessible.
+func (t *freezerTable) retrieve(item uint64, verify bool) ([]byte, error) {
 	t.lock.RLock()
+	defer t.lock.RUnlock()
+
 	// Ensure the table and the item is accessible
 	if t.index == nil || t.head == nil {
-		t.lock.RUnlock()
 		return nil, errClosed
 	}
 	if atomic.LoadUint64(&t.items) <= item {
-		t.lock.RUnlock()
 		return nil, errOutOfBounds
 	}
 	// Ensure the item was not deleted from the tail either
 	if uint64(t.itemOffset) > item {
-		t.lock.RUnlock()
-		return nil, errOutOfBounds
+		return nil, errPruned
 	}
 	startOffset, endOffset, filenum, err := t.getBounds(item - uint64(t.itemOffset))
 	if err != nil {
-		t.lock.RUnlock()
 		return nil, err
 	}
 	dataFile, exist := t.files[filenum]
 	if !exist {
-		t.lock.RUnlock()
 		return nil, fmt.Errorf("missing data file %d", filenum)
 	}
-	// Retrieve the data itself, decompress and return
+	// Retrieve the data itself and verify it if requested
 	blob := make([]byte, endOffset-startOffset)
 	if _, err := dataFile.ReadAt(blob, int64(startOffset)); err != nil {
-		t.lock.RUnlock()
 		return nil, err
 	}
-	t.lock.RUnlock()
 	t.readMeter.Mark(int64(len(blob) + 2*indexEntrySize))
 
-	if t.noCompression {
-		return blob, nil
+	if verify && t.sums != nil && item >= t.sumTail && item < atomic.LoadUint64(&t.checksums) {
+		sum := make([]byte, checksumSize)
+		if _, err := t.sums.ReadAt(sum, checksumHeaderSize+int64(item-t.sumTail)*checksumSize); err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(sum, itemChecksum(blob)) {
+			return nil, errChecksumMismatch
+		}
 	}
-	return snappy.Decode(nil, blob)
+	return blob, nil
 }
 
 // has returns an indicator whVBGer the specified number data
 // exists in the freezer table.
 func (t *freezerTable) has(number uint64) bool {
-	return atomic.LoadUint64(&t.items) > number
+	return atomic.LoadUint64(&t.items) > number && atomic.LoadUint64(&t.tail) <= number
+}
+
+// pruned returns an indicator whVBGer the specified number data was
+// discarded from the tail of the freezer table.
+func (t *freezerTable) pruned(number uint64) bool {
+	return atomic.LoadUint64(&t.tail) > number
 }
 
 // size returns the total data size in the freezer table.
@@ -619,15 +1069,27 @@ func (t *freezerTable) sizeNolock() (uint64, error) {
 		return 0, err
 	}
 	total := uint64(t.maxFileSize)*uint64(t.headId-t.tailId) + uint64(t.headBytes) + uint64(stat.Size())
+	if t.sums != nil {
+		if stat, err = t.sums.Stat(); err != nil {
+			return 0, err
+		}
+		total += uint64(stat.Size())
+	}
 	return total, nil
 }
 
 // Sync pushes any pending data from memory out to disk. This is an expensive
 // operation, so use it with care.
 func (t *freezerTable) Sync() error {
+	if t.readonly {
+		return nil
+	}
 	if err := t.index.Sync(); err != nil {
 		return err
 	}
+	if err := t.sums.Sync(); err != nil {
+		return err
+	}
 	return t.head.Sync()
 }
 
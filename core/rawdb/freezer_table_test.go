@@ -48,7 +48,7 @@ func TestFreezerBasics(t *testing.T) {
 	// set cutoff at 50 bytes
 	f, err := newCustomTable(os.TempDir(),
 		fmt.Sprintf("unittest-%d", rand.Uint64()),
-		metrics.NewMeter(), metrics.NewMeter(), metrics.NewGauge(), 50, true)
+		metrics.NewMeter(), metrics.NewMeter(), metrics.NewGauge(), 50, true, false)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -95,7 +95,7 @@ func TestFreezerBasicsClosing(t *testing.T) {
 		f          *freezerTable
 		err        error
 	)
-	f, err = newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true)
+	f, err = newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -104,7 +104,7 @@ func TestFreezerBasicsClosing(t *testing.T) {
 		data := getChunk(15, x)
 		f.Append(uint64(x), data)
 		f.Close()
-		f, err = newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true)
+		f, err = newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -121,7 +121,7 @@ func TestFreezerBasicsClosing(t *testing.T) {
 			t.Fatalf("test %d, got \n%x != \n%x", y, got, exp)
 		}
 		f.Close()
-		f, err = newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true)
+		f, err = newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -135,7 +135,7 @@ func TestFreezerRepairDanglingHead(t *testing.T) {
 	fname := fmt.Sprintf("dangling_headtest-%d", rand.Uint64())
 
 	{ // Fill table
-		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true)
+		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -164,7 +164,7 @@ func TestFreezerRepairDanglingHead(t *testing.T) {
 	idxFile.Close()
 	// Now open it again
 	{
-		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true)
+		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -186,7 +186,7 @@ func TestFreezerRepairDanglingHeadLarge(t *testing.T) {
 	fname := fmt.Sprintf("dangling_headtest-%d", rand.Uint64())
 
 	{ // Fill a table and close it
-		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true)
+		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -212,7 +212,7 @@ func TestFreezerRepairDanglingHeadLarge(t *testing.T) {
 	idxFile.Close()
 	// Now open it again
 	{
-		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true)
+		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -233,7 +233,7 @@ func TestFreezerRepairDanglingHeadLarge(t *testing.T) {
 	}
 	// And if we open it, we should now be able to read all of them (new values)
 	{
-		f, _ := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true)
+		f, _ := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
 		for y := 1; y < 255; y++ {
 			exp := getChunk(15, ^y)
 			got, err := f.Retrieve(uint64(y))
@@ -254,7 +254,7 @@ func TestSnappyDetection(t *testing.T) {
 	fname := fmt.Sprintf("snappytest-%d", rand.Uint64())
 	// Open with snappy
 	{
-		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true)
+		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -267,7 +267,7 @@ func TestSnappyDetection(t *testing.T) {
 	}
 	// Open without snappy
 	{
-		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, false)
+		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, false, false)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -279,7 +279,7 @@ func TestSnappyDetection(t *testing.T) {
 
 	// Open with snappy
 	{
-		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true)
+		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -311,7 +311,7 @@ func TestFreezerRepairDanglingIndex(t *testing.T) {
 	fname := fmt.Sprintf("dangling_indextest-%d", rand.Uint64())
 
 	{ // Fill a table and close it
-		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true)
+		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -347,7 +347,7 @@ func TestFreezerRepairDanglingIndex(t *testing.T) {
 	// 45, 45, 15
 	// with 3+3+1 items
 	{
-		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true)
+		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -368,7 +368,7 @@ func TestFreezerTruncate(t *testing.T) {
 	fname := fmt.Sprintf("truncation-%d", rand.Uint64())
 
 	{ // Fill table
-		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true)
+		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -385,7 +385,7 @@ func TestFreezerTruncate(t *testing.T) {
 	}
 	// Reopen, truncate
 	{
-		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true)
+		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -410,7 +410,7 @@ func TestFreezerRepairFirstFile(t *testing.T) {
 	rm, wm, sg := metrics.NewMeter(), metrics.NewMeter(), metrics.NewGauge()
 	fname := fmt.Sprintf("truncationfirst-%d", rand.Uint64())
 	{ // Fill table
-		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true)
+		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -438,7 +438,7 @@ func TestFreezerRepairFirstFile(t *testing.T) {
 	}
 	// Reopen
 	{
-		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true)
+		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -466,7 +466,7 @@ func TestFreezerReadAndTruncate(t *testing.T) {
 	rm, wm, sg := metrics.NewMeter(), metrics.NewMeter(), metrics.NewGauge()
 	fname := fmt.Sprintf("read_truncate-%d", rand.Uint64())
 	{ // Fill table
-		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true)
+		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -483,7 +483,7 @@ func TestFreezerReadAndTruncate(t *testing.T) {
 	}
 	// Reopen and read all files
 	{
-		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true)
+		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -512,7 +512,7 @@ func TestOffset(t *testing.T) {
 	rm, wm, sg := metrics.NewMeter(), metrics.NewMeter(), metrics.NewGauge()
 	fname := fmt.Sprintf("offset-%d", rand.Uint64())
 	{ // Fill table
-		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 40, true)
+		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 40, true, false)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -568,7 +568,7 @@ func TestOffset(t *testing.T) {
 	}
 	// Now open again
 	checkPresent := func(numDeleted uint64) {
-		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 40, true)
+		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 40, true, false)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -630,6 +630,103 @@ func TestOffset(t *testing.T) {
 	checkPresent(1000000)
 }
 
+// TestFreezerTruncateTail tests discarding items from the tail of the table,
+// ensuring the data files are dropped and the tail survives a reopen.
+func TestFreezerTruncateTail(t *testing.T) {
+	t.Parallel()
+	rm, wm, sg := metrics.NewMeter(), metrics.NewMeter(), metrics.NewGauge()
+	fname := fmt.Sprintf("truncationtail-%d", rand.Uint64())
+
+	// checkRange ensures items below tail are pruned and the ones above are intact
+	checkRange := func(f *freezerTable, tail, items uint64) {
+		for i := uint64(0); i < tail; i++ {
+			if _, err := f.Retrieve(i); err != errPruned {
+				t.Fatalf("item %d: expected pruned error, got %v", i, err)
+			}
+			if f.has(i) {
+				t.Fatalf("item %d: pruned item reported present", i)
+			}
+		}
+		for i := tail; i < items; i++ {
+			got, err := f.Retrieve(i)
+			if err != nil {
+				t.Fatalf("item %d: %v", i, err)
+			}
+			if exp := getChunk(15, int(i)); !bytes.Equal(got, exp) {
+				t.Fatalf("item %d: expected %x got %x", i, exp, got)
+			}
+		}
+	}
+	{ // Fill table, 3 items per data file
+		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for x := 0; x < 30; x++ {
+			f.Append(uint64(x), getChunk(15, x))
+		}
+		// Discard the first data file and hide an item of the second
+		if err := f.truncateTail(4); err != nil {
+			t.Fatal(err)
+		}
+		if f.tailId != 1 || f.itemOffset != 3 {
+			t.Fatalf("tail mismatch: have file %d offset %d, want file %d offset %d", f.tailId, f.itemOffset, 1, 3)
+		}
+		checkRange(f, 4, 30)
+		if err := f.truncateTail(31); err == nil {
+			t.Fatal("expected error truncating above head")
+		}
+		f.Close()
+	}
+	// The first data file should be gone
+	if _, err := os.Stat(filepath.Join(os.TempDir(), fmt.Sprintf("%s.0000.rdat", fname))); !os.IsNotExist(err) {
+		t.Fatalf("expected data file to be deleted, got %v", err)
+	}
+	{ // Reopen, ensure the tail was persisted and discard everything
+		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f.tail != 4 || f.items != 30 {
+			t.Fatalf("reopened table mismatch: have tail %d items %d, want tail %d items %d", f.tail, f.items, 4, 30)
+		}
+		checkRange(f, 4, 30)
+		if err := f.truncateTail(30); err != nil {
+			t.Fatal(err)
+		}
+		if f.tailId != f.headId || f.itemOffset != 27 {
+			t.Fatalf("tail mismatch: have file %d offset %d, want file %d offset %d", f.tailId, f.itemOffset, f.headId, 27)
+		}
+		checkRange(f, 30, 30)
+
+		// Truncating the head below the tail should pull the tail back
+		if err := f.truncate(28); err != nil {
+			t.Fatal(err)
+		}
+		if f.tail != 28 {
+			t.Fatalf("tail mismatch: have %d, want %d", f.tail, 28)
+		}
+		if err := f.truncate(20); err == nil {
+			t.Fatal("expected error truncating below deleted items")
+		}
+		for x := 28; x < 35; x++ {
+			if err := f.Append(uint64(x), getChunk(15, x)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		checkRange(f, 28, 35)
+		f.Close()
+	}
+	{ // Reopen, ensure the new items are retained
+		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		checkRange(f, 28, 35)
+	}
+}
+
 // TODO (?)
 // - test that if we remove several head-files, aswell as data last data-file,
 //   the index is truncated accordingly
@@ -640,3 +737,159 @@ func TestOffset(t *testing.T) {
 // However, all 'normal' failure modes arising due to failing to sync() or save a file should be
 // handled already, and the case described above can only (?) happen if an external process/user
 // deletes files from the filesystem.
+
+// TestFreezerReadonly checks that a read-only table never repairs its files, but
+// ignores unindexed data and refuses to open if indexed data is missing.
+func TestFreezerReadonly(t *testing.T) {
+	t.Parallel()
+	rm, wm, sg := metrics.NewMeter(), metrics.NewMeter(), metrics.NewGauge()
+	fname := fmt.Sprintf("readonlytest-%d", rand.Uint64())
+
+	// Read-only tables can't be created
+	if _, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, true); err == nil {
+		t.Fatal("expected error opening missing table read-only")
+	}
+	{ // Fill a table and close it
+		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Write 15 bytes 9 times : 150 bytes
+		for x := 0; x < 9; x++ {
+			data := getChunk(15, x)
+			f.Append(uint64(x), data)
+		}
+		f.Close()
+		// File sizes should be 45, 45, 45 : items[3, 3, 3)
+	}
+	// Append some unindexed junk to the third file: 45, 45, 50
+	fileToCrop := filepath.Join(os.TempDir(), fmt.Sprintf("%s.0002.rdat", fname))
+	{
+		file, err := os.OpenFile(fileToCrop, os.O_RDWR|os.O_APPEND, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		file.Write(getChunk(5, 0xff))
+		file.Close()
+	}
+	// Open the table read-only, the junk should be ignored but left in place
+	{
+		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f.items != 9 {
+			f.Close()
+			t.Fatalf("expected %d items, got %d", 9, f.items)
+		}
+		for y := 0; y < 9; y++ {
+			exp := getChunk(15, y)
+			got, err := f.Retrieve(uint64(y))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, exp) {
+				t.Fatalf("test %d, got \n%x != \n%x", y, got, exp)
+			}
+		}
+		if err := f.Append(9, getChunk(15, 9)); err == nil {
+			t.Fatal("expected error appending to read-only table")
+		}
+		f.Close()
+		if err := assertFileSize(fileToCrop, 50); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Crop the third file below the indexed items: 45, 45, 20
+	{
+		file, err := os.OpenFile(fileToCrop, os.O_RDWR, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		file.Truncate(20)
+		file.Close()
+	}
+	// Opening read-only should fail without repairing the table
+	{
+		if _, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, true); err == nil {
+			t.Fatal("expected error opening damaged table read-only")
+		}
+		if err := assertFileSize(fileToCrop, 20); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// TestFreezerChecksums tests that checksums are generated for tables predating
+// them, survive tail truncation and detect corrupted items.
+func TestFreezerChecksums(t *testing.T) {
+	t.Parallel()
+	rm, wm, sg := metrics.NewMeter(), metrics.NewMeter(), metrics.NewGauge()
+	fname := fmt.Sprintf("checksumtest-%d", rand.Uint64())
+	{ // Fill a table and drop its checksums, as if it predated them
+		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Write 15 bytes 9 times : 150 bytes
+		for x := 0; x < 9; x++ {
+			data := getChunk(15, x)
+			f.Append(uint64(x), data)
+		}
+		f.Close()
+		os.Remove(filepath.Join(os.TempDir(), fmt.Sprintf("%s.rsum", fname)))
+	}
+	// Reopen the table, the checksums should be regenerated
+	{
+		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f.checksums != 9 {
+			t.Fatalf("expected %d checksums, got %d", 9, f.checksums)
+		}
+		// Drop the first file, the checksums should follow
+		if err := f.truncateTail(3); err != nil {
+			t.Fatal(err)
+		}
+		f.Close()
+		if err := assertFileSize(filepath.Join(os.TempDir(), fmt.Sprintf("%s.rsum", fname)), checksumHeaderSize+6*checksumSize); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Corrupt an item in the second file and ensure it's detected
+	{
+		file, err := os.OpenFile(filepath.Join(os.TempDir(), fmt.Sprintf("%s.0001.rdat", fname)), os.O_RDWR, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		file.WriteAt([]byte{0xff}, 20)
+		file.Close()
+	}
+	{
+		f, err := newCustomTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+
+		if f.sumTail != 3 || f.checksums != 9 {
+			t.Fatalf("checksum range mismatch: have [%d, %d), want [%d, %d)", f.sumTail, f.checksums, 3, 9)
+		}
+		for y := 3; y < 9; y++ {
+			got, err := f.Retrieve(uint64(y))
+			if y == 4 {
+				if err != errChecksumMismatch {
+					t.Fatalf("test %d: corruption not detected: %v", y, err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if exp := getChunk(15, y); !bytes.Equal(got, exp) {
+				t.Fatalf("test %d, got \n%x != \n%x", y, got, exp)
+			}
+		}
+	}
+}
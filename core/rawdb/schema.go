@@ -30,6 +30,12 @@ var (
 	// databaseVerisionKey tracks the current database version.
 	databaseVerisionKey = []byte("DatabaseVersion")
 
+	// databaseEngineKey tracks the key-value engine the database was created with.
+	databaseEngineKey = []byte("DatabaseEngine")
+
+	// stateStoreKey marks databases keeping their state in a separate key-value store.
+	stateStoreKey = []byte("StateStore")
+
 	// headHeaderKey tracks the latest known header's hash.
 	headHeaderKey = []byte("LastHeader")
 
@@ -39,6 +45,10 @@ var (
 	// headFastBlockKey tracks the latest known incomplete block's hash during fast sync.
 	headFastBlockKey = []byte("LastFast")
 
+	// headFinalizedBlockKey tracks the latest block considered final, which may
+	// never be reorged out.
+	headFinalizedBlockKey = []byte("LastFinalized")
+
 	// lastPivotKey tracks the last pivot block used by fast sync (to reenable on sVBGead).
 	lastPivotKey = []byte("LastPivot")
 
@@ -57,12 +67,21 @@ var (
 	// snapshotRecoveryKey tracks the snapshot recovery marker across restarts.
 	snapshotRecoveryKey = []byte("SnapshotRecovery")
 
+	// snapshotSyncStatusKey tracks the snapshot sync status across restarts.
+	snapshotSyncStatusKey = []byte("SnapshotSyncStatus")
+
 	// txIndexTailKey tracks the oldest block whose transactions have been indexed.
 	txIndexTailKey = []byte("TransactionIndexTail")
 
 	// fastTxLookupLimitKey tracks the transaction lookup limit during fast sync.
 	fastTxLookupLimitKey = []byte("FastTransactionLookupLimit")
 
+	// pruningTargetKey tracks the state root an offline state pruning is running for.
+	pruningTargetKey = []byte("PruningTarget")
+
+	// pruningProgressKey tracks the last database key swept by an offline state pruning.
+	pruningProgressKey = []byte("PruningProgress")
+
 	// Data item prefixes (use single byte to avoid mixing data types, avoid `i`, used for indexes).
 	headerPrefix       = []byte("h") // headerPrefix + num (uint64 big endian) + hash -> header
 	headerTDSuffix     = []byte("t") // headerPrefix + num (uint64 big endian) + hash + headerTDSuffix -> td
@@ -77,12 +96,15 @@ var (
 	SnapshotAccountPrefix = []byte("a") // SnapshotAccountPrefix + account hash -> account trie value
 	SnapshotStoragePrefix = []byte("o") // SnapshotStoragePrefix + account hash + storage hash -> storage trie value
 	codePrefix            = []byte("c") // codePrefix + code hash -> account code
+	blockTracesPrefix     = []byte("t") // blockTracesPrefix + num (uint64 big endian) + hash -> block traces
+	traceAddressPrefix    = []byte("x") // traceAddressPrefix + address + num (uint64 big endian) -> empty (address traced in block)
 
 	preimagePrefix = []byte("secure-key-")      // preimagePrefix + hash -> preimage
 	configPrefix   = []byte("vbgloble-config-") // config prefix for the db
 
 	// Chain index prefixes (use `i` + single byte to avoid mixing data types).
 	BloomBitsIndexPrefix = []byte("iB") // BloomBitsIndexPrefix is the data table of a chain indexer to track its progress
+	TraceIndexPrefix     = []byte("iT") // TraceIndexPrefix is the data table of the trace indexer to track its progress
 
 	preimageCounter    = metrics.NewRegisteredCounter("db/preimage/total", nil)
 	preimageHitCounter = metrics.NewRegisteredCounter("db/preimage/hits", nil)
@@ -105,9 +127,9 @@ const (
 	freezerDifficultyTable = "diffs"
 )
 
-// freezerNoSnappy configures whVBGer compression is disabled for the ancient-tables.
+// FreezerNoSnappy configures whVBGer compression is disabled for the ancient-tables.
 // Hashes and difficulties don't compress well.
-var freezerNoSnappy = map[string]bool{
+var FreezerNoSnappy = map[string]bool{
 	freezerHeaderTable:     false,
 	freezerHashTable:       true,
 	freezerBodiesTable:     false,
@@ -115,6 +137,14 @@ var freezerNoSnappy = map[string]bool{
 	freezerDifficultyTable: true,
 }
 
+// freezerPrunable configures which ancient-tables may be discarded from the tail
+// to expire old chain history. Hashes, headers and difficulties are retained to
+// keep the header chain intact.
+var freezerPrunable = map[string]bool{
+	freezerBodiesTable:  true,
+	freezerReceiptTable: true,
+}
+
 // LegacyTxLookupEntry is the legacy TxLookupEntry definition with some unnecessary
 // fields.
 type LegacyTxLookupEntry struct {
@@ -195,6 +225,16 @@ func bloomBitsKey(bit uint, section uint64, hash common.Hash) []byte {
 	return key
 }
 
+// blockTracesKey = blockTracesPrefix + num (uint64 big endian) + hash
+func blockTracesKey(number uint64, hash common.Hash) []byte {
+	return append(append(blockTracesPrefix, encodeBlockNumber(number)...), hash.Bytes()...)
+}
+
+// traceAddressKey = traceAddressPrefix + address + num (uint64 big endian)
+func traceAddressKey(address common.Address, number uint64) []byte {
+	return append(append(traceAddressPrefix, address.Bytes()...), encodeBlockNumber(number)...)
+}
+
 // preimageKey = preimagePrefix + hash
 func preimageKey(hash common.Hash) []byte {
 	return append(preimagePrefix, hash.Bytes()...)
@@ -214,6 +254,64 @@ func IsCodeKey(key []byte) (bool, []byte) {
 	return false, nil
 }
 
+// isStateKey reports whVBGer the given key belongs to the state key space, i.e.
+// trie nodes, contract codes, preimages and the snapshot with its metadata.
+func isStateKey(key []byte) bool {
+	switch {
+	case len(key) == common.HashLength:
+		return true
+	case bytes.HasPrefix(key, codePrefix) && len(key) == len(codePrefix)+common.HashLength:
+		return true
+	case bytes.HasPrefix(key, SnapshotAccountPrefix) && len(key) == len(SnapshotAccountPrefix)+common.HashLength:
+		return true
+	case bytes.HasPrefix(key, SnapshotStoragePrefix) && len(key) == len(SnapshotStoragePrefix)+2*common.HashLength:
+		return true
+	case bytes.HasPrefix(key, preimagePrefix) && len(key) == len(preimagePrefix)+common.HashLength:
+		return true
+	}
+	for _, meta := range [][]byte{snapshotRootKey, snapshotJournalKey, snapshotGeneratorKey, snapshotRecoveryKey, snapshotSyncStatusKey, pruningTargetKey, pruningProgressKey} {
+		if bytes.Equal(key, meta) {
+			return true
+		}
+	}
+	return false
+}
+
+// isStatePrefix reports whVBGer all keys starting with the given iteration
+// prefix belong to the state key space. The code prefix is shared with other
+// chain data (e.g. clique snapshots), so only the bare code prefix counts.
+func isStatePrefix(prefix []byte) bool {
+	if bytes.Equal(prefix, codePrefix) {
+		return true
+	}
+	for _, p := range [][]byte{SnapshotAccountPrefix, SnapshotStoragePrefix, preimagePrefix} {
+		if bytes.HasPrefix(prefix, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// isChainPrefix reports whVBGer no key starting with the given iteration prefix
+// belongs to the state key space. Trie nodes are keyed by their bare hash, so
+// any prefix not longer than a hash may match one.
+func isChainPrefix(prefix []byte) bool {
+	if len(prefix) <= common.HashLength {
+		return false
+	}
+	for _, p := range [][]byte{codePrefix, SnapshotAccountPrefix, SnapshotStoragePrefix, preimagePrefix} {
+		if bytes.HasPrefix(prefix, p) {
+			return false
+		}
+	}
+	for _, meta := range [][]byte{snapshotRootKey, snapshotJournalKey, snapshotGeneratorKey, snapshotRecoveryKey, snapshotSyncStatusKey, pruningTargetKey, pruningProgressKey} {
+		if bytes.HasPrefix(meta, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
 // configKey = configPrefix + hash
 func configKey(hash common.Hash) []byte {
 	return append(configPrefix, hash.Bytes()...)
@@ -0,0 +1,287 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package rawdb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/VBGdb"
+)
+
+var (
+	// errStateStoreMissing is returned if a database keeping its state in a
+	// dedicated key-value store is opened without it.
+	errStateStoreMissing = errors.New("database keeps state in a separate store, but none configured")
+
+	// errStateStoreUnsupported is returned if a dedicated state store is attached
+	// to a database already containing state data.
+	errStateStoreUnsupported = errors.New("database already contains state data, separate state store unsupported")
+)
+
+// statedb is a database wrapper that keeps the state key space (trie nodes,
+// contract codes, preimages and the snapshot) in a dedicated key-value store,
+// forwarding everything else to the chain database.
+type statedb struct {
+	VBGdb.Database                     // Chain database along with the ancient store
+	state          VBGdb.KeyValueStore // Key-value store holding the state key space
+}
+
+// NewDatabaseWithStateStore creates a high level database routing the state key
+// space into the given key-value store and everything else into the chain
+// database. A chain database which already holds state can't be split, and a
+// read-only one can only be opened if it was split upon creation.
+func NewDatabaseWithStateStore(db VBGdb.Database, state VBGdb.KeyValueStore, readonly bool) (VBGdb.Database, error) {
+	if !HasSeparateStateStore(db) {
+		if readonly || ReadHeadHeaderHash(db) != (common.Hash{}) {
+			return nil, errStateStoreUnsupported
+		}
+		writeSeparateStateStore(db)
+	}
+	return &statedb{Database: db, state: state}, nil
+}
+
+// CheckStateStore returns an error if the database was created with a dedicated
+// state store, which has not been attached.
+func CheckStateStore(db VBGdb.Database) error {
+	if _, ok := db.(*statedb); !ok && HasSeparateStateStore(db) {
+		return errStateStoreMissing
+	}
+	return nil
+}
+
+// store returns the key-value store responsible for the given key.
+func (db *statedb) store(key []byte) VBGdb.KeyValueStore {
+	if isStateKey(key) {
+		return db.state
+	}
+	return db.Database
+}
+
+// Close implements io.Closer, closing both the state and the chain database.
+func (db *statedb) Close() error {
+	serr := db.state.Close()
+	if err := db.Database.Close(); err != nil {
+		return err
+	}
+	return serr
+}
+
+// Has retrieves if a key is present in the store responsible for it.
+func (db *statedb) Has(key []byte) (bool, error) {
+	return db.store(key).Has(key)
+}
+
+// Get retrieves the given key from the store responsible for it.
+func (db *statedb) Get(key []byte) ([]byte, error) {
+	return db.store(key).Get(key)
+}
+
+// Put inserts the given value into the store responsible for the key.
+func (db *statedb) Put(key []byte, value []byte) error {
+	return db.store(key).Put(key, value)
+}
+
+// Delete removes the key from the store responsible for it.
+func (db *statedb) Delete(key []byte) error {
+	return db.store(key).Delete(key)
+}
+
+// NewIterator creates a binary-alphabetical iterator over a subset of database
+// content with a particular key prefix, starting at a particular initial key.
+// Prefixes confined to one key space only iterate the responsible store, the
+// rest (including most short prefixes, which may match trie nodes) merge the
+// contents of both.
+func (db *statedb) NewIterator(prefix []byte, start []byte) VBGdb.Iterator {
+	switch {
+	case isStatePrefix(prefix):
+		return db.state.NewIterator(prefix, start)
+	case isChainPrefix(prefix):
+		return db.Database.NewIterator(prefix, start)
+	default:
+		return &mergedIterator{
+			chain: db.Database.NewIterator(prefix, start),
+			state: db.state.NewIterator(prefix, start),
+		}
+	}
+}
+
+// Stat returns a particular internal stat of both the chain and state stores.
+func (db *statedb) Stat(property string) (string, error) {
+	chain, err := db.Database.Stat(property)
+	if err != nil {
+		return "", err
+	}
+	state, err := db.state.Stat(property)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Chain store:\n%s\nState store:\n%s", chain, state), nil
+}
+
+// Compact flattens the given key range in both the chain and state stores.
+func (db *statedb) Compact(start []byte, limit []byte) error {
+	if err := db.state.Compact(start, limit); err != nil {
+		return err
+	}
+	return db.Database.Compact(start, limit)
+}
+
+// NewBatch creates a write-only database batch spanning both stores.
+func (db *statedb) NewBatch() VBGdb.Batch {
+	return &stateBatch{
+		db:    db,
+		chain: db.Database.NewBatch(),
+		state: db.state.NewBatch(),
+	}
+}
+
+// stateBatch is a batch splitting its writes between the chain and the state
+// stores of a database.
+type stateBatch struct {
+	db    *statedb
+	chain VBGdb.Batch
+	state VBGdb.Batch
+}
+
+// batch returns the sub-batch responsible for the given key.
+func (b *stateBatch) batch(key []byte) VBGdb.Batch {
+	if isStateKey(key) {
+		return b.state
+	}
+	return b.chain
+}
+
+// Put inserts the given value into the sub-batch responsible for the key.
+func (b *stateBatch) Put(key, value []byte) error {
+	return b.batch(key).Put(key, value)
+}
+
+// Delete inserts a key removal into the sub-batch responsible for the key.
+func (b *stateBatch) Delete(key []byte) error {
+	return b.batch(key).Delete(key)
+}
+
+// ValueSize retrieves the amount of data queued up for writing.
+func (b *stateBatch) ValueSize() int {
+	return b.chain.ValueSize() + b.state.ValueSize()
+}
+
+// Write flushes any accumulated data to disk. State is written first, so chain
+// markers never reference missing state after a crash.
+func (b *stateBatch) Write() error {
+	if err := b.state.Write(); err != nil {
+		return err
+	}
+	return b.chain.Write()
+}
+
+// Reset resets the batch for reuse.
+func (b *stateBatch) Reset() {
+	b.chain.Reset()
+	b.state.Reset()
+}
+
+// Replay replays the batch contents.
+func (b *stateBatch) Replay(w VBGdb.KeyValueWriter) error {
+	if err := b.state.Replay(w); err != nil {
+		return err
+	}
+	return b.chain.Replay(w)
+}
+
+// mergedIterator iterates the union of the chain and state stores in binary-
+// alphabetical order. Keys present in both stores are only returned from the
+// one responsible for them.
+type mergedIterator struct {
+	chain, state     VBGdb.Iterator
+	chainOk, stateOk bool
+	current          VBGdb.Iterator
+	started          bool
+}
+
+// Next moves the iterator to the next key/value pair. It returns whVBGer the
+// iterator is exhausted.
+func (it *mergedIterator) Next() bool {
+	switch {
+	case !it.started:
+		it.chainOk, it.stateOk = it.chain.Next(), it.state.Next()
+		it.started = true
+	case it.current == it.chain:
+		it.chainOk = it.chain.Next()
+	case it.current == it.state:
+		it.stateOk = it.state.Next()
+	default:
+		return false
+	}
+	for it.chainOk && it.stateOk {
+		switch diff := bytes.Compare(it.chain.Key(), it.state.Key()); {
+		case diff < 0:
+			it.current = it.chain
+		case diff > 0:
+			it.current = it.state
+		case isStateKey(it.chain.Key()):
+			it.chainOk = it.chain.Next()
+			continue
+		default:
+			it.stateOk = it.state.Next()
+			continue
+		}
+		return true
+	}
+	switch {
+	case it.chainOk:
+		it.current = it.chain
+	case it.stateOk:
+		it.current = it.state
+	default:
+		it.current = nil
+	}
+	return it.current != nil
+}
+
+// Error returns any accumulated error of either sub-iterator.
+func (it *mergedIterator) Error() error {
+	if err := it.chain.Error(); err != nil {
+		return err
+	}
+	return it.state.Error()
+}
+
+// Key returns the key of the current key/value pair, or nil if done.
+func (it *mergedIterator) Key() []byte {
+	if it.current == nil {
+		return nil
+	}
+	return it.current.Key()
+}
+
+// Value returns the value of the current key/value pair, or nil if done.
+func (it *mergedIterator) Value() []byte {
+	if it.current == nil {
+		return nil
+	}
+	return it.current.Value()
+}
+
+// Release releases associated resources of both sub-iterators.
+func (it *mergedIterator) Release() {
+	it.chain.Release()
+	it.state.Release()
+}
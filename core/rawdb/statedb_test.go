@@ -0,0 +1,161 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package rawdb
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/VBGdb/memorydb"
+)
+
+// Tests that the state key space is routed into the dedicated state store and
+// everything else into the chain database.
+func TestStateStoreRouting(t *testing.T) {
+	var (
+		chaindb = NewMemoryDatabase()
+		statekv = memorydb.New()
+	)
+	db, err := NewDatabaseWithStateStore(chaindb, statekv, false)
+	if err != nil {
+		t.Fatalf("failed to attach state store: %v", err)
+	}
+	var (
+		hash   = common.Hash{0x01}
+		header = &types.Header{Number: common.Big1, Extra: []byte("test header")}
+	)
+	WriteTrieNode(db, hash, []byte{0x02})
+	WriteCode(db, hash, []byte{0x03})
+	WriteAccountSnapshot(db, hash, []byte{0x04})
+	WriteSnapshotRoot(db, hash)
+	WriteHeader(db, header)
+
+	batch := db.NewBatch()
+	WriteStorageSnapshot(batch, hash, hash, []byte{0x05})
+	WriteCanonicalHash(batch, header.Hash(), 1)
+	if err := batch.Write(); err != nil {
+		t.Fatalf("failed to write batch: %v", err)
+	}
+	// Ensure the entries landed in their stores and are retrievable
+	stateKeys := [][]byte{hash[:], codeKey(hash), accountSnapshotKey(hash), storageSnapshotKey(hash, hash), snapshotRootKey}
+	for _, key := range stateKeys {
+		if ok, _ := statekv.Has(key); !ok {
+			t.Errorf("state key %x missing from state store", key)
+		}
+		if ok, _ := chaindb.Has(key); ok {
+			t.Errorf("state key %x present in chain store", key)
+		}
+	}
+	chainKeys := [][]byte{headerKey(1, header.Hash()), headerHashKey(1), stateStoreKey}
+	for _, key := range chainKeys {
+		if ok, _ := chaindb.Has(key); !ok {
+			t.Errorf("chain key %x missing from chain store", key)
+		}
+		if ok, _ := statekv.Has(key); ok {
+			t.Errorf("chain key %x present in state store", key)
+		}
+	}
+	if blob := ReadCodeWithPrefix(db, hash); !bytes.Equal(blob, []byte{0x03}) {
+		t.Errorf("code mismatch: have %x, want %x", blob, []byte{0x03})
+	}
+	if have := ReadCanonicalHash(db, 1); have != header.Hash() {
+		t.Errorf("canonical hash mismatch: have %x, want %x", have, header.Hash())
+	}
+	// Ensure full iteration merges both stores in order, preferring the store
+	// responsible for duplicate keys
+	chaindb.Put(hash[:], []byte{0xff})
+
+	var want [][]byte
+	want = append(want, stateKeys...)
+	it := chaindb.NewIterator(nil, nil)
+	for it.Next() {
+		if !isStateKey(it.Key()) {
+			want = append(want, common.CopyBytes(it.Key()))
+		}
+	}
+	it.Release()
+	sort.Slice(want, func(i, j int) bool { return bytes.Compare(want[i], want[j]) < 0 })
+
+	var have [][]byte
+	it = db.NewIterator(nil, nil)
+	for it.Next() {
+		have = append(have, common.CopyBytes(it.Key()))
+		if bytes.Equal(it.Key(), hash[:]) && !bytes.Equal(it.Value(), []byte{0x02}) {
+			t.Errorf("duplicate key served from wrong store: have %x, want %x", it.Value(), []byte{0x02})
+		}
+	}
+	it.Release()
+	if len(have) != len(want) {
+		t.Fatalf("iterated key count mismatch: have %d, want %d", len(have), len(want))
+	}
+	for i := range have {
+		if !bytes.Equal(have[i], want[i]) {
+			t.Errorf("key %d mismatch: have %x, want %x", i, have[i], want[i])
+		}
+	}
+	// Ensure prefixed iteration only hits the responsible store
+	it = db.NewIterator(SnapshotAccountPrefix, nil)
+	if !it.Next() || !bytes.Equal(it.Key(), accountSnapshotKey(hash)) || it.Next() {
+		t.Errorf("account snapshot iteration mismatch")
+	}
+	it.Release()
+
+	// Ensure short prefixes also reach the trie nodes in the state store, and
+	// prefixes longer than a hash only the chain store
+	it = db.NewIterator(hash[:2], nil)
+	if !it.Next() || !bytes.Equal(it.Key(), hash[:]) || !bytes.Equal(it.Value(), []byte{0x02}) {
+		t.Errorf("trie node missing from prefixed iteration")
+	}
+	it.Release()
+
+	it = db.NewIterator(headerKey(1, header.Hash()), nil)
+	if !it.Next() || !bytes.Equal(it.Key(), headerKey(1, header.Hash())) || it.Next() {
+		t.Errorf("header iteration mismatch")
+	}
+	it.Release()
+}
+
+// Tests that a chain database can't be split after the fact, and that a split
+// database is rejected without its state store.
+func TestStateStoreMarker(t *testing.T) {
+	chaindb := NewMemoryDatabase()
+	WriteHeadHeaderHash(chaindb, common.Hash{0x01})
+	if _, err := NewDatabaseWithStateStore(chaindb, memorydb.New(), false); err != errStateStoreUnsupported {
+		t.Fatalf("populated database split: have %v, want %v", err, errStateStoreUnsupported)
+	}
+	chaindb = NewMemoryDatabase()
+	if _, err := NewDatabaseWithStateStore(chaindb, memorydb.New(), true); err != errStateStoreUnsupported {
+		t.Fatalf("read-only database split: have %v, want %v", err, errStateStoreUnsupported)
+	}
+	db, err := NewDatabaseWithStateStore(chaindb, memorydb.New(), false)
+	if err != nil {
+		t.Fatalf("failed to attach state store: %v", err)
+	}
+	if err := CheckStateStore(db); err != nil {
+		t.Fatalf("split database rejected: %v", err)
+	}
+	WriteHeadHeaderHash(db, common.Hash{0x01})
+	if err := CheckStateStore(chaindb); err != errStateStoreMissing {
+		t.Fatalf("missing state store accepted: have %v, want %v", err, errStateStoreMissing)
+	}
+	if _, err := NewDatabaseWithStateStore(chaindb, memorydb.New(), false); err != nil {
+		t.Fatalf("failed to reattach state store: %v", err)
+	}
+}
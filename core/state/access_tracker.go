@@ -0,0 +1,230 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package state
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/vbgloble/go-VGB/common"
+)
+
+// AccountAccess is a bitmask of the fields of an account which were accessed.
+type AccountAccess uint8
+
+const (
+	AccessBalance   AccountAccess = 1 << iota // Account balance
+	AccessNonce                               // Account nonce
+	AccessCode                                // Account code
+	AccessExistence                           // Existence of the account, along with its storage
+
+	accessAll = AccessBalance | AccessNonce | AccessCode | AccessExistence
+)
+
+// AccessSet is the set of accounts fields and storage slots read or written by
+// one or more transactions.
+type AccessSet struct {
+	Accounts map[common.Address]AccountAccess
+	Slots    map[common.Address]map[common.Hash]struct{}
+}
+
+// NewAccessSet creates an empty access set.
+func NewAccessSet() *AccessSet {
+	return &AccessSet{
+		Accounts: make(map[common.Address]AccountAccess),
+		Slots:    make(map[common.Address]map[common.Hash]struct{}),
+	}
+}
+
+// addAccount marks the given fields of an account accessed.
+func (set *AccessSet) addAccount(addr common.Address, access AccountAccess) {
+	set.Accounts[addr] |= access
+}
+
+// addSlot marks a storage slot of an account accessed.
+func (set *AccessSet) addSlot(addr common.Address, slot common.Hash) {
+	slots, ok := set.Slots[addr]
+	if !ok {
+		slots = make(map[common.Hash]struct{})
+		set.Slots[addr] = slots
+	}
+	slots[slot] = struct{}{}
+}
+
+// Merge adds all accesses of another set into this one.
+func (set *AccessSet) Merge(other *AccessSet) {
+	for addr, access := range other.Accounts {
+		set.addAccount(addr, access)
+	}
+	for addr, slots := range other.Slots {
+		for slot := range slots {
+			set.addSlot(addr, slot)
+		}
+	}
+}
+
+// Conflicts reports whVBGer any of the state read by this set was modified by
+// the given write set. Storage reads also conflict with the account being
+// created or destructed, as that resets its storage.
+func (set *AccessSet) Conflicts(writes *AccessSet) bool {
+	for addr, access := range set.Accounts {
+		if writes.Accounts[addr]&access != 0 {
+			return true
+		}
+	}
+	for addr, slots := range set.Slots {
+		if writes.Accounts[addr]&AccessExistence != 0 {
+			return true
+		}
+		written, ok := writes.Slots[addr]
+		if !ok {
+			continue
+		}
+		for slot := range slots {
+			if _, ok := written[slot]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// accessTracker records the state accessed through a StateDB, so that a
+// transaction executed speculatively can be checked for conflicts and its
+// changes replayed onto another state.
+type accessTracker struct {
+	reads   *AccessSet                                  // Account fields and storage slots read
+	dirties map[common.Address]struct{}                 // Accounts modified, collected upon finalisation
+	slots   map[common.Address]map[common.Hash]struct{} // Storage slots written
+	created map[common.Address]*stateObject             // Accounts explicitly (re)created
+}
+
+// newAccessTracker creates an empty access tracker.
+func newAccessTracker() *accessTracker {
+	return &accessTracker{
+		reads:   NewAccessSet(),
+		dirties: make(map[common.Address]struct{}),
+		slots:   make(map[common.Address]map[common.Hash]struct{}),
+		created: make(map[common.Address]*stateObject),
+	}
+}
+
+// StartAccessTracking starts recording all subsequent state accesses, dropping
+// any previously recorded ones.
+func (s *StateDB) StartAccessTracking() {
+	s.tracker = newAccessTracker()
+}
+
+// StopAccessTracking stops recording state accesses.
+func (s *StateDB) StopAccessTracking() {
+	s.tracker = nil
+}
+
+// AccessedReads returns the account fields and storage slots read since access
+// tracking was started, or nil if it's disabled.
+func (s *StateDB) AccessedReads() *AccessSet {
+	if s.tracker == nil {
+		return nil
+	}
+	return s.tracker.reads
+}
+
+// AccessedWrites returns the state modified since access tracking was started,
+// or nil if it's disabled. All fields of a modified account are reported.
+func (s *StateDB) AccessedWrites() *AccessSet {
+	if s.tracker == nil {
+		return nil
+	}
+	writes := NewAccessSet()
+	for addr := range s.tracker.dirties {
+		writes.addAccount(addr, accessAll)
+	}
+	for addr, slots := range s.tracker.slots {
+		for slot := range slots {
+			writes.addSlot(addr, slot)
+		}
+	}
+	return writes
+}
+
+// ApplyAccessedWrites replays the finalised changes tracked by src, relative to
+// the base state it was copied from, onto this state. Balances are applied as
+// deltas and touched accounts are touched again, so changes to state that src
+// didn't read are merged rather than overwritten. The precise set of modified
+// state is returned.
+//
+// The caller must ensure src didn't read any state modified in this state since
+// base, otherwise the result is undefined.
+func (s *StateDB) ApplyAccessedWrites(src *StateDB, base *StateDB) *AccessSet {
+	writes := NewAccessSet()
+	for addr := range src.tracker.dirties {
+		obj := src.stateObjects[addr]
+		if obj == nil {
+			continue
+		}
+		var (
+			prev   = base.getStateObject(addr)
+			access AccountAccess
+		)
+		if src.tracker.created[addr] == obj {
+			s.CreateAccount(addr)
+			access |= accessAll
+		}
+		if obj.suicided {
+			s.Suicide(addr)
+			writes.addAccount(addr, accessAll)
+			continue
+		}
+		if obj.deleted == (prev != nil) {
+			access |= accessAll
+		}
+		var (
+			balance  = new(big.Int)
+			nonce    uint64
+			codeHash = emptyCodeHash
+		)
+		if prev != nil {
+			balance, nonce, codeHash = prev.Balance(), prev.Nonce(), prev.CodeHash()
+		}
+		switch delta := new(big.Int).Sub(obj.Balance(), balance); delta.Sign() {
+		case 1:
+			s.AddBalance(addr, delta)
+			access |= AccessBalance
+		case -1:
+			s.SubBalance(addr, delta.Neg(delta))
+			access |= AccessBalance
+		default:
+			s.AddBalance(addr, delta) // Touch the account
+		}
+		if obj.Nonce() != nonce {
+			s.SetNonce(addr, obj.Nonce())
+			access |= AccessNonce
+		}
+		if !bytes.Equal(obj.CodeHash(), codeHash) {
+			s.SetCode(addr, obj.Code(src.db))
+			access |= AccessCode
+		}
+		for slot := range src.tracker.slots[addr] {
+			s.SetState(addr, slot, obj.GetState(src.db, slot))
+			writes.addSlot(addr, slot)
+		}
+		if access != 0 {
+			writes.addAccount(addr, access)
+		}
+	}
+	return writes
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/vbgloble/go-VGB/common"
 	"github.com/vbgloble/go-VGB/core/rawdb"
 	"github.com/vbgloble/go-VGB/VBGdb"
+	"github.com/vbgloble/go-VGB/VBGdb/memorydb"
 	"github.com/vbgloble/go-VGB/trie"
 	lru "github.com/hashicorp/golang-lru"
 )
@@ -121,6 +122,19 @@ func NewDatabaseWithConfig(db VBGdb.Database, config *trie.Config) Database {
 	}
 }
 
+// NewVolatileDatabase creates a backing store for state which reads through to
+// the given database, but keeps everything written to it - trie nodes, preimages
+// and contract code alike - in memory. It allows regenerating and committing
+// historical state on top of a database opened read-only.
+func NewVolatileDatabase(db VBGdb.Database, config *trie.Config) Database {
+	csc, _ := lru.New(codeSizeCacheSize)
+	return &cachingDB{
+		db:            trie.NewDatabaseWithConfig(&volatileStore{disk: db, memory: memorydb.New()}, config),
+		codeSizeCache: csc,
+		codeCache:     fastcache.New(codeCacheSize),
+	}
+}
+
 type cachingDB struct {
 	db            *trie.Database
 	codeSizeCache *lru.Cache
@@ -190,3 +204,62 @@ func (db *cachingDB) ContractCodeSize(addrHash, codeHash common.Hash) (int, erro
 func (db *cachingDB) TrieDB() *trie.Database {
 	return db.db
 }
+
+// volatileStore is a key-value store layering an in-memory write buffer on top
+// of a persistent database, which is only ever read from.
+type volatileStore struct {
+	disk   VBGdb.KeyValueStore
+	memory *memorydb.Database
+}
+
+// Has retrieves if a key is present in either the write buffer or the database.
+func (s *volatileStore) Has(key []byte) (bool, error) {
+	if ok, _ := s.memory.Has(key); ok {
+		return true, nil
+	}
+	return s.disk.Has(key)
+}
+
+// Get retrieves the given key from the write buffer, falling back to the database.
+func (s *volatileStore) Get(key []byte) ([]byte, error) {
+	if blob, err := s.memory.Get(key); err == nil {
+		return blob, nil
+	}
+	return s.disk.Get(key)
+}
+
+// Put inserts the given value into the write buffer.
+func (s *volatileStore) Put(key []byte, value []byte) error {
+	return s.memory.Put(key, value)
+}
+
+// Delete removes the key from the write buffer. Any value in the database is
+// left untouched and remains visible.
+func (s *volatileStore) Delete(key []byte) error {
+	return s.memory.Delete(key)
+}
+
+// NewBatch creates a batch which writes into the write buffer.
+func (s *volatileStore) NewBatch() VBGdb.Batch {
+	return s.memory.NewBatch()
+}
+
+// NewIterator iterates over the database only, the write buffer is not merged in.
+func (s *volatileStore) NewIterator(prefix []byte, start []byte) VBGdb.Iterator {
+	return s.disk.NewIterator(prefix, start)
+}
+
+// Stat returns a particular internal stat of the database.
+func (s *volatileStore) Stat(property string) (string, error) {
+	return s.disk.Stat(property)
+}
+
+// Compact is a noop, the database is never modified.
+func (s *volatileStore) Compact(start []byte, limit []byte) error {
+	return nil
+}
+
+// Close releases the write buffer, the database is left open for its owner.
+func (s *volatileStore) Close() error {
+	return s.memory.Close()
+}
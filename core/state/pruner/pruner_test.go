@@ -0,0 +1,101 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package pruner
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/consensus/VBGash"
+	"github.com/vbgloble/go-VGB/core"
+	"github.com/vbgloble/go-VGB/core/rawdb"
+	"github.com/vbgloble/go-VGB/core/vm"
+	"github.com/vbgloble/go-VGB/params"
+)
+
+// Tests that pruning removes all the stale state, but retains the target and
+// the genesis state, and that a dry run doesn't touch the database.
+func TestPruneState(t *testing.T) {
+	datadir, err := ioutil.TempDir("", "pruner-test")
+	if err != nil {
+		t.Fatalf("failed to create temporary datadir: %v", err)
+	}
+	defer os.RemoveAll(datadir)
+
+	var (
+		db      = rawdb.NewMemoryDatabase()
+		gspec   = &core.Genesis{Config: params.TestChainConfig, Alloc: core.GenesisAlloc{{1}: {Balance: big.NewInt(1)}}}
+		genesis = gspec.MustCommit(db)
+	)
+	blocks, _ := core.GenerateChain(params.TestChainConfig, genesis, VBGash.NewFaker(), db, 8, func(i int, b *core.BlockGen) {
+		b.SetCoinbase(common.Address{byte(i + 2)})
+	})
+	config := &core.CacheConfig{
+		TrieCleanLimit:    256,
+		TrieDirtyDisabled: true,
+		SnapshotLimit:     256,
+		SnapshotWait:      true,
+	}
+	chain, err := core.NewBlockChain(db, config, params.TestChainConfig, VBGash.NewFaker(), vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create chain: %v", err)
+	}
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+	chain.Stop()
+
+	var (
+		stale  = blocks[3].Root()
+		target = blocks[len(blocks)-1].Root()
+	)
+	pruner, err := NewPruner(db, datadir, filepath.Join(datadir, "triecache"), 256)
+	if err != nil {
+		t.Fatalf("failed to create pruner: %v", err)
+	}
+	count, size, err := pruner.DryRun(target)
+	if err != nil {
+		t.Fatalf("failed to dry run pruning: %v", err)
+	}
+	if count == 0 || size == 0 {
+		t.Fatalf("dry run found nothing to prune: count %d, size %v", count, size)
+	}
+	if len(rawdb.ReadTrieNode(db, stale)) == 0 {
+		t.Fatalf("dry run deleted stale state")
+	}
+	if err := pruner.Prune(target); err != nil {
+		t.Fatalf("failed to prune state: %v", err)
+	}
+	if len(rawdb.ReadTrieNode(db, stale)) != 0 {
+		t.Fatalf("stale state root not pruned")
+	}
+	for _, root := range []common.Hash{genesis.Root(), target} {
+		if len(rawdb.ReadTrieNode(db, root)) == 0 {
+			t.Fatalf("state root %x pruned", root)
+		}
+	}
+	if root := rawdb.ReadPruningTarget(db); root != (common.Hash{}) {
+		t.Fatalf("pruning target not cleared: %x", root)
+	}
+	if found, _ := filepath.Glob(filepath.Join(datadir, stateBloomFilePrefix+"*")); len(found) != 0 {
+		t.Fatalf("state bloom not removed: %v", found)
+	}
+}
@@ -265,6 +265,14 @@ func (dl *diffLayer) Parent() snapshot {
 	return dl.parent
 }
 
+// ParentRoot returns the root hash of the state this diff layer was built on.
+func (dl *diffLayer) ParentRoot() common.Hash {
+	dl.lock.RLock()
+	defer dl.lock.RUnlock()
+
+	return dl.parent.Root()
+}
+
 // Stale return whVBGer this layer has become stale (was flattened across) or if
 // it's still live.
 func (dl *diffLayer) Stale() bool {
@@ -114,6 +114,23 @@ type Snapshot interface {
 	Storage(accountHash, storageHash common.Hash) ([]byte, error)
 }
 
+// DiffLayer is the read only view of a single in-memory diff layer, exposing the
+// accounts and storage slots modified by the block it belongs to.
+type DiffLayer interface {
+	Snapshot
+
+	// ParentRoot returns the root hash of the state this diff layer was built on.
+	ParentRoot() common.Hash
+
+	// AccountList returns a sorted list of all accounts in this diff layer,
+	// including the deleted ones.
+	AccountList() []common.Hash
+
+	// StorageList returns a sorted list of all storage slots in this diff layer
+	// for the given account, and whVBGer the account's storage was destructed.
+	StorageList(accountHash common.Hash) ([]common.Hash, bool)
+}
+
 // snapshot is the internal version of the snapshot data layer that supports some
 // additional mVBGods compared to the public API.
 type snapshot interface {
@@ -173,12 +190,13 @@ type Tree struct {
 // store (with a number of memory layers from a journal), ensuring that the head
 // of the snapshot matches the expected one.
 //
-// If the snapshot is missing or the disk layer is broken, the entire is deleted
-// and will be reconstructed from scratch based on the tries in the key-value
-// store, on a background thread. If the memory layers from the journal is not
-// continuous with disk layer or the journal is missing, all diffs will be discarded
-// iff it's in "recovery" mode, otherwise rebuild is mandatory.
-func New(diskdb VBGdb.KeyValueStore, triedb *trie.Database, cache int, root common.Hash, async bool, recovery bool) *Tree {
+// If the snapshot is missing or the disk layer is broken, the snapshot will be
+// reconstructed using both the existing data and the state trie, on a background
+// thread if rebuilding is allowed, otherwise the error is returned. If the memory
+// layers from the journal is not continuous with disk layer or the journal is
+// missing, all diffs will be discarded iff it's in "recovery" mode, otherwise
+// rebuild is mandatory.
+func New(diskdb VBGdb.KeyValueStore, triedb *trie.Database, cache int, root common.Hash, async bool, rebuild bool, recovery bool) (*Tree, error) {
 	// Create a new, empty snapshot tree
 	snap := &Tree{
 		diskdb: diskdb,
@@ -192,16 +210,19 @@ func New(diskdb VBGdb.KeyValueStore, triedb *trie.Database, cache int, root comm
 	// Attempt to load a previously persisted snapshot and rebuild one if failed
 	head, err := loadSnapshot(diskdb, triedb, cache, root, recovery)
 	if err != nil {
+		if !rebuild {
+			return nil, err
+		}
 		log.Warn("Failed to load snapshot, regenerating", "err", err)
 		snap.Rebuild(root)
-		return snap
+		return snap, nil
 	}
 	// Existing snapshot loaded, seed all the layers
 	for head != nil {
 		snap.layers[head.Root()] = head
 		head = head.Parent()
 	}
-	return snap
+	return snap, nil
 }
 
 // waitBuild blocks until the snapshot finishes rebuilding. This mVBGod is meant
@@ -234,6 +255,51 @@ func (t *Tree) Snapshot(blockRoot common.Hash) Snapshot {
 	return t.layers[blockRoot]
 }
 
+// Snapshots returns all visited layers from the topmost layer with specific
+// root and traverses downward. The layer amount is limited by the given number.
+// If nodisk is set, then disk layer is excluded.
+func (t *Tree) Snapshots(root common.Hash, limits int, nodisk bool) []Snapshot {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	if limits == 0 {
+		return nil
+	}
+	layer := t.layers[root]
+	if layer == nil {
+		return nil
+	}
+	var ret []Snapshot
+	for {
+		if _, isdisk := layer.(*diskLayer); isdisk && nodisk {
+			break
+		}
+		ret = append(ret, layer)
+		limits -= 1
+		if limits == 0 {
+			break
+		}
+		parent := layer.Parent()
+		if parent == nil {
+			break
+		}
+		layer = parent
+	}
+	return ret
+}
+
+// Diff retrieves the diff layer belonging to the given block root, or nil if the
+// root is not tracked by a diff layer (unknown, or already flattened into disk).
+func (t *Tree) Diff(blockRoot common.Hash) DiffLayer {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	if diff, ok := t.layers[blockRoot].(*diffLayer); ok {
+		return diff
+	}
+	return nil
+}
+
 // Update adds a new snapshot into the tree, if that can be linked to an existing
 // old parent. It is disallowed to insert a disk layer (the origin of all).
 func (t *Tree) Update(blockRoot common.Hash, parentRoot common.Hash, destructs map[common.Hash]struct{}, accounts map[common.Hash][]byte, storage map[common.Hash]map[common.Hash][]byte) error {
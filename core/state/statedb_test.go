@@ -32,6 +32,7 @@ import (
 	"github.com/vbgloble/go-VGB/common"
 	"github.com/vbgloble/go-VGB/core/rawdb"
 	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/crypto"
 )
 
 // Tests that updating a state trie does not leak any database writes prior to
@@ -695,6 +696,66 @@ func TestDeleteCreateRevert(t *testing.T) {
 	}
 }
 
+// Tests that committing state on top of a volatile database keeps all writes in
+// memory, while the state remains accessible through it.
+func TestVolatileDatabaseCommit(t *testing.T) {
+	// Create a persisted state to build on top of
+	var (
+		db    = rawdb.NewMemoryDatabase()
+		addr  = toAddr([]byte("so"))
+		code  = []byte{0x60, 0x00, 0x60, 0x00, 0xf3}
+		count = func() (n int) {
+			it := db.NewIterator(nil, nil)
+			defer it.Release()
+			for it.Next() {
+				n++
+			}
+			return n
+		}
+	)
+	state, _ := New(common.Hash{}, NewDatabase(db), nil)
+	state.SetBalance(addr, big.NewInt(1))
+	root, _ := state.Commit(false)
+	state.Database().TrieDB().Commit(root, false, nil)
+	entries := count()
+
+	// Deploy code and modify storage through a volatile database, committing all
+	volatile := NewVolatileDatabase(db, nil)
+	state, err := New(root, volatile, nil)
+	if err != nil {
+		t.Fatalf("failed to open persisted state: %v", err)
+	}
+	state.SetCode(addr, code)
+	state.SetState(addr, common.Hash{1}, common.Hash{2})
+	root, err = state.Commit(false)
+	if err != nil {
+		t.Fatalf("failed to commit state: %v", err)
+	}
+	if err := volatile.TrieDB().Commit(root, false, nil); err != nil {
+		t.Fatalf("failed to commit trie: %v", err)
+	}
+	if have := count(); have != entries {
+		t.Fatalf("database modified: have %d entries, want %d", have, entries)
+	}
+	if blob := rawdb.ReadCode(db, crypto.Keccak256Hash(code)); len(blob) != 0 {
+		t.Fatalf("code written to database")
+	}
+	// Reopen the committed state and ensure it's complete
+	state, err = New(root, NewVolatileDatabase(db, nil), nil)
+	if err == nil {
+		t.Fatalf("volatile state leaked into a fresh database")
+	}
+	if state, err = New(root, volatile, nil); err != nil {
+		t.Fatalf("failed to reopen committed state: %v", err)
+	}
+	if have := state.GetCode(addr); !bytes.Equal(have, code) {
+		t.Fatalf("code mismatch: have %x, want %x", have, code)
+	}
+	if have := state.GetState(addr, common.Hash{1}); have != (common.Hash{2}) {
+		t.Fatalf("storage mismatch: have %x, want %x", have, common.Hash{2})
+	}
+}
+
 // TestMissingTrieNodes tests that if the StateDB fails to load parts of the trie,
 // the Commit operation fails with an error
 // If we are missing trie nodes, we should not continue writing to the trie
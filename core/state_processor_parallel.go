@@ -0,0 +1,202 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"github.com/vbgloble/go-VGB/consensus"
+	"github.com/vbgloble/go-VGB/consensus/misc"
+	"github.com/vbgloble/go-VGB/core/state"
+	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/core/vm"
+	"github.com/vbgloble/go-VGB/metrics"
+	"github.com/vbgloble/go-VGB/params"
+)
+
+var (
+	parallelTxMeter          = metrics.NewRegisteredMeter("chain/parallel/txs", nil)
+	parallelConflictMeter    = metrics.NewRegisteredMeter("chain/parallel/conflicts", nil)
+	parallelConflictRateHist = metrics.NewRegisteredHistogram("chain/parallel/conflictrate", nil, metrics.NewExpDecaySample(1028, 0.015))
+)
+
+// ParallelStateProcessor is a Processor executing the transactions of a block
+// optimistically in parallel. Every transaction is run speculatively on its own
+// copy of the block's pre-state, tracking the state it reads and writes. The
+// results are then merged in order, re-executing any transaction which read
+// state modified by a preceding one, yielding results identical to sequential
+// execution.
+//
+// ParallelStateProcessor implements Processor.
+type ParallelStateProcessor struct {
+	config     *params.ChainConfig // Chain configuration options
+	bc         processorChain      // Canonical block chain
+	engine     consensus.Engine    // Consensus engine used for block rewards
+	workers    int                 // Number of transactions to execute concurrently
+	sequential *StateProcessor     // Fallback for blocks unsuitable for parallel execution
+}
+
+// NewParallelStateProcessor initialises a new ParallelStateProcessor.
+func NewParallelStateProcessor(config *params.ChainConfig, bc *BlockChain, engine consensus.Engine, workers int) *ParallelStateProcessor {
+	return &ParallelStateProcessor{
+		config:     config,
+		bc:         bc,
+		engine:     engine,
+		workers:    workers,
+		sequential: NewStateProcessor(config, bc, engine),
+	}
+}
+
+// speculation is the result of executing a transaction on a private copy of
+// the block's pre-state.
+type speculation struct {
+	state   *state.StateDB // State copy the transaction was executed on
+	receipt *types.Receipt // Receipt of the transaction, sans cumulative gas
+	err     error          // Error aborting the execution, if any
+}
+
+// Process processes the state changes according to the vbgloble rules, executing
+// the transactions of the block in parallel where possible, and applying any
+// rewards to both the processor (coinbase) and any included uncles.
+//
+// Pre-Byzantium blocks, which require the intermediate state root after every
+// transaction, and blocks processed with debugging or preimage recording enabled
+// are processed sequentially.
+func (p *ParallelStateProcessor) Process(block *types.Block, statedb *state.StateDB, cfg vm.Config) (types.Receipts, []*types.Log, uint64, error) {
+	txs := block.Transactions()
+	if p.workers < 2 || len(txs) < 2 || !p.config.IsByzantium(block.Number()) || cfg.Debug || cfg.EnablePreimageRecording {
+		return p.sequential.Process(block, statedb, cfg)
+	}
+	var (
+		receipts = make(types.Receipts, 0, len(txs))
+		usedGas  = new(uint64)
+		header   = block.Header()
+		allLogs  []*types.Log
+		gp       = new(GasPool).AddGas(block.GasLimit())
+		signer   = types.MakeSigner(p.config, header.Number)
+	)
+	// Mutate the block and state according to any hard-fork specs
+	if p.config.DAOForkSupport && p.config.DAOForkBlock != nil && p.config.DAOForkBlock.Cmp(block.Number()) == 0 {
+		misc.ApplyDAOHardFork(statedb)
+	}
+	// Start executing all the transactions speculatively on copies of the
+	// pre-state. The base state is never modified, so it can be copied
+	// concurrently; a separate copy serves lookups during merging.
+	var (
+		base    = statedb.Copy()
+		pre     = statedb.Copy()
+		tasks   = make(chan int, len(txs))
+		results = make([]chan *speculation, len(txs))
+		abort   = make(chan struct{})
+	)
+	defer close(abort)
+
+	for i := range txs {
+		tasks <- i
+		results[i] = make(chan *speculation, 1)
+	}
+	close(tasks)
+
+	workers := p.workers
+	if workers > len(txs) {
+		workers = len(txs)
+	}
+	for n := 0; n < workers; n++ {
+		go func() {
+			for i := range tasks {
+				select {
+				case <-abort:
+					return
+				default:
+				}
+				results[i] <- p.speculate(block, base, i, signer, cfg)
+			}
+		}()
+	}
+	// Merge the speculative results in order, re-executing conflicting ones
+	var (
+		vmenv     = vm.NewEVM(NewEVMBlockContext(header, p.bc, nil), vm.TxContext{}, statedb, p.config, cfg)
+		written   = state.NewAccessSet()
+		conflicts int
+	)
+	for i, tx := range txs {
+		spec := <-results[i]
+
+		statedb.Prepare(tx.Hash(), block.Hash(), i)
+		if spec.err == nil && !spec.state.AccessedReads().Conflicts(written) && gp.SubGas(tx.Gas()) == nil {
+			gp.AddGas(tx.Gas() - spec.receipt.GasUsed)
+
+			written.Merge(statedb.ApplyAccessedWrites(spec.state, pre))
+			for _, log := range spec.receipt.Logs {
+				statedb.AddLog(log)
+			}
+			statedb.Finalise(true)
+
+			*usedGas += spec.receipt.GasUsed
+			spec.receipt.CumulativeGasUsed = *usedGas
+
+			receipts = append(receipts, spec.receipt)
+			allLogs = append(allLogs, spec.receipt.Logs...)
+			continue
+		}
+		// The speculative execution is invalid, execute the transaction on the
+		// live state instead
+		conflicts++
+
+		msg, err := tx.AsMessage(signer, header.BaseFee)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		statedb.StartAccessTracking()
+		receipt, err := applyTransaction(msg, p.config, p.bc, nil, gp, statedb, header, tx, usedGas, vmenv)
+		writes := statedb.AccessedWrites()
+		statedb.StopAccessTracking()
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		written.Merge(writes)
+
+		receipts = append(receipts, receipt)
+		allLogs = append(allLogs, receipt.Logs...)
+	}
+	parallelTxMeter.Mark(int64(len(txs)))
+	parallelConflictMeter.Mark(int64(conflicts))
+	parallelConflictRateHist.Update(int64(conflicts * 100 / len(txs)))
+
+	// Finalize the block, applying any consensus engine specific extras (e.g. block rewards)
+	p.engine.Finalize(p.bc, header, statedb, txs, block.Uncles())
+
+	return receipts, allLogs, *usedGas, nil
+}
+
+// speculate executes the i-th transaction of a block on a private copy of the
+// block's pre-state, tracking the state accessed.
+func (p *ParallelStateProcessor) speculate(block *types.Block, base *state.StateDB, i int, signer types.Signer, cfg vm.Config) *speculation {
+	var (
+		tx      = block.Transactions()[i]
+		header  = block.Header()
+		statedb = base.Copy()
+	)
+	msg, err := tx.AsMessage(signer, header.BaseFee)
+	if err != nil {
+		return &speculation{err: err}
+	}
+	statedb.StartAccessTracking()
+	statedb.Prepare(tx.Hash(), block.Hash(), i)
+
+	vmenv := vm.NewEVM(NewEVMBlockContext(header, p.bc, nil), vm.TxContext{}, statedb, p.config, cfg)
+	receipt, err := applyTransaction(msg, p.config, p.bc, nil, new(GasPool).AddGas(block.GasLimit()), statedb, header, tx, new(uint64), vmenv)
+	return &speculation{state: statedb, receipt: receipt, err: err}
+}
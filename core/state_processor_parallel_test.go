@@ -0,0 +1,124 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/consensus/VBGash"
+	"github.com/vbgloble/go-VGB/core/rawdb"
+	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/core/vm"
+	"github.com/vbgloble/go-VGB/crypto"
+	"github.com/vbgloble/go-VGB/params"
+)
+
+// Tests that executing blocks in parallel yields the same results as executing
+// them sequentially, even if the transactions heavily depend on each other.
+func TestParallelStateProcessor(t *testing.T) {
+	var (
+		keys  = make([]*ecdsa.PrivateKey, 8)
+		addrs = make([]common.Address, len(keys))
+		funds = new(big.Int).Mul(big.NewInt(1000), big.NewInt(params.VBGer))
+		alloc = GenesisAlloc{
+			// Increments a shared counter: PUSH1 0 SLOAD PUSH1 1 ADD PUSH1 0 SSTORE
+			common.Address{0x01, 0x01}: {Code: common.FromHex("0x600054600101600055"), Balance: common.Big0},
+			// Increments a counter per caller: CALLER SLOAD PUSH1 1 ADD CALLER SSTORE
+			common.Address{0x01, 0x02}: {Code: common.FromHex("0x33546001013355"), Balance: common.Big0},
+			// Emits an empty log: PUSH1 0 PUSH1 0 LOG0
+			common.Address{0x01, 0x03}: {Code: common.FromHex("0x60006000a0"), Balance: common.Big0},
+			// Stores the balance of the coinbase: COINBASE BALANCE PUSH1 0 SSTORE
+			common.Address{0x01, 0x04}: {Code: common.FromHex("0x4131600055"), Balance: common.Big0},
+			// Self destructs to the caller: CALLER SELFDESTRUCT
+			common.Address{0x01, 0x05}: {Code: common.FromHex("0x33ff"), Balance: big.NewInt(params.VBGer)},
+		}
+	)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		addrs[i] = crypto.PubkeyToAddress(keys[i].PublicKey)
+		alloc[addrs[i]] = GenesisAccount{Balance: funds}
+	}
+	var (
+		gspec   = &Genesis{Config: params.TestChainConfig, Alloc: alloc}
+		gendb   = rawdb.NewMemoryDatabase()
+		genesis = gspec.MustCommit(gendb)
+		signer  = types.LatestSigner(gspec.Config)
+	)
+	blocks, _ := GenerateChain(gspec.Config, genesis, VBGash.NewFaker(), gendb, 8, func(i int, b *BlockGen) {
+		// Make every other block heavily interdependent by paying the fees to
+		// one of the senders and chaining transfers between them
+		dependent := i%2 == 1
+		if dependent {
+			b.SetCoinbase(addrs[i%len(addrs)])
+		}
+
+		for j, key := range keys {
+			var (
+				to    = common.Address{0x01, byte(1 + (i+j)%5)}
+				value = new(big.Int)
+			)
+			if (i+j)%6 == 5 {
+				// Send funds to a fresh account
+				to, value = common.Address{0x02, byte(i), byte(j)}, big.NewInt(params.GWei)
+			}
+			tx, err := types.SignTx(types.NewTransaction(b.TxNonce(addrs[j]), to, value, 100000, big.NewInt(params.GWei), nil), signer, key)
+			if err != nil {
+				t.Fatalf("failed to sign transaction: %v", err)
+			}
+			b.AddTx(tx)
+
+			if !dependent {
+				continue
+			}
+			// Send funds to the next sender, which spends them afterwards
+			tx, err = types.SignTx(types.NewTransaction(b.TxNonce(addrs[j]), addrs[(j+1)%len(addrs)], big.NewInt(params.VBGer), params.TxGas, big.NewInt(params.GWei), nil), signer, key)
+			if err != nil {
+				t.Fatalf("failed to sign transaction: %v", err)
+			}
+			b.AddTx(tx)
+		}
+	})
+	// Import the chain both sequentially and in parallel
+	seqdb, pardb := rawdb.NewMemoryDatabase(), rawdb.NewMemoryDatabase()
+	gspec.MustCommit(seqdb)
+	gspec.MustCommit(pardb)
+
+	seqchain, _ := NewBlockChain(seqdb, nil, gspec.Config, VBGash.NewFaker(), vm.Config{}, nil, nil)
+	defer seqchain.Stop()
+	if _, err := seqchain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to import chain sequentially: %v", err)
+	}
+	parchain, _ := NewBlockChain(pardb, nil, gspec.Config, VBGash.NewFaker(), vm.Config{}, nil, nil)
+	defer parchain.Stop()
+	parchain.importer = NewParallelStateProcessor(gspec.Config, parchain, parchain.engine, 4)
+	if _, err := parchain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to import chain in parallel: %v", err)
+	}
+	// State roots, receipt roots and gas usage are validated upon import, ensure
+	// the remaining receipt fields and the logs match too
+	for _, block := range blocks {
+		want := seqchain.GetReceiptsByHash(block.Hash())
+		have := parchain.GetReceiptsByHash(block.Hash())
+		if !reflect.DeepEqual(have, want) {
+			t.Fatalf("block %d: receipts mismatch", block.NumberU64())
+		}
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"fmt"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/consensus"
+	"github.com/vbgloble/go-VGB/core/state"
+	"github.com/vbgloble/go-VGB/core/stateless"
+	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/core/vm"
+	"github.com/vbgloble/go-VGB/params"
+	"github.com/vbgloble/go-VGB/trie"
+)
+
+// RecordWitness re-executes a block on top of its parent state, recording every
+// trie node, contract code and ancestor header accessed into an execution
+// witness, which is sufficient to verify the block without holding the state.
+func (bc *BlockChain) RecordWitness(block *types.Block) (*stateless.Witness, error) {
+	parent := bc.GVBGeader(block.ParentHash(), block.NumberU64()-1)
+	if parent == nil {
+		return nil, consensus.ErrUnknownAncestor
+	}
+	witness, err := stateless.NewWitness(block.Header(), bc)
+	if err != nil {
+		return nil, err
+	}
+	statedb, err := state.New(parent.Root, stateless.NewRecordingDatabase(bc.stateCache, witness), nil)
+	if err != nil {
+		return nil, err
+	}
+	processor := &StateProcessor{
+		config: bc.chainConfig,
+		bc:     &recordingChain{BlockChain: bc, witness: witness},
+		engine: bc.engine,
+	}
+	if _, _, _, err := processor.Process(block, statedb, vm.Config{}); err != nil {
+		return nil, err
+	}
+	// Hash the post state to pull in the nodes touched by trie restructuring
+	root := statedb.IntermediateRoot(bc.chainConfig.IsEIP158(block.Number()))
+	if err := statedb.Error(); err != nil {
+		return nil, err
+	}
+	if root != block.Root() {
+		return nil, fmt.Errorf("post state root mismatch (have %x, want %x)", root, block.Root())
+	}
+	return witness, nil
+}
+
+// ExecuteStateless runs a block against only the state contained in a witness,
+// returning the resulting state root and receipt root. The caller is expected
+// to compare them against the block header to verify the block.
+func ExecuteStateless(config *params.ChainConfig, vmconfig vm.Config, engine consensus.Engine, block *types.Block, witness *stateless.Witness) (common.Hash, common.Hash, error) {
+	if err := witness.Verify(block.Header()); err != nil {
+		return common.Hash{}, common.Hash{}, err
+	}
+	statedb, err := state.New(witness.Root(), state.NewDatabase(witness.MakeHashDB()), nil)
+	if err != nil {
+		return common.Hash{}, common.Hash{}, err
+	}
+	processor := &StateProcessor{
+		config: config,
+		bc:     newWitnessChain(config, engine, witness),
+		engine: engine,
+	}
+	receipts, _, usedGas, err := processor.Process(block, statedb, vmconfig)
+	if err != nil {
+		return common.Hash{}, common.Hash{}, err
+	}
+	root := statedb.IntermediateRoot(config.IsEIP158(block.Number()))
+	if err := statedb.Error(); err != nil {
+		return common.Hash{}, common.Hash{}, fmt.Errorf("incomplete witness: %v", err)
+	}
+	if block.GasUsed() != usedGas {
+		return common.Hash{}, common.Hash{}, fmt.Errorf("invalid gas used (remote: %d local: %d)", block.GasUsed(), usedGas)
+	}
+	return root, types.DeriveSha(receipts, trie.NewStackTrie(nil)), nil
+}
+
+// VerifyStateless runs a block against only the state contained in a witness
+// and checks the resulting state and receipt roots against the block header.
+func VerifyStateless(config *params.ChainConfig, vmconfig vm.Config, engine consensus.Engine, block *types.Block, witness *stateless.Witness) error {
+	root, receiptRoot, err := ExecuteStateless(config, vmconfig, engine, block, witness)
+	if err != nil {
+		return err
+	}
+	if receiptRoot != block.ReceiptHash() {
+		return fmt.Errorf("invalid receipt root hash (remote: %x local: %x)", block.ReceiptHash(), receiptRoot)
+	}
+	if root != block.Root() {
+		return fmt.Errorf("invalid merkle root (remote: %x local: %x)", block.Root(), root)
+	}
+	return nil
+}
+
+// recordingChain wraps a blockchain, extending the witness with every ancestor
+// header looked up by the EVM while executing a block.
+type recordingChain struct {
+	*BlockChain
+	witness *stateless.Witness
+}
+
+// GVBGeader retrieves a block header by hash and number, recording it.
+func (c *recordingChain) GVBGeader(hash common.Hash, number uint64) *types.Header {
+	c.witness.AddBlockHash(number)
+	return c.BlockChain.GVBGeader(hash, number)
+}
+
+// witnessChain is a chain backed solely by the ancestor headers of a witness.
+type witnessChain struct {
+	config  *params.ChainConfig
+	engine  consensus.Engine
+	headers []*types.Header
+	hashes  map[common.Hash]*types.Header
+}
+
+// newWitnessChain creates a chain over the ancestor headers of a witness.
+func newWitnessChain(config *params.ChainConfig, engine consensus.Engine, witness *stateless.Witness) *witnessChain {
+	chain := &witnessChain{
+		config:  config,
+		engine:  engine,
+		headers: witness.Headers,
+		hashes:  make(map[common.Hash]*types.Header, len(witness.Headers)),
+	}
+	for _, header := range witness.Headers {
+		chain.hashes[header.Hash()] = header
+	}
+	return chain
+}
+
+// Config retrieves the chain configuration.
+func (c *witnessChain) Config() *params.ChainConfig { return c.config }
+
+// Engine retrieves the consensus engine.
+func (c *witnessChain) Engine() consensus.Engine { return c.engine }
+
+// CurrentHeader retrieves the parent of the block being executed.
+func (c *witnessChain) CurrentHeader() *types.Header { return c.headers[0] }
+
+// GVBGeader retrieves a witness header by hash and number.
+func (c *witnessChain) GVBGeader(hash common.Hash, number uint64) *types.Header {
+	if header := c.hashes[hash]; header != nil && header.Number.Uint64() == number {
+		return header
+	}
+	return nil
+}
+
+// GVBGeaderByHash retrieves a witness header by hash.
+func (c *witnessChain) GVBGeaderByHash(hash common.Hash) *types.Header {
+	return c.hashes[hash]
+}
+
+// GVBGeaderByNumber retrieves a witness header by number.
+func (c *witnessChain) GVBGeaderByNumber(number uint64) *types.Header {
+	for _, header := range c.headers {
+		if header.Number.Uint64() == number {
+			return header
+		}
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package stateless
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/core/rawdb"
+	"github.com/vbgloble/go-VGB/core/state"
+	"github.com/vbgloble/go-VGB/crypto"
+	"github.com/vbgloble/go-VGB/VBGdb"
+	"github.com/vbgloble/go-VGB/VBGdb/memorydb"
+	"github.com/vbgloble/go-VGB/trie"
+)
+
+// errNotFound is returned by the node recorder for non trie node keys that
+// were never written to it.
+var errNotFound = errors.New("not found")
+
+// MakeHashDB imports the codes and trie nodes of the witness into a new in
+// memory database, keyed by their hashes, suitable for stateless execution.
+func (w *Witness) MakeHashDB() VBGdb.Database {
+	db := rawdb.NewMemoryDatabase()
+	for code := range w.Codes {
+		blob := []byte(code)
+		rawdb.WriteCode(db, crypto.Keccak256Hash(blob), blob)
+	}
+	for node := range w.State {
+		blob := []byte(node)
+		rawdb.WriteTrieNode(db, crypto.Keccak256Hash(blob), blob)
+	}
+	return db
+}
+
+// recordingDB is a state database which resolves trie nodes and contract codes
+// through a live database, recording every item accessed into a witness.
+type recordingDB struct {
+	state.Database                // Live database to pull the contract codes from
+	triedb         *trie.Database // Isolated trie database reading through the recorder
+	witness        *Witness       // Witness to record the accessed items into
+}
+
+// NewRecordingDatabase wraps a state database so that every trie node and
+// contract code resolved through it is recorded into the given witness. Trie
+// modifications are kept in memory and never reach the live database.
+func NewRecordingDatabase(db state.Database, witness *Witness) state.Database {
+	recorder := &nodeRecorder{
+		Database: memorydb.New(),
+		source:   db.TrieDB(),
+		witness:  witness,
+	}
+	return &recordingDB{
+		Database: db,
+		triedb:   trie.NewDatabase(recorder),
+		witness:  witness,
+	}
+}
+
+// OpenTrie opens the main account trie at a specific root hash.
+func (db *recordingDB) OpenTrie(root common.Hash) (state.Trie, error) {
+	return trie.NewSecure(root, db.triedb)
+}
+
+// OpenStorageTrie opens the storage trie of an account.
+func (db *recordingDB) OpenStorageTrie(addrHash, root common.Hash) (state.Trie, error) {
+	return trie.NewSecure(root, db.triedb)
+}
+
+// CopyTrie returns an independent copy of the given trie.
+func (db *recordingDB) CopyTrie(t state.Trie) state.Trie {
+	switch t := t.(type) {
+	case *trie.SecureTrie:
+		return t.Copy()
+	default:
+		panic(fmt.Errorf("unknown trie type %T", t))
+	}
+}
+
+// ContractCode retrieves a particular contract's code, recording it.
+func (db *recordingDB) ContractCode(addrHash, codeHash common.Hash) ([]byte, error) {
+	code, err := db.Database.ContractCode(addrHash, codeHash)
+	if err != nil {
+		return nil, err
+	}
+	db.witness.AddCode(code)
+	return code, nil
+}
+
+// ContractCodeSize retrieves a particular contracts code's size. The code itself
+// is recorded as a stateless execution needs it to derive the size.
+func (db *recordingDB) ContractCodeSize(addrHash, codeHash common.Hash) (int, error) {
+	code, err := db.ContractCode(addrHash, codeHash)
+	return len(code), err
+}
+
+// TrieDB retrieves the isolated trie database used for recording.
+func (db *recordingDB) TrieDB() *trie.Database {
+	return db.triedb
+}
+
+// nodeRecorder is a key-value store backing the isolated trie database of a
+// recording state database. Reads fall through to the live trie database and
+// get recorded, writes are kept in memory.
+type nodeRecorder struct {
+	*memorydb.Database
+	source  *trie.Database
+	witness *Witness
+}
+
+// Has retrieves if a key is present in the key-value store.
+func (r *nodeRecorder) Has(key []byte) (bool, error) {
+	if blob, err := r.Get(key); err == nil && len(blob) > 0 {
+		return true, nil
+	}
+	return false, nil
+}
+
+// Get retrieves the given key if it's present in the key-value store, pulling
+// trie nodes from the live database and recording them.
+func (r *nodeRecorder) Get(key []byte) ([]byte, error) {
+	if blob, err := r.Database.Get(key); err == nil {
+		return blob, nil
+	}
+	if len(key) != common.HashLength {
+		return nil, errNotFound
+	}
+	blob, err := r.source.Node(common.BytesToHash(key))
+	if err != nil {
+		return nil, err
+	}
+	r.witness.AddState(blob)
+	return blob, nil
+}
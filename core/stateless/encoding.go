@@ -0,0 +1,88 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package stateless
+
+import (
+	"io"
+	"sort"
+
+	"github.com/vbgloble/go-VGB/common/hexutil"
+	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/rlp"
+)
+
+// ExtWitness is a witness RLP and JSON encoding for transferring across clients.
+type ExtWitness struct {
+	Headers []*types.Header `json:"headers"`
+	Codes   []hexutil.Bytes `json:"codes"`
+	State   []hexutil.Bytes `json:"state"`
+}
+
+// ToExtWitness converts the witness into its external form. Codes and trie
+// nodes are sorted to make the encoding deterministic.
+func (w *Witness) ToExtWitness() *ExtWitness {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	ext := &ExtWitness{
+		Headers: w.Headers,
+		Codes:   make([]hexutil.Bytes, 0, len(w.Codes)),
+		State:   make([]hexutil.Bytes, 0, len(w.State)),
+	}
+	for code := range w.Codes {
+		ext.Codes = append(ext.Codes, []byte(code))
+	}
+	for node := range w.State {
+		ext.State = append(ext.State, []byte(node))
+	}
+	sort.Slice(ext.Codes, func(i, j int) bool { return string(ext.Codes[i]) < string(ext.Codes[j]) })
+	sort.Slice(ext.State, func(i, j int) bool { return string(ext.State[i]) < string(ext.State[j]) })
+	return ext
+}
+
+// FromExtWitness converts the external witness form into a witness usable for
+// stateless execution.
+func FromExtWitness(ext *ExtWitness) *Witness {
+	w := &Witness{
+		Headers: ext.Headers,
+		Codes:   make(map[string]struct{}, len(ext.Codes)),
+		State:   make(map[string]struct{}, len(ext.State)),
+	}
+	for _, code := range ext.Codes {
+		w.Codes[string(code)] = struct{}{}
+	}
+	for _, node := range ext.State {
+		w.State[string(node)] = struct{}{}
+	}
+	return w
+}
+
+// EncodeRLP serializes a witness as RLP.
+func (w *Witness) EncodeRLP(wr io.Writer) error {
+	return rlp.Encode(wr, w.ToExtWitness())
+}
+
+// DecodeRLP decodes a witness from RLP.
+func (w *Witness) DecodeRLP(s *rlp.Stream) error {
+	var ext ExtWitness
+	if err := s.Decode(&ext); err != nil {
+		return err
+	}
+	dec := FromExtWitness(&ext)
+	w.Headers, w.Codes, w.State = dec.Headers, dec.Codes, dec.State
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package stateless implements execution witnesses: the minimal set of trie
+// nodes, contract codes and ancestor headers needed to re-execute a block
+// without access to the full state.
+package stateless
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/core/types"
+)
+
+// HeaderReader is the subset of chain access needed to extend a witness with
+// the ancestor headers referenced by the BLOCKHASH opcode.
+type HeaderReader interface {
+	// GVBGeader retrieves a block header from the database by hash and number.
+	GVBGeader(hash common.Hash, number uint64) *types.Header
+}
+
+// Witness encompasses the state required to apply a block's transactions on
+// top of its parent state and derive the post state and receipt roots.
+type Witness struct {
+	context *types.Header // Header to which this witness belongs to
+	chain   HeaderReader  // Chain to pull ancestor headers from while recording
+
+	Headers []*types.Header     // Past headers in reverse order (0=parent, 1=grandparent, etc)
+	Codes   map[string]struct{} // Set of contract codes loaded during execution
+	State   map[string]struct{} // Set of account and storage trie nodes resolved
+
+	lock sync.Mutex // Lock protecting the witness during recording
+}
+
+// NewWitness creates an empty witness ready for recording the execution of
+// the block identified by context. The parent header is always included.
+func NewWitness(context *types.Header, chain HeaderReader) (*Witness, error) {
+	if context.Number.Sign() == 0 {
+		return nil, errors.New("cannot record witness for genesis block")
+	}
+	parent := chain.GVBGeader(context.ParentHash, context.Number.Uint64()-1)
+	if parent == nil {
+		return nil, errors.New("failed to retrieve parent header")
+	}
+	return &Witness{
+		context: context,
+		chain:   chain,
+		Headers: []*types.Header{parent},
+		Codes:   make(map[string]struct{}),
+		State:   make(map[string]struct{}),
+	}, nil
+}
+
+// AddBlockHash adds a block hash lookup to the witness, extending the list of
+// ancestor headers all the way down to the requested block.
+func (w *Witness) AddBlockHash(number uint64) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	for int(w.context.Number.Uint64()-number) > len(w.Headers) {
+		tail := w.Headers[len(w.Headers)-1]
+		header := w.chain.GVBGeader(tail.ParentHash, tail.Number.Uint64()-1)
+		if header == nil {
+			return
+		}
+		w.Headers = append(w.Headers, header)
+	}
+}
+
+// AddCode adds a contract code to the witness.
+func (w *Witness) AddCode(code []byte) {
+	if len(code) == 0 {
+		return
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.Codes[string(code)] = struct{}{}
+}
+
+// AddState adds a resolved trie node to the witness.
+func (w *Witness) AddState(node []byte) {
+	if len(node) == 0 {
+		return
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.State[string(node)] = struct{}{}
+}
+
+// Root returns the pre-state root, i.e. the state root of the parent block.
+func (w *Witness) Root() common.Hash {
+	return w.Headers[0].Root
+}
+
+// Verify checks that the witness ancestor headers form a valid hash chain
+// leading to the parent of the given header.
+func (w *Witness) Verify(header *types.Header) error {
+	if len(w.Headers) == 0 {
+		return errors.New("witness has no parent header")
+	}
+	if w.Headers[0].Hash() != header.ParentHash {
+		return errors.New("witness parent header mismatch")
+	}
+	for i := 1; i < len(w.Headers); i++ {
+		if w.Headers[i].Hash() != w.Headers[i-1].ParentHash {
+			return errors.New("witness ancestor headers not linked")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/consensus/VBGash"
+	"github.com/vbgloble/go-VGB/core/rawdb"
+	"github.com/vbgloble/go-VGB/core/stateless"
+	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/core/vm"
+	"github.com/vbgloble/go-VGB/crypto"
+	"github.com/vbgloble/go-VGB/params"
+	"github.com/vbgloble/go-VGB/rlp"
+)
+
+// Tests that a witness recorded for a block contains everything needed to
+// re-execute it statelessly, and that incomplete witnesses are rejected.
+func TestStatelessExecution(t *testing.T) {
+	var (
+		db      = rawdb.NewMemoryDatabase()
+		key, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		address = crypto.PubkeyToAddress(key.PublicKey)
+		funds   = big.NewInt(1000000000)
+
+		// Contract storing BLOCKHASH(NUMBER-3) in slot 0, incrementing slot 1
+		// and storing its own code size in slot 2
+		theAddr = common.Address{0xaa}
+		theCode = common.FromHex("436003900340600055600154600101600155303b60025500")
+		gspec   = &Genesis{
+			Config: params.TestChainConfig,
+			Alloc: GenesisAlloc{
+				address: {Balance: funds},
+				theAddr: {Balance: big.NewInt(0), Code: theCode},
+			},
+		}
+		signer = types.NewEIP155Signer(gspec.Config.ChainID)
+	)
+	gspec.MustCommit(db)
+	blockchain, _ := NewBlockChain(db, nil, gspec.Config, VBGash.NewFaker(), vm.Config{}, nil, nil)
+	defer blockchain.Stop()
+
+	// Import the blocks one by one, as the BLOCKHASH opcode needs the chain to
+	// resolve the ancestors of the block being generated
+	var block *types.Block
+	for i := 0; i < 4; i++ {
+		blocks, _ := GenerateChain(gspec.Config, blockchain.CurrentBlock(), VBGash.NewFaker(), db, 1, func(i int, b *BlockGen) {
+			tx, err := types.SignTx(types.NewTransaction(b.TxNonce(address), theAddr, new(big.Int), 100000, new(big.Int), nil), signer, key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b.AddTxWithChain(blockchain, tx)
+		})
+		if _, err := blockchain.InsertChain(blocks); err != nil {
+			t.Fatalf("failed to insert block %d: %v", i+1, err)
+		}
+		block = blocks[0]
+	}
+
+	witness, err := blockchain.RecordWitness(block)
+	if err != nil {
+		t.Fatalf("failed to record witness: %v", err)
+	}
+	if len(witness.Headers) != 2 {
+		t.Errorf("ancestor header count mismatch: have %d, want %d", len(witness.Headers), 2)
+	}
+	if len(witness.Codes) != 1 {
+		t.Errorf("code count mismatch: have %d, want %d", len(witness.Codes), 1)
+	}
+	if err := VerifyStateless(gspec.Config, vm.Config{}, VBGash.NewFaker(), block, witness); err != nil {
+		t.Fatalf("failed to verify block statelessly: %v", err)
+	}
+	// Ensure the witness survives an encoding roundtrip
+	blob, err := rlp.EncodeToBytes(witness)
+	if err != nil {
+		t.Fatalf("failed to encode witness: %v", err)
+	}
+	decoded := new(stateless.Witness)
+	if err := rlp.DecodeBytes(blob, decoded); err != nil {
+		t.Fatalf("failed to decode witness: %v", err)
+	}
+	if err := VerifyStateless(gspec.Config, vm.Config{}, VBGash.NewFaker(), block, decoded); err != nil {
+		t.Fatalf("failed to verify block with decoded witness: %v", err)
+	}
+	// Ensure missing ancestors, codes or state are detected
+	incomplete := stateless.FromExtWitness(witness.ToExtWitness())
+	incomplete.Headers = incomplete.Headers[:1]
+	if err := VerifyStateless(gspec.Config, vm.Config{}, VBGash.NewFaker(), block, incomplete); err == nil {
+		t.Errorf("verification succeeded without ancestor headers")
+	}
+	incomplete = stateless.FromExtWitness(witness.ToExtWitness())
+	incomplete.Codes = make(map[string]struct{})
+	if err := VerifyStateless(gspec.Config, vm.Config{}, VBGash.NewFaker(), block, incomplete); err == nil {
+		t.Errorf("verification succeeded without contract codes")
+	}
+	for node := range witness.State {
+		incomplete = stateless.FromExtWitness(witness.ToExtWitness())
+		delete(incomplete.State, node)
+		if err := VerifyStateless(gspec.Config, vm.Config{}, VBGash.NewFaker(), block, incomplete); err == nil {
+			t.Errorf("verification succeeded without trie node %x", crypto.Keccak256([]byte(node)))
+		}
+	}
+	// Ensure tampered parent headers are rejected
+	incomplete = stateless.FromExtWitness(witness.ToExtWitness())
+	incomplete.Headers = []*types.Header{types.CopyHeader(witness.Headers[0])}
+	incomplete.Headers[0].Extra = []byte("tampered")
+	if err := VerifyStateless(gspec.Config, vm.Config{}, VBGash.NewFaker(), block, incomplete); err == nil {
+		t.Errorf("verification succeeded with tampered parent header")
+	}
+}
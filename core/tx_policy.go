@@ -0,0 +1,266 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/metrics"
+)
+
+// policyRateWindow is the period over which the remote transactions of a sender
+// are counted for rate limiting.
+const policyRateWindow = time.Minute
+
+var (
+	// ErrPolicySenderDenied is returned if the sender of a transaction is denied,
+	// or not allowed, by the admission policy.
+	ErrPolicySenderDenied = errors.New("sender denied by policy")
+
+	// ErrPolicyRecipientDenied is returned if the recipient of a transaction is
+	// denied, or not allowed, by the admission policy.
+	ErrPolicyRecipientDenied = errors.New("recipient denied by policy")
+
+	// ErrPolicyUnderpriced is returned if a transaction's gas tip is below the
+	// minimum the admission policy sets for its sender.
+	ErrPolicyUnderpriced = errors.New("transaction underpriced by policy")
+
+	// ErrPolicyRateLimited is returned if the sender of a remote transaction has
+	// exceeded its admission rate.
+	ErrPolicyRateLimited = errors.New("sender rate limited by policy")
+
+	// ErrPolicyOversizedData is returned if the calldata of a transaction exceeds
+	// the size allowed by the admission policy.
+	ErrPolicyOversizedData = errors.New("oversized data by policy")
+
+	// ErrPolicyCreationDenied is returned if the sender of a contract creation is
+	// not permitted to deploy contracts by the admission policy.
+	ErrPolicyCreationDenied = errors.New("contract creation denied by policy")
+)
+
+var (
+	// Metrics for transactions rejected by the admission policy
+	policySenderMeter      = metrics.NewRegisteredMeter("txpool/policy/sender", nil)
+	policyRecipientMeter   = metrics.NewRegisteredMeter("txpool/policy/recipient", nil)
+	policyUnderpricedMeter = metrics.NewRegisteredMeter("txpool/policy/underpriced", nil)
+	policyRateLimitMeter   = metrics.NewRegisteredMeter("txpool/policy/ratelimit", nil)
+	policyOversizedMeter   = metrics.NewRegisteredMeter("txpool/policy/oversized", nil)
+	policyCreationMeter    = metrics.NewRegisteredMeter("txpool/policy/creation", nil)
+)
+
+// TxPolicy is a set of admission rules applied by the transaction pool on top
+// of its configuration. Empty fields impose no restriction.
+type TxPolicy struct {
+	DenySenders     []common.Address `json:"denySenders,omitempty"`     // Senders whose transactions are rejected
+	AllowSenders    []common.Address `json:"allowSenders,omitempty"`    // If set, the only senders accepted
+	DenyRecipients  []common.Address `json:"denyRecipients,omitempty"`  // Recipients whose transactions are rejected
+	AllowRecipients []common.Address `json:"allowRecipients,omitempty"` // If set, the only recipients accepted for calls
+
+	MinGasPrice *big.Int        `json:"minGasPrice,omitempty"` // Minimum gas tip of remote transactions from unclassified senders
+	Classes     []TxPolicyClass `json:"classes,omitempty"`     // Sender classes overriding the minimum gas tip
+
+	RemotesPerMinute uint64 `json:"remotesPerMinute,omitempty"` // Maximum remote transactions admitted per sender and minute
+	MaxDataSize      uint64 `json:"maxDataSize,omitempty"`      // Maximum calldata size of a transaction in bytes
+
+	NoCreation bool             `json:"noCreation,omitempty"` // Reject contract creations, except from the creators
+	Creators   []common.Address `json:"creators,omitempty"`   // If set, the only senders allowed to create contracts
+}
+
+// TxPolicyClass is a named group of senders sharing a minimum gas tip.
+type TxPolicyClass struct {
+	Name        string           `json:"name"`
+	Senders     []common.Address `json:"senders"`
+	MinGasPrice *big.Int         `json:"minGasPrice"`
+}
+
+// LoadTxPolicy reads a JSON encoded admission policy from the given file.
+func LoadTxPolicy(path string) (*TxPolicy, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	dec := json.NewDecoder(file)
+	dec.DisallowUnknownFields()
+
+	policy := new(TxPolicy)
+	if err := dec.Decode(policy); err != nil {
+		return nil, fmt.Errorf("invalid transaction policy %s: %v", path, err)
+	}
+	if _, err := newTxPolicy(policy); err != nil {
+		return nil, fmt.Errorf("invalid transaction policy %s: %v", path, err)
+	}
+	return policy, nil
+}
+
+// txPolicy evaluates an admission policy, tracking the admission rate of the
+// remote senders.
+type txPolicy struct {
+	config *TxPolicy
+
+	denySenders     map[common.Address]struct{}
+	allowSenders    map[common.Address]struct{}
+	denyRecipients  map[common.Address]struct{}
+	allowRecipients map[common.Address]struct{}
+	creators        map[common.Address]struct{}
+	minGasPrices    map[common.Address]*big.Int // Minimum gas tip of the classified senders
+
+	rates     map[common.Address]*policyRate // Admissions of each remote sender in the current window
+	lastPrune time.Time                      // Last time the expired rate windows were dropped
+}
+
+// policyRate counts the admissions of a sender within a rate limiting window.
+type policyRate struct {
+	start time.Time
+	count uint64
+}
+
+// newTxPolicy validates an admission policy and prepares it for evaluation.
+func newTxPolicy(config *TxPolicy) (*txPolicy, error) {
+	if config.MinGasPrice != nil && config.MinGasPrice.Sign() < 0 {
+		return nil, errors.New("negative minimum gas price")
+	}
+	policy := &txPolicy{
+		config:          config,
+		denySenders:     addressSet(config.DenySenders),
+		allowSenders:    addressSet(config.AllowSenders),
+		denyRecipients:  addressSet(config.DenyRecipients),
+		allowRecipients: addressSet(config.AllowRecipients),
+		creators:        addressSet(config.Creators),
+		minGasPrices:    make(map[common.Address]*big.Int),
+		rates:           make(map[common.Address]*policyRate),
+	}
+	for _, class := range config.Classes {
+		if class.MinGasPrice == nil || class.MinGasPrice.Sign() < 0 {
+			return nil, fmt.Errorf("class %q: missing or negative minimum gas price", class.Name)
+		}
+		for _, addr := range class.Senders {
+			if _, ok := policy.minGasPrices[addr]; ok {
+				return nil, fmt.Errorf("class %q: sender %x already classified", class.Name, addr)
+			}
+			policy.minGasPrices[addr] = class.MinGasPrice
+		}
+	}
+	return policy, nil
+}
+
+// addressSet converts a list of addresses into a set, nil if the list is empty.
+func addressSet(addrs []common.Address) map[common.Address]struct{} {
+	if len(addrs) == 0 {
+		return nil
+	}
+	set := make(map[common.Address]struct{}, len(addrs))
+	for _, addr := range addrs {
+		set[addr] = struct{}{}
+	}
+	return set
+}
+
+// validate checks whVBGer a transaction from the given sender is admitted by
+// the policy. Gas prices are only enforced on remote transactions, rate limits
+// separately by limit.
+func (p *txPolicy) validate(tx *types.Transaction, from common.Address, local bool) error {
+	if _, ok := p.denySenders[from]; ok {
+		policySenderMeter.Mark(1)
+		return ErrPolicySenderDenied
+	}
+	if _, ok := p.allowSenders[from]; p.allowSenders != nil && !ok {
+		policySenderMeter.Mark(1)
+		return ErrPolicySenderDenied
+	}
+	if to := tx.To(); to != nil {
+		if _, ok := p.denyRecipients[*to]; ok {
+			policyRecipientMeter.Mark(1)
+			return ErrPolicyRecipientDenied
+		}
+		if _, ok := p.allowRecipients[*to]; p.allowRecipients != nil && !ok {
+			policyRecipientMeter.Mark(1)
+			return ErrPolicyRecipientDenied
+		}
+	} else if p.config.NoCreation || p.creators != nil {
+		if _, ok := p.creators[from]; !ok {
+			policyCreationMeter.Mark(1)
+			return ErrPolicyCreationDenied
+		}
+	}
+	if p.config.MaxDataSize != 0 && uint64(len(tx.Data())) > p.config.MaxDataSize {
+		policyOversizedMeter.Mark(1)
+		return ErrPolicyOversizedData
+	}
+	if local {
+		return nil
+	}
+	minGasPrice, ok := p.minGasPrices[from]
+	if !ok {
+		minGasPrice = p.config.MinGasPrice
+	}
+	if minGasPrice != nil && tx.GasTipCapIntCmp(minGasPrice) < 0 {
+		policyUnderpricedMeter.Mark(1)
+		return ErrPolicyUnderpriced
+	}
+	return nil
+}
+
+// limit checks whVBGer the sender of a remote transaction has any admissions
+// left in its current rate limiting window. The transaction is not counted, that
+// is left to charge once it's actually pooled.
+func (p *txPolicy) limit(from common.Address, now time.Time) error {
+	if p.config.RemotesPerMinute == 0 {
+		return nil
+	}
+	if rate := p.rates[from]; rate != nil && now.Sub(rate.start) < policyRateWindow && rate.count >= p.config.RemotesPerMinute {
+		policyRateLimitMeter.Mark(1)
+		return ErrPolicyRateLimited
+	}
+	return nil
+}
+
+// charge counts a pooled remote transaction towards the rate limit of its sender.
+func (p *txPolicy) charge(from common.Address, now time.Time) {
+	if p.config.RemotesPerMinute == 0 {
+		return
+	}
+	p.pruneRates(now)
+
+	rate := p.rates[from]
+	if rate == nil || now.Sub(rate.start) >= policyRateWindow {
+		rate = &policyRate{start: now}
+		p.rates[from] = rate
+	}
+	rate.count++
+}
+
+// pruneRates drops the rate windows which have expired, at most once per window.
+func (p *txPolicy) pruneRates(now time.Time) {
+	if now.Sub(p.lastPrune) < policyRateWindow {
+		return
+	}
+	for addr, rate := range p.rates {
+		if now.Sub(rate.start) >= policyRateWindow {
+			delete(p.rates, addr)
+		}
+	}
+	p.lastPrune = now
+}
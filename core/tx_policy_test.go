@@ -0,0 +1,241 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/core/rawdb"
+	"github.com/vbgloble/go-VGB/core/state"
+	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/crypto"
+	"github.com/vbgloble/go-VGB/event"
+	"github.com/vbgloble/go-VGB/params"
+	"github.com/vbgloble/go-VGB/trie"
+)
+
+// Tests that each rule of an admission policy rejects the transactions it is
+// meant to, with its own error.
+func TestTxPolicyValidate(t *testing.T) {
+	var (
+		signer = types.HomesteadSigner{}
+		now    = time.Now()
+
+		denied   = common.Address{0x01}
+		premium  = common.Address{0x02}
+		creator  = common.Address{0x03}
+		regular  = common.Address{0x04}
+		blocked  = common.Address{0xb1}
+		contract = common.Address{0xc1}
+	)
+	policy, err := newTxPolicy(&TxPolicy{
+		DenySenders:      []common.Address{denied},
+		DenyRecipients:   []common.Address{blocked},
+		MinGasPrice:      big.NewInt(10),
+		Classes:          []TxPolicyClass{{Name: "premium", Senders: []common.Address{premium}, MinGasPrice: big.NewInt(1)}},
+		RemotesPerMinute: 2,
+		MaxDataSize:      4,
+		Creators:         []common.Address{creator},
+	})
+	if err != nil {
+		t.Fatalf("failed to create policy: %v", err)
+	}
+	key, _ := crypto.GenerateKey()
+	call := func(to common.Address, price int64, data []byte) *types.Transaction {
+		tx, _ := types.SignTx(types.NewTransaction(0, to, big.NewInt(0), 100000, big.NewInt(price), data), signer, key)
+		return tx
+	}
+	create, _ := types.SignTx(types.NewContractCreation(0, big.NewInt(0), 100000, big.NewInt(10), nil), signer, key)
+
+	tests := []struct {
+		tx    *types.Transaction
+		from  common.Address
+		local bool
+		err   error
+	}{
+		{call(contract, 10, nil), denied, true, ErrPolicySenderDenied},
+		{call(blocked, 10, nil), regular, true, ErrPolicyRecipientDenied},
+		{create, regular, true, ErrPolicyCreationDenied},
+		{create, creator, false, nil},
+		{call(contract, 10, []byte{1, 2, 3, 4, 5}), regular, true, ErrPolicyOversizedData},
+		{call(contract, 9, nil), regular, false, ErrPolicyUnderpriced},
+		{call(contract, 9, nil), regular, true, nil},
+		{call(contract, 1, nil), premium, false, nil},
+		{call(contract, 10, nil), regular, false, nil},
+		{call(contract, 10, nil), regular, false, nil},
+		{call(contract, 10, nil), regular, false, ErrPolicyRateLimited},
+		{call(contract, 10, nil), regular, true, nil},
+	}
+	for i, tt := range tests {
+		err := policy.validate(tt.tx, tt.from, tt.local)
+		if err == nil && !tt.local {
+			if err = policy.limit(tt.from, now); err == nil {
+				policy.charge(tt.from, now)
+			}
+		}
+		if err != tt.err {
+			t.Errorf("test %d: error mismatch: have %v, want %v", i, err, tt.err)
+		}
+	}
+	// Ensure the rate limit is lifted once the window passes
+	if err := policy.limit(regular, now.Add(policyRateWindow)); err != nil {
+		t.Errorf("rate limit not lifted after window: %v", err)
+	}
+	// Ensure invalid policies are rejected
+	if _, err := newTxPolicy(&TxPolicy{Classes: []TxPolicyClass{{Name: "free", Senders: []common.Address{regular}}}}); err == nil {
+		t.Errorf("class without minimum gas price accepted")
+	}
+	dup := []TxPolicyClass{
+		{Name: "a", Senders: []common.Address{regular}, MinGasPrice: big.NewInt(1)},
+		{Name: "b", Senders: []common.Address{regular}, MinGasPrice: big.NewInt(2)},
+	}
+	if _, err := newTxPolicy(&TxPolicy{Classes: dup}); err == nil {
+		t.Errorf("sender in multiple classes accepted")
+	}
+}
+
+// Tests that the pool enforces the policy loaded from its file, and picks up
+// changes when reloaded.
+func TestTransactionPoolPolicy(t *testing.T) {
+	t.Parallel()
+
+	key, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	file, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("failed to create policy file: %v", err)
+	}
+	path := file.Name()
+	file.Close()
+	defer os.Remove(path)
+
+	write := func(policy string) {
+		if err := ioutil.WriteFile(path, []byte(policy), 0600); err != nil {
+			t.Fatalf("failed to write policy file: %v", err)
+		}
+	}
+	write(fmt.Sprintf(`{"denySenders": ["%s"]}`, sender.Hex()))
+
+	pool, _ := setupTxPool()
+	pool.Stop()
+
+	config := testTxPoolConfig
+	config.Policy = path
+	pool = NewTxPool(config, pool.chainconfig, pool.chain)
+	defer pool.Stop()
+
+	pool.currentState.AddBalance(sender, big.NewInt(1000000))
+
+	if err := pool.AddRemote(transaction(0, 100000, key)); err != ErrPolicySenderDenied {
+		t.Fatalf("denied sender error mismatch: have %v, want %v", err, ErrPolicySenderDenied)
+	}
+	// Replace the policy with an invalid one and ensure the old one is kept
+	write(`{"denySenders": [], "unknown": true}`)
+	if err := pool.ReloadPolicy(); err == nil {
+		t.Fatalf("invalid policy reloaded")
+	}
+	if err := pool.AddRemote(transaction(0, 100000, key)); err != ErrPolicySenderDenied {
+		t.Fatalf("denied sender error mismatch: have %v, want %v", err, ErrPolicySenderDenied)
+	}
+	// Lift the restriction and ensure the transaction is accepted
+	write(`{"maxDataSize": 16}`)
+	if err := pool.ReloadPolicy(); err != nil {
+		t.Fatalf("failed to reload policy: %v", err)
+	}
+	if err := pool.AddRemote(transaction(0, 100000, key)); err != nil {
+		t.Fatalf("failed to add transaction: %v", err)
+	}
+	if err := pool.AddRemote(pricedDataTransaction(1, 100000, big.NewInt(1), key, 17)); err != ErrPolicyOversizedData {
+		t.Fatalf("oversized data error mismatch: have %v, want %v", err, ErrPolicyOversizedData)
+	}
+	// Remove the policy altogVBGer
+	if err := pool.SetPolicy(nil); err != nil {
+		t.Fatalf("failed to remove policy: %v", err)
+	}
+	if pool.Policy() != nil {
+		t.Fatalf("policy not removed")
+	}
+	if err := pool.AddRemote(pricedDataTransaction(1, 100000, big.NewInt(1), key, 17)); err != nil {
+		t.Fatalf("failed to add transaction: %v", err)
+	}
+}
+
+// reorgBlockChain is a test chain serving a fixed set of blocks, allowing the
+// pool to reinject the transactions of reorged blocks.
+type reorgBlockChain struct {
+	*testBlockChain
+	blocks map[common.Hash]*types.Block
+}
+
+func (bc *reorgBlockChain) GetBlock(hash common.Hash, number uint64) *types.Block {
+	return bc.blocks[hash]
+}
+
+// Tests that only transactions actually pooled count towards the rate limit of
+// their sender, and that transactions reinjected after a reorg are exempt.
+func TestTransactionPoolPolicyRateLimit(t *testing.T) {
+	t.Parallel()
+
+	key, _ := crypto.GenerateKey()
+	reorged := pricedTransaction(2, 100000, big.NewInt(1), key)
+
+	var (
+		statedb, _ = state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+		genesis    = types.NewBlock(&types.Header{Number: big.NewInt(0), GasLimit: 1000000}, nil, nil, nil, new(trie.Trie))
+		dropped    = types.NewBlock(&types.Header{ParentHash: genesis.Hash(), Number: big.NewInt(1), GasLimit: 1000000, Extra: []byte("old")}, []*types.Transaction{reorged}, nil, nil, new(trie.Trie))
+		canonical  = types.NewBlock(&types.Header{ParentHash: genesis.Hash(), Number: big.NewInt(1), GasLimit: 1000000, Extra: []byte("new")}, nil, nil, nil, new(trie.Trie))
+		blockchain = &reorgBlockChain{
+			testBlockChain: &testBlockChain{statedb, 1000000, new(event.Feed)},
+			blocks:         map[common.Hash]*types.Block{genesis.Hash(): genesis, dropped.Hash(): dropped, canonical.Hash(): canonical},
+		}
+	)
+	pool := NewTxPool(testTxPoolConfig, params.TestChainConfig, blockchain)
+	defer pool.Stop()
+
+	pool.currentState.AddBalance(crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1000000000))
+	if err := pool.SetPolicy(&TxPolicy{RemotesPerMinute: 2}); err != nil {
+		t.Fatalf("failed to set policy: %v", err)
+	}
+	// Add a transaction and fail to replace it, which must not use up the budget
+	if err := pool.addRemoteSync(pricedTransaction(0, 100000, big.NewInt(1), key)); err != nil {
+		t.Fatalf("failed to add transaction: %v", err)
+	}
+	if err := pool.addRemoteSync(pricedTransaction(0, 100001, big.NewInt(1), key)); err != ErrReplaceUnderpriced {
+		t.Fatalf("replacement error mismatch: have %v, want %v", err, ErrReplaceUnderpriced)
+	}
+	if err := pool.addRemoteSync(pricedTransaction(1, 100000, big.NewInt(1), key)); err != nil {
+		t.Fatalf("failed to add transaction: %v", err)
+	}
+	if err := pool.addRemoteSync(reorged); err != ErrPolicyRateLimited {
+		t.Fatalf("rate limit error mismatch: have %v, want %v", err, ErrPolicyRateLimited)
+	}
+	// Reorg out a block containing a further transaction and ensure it's reinjected
+	<-pool.requestReset(dropped.Header(), canonical.Header())
+	if pool.Get(reorged.Hash()) == nil {
+		t.Fatalf("reorged transaction not reinjected")
+	}
+	if pending, queued := pool.Stats(); pending+queued != 3 {
+		t.Fatalf("pooled transactions mismatched: have %d, want %d", pending+queued, 3)
+	}
+}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/vbgloble/go-VGB/common"
 	"github.com/vbgloble/go-VGB/common/prque"
+	"github.com/vbgloble/go-VGB/consensus/misc"
 	"github.com/vbgloble/go-VGB/core/state"
 	"github.com/vbgloble/go-VGB/core/types"
 	"github.com/vbgloble/go-VGB/event"
@@ -79,6 +80,10 @@ var (
 	// than some meaningful limit a user might use. This is not a consensus error
 	// making the transaction invalid, rather a DOS protection.
 	ErrOversizedData = errors.New("oversized data")
+
+	// ErrPrivateExpired is returned if a private transaction is submitted with an
+	// expiry block that is already part of the chain.
+	ErrPrivateExpired = errors.New("private transaction expiry reached")
 )
 
 var (
@@ -106,6 +111,10 @@ var (
 	invalidTxMeter     = metrics.NewRegisteredMeter("txpool/invalid", nil)
 	underpricedTxMeter = metrics.NewRegisteredMeter("txpool/underpriced", nil)
 
+	// Metrics for private transactions
+	privateExpiredMeter  = metrics.NewRegisteredMeter("txpool/private/expired", nil)  // Dropped due to expiry
+	privateReleasedMeter = metrics.NewRegisteredMeter("txpool/private/released", nil) // Made public on expiry
+
 	pendingGauge = metrics.NewRegisteredGauge("txpool/pending", nil)
 	queuedGauge  = metrics.NewRegisteredGauge("txpool/queued", nil)
 	localGauge   = metrics.NewRegisteredGauge("txpool/local", nil)
@@ -148,6 +157,12 @@ type TxPoolConfig struct {
 	GlobalQueue  uint64 // Maximum number of non-executable transaction slots for all accounts
 
 	Lifetime time.Duration // Maximum amount of time non-executable transaction are queued
+
+	Policy string // Admission policy file, reloaded on request
+
+	Store         string        // Disk store for remote transactions to survive node restarts
+	StoreInterval time.Duration // Time interval to regenerate the remote transaction store
+	StoreSize     uint64        // Maximum size of the remote transaction store in megabytes
 }
 
 // DefaultTxPoolConfig contains the default configurations for the transaction
@@ -165,6 +180,9 @@ var DefaultTxPoolConfig = TxPoolConfig{
 	GlobalQueue:  1024,
 
 	Lifetime: 3 * time.Hour,
+
+	StoreInterval: 5 * time.Minute,
+	StoreSize:     32,
 }
 
 // sanitize checks the provided user configurations and changes anything that's
@@ -203,6 +221,14 @@ func (config *TxPoolConfig) sanitize() TxPoolConfig {
 		log.Warn("Sanitizing invalid txpool lifetime", "provided", conf.Lifetime, "updated", DefaultTxPoolConfig.Lifetime)
 		conf.Lifetime = DefaultTxPoolConfig.Lifetime
 	}
+	if conf.StoreInterval < time.Second {
+		log.Warn("Sanitizing invalid txpool store interval", "provided", conf.StoreInterval, "updated", DefaultTxPoolConfig.StoreInterval)
+		conf.StoreInterval = DefaultTxPoolConfig.StoreInterval
+	}
+	if conf.StoreSize < 1 {
+		log.Warn("Sanitizing invalid txpool store size", "provided", conf.StoreSize, "updated", DefaultTxPoolConfig.StoreSize)
+		conf.StoreSize = DefaultTxPoolConfig.StoreSize
+	}
 	return conf
 }
 
@@ -224,6 +250,8 @@ type TxPool struct {
 	mu          sync.RWMutex
 
 	istanbul bool // Fork indicator whVBGer we are in the istanbul stage.
+	eip2718  bool // Fork indicator whVBGer we are using EIP-2718 type transactions.
+	eip1559  bool // Fork indicator whVBGer we are using EIP-1559 type transactions.
 
 	currentState  *state.StateDB // Current state in the blockchain head
 	pendingNonces *txNoncer      // Pending state tracking virtual nonces
@@ -231,12 +259,17 @@ type TxPool struct {
 
 	locals  *accountSet // Set of local transaction to exempt from eviction rules
 	journal *txJournal  // Journal of local transaction to back up to disk
+	store   *txStore    // Store of remote transactions to back up to disk
 
 	pending map[common.Address]*txList   // All currently processable transactions
 	queue   map[common.Address]*txList   // Queued but non-processable transactions
 	beats   map[common.Address]time.Time // Last heartbeat from each known account
 	all     *txLookup                    // All transactions to allow lookups
 	priced  *txPricedList                // All transactions sorted by price
+	private map[common.Hash]*privateTx   // Transactions withheld from the network
+	policy  *txPolicy                    // Admission policy applied on top of the config
+
+	unmetered bool // WhVBGer transactions being added are exempt from the policy rate limits
 
 	chainHeadCh     chan ChainHeadEvent
 	chainHeadSub    event.Subscription
@@ -248,6 +281,13 @@ type TxPool struct {
 	wg              sync.WaitGroup // tracks loop, scheduleReorgLoop
 }
 
+// privateTx tracks the expiry of a transaction that is not announced to the
+// network, only included in locally mined blocks.
+type privateTx struct {
+	expiry  uint64 // Block number after which the transaction is no longer private
+	release bool   // WhVBGer to announce the transaction on expiry instead of dropping it
+}
+
 type txpoolResetRequest struct {
 	oldHead, newHead *types.Header
 }
@@ -263,11 +303,12 @@ func NewTxPool(config TxPoolConfig, chainconfig *params.ChainConfig, chain block
 		config:          config,
 		chainconfig:     chainconfig,
 		chain:           chain,
-		signer:          types.NewEIP155Signer(chainconfig.ChainID),
+		signer:          types.LatestSigner(chainconfig),
 		pending:         make(map[common.Address]*txList),
 		queue:           make(map[common.Address]*txList),
 		beats:           make(map[common.Address]time.Time),
 		all:             newTxLookup(),
+		private:         make(map[common.Hash]*privateTx),
 		chainHeadCh:     make(chan ChainHeadEvent, chainHeadChanSize),
 		reqResetCh:      make(chan *txpoolResetRequest),
 		reqPromoteCh:    make(chan *accountSet),
@@ -281,6 +322,11 @@ func NewTxPool(config TxPoolConfig, chainconfig *params.ChainConfig, chain block
 		log.Info("Setting new local account", "address", addr)
 		pool.locals.add(addr)
 	}
+	if config.Policy != "" {
+		if err := pool.ReloadPolicy(); err != nil {
+			log.Error("Failed to load transaction policy", "err", err)
+		}
+	}
 	pool.priced = newTxPricedList(pool.all)
 	pool.reset(nil, chain.CurrentBlock().Header())
 
@@ -299,6 +345,16 @@ func NewTxPool(config TxPoolConfig, chainconfig *params.ChainConfig, chain block
 			log.Warn("Failed to rotate transaction journal", "err", err)
 		}
 	}
+	// If the remote transaction store is enabled, restore it from disk
+	if config.Store != "" {
+		pool.store = newTxStore(config.Store, config.StoreSize*1024*1024)
+
+		pool.unmetered = true
+		if err := pool.store.load(config.Lifetime, pool.restore); err != nil {
+			log.Warn("Failed to load transaction pool store", "err", err)
+		}
+		pool.unmetered = false
+	}
 
 	// Subscribe events from blockchain and start the main event loop.
 	pool.chainHeadSub = pool.chain.SubscribeChainHeadEvent(pool.chainHeadCh)
@@ -320,12 +376,14 @@ func (pool *TxPool) loop() {
 		report  = time.NewTicker(statsReportInterval)
 		evict   = time.NewTicker(evictionInterval)
 		journal = time.NewTicker(pool.config.Rejournal)
+		persist = time.NewTicker(pool.config.StoreInterval)
 		// Track the previous head headers for transaction reorgs
 		head = pool.chain.CurrentBlock()
 	)
 	defer report.Stop()
 	defer evict.Stop()
 	defer journal.Stop()
+	defer persist.Stop()
 
 	for {
 		select {
@@ -381,6 +439,12 @@ func (pool *TxPool) loop() {
 				}
 				pool.mu.Unlock()
 			}
+
+		// Handle remote transaction store regeneration
+		case <-persist.C:
+			if pool.store != nil {
+				pool.persist()
+			}
 		}
 	}
 }
@@ -397,6 +461,9 @@ func (pool *TxPool) Stop() {
 	if pool.journal != nil {
 		pool.journal.close()
 	}
+	if pool.store != nil {
+		pool.persist()
+	}
 	log.Info("Transaction pool stopped")
 }
 
@@ -427,6 +494,49 @@ func (pool *TxPool) SetGasPrice(price *big.Int) {
 	log.Info("Transaction pool price threshold updated", "price", price)
 }
 
+// SetPolicy replaces the admission policy of the pool, removing it if nil. The
+// policy only applies to transactions added afterwards, and its rate limits are
+// counted afresh.
+func (pool *TxPool) SetPolicy(config *TxPolicy) error {
+	var policy *txPolicy
+	if config != nil {
+		var err error
+		if policy, err = newTxPolicy(config); err != nil {
+			return err
+		}
+	}
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	pool.policy = policy
+	log.Info("Transaction pool admission policy updated", "enabled", policy != nil)
+	return nil
+}
+
+// ReloadPolicy reloads the admission policy from the file configured for the
+// pool. The current policy is kept if the file cannot be loaded.
+func (pool *TxPool) ReloadPolicy() error {
+	if pool.config.Policy == "" {
+		return errors.New("no transaction policy file configured")
+	}
+	config, err := LoadTxPolicy(pool.config.Policy)
+	if err != nil {
+		return err
+	}
+	return pool.SetPolicy(config)
+}
+
+// Policy returns the admission policy currently enforced, nil if none.
+func (pool *TxPool) Policy() *TxPolicy {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	if pool.policy == nil {
+		return nil
+	}
+	return pool.policy.config
+}
+
 // Nonce returns the next nonce of an account, with all transactions executable
 // by the pool already applied on top.
 func (pool *TxPool) Nonce(addr common.Address) uint64 {
@@ -499,24 +609,117 @@ func (pool *TxPool) Locals() []common.Address {
 }
 
 // local retrieves all currently known local transactions, grouped by origin
-// account and sorted by nonce. The returned transaction set is a copy and can be
-// freely modified by calling code.
+// account and sorted by nonce, excluding any private ones so that they're never
+// journaled. The returned transaction set is a copy and can be freely modified
+// by calling code.
 func (pool *TxPool) local() map[common.Address]types.Transactions {
 	txs := make(map[common.Address]types.Transactions)
 	for addr := range pool.locals.accounts {
+		var all types.Transactions
 		if pending := pool.pending[addr]; pending != nil {
-			txs[addr] = append(txs[addr], pending.Flatten()...)
+			all = append(all, pending.Flatten()...)
 		}
 		if queued := pool.queue[addr]; queued != nil {
-			txs[addr] = append(txs[addr], queued.Flatten()...)
+			all = append(all, queued.Flatten()...)
+		}
+		for _, tx := range all {
+			if _, ok := pool.private[tx.Hash()]; !ok {
+				txs[addr] = append(txs[addr], tx)
+			}
+		}
+	}
+	return txs
+}
+
+// remotes retrieves all currently known remote transactions which may be
+// announced to the network, executable ones first in price and nonce order,
+// followed by the queued ones.
+func (pool *TxPool) remotes() []*types.Transaction {
+	pending := make(map[common.Address]types.Transactions)
+	for addr, list := range pool.pending {
+		if !pool.locals.contains(addr) {
+			pending[addr] = list.Flatten()
+		}
+	}
+	var txs []*types.Transaction
+
+	ordered := types.NewTransactionsByPriceAndNonce(pool.signer, pending, nil)
+	for tx := ordered.Peek(); tx != nil; tx = ordered.Peek() {
+		if _, ok := pool.private[tx.Hash()]; !ok {
+			txs = append(txs, tx)
+		}
+		ordered.Shift()
+	}
+	for addr, list := range pool.queue {
+		if pool.locals.contains(addr) {
+			continue
+		}
+		for _, tx := range list.Flatten() {
+			if _, ok := pool.private[tx.Hash()]; !ok {
+				txs = append(txs, tx)
+			}
 		}
 	}
 	return txs
 }
 
+// restore adds transactions loaded from the remote transaction store to the pool,
+// reinstating the heartbeats of their senders so that queued transactions expire
+// as if the node never restarted.
+func (pool *TxPool) restore(txs []*storedTx) []error {
+	plain := make([]*types.Transaction, len(txs))
+	for i, stored := range txs {
+		plain[i] = stored.Tx
+	}
+	errs := pool.AddRemotesSync(plain)
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	for i, stored := range txs {
+		if errs[i] != nil || stored.Beat == 0 {
+			continue
+		}
+		from, _ := types.Sender(pool.signer, stored.Tx) // already validated
+		if _, ok := pool.beats[from]; ok {
+			pool.beats[from] = time.Unix(0, int64(stored.Beat))
+		}
+	}
+	return errs
+}
+
+// persist regenerates the remote transaction store with the current contents of
+// the pool. Private transactions are never persisted, as they would be announced
+// to the network once restored.
+func (pool *TxPool) persist() {
+	pool.mu.RLock()
+	remotes := pool.remotes()
+	txs := make([]*storedTx, len(remotes))
+	for i, tx := range remotes {
+		from, _ := types.Sender(pool.signer, tx) // already validated
+		txs[i] = &storedTx{Tx: tx, Time: uint64(tx.Time().UnixNano())}
+		if beat, ok := pool.beats[from]; ok {
+			txs[i].Beat = uint64(beat.UnixNano())
+		}
+	}
+	pool.mu.RUnlock()
+
+	if err := pool.store.save(txs); err != nil {
+		log.Warn("Failed to persist transaction pool", "err", err)
+	}
+}
+
 // validateTx checks whVBGer a transaction is valid according to the consensus
 // rules and adheres to some heuristic limits of the local node (price and size).
 func (pool *TxPool) validateTx(tx *types.Transaction, local bool) error {
+	// Accept only legacy transactions until EIP-2718/2930 activates.
+	if !pool.eip2718 && tx.Type() != types.LegacyTxType {
+		return ErrTxTypeNotSupported
+	}
+	// Reject dynamic fee transactions until EIP-1559 activates.
+	if !pool.eip1559 && tx.Type() == types.DynamicFeeTxType {
+		return ErrTxTypeNotSupported
+	}
 	// Reject transactions over defined size to prevent DOS attacks
 	if uint64(tx.Size()) > txMaxSize {
 		return ErrOversizedData
@@ -530,14 +733,25 @@ func (pool *TxPool) validateTx(tx *types.Transaction, local bool) error {
 	if pool.currentMaxGas < tx.Gas() {
 		return ErrGasLimit
 	}
+	// Sanity check for extremely large numbers
+	if tx.GasFeeCap().BitLen() > 256 {
+		return ErrFeeCapVeryHigh
+	}
+	if tx.GasTipCap().BitLen() > 256 {
+		return ErrTipVeryHigh
+	}
+	// Ensure gasFeeCap is greater than or equal to gasTipCap.
+	if tx.GasFeeCapIntCmp(tx.GasTipCap()) < 0 {
+		return ErrTipAboveFeeCap
+	}
 	// Make sure the transaction is signed properly
 	from, err := types.Sender(pool.signer, tx)
 	if err != nil {
 		return ErrInvalidSender
 	}
-	// Drop non-local transactions under our own minimal accepted gas price
+	// Drop non-local transactions under our own minimal accepted gas price or tip
 	local = local || pool.locals.contains(from) // account may be local even if the transaction arrived from the network
-	if !local && tx.GasPriceIntCmp(pool.gasPrice) < 0 {
+	if !local && tx.GasTipCapIntCmp(pool.gasPrice) < 0 {
 		return ErrUnderpriced
 	}
 	// Ensure the transaction adheres to nonce ordering
@@ -545,18 +759,28 @@ func (pool *TxPool) validateTx(tx *types.Transaction, local bool) error {
 		return ErrNonceTooLow
 	}
 	// Transactor should have enough funds to cover the costs
-	// cost == V + GP * GL
+	// cost == V + GP * GL, with the fee cap standing in for GP post EIP-1559
 	if pool.currentState.GetBalance(from).Cmp(tx.Cost()) < 0 {
 		return ErrInsufficientFunds
 	}
 	// Ensure the transaction has more gas than the basic tx fee.
-	intrGas, err := IntrinsicGas(tx.Data(), tx.To() == nil, true, pool.istanbul)
+	intrGas, err := IntrinsicGas(tx.Data(), tx.AccessList(), tx.To() == nil, true, pool.istanbul)
 	if err != nil {
 		return err
 	}
 	if tx.Gas() < intrGas {
 		return ErrIntrinsicGas
 	}
+	// Enforce the admission policy last. Transactions re-added to the pool rather
+	// than newly received are exempt from the rate limits.
+	if pool.policy != nil {
+		if err := pool.policy.validate(tx, from, local); err != nil {
+			return err
+		}
+		if !local && !pool.unmetered {
+			return pool.policy.limit(from, time.Now())
+		}
+	}
 	return nil
 }
 
@@ -681,10 +905,13 @@ func (pool *TxPool) enqueueTx(hash common.Hash, tx *types.Transaction) (bool, er
 // journalTx adds the specified transaction to the local disk journal if it is
 // deemed to have been sent from a local account.
 func (pool *TxPool) journalTx(from common.Address, tx *types.Transaction) {
-	// Only journal if it's enabled and the transaction is local
+	// Only journal if it's enabled and the transaction is local, but not private
 	if pool.journal == nil || !pool.locals.contains(from) {
 		return
 	}
+	if _, ok := pool.private[tx.Hash()]; ok {
+		return
+	}
 	if err := pool.journal.insert(tx); err != nil {
 		log.Warn("Failed to journal local transaction", "err", err)
 	}
@@ -835,6 +1062,9 @@ func (pool *TxPool) addTxsLocked(txs []*types.Transaction, local bool) ([]error,
 	for i, tx := range txs {
 		replaced, err := pool.add(tx, local)
 		errs[i] = err
+		if err == nil {
+			pool.chargePolicy(tx, local)
+		}
 		if err == nil && !replaced {
 			dirty.addTx(tx)
 		}
@@ -843,6 +1073,21 @@ func (pool *TxPool) addTxsLocked(txs []*types.Transaction, local bool) ([]error,
 	return errs, dirty
 }
 
+// chargePolicy counts a newly pooled remote transaction towards the rate limit
+// of its sender, unless it was merely re-added to the pool.
+//
+// Note, this mVBGod assumes the pool lock is held!
+func (pool *TxPool) chargePolicy(tx *types.Transaction, local bool) {
+	if pool.policy == nil || pool.unmetered {
+		return
+	}
+	from, _ := types.Sender(pool.signer, tx) // already validated
+	if local || pool.locals.contains(from) {
+		return
+	}
+	pool.policy.charge(from, time.Now())
+}
+
 // Status returns the status (unknown/pending/queued) of a batch of transactions
 // identified by their hashes.
 func (pool *TxPool) Status(hashes []common.Hash) []TxStatus {
@@ -877,6 +1122,80 @@ func (pool *TxPool) Has(hash common.Hash) bool {
 	return pool.all.Get(hash) != nil
 }
 
+// AddPrivate enqueues a single transaction into the pool without ever announcing
+// it to the network. The transaction remains eligible for inclusion by the local
+// miner until the expiry block is reached, after which it is either dropped or,
+// if release is set, announced like any other local transaction.
+//
+// Private transactions are added as local ones, exempting them from the price
+// limits and eviction rules that could otherwise silently drop them before their
+// expiry. They are never journaled, as they would be announced once reloaded.
+func (pool *TxPool) AddPrivate(tx *types.Transaction, expiry uint64, release bool) error {
+	hash := tx.Hash()
+
+	pool.mu.Lock()
+	if pool.all.Get(hash) != nil {
+		pool.mu.Unlock()
+		knownTxMeter.Mark(1)
+		return ErrAlreadyKnown
+	}
+	if expiry <= pool.chain.CurrentBlock().NumberU64() {
+		pool.mu.Unlock()
+		return ErrPrivateExpired
+	}
+	pool.private[hash] = &privateTx{expiry: expiry, release: release}
+	pool.mu.Unlock()
+
+	if err := pool.addTxs([]*types.Transaction{tx}, !pool.config.NoLocals, true)[0]; err != nil {
+		pool.mu.Lock()
+		delete(pool.private, hash)
+		pool.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// IsPrivate returns an indicator whVBGer the transaction with the given hash is
+// being withheld from the network.
+func (pool *TxPool) IsPrivate(hash common.Hash) bool {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	_, ok := pool.private[hash]
+	return ok
+}
+
+// expirePrivate drops or releases all private transactions whose expiry block
+// has been reached, returning the released ones which are already executable.
+// Entries of transactions which left the pool in the meantime are discarded.
+func (pool *TxPool) expirePrivate(number uint64) []*types.Transaction {
+	var released []*types.Transaction
+	for hash, ptx := range pool.private {
+		tx := pool.all.Get(hash)
+		if tx == nil {
+			delete(pool.private, hash)
+			continue
+		}
+		if number < ptx.expiry {
+			continue
+		}
+		delete(pool.private, hash)
+		if !ptx.release {
+			pool.removeTx(hash, true)
+			privateExpiredMeter.Mark(1)
+			continue
+		}
+		privateReleasedMeter.Mark(1)
+
+		// Queued transactions will be announced once promoted
+		addr, _ := types.Sender(pool.signer, tx) // already validated during insertion
+		if list := pool.pending[addr]; list != nil && list.txs.Get(tx.Nonce()) == tx {
+			released = append(released, tx)
+		}
+	}
+	return released
+}
+
 // removeTx removes a single transaction from the queue, moving all subsequent
 // transactions back to the future queue.
 func (pool *TxPool) removeTx(hash common.Hash, outofbound bool) {
@@ -1065,6 +1384,13 @@ func (pool *TxPool) runReorg(done chan struct{}, reset *txpoolResetRequest, dirt
 	// because of another transaction (e.g. higher gas price).
 	if reset != nil {
 		pool.demoteUnexecutables()
+		if reset.newHead != nil {
+			promoted = append(promoted, pool.expirePrivate(reset.newHead.Number.Uint64())...)
+		}
+		if reset.newHead != nil && pool.chainconfig.IsEIP1559(new(big.Int).Add(reset.newHead.Number, big.NewInt(1))) {
+			pendingBaseFee := misc.CalcBaseFee(pool.chainconfig, reset.newHead)
+			pool.priced.SetBaseFee(pendingBaseFee)
+		}
 	}
 	// Ensure pool.queue and pool.pending sizes stay within the configured limits.
 	pool.truncatePending()
@@ -1075,9 +1401,7 @@ func (pool *TxPool) runReorg(done chan struct{}, reset *txpoolResetRequest, dirt
 		highestPending := list.LastElement()
 		pool.pendingNonces.set(addr, highestPending.Nonce()+1)
 	}
-	pool.mu.Unlock()
-
-	// Notify subsystems for newly added transactions
+	// Gather the newly added transactions, withholding the private ones
 	for _, tx := range promoted {
 		addr, _ := types.Sender(pool.signer, tx)
 		if _, ok := events[addr]; !ok {
@@ -1085,11 +1409,18 @@ func (pool *TxPool) runReorg(done chan struct{}, reset *txpoolResetRequest, dirt
 		}
 		events[addr].Put(tx)
 	}
-	if len(events) > 0 {
-		var txs []*types.Transaction
-		for _, set := range events {
-			txs = append(txs, set.Flatten()...)
+	var txs []*types.Transaction
+	for _, set := range events {
+		for _, tx := range set.Flatten() {
+			if _, ok := pool.private[tx.Hash()]; !ok {
+				txs = append(txs, tx)
+			}
 		}
+	}
+	pool.mu.Unlock()
+
+	// Notify subsystems for newly added transactions
+	if len(txs) > 0 {
 		pool.txFeed.Send(NewTxsEvent{txs})
 	}
 }
@@ -1175,11 +1506,16 @@ func (pool *TxPool) reset(oldHead, newHead *types.Header) {
 	// Inject any transactions discarded due to reorgs
 	log.Debug("Reinjecting stale transactions", "count", len(reinject))
 	senderCacher.recover(pool.signer, reinject)
+
+	pool.unmetered = true
 	pool.addTxsLocked(reinject, false)
+	pool.unmetered = false
 
 	// Update all fork indicator by next pending block number.
 	next := new(big.Int).Add(newHead.Number, big.NewInt(1))
 	pool.istanbul = pool.chainconfig.IsIstanbul(next)
+	pool.eip2718 = pool.chainconfig.IsEIP2718(next)
+	pool.eip1559 = pool.chainconfig.IsEIP1559(next)
 }
 
 // promoteExecutables moves transactions that have become processable from the
@@ -24,6 +24,7 @@ import (
 	"math/big"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -34,6 +35,7 @@ import (
 	"github.com/vbgloble/go-VGB/crypto"
 	"github.com/vbgloble/go-VGB/event"
 	"github.com/vbgloble/go-VGB/params"
+	"github.com/vbgloble/go-VGB/rlp"
 	"github.com/vbgloble/go-VGB/trie"
 )
 
@@ -1051,6 +1053,147 @@ func TestTransactionPendingLimiting(t *testing.T) {
 	}
 }
 
+// Tests that private transactions are never announced via the event feed, and
+// that they are dropped or released once their expiry block is reached.
+func TestTransactionPrivate(t *testing.T) {
+	t.Parallel()
+
+	// Create the pool and fund a dropped and a released account
+	pool, key := setupTxPool()
+	defer pool.Stop()
+
+	other, _ := crypto.GenerateKey()
+	pool.currentState.AddBalance(crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1000000))
+	pool.currentState.AddBalance(crypto.PubkeyToAddress(other.PublicKey), big.NewInt(1000000))
+
+	events := make(chan NewTxsEvent, 32)
+	sub := pool.txFeed.Subscribe(events)
+	defer sub.Unsubscribe()
+
+	dropped, released := transaction(0, 100000, key), transaction(0, 100000, other)
+	if err := pool.AddPrivate(dropped, 2, false); err != nil {
+		t.Fatalf("failed to add private transaction: %v", err)
+	}
+	if err := pool.AddPrivate(released, 2, true); err != nil {
+		t.Fatalf("failed to add private transaction: %v", err)
+	}
+	if err := pool.AddPrivate(dropped, 2, false); err != ErrAlreadyKnown {
+		t.Fatalf("duplicate private transaction error mismatch: have %v, want %v", err, ErrAlreadyKnown)
+	}
+	if err := pool.AddPrivate(transaction(1, 100000, key), 0, false); err != ErrPrivateExpired {
+		t.Fatalf("expired private transaction error mismatch: have %v, want %v", err, ErrPrivateExpired)
+	}
+	if pending, _ := pool.Stats(); pending != 2 {
+		t.Fatalf("pending transactions mismatched: have %d, want %d", pending, 2)
+	}
+	if !pool.IsPrivate(dropped.Hash()) || !pool.IsPrivate(released.Hash()) {
+		t.Fatalf("transactions not tracked as private")
+	}
+	if err := validateEvents(events, 0); err != nil {
+		t.Fatalf("private transactions announced: %v", err)
+	}
+	// Advance the chain to just before the expiry and ensure nothing changes
+	<-pool.requestReset(nil, &types.Header{Number: big.NewInt(1), GasLimit: 10000000})
+	if pending, _ := pool.Stats(); pending != 2 {
+		t.Fatalf("pending transactions mismatched: have %d, want %d", pending, 2)
+	}
+	if err := validateEvents(events, 0); err != nil {
+		t.Fatalf("private transactions announced before expiry: %v", err)
+	}
+	// Reach the expiry and ensure one is dropped and the other announced
+	<-pool.requestReset(nil, &types.Header{Number: big.NewInt(2), GasLimit: 10000000})
+	if pool.Has(dropped.Hash()) {
+		t.Fatalf("expired private transaction not dropped")
+	}
+	if !pool.Has(released.Hash()) || pool.IsPrivate(released.Hash()) {
+		t.Fatalf("expired private transaction not released")
+	}
+	if err := validateEvents(events, 1); err != nil {
+		t.Fatalf("released transaction not announced: %v", err)
+	}
+	if err := validateTxPoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
+
+// Tests that private transactions are exempt from the price limits, policies and
+// eviction rules applying to remote transactions, so that they survive a full
+// pool, and that they are never journaled.
+func TestTransactionPrivateRetention(t *testing.T) {
+	t.Parallel()
+
+	// Create a temporary file for the journal, we only need the path for now
+	file, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("failed to create temporary journal: %v", err)
+	}
+	journal := file.Name()
+	defer os.Remove(journal)
+
+	file.Close()
+	os.Remove(journal)
+
+	// Create a tiny pool with a strict policy
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	blockchain := &testBlockChain{statedb, 1000000, new(event.Feed)}
+
+	config := testTxPoolConfig
+	config.Journal = journal
+	config.GlobalSlots = 2
+	config.GlobalQueue = 2
+
+	pool := NewTxPool(config, params.TestChainConfig, blockchain)
+	if err := pool.SetPolicy(&TxPolicy{MinGasPrice: big.NewInt(10), RemotesPerMinute: 1}); err != nil {
+		t.Fatalf("failed to set policy: %v", err)
+	}
+	keys := make([]*ecdsa.PrivateKey, 6)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		pool.currentState.AddBalance(crypto.PubkeyToAddress(keys[i].PublicKey), big.NewInt(1000000000))
+	}
+	// Add a cheap private transaction, below both the policy floor and rate limit
+	private := pricedTransaction(0, 100000, big.NewInt(1), keys[0])
+	if err := pool.AddPrivate(private, 10, false); err != nil {
+		t.Fatalf("failed to add private transaction: %v", err)
+	}
+	if err := pool.AddPrivate(pricedTransaction(1, 100000, big.NewInt(1), keys[0]), 10, false); err != nil {
+		t.Fatalf("failed to add second private transaction: %v", err)
+	}
+	// Overflow the pool with pricier remote transactions
+	for _, key := range keys[1:] {
+		if err := pool.addRemoteSync(pricedTransaction(0, 100000, big.NewInt(20), key)); err != nil {
+			t.Fatalf("failed to add remote transaction: %v", err)
+		}
+	}
+	if !pool.Has(private.Hash()) || !pool.IsPrivate(private.Hash()) {
+		t.Fatalf("private transaction evicted by full pool")
+	}
+	// Raise the price floor above every transaction and ensure it still survives
+	pool.SetGasPrice(big.NewInt(100))
+	if !pool.Has(private.Hash()) {
+		t.Fatalf("private transaction evicted by price floor")
+	}
+	if err := validateTxPoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+	// Ensure the private transactions never made it into the journal
+	if txs := pool.local()[crypto.PubkeyToAddress(keys[0].PublicKey)]; len(txs) != 0 {
+		t.Fatalf("private transactions up for journaling: %d", len(txs))
+	}
+	pool.Stop()
+
+	var journaled int
+	if err := newTxJournal(journal).load(func(txs []*types.Transaction) []error {
+		journaled += len(txs)
+		return make([]error, len(txs))
+	}); err != nil {
+		t.Fatalf("failed to load journal: %v", err)
+	}
+	if journaled != 0 {
+		t.Fatalf("private transactions journaled: %d", journaled)
+	}
+}
+
 // Tests that if the transaction count belonging to multiple accounts go above
 // some hard threshold, the higher transactions are dropped to prevent DOS
 // attacks.
@@ -1849,6 +1992,126 @@ func testTransactionJournaling(t *testing.T, nolocals bool) {
 	pool.Stop()
 }
 
+// Tests that remote transactions, but not local or private ones, are persisted
+// on shutdown and revalidated when the pool is restarted, retaining their age
+// and the heartbeats of their senders.
+func TestTransactionStore(t *testing.T) {
+	t.Parallel()
+
+	// Create a temporary file for the store, we only need the path for now
+	file, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("failed to create temporary store: %v", err)
+	}
+	store := file.Name()
+	defer os.Remove(store)
+
+	file.Close()
+	os.Remove(store)
+
+	// Create the original pool to inject transactions into the store
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	blockchain := &testBlockChain{statedb, 1000000, new(event.Feed)}
+
+	config := testTxPoolConfig
+	config.Store = store
+
+	pool := NewTxPool(config, params.TestChainConfig, blockchain)
+
+	keys := make([]*ecdsa.PrivateKey, 4)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		pool.currentState.AddBalance(crypto.PubkeyToAddress(keys[i].PublicKey), big.NewInt(1000000000))
+	}
+	remote, gapped, local, private := keys[0], keys[1], keys[2], keys[3]
+
+	// Add two executable and one queued remote transaction, along with a local
+	// and a private one which must not be stored
+	second := pricedTransaction(1, 100000, big.NewInt(1), remote)
+	if errs := pool.AddRemotesSync([]*types.Transaction{pricedTransaction(0, 100000, big.NewInt(1), remote), second, pricedTransaction(1, 100000, big.NewInt(1), gapped)}); errs[0] != nil || errs[1] != nil || errs[2] != nil {
+		t.Fatalf("failed to add remote transactions: %v", errs)
+	}
+	if err := pool.AddLocal(pricedTransaction(0, 100000, big.NewInt(1), local)); err != nil {
+		t.Fatalf("failed to add local transaction: %v", err)
+	}
+	if err := pool.AddPrivate(pricedTransaction(0, 100000, big.NewInt(1), private), 10, false); err != nil {
+		t.Fatalf("failed to add private transaction: %v", err)
+	}
+	if pending, queued := pool.Stats(); pending != 4 || queued != 1 {
+		t.Fatalf("transaction count mismatch: have %d/%d, want %d/%d", pending, queued, 4, 1)
+	}
+	pool.mu.RLock()
+	beat := pool.beats[crypto.PubkeyToAddress(gapped.PublicKey)]
+	pool.mu.RUnlock()
+
+	// Terminate the old pool, bump the remote nonce, create a new pool and ensure
+	// the remaining remote transactions survive
+	pool.Stop()
+	statedb.SetNonce(crypto.PubkeyToAddress(remote.PublicKey), 1)
+	blockchain = &testBlockChain{statedb, 1000000, new(event.Feed)}
+
+	pool = NewTxPool(config, params.TestChainConfig, blockchain)
+
+	if pending, queued := pool.Stats(); pending != 1 || queued != 1 {
+		t.Fatalf("transaction count mismatch: have %d/%d, want %d/%d", pending, queued, 1, 1)
+	}
+	if err := validateTxPoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+	if tx := pool.Get(second.Hash()); tx == nil || !tx.Time().Equal(second.Time()) {
+		t.Fatalf("restored transaction age not retained")
+	}
+	pool.mu.RLock()
+	restored := pool.beats[crypto.PubkeyToAddress(gapped.PublicKey)]
+	pool.mu.RUnlock()
+	if !restored.Equal(beat) {
+		t.Fatalf("restored heartbeat mismatch: have %v, want %v", restored, beat)
+	}
+	// Restart with a lifetime already passed and ensure nothing is restored
+	pool.Stop()
+
+	config.Lifetime = time.Nanosecond
+	pool = NewTxPool(config, params.TestChainConfig, blockchain)
+	defer pool.Stop()
+
+	if pending, queued := pool.Stats(); pending != 0 || queued != 0 {
+		t.Fatalf("transaction count mismatch: have %d/%d, want %d/%d", pending, queued, 0, 0)
+	}
+}
+
+// Tests that the transaction store stops writing once its size limit is reached.
+func TestTransactionStoreLimit(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	key, _ := crypto.GenerateKey()
+	txs := make([]*storedTx, 3)
+	for i := range txs {
+		tx := transaction(uint64(i), 100000, key)
+		txs[i] = &storedTx{Tx: tx, Time: uint64(tx.Time().UnixNano())}
+	}
+	blob, _ := rlp.EncodeToBytes(txs[0])
+	store := newTxStore(filepath.Join(dir, "store.rlp"), uint64(2*len(blob)))
+	if err := store.save(txs); err != nil {
+		t.Fatalf("failed to save transactions: %v", err)
+	}
+	var loaded []*storedTx
+	if err := store.load(time.Hour, func(txs []*storedTx) []error {
+		loaded = append(loaded, txs...)
+		return make([]error, len(txs))
+	}); err != nil {
+		t.Fatalf("failed to load transactions: %v", err)
+	}
+	if len(loaded) != 2 || loaded[0].Tx.Hash() != txs[0].Tx.Hash() || loaded[1].Tx.Hash() != txs[1].Tx.Hash() {
+		t.Fatalf("stored transactions mismatch: have %d, want first %d", len(loaded), 2)
+	}
+}
+
 // TestTransactionStatusCheck tests that the pool can correctly retrieve the
 // pending status of individual transactions.
 func TestTransactionStatusCheck(t *testing.T) {
@@ -0,0 +1,181 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/log"
+	"github.com/vbgloble/go-VGB/rlp"
+)
+
+// txStore is a periodically regenerated snapshot of the remote transactions in
+// the pool, allowing them to survive node restarts. Contrary to the journal of
+// local transactions, it is bounded in size and its contents expire.
+type txStore struct {
+	path  string // Filesystem path to store the transactions at
+	limit uint64 // Maximum size of the store in bytes
+}
+
+// storedTx is a transaction persisted along with the time it was first seen and
+// the last heartbeat of its sender, so that its age is retained across restarts.
+type storedTx struct {
+	Tx   *types.Transaction
+	Time uint64 // Unix time in nanoseconds the transaction was first seen
+	Beat uint64 // Unix time in nanoseconds of the sender's last heartbeat
+}
+
+// newTxStore creates a new transaction store at the given path.
+func newTxStore(path string, limit uint64) *txStore {
+	return &txStore{
+		path:  path,
+		limit: limit,
+	}
+}
+
+// load parses a transaction store from disk, loading all transactions first seen
+// within the given lifetime into the specified pool.
+func (store *txStore) load(lifetime time.Duration, add func([]*storedTx) []error) error {
+	// Skip the parsing if the store file doesn't exist at all
+	if _, err := os.Stat(store.path); os.IsNotExist(err) {
+		return nil
+	}
+	input, err := os.Open(store.path)
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	var (
+		stream = rlp.NewStream(input, 0)
+		cutoff = time.Now().Add(-lifetime)
+
+		total, stale, dropped int
+		failure               error
+		batch                 []*storedTx
+	)
+	loadBatch := func(txs []*storedTx) {
+		for _, err := range add(txs) {
+			if err != nil {
+				log.Trace("Failed to add stored transaction", "err", err)
+				dropped++
+			}
+		}
+	}
+	for {
+		// Parse the next transaction and terminate on error
+		stored := new(storedTx)
+		if err = stream.Decode(stored); err != nil {
+			if err != io.EOF {
+				failure = err
+			}
+			if len(batch) > 0 {
+				loadBatch(batch)
+			}
+			break
+		}
+		total++
+
+		// Skip transactions past their lifetime, restore the age of the rest
+		seen := time.Unix(0, int64(stored.Time))
+		if seen.Before(cutoff) {
+			stale++
+			continue
+		}
+		stored.Tx.SetTime(seen)
+
+		if batch = append(batch, stored); len(batch) > 1024 {
+			loadBatch(batch)
+			batch = batch[:0]
+		}
+	}
+	log.Info("Loaded transaction pool store", "transactions", total, "stale", stale, "dropped", dropped)
+
+	return failure
+}
+
+// save regenerates the transaction store with the given transactions, which are
+// written in order until the size limit is reached. The new store is synced to
+// disk before replacing the old one, so a crash never leaves it truncated.
+func (store *txStore) save(txs []*storedTx) error {
+	output, err := os.OpenFile(store.path+".new", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	var (
+		writer = bufio.NewWriter(output)
+		size   uint64
+		saved  int
+	)
+	for _, tx := range txs {
+		blob, err := rlp.EncodeToBytes(tx)
+		if err != nil {
+			output.Close()
+			return err
+		}
+		if size+uint64(len(blob)) > store.limit {
+			break
+		}
+		if _, err := writer.Write(blob); err != nil {
+			output.Close()
+			return err
+		}
+		size += uint64(len(blob))
+		saved++
+	}
+	if err := writer.Flush(); err != nil {
+		output.Close()
+		return err
+	}
+	if err := output.Sync(); err != nil {
+		output.Close()
+		return err
+	}
+	output.Close()
+
+	// Replace the live store with the newly generated one and persist the rename
+	if err := os.Rename(store.path+".new", store.path); err != nil {
+		return err
+	}
+	if err := syncDir(filepath.Dir(store.path)); err != nil {
+		return err
+	}
+	log.Info("Persisted transaction pool store", "transactions", saved, "skipped", len(txs)-saved, "size", common.StorageSize(size))
+	return nil
+}
+
+// syncDir flushes the entries of a directory to disk, making renames within it
+// durable. Windows does not support syncing directories, nor needs it.
+func syncDir(path string) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+	dir, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+
+	return dir.Sync()
+}
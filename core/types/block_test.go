@@ -69,6 +69,58 @@ func TestBlockEncoding(t *testing.T) {
 	}
 }
 
+// Tests that headers carrying an EIP-1559 base fee and blocks containing dynamic
+// fee transactions survive an RLP round trip.
+func TestEIP1559BlockEncoding(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	addr := common.HexToAddress("095e7baea6a6c7c4c2dfeb977efac326af552d87")
+	tx := MustSignNewTx(key, NewLondonSigner(common.Big1), &DynamicFeeTx{
+		ChainID:   common.Big1,
+		Nonce:     0,
+		To:        &addr,
+		Gas:       50000,
+		GasTipCap: big.NewInt(2),
+		GasFeeCap: big.NewInt(params.InitialBaseFee + 2),
+		Value:     big.NewInt(10),
+	})
+	header := &Header{
+		Difficulty: big.NewInt(131072),
+		Number:     big.NewInt(1),
+		GasLimit:   3141592,
+		Time:       1426516743,
+		BaseFee:    new(big.Int).SetUint64(params.InitialBaseFee),
+	}
+	block := NewBlock(header, []*Transaction{tx}, nil, nil, newHasher())
+
+	enc, err := rlp.EncodeToBytes(block)
+	if err != nil {
+		t.Fatal("encode error: ", err)
+	}
+	var decoded Block
+	if err := rlp.DecodeBytes(enc, &decoded); err != nil {
+		t.Fatal("decode error: ", err)
+	}
+	if decoded.BaseFee() == nil || decoded.BaseFee().Cmp(header.BaseFee) != 0 {
+		t.Errorf("base fee mismatch: got %v, want %v", decoded.BaseFee(), header.BaseFee)
+	}
+	if decoded.Hash() != block.Hash() {
+		t.Errorf("block hash mismatch: got %x, want %x", decoded.Hash(), block.Hash())
+	}
+	if len(decoded.Transactions()) != 1 || decoded.Transactions()[0].Hash() != tx.Hash() {
+		t.Fatalf("transaction mismatch after decoding")
+	}
+	if got := decoded.Transactions()[0].Type(); got != DynamicFeeTxType {
+		t.Errorf("transaction type mismatch: got %d, want %d", got, DynamicFeeTxType)
+	}
+	from, err := Sender(NewLondonSigner(common.Big1), decoded.Transactions()[0])
+	if err != nil {
+		t.Fatal("sender error: ", err)
+	}
+	if want := crypto.PubkeyToAddress(key.PublicKey); from != want {
+		t.Errorf("sender mismatch: got %x, want %x", from, want)
+	}
+}
+
 func TestUncleHash(t *testing.T) {
 	uncles := make([]*Header, 0)
 	h := CalcUncleHash(uncles)
@@ -11,23 +11,29 @@ import (
 	"github.com/vbgloble/go-VGB/common/hexutil"
 )
 
-var _ = (*txdataMarshaling)(nil)
+var _ = (*txJSONMarshaling)(nil)
 
 // MarshalJSON marshals as JSON.
-func (t txdata) MarshalJSON() ([]byte, error) {
-	type txdata struct {
-		AccountNonce hexutil.Uint64  `json:"nonce"    gencodec:"required"`
-		Price        *hexutil.Big    `json:"gasPrice" gencodec:"required"`
-		GasLimit     hexutil.Uint64  `json:"gas"      gencodec:"required"`
-		Recipient    *common.Address `json:"to"       rlp:"nil"`
-		Amount       *hexutil.Big    `json:"value"    gencodec:"required"`
-		Payload      hexutil.Bytes   `json:"input"    gencodec:"required"`
-		V            *hexutil.Big    `json:"v" gencodec:"required"`
-		R            *hexutil.Big    `json:"r" gencodec:"required"`
-		S            *hexutil.Big    `json:"s" gencodec:"required"`
-		Hash         *common.Hash    `json:"hash" rlp:"-"`
-	}
-	var enc txdata
+func (t txJSON) MarshalJSON() ([]byte, error) {
+	type txJSON struct {
+		Type                 hexutil.Uint64  `json:"type"`
+		AccountNonce         hexutil.Uint64  `json:"nonce"    gencodec:"required"`
+		Price                *hexutil.Big    `json:"gasPrice" gencodec:"required"`
+		GasLimit             hexutil.Uint64  `json:"gas"      gencodec:"required"`
+		Recipient            *common.Address `json:"to"       rlp:"nil"`
+		Amount               *hexutil.Big    `json:"value"    gencodec:"required"`
+		Payload              hexutil.Bytes   `json:"input"    gencodec:"required"`
+		V                    *hexutil.Big    `json:"v"        gencodec:"required"`
+		R                    *hexutil.Big    `json:"r"        gencodec:"required"`
+		S                    *hexutil.Big    `json:"s"        gencodec:"required"`
+		ChainID              *hexutil.Big    `json:"chainId,omitempty"`
+		AccessList           *AccessList     `json:"accessList,omitempty"`
+		MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas,omitempty"`
+		MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas,omitempty"`
+		Hash                 *common.Hash    `json:"hash" rlp:"-"`
+	}
+	var enc txJSON
+	enc.Type = hexutil.Uint64(t.Type)
 	enc.AccountNonce = hexutil.Uint64(t.AccountNonce)
 	enc.Price = (*hexutil.Big)(t.Price)
 	enc.GasLimit = hexutil.Uint64(t.GasLimit)
@@ -37,63 +43,87 @@ func (t txdata) MarshalJSON() ([]byte, error) {
 	enc.V = (*hexutil.Big)(t.V)
 	enc.R = (*hexutil.Big)(t.R)
 	enc.S = (*hexutil.Big)(t.S)
+	enc.ChainID = (*hexutil.Big)(t.ChainID)
+	enc.AccessList = t.AccessList
+	enc.MaxPriorityFeePerGas = (*hexutil.Big)(t.MaxPriorityFeePerGas)
+	enc.MaxFeePerGas = (*hexutil.Big)(t.MaxFeePerGas)
 	enc.Hash = t.Hash
 	return json.Marshal(&enc)
 }
 
 // UnmarshalJSON unmarshals from JSON.
-func (t *txdata) UnmarshalJSON(input []byte) error {
-	type txdata struct {
-		AccountNonce *hexutil.Uint64 `json:"nonce"    gencodec:"required"`
-		Price        *hexutil.Big    `json:"gasPrice" gencodec:"required"`
-		GasLimit     *hexutil.Uint64 `json:"gas"      gencodec:"required"`
-		Recipient    *common.Address `json:"to"       rlp:"nil"`
-		Amount       *hexutil.Big    `json:"value"    gencodec:"required"`
-		Payload      *hexutil.Bytes  `json:"input"    gencodec:"required"`
-		V            *hexutil.Big    `json:"v" gencodec:"required"`
-		R            *hexutil.Big    `json:"r" gencodec:"required"`
-		S            *hexutil.Big    `json:"s" gencodec:"required"`
-		Hash         *common.Hash    `json:"hash" rlp:"-"`
-	}
-	var dec txdata
+func (t *txJSON) UnmarshalJSON(input []byte) error {
+	type txJSON struct {
+		Type                 *hexutil.Uint64 `json:"type"`
+		AccountNonce         *hexutil.Uint64 `json:"nonce"    gencodec:"required"`
+		Price                *hexutil.Big    `json:"gasPrice" gencodec:"required"`
+		GasLimit             *hexutil.Uint64 `json:"gas"      gencodec:"required"`
+		Recipient            *common.Address `json:"to"       rlp:"nil"`
+		Amount               *hexutil.Big    `json:"value"    gencodec:"required"`
+		Payload              *hexutil.Bytes  `json:"input"    gencodec:"required"`
+		V                    *hexutil.Big    `json:"v"        gencodec:"required"`
+		R                    *hexutil.Big    `json:"r"        gencodec:"required"`
+		S                    *hexutil.Big    `json:"s"        gencodec:"required"`
+		ChainID              *hexutil.Big    `json:"chainId,omitempty"`
+		AccessList           *AccessList     `json:"accessList,omitempty"`
+		MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas,omitempty"`
+		MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas,omitempty"`
+		Hash                 *common.Hash    `json:"hash" rlp:"-"`
+	}
+	var dec txJSON
 	if err := json.Unmarshal(input, &dec); err != nil {
 		return err
 	}
+	if dec.Type != nil {
+		t.Type = uint64(*dec.Type)
+	}
 	if dec.AccountNonce == nil {
-		return errors.New("missing required field 'nonce' for txdata")
+		return errors.New("missing required field 'nonce' for txJSON")
 	}
 	t.AccountNonce = uint64(*dec.AccountNonce)
 	if dec.Price == nil {
-		return errors.New("missing required field 'gasPrice' for txdata")
+		return errors.New("missing required field 'gasPrice' for txJSON")
 	}
 	t.Price = (*big.Int)(dec.Price)
 	if dec.GasLimit == nil {
-		return errors.New("missing required field 'gas' for txdata")
+		return errors.New("missing required field 'gas' for txJSON")
 	}
 	t.GasLimit = uint64(*dec.GasLimit)
 	if dec.Recipient != nil {
 		t.Recipient = dec.Recipient
 	}
 	if dec.Amount == nil {
-		return errors.New("missing required field 'value' for txdata")
+		return errors.New("missing required field 'value' for txJSON")
 	}
 	t.Amount = (*big.Int)(dec.Amount)
 	if dec.Payload == nil {
-		return errors.New("missing required field 'input' for txdata")
+		return errors.New("missing required field 'input' for txJSON")
 	}
 	t.Payload = *dec.Payload
 	if dec.V == nil {
-		return errors.New("missing required field 'v' for txdata")
+		return errors.New("missing required field 'v' for txJSON")
 	}
 	t.V = (*big.Int)(dec.V)
 	if dec.R == nil {
-		return errors.New("missing required field 'r' for txdata")
+		return errors.New("missing required field 'r' for txJSON")
 	}
 	t.R = (*big.Int)(dec.R)
 	if dec.S == nil {
-		return errors.New("missing required field 's' for txdata")
+		return errors.New("missing required field 's' for txJSON")
 	}
 	t.S = (*big.Int)(dec.S)
+	if dec.ChainID != nil {
+		t.ChainID = (*big.Int)(dec.ChainID)
+	}
+	if dec.AccessList != nil {
+		t.AccessList = dec.AccessList
+	}
+	if dec.MaxPriorityFeePerGas != nil {
+		t.MaxPriorityFeePerGas = (*big.Int)(dec.MaxPriorityFeePerGas)
+	}
+	if dec.MaxFeePerGas != nil {
+		t.MaxFeePerGas = (*big.Int)(dec.MaxFeePerGas)
+	}
 	if dec.Hash != nil {
 		t.Hash = dec.Hash
 	}
@@ -108,6 +108,7 @@ type BlockContext struct {
 	BlockNumber *big.Int       // Provides information for NUMBER
 	Time        *big.Int       // Provides information for TIME
 	Difficulty  *big.Int       // Provides information for DIFFICULTY
+	BaseFee     *big.Int       // EIP-1559 base fee of the block, nil before the fork
 }
 
 // TxContext provides the EVM with information about a transaction.
@@ -531,3 +532,6 @@ func (evm *EVM) Create2(caller ContractRef, code []byte, gas uint64, endowment *
 
 // ChainConfig returns the environment's chain configuration
 func (evm *EVM) ChainConfig() *params.ChainConfig { return evm.chainConfig }
+
+// VMConfig returns the environment's virtual machine configuration
+func (evm *EVM) VMConfig() Config { return evm.vmConfig }
@@ -31,7 +31,6 @@ import (
 	"github.com/vbgloble/go-VGB/core/vm"
 	"github.com/vbgloble/go-VGB/VBG/filters"
 	"github.com/vbgloble/go-VGB/internal/VBGapi"
-	"github.com/vbgloble/go-VGB/rlp"
 	"github.com/vbgloble/go-VGB/rpc"
 )
 
@@ -213,10 +212,7 @@ func (t *Transaction) From(ctx context.Context, args BlockNumberArgs) (*Account,
 	if err != nil || tx == nil {
 		return nil, err
 	}
-	var signer types.Signer = types.HomesteadSigner{}
-	if tx.Protected() {
-		signer = types.NewEIP155Signer(tx.ChainId())
-	}
+	signer := types.LatestSignerForChainID(tx.ChainId())
 	from, _ := types.Sender(signer, tx)
 
 	return &Account{
@@ -985,7 +981,7 @@ func (r *Resolver) Transaction(ctx context.Context, args struct{ Hash common.Has
 
 func (r *Resolver) SendRawTransaction(ctx context.Context, args struct{ Data hexutil.Bytes }) (common.Hash, error) {
 	tx := new(types.Transaction)
-	if err := rlp.DecodeBytes(args.Data, tx); err != nil {
+	if err := tx.UnmarshalBinary(args.Data); err != nil {
 		return common.Hash{}, err
 	}
 	hash, err := VBGapi.SubmitTransaction(ctx, r.backend, tx)
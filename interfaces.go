@@ -119,6 +119,11 @@ type CallMsg struct {
 	GasPrice *big.Int        // wei <-> gas exchange ratio
 	Value    *big.Int        // amount of wei sent along with the call
 	Data     []byte          // input data, usually an ABI-encoded contract mVBGod invocation
+
+	GasFeeCap *big.Int // EIP-1559 fee cap per gas.
+	GasTipCap *big.Int // EIP-1559 tip per gas.
+
+	AccessList types.AccessList // EIP-2930 access list.
 }
 
 // A ContractCaller provides contract calls, essentially transactions that are executed by
@@ -0,0 +1,101 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+package era
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/common/math"
+)
+
+// zeroHashes caches the roots of all-zero subtrees of the accumulator, indexed
+// by the depth of the subtree.
+var zeroHashes = func() [accumulatorDepth + 1]common.Hash {
+	var hashes [accumulatorDepth + 1]common.Hash
+	for i := 1; i <= accumulatorDepth; i++ {
+		hashes[i] = sha256.Sum256(append(hashes[i-1].Bytes(), hashes[i-1].Bytes()...))
+	}
+	return hashes
+}()
+
+// accumulatorDepth is the depth of the merkle tree holding the header records
+// of an epoch, large enough to fit MaxEra1Size leaves.
+const accumulatorDepth = 13
+
+// ComputeAccumulator calculates the SSZ hash tree root of the Era1 accumulator
+// of header records, which is defined as List[HeaderRecord, MaxEra1Size] where
+// each record holds the hash and the total difficulty of a block.
+func ComputeAccumulator(hashes []common.Hash, tds []*big.Int) (common.Hash, error) {
+	if len(hashes) != len(tds) {
+		return common.Hash{}, errors.New("must have equal number hashes as td values")
+	}
+	if len(hashes) > MaxEra1Size {
+		return common.Hash{}, fmt.Errorf("too many records: have %d, max %d", len(hashes), MaxEra1Size)
+	}
+	// Hash each header record into a leaf of the tree
+	layer := make([]common.Hash, len(hashes))
+	for i := range hashes {
+		td := bigToBytes32LE(tds[i])
+		layer[i] = sha256.Sum256(append(hashes[i].Bytes(), td[:]...))
+	}
+	// Merkleize the leaves, padding the missing siblings with zero subtrees
+	for depth := 0; depth < accumulatorDepth; depth++ {
+		next := make([]common.Hash, (len(layer)+1)/2)
+		for i := range next {
+			right := zeroHashes[depth]
+			if 2*i+1 < len(layer) {
+				right = layer[2*i+1]
+			}
+			next[i] = sha256.Sum256(append(layer[2*i].Bytes(), right.Bytes()...))
+		}
+		layer = next
+	}
+	root := zeroHashes[accumulatorDepth]
+	if len(layer) > 0 {
+		root = layer[0]
+	}
+	// Mix in the length of the list
+	var length [32]byte
+	binary.LittleEndian.PutUint64(length[:], uint64(len(hashes)))
+	return sha256.Sum256(append(root.Bytes(), length[:]...)), nil
+}
+
+// bigToBytes32LE encodes a big integer into a 32 byte little-endian slice.
+func bigToBytes32LE(n *big.Int) [32]byte {
+	var b [32]byte
+	copy(b[:], math.PaddedBigBytes(n, 32))
+	reverse(b[:])
+	return b
+}
+
+// bytes32LEToBig decodes a 32 byte little-endian slice into a big integer.
+func bytes32LEToBig(b []byte) *big.Int {
+	be := common.CopyBytes(b)
+	reverse(be)
+	return new(big.Int).SetBytes(be)
+}
+
+// reverse reverses the byte order of the given slice in place.
+func reverse(b []byte) {
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package e2store
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/vbgloble/go-VGB/common"
+)
+
+func TestEncode(t *testing.T) {
+	for _, test := range []struct {
+		entries []Entry
+		want    string
+		name    string
+	}{
+		{
+			name:    "emptyEntry",
+			entries: []Entry{{0xffff, nil}},
+			want:    "ffff000000000000",
+		},
+		{
+			name:    "beef",
+			entries: []Entry{{42, common.Hex2Bytes("beef")}},
+			want:    "2a00020000000000beef",
+		},
+		{
+			name: "twoEntries",
+			entries: []Entry{
+				{42, common.Hex2Bytes("beef")},
+				{9, common.Hex2Bytes("abcdabcd")},
+			},
+			want: "2a00020000000000beef0900040000000000abcdabcd",
+		},
+	} {
+		tt := test
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				b = &bytes.Buffer{}
+				w = NewWriter(b)
+			)
+			for _, e := range tt.entries {
+				if _, err := w.Write(e.Type, e.Value); err != nil {
+					t.Fatalf("encoding error: %v", err)
+				}
+			}
+			if want, have := common.FromHex(tt.want), b.Bytes(); !bytes.Equal(want, have) {
+				t.Fatalf("encoding mismatch (want %x, have %x", want, have)
+			}
+			r := NewReader(bytes.NewReader(b.Bytes()))
+			for _, want := range tt.entries {
+				have, err := r.Read()
+				if err != nil {
+					t.Fatalf("decoding error: %v", err)
+				}
+				if have.Type != want.Type {
+					t.Fatalf("type mismatch (want %d, have %d", want.Type, have.Type)
+				}
+				if !bytes.Equal(have.Value, want.Value) {
+					t.Fatalf("value mismatch (want %x, have %x", want.Value, have.Value)
+				}
+			}
+			if _, err := r.Read(); err != io.EOF {
+				t.Fatalf("expected EOF after last entry, have %v", err)
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	for i, tt := range []struct {
+		have string
+		err  error
+	}{
+		{ // basic valid decoding
+			have: "ffff000000000000",
+		},
+		{ // basic invalid decoding
+			have: "ffff000000000001",
+			err:  errReserved,
+		},
+		{ // no more entries to read, returns EOF
+			have: "",
+			err:  io.EOF,
+		},
+		{ // malformed type
+			have: "bad",
+			err:  io.ErrUnexpectedEOF,
+		},
+		{ // malformed length
+			have: "badbeef",
+			err:  io.ErrUnexpectedEOF,
+		},
+		{ // specified length longer than actual value
+			have: "beef010000000000",
+			err:  io.ErrUnexpectedEOF,
+		},
+	} {
+		r := NewReader(bytes.NewReader(common.FromHex(tt.have)))
+		if tt.err != nil {
+			_, err := r.Read()
+			if err == nil {
+				t.Fatalf("test %d, expected error, got none", i)
+			}
+			if err != tt.err {
+				t.Fatalf("test %d, expected err %v, got %v", i, tt.err, err)
+			}
+			continue
+		}
+	}
+}
+
+func TestFind(t *testing.T) {
+	var (
+		b = &bytes.Buffer{}
+		w = NewWriter(b)
+	)
+	w.Write(1, common.Hex2Bytes("aa"))
+	w.Write(2, common.Hex2Bytes("bbbb"))
+	w.Write(3, common.Hex2Bytes("cccccc"))
+
+	r := NewReader(bytes.NewReader(b.Bytes()))
+	e, err := r.Find(2)
+	if err != nil {
+		t.Fatalf("failed to find entry: %v", err)
+	}
+	if !bytes.Equal(e.Value, common.Hex2Bytes("bbbb")) {
+		t.Fatalf("value mismatch: have %x, want %x", e.Value, "bbbb")
+	}
+	if _, err := r.Find(4); err != io.EOF {
+		t.Fatalf("expected EOF for missing entry, have %v", err)
+	}
+	reader, n, err := r.ReaderAt(3, int64(2*headerSize+1+2))
+	if err != nil {
+		t.Fatalf("failed to open entry reader: %v", err)
+	}
+	if n != headerSize+3 {
+		t.Fatalf("entry length mismatch: have %d, want %d", n, headerSize+3)
+	}
+	if value, _ := ioutil.ReadAll(reader); !bytes.Equal(value, common.Hex2Bytes("cccccc")) {
+		t.Fatalf("value mismatch: have %x, want %x", value, "cccccc")
+	}
+}
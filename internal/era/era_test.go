@@ -0,0 +1,162 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+package era
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/rlp"
+)
+
+type testchain struct {
+	headers  [][]byte
+	bodies   [][]byte
+	receipts [][]byte
+	tds      []*big.Int
+}
+
+func TestEra1Builder(t *testing.T) {
+	// Get temp directory.
+	f, err := ioutil.TempFile("", "era1-test")
+	if err != nil {
+		t.Fatalf("error creating temp file: %v", err)
+	}
+	defer os.Remove(f.Name())
+
+	var (
+		builder = NewBuilder(f)
+		chain   = testchain{}
+		hashes  []common.Hash
+	)
+	for i := 0; i < 128; i++ {
+		header := &types.Header{Number: big.NewInt(int64(i)), Difficulty: big.NewInt(int64(i))}
+		body := &types.Body{Uncles: []*types.Header{{Number: big.NewInt(int64(i))}}}
+		receipts := types.Receipts{{
+			Status:            types.ReceiptStatusSuccessful,
+			CumulativeGasUsed: uint64(i),
+			Logs:              []*types.Log{{Address: common.Address{byte(i)}, Topics: []common.Hash{}, Data: []byte{byte(i)}}},
+		}}
+		receipts[0].Bloom = types.CreateBloom(receipts)
+		td := big.NewInt(int64(i * (i + 1) / 2))
+
+		rawHeader, _ := rlp.EncodeToBytes(header)
+		rawBody, _ := rlp.EncodeToBytes(body)
+		rawReceipts, _ := rlp.EncodeToBytes(receipts)
+
+		chain.headers = append(chain.headers, rawHeader)
+		chain.bodies = append(chain.bodies, rawBody)
+		chain.receipts = append(chain.receipts, rawReceipts)
+		chain.tds = append(chain.tds, td)
+		hashes = append(hashes, header.Hash())
+
+		if err := builder.AddRLP(rawHeader, rawBody, rawReceipts, uint64(i), header.Hash(), td, header.Difficulty); err != nil {
+			t.Fatalf("error adding entry: %v", err)
+		}
+	}
+	// Blocks must be added contiguously
+	if err := builder.AddRLP(chain.headers[0], chain.bodies[0], chain.receipts[0], 0, hashes[0], chain.tds[0], common.Big0); err == nil {
+		t.Fatalf("expected error adding non-contiguous block")
+	}
+	// Finalize Era1.
+	root, err := builder.Finalize()
+	if err != nil {
+		t.Fatalf("error finalizing era1: %v", err)
+	}
+	if want, _ := ComputeAccumulator(hashes, chain.tds); root != want {
+		t.Fatalf("accumulator root mismatch: have %x, want %x", root, want)
+	}
+	// Verify Era1 contents.
+	e, err := Open(f.Name())
+	if err != nil {
+		t.Fatalf("failed to open era: %v", err)
+	}
+	defer e.Close()
+
+	if e.Start() != 0 || e.Count() != 128 {
+		t.Fatalf("metadata mismatch: have start %d count %d, want start %d count %d", e.Start(), e.Count(), 0, 128)
+	}
+	if have, err := e.Accumulator(); err != nil || have != root {
+		t.Fatalf("stored accumulator mismatch: have %x, want %x (err %v)", have, root, err)
+	}
+	if err := e.Verify(); err != nil {
+		t.Fatalf("failed to verify era: %v", err)
+	}
+	if td, err := e.InitialTD(); err != nil || td.Sign() != 0 {
+		t.Fatalf("initial total difficulty mismatch: have %v, want 0 (err %v)", td, err)
+	}
+	// Read the blocks back in random order.
+	for _, i := range []uint64{127, 0, 64, 1, 100} {
+		block, err := e.GetBlockByNumber(i)
+		if err != nil {
+			t.Fatalf("error reading block %d: %v", i, err)
+		}
+		if block.Hash() != hashes[i] {
+			t.Fatalf("block %d hash mismatch: have %x, want %x", i, block.Hash(), hashes[i])
+		}
+		rawBody, _ := rlp.EncodeToBytes(block.Body())
+		if !bytes.Equal(rawBody, chain.bodies[i]) {
+			t.Fatalf("block %d body mismatch: have %x, want %x", i, rawBody, chain.bodies[i])
+		}
+		receipts, err := e.GetReceiptsByNumber(i)
+		if err != nil {
+			t.Fatalf("error reading receipts %d: %v", i, err)
+		}
+		rawReceipts, _ := rlp.EncodeToBytes(receipts)
+		if !bytes.Equal(rawReceipts, chain.receipts[i]) {
+			t.Fatalf("block %d receipts mismatch: have %x, want %x", i, rawReceipts, chain.receipts[i])
+		}
+		td, err := e.GetTotalDifficultyByNumber(i)
+		if err != nil {
+			t.Fatalf("error reading total difficulty %d: %v", i, err)
+		}
+		if td.Cmp(chain.tds[i]) != 0 {
+			t.Fatalf("block %d total difficulty mismatch: have %v, want %v", i, td, chain.tds[i])
+		}
+	}
+	if _, err := e.GetBlockByNumber(128); err == nil {
+		t.Fatalf("expected out-of-bounds error")
+	}
+}
+
+func TestAccumulator(t *testing.T) {
+	var (
+		hashes = []common.Hash{{0x01}, {0x02}, {0x03}}
+		tds    = []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)}
+	)
+	root, err := ComputeAccumulator(hashes, tds)
+	if err != nil {
+		t.Fatalf("failed to compute accumulator: %v", err)
+	}
+	// Any change to a record or to the number of records alters the root
+	if other, _ := ComputeAccumulator(hashes, []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(4)}); other == root {
+		t.Fatalf("accumulator insensitive to total difficulty")
+	}
+	if other, _ := ComputeAccumulator(hashes[:2], tds[:2]); other == root {
+		t.Fatalf("accumulator insensitive to record count")
+	}
+	if _, err := ComputeAccumulator(hashes, tds[:2]); err == nil {
+		t.Fatalf("expected error for mismatching inputs")
+	}
+	if _, err := ComputeAccumulator(make([]common.Hash, MaxEra1Size+1), make([]*big.Int, MaxEra1Size+1)); err == nil {
+		t.Fatalf("expected error for oversized epoch")
+	}
+}
@@ -98,7 +98,7 @@ func bignumberJs() (*asset, error) {
 	return a, nil
 }
 
-var _web3Js = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xfd\x6b\x7b\x13\x39\xd2\x38\x0e\xbf\xcf\xa7\x50\xfc\xdc\x0f\xb6\x49\x63\xe7\x34\x0c\xd3\x99\x0c\x1b\x02\x33\x64\x6f\x20\x5c\x40\x76\x76\xef\x6c\x96\xab\xed\x96\x6d\x0d\xed\x6e\xff\xba\xdb\x09\x19\x92\xef\xfe\xbf\x54\x3a\x1f\xfa\xe0\x24\xcc\x69\x93\x17\xe0\x96\x4a\xa7\x52\xa9\x54\x2a\x95\xaa\x72\xfc\xff\x96\x24\xc7\xfb\xbd\xc9\x32\x1d\x97\x24\x4b\x11\xee\x95\x41\x1a\xe4\xfd\x2f\x32\xa5\xe8\x65\xc1\xb2\xff\x85\x4c\x7a\xeb\xe9\x69\x76\xc6\x7e\x95\xf0\xeb\x3c\xca\x51\xb4\x5f\x5e\x2e\x70\x36\x41\xa2\xae\xfd\x8e\x28\xda\x79\xf0\x80\x27\xee\xd1\x32\xcb\x07\x0f\xa2\x7e\x8e\xcb\x65\x9e\xa2\xa8\x97\x05\xeb\x9b\x7d\x9a\x4e\x44\x1a\xe1\x69\xb4\xd6\xc9\x7e\x8a\x2f\xd0\x8b\x3c\xcf\xf2\x5e\xe7\x30\x4a\xd3\xac\x44\x13\x92\xc6\x68\x9e\xc5\xcb\x04\xa3\x6e\x67\x23\xdb\xe8\x74\x3b\xfd\xbd\x72\x96\x67\x17\x68\x32\x18\x67\x31\xde\xef\xbc\x3e\x7e\x7e\xf2\xea\xc5\xc7\x37\xc7\x1f\x3e\xfe\x78\x7c\xf2\xe6\x79\x27\x98\x5c\xd3\xfa\x92\x7d\xda\xf7\xfd\x2f\xf8\xf3\x22\xcb\xcb\x22\xfc\x72\x7d\xbd\x47\xc7\x70\xba\x79\x36\x18\x47\x49\xd2\x4b\x06\x3c\x2b\x10\xbd\xef\x61\x36\xc0\x74\x1f\x00\xb7\xce\x4e\xf1\xd9\x1e\xef\x6a\xd1\x4b\x9f\xa6\x21\xee\x5f\x07\x49\xa0\x4a\xe2\x80\xe1\xee\x9a\x43\xd1\x26\x45\x26\xf4\x82\xb4\xc2\xd5\x24\xcb\x7b\x14\x3a\xdb\xdf\xdc\xcb\xbe\xcf\x07\x09\x4e\xa7\xe5\x6c\x2f\xdb\xd8\xe8\x17\xbd\x9c\x22\x5e\x76\xe3\xba\xdf\xfb\xb2\x15\x9e\xca\x2e\xf3\x2a\x02\x86\xa5\x80\xb7\xdd\xff\xb2\xc6\x12\x44\x67\xf6\x4f\xd7\x10\xfa\xb2\x86\x10\x42\x9d\x71\x96\x16\x65\x94\x96\x9d\x10\x95\xf9\x12\x07\x2c\x95\xa4\x8b\x65\x59\x74\x42\x74\x0a\xdf\x02\x1a\xf2\xd2\x68\x8e\x3b\x21\xea\x7c\xcc\x2e\x52\x9c\x77\x02\x95\x43\x47\x47\x73\xa2\x38\xce\x71\x51\x74\x78\xce\x35\xfc\x7f\xc6\xab\x16\xc5\xe1\x7f\x9e\x96\x2d\xcb\xe6\xf6\xb2\x8f\x5a\x11\xa3\xbd\xd1\x65\x89\x8b\x9d\x6d\x7f\x7b\x02\x48\x62\x7a\x0d\xa1\xeb\xe0\x4e\x10\x70\xa3\xfe\xc8\xe1\x68\xd8\x6b\x87\x80\x95\x51\xfd\x47\x1d\xfa\x38\x4b\x4b\x9c\x96\xb7\x1e\xfc\x9f\x72\xde\xe9\x8c\xfd\x61\xa6\x7d\x12\x25\xc5\x6f\x37\xf4\x1c\x17\x38\x3f\xf7\xad\xfa\x3f\xfa\xa4\x15\xcb\xd1\x3b\x3c\x25\x45\x99\x47\xff\x05\x93\x17\xd4\xd5\x81\x2f\x8e\x6f\xc5\xf7\xcb\x3c\x4a\x8b\x89\x97\xf5\xfd\x59\x70\x90\x5b\xa4\xb0\x3a\x12\x0a\x5c\xbe\xaf\x27\xa9\x3b\xc3\x85\xdd\xf4\x6f\xd2\xe8\x57\x9e\x80\xa8\x0d\xe2\xeb\x2a\x58\xe4\x64\x1e\xe5\x97\xde\x7e\x64\x59\xd2\x38\x79\x07\xbc\xad\x3f\x2f\x0a\xcd\x3d\xb8\xb6\x9a\x2a\x24\x1c\x56\x6e\xe3\x7f\x24\x24\x78\x7b\x1f\x93\x22\xbb\x48\x6f\xd1\xf3\x28\xcd\xd2\xcb\x79\xb6\x2c\x56\xe8\x3a\x49\x63\xfc\x19\xc7\xc6\xde\x75\x67\x13\xab\x2a\xd7\xba\x63\xd6\x7e\x41\xd2\xdb\x30\xee\x83\x25\x60\xe2\x45\x1a\xe3\xb8\x63\xa1\x09\x9f\x53\x42\xf8\x0b\xe0\x68\x44\xe2\xb8\x1d\x8e\x6e\x56\xff\x79\x94\x2c\xbd\xdd\x5f\x92\xb4\xdc\xfe\xe6\x71\xfd\x14\xbc\xc1\x17\xcf\xc8\xef\x88\xfc\x5b\xad\xb9\xc3\x59\x94\x4e\x7f\x4f\xd2\xb9\x13\xca\xa9\xa8\x5b\x93\xea\x6b\xa9\xc6\x8b\x99\xb7\x6c\x37\x6a\x44\xd0\xda\xd9\xda\xda\x75\xf0\xe5\xfa\x2c\xd8\xfe\xdd\x0e\xfd\x7f\xa1\x33\xef\xef\x24\x3b\x4e\x96\x69\x7c\x63\x52\xb9\xf5\xc6\x75\x7f\xec\xfd\x73\x1f\x7b\xef\x0f\x7d\x7f\xe4\x33\x87\x77\xf0\xfc\xbc\xf0\x47\x93\x36\xbf\xee\x66\xae\xf6\xaa\x9d\x3b\xdb\xab\x56\x9d\xf7\x49\x9e\xcd\x6f\x39\xed\x65\x76\xcb\xa3\xe6\xed\x04\xbe\xdf\x77\xdd\xfc\x11\xf0\x47\xd2\x98\xe4\x78\x5c\x1e\x79\xf7\xcc\x15\x7a\x72\xbb\x89\x20\xe3\x68\xf1\xe1\x77\x9d\x0c\x3f\x26\xdb\x9d\x76\xf1\x22\x2b\x48\xdd\x41\x7d\x11\x5d\x46\xa3\x04\x9b\x42\xc1\xef\xc2\x95\xaa\x68\xee\x4e\x8e\x5f\xb7\xa3\x81\x03\x31\xde\xe7\x26\x3e\x7f\xfb\x93\xcc\x9d\x20\xa9\xa2\xee\x76\x74\xf6\x3b\xa0\xff\x0f\x8b\xf5\xbb\x38\x3f\xde\x98\x4f\x7e\x6d\xac\xdb\x4c\xef\x1e\xed\x2d\xd1\x7e\xeb\x8d\xeb\x6b\xcf\xec\x91\x67\x4b\xab\x93\xe3\x76\xdb\xc8\x71\x60\xbc\x81\xf6\x85\x85\x43\xaf\x3b\x18\x4e\xb2\x7c\x1e\x95\x25\xce\x8b\x6e\x7f\x0f\x00\xde\x67\x09\x89\x49\x79\xf9\xe1\x72\x81\x4d\x58\xda\x3e\x85\x5a\x1b\x3e\x7c\xb8\x86\x1e\x1a\x90\x5c\xe7\x8e\x48\x81\x22\xb4\xc8\xb3\x8c\x02\xa3\x72\x16\x95\x28\xc7\x0b\x7a\xc8\x4a\xcb\x02\xf1\xb9\x43\x34\x93\xd6\x70\x54\xa2\x79\x54\x8e\x67\xb8\x08\xe9\x27\xcf\xd6\x7e\x9e\x9e\xe9\x1f\xbb\xc6\xd7\x99\x99\xb9\x63\x7d\x9f\x9d\x3e\x3e\x3b\x3d\x0b\xd0\x60\x30\x58\x43\x0f\x87\xce\xd8\x44\x8f\xf7\x91\xb4\xa6\xe9\xf5\xf9\x14\x97\x33\x52\x0c\x3e\xc2\xc2\xf8\x51\x20\x88\x02\x0e\x18\xba\x8e\x68\xc6\x51\x5a\xee\x69\xc0\x6c\xdf\xf6\x41\x1f\x43\x0e\x6f\x6e\x6f\xed\x7a\x6f\x6d\xcd\xd3\x8f\xc1\x22\xcf\x4a\x86\xb5\x7d\x94\xe2\x0b\xa3\xaf\xbd\x2f\xd7\xfd\xbd\xfa\x52\x03\x90\x5e\xf2\xe5\xb8\xcc\x68\xe3\x1e\xd8\xa6\x76\x07\xa4\xe0\x73\xae\x10\x42\xc9\x51\x20\x85\xdb\xb5\xac\xaf\xd3\xc4\x01\xcc\x5b\x6f\xc8\xb1\xdd\xfb\xf7\x69\xef\x74\xf3\xd1\x77\x67\x0f\xfb\xff\x3e\xeb\x3f\x1d\xf6\xd9\x38\xcd\x83\x43\x65\xb7\xae\x83\x2f\x1d\x9d\x14\x3b\xe1\x77\x41\x87\xd1\x5b\x27\xdc\xda\xbd\x3e\x0b\xbe\xf9\x9d\xc9\xfb\x59\x96\x25\x0d\xb4\x3d\xa2\x20\x15\x84\x4d\xf3\xc4\xff\x8c\x4a\xe1\xd7\xae\xfa\x79\xa6\x25\xef\xe8\x1f\x4d\x64\x0c\x3d\xbb\x29\x0d\xd3\xc2\xab\x10\x31\x83\xb7\x29\x98\xa6\xae\x48\xbe\x66\x91\x1a\xda\x65\x2d\xd6\x95\xbd\x09\xd5\xfe\x87\xa2\xd6\xa4\xd9\x87\xff\xd3\x8a\x68\x79\x7f\x9a\x29\xf6\xf1\xef\x4d\xb1\x74\x0f\x93\x24\x5b\xfa\x69\xb6\x9c\x61\x04\x9b\x1d\x10\xee\xc0\x47\xb9\x34\x57\xfe\xe0\x74\x09\x3f\x77\xb5\xdf\x67\x7a\xc6\x8e\xf1\x65\xd2\x2f\xe2\x5b\xab\xfc\xf9\xc4\xa8\x87\x17\xf5\x50\x39\x74\xf2\xc6\x64\x4e\x4b\xaf\x44\xe7\xac\x80\x43\xe8\x34\x79\x55\x4a\x37\xcb\xd4\x91\x3a\x6b\xb4\xb6\xf4\xcd\x88\x9d\x56\xc2\x48\xfd\xcb\x56\x70\xdd\xbf\x19\xe1\xf3\xde\x35\x53\xfe\xb7\x6d\x28\x7f\xf8\x10\x3a\xfc\x61\x46\x0a\x34\x21\x09\xa6\x94\xba\x88\xf2\x12\x65\x13\x74\x81\x47\x3b\x83\x5f\x8a\xc1\x1a\x80\xf0\x2f\x0a\x30\xc9\x31\x46\x45\x36\x29\x2f\xa2\x1c\x87\xe8\x32\x5b\xa2\x71\x94\xa2\x1c\xc7\xa4\x28\x73\x32\x5a\x96\x18\x91\x12\x45\x69\x3c\xcc\x72\x34\xcf\x62\x32\xb9\x84\x3a\x48\x89\x96\x69\x8c\x73\x20\xf8\x12\xe7\xf3\x82\xb6\x43\x3f\x7e\x7a\x73\x82\x5e\xe1\xa2\xc0\x39\xfa\x09\xa7\x38\x8f\x12\xf4\x76\x39\x4a\xc8\x18\xbd\x22\x63\x9c\x16\x18\x45\x05\x5a\xd0\x94\x62\x86\x63\x34\xba\xe4\x54\x84\xd1\x8f\xb4\x33\xef\x79\x67\xd0\x8f\xd9\x32\x8d\x23\x3a\xe6\x00\x61\x52\xce\x70\x8e\xce\x71\x5e\xd0\x19\xda\x11\x6d\xf1\x1a\x03\x94\xe5\x50\x4b\x2f\x2a\xe9\x18\x72\x94\x2d\x68\xc1\x3e\x8a\xd2\x4b\x94\x44\xa5\x2a\xeb\xa2\x40\x8d\x34\x46\x24\x85\x6a\x67\x99\x58\xd9\xa4\x44\x17\x24\x49\xd0\x08\xa3\x65\x81\x27\xcb\x84\x09\x8e\xa3\x65\x89\x7e\x3e\xfa\xf0\xf2\xf8\xe4\x03\x3a\x78\xf3\x2f\xf4\xf3\xc1\xbb\x77\x07\x6f\x3e\xfc\x6b\x0f\x5d\x90\x72\x96\x2d\x4b\x44\x25\x4a\xa8\x8b\xcc\x17\x09\xc1\x31\xba\x88\xf2\x3c\x4a\xcb\x4b\x94\x4d\xa0\x8a\xd7\x2f\xde\x1d\xbe\x3c\x78\xf3\xe1\xe0\xd9\xd1\xab\xa3\x0f\xff\x42\x59\x8e\x7e\x3c\xfa\xf0\xe6\xc5\xfb\xf7\xe8\xc7\xe3\x77\xe8\x00\xbd\x3d\x78\xf7\xe1\xe8\xf0\xe4\xd5\xc1\x3b\xf4\xf6\xe4\xdd\xdb\xe3\xf7\x2f\x06\x08\xbd\xc7\xb4\x63\x18\x6a\x68\x46\xf4\x04\xe6\x2c\xc7\x28\xc6\x65\x44\x12\x31\xff\xff\xca\x96\xa8\x98\x65\xcb\x24\x46\xb3\xe8\x1c\xa3\x1c\x8f\x31\x39\xc7\x31\x8a\xd0\x38\x5b\x5c\xb6\x9e\x48\xa8\x2c\x4a\xb2\x74\x0a\xc3\x96\x54\x86\xd0\xd1\x04\xa5\x59\x19\xa0\x02\x63\xf4\xfd\xac\x2c\x17\xe1\x70\x78\x71\x71\x31\x98\xa6\xcb\x41\x96\x4f\x87\x09\xab\xa0\x18\xfe\x30\x58\x7b\x38\x14\xcc\xf6\x6f\x40\xb6\xe3\x2c\xc6\xf9\xe0\x17\x60\x91\x7f\x8b\x96\xe5\x2c\xcb\xd1\xeb\x28\xc7\x9f\xd0\xff\x66\x25\xbe\x20\xe3\x5f\xd1\xf7\x73\xfa\xfd\x37\x5c\xce\x62\x7c\x3e\x18\x67\xf3\x1f\x00\x38\x8e\x4a\x8c\xb6\x37\xb7\xbe\x01\x86\xd7\xbc\x15\xd4\x08\xb0\x5a\x19\x2e\x8f\xf9\xf6\x0e\x2e\x29\x68\xc0\x74\x17\xf4\x41\x1e\xa5\xa5\x09\x48\xd2\xd2\x07\x77\xe2\x00\x2e\x2b\x20\x9f\x5f\xa6\xd1\x9c\x8c\x05\x1b\xd7\x4a\xc4\x2c\x07\x78\x94\xaf\xe4\xfb\x32\x27\xe9\xd4\x2c\x53\x40\x9a\x0f\xfa\x1d\x8e\xac\x31\xe6\x38\xf2\x8e\xf1\xc4\x05\x5d\x56\xc1\x7a\xba\x2d\xfb\x0b\xc0\xa4\xe0\x03\x34\x38\x73\xa1\x55\x11\xc0\x0e\xcb\xf9\xb4\xb0\x10\xd7\xf2\x07\xb2\x0a\xd8\x46\x18\xf0\xd5\x95\x3c\x3d\xa2\x0a\xe8\x83\x3c\x8f\x2e\x19\x38\x63\xe2\x96\x28\x70\x48\xe9\x53\x93\x00\xf8\x4a\x62\x1c\x22\x46\x65\x86\x70\x4a\x69\x78\x18\x63\xfa\x9f\x6c\x85\x32\xe3\x88\xb1\x49\xca\x95\xb8\x5c\x6b\x6e\xcc\xac\x6e\x7d\xc4\x14\xac\x30\x77\x66\x48\x42\xfb\x50\x43\x61\x74\x11\x78\xff\x1c\x97\xb3\x2c\xf6\x74\x8b\x29\xd7\xb3\x7c\x8e\x98\xe4\x92\x19\x33\xb2\x86\xd8\x1a\xe4\xc5\x3f\xf2\x99\xe1\x59\xe8\x6f\xd0\x7b\xf4\x85\x11\xcf\xb5\x14\xcb\xff\xc6\x30\x5f\xa0\x2f\x7a\x65\xd7\x90\x05\x6f\x15\x0a\xf4\x05\xde\x35\x5c\x23\xfe\x49\x28\x6f\x60\x12\x11\x25\x43\xe8\x0b\xdd\x89\x28\xbb\x07\x84\x18\xc8\xd0\x76\x6a\xbd\x4b\x0e\x8e\x04\x8a\x28\x36\x0b\x53\xbc\xd3\xb0\x36\x98\x90\xa4\xc4\x79\x4f\x2b\xdb\xd7\x74\x10\x9c\x8a\x4a\x2e\x14\x08\x22\x00\x9d\x42\xff\x74\xf3\x6c\x8f\xf1\x4f\x32\x41\xbd\x75\xbd\x11\xbd\x0e\xf6\x40\x83\x3d\xe5\xe8\x92\xf4\x3c\x4a\x48\xac\x68\x80\xd6\xb8\x1e\xa2\x2e\xda\x40\x7a\xe5\x6b\xba\xac\xa1\xd7\x6c\x52\x60\x05\xa5\xa1\x45\x12\x91\x94\xd1\x97\x35\x8d\x0c\xe0\x2d\xcf\xa9\x9e\x45\x9e\x7e\x3c\xfa\x05\x8f\xcb\x6b\xab\x42\x31\xc9\xaa\x1c\xab\x36\xb6\xe0\xaa\xa7\x4e\xeb\x86\x33\x73\x01\x2b\x6f\x09\x5c\x30\x69\x5a\xb1\xa2\x77\x4a\x81\xcf\x02\x74\x0a\xe0\x67\xfd\x76\xa8\x49\x48\x01\x12\x10\x5b\x7c\xd5\xd8\x29\x74\x34\x00\x0b\x60\xd8\xf1\xa5\x2f\x54\x81\x2a\xc4\x38\xcd\xb6\xc2\x4d\xe1\x2e\x7d\x8e\x9d\xa2\x8a\xbe\x0b\x41\xe0\x53\x5c\xea\x2b\xb0\xe0\x9c\x83\x93\x2c\x2d\xc6\xfb\x46\x4b\x18\x35\x0c\xe6\xd1\xa2\x57\xc5\x63\x41\x2b\xe7\x59\x23\x06\xef\x64\x35\xf7\x58\x4f\x4f\xa1\xc8\x19\x63\xcf\xe2\x4b\xae\x22\xad\x3f\x7c\x9f\x3a\x9e\x4c\x0a\x5c\x3a\x9d\xca\x71\xbc\x1c\x63\xad\x5f\xd1\x78\x1c\xa0\x86\xce\x01\x76\xca\xa8\x24\xe3\xb7\x51\x5e\xbe\x82\x97\x44\x56\xcd\x03\x3b\xbf\xe7\xe9\xa7\xa8\x2b\xa7\x4c\x09\xc7\xef\xdd\x2a\x5f\x47\xe5\x6c\x30\x49\xb2\x2c\xef\xf5\x9c\x16\x37\xd0\xce\x56\x1f\x0d\xd1\xce\x76\x1f\x3d\x44\x3b\xdb\x7c\xd0\x1a\xfa\xa2\xf1\x18\x6d\xa0\x9e\xdc\x74\x0c\xac\x57\xa0\x10\x3d\xd5\xf6\x2e\x84\x76\xb6\x51\x68\x24\x54\x74\x56\xa0\x3e\x40\x9b\x3a\xf6\x73\x5c\x2c\x93\x52\x50\x0f\x9b\xc1\xd7\xcb\xa4\x24\x3f\x93\x72\xc6\xe6\x44\x50\xa0\xd1\xb7\x40\xd2\x51\x60\xce\xa0\xa8\x9c\x8f\x90\xd5\x6f\x9e\xf8\xfc\xa4\x6f\xb5\xea\x5b\x03\x2d\x7b\xa0\xad\x11\x39\xbc\x4e\x67\x4f\x2d\x1c\x9c\x4c\xf8\x88\x79\x67\xf9\xae\x90\xe5\x2f\xa2\xf1\xac\x67\x33\x26\xa2\xd3\x16\xe5\xfa\x95\xf3\xa5\xe6\xea\xac\xaf\x17\x62\x08\x81\xae\x6c\xb8\xda\xce\x9e\xd9\x7d\xb1\x8e\x34\x22\x94\x6b\x97\x52\x31\x4e\x26\x1c\xc4\x9e\x23\xe8\x80\xdb\x25\x81\x27\xf8\xb0\x27\x4b\x6f\xc2\x5c\x8a\x1b\xfb\x08\xf3\x67\x78\x68\x88\xb6\x15\xe8\x35\xc2\x49\x81\xad\xe1\x0d\x87\x28\xce\xd2\x6e\x89\xa2\x38\x46\xbc\x54\x99\x99\x55\x0e\x10\x29\xbb\x05\x8a\x92\x1c\x47\xf1\x25\x1a\x67\xcb\xb4\xc4\x71\x05\x96\xbe\xd2\x38\xaf\xd5\x22\x1c\x0e\xd1\x87\xe3\xe7\xc7\x21\x9a\x90\xe9\x32\xc7\x88\x1e\xd8\x52\x5c\xd0\x13\x20\x3d\xa5\x5d\x16\x26\xb3\xfa\x2d\x88\xe4\x8f\x33\xc9\xe6\x64\x60\x1d\x81\x02\x2b\x15\xcb\x5c\xa2\x35\xc7\x93\x08\xd4\x31\x17\xb3\x2c\xc1\xac\x87\x24\x9d\xae\x37\x30\x82\x1a\x1e\x60\x73\x7e\x3e\xe8\x00\x65\xce\xca\x37\x16\xb9\x98\x93\x46\x51\xdf\xb3\xc5\xf5\x5c\xd5\x98\x46\x40\xac\x61\x74\x11\x29\xb2\x2e\x70\xe9\xcc\x29\x23\xab\x37\xd1\x1c\xdb\xfb\x90\xca\xd1\xe5\x4c\xb7\xac\x67\xf3\xa9\xdf\xcf\x54\xc5\x9e\x3a\x25\x5f\xe4\x18\x54\x52\xad\xf8\xab\x19\xb6\xa8\x64\x91\xe3\x73\x92\x2d\x0b\xd9\xa1\xed\x3d\x8a\x12\x92\x22\x92\x96\x4e\x89\x26\xfc\x6b\xfd\xf5\x35\x48\xff\x26\x59\x8e\xe0\x91\x30\x41\xfb\x68\x6b\x0f\x11\xf4\xbd\x18\x80\x78\x2f\x8c\xc8\xc6\x46\x55\x71\xfa\x67\xf5\x79\x63\x1f\x6d\xf4\x04\x0e\x08\x7a\x84\xb6\xce\xa8\x84\x8f\xae\xae\xd0\xe6\x5e\x65\x25\x35\xac\x9c\xd3\xc3\x06\x22\xe8\x61\xd5\xcc\x6d\xd8\xbd\xa0\xc2\x41\x15\xdb\x17\x7f\xd7\x4e\xaa\x99\x72\xdd\xef\xf5\xad\x29\x1c\x0e\xd1\x84\xe4\x45\x89\x70\x82\xe7\x38\x2d\xe9\xf9\x8a\xa1\x29\x40\xc5\x27\xb2\x40\xa4\x5c\x65\xca\x0d\xec\x6f\xfa\xb0\x4f\xf1\x57\x3b\x03\xf0\x74\x3e\x8e\x09\x6d\x24\x4a\xe4\x22\xe7\xf8\x74\xf8\x8f\x8b\x6f\x3f\x5f\x54\xa4\x53\xc1\x20\x4e\x09\xda\x40\x5b\x67\x82\x4f\xa0\x0d\xe4\x74\xc3\x83\xf6\x46\x04\x5b\xcc\xcf\x03\xc9\xb7\x4a\x0f\xed\x33\xaa\xb8\x31\xeb\xf9\x43\x33\x15\x2a\x6c\x99\x98\xba\xe5\xe2\x6f\xa0\x4c\x54\xc5\x90\x36\xeb\x18\x12\x6a\x45\xd3\x8d\x1c\x65\x38\x44\xe3\x28\x19\x2f\x93\xa8\xc4\x42\xf0\xa1\x47\x3e\xde\x17\x44\x4a\x3c\xbf\x05\x3b\xa2\xac\xe8\xf4\x4f\xc4\x94\xfa\x36\xec\xf5\x4a\xfb\xca\x2d\x27\xe4\xf7\x63\x30\x3a\x73\xf9\xea\xbc\x05\x39\xda\x22\xde\x8f\x06\x6d\x08\xd7\x45\xf2\x9b\xc9\xac\x46\x63\xc4\x20\x5b\x6b\x8c\x44\xba\xbc\xd5\x94\x2a\x11\xbf\x2e\xa9\x5a\x0f\xa2\x35\xec\x11\xff\xa0\x7e\x9f\x8e\x48\x2b\xa6\x74\x44\x0c\x1a\x64\x9b\x36\x68\xa9\x55\x12\x55\x20\xa4\x4a\x47\x54\x8d\x10\x5e\x02\x4e\x18\xd0\x9a\x42\x4c\xbd\x86\x48\x1f\xa2\xef\x74\x6c\xe0\x66\x75\x05\x91\x28\xc5\xa8\x58\x87\x67\x44\x5c\x78\x4f\xe1\xd6\x71\xff\x8e\x35\x4a\x6c\xc8\x3d\x18\x99\x58\x5f\x4a\x2d\x62\xe8\x45\x44\x8d\x4a\xc3\x54\xa7\x72\x50\xa3\x6a\xd4\x33\xe8\x18\x65\x1c\x88\x96\xb9\xeb\x91\xb6\x51\x47\xc9\x93\xa8\x4f\x0e\xe6\x5d\xab\x64\x92\xc3\x21\x2a\x96\x73\x76\x43\xe7\xd9\xa5\xb8\x88\x28\xe1\x79\x75\xa7\xe4\x8c\x72\x45\xf9\x05\x5b\x92\x8f\xff\x88\xe6\x4d\x44\x08\x69\xd3\x41\xc1\x70\x88\x72\x3c\xcf\xce\xe1\x1a\x13\x8d\x97\x79\x4e\xe5\x53\x29\x9c\x66\x90\xcc\xbb\x49\x0a\xe8\xb9\xa7\xb7\xc5\x2a\x1a\x3f\x81\xcc\xd6\x9a\x3f\x63\x64\xe8\x91\x53\x7f\x6b\x4a\x7b\x6f\xad\xc3\x8a\x6b\x1d\xef\xa9\x55\xf0\x38\x0f\x95\x95\xd6\x95\x83\x20\x2b\xba\x83\xe9\x97\x24\xe6\xfd\x05\xeb\x2d\x6d\x6b\xcc\x6f\x99\x74\x53\x0b\xe8\x7d\x8f\xd9\xab\xda\x26\x18\xfc\x5a\xb4\xd7\x0f\xbc\xd9\xcf\xb2\x2c\xa9\xca\xa3\x42\x48\x45\xd6\x49\x4d\x9e\x7e\xb9\x59\xd9\x6c\x5d\x26\xe3\xc2\x55\xb9\xef\x70\x54\xd9\xe3\x13\x96\xb9\x46\x09\xc2\xb5\xdf\x00\xd4\x49\x9b\x0d\x61\x38\x1b\xee\x06\x1d\x76\xf7\xdb\x09\xbf\x81\x9f\xb4\x6f\x9d\xf0\x31\xfd\xad\x5f\xc7\x76\xc2\x27\x81\xcf\xd6\x83\xa4\x65\x27\xdc\xda\xa4\x3f\x73\x1c\x25\x9d\x70\x6b\x9b\xfe\x66\xb7\xb2\x9d\x70\x6b\x87\x7e\x2d\x19\x14\x34\xb0\xe4\x60\x8f\xaf\xcf\x82\x27\xbf\xa5\x5d\x54\xc3\x35\xf4\xcd\xac\x89\xf4\x4a\x56\x31\x2a\x32\xcb\xd9\xb6\x45\x7a\xee\x8a\x26\x46\xfe\xa2\x35\x96\x46\x66\x4f\xda\xd4\x75\x0b\xbb\xa3\x0a\x63\xa3\x56\x8d\x6a\x57\xe2\xde\xe9\x12\x6c\x27\x5f\xe2\x16\x26\x4c\xd6\xb0\x9b\x2d\x99\xbe\xbb\xb7\x64\xba\xb7\x64\xfa\x6f\xb1\x64\x52\x0b\xe1\xae\xcc\x99\x9e\x91\xe9\x9b\xe5\x7c\x04\xac\x50\x72\xe7\x11\x99\xa6\x90\x38\xf8\x45\x72\xf2\x65\x49\x12\xd3\xbe\x66\x30\x84\x34\xf6\xaf\x00\x1b\x7b\x41\xc6\x59\x3a\x21\x8e\x31\x90\x38\x99\x69\xbb\x02\x9c\x5d\x60\x5b\x10\x03\x67\xbc\xba\x40\xc0\xef\x11\x3c\xd8\xa0\xe7\x2c\xca\xb7\x94\x95\x2c\x2c\x05\x3a\x37\xa0\x9c\x79\x48\x71\xcc\x20\x49\x81\x52\x3c\x8d\x4a\x72\x8e\x03\xc1\x89\xe0\xe2\xa8\xbc\xc8\xba\x05\x1a\x67\xf3\x85\x90\x56\xa1\x14\x9d\x5b\x59\x72\x92\x64\x51\x49\xd2\x29\x5a\x64\x24\x2d\x03\x76\x1d\x4a\xc9\x3e\xce\x2e\x52\xeb\x4c\x67\xaa\x49\xdc\xe3\xdb\x15\xc3\xf2\x95\xc4\xf7\xb5\x18\x0b\x5d\x4a\x29\xc6\x31\x9c\xa2\x47\x6a\x8e\x63\xbf\x31\x0c\x20\xed\x5a\xda\xf9\x98\xed\x1a\x0c\x18\xea\x17\x5c\x58\xb6\x3b\x60\x73\xd1\x1b\x0f\x5e\x7c\x78\xf9\xf1\xd9\xd1\x4f\x6f\x4e\x5e\x3f\x7b\xf1\xee\xe3\xbb\xe3\x93\x37\xcf\x8f\xde\xfc\xf4\xf1\xf5\xf1\xf3\x17\xda\x19\x4e\x6a\xe2\x60\x26\x07\x8b\x28\x7e\x85\x27\x65\x8f\x7d\x95\xd9\x87\x8b\xac\x38\x94\x58\xe4\x6d\x0e\xca\x8c\x8b\x4b\x5b\x8f\xfb\x01\x7a\xbc\x6b\xde\xf0\xe8\xbb\x25\x0c\xa7\xc7\x1a\x31\x0d\x30\xcc\x89\x17\x87\xdf\x0a\x9c\x3f\x93\x67\x63\xf3\xd0\xbc\x2a\x0e\x5d\xa9\xc3\xc0\xa2\x07\x21\x65\xf6\x12\x7f\x16\xe3\x2e\x96\xa3\xa2\xcc\x7b\xdb\x1a\xfe\x12\xeb\x6a\x9f\x15\x17\x5a\xee\x0d\xf4\x78\xa7\x8f\x86\x3a\x8a\x6c\x74\xbf\x23\xd3\x59\xc9\x8b\x05\x28\x41\x0f\xbf\x32\x3e\xf9\x0e\x7c\xa7\x68\xad\x94\xe9\x6e\x8d\x5d\x71\x3c\x33\xd1\x2a\xb5\x73\xbf\xdb\x0c\x58\x6a\x53\xd6\x58\x7f\xc0\xd6\xfc\x06\x6a\x9e\xa0\x26\x4e\xc7\x24\xf9\xea\x15\xf1\x5e\xe4\xdf\x76\xee\xa4\x71\x67\xfb\x59\x9b\xe4\xd9\xfc\xa4\x9c\x3c\xb9\x9f\x38\xcf\xc4\xf1\x77\x46\x55\x8c\x8c\xbf\x42\x12\x93\x46\xbf\x71\x94\xae\xce\xc8\xec\x27\x47\xd5\x73\xd6\xdd\xbc\xdd\x5f\x17\x6d\xf0\xea\xd1\x53\x84\xba\x5b\x5d\x14\xa2\xee\x66\xf7\xf6\x3c\xaa\x09\x93\xf4\xc4\x4a\x4b\xfd\x83\xc2\x15\x88\x0a\xc6\xf3\x65\x52\x12\x26\x54\x8e\x2e\xd1\xf6\x7f\xe6\x54\x3c\x97\x36\x74\x11\xad\xb9\xc4\x53\x9c\xd7\x6c\x25\xef\x78\xad\x4d\xfb\xf7\xaa\x33\xc2\x6d\x99\x2b\x66\x84\xa3\xc9\xa2\x3e\x8a\x35\xd9\xa2\xdc\x5c\xc9\x1c\x17\x56\xd6\x76\x7f\xb0\xc8\x2e\x7a\x5b\xdb\x4f\xfa\x7d\x13\xa5\x87\x33\x3c\xfe\x84\xc8\xc4\xc0\xa9\x26\x16\x59\x88\x28\xc8\x34\xc5\xf1\x51\xf1\x46\x65\x3b\x8a\x68\x59\xc7\x0c\x7f\xe6\x3d\x36\x91\x21\x88\x16\x0e\x7d\xd0\x76\x69\x4a\x62\x19\x3d\xb2\x5c\x10\x2a\x86\x47\x49\xa1\xac\x96\xed\xd6\x1b\xf1\xe5\xc3\x90\x60\x37\x9b\x01\xda\xea\x07\x68\xeb\xb1\x26\x8f\x6c\xf7\x8d\xdc\x3e\xda\xdf\xdf\xa7\x24\xeb\xa5\xc2\x9c\xb2\x8f\x47\x51\x02\x9d\x42\x4c\x75\xa0\x2e\x3c\x98\xa8\xe9\x12\x11\x53\x24\xd8\x42\xa0\x41\x1e\x8e\x1d\x2c\xc5\x99\x12\x0c\x6b\xda\x95\xc2\x21\x2c\x0b\x32\x45\x4c\x4e\xb7\xe8\x4d\x76\xc1\xc0\x9f\x61\x14\x4b\x81\xd9\x3c\xee\xb3\xde\x68\xba\xcc\x5e\x1f\x5d\x5d\xa1\xce\x66\x87\xeb\x88\x87\x43\x34\x96\x54\x44\x85\x67\x31\x91\xb2\x75\x06\x44\x4a\x36\xd1\x52\xd2\x76\x85\x6c\x71\x7f\x6b\xcd\x33\x9f\x5b\x8f\x0a\xd2\x33\xbf\x6c\x4a\xe7\x24\x5d\xda\xab\xa0\x3b\xb9\xe5\x5f\x17\xea\x16\x95\x6f\xc9\xeb\xb1\x16\x1d\xba\x01\x05\x2d\xeb\x49\xe8\xa4\x96\x86\x7c\xd4\x83\x57\x22\x1f\xde\xbc\x4b\x38\x27\x77\x41\x39\x5f\x07\x65\x9c\xe5\x57\xa1\xcc\xe1\xdd\x8d\x28\x03\x8c\x69\x22\xb1\x89\x22\xde\x9c\x8b\x22\x87\x99\xfb\x2c\xce\xad\xc5\xc8\x61\x06\x31\x39\x27\x31\x8e\x9f\x5d\xd6\xf0\xf0\x9b\x50\x53\x03\x6e\x4e\xee\x1a\x39\xcb\x4a\xec\x9c\xac\x8c\x9e\x93\xdb\xe0\xc7\xbd\x85\x65\x55\x4b\x14\x55\x49\x5c\xea\xc1\x74\x6b\xbc\x88\x9d\xcd\x9c\x8b\x4a\x1c\xf1\xa6\x5d\x14\x39\xf2\x99\x0f\x43\x9e\xe5\x05\xfb\xd5\x2d\x05\xb6\xad\x2e\x7a\xca\xb6\x66\xee\x19\x63\x35\x6c\x56\x9e\x1c\xb5\x77\xb9\x35\x7b\x5f\x82\x27\x0a\x71\x54\x82\xa8\x39\xdb\x38\xa2\x47\x1a\xcd\x31\x7b\xe0\x43\x7f\x59\x22\x18\x87\xa1\x75\xca\x1a\x3c\x98\x77\x0e\xa1\xd0\x46\x80\x74\x65\x39\x2d\xc4\x9f\x58\xa3\x7d\x54\xf5\x52\xf7\x61\x7f\xa8\x1d\x69\x0a\xf2\x2b\xe7\x89\x05\xdc\x52\xf1\xf2\xa7\x5b\x67\xa6\x28\xdc\xdd\xfc\x4c\x45\x66\x77\x72\x07\x45\x42\xc6\x98\x4a\x26\xdb\xe8\x21\x54\xb7\x22\x9d\x37\xcc\x8c\x7e\x0a\xbf\xb3\x09\x5a\x15\xfd\x95\xaa\x00\x67\x93\x91\x47\x44\x8b\x0f\x30\xc4\xf1\x4b\x30\x1b\x73\x8f\x77\xfb\x7c\x0f\x2f\x33\x0e\xdf\x47\x0f\xc5\xa9\xd2\x37\x03\x56\x45\x4c\x3a\x7c\xbc\x1b\xf0\xf6\x57\x9b\x82\x9a\x53\x39\x1b\xbe\xe7\x58\x7e\xa7\xd8\x8f\x8a\x31\x21\x75\xf8\xf7\x1c\xe7\x7f\x43\xcc\x0b\xad\x0e\x68\x07\xda\xe1\x7f\xb5\x09\x50\xee\x69\xaa\x66\xe0\x40\x39\xb0\xa9\x98\x82\x4a\xde\x5e\x81\x72\x59\xa1\x8b\x6d\x9f\x03\x9b\x15\xa4\x29\x03\x77\x9d\xcd\xcf\x1d\xb4\x81\xf8\x19\x07\xd0\xce\x7e\x4b\xb3\x82\xdd\xcd\x00\xe9\x49\x55\x3e\x03\xbe\x08\xd3\x0f\xed\xac\x19\x5a\xdf\x81\x0d\x03\x2b\x36\x74\x52\x1c\x38\x7d\x81\x87\x55\x19\x4e\x29\x86\xcc\xd0\x4d\x72\xfb\x91\x65\x49\x68\x27\x38\x50\x54\x02\x09\xed\x04\x1d\x4a\x8a\x65\xa1\x9d\xe0\x42\x9d\x38\x60\x27\x5e\x38\xbd\x51\x95\xe2\xa9\xcf\x05\x3c\xf1\x43\xea\x83\x55\x29\x1e\x38\x1d\xdb\x5a\x92\x0b\xe9\x9b\x1e\x37\xc7\x2d\x67\x4e\x90\x9e\xe6\xc2\x72\xaa\x0f\xbd\xeb\xee\x5a\x5c\xeb\x9a\x97\x43\x9d\x70\xeb\x49\xd0\x31\x2f\x95\x3a\xe1\x36\x58\x30\xc0\xc2\xe8\x84\x5b\x5b\x41\x47\xbf\x9a\xea\x84\xe6\xe7\xf5\x59\xb0\xb5\xf9\x3b\xbb\x74\x39\x62\xb6\xf1\x35\x3e\x88\x48\x5a\x56\xb9\x20\xe2\xb7\x57\x24\x2d\x99\x77\x16\xfa\x63\x57\xfe\x3a\x53\x89\x3b\xda\x6f\xcb\x79\x0b\x49\x4b\xe6\xba\x85\xa4\xe5\xe3\x5d\x09\xf6\x44\x55\xb4\xfd\xcd\xe3\x8a\xba\x28\x7c\x83\x2b\x23\xfb\x68\xf8\x15\xbd\x71\x01\xb8\x6d\x86\x70\x94\x96\x2b\x5a\x5e\x18\x25\x6a\x0c\x2e\xa0\xb9\x9a\x92\x37\x32\xaf\x20\x69\x29\x44\xc5\xa7\x37\x72\xe9\xc2\x7a\xd5\x6c\x06\xb1\xd5\x2a\x8a\xdd\xbd\x1d\xc4\xbd\x1d\xc4\x9f\xd7\x0e\x02\x29\x43\x08\x26\x2a\xdd\x91\x0d\x44\x0b\xd3\x06\x9b\xd5\x33\xd3\x85\x0c\x0c\xd2\x95\xe7\x8e\x81\x47\x42\xbd\x98\xe1\x54\xbe\x57\x0c\x98\xed\x37\x15\xc0\xa5\x03\x07\x21\x59\x0e\xbd\xb6\x11\x96\xfa\xdb\x7e\x9e\x08\x9c\x54\xc8\x8f\xec\xff\xab\x2b\xd4\xed\x6a\x7c\x36\x13\x2f\x17\xd8\x8f\x3d\xed\xa9\x21\x49\x79\xeb\xad\x3d\x7e\x4c\x71\xa9\x9b\xfc\x82\x01\x79\xb7\x10\x0f\x41\x81\x97\xd0\x4a\x0c\x6b\x77\x25\xdf\x33\x63\x57\x53\x8a\x16\x6a\x26\x55\xab\x5e\x19\xea\x89\x3e\xf6\x0d\x83\x76\x40\x8f\x6e\xd0\x6e\x37\x52\x6b\x8a\x06\x56\xfe\xc6\xb1\x43\xbf\x7e\x6c\x8d\x8c\x71\x8e\x29\x31\x89\xf5\x60\xba\x65\x61\xe4\x1e\x93\xc9\x04\x83\x41\x32\x43\xb9\x75\x2e\xb9\x90\xef\x42\xf4\xe3\x88\x40\x09\x9f\x25\x61\xbb\x9c\x7a\x0f\x21\xe6\xd1\x85\x6e\x87\xbe\x7e\x44\x0b\xc6\x61\x64\x2f\xaa\x51\x79\xe1\x7f\x33\x6b\xd2\x5d\xe5\xad\x9e\x22\x48\x49\xaa\xab\x60\x34\x9b\x8f\x48\xea\x7a\xb8\x29\xb3\x29\xa6\xdc\x9d\xd6\x80\xa7\x03\xb6\xa8\xa2\xc5\x02\xa7\xb0\x96\xa2\x94\xbd\x81\xb0\xb0\xcb\x6b\x6b\xba\x87\xe1\x8c\x69\x46\xc6\x94\x3d\x89\x5e\x35\x17\xe6\x17\xa8\xd9\x84\xc3\xc2\x3e\x54\x8b\x5a\x31\xbc\x26\xbd\x5f\x1d\x5a\xa5\xde\x82\x5d\x99\xec\xa1\x66\xec\x8e\xa3\x24\xe1\xf8\x15\xd7\x38\x6c\x44\xb3\x48\x2d\xdd\x82\xfc\xca\x9d\x0b\xc2\x75\xdd\x2c\x2a\x02\xfa\xbf\x20\x34\x70\xff\xeb\xb9\xb7\xd3\xf1\x2d\x6d\x41\xfd\x3a\xd3\x5a\xd4\xf8\xbd\x33\xf9\x16\x2e\x5f\x15\xeb\xfb\xfb\x20\x5d\x4c\x48\x6a\xbd\x55\x6a\x42\x82\xf2\x5a\xc4\xab\xe2\x37\xcc\xb6\xd2\x80\xe5\x1e\x14\xcf\xaa\x8f\xfe\x4c\xe3\xeb\x6a\x68\x5a\x2c\x33\xa3\xf6\xba\x41\xaf\xc3\xa8\x95\x0b\x80\x3e\x7a\x8a\xba\x5d\x14\xb6\x33\xc8\xd2\x50\xe6\x35\xcb\x5a\x01\x6f\x94\xf7\x33\xe5\x84\x94\x19\x7d\xcf\xbd\x94\xfe\xc2\x8f\x33\xb1\xf7\x88\x5b\xe1\x48\x67\xf8\xd1\x5c\x27\x32\x20\xf1\x5a\x2c\xaa\xc6\xbc\x28\x04\xbf\x4a\x36\xfe\x7c\xfe\x99\xe4\xf2\xda\x43\xec\xca\x0f\x55\xd0\x1d\x9f\xb0\xde\xea\xa8\x33\xb6\xb5\x0a\xdc\x69\x9b\x92\x1f\x79\x22\x21\x12\x97\xf0\x2d\xb0\x88\xe7\x8b\xf2\x52\x57\x09\xb6\xd8\x44\x1b\x57\xa1\x49\x8f\x1a\x7b\x0a\x41\xfa\x58\x01\x37\xc2\xe3\x54\xa5\xaf\x29\x2f\x26\x6a\x07\xc2\xab\x6c\x1a\x83\x71\xb1\xb2\xe1\x11\x0b\x6e\x32\x0e\xf5\x18\xaf\xda\x3f\xd4\x2b\x52\x94\xce\xcb\xbf\x53\x63\x34\x67\x1e\xa7\x50\xb5\xa3\x57\x35\xbb\xdb\x8b\x7c\x17\x24\x6e\xea\x97\x8b\x98\x59\xb6\xf2\x77\x70\x52\x15\x59\x66\xa5\xf6\xd6\x95\x15\x16\xc2\x11\xf3\x3b\x84\x8c\xb7\x7d\xf2\x09\x21\x07\x35\x9f\x15\x19\x7b\x9b\x5c\x8f\x6c\xfb\xaa\x58\x90\xf6\xed\x97\xed\x2c\xc4\x6c\x1e\xed\xeb\x3d\x56\xb0\xfa\x30\x36\xf6\x5d\x45\x3f\x7f\xad\xe5\xbe\xd0\x62\x90\x4a\x04\xea\x65\xfa\xab\x5b\xf9\x6a\x6e\x38\x14\xd3\x8d\xcf\x71\x7e\x59\xce\xc0\x17\x89\x56\x8f\x8e\x1d\xd7\xf1\x94\xb0\x48\x73\xf0\x63\xbc\xd4\xf5\xdf\x50\x48\xdf\x4b\x77\xda\x84\xab\x74\xbe\x0e\x50\xb7\x2b\x94\xef\x35\x4a\x8a\xb7\x6c\x96\x2c\x9d\x9e\x54\xdf\x5d\x9f\x05\x5b\xad\x62\xed\x7d\x45\x9d\x1c\xdc\x46\xd7\x2b\xe5\x72\x0a\x52\xa1\x95\x13\x66\x66\xf4\x7f\xa6\x2a\x83\x5f\xbb\xea\xe7\x99\x96\xbc\xa3\x7f\x58\xba\x39\x9a\xc6\x94\x73\xf4\x97\xd0\xce\xd1\xdf\x4f\xb4\xea\x34\xfd\x9c\x53\x63\x0b\x0d\x9d\x73\xf7\xbe\x8a\x8a\x8e\x16\x5e\x45\x47\xc7\xe0\x6d\x25\x1d\x4d\x5d\x51\x4b\x67\x16\xa9\x51\xd3\xb1\x16\xeb\xca\xde\x44\x51\x47\x71\x5b\xa1\xa8\x6b\xe7\x28\x9f\x77\xab\x85\xa2\xae\x55\x34\xaf\xaf\xf5\xb8\xce\x73\xfb\xb7\x0a\x79\xb0\xe2\xab\x10\x88\x28\x61\x93\x08\x4b\x5f\x91\x48\xec\x42\x35\x64\x22\xda\xad\x2f\x7f\x23\x9d\x2e\x93\xa4\xda\xbc\x99\xf3\xb4\x77\xb7\xaf\xe5\xe4\x28\x5b\xd0\xdd\xdd\x47\x1f\xa9\x7d\xbf\xe3\xe1\xc3\x9a\x8b\x5b\x52\xb4\xf7\x6d\x3b\xc6\x79\x19\x91\xd4\xef\xdf\xd6\x41\x24\xbb\x4d\x6a\x20\x6a\x06\x34\x30\xd3\xeb\xc9\x9a\x17\xb1\x32\x1a\xbd\x41\x94\x38\x9f\xd3\x23\x3f\x99\x40\xcd\x66\xbf\x63\xee\xb5\x16\x4d\xc9\x39\x4e\x85\x49\x8b\x79\xa4\xae\x72\x97\x6b\xd9\xbf\xb0\x63\xb6\xb2\xb8\x05\x2c\xb3\xca\x9d\x76\xfd\xf6\xb7\x3a\x44\xfb\x25\xc2\x9c\xd3\x76\x4a\xaf\x70\x9c\x9d\xe3\x3c\xbf\xc8\x49\x59\x62\x30\xf7\x62\xbd\xea\xa0\x0d\xe8\x7d\x6b\xdc\x5d\x80\x96\xbd\xd0\x1f\xf2\x83\x15\x84\x3a\x8a\x92\x94\xa3\xb0\x74\xfd\x0e\xdb\x6f\xed\x5b\x21\xd3\xd5\x4a\x5a\xcd\x29\xad\x6d\x05\xde\x3c\x2e\x04\xfc\x18\x1c\x0e\x41\x15\x1e\xcd\xe9\xaa\x00\xaf\x87\x5c\x9b\x45\xc7\x4b\x39\x01\x66\x77\x0c\x09\xf9\x84\x51\x84\x0a\x92\x4e\x13\x2c\xfd\x70\x01\xe4\xc0\x30\x89\x06\x0a\x66\x6e\x66\x98\x5b\x0e\xd6\xda\xd5\x15\x3a\xed\x9e\x6e\x9d\x75\xcf\xfa\x52\x18\x6c\x70\x03\xc0\xbb\x67\xe2\x9d\x7e\xe9\xae\x0d\x2b\x44\x77\x66\x03\xc5\x50\x01\xb6\x0a\x5b\x01\x7a\x04\xf6\xd8\x9b\xd0\x97\x2d\xdd\x11\x8d\xea\x90\x23\xc8\x0a\x47\x0d\x81\x70\xed\x50\x75\x5a\x10\x0e\x1d\x1e\x0a\x40\xd5\xc0\x70\x88\xa2\x24\x41\xa3\xa8\x20\x63\xe6\xff\x00\x1e\x0b\xec\x6c\x73\x05\x4e\x92\xd1\x93\xb1\xe8\x4d\x80\x76\xb6\x9b\x8c\x4e\xcc\x85\xcd\x39\x9a\x38\x81\x0b\x5d\x24\xc2\x53\x10\x20\x21\x28\xd4\xe9\x59\x07\xed\xff\x00\xeb\x53\xa5\xed\xb2\xc4\x5a\x65\xda\x81\xa8\x6d\x55\x0e\x30\xc3\x95\x3d\xab\x59\xed\x7a\xab\x95\x34\xab\xdc\x7e\x19\x0e\x61\x1c\xa2\xdb\xb3\xb6\x51\xad\xc8\x83\x07\x48\xff\x3e\xd5\x7e\x6b\x2e\xe0\xce\xc4\xae\x2b\x23\x63\x0c\xa7\x37\x9a\x1b\xbe\x7c\xeb\xa6\x46\xcc\x82\x39\x37\x7c\xc2\xcc\xa9\xd1\x3c\xae\xdd\x72\x66\xac\x7e\xd5\x4c\x8c\xd6\xe6\xd7\x9e\x97\xbb\x9c\x18\xd3\xf5\x89\x62\xa4\xda\x4c\xc0\xd9\xa8\x03\xb6\x08\xdb\x0c\xe9\xec\x90\xd4\xe1\xc6\x0a\x5b\x7c\x2a\xb6\x76\x25\xe0\xf6\xd9\xe9\x0e\x07\x15\x69\x0c\x44\x42\x6c\x9d\x59\x09\xea\xdb\xdd\x1d\x00\xab\x37\xd8\x1e\xf4\xb1\xf0\x21\x36\xef\x09\x5a\x63\x77\x34\x91\x64\x82\x7a\x5a\x96\xc6\x21\x6d\x7e\x7c\xc3\x89\x05\x86\xed\x7b\x0d\xb1\x55\x33\xe5\x7c\x93\x10\xa7\x6a\xdf\x3c\xc3\xbc\xf9\xa6\xba\x23\xe3\xef\x39\x13\xce\x3f\x3b\xc6\xbc\x1b\x15\x9d\x9a\x95\xeb\xd3\xad\xbc\xaf\xb5\x9a\x67\x99\xc1\x86\xc2\xf3\x2b\xe7\xd7\xf0\xa2\x58\xb9\xdb\x73\x6f\x45\x49\x54\x94\xe8\xf4\x8c\x0a\x13\xac\xde\x1b\x4d\xfb\xba\x7f\xde\xe5\x1c\x80\x9c\x85\x1c\x1f\x4b\x70\xa0\x51\x2f\xa1\xe0\x53\xd2\x40\x1b\x22\xa9\x31\x8e\xd5\x8e\x30\x92\x03\xdb\x37\x4d\x68\x74\x89\x62\x3c\x89\x96\x09\x28\x42\x8b\x25\x95\x53\xe5\xc6\xdc\xe1\x6e\x6a\x02\x1e\xe6\xd1\x9e\x45\xe3\x18\x75\x03\x06\xac\x76\xc4\x15\x45\xe1\x96\xa7\xb7\x4a\xa3\x7a\xe1\xab\x5d\xe8\x88\xb5\x25\x52\xd8\x6b\x04\x28\x9e\x93\xf2\x69\x87\x52\x7c\x80\x3a\x74\x11\xd0\xff\xce\x3a\x67\x8a\xda\x39\x84\x96\x06\x85\xd2\x65\x62\x3f\x7b\xd0\x66\xb3\x15\xda\x6c\x07\x73\x56\x7f\x1b\x16\x82\xeb\xa4\xca\x59\x09\x6c\x6f\xe0\xce\xf2\xd8\xac\x17\x70\xc3\x4b\x87\x63\x8c\x97\xfe\x0b\xab\xde\x22\x62\xce\xad\x7a\xff\x3e\x65\xa7\xf1\x7f\x9f\xf5\x9b\x45\x04\xae\xbc\x95\xde\x1e\xaa\xef\x1d\xac\x30\x16\x02\xba\x3d\xeb\x10\x6f\x4f\xdd\xbb\x2c\x0b\x67\x9e\x4b\x0b\x7e\x8f\x6e\x6f\x0c\x5e\x7f\xd4\xe6\xad\x0c\x77\x85\x2a\x9c\xa0\xda\x6c\xa1\xc1\x1b\xac\xb4\xff\xd6\x8d\x89\xf7\x50\xe5\x9f\xdf\x31\xaa\xeb\x57\x16\x27\x13\xdd\x9f\x2c\x67\x65\x4e\x21\xf9\x32\xf9\xf4\xcc\xe7\x44\x7c\xb0\x58\x16\xb3\x9e\xe3\x99\x54\xbc\xd4\x16\x6e\x46\xdd\x9a\xe9\x58\x5c\x9f\xeb\xe7\x3e\x07\xa0\x7a\x4b\x9a\x1f\xcf\xde\x79\x80\x74\xff\xb2\x96\x7b\xd2\x5b\x39\xf5\xe5\x13\xa8\x3b\xf3\xbd\xf5\xfc\x41\xd7\x1d\xa9\x83\x23\xfe\xb7\x9f\x3f\x9f\x47\xd6\x06\x4f\xac\x95\x13\x41\x67\x13\x5c\xa5\xd6\xcc\xc7\xca\xb3\xb1\xe6\xdc\x11\x5a\xba\x23\x63\x49\x6a\x1e\x6d\xdb\xf8\x04\x65\xf7\xa3\x93\x3c\x9b\x7b\xcd\x0d\x18\x94\x8f\xb7\x8c\xec\x07\x3b\x96\x81\x90\x61\x19\xb4\xc2\x83\x29\xc1\xd4\x58\xcb\x2d\x58\x14\x1f\x88\xce\xa2\x0c\x7f\x9a\x0d\xac\xea\xab\xf0\x2a\xd8\x9b\xf4\x1b\x4b\x26\xe8\xf2\x27\x3e\xd0\x3d\x21\xe8\x70\x74\x3d\x44\xdb\x60\xfc\xd0\x17\x1e\x9d\x39\xf2\xaa\x16\x51\x6d\x9d\x7a\xf3\x4e\xc5\xbe\x15\x05\x05\xde\x97\xec\x8e\x5d\x2f\xbd\x81\x76\x98\xd3\x7b\xb6\xdb\x16\x14\xa4\x40\xd1\xa4\xc4\xb9\x5c\x24\x7a\x7f\x6f\xb4\x56\xfd\x65\x7c\xbe\xbb\x15\xe7\xa8\xf0\xd9\x8d\x6a\xb1\xc7\x43\xc7\xbc\xa9\xaa\x5f\xf7\xeb\x51\xe9\x46\xda\x8e\x79\x53\xcb\x68\x5a\x72\x1a\xf4\xb0\xbe\x6f\x14\x76\x63\xbf\x1e\xa6\x15\xa3\x32\x1d\xce\x6a\xd3\xbe\x81\xc8\xdd\x72\xad\x3f\xc4\x1e\xa2\xff\xb5\xa4\x7e\x61\x90\xda\xf2\xef\x0f\x45\xfc\xf7\xb4\xaf\xfd\xfd\x2e\xb4\x8f\xbc\xa4\xaf\x07\x68\xbc\x29\xe9\xdb\x61\xc4\x56\xdc\x54\x1c\x62\xb5\xeb\x6f\xb7\xb3\x98\xbd\x58\xa5\x7e\x3e\x7f\x5e\x7a\x4b\x1c\xfa\xf2\xaf\xbf\xea\x25\xbc\xe0\xb7\x7e\xae\x91\x6a\x53\xf7\x7b\x68\x0b\x6d\x98\xbd\xeb\x33\x9f\x4c\x2c\x92\x98\x67\xea\x99\x07\x62\xeb\xd2\xcd\x78\xb0\x5d\xe3\xcf\xde\xc0\xb5\x65\xf1\x65\x70\xb1\xb5\x15\xc7\xa6\xcf\xb9\x5c\x59\xdb\x7d\x53\xad\xea\xbd\x48\xb4\xba\xde\x78\xc1\x5b\x7d\xb5\x2b\xdf\xc4\x5d\x9f\x05\x5b\xbf\x77\xe8\xfd\x93\xe6\x67\x6f\xcb\x9a\x77\x6f\xdc\x13\x09\xfc\xcf\x6c\x5d\x96\xea\xe9\xdb\x52\x7b\xfb\xb6\xd4\x1f\xac\x2d\x3d\xaf\xdf\x96\xf2\xf9\xdb\x52\x7b\xff\xb6\xd4\x1e\xc0\x2d\xcd\x17\x70\x4e\x8d\x2d\x2c\x6c\x1c\xff\x28\x5f\xf1\x11\xdc\x89\xf7\x15\xdc\xc9\xea\xcf\xe0\x4e\xda\xbe\x83\x3b\x71\x1f\xc2\x9d\xdc\xc1\x4b\xb8\xe5\xad\x9f\xc2\x9d\xb4\x7e\x0b\xf7\x7b\xc7\xf5\x3f\x69\x61\x71\xb6\xac\x33\x39\x13\xae\x55\xd8\x0f\x4e\x9c\x9a\xd5\xd9\x52\x37\x3b\x5b\x1a\x56\x62\x4b\x9f\xe1\xd9\x52\x59\x9e\x2d\x75\xd3\xb3\xa5\x6e\x7b\xb6\xb4\x8c\xcf\x3c\xf5\xb6\x59\x1c\xbf\xa9\xfd\xd9\x89\xdf\x00\xed\xe4\x06\x16\x68\x27\xad\x4d\xd0\x4e\x3c\x36\x68\x76\xe9\x9b\xad\x91\x1a\x33\xb4\xb6\x8b\xa4\xbd\x21\xda\xb7\x6d\x56\x49\x77\x59\x60\x50\xcc\x8e\xcb\x2e\x0b\xc8\x37\xcd\x10\x4e\xcf\x51\x9c\x61\xb0\x56\x80\xd7\x81\x51\x1a\x83\x0f\x5b\xf4\xcf\xd7\xaf\x5e\x96\xe5\xe2\x1d\xfe\x7f\x4b\x5c\x94\x6b\x20\x98\x5d\x2e\x70\x36\xb1\x72\x98\x1f\x1b\xf9\x7e\xa3\x2b\xf0\xc2\x1b\x1e\xd8\xd0\xe8\xcb\xf5\xde\x9a\x11\x2c\xb2\x12\xd2\x4c\x00\x49\xfd\x97\x62\x46\x77\x1f\x32\x4d\xb3\x1c\x87\x09\x49\xf1\xda\x35\xb3\x58\xa5\x78\x68\xe5\xed\xfe\xfe\xe5\xec\xfd\xcb\xd9\x3f\xf1\xcb\x59\xf6\x6a\x96\xdb\xb0\x19\xcf\x66\xd9\x86\x83\x6e\xf6\x7a\x96\xef\x7d\x27\x25\x49\xa0\x4e\xa6\xcf\x84\xb5\xc3\x9e\x27\x39\x60\xa4\xbc\x94\x2c\x51\x15\x19\x27\x51\x51\xa0\x53\x28\x72\xc6\xbb\xc9\x32\x14\x13\x66\x55\xad\x0d\xe1\xde\x08\x56\x29\x57\xae\x52\x0e\x82\x6a\x9c\x59\xb7\xf7\x73\x0e\x90\xb4\xa6\x93\x37\x47\x1f\xde\xd3\xb3\x35\x4c\x42\xf7\x02\x93\x2e\x23\xcd\xee\x27\xed\xf7\x6b\xed\xf7\x4f\xda\xef\xe2\xd7\x68\x94\x89\x8f\x09\x49\x53\x7c\x29\xbf\xf0\xbc\xcc\xe0\x29\xa3\x48\x59\x90\xb1\x99\x90\x46\xa9\x99\x30\x27\xe3\xdc\x4e\x49\x12\xe2\x14\x32\xe0\x0d\x50\xf1\x61\x14\x99\xe6\x51\x1a\xcb\xa1\x18\x59\x3f\x19\x5f\x1f\x8c\xaf\xb7\xc6\xd7\x0b\xe3\xeb\xff\x8c\xaf\x7f\x19\x5f\x6f\x8c\xaf\xe7\xc6\xd7\x3f\x8c\xaf\x13\xf6\xb5\x76\x56\xed\xba\x86\xce\xd1\xdb\x83\xe7\x74\x8a\x43\xb4\xb3\x1d\xc8\xc4\xf7\x47\x3f\xbd\x39\xf8\x70\xf2\xee\xc5\xc7\x57\x2f\xde\xfc\xf4\xe1\x65\x88\x76\x55\x26\xcc\x6a\xa8\x7e\xaa\x9c\x0a\xca\x09\xd1\x17\x64\x25\x28\x3f\xea\x90\xf1\xf1\xf9\xf1\xcf\x6f\xd0\xb5\xaa\xe9\xed\xf1\xab\x57\x14\xfa\xc3\xd1\xeb\x17\xc7\x27\x1f\x42\xb4\xb5\xb9\xb9\x39\xe4\x3d\xe4\x37\xde\xcf\x92\x6c\xfc\x29\x44\x5d\xca\x3a\x8b\xb2\x6b\xe4\x1d\x8c\x21\x94\x71\xa8\xde\x36\xb2\x07\x18\x74\x3f\x6f\xf2\x7d\x72\x1f\x0a\xe3\x7e\x23\xfb\xab\x6f\x64\x6b\xd2\x05\x44\x31\x8b\x76\xee\xca\x03\xc4\x61\x7e\xb9\x28\xb3\xbf\xbf\xd7\x37\x87\x31\xa4\x3d\x52\x11\x30\x68\x83\x5e\x80\x21\xcd\xe9\x7a\xa3\x3b\xb9\xee\x1b\x80\xe2\x0a\xfd\x81\x2a\x4f\x42\x0f\x1e\x88\xdc\x81\xf0\x17\xc1\xc4\xe4\x19\xfe\xdc\xb5\x5f\xd1\x19\x9e\xbf\x7e\x40\xdb\xb4\xb4\xed\xfd\x78\x5b\xb8\x8b\x34\x8b\x23\x71\x19\x2e\x2f\xf8\x2d\xff\xec\xc8\x7a\x6d\xc7\x40\x05\x8e\x68\xe7\x06\x2f\xf1\xe7\x01\x68\x2f\xb9\xe7\x5e\x9f\x8d\x11\xc5\x8a\x18\xb6\x6a\x9d\x9d\xe8\x98\xfa\x2d\x44\xdb\xdf\x3c\x66\x25\xb5\xc7\xc9\xe2\xcd\x19\x65\x79\x12\xc7\x9d\xf0\x9b\xef\x82\x8e\x89\xf2\x4e\xf8\x64\xf3\xfa\x2c\xd8\x6e\xe5\xf3\xe9\x9e\xef\xdd\xf3\xbd\x3f\x2f\xdf\x53\x6c\x8f\xbd\xf3\xbf\x03\xbe\x67\xc9\xee\xab\x8b\xee\x1e\xc9\x5d\x14\xf4\x09\xee\x2b\x45\x1b\xb2\x79\xed\x60\xc8\xd9\xbd\x0a\x47\x34\x79\xa2\x03\xd0\x6f\x29\xc2\x2f\x53\x52\xbe\x8e\x16\x52\x5c\xec\x0a\x89\x3a\x64\x3c\xa8\xbb\x29\x64\x4d\x2a\xb5\x87\x8a\x2d\x76\xb7\x0c\x39\x3f\xd4\x32\x36\x37\x65\xa1\xff\xad\xc9\x1b\x45\xa3\x51\x34\xc5\xb2\x25\x3d\x4f\x13\xfe\x43\x3b\x6f\xee\xa9\x53\xcb\x7e\x5d\x9f\x9d\x64\xe7\x38\x89\xc6\xa2\x59\x3b\x5b\x9d\x31\x42\x5f\xf6\xd4\x5f\xb9\x06\xf1\x53\x23\x44\x31\x8b\xd2\x34\x4b\x8d\x71\x9b\x10\xea\x5c\x13\xd6\x40\x34\xb4\x02\x27\xab\xd0\x03\xa1\xa3\x52\x9d\x97\xc2\x7a\xa0\xa6\x9a\xf8\xd9\x2d\xf4\x02\x19\x95\xc9\xf3\x98\x3d\x36\x0f\xa0\x7f\x88\x26\xa0\x41\xae\x1e\x38\x0d\xf4\x93\x09\xeb\x03\xd5\xe7\x1a\x4e\x7d\xb5\x15\xeb\xfd\x6d\x55\xb7\x5e\x7d\xdb\x02\x5a\x99\x72\x85\x32\xb4\x98\xdf\xd8\x4a\x39\x62\x58\x44\x31\x37\x25\x05\x53\xcf\xcf\x0b\x3c\xa6\x9b\x97\x34\xcf\xd7\x8d\xae\xb8\xf7\x14\x9f\xe5\x94\xaa\x62\x84\x29\x5c\xcc\x23\x72\x59\x36\x58\xe3\x59\x94\x47\xe3\x12\xe7\x85\x50\xf1\xc3\xbd\x3c\x2f\xad\xed\x23\xde\x36\xc8\x34\x0d\x34\x5b\x68\xb4\xb9\xe6\x77\xfb\x41\xa6\xb3\x12\x09\x8f\xb4\x96\x77\x5f\x3e\x06\x43\xda\x64\x20\x01\xf4\xae\x08\xa0\x1d\x8f\x8f\x21\x66\x21\x02\x30\x10\x94\x16\x5e\xab\xca\x1b\xe2\xad\xfe\xe0\x97\x8c\xa4\x10\xac\x01\x3d\x85\x3a\x50\x88\x3a\x9b\x9d\x3e\xda\xe0\xc0\x15\x86\x6f\x37\x9e\x0b\x08\xd8\xf3\x67\x9f\x0c\x18\xc4\x8a\xb3\xc1\x7b\xb8\xc1\x3c\x2e\xdf\x74\x5e\xaa\x0c\x11\x4d\x47\x34\xb0\x75\x82\x19\x22\x04\xf3\x70\x7d\x4c\x5b\xf3\xc2\xbc\x35\xd7\xcc\x0a\x49\x69\x25\x7e\x64\xe9\xfe\xa8\x3d\x8e\x92\x68\xe3\xca\xec\x90\x79\x20\x39\x61\x5b\xbb\x14\xe9\x67\x2c\xde\xf3\x70\x88\x7e\x24\x69\x8c\xd8\xe3\x2e\xde\x51\x19\xaf\x99\x4a\x14\x9d\x8e\xba\xc9\x07\xdb\x97\x00\x42\x48\xcd\xf0\x67\x61\xc2\x2c\xcf\x5c\x34\x8d\x9d\x7a\xe8\x89\xa3\xfa\xac\x44\xab\xd9\xd6\xdf\xbd\x80\x71\x0d\xb7\xa9\xd9\x43\x64\x63\x7f\x5b\x07\x17\xb1\x90\x75\xdb\x0e\xd5\x54\x8f\xd0\x76\x78\xe8\x0b\xd9\xc2\x04\xf5\x58\x91\xfd\x7d\xb4\xd9\x37\x4e\x69\xa3\x1c\x47\x9f\x14\x28\x1d\xe5\xc6\x3e\xe2\xaf\xca\xe9\x0c\x1e\xce\xa2\xfc\x30\x8b\x31\xd4\xe0\x3d\x84\xd1\xc9\x16\xe6\x38\x45\x99\xb7\xa3\x10\x36\x69\x2b\x91\xc8\x01\x2d\xf2\xdb\xd1\x08\x34\xf7\xdf\x43\x24\x37\x99\xf9\xa2\xac\x7a\x9d\x6e\x4e\xb6\xc7\xc7\x7c\x6f\x91\xe3\x09\xf9\xcc\x82\x68\x6d\x7e\xee\xd3\x59\x00\xae\xe1\x77\x6f\xcf\xa3\xbd\x55\xcf\xbe\xd7\x76\x19\x8e\xa0\x51\x02\xdc\xbc\x36\x98\x80\x2f\xca\xa7\xe1\x6b\x9f\xbb\x5d\xe7\xdd\xd0\xa9\x82\x52\x3c\xc7\x3c\x9b\x7d\x58\x0e\xdc\x6c\x9b\x2d\x07\x31\x23\xb4\x25\x45\x1d\x93\x2c\xb7\x4d\xe8\x8a\x32\xaf\x8a\x88\xaf\xcd\x28\x85\x1a\xf3\xb9\x39\x28\x7b\xe4\x66\x2b\x1d\xac\x13\x79\x70\x70\xc3\x6b\x9b\x02\xa1\xfd\xdd\xd8\x47\xa9\xd8\x17\xbe\x47\xdb\xe8\x29\x3d\xd9\xa0\x0d\x44\xf7\x83\xd4\x47\x13\xdc\x85\xfc\x0c\x7f\xbe\x4b\xd2\xb0\x62\x0e\xd8\xb4\xd1\xc0\x1a\x7e\x33\xe2\x70\x78\x86\x46\x1d\xbf\x0d\x05\xfc\x6e\xd3\x6a\x79\x2c\x9d\x2c\x93\x44\xa2\x61\x88\xcf\x71\x5a\xb2\x87\x02\xc0\xf2\x7f\x29\xb2\x14\x45\x23\x62\xf3\x78\xe1\x36\xf1\x43\xf6\xe3\x32\x49\xec\x37\x94\xe2\x31\x01\x2d\xfd\x88\x95\x76\x1f\x43\xb1\x86\x9d\x76\x15\x63\x77\xdb\x30\x04\x29\x5a\xb9\xae\x3a\xa5\xdf\x03\x30\xa1\x20\x69\x8c\x3f\x1f\x4f\x7a\xdd\x5e\xb7\x0f\xbe\x21\x1f\x6d\x79\x9e\x43\x4a\x78\xc7\x4e\xb0\xbc\x5c\x60\xde\x1c\x00\x01\x15\x99\xfe\xcc\x7a\xa4\xff\x45\x84\x10\x1e\x50\xf8\x3d\x74\xcd\x45\x31\xd3\xf2\x4f\xb6\x82\x36\x50\xb7\x47\x67\x4e\xd6\xbe\x81\xba\xfd\x6e\xab\xb5\x17\x93\x62\x91\x44\x97\x6c\x5e\xc0\xc7\x68\x5a\x52\xd9\x56\x62\xc3\x7e\xb3\xf6\x19\xb2\x9f\xb3\x62\x75\x2f\x5c\x69\x6d\xe6\xe4\xfb\x97\x97\xd1\x03\xba\xa5\x59\x14\x83\xa7\x03\x11\x6f\xf1\xb2\xc7\xcd\xea\xfa\xe8\xd1\x0f\x32\x51\x4e\xab\xdb\xb7\xda\x87\xcf\xd2\x66\xd3\x99\x59\x03\xcd\x1c\x8c\x4d\x36\x7a\x6a\xbf\x69\xe5\xef\xc1\xe8\x9a\x51\xce\x46\x86\x43\x35\xd0\xec\x1c\xe7\x49\x16\xc5\x38\x96\x8a\x60\xcf\x9a\xd0\x07\xf0\x41\x11\x49\xd5\x9b\xc6\x21\xfa\x70\xfc\xfc\x38\x44\xf3\xe8\x13\xa8\x86\x49\x7a\xbe\x4c\x52\x9c\x47\xa3\x04\xdf\xe5\x00\xd5\x69\xc0\x7e\xbd\xbb\x85\x1e\x21\x2d\xbb\xdf\x1f\xe4\x78\x91\x44\x63\xdc\xeb\xa2\x2e\x38\x75\xa3\xa7\x85\x8e\x19\x24\x32\x4b\xcf\x71\x5e\x16\x2a\xe4\x26\xc8\x7d\x31\x1e\x93\x79\x94\xd8\x4c\x96\xa4\x7e\x66\x5f\x66\xcf\x59\x01\x97\xf2\x6a\xc3\x67\x9a\x6e\x0d\x99\x80\xc7\x6b\x6a\x0c\x00\x59\x66\x6e\x7c\x4c\x19\x7e\xa6\xcd\x18\x1b\x65\x5b\xca\x13\xef\x6a\x5c\x5a\x5d\xf5\xc1\x59\x53\xa1\x25\x75\xc7\xe7\x09\xcd\xcd\xd5\xa7\xe6\x8e\x62\x1c\xf6\x19\x40\x82\x8b\xe2\xc3\x2c\x4a\x7b\x9b\xe0\x44\xf6\x11\xb3\x3a\xe7\xd6\xfb\x9c\xb0\xb6\xfa\x10\xbe\x55\xcb\x31\xb0\x78\xb0\x04\x37\xcd\x1c\x95\x51\x7a\xc9\x1d\xef\x70\x77\xa4\x69\x35\x5a\x07\x1c\xaf\x07\x69\xcc\xd4\xff\x8c\x86\xc8\xe4\xb2\xe0\x8e\xd4\x0b\x34\xc2\x93\x2c\xc7\x03\x87\xae\x5e\xf2\xa3\x43\x3d\xee\xaf\xf8\x1e\xd4\x40\x5a\x2f\x61\x9f\x37\x90\x2f\xd7\xef\x43\x6e\x2a\x36\x8f\x3e\xb3\xb0\x95\x9f\x49\x79\x19\xa2\x27\xa0\xc2\x16\xbb\x0e\x29\xb8\x4b\x63\x28\xda\xb7\x37\x19\x6d\x92\x7b\x1b\x14\x62\xcf\x28\xaa\x4f\x67\x7d\x61\xab\x2c\x37\xa4\x3b\xa7\xb4\x43\x4f\x21\x0c\x69\x5d\x6f\x15\x10\x5f\xe9\xef\xef\x8f\xdf\x0c\x24\x96\x59\x7b\xca\x81\x25\xb8\x8e\x2d\x50\x64\x47\xf3\x0c\xd0\x22\x2a\x0a\xca\xbb\xca\x59\x9e\x2d\xa7\x33\x73\x05\xc8\x81\x70\x5a\x83\x5a\xdd\xcb\x49\xc5\xd5\x1e\xc1\x69\xc9\x23\xf3\x56\x8e\x58\x00\xf0\xb7\x1d\x66\x75\x0d\xb5\x9d\x0b\xfb\x51\xad\x02\xd4\x5b\x27\xc5\x8f\x24\x25\x25\xb6\x90\x6e\x75\x03\x24\x44\xad\x13\xa6\x94\xe5\x76\x54\x5b\x17\xef\xf8\xa6\xc2\xd6\x01\x3d\x2f\xa5\xc0\xfd\xd1\xcf\xd8\x16\xa4\xa6\xb8\x84\x88\xc5\xc7\x93\x93\x94\x78\xb5\x5d\x50\xb6\x9c\x61\xfe\x43\x2e\x38\x54\x66\x81\xd4\x4e\x49\x87\xe8\xde\xa8\x8d\xb2\x1f\xb2\x9a\x1e\xeb\x4c\x1f\x8a\x80\xdb\xae\x02\xe1\x3c\xcf\x72\xe1\x92\x86\xf5\xb8\x40\x69\x56\xa2\x71\x96\xe7\x78\x5c\x86\x17\x72\xdd\x98\xbd\x36\x16\x10\x2d\x28\x48\x60\xc9\x32\xe1\xbf\xa7\xf0\xdf\xa0\xcc\x5e\x65\x17\x38\x3f\x8c\x0a\xdc\x03\xe6\xc2\xf4\xbd\x8a\x8f\x51\xa8\x7f\xf0\x5b\x66\x7e\x75\x73\x4a\xff\x3f\x53\x47\x71\x0d\x44\xf7\xfb\xad\x13\x1e\xf3\x44\x96\xe2\x0b\xf4\x82\x8e\xaa\xd7\x85\xab\x5e\xe8\x08\xd8\xaa\xfe\xbb\x5b\x22\xfc\x99\x14\x65\x11\xa0\x45\x82\xa3\x02\xc4\x62\x18\x79\x96\x4a\x54\x4d\xb2\x24\xc9\x2e\x48\x3a\x85\x92\x05\xe5\x82\xd6\x32\xe2\x3d\x0c\xc0\xbf\x42\xa0\x9e\x7d\xd4\x44\x89\x95\xbd\x07\xef\x57\xa6\x57\xe1\xe8\x13\x86\x45\xc8\x18\x3e\x5c\x46\x13\xb0\xa7\x95\x4c\x96\x91\x00\x65\xb5\xe0\xab\x82\x4d\x3c\x43\x2d\xa7\xac\xb7\x59\x51\x90\x51\xc2\xa6\x10\x5c\x68\x70\xa3\xbe\xf7\x47\x54\xbe\xcc\x4b\xf6\x93\x8a\xd4\x02\x5b\x2f\x26\x13\x32\xbd\xe4\x1f\xc7\x82\x94\x1e\xa1\x4f\xb4\x79\xf6\xa7\xae\xab\xe0\x93\xdf\x6c\x31\xb0\xb9\x02\x93\x97\x4b\xec\x53\x5c\x45\x31\xb8\xa9\x82\x93\xf7\x3f\xec\x93\x5f\x18\xa9\x3c\x56\xe0\xd1\x23\xb9\x30\xd5\x3d\x0e\x2b\xf0\x6b\x34\xca\x8c\x3c\x4f\x09\x71\x0f\xc3\x06\x00\xd7\x37\x7a\x1e\x2b\xa1\xf5\x42\x2b\xcc\x3e\x39\x16\x34\x10\x64\x41\x68\x1f\x70\x99\xc2\x11\x82\x15\x0e\xa7\xda\xef\x52\xfc\xb6\x45\x0a\xc6\x17\xac\x93\xef\x95\x94\xd3\x19\x39\x8c\xa3\x94\x9e\x0c\x22\xc9\x9a\x79\x3a\xd7\x95\x65\x39\x8a\xd0\xcb\x17\xff\x84\x43\xb8\x90\xd6\xee\x8c\xa1\xc8\x7d\x56\x1c\xed\x7e\x9e\x61\xe1\x67\x2f\xd2\xae\x72\x79\x14\x14\x2d\x58\x00\x5d\x4f\x51\x81\x2e\x30\x5d\x20\xca\xc1\x8a\x18\xc6\x9a\x26\x0d\xfd\x8c\x8d\x23\xb9\x18\xa7\xce\x52\x98\xa8\x43\x6b\x16\x4c\x42\x17\x8a\x58\x09\x3d\x6a\xac\xc9\xa9\x18\x77\xb2\xe4\x21\x7d\x1b\xe6\x15\xa0\xa7\x46\x23\xa1\xfe\xa5\x49\x56\x8d\xcb\x37\x62\x38\xf6\xac\xe0\x0b\x4c\xee\x17\xec\x7f\xcb\x12\x2f\xb3\xba\x05\xae\x9d\x17\x7e\xb3\xa5\x4e\x57\xdb\xef\xb8\xd8\x01\x21\x77\xb3\xd4\x4b\x32\xc7\xc5\xef\xb1\xcc\x53\xae\x5c\xa4\x8b\x5b\xaa\xaa\x0a\x76\xcc\x87\x2d\x1a\x49\x5b\x16\x87\x1c\x64\x4f\x5a\x11\x85\x22\x03\x71\x77\x48\xe7\x5e\xd1\x82\x59\x9b\xf4\x72\xa5\x2a\x50\x00\x12\xff\xba\xf1\x8d\x35\x0b\x0d\xe7\x9f\x6f\xa8\x10\x08\xcb\x5e\x94\xe7\x3f\xae\xae\xd0\xe6\x9e\xf7\x70\xc3\xeb\x75\x0e\x27\x2c\xdd\x38\x11\x71\x9c\x8b\x9e\x3c\x78\x80\xf8\x6f\x9f\xd0\x4f\x9b\xb4\x73\xf5\x13\x86\xcf\x07\x9a\x21\x8b\xf1\xc2\x52\x27\xb2\xf9\xb9\x1b\x74\xbb\xfa\x85\x8b\xe5\x29\xcd\x57\x5a\x27\x94\x4a\x99\x2e\x15\xb1\x63\x3d\xa4\x22\xe9\x84\x81\x89\x28\x1e\xf2\x28\xc6\xcd\x26\x01\xb6\xbc\xc8\xba\x05\x1a\xcb\x98\x2e\x0e\x69\x99\x21\x5f\xda\xd0\x57\x05\xd5\x68\x87\x64\xb3\x4e\x53\xe1\x65\x90\x0c\x05\x1f\x69\x94\xe5\x5b\xb0\xf0\xe4\xbb\xa7\x79\xad\x93\x05\x74\x95\x44\xe3\xd4\x75\x26\xb7\xfc\xeb\xc0\x2c\x0f\x16\xc9\xb2\x50\x5d\xe0\xdf\x5e\xf7\x86\x12\xc8\xd4\x24\xcd\xf0\xf8\x53\x21\x8e\x4d\x8c\x47\x8a\x6b\xce\x82\x3f\x96\x4b\x2e\xc1\x8f\xaf\x37\x1a\x31\x23\xf9\xb1\x37\x12\xb1\x19\x53\x58\x6b\x80\xae\xff\x48\xc1\xeb\x8e\xed\x20\xb8\x12\x9f\x39\xab\x6e\x63\xe2\x78\xa5\x96\x06\x6d\xf8\x9f\xcd\xcf\xa7\x9b\x8f\xbe\x8b\x1e\x4d\xce\xbe\xec\x6e\x5e\xff\xcf\x90\x0c\x4a\x5c\x94\x12\x7c\x85\xb1\xd7\x0c\xf9\xeb\x0c\xb6\xc5\x30\xe1\xfc\x3f\xfc\x4f\x6f\xf3\x73\xff\x69\xed\x38\x75\xfa\x1b\x0e\x55\xac\x2c\x16\x0d\x0b\x7a\xc7\x3c\x08\x73\xa3\xc3\x39\xbc\xe3\xa5\xfb\xb1\x36\x6a\x93\x7e\xb9\x23\x40\x64\xba\xaa\xf0\x76\xc6\xec\x0b\x65\x73\x1a\xd8\xc1\xa3\x1f\xbd\x60\x56\x97\x21\x74\x57\xb7\x00\x67\xc7\xc5\x9c\xfe\x3b\x8e\x16\x05\xc8\x0e\x49\x82\xc4\x77\xa0\x7b\x68\xb4\x7b\xcc\x1c\xcf\x6b\x1d\x36\x1a\x38\x96\xdb\x3b\xc3\x0e\x8e\xc6\x33\x34\x8e\x0a\xa7\x1a\x52\x30\x42\x59\xce\xf9\x0c\x69\xd4\xc4\x56\x59\x7b\x9a\x62\x6d\x15\xcb\xf9\x1c\xc7\x95\xe4\x65\x35\x77\xc7\x64\x66\xd5\x5e\x47\x6e\xc3\x21\x1b\x8f\x85\x9b\x48\x96\xe4\xbf\x9c\x1d\x48\xe9\x43\x38\xc4\xcb\xa8\x00\x67\x34\xb3\x68\x47\x34\x62\xea\x52\x84\xb4\xe3\x73\xf8\xb2\xbb\x09\x77\x94\xc8\xa2\x10\x70\xde\x5d\xce\x50\x82\xe1\x39\xb5\x16\x81\x6f\xb1\xc0\x39\xed\xad\x98\x86\x14\xa2\x17\x4e\x09\x0b\x70\x17\x15\x78\x1e\x2d\xe8\x74\x6c\x19\x9a\xbe\x9e\xb4\x60\xd0\x3a\x0d\x6e\xd9\xb6\x1e\xf7\xd1\x0f\xe8\x5b\xba\x9d\xf3\xac\x53\x72\x36\x28\xb3\x13\xda\x10\xd7\x05\xad\xef\xef\x6b\x99\x40\xf4\xf5\x15\x7e\xbf\xef\xa9\x51\xd7\x2e\x59\x35\x56\xf8\x0a\xd7\x96\xa5\x62\xf9\x06\xe3\x57\xf1\x17\x24\x95\xbe\xe6\x47\x3d\x41\x8d\x15\xa4\x58\x66\x77\x49\x8a\x42\x7b\x2d\xf7\xe5\x15\x28\x51\xd3\x19\x4b\xea\xb3\x5f\x5d\xf3\x76\xba\x5d\x4e\x4a\x2e\x99\x1a\xf8\xbd\x11\xd1\x6a\x40\x63\xa7\xf7\xb4\xa2\x1a\x32\x16\xbd\xd0\xae\xdd\x6d\x92\x06\xa6\x37\x53\xa6\x7f\x94\x48\xbf\xa3\x07\x9f\x09\x73\xa0\x2f\x6e\xe2\x24\x85\x1b\x04\xdc\x44\xbf\x26\x05\xd9\xfd\xdf\xd8\xaf\x24\x6e\x8d\xbc\x6c\x46\xda\x58\x53\x2d\x69\x5a\x25\x4d\xc9\x53\x49\x9a\x06\x1b\x2d\x33\x2a\x51\x92\x18\x6d\x6f\x32\x9f\x41\x8f\xf8\x05\x21\x6b\x93\xbd\x4f\xd8\xfc\x8c\x98\x71\x87\x6b\xdc\xd5\x48\xc9\xfe\xdb\x7e\xee\x7d\x40\xe7\xda\xd2\x80\xab\x1d\xfd\x5a\xe2\x8c\x77\xe3\x13\x4e\x75\x05\x3e\x34\x19\x9e\xed\xb6\x75\x1b\xad\xa7\x22\x7e\xf9\xe5\xab\xcf\x84\x10\xa1\x17\xe1\x4a\x49\xd6\xa8\x5e\x53\x05\x68\x77\xd3\x7f\x4b\x20\x1c\x11\x8b\xd3\x74\xa1\xa4\xdc\xe6\x20\x9b\xde\x93\xa4\xef\xea\xcb\x08\xbc\xc9\x36\x32\xdf\x19\x10\xf5\x58\x37\x2c\xb9\x50\xf4\x2d\x2d\xca\x28\x1d\x53\x2e\xa2\x0a\x5f\x5d\x49\xa4\xf1\xc2\xf0\x7a\x0d\x7e\x19\x8e\x33\xbc\xa9\xcc\x36\x02\x78\x91\xac\xb2\xdb\x16\x51\xe2\x3c\xdc\x84\xa5\xf7\x8e\x71\x51\x4b\x14\x79\x42\x24\x79\xf1\xc3\x59\x2b\xef\x19\x8c\x86\xf5\xad\x7b\x77\xe8\x61\x7d\x69\x8d\x1b\xd1\xe3\x66\xec\xfc\xa8\x8c\x48\x56\xc5\x8f\x28\x7a\x23\x0c\x89\x12\xdd\x96\x23\xa2\x7d\x2a\x9b\x87\xc3\xba\x7e\x83\xc1\x1c\xf3\xbe\xb5\x5c\x0c\x54\xbc\x48\x97\x49\x02\x51\x12\x7a\xee\x0a\x01\xc3\x6d\x50\x61\x78\xc6\xce\xef\x6b\x5b\x8e\x7c\xc4\x3a\xdb\x82\x1d\x30\xc0\x9b\x30\x03\x96\x74\xa3\x89\xe4\xdd\x6b\x3b\x1a\x70\x01\xd8\x3c\x16\x27\xa2\x46\xcb\x91\xb8\x51\x31\x5a\xb2\x34\x28\x58\x3b\x06\xfb\x38\xc2\xf6\x51\xb0\x91\xcb\x04\xd5\x99\x83\xf8\x7b\x61\xae\x2b\x65\x81\x50\x3b\x06\x5a\xcc\x7e\x35\x20\x5d\x27\xe5\x97\xee\x3e\xb5\xbe\x0e\x37\x93\xec\x19\xae\x32\x66\xbd\x46\x63\x08\xfb\xd4\xc3\x9e\xbd\x4d\x3f\x90\xae\x32\xea\x88\x8f\xfb\x05\x9d\x40\xbc\x9c\xa3\x51\x92\x8d\x3f\xa1\x19\x8e\x62\x9c\xd3\x8f\x6c\x6e\x5b\x6d\x90\xe2\x19\x4d\xf6\x09\x0d\x33\xfc\x59\xfa\x45\x87\xb2\x68\x42\x92\xd2\x56\x66\x7a\x08\x16\x60\x0d\xf7\xc3\x34\xa5\xf6\xa4\xff\xcd\xd6\xb6\x3a\xea\x33\xf0\x06\xbc\x54\x1f\xd4\x59\x5d\x7a\x55\xbe\x73\xba\x0b\xe5\x8b\x38\xac\xce\xd9\x6b\x6e\x3f\x6e\x30\x33\x49\x46\xc5\xbc\x05\x19\xbb\xf3\xf0\x81\x26\x37\xcd\x43\x29\x81\x6a\x26\x00\x6a\x32\x26\x00\x8a\xd5\x4e\xc0\xe3\x5d\x85\x7f\x06\x7d\x63\xfc\x43\x55\x7a\x4d\x3e\xf4\x3b\x40\x37\xc2\x7e\x85\xe3\x11\x2e\xf2\x85\xe2\x47\x20\x52\xe1\xd1\x4f\x28\x7f\xb1\x74\x10\x0c\x43\xf6\x9f\x48\xe1\x16\x24\xa1\xfa\xc9\x72\x34\xeb\x92\x50\xff\x10\xe5\x4e\xca\xc9\x93\x90\xff\x2f\xd2\xc0\x5e\x25\x14\x3f\x54\x3d\x0c\x56\xfc\x52\xe9\x1c\x5e\xfe\xe4\xf5\xb8\x46\xb7\xa1\x2f\x91\x41\xbb\xb6\x9c\xa1\x27\xcd\x80\x15\x66\x93\xa1\x9d\x20\xc6\xf1\x33\x86\x51\xfc\x8c\xb5\x31\x40\x1a\xff\x21\xe0\xa4\xb4\x18\xea\x1f\x22\xd7\x54\x59\x87\x4e\x8a\xc4\x1a\x13\xd4\x43\xf5\x93\xe5\x68\xd2\x71\xa8\x7f\x88\x5c\xe3\x24\x12\xda\x09\x02\x4a\xcb\xb7\x72\xac\xa3\x7b\xe8\x26\x89\x1e\x3a\x90\x4e\x92\xa8\x53\x08\x63\xa1\xf6\x5b\xef\x6f\x3a\x0d\xe5\x2f\x91\xce\xf6\xd4\x50\xfe\x92\xa3\x67\xeb\x3d\x54\x3f\xe5\x98\xe8\x2e\x19\x8a\x1f\x22\x95\x6e\x58\x21\xff\x5f\xd6\x41\xf9\x5d\x28\x7e\x88\x54\x60\x1b\xa1\xf8\x11\xc0\x02\x63\x0e\xea\xf8\xab\xee\x4e\xb8\xf5\x5d\x50\xeb\xdf\x26\xe8\x2c\xcb\xc9\x93\x4e\xf8\xe4\x9b\xeb\xb3\x60\x7b\xab\x8d\xc7\x07\x73\x09\xef\xb3\x05\xdc\xe1\x8e\x0e\x3a\x21\xea\x6c\x0e\xb6\x37\x07\x5b\x9d\xb5\x6b\xe1\x0a\x6e\xbb\x55\xa4\xe2\x7b\x4f\x12\xf7\x9e\x24\xfe\x0a\x9e\x24\x78\x2d\x6b\xae\x2f\xb8\xbf\xe3\xc9\x24\xc7\x97\xe8\x67\x92\x8c\x3f\x61\xf4\xfd\x2f\x78\x32\xb1\xdd\x49\xb4\xf4\x18\x07\x60\x24\x4a\xd1\x31\x95\xb8\x23\x80\x22\x51\xea\x82\xfd\x18\x8d\x28\xd8\x3f\xb2\x29\x4e\x8a\x12\x27\x09\xce\xd1\xf7\x13\x48\x74\x81\x7f\x8a\xce\xd1\xcf\x59\x16\xa3\xef\xa7\x95\x6e\x2e\x76\x95\x7b\x1f\xee\x0b\xf2\x75\x94\x46\x53\xd3\xf7\xc4\x60\x48\xb1\x30\xcc\x19\xc0\x9c\x01\x08\x1f\x13\x47\x23\x38\x1c\xd9\xc0\x64\x14\xa5\x02\xe4\x05\x98\xf1\xdb\x10\x4c\xf2\x2a\x86\xb8\x9c\x09\xc0\xe7\xcf\x6a\xe0\xe2\x91\xf4\x37\x3b\xab\xab\xaf\x98\xc9\xfa\xde\x80\x67\xf2\x2a\xc0\x14\x97\x02\xf0\x2d\xce\x0b\x78\x4a\x55\x0d\xbd\xe0\x20\xb2\x13\x17\x51\x3e\xaf\xeb\x06\xcd\x97\xc0\xb8\x2c\x21\x6a\x93\x0b\x5f\xf0\x2c\x01\x2a\xb8\x8a\x01\x29\xd8\x05\x3d\x51\x29\xe7\x1e\x24\xb1\x2a\xd4\xc2\xca\x57\xfb\x08\x61\x40\xc2\x1b\x13\x7f\xb8\x81\xd3\xd8\xd3\x37\x96\x21\xc0\x9e\xc1\x89\xc9\x85\x1a\xd1\x74\x89\xc9\x3c\x5b\xe0\xbc\xbc\xf4\xc0\x2d\x78\x96\x00\x7d\x59\x96\x8b\xb7\x79\x76\x4e\x62\x2f\xb9\xd1\x85\xba\xe0\xd9\x92\xd8\x16\xe3\x9a\x12\x64\x31\xb6\x0b\xb4\xf3\x68\xb8\xb6\x26\x65\xf5\x9f\xf1\x68\x07\xf5\x44\x35\xa6\x57\xde\xdc\x5e\x21\x29\xbe\xb0\x96\x8d\x2a\xa9\x39\xe8\xe5\xa1\x56\xb5\x9e\x0b\x28\x0d\x08\xb3\xb7\x2e\xf8\x82\x2e\x17\x70\xd4\xaf\x57\x11\x8f\x78\xe6\xf3\x67\x4e\x5e\x31\x13\x25\xdf\xcf\xdc\x92\x29\xac\x01\x9a\xfb\x06\x97\x4e\xee\x42\x11\x3e\x05\x11\xeb\xc0\x81\x1b\xfd\xfa\xab\x68\x83\xd2\xb5\xdb\x07\x45\xe0\x00\xc4\x3f\x7b\x3a\x8c\xa2\x6c\x75\xd4\x88\x16\x24\x94\x9b\x21\xff\x9f\x1d\x39\xf4\x4e\x72\x6c\x15\x46\x51\x9d\x7c\x42\xe3\x2b\x90\x30\x1a\xbd\x84\xfa\x87\xd3\xc4\x47\xb9\x06\xd8\x0f\x67\x80\x1c\xa0\xa7\xda\xe7\xe4\x4c\x70\x11\x6a\xbf\x7b\xcc\x98\xe7\xba\xbf\x47\x25\xa6\xe1\x10\x5c\xf0\x16\x18\xa9\x31\x64\x6c\x27\x06\x9f\x40\x6b\x94\xdc\x3c\xe3\x6b\x1a\x5b\xe5\xb8\xa8\xd0\x28\xea\x14\xf1\x34\xb1\x4e\x79\x7a\xcc\xe0\x66\x1a\xd7\x0b\xaf\x4c\xda\x9e\xbe\xe4\x98\x05\x8b\x50\xbd\xf8\x84\xf1\xe2\xa8\x78\x7f\x99\x8e\x49\x3a\xad\xed\x0a\x94\xb5\xe0\xdb\x51\xa0\xa7\x23\x3a\x5f\x78\xa6\xec\x57\x2c\x28\xf1\x0c\x86\xb9\x93\x82\x2f\x0f\x8c\x78\x32\x2b\xa0\xe0\xdb\x03\xc7\xdf\x5d\x0b\x30\xfa\xe9\x40\xe9\x4f\x70\x19\xa0\x4c\xf1\xc2\x1a\x75\x8a\x04\x4f\xdb\xea\x3d\x95\x68\x9e\xa7\x78\x6b\xb5\xa1\xb5\x34\x4f\xdd\x3a\x2e\x45\xed\x75\x38\x65\x26\x8e\x02\xf2\x67\xec\x1f\x99\x0e\xc5\xbf\x1d\x38\xdd\xb4\x85\x41\xca\x14\x0f\xac\x7b\x2b\x2c\xca\x1c\xda\xb7\x85\x4e\x9f\xab\xca\x3a\x39\x9e\x76\x8f\x9e\x1d\xbc\xd1\x1a\xa3\x9f\x0e\x94\x72\x4f\xc3\xc0\xf8\xb7\x0f\x4e\x78\x4e\x91\x80\x90\x40\x77\x31\x7b\xe1\xb3\xad\x5f\x7f\xc9\xcd\x2e\x85\x4c\xef\x8a\xe6\x75\x1d\xdc\x49\xdb\x90\x55\xd7\xa7\x31\xc9\x41\x55\x3c\x8e\x16\xf0\xfa\x42\xbb\xc0\xf4\xcc\xe8\xd1\xe1\xc1\x5b\x63\xed\xd3\x72\xba\x85\x1c\x61\xa2\x24\x5d\xbe\x54\xaa\x64\xf9\xc6\x63\x4f\x0a\x31\xe0\xcd\x88\x95\x0d\x0e\x65\x24\xff\xad\x8b\x38\x7a\x2a\x79\x37\xec\x75\x5c\x1c\xe9\x99\x77\xce\x29\xe8\x60\xba\x62\x4f\x4a\xb3\x18\x77\x03\x03\x62\x0a\x66\x21\x21\xea\x52\xa1\xe3\xe3\x38\x21\x38\x2d\xff\xc1\xc0\xbb\xea\x4e\xba\x1f\xdc\xa4\x35\x5c\x5e\x64\xf9\xa7\xaa\x06\x53\x5c\x7e\xe4\xa0\x16\x88\x19\x30\x20\xb4\x57\xf9\x2d\xbb\x85\xb9\x42\xbb\xaa\x5f\xb8\x9c\x7d\x84\xb9\x1e\x67\xc9\x3f\x7e\x87\xfe\x5d\xcc\x48\xb1\x90\xbe\x91\x9d\xee\x15\xb3\xd9\xad\xd1\x06\x3f\xcf\xbc\x7b\x09\x29\x0e\xb3\x34\x65\x3e\x9b\xb4\xe5\xd6\x37\x68\xaf\xe7\xdd\x2e\x1f\x3c\xf0\x6e\xa3\x7a\x95\xbd\xbe\x7f\x07\x63\x5e\x0a\x84\x4c\x5e\x49\xf3\x60\x1c\x0a\x91\x13\xb8\x44\xe3\xd5\xdb\xd2\xba\x85\x37\x51\xfd\x84\xc0\x54\x4e\xc6\xc1\xa2\x13\x6e\x6f\xd2\x24\xfd\x48\xd2\x09\xb7\xb7\x68\x9a\x3a\x0e\x74\xc2\xed\x5d\x99\xc2\x44\xa7\x4e\xb8\xfd\x44\x26\xe9\xc2\x7d\x27\xdc\xd9\x96\x19\x74\x85\x77\xc2\x9d\x1d\x95\xa0\x84\xfa\x4e\xb8\xa3\x2a\x55\xc7\xc2\x4e\xb8\xf3\xad\x93\x8c\xcb\x59\x27\xdc\x79\xe2\xa4\xa7\xb8\xec\x84\x3b\xdf\x39\xe9\x42\x10\xee\x84\xbb\x9b\x4e\x66\x31\x9b\x75\xc2\xdd\x2d\x37\x9d\xca\xc2\x9d\x70\x57\x75\x5f\x9c\x71\x3a\xe1\xee\x37\x32\xd1\x3c\x38\x77\xc2\xdd\xc7\x32\x4b\x48\x2d\x9d\x70\xf7\xdb\x7a\xdd\xde\xf5\x59\xb0\xbd\x73\xaf\x79\xbb\xd7\xbc\xfd\xb7\x68\xde\xa2\x24\x01\x07\x13\xb7\xf3\xe3\xaa\x29\xb8\x1c\x55\x88\x4f\x17\x22\xc2\xc4\xbc\x38\x67\x16\xfd\x9a\x8e\x01\x7a\x23\xe0\x54\xd0\x98\xa6\xe8\x48\xae\x9e\xc6\xab\xa8\xf9\x11\x2e\x77\xad\xca\x20\x4d\x40\x5c\xb0\xd8\x47\x26\x88\x60\x45\x3c\x53\x7a\x77\x3d\x48\x12\x63\x28\xa6\x60\x64\x1e\xad\x02\xb8\xa9\x0f\x90\x65\x5a\x56\xa1\xf4\x30\x13\xf4\x23\xf2\x2f\xec\x72\x9e\xfe\xa7\x27\x3b\xe6\x92\x6c\x13\x72\x7a\x58\x1f\xe6\xdb\x92\x5a\x85\x3f\xf0\x7d\xf9\xeb\xea\x0a\xe2\xdf\x20\xdb\xef\x03\x4d\x84\xd4\xd3\x2e\x95\x42\x21\xae\x40\x37\x40\xdd\x32\x63\x3f\xcf\x06\x0c\xcd\x5a\xbc\xc3\x89\xe7\x36\x94\x37\x73\x3a\x39\x03\x03\x17\x69\x1f\xca\x6f\x48\xfb\x9e\xa0\xd9\x56\x35\xb4\x3f\xb4\xf8\xbe\x46\x3c\xcc\x85\x0e\x74\x84\x9d\x97\x54\x10\x3c\xd5\xa0\xb0\x59\x50\x0f\xb7\xc0\x17\x85\xc2\xab\x81\x67\xf3\x75\x97\xee\x9d\xa2\x0e\xe3\x9e\xa8\xc5\x71\x54\x46\x62\x04\xf4\xf7\x80\xfe\x83\xf6\xb5\xdf\x57\x57\x60\x14\x2b\x01\xe0\x2a\xb9\x10\x20\xfc\xeb\xea\x4a\x45\xdf\x04\x6d\x23\x6d\x5a\xdc\x91\x6b\x80\xa7\x9b\x67\x83\x82\x32\x04\xe9\x62\x9d\x42\xcf\xb9\x80\xa3\x28\xcc\x9d\xae\x5f\x3c\xd3\xa5\xb7\xb2\xcf\x4d\x6d\xb9\x74\xe7\x5e\xda\xf6\x7e\x91\xef\xd3\xfb\xa7\x9b\x67\xda\xc3\xab\x75\x68\xbf\x8f\xbe\xc0\x63\x87\x28\x4d\xb3\x12\x4d\x48\x1a\xb3\x7e\x91\x74\xca\x1a\x7a\x2a\x9b\x1f\x67\x69\x91\x25\x78\x70\x11\xe5\x69\xaf\xab\x97\x60\xde\x72\x28\x2b\x4e\xb2\x69\x57\x33\x7d\xe5\x3d\xa6\xa8\x70\x3c\x2e\x61\xce\x86\xf4\xc8\x5c\x30\x77\x3d\xdf\xea\x0c\x58\xb7\x02\x93\x20\xcc\x23\x14\xd4\x28\x9c\x1d\xc2\x14\xb7\x58\x8e\x9f\xf1\x98\x4a\x00\x9e\xf5\x18\x80\x47\xa6\x51\x34\xfe\x24\x63\x88\x82\x2b\x02\x7e\xd8\x15\xd7\xad\xbd\x28\x9f\x2e\xe1\x2d\xc8\xa9\xfc\xa5\x79\xe3\x31\x8d\xd0\x45\x8d\x10\xfb\xb9\xb6\x98\xee\x37\xae\xe7\x40\xd0\x89\xdf\x32\xfd\x58\x28\xb6\x91\x2e\x93\xc4\x41\x77\x26\x28\x8d\x7b\xbf\x53\x07\x60\x01\x31\xd1\xa2\xac\x31\xcd\x2c\x60\x72\x30\x22\xa6\xd2\x50\x13\xbf\x19\x67\xef\x65\x01\x1c\x94\xba\x81\x97\xb1\x06\x82\xbd\xd3\xc3\x56\xaf\x1f\xa8\x86\x34\x0c\x37\xcf\x54\x54\x96\xd1\x78\xf6\x21\x3b\x14\x8e\xb0\xf4\x29\x13\xde\xb1\xf4\x33\xb7\x9a\x5a\x36\x6e\xf6\xe9\x0c\x47\x14\x1d\x44\x49\x22\xf7\x13\x0e\x5c\x71\xa6\x70\xba\x29\x0f\x18\x9e\x13\x86\xf7\x88\x01\xa4\xda\x09\xb7\x41\xba\x67\xab\xbe\x13\x6e\x83\xec\xae\xc7\x6c\xdb\x01\x60\x6b\x23\xec\x84\xbb\x3b\x54\x64\xde\xbd\x17\x99\xef\x45\xe6\xbf\xb6\xc8\xac\x85\x7b\x81\xb3\xf7\x5d\xc5\x7b\xf9\x7b\x91\xa5\xf9\x62\x6c\xca\x9b\xbf\xb0\x44\x79\x75\x98\xe7\x99\x2d\x02\xb3\x34\x29\x89\xba\x2a\x0a\x3a\x58\x43\xc8\x74\x64\x4c\x40\xc7\xc7\x4a\x49\x93\x67\x14\x3c\xb0\x77\x83\xa3\xc0\x28\x8e\x85\x4f\x47\xca\x8e\x79\x61\x70\x93\x0d\x5d\xe3\x09\x96\x45\x60\x14\xc7\x1e\x1b\x5b\xc4\xc7\xcf\x0a\x95\xca\xba\x75\xb8\x06\xe3\xd4\x59\x71\x1c\xfb\x64\x6e\xdf\xc0\x0b\x16\x15\x5c\x40\x34\x8e\x48\x30\xed\xba\xfe\x73\x18\x6f\xd7\x7c\xfb\xb9\xe9\x5d\x40\xe2\xd7\xe8\xa6\x3b\x05\xea\x9e\x28\x8d\x99\x9a\x49\xc0\x06\x5a\xdd\x38\xcf\x03\x2e\x89\x16\xae\x4c\xcc\xc8\x87\xfd\xe2\xc2\xa2\x02\xe0\xf8\xd1\x5d\x4c\x4a\x54\x06\x08\x5e\xb7\x57\xbc\x5f\xe3\x55\x9e\x02\xcc\x99\x7e\x3c\xa8\x14\xd8\x59\x91\x8a\x6a\xa9\x58\x23\xfa\xc3\x2b\x1d\x38\xb2\x8f\x5d\x60\x9d\x2f\xa2\x01\x29\xfe\x11\x25\x24\x7e\x87\x8b\x45\x96\x16\x98\x37\xe5\xbc\xbd\x73\xc6\xe0\x6f\xaf\xc7\xd6\xd8\xe0\x28\x3d\xf7\xd6\xba\xe7\x54\x7a\xed\xf6\xaf\xb2\x72\xe6\xb3\xc9\x19\x2c\xdb\x73\xc1\x37\x84\x2f\x83\x37\x3e\xe0\x7d\x00\xaf\x11\x7a\x82\x13\xc5\x5e\x4d\x85\x3c\xdf\x20\xbf\x28\x01\x94\xa5\xf4\x93\x6c\xf0\x9d\x70\x1b\xf4\x68\x7c\x45\x76\xc2\x1d\xb0\x7a\x6b\x15\xe5\xfb\x7e\xc3\xbf\xdf\xf0\xff\xbc\x1b\xbe\xda\xef\xa5\x58\x7e\x47\x2a\xb2\x96\xba\x2a\x7a\xe2\xc9\x2d\xb0\x82\xcb\xfa\x43\xc8\x5c\x55\x9d\x26\xe0\xb4\x77\x53\xba\x1e\x4c\x3c\x88\xe2\xd0\x07\xda\x21\x44\x03\x93\x1a\x43\x23\xe4\xb8\x6f\xff\x64\xea\x25\xfd\x91\x19\x6c\xf3\xf6\x33\x65\x06\x77\xa8\xc0\xde\x0a\x28\x29\x17\x80\xb1\xef\x35\x12\xbe\x9c\xcd\x54\x6f\x03\xdc\xdb\xaf\xbf\x6a\xf3\xa9\xe7\x88\x04\xbc\x9c\x75\xab\x13\x8d\x88\x47\x0d\xa2\xf9\x7d\x46\x96\xa3\x65\x16\xe0\x7b\x7f\x1f\x75\xb5\x3e\x75\xd1\x83\x07\x86\x23\x67\xed\xdc\xcc\x9a\x35\xbc\xfd\x5f\xf7\xad\x6d\xb8\xae\x41\x8f\x6b\x68\xd4\x83\xc4\x8a\xed\x1a\xf2\x98\xc7\x68\xcf\xce\x60\x55\x44\xc1\x0a\x4f\xd3\x40\x7b\xfc\xf0\xce\x10\xca\x40\x25\x1a\x35\x2d\x8f\xd0\x5e\xb5\x90\x1e\x65\x40\x2f\xee\x2a\x8a\x1d\xad\xbd\x2f\x9b\xa2\x38\x16\x34\x5c\xa8\x63\xb8\x4e\x1b\x22\xed\x5a\xd6\x54\x49\x4f\x8c\x54\xfc\x55\xd6\x9e\xec\xd5\x71\xfd\xe6\x84\xa2\xbd\x42\x5c\x65\xf6\x75\x4d\x95\xd4\xfe\xc8\xfe\x7c\xc4\xe5\x4c\xa8\x9b\x55\x27\x4d\xaf\x17\x8d\xaa\xd4\x89\xa3\xe6\x50\x08\x50\xaa\xd2\x16\xf3\xca\xb8\x45\xab\x49\x65\xfc\xe6\xee\x66\xd4\xae\xaf\x59\x51\x23\x18\xde\x5d\xcc\x2d\xe3\xbd\x96\x5a\x99\x73\x56\xae\x6d\x94\x3c\xd6\x9c\x3c\x57\x6f\xc5\x3a\x56\x39\x9d\x07\x49\x52\x3b\x5d\x00\xc4\x2f\x7a\x56\x26\x30\xa6\x0a\x6d\xe8\xe0\xea\xd4\x66\x3c\x0a\x74\x95\x6a\x55\xd4\x56\x47\x6e\xd2\x51\x0e\xd8\xfe\x89\x49\x9f\xe2\xb2\xe0\xc6\x2b\xc9\x25\x8a\xf1\x22\xc9\x2e\x71\x2c\x4c\x04\xe1\xf9\xe0\x78\x16\x91\xd4\x7e\xae\x06\xb5\xfd\x98\xe5\xa2\x47\x1e\xdf\x03\xe2\xc0\xea\x23\x49\xb1\x2e\xaf\xa5\x6a\x71\xcd\x70\x91\x7b\x22\x2e\x37\xd4\xb3\xb6\x8a\x16\x75\x83\x07\xd1\x92\xa6\xb0\x54\xe4\x0b\x01\x9b\x21\x92\x8c\xa3\xe6\xfd\x11\xa2\x94\xef\x8b\x87\x65\x90\x3f\x1c\xa2\x8b\x88\x30\x75\x39\x88\x5c\x8b\x52\xa9\x60\xc5\x4d\x99\x39\xef\x7c\x29\xc8\x80\xd1\xaa\x63\xb8\x6f\x7a\x5e\x5e\xc7\xf0\x34\xd9\x68\xdf\xde\x95\xa0\xbf\x1b\x1b\x7b\xe6\xb1\x69\x38\x44\x45\x99\x2d\x98\xae\x96\xa4\x53\x14\x4d\x68\x57\xbe\xd9\x64\x73\x55\xa0\x5e\x49\xe6\x38\x5b\x96\x7d\xe7\xe8\xc8\x10\xf0\x03\xfa\x66\xd3\x7b\x58\x64\xbd\x1f\xd0\xda\x7f\xe6\x95\xab\x98\x0a\x7d\xf4\xe5\xda\x73\xa6\xb3\x11\xc8\x1e\xec\x79\xcf\xa1\x72\x46\xbc\xa7\x4d\x75\xf2\x53\x8e\x81\x25\x63\x82\xfb\x92\x88\xad\x8c\x31\x25\x6c\x70\x12\x3c\xa2\x12\xf3\x32\x8d\x6d\x0c\x74\x7d\x87\x4f\x9c\x68\x0e\x45\xf4\x3f\xc7\x1d\xf1\x8d\x5b\xa5\xcb\x4f\xaf\x59\xfa\x13\x71\xb1\x66\x50\xcd\x14\x97\x1f\x54\x53\xef\x18\xa9\x29\x8e\xa2\x75\xe3\x65\x54\xcc\x74\xa2\x0a\x04\x61\xf6\xfd\x47\x78\x32\xe9\x71\x00\x3f\xb5\x79\x0b\x79\x3b\x08\x21\x8c\x78\x5d\x83\xb1\xb9\x00\xcd\x1e\x41\x9c\x23\x7f\x77\xc4\x5f\x95\xf7\xf6\xc7\xd2\x7b\x7b\xd5\x1f\x99\xf4\x4c\x8a\xbb\xba\x42\xeb\xd0\x62\x6d\x31\x24\x59\xb7\x87\x36\xf5\xbf\x9b\x2c\x01\xfd\xaf\xe5\x72\xb0\x87\x94\xc5\x5a\x88\xe8\x9d\xda\x99\x11\x7f\xc3\xa1\xbc\xe7\x4b\xb2\xa9\x46\xb5\x70\xac\x10\x6c\x7c\xbd\xdb\x6f\x68\x1e\x19\xa2\x9a\xe4\xa8\x15\x53\xdd\xa2\xb2\xe1\x10\xb1\xcd\x4a\x88\x0b\x51\x1a\x23\x7e\x33\x82\xa2\x69\x44\x52\xbe\x72\x2e\x30\x8f\xf0\xd7\xf0\xe7\x97\x3d\xed\x0d\xb0\xa1\x06\x5b\xd6\x71\xb6\xff\x86\x21\x8d\x99\x53\x36\x7e\x29\x48\xb7\x04\xba\x3b\x16\x78\x9c\xa5\x31\xa2\x0c\xb7\xb1\x12\x8d\x74\x9b\x89\x15\x19\x1c\x11\x74\x61\x6d\x3b\xec\xf5\x62\x74\xc7\x1d\xd2\x7d\xb7\x6b\xa2\x04\x3f\xd1\x6a\x9c\xb2\x28\xb3\x1c\xc7\xd2\x8f\x3b\x93\x40\x40\xe3\x33\x8d\x0a\x14\xcd\xe9\x86\x34\xf0\xf2\x6b\xfb\xaf\x92\x7f\xdb\x7f\x1e\xf7\xf2\x77\xd1\xc5\xfa\x1e\x5e\x57\xe6\x56\x71\x0c\xb7\x84\x0d\xa9\x69\x27\xdb\x1e\x28\xb4\x2b\x06\x41\xe8\x3f\x46\xf4\x98\x7d\x29\x7d\x69\x58\x52\x9c\x05\xd6\x70\x68\xb0\x2b\xd5\x0f\x0c\x70\xaa\x8a\x46\xc4\xb8\x5c\x60\x2f\x8a\xb0\x38\xbe\x43\x5a\x34\x22\x68\x9f\x42\x0a\x39\xeb\x21\xd3\x84\x36\x8f\x49\x9d\x90\x52\x14\x69\xa2\x29\x2f\x2e\x6a\x11\x63\x4b\xf1\x85\x4c\x12\x63\x4a\x2f\xaf\x75\x62\xb0\x74\x23\x5b\xc2\xa6\x20\x4a\xfa\x2b\x16\xdd\xae\x29\x6a\xcb\xc1\x86\x64\xc1\x7d\x9d\x08\x45\x71\xec\x94\xf6\x49\xca\x1c\x42\x4a\xcb\xea\xf8\x27\x92\x64\x5b\x6a\xe2\xa1\xd0\x50\x4d\x04\x45\xa9\xef\xfa\x05\xd5\x6c\xd1\xdf\x8a\x1a\xe8\x9f\xfc\xc1\xa4\x6b\x79\x8a\xd4\xe9\xaf\x27\xe8\x20\x90\xb3\xaf\x73\xb0\xe1\x90\xc5\x56\x54\x56\x16\x46\xa5\xca\x56\xe2\xcb\xf5\x1e\x05\x16\x58\x5a\x37\xdb\xe6\x88\xd1\x2a\x86\x33\x6e\x0e\x6f\x71\x80\x90\xf5\x47\x09\x09\x19\x63\xb8\x6a\x50\xf6\x1a\x56\xf8\x3f\x9f\xed\x08\xd8\x7f\x54\x5b\x8c\x20\xc7\x6a\xa4\x18\x2c\xb2\x85\xe1\x60\xce\xec\x5e\x12\x15\x25\x87\x74\xaa\xf6\x77\x87\x11\x52\x8f\x16\x04\xe7\x45\xeb\xf2\xc5\x09\x04\xa2\x85\x74\xbb\x4f\x0a\x85\x0d\x5d\xa2\x0d\x71\xe0\x01\x8b\x4a\xf2\x03\xda\xb4\x6b\xe3\x33\x2d\x68\xff\x40\xac\xe5\x66\x2d\x80\xf8\xbb\x95\x4a\x50\x43\x93\xc5\x2c\x85\x3a\x4d\x9a\xda\xe9\xc3\x5a\x37\xbb\x3c\x58\x44\x97\xd1\x28\xc1\xbe\xee\xb9\xc7\x01\x66\x3f\x55\xe0\x34\x56\x11\xa9\xd2\x2c\x7d\xc4\x2b\xd1\xd1\x61\x6f\x13\xd7\x55\x53\x0f\xbe\xfd\x28\x67\xf4\xab\x60\x7b\x62\xa9\x04\x30\x62\xad\x56\x31\x41\x60\xfb\xb6\xb1\xcf\x2a\xda\x33\x27\xb1\xf2\x46\x50\x3f\xd1\x1a\x3a\x00\x21\xf7\x45\x71\x6a\x6b\x09\x62\x8c\x2e\xa2\x42\x0a\x94\x6b\x26\xae\xd8\xd2\x86\xab\x57\xed\x08\xa3\x0c\xb3\xac\xfb\xd7\x59\x54\xcc\x7c\x48\xa7\xbd\xc6\x79\x5e\x75\x13\xa9\x5f\x39\xfa\xee\x15\xeb\x24\x1e\x2a\x8e\xc6\x31\xbb\xf6\xd2\xb8\x2e\xed\x89\xbf\xad\x8a\x63\x17\xda\x87\x32\x15\xc2\x57\xa5\x84\x38\x21\x79\x51\x56\x0b\x88\x2b\xca\x78\x15\x1a\x10\x9f\xda\xc3\x77\xfd\x6a\x7c\x35\x39\xbe\x84\x48\x9b\x6c\xe0\x4d\xf3\x6c\x35\xd6\x16\xe5\x8d\xa8\x5e\x65\xe8\x7e\x9e\x26\x75\xf2\x0c\x88\xeb\xca\x38\x76\xc5\x26\x48\xcf\xb7\xcf\x99\x5d\x29\x24\xf1\x4f\xc3\x00\xed\xc6\x82\x97\xad\x35\x6b\xd2\xce\x7a\x36\x75\x5e\xd3\xb5\x29\x03\x4d\x64\xfd\xc3\xb5\xe1\xd0\xda\x81\x8d\x0b\x1c\xe5\xf1\x58\x53\x5f\x5a\x95\xf7\xd8\xbe\x3c\x1c\x1a\xae\x74\x2b\xe3\x4e\x8f\xc7\xe0\x15\x37\x63\x81\x9a\x48\x3a\xad\x91\xcd\x4c\x35\xb6\x39\x72\x36\x89\xd7\x2e\x27\xd2\xc5\xa1\x3a\x51\x08\x7d\xd1\xa4\xae\xb6\x12\xd1\x04\xa5\x99\xaa\x81\xb2\xb7\x45\x54\x14\x38\x0e\x68\x15\xca\xf5\x1d\x85\x28\xb4\x25\x6d\xf2\x32\x49\x78\x30\x03\x16\x3a\x0d\x73\x48\x9f\xef\x54\xd3\x66\x15\xad\x2c\x43\x29\x4b\x79\xa5\xad\x2c\x67\x9a\x6b\x49\x08\x56\x03\x21\xc2\x84\x51\x81\xec\x52\x20\x0a\x8c\xf0\x38\x5a\x16\x98\x9e\xc4\xe3\x2c\x2d\xd1\x45\x94\x82\x4d\x52\xb1\xc8\x48\xc2\x6e\xc3\xd3\x12\xe7\x93\x68\x2c\x7d\x63\xb7\x38\x89\xb7\x39\x6d\xdb\xdb\x54\x33\x3f\x44\x8e\x7b\x5d\xb9\xa6\xb5\xb5\xf9\x13\x2e\x99\xb3\x66\xba\x3f\x06\xe8\x62\x46\xc6\x33\x30\x1a\xa0\xcb\xbb\xcc\xf8\x36\x86\x16\xc9\xb2\x68\xbe\x7a\xe5\x7c\xa0\x61\x7e\x15\xf3\xf0\x1b\x32\x35\x88\xb0\xab\xcb\xa9\xb2\x58\xb3\xfc\x78\x1b\xd9\xb1\x5a\x6e\xd4\x8c\x95\x6f\x24\xc7\xd4\xc9\x30\xe6\x4b\x87\x01\x33\x48\x6f\xcf\x7c\x3d\xa7\x1e\xef\x71\xb7\xc5\xf5\x79\x15\x6b\x72\x0e\xc3\xde\x53\x70\xc5\x4b\x16\xdf\x79\xd8\xdd\xfd\x94\x5d\x38\xc3\x9f\xfb\x78\x05\x79\x0e\xd3\x5e\xb3\x25\x8b\x6e\xf7\xa4\xf9\xb3\x69\x2b\xd1\x09\xbf\xad\xb2\x80\x96\x16\x0d\x9d\x70\x7b\xc7\x35\x89\xe6\x23\xef\x84\x3b\x5b\xd7\x67\xc1\xf6\xe3\x7b\xd3\xa7\x7b\xd3\xa7\xbf\xb6\xe9\x93\x66\xeb\xcc\x4d\x20\xef\xc0\xd8\xb9\xc2\x8d\x25\x37\xae\x64\xef\xb2\x8e\x27\xe2\xce\xf9\x20\x9f\x16\x61\x85\xe6\x46\x93\xf1\xf8\x09\x96\x57\xa2\xc7\xbe\x13\xdb\x09\x65\x9f\xa2\x52\xa4\x9b\x38\x01\x9f\x0f\x7c\x7d\x78\xf7\xf6\x90\x31\xf7\xdb\x74\x80\xc5\x5b\x02\x56\x8b\xe1\x01\x63\x99\xa1\x77\x6f\x0f\xf9\x3d\x81\xbf\x03\xfc\x39\x3a\x38\x51\x54\x2d\xcf\xb2\x42\xbf\xfd\x72\x1b\x3f\x3c\x7e\xf3\xe6\xc5\xe1\x87\xa3\xe3\x37\xe8\xc5\xbb\x77\xc7\xef\x42\x74\x28\xd5\xbf\x63\x56\x25\x3b\xd1\xc7\x18\x75\x37\x10\xad\x0f\x6d\x74\x07\xfe\x3e\x28\x8f\x37\x6d\xc7\x2e\xdf\xd9\x33\x25\x42\x49\x57\x0f\x61\x95\xf9\x9b\x10\x86\xb4\x21\xb2\x8d\x82\xb5\x61\xc2\xb3\x34\x5c\x14\xd1\x14\xa3\x7d\xb4\xbe\xce\x5f\x1a\xd2\x6d\x9d\xff\x1e\xb0\x90\xb1\x4e\xca\x40\x14\x7b\x8a\xbc\xc9\x21\x92\xd3\xf5\xf7\xf7\xc7\x6f\x60\x56\x72\xd9\x25\x4f\x98\x55\xde\x37\xe7\x2d\x99\xc2\x01\xaf\xda\x1c\xad\x9a\xcd\x0f\xec\xba\x5a\x1f\xef\xbc\x68\x3b\xa5\x1f\x8e\x5e\xbf\x38\x3e\xf9\x10\x22\x7e\xe9\x4d\x89\x8b\x76\x72\x5e\xa0\x0d\xd4\xa5\xff\x45\xe3\x19\xe5\x18\x5d\x23\xa0\x0d\x77\x23\xf9\xed\xfd\x6e\x75\xbf\x5b\xfd\xb5\x77\x2b\x6d\xb3\x82\x57\x97\x7f\x54\x2b\xdd\xf6\x8f\xd9\x5b\xbd\xa1\xbf\xc3\xa7\xec\xc2\xe7\x10\x5d\xff\xf2\x70\xa6\x47\x64\x2a\x8c\x63\x08\x7f\x63\x0b\x6d\xa9\xc3\x82\x6d\x84\xfc\xb5\xdf\xc1\x2f\x84\x29\xaf\xa6\x48\xd7\xf3\x59\xe8\x0a\x54\xf3\x1c\xb9\xc8\xd2\x7e\xc3\x13\x7a\x2d\x33\xcd\xd2\xcb\x79\xb6\x94\x2d\xca\x84\x8a\xd3\x9b\x40\xda\x14\x0b\x5c\xe1\x98\xc9\x03\x10\xc4\xc0\x09\xd6\xc4\xd3\xe4\xf1\xec\x59\x96\x25\xd7\x10\x5e\x35\x06\x17\xe4\x6c\x93\xc0\x0c\x32\xd6\x66\x07\xde\x87\xe0\xd8\x70\x98\x2e\x4e\x7c\x10\x8c\x80\x2e\x4a\x5e\xfb\x70\xcd\x98\x26\xdd\xfb\x16\x85\x30\x1d\x47\xb1\x7a\xed\x00\x0c\x1a\xf2\xdd\x2b\x11\xe2\x11\x15\xc8\x80\xd7\x04\xf7\x1b\xfc\x77\x85\xb9\xab\xbf\xbc\xb2\x57\xae\xbc\x21\xd6\xb1\xcd\xe8\x33\x66\x2e\xc0\xc1\x8b\x91\x85\xeb\x58\x79\x07\xf7\x46\x0b\xf2\x56\x50\x8d\x3a\xad\xba\x3a\x27\x41\x8c\x12\x5d\x07\xe5\x3d\x45\xaf\xed\x47\x07\x2b\xd4\x33\xb4\x92\xfb\x33\xaf\x19\x97\x5e\xb4\x9e\x1e\x56\x1a\x91\xf0\xa4\x7e\xa3\xe1\x14\x64\x9a\x46\xe5\x32\xb7\x87\xa3\xa7\x57\x8d\x47\x87\xa9\x1e\x8f\x84\xaa\x1b\x10\x38\x30\x68\xdf\x7f\xfe\xc2\x41\x90\x37\xe7\x48\x51\x1a\x4b\xd5\x52\x99\x41\x50\xe2\x09\x49\xa3\xc4\x6f\xf5\xcc\xea\xf0\xd9\x94\xea\xeb\xda\xca\xe2\xd5\x1b\x48\x11\x79\xf8\x1c\xe7\x97\xe5\x8c\x69\xac\xe7\x23\x02\x2c\x23\x63\x51\xa2\xa1\x6f\x3c\xcc\x42\x2d\xb6\x3c\xae\x41\x78\x77\x1c\xcf\x76\x72\x71\xcb\x5f\xda\x23\xc0\x7b\x07\x22\xca\x5d\x87\xf4\xcf\xd1\xe4\x59\x44\xe8\x35\xd7\xad\x9d\xc7\xed\x27\xaf\x9c\x3d\x6c\xe5\xbe\x05\x99\x9f\x4e\x41\xed\xbd\xbe\xab\xd2\xe4\xf3\xf4\x41\x74\xec\xb6\x2c\x5d\x0b\x61\x51\xcb\xcf\xc1\xf1\x32\x0f\xa6\xcd\xcb\x9f\x12\x08\x31\x65\x19\x03\x70\x20\x7d\xfe\x28\xdd\xa8\xe4\x74\x99\x24\x15\x2f\x5c\x94\x66\x11\xb9\x97\xff\xa6\x42\x18\xea\xab\x0a\xcc\x08\x99\xd6\x68\xce\x6b\x6e\xfb\x39\xf6\x9d\xb7\x31\x3d\xb6\x7d\x05\xe8\xdc\xbe\x39\xef\xdb\xb1\xf5\x56\xaa\x0d\xfa\xde\x40\x71\x26\x91\x8c\xb3\x74\x1c\x95\x3d\x63\xf6\xfb\xd5\x7e\x6c\x2a\xb9\x1e\x77\x62\x53\xcd\xf5\xec\xdd\x16\x57\x71\xb8\x98\xdd\x3d\xb8\x3c\x8c\x73\x05\x6e\x38\x04\x27\x04\x56\x4b\x2c\x9b\x7d\xf0\x00\xf4\x0d\x66\x2f\xea\xb7\xe9\x6a\xe7\x3b\x80\x83\x3b\xf4\xbe\x13\xe5\x53\x6b\x75\x29\xf1\xf1\xa9\x51\x32\xd4\xbf\xb8\x67\x9e\x2d\xcd\x13\x0a\x1f\x1f\xbf\x7f\x91\xf5\xda\x2f\xb5\xd8\x64\xa2\x2f\x52\x4a\xd3\xeb\xdb\xee\xef\xd1\x95\xf9\x4b\x46\xd2\x5e\xa7\xe3\x56\x2e\x1f\xc5\x31\x72\x63\x78\xd2\xaf\x37\x40\x36\xec\xd1\x65\xde\x0f\xf4\x1e\xe9\x57\x35\x69\x56\x1e\x19\x7d\x95\x28\xf4\xf8\x1b\x52\xc0\x2d\xdb\x86\x57\x0b\xfd\xc0\x6a\x45\x6f\x5f\x6e\x24\x1a\xd7\xce\x96\xe5\x62\x59\xbe\xca\xa6\x8a\x5d\x73\x5f\x3c\xda\x6a\x11\xce\x7f\x98\x9f\x19\x4d\x2c\x33\xc1\x14\xb7\x86\x31\xd9\x6e\xa0\x18\x0c\xbb\xe5\x32\xf8\x69\x8e\xe3\xe5\x18\x6b\x73\x15\x8d\xc7\x01\xe2\xae\x28\x75\x7e\x12\x8d\xc7\xa7\x3c\x99\xf1\x44\x8a\x14\xfe\x2d\xa8\xfc\xa9\x39\x65\x83\x62\x46\x26\x65\xaf\x8f\x42\x07\xa3\x22\xcb\x51\x5a\x45\xe3\xb1\xd0\x52\x31\x63\x6f\x46\xda\x38\xc1\x25\x16\xe3\x50\x4e\x92\xcc\x74\x46\x55\x37\x60\x19\xda\xfd\x15\x7f\x57\xc2\x97\x36\xdd\xea\x99\x18\x57\xe9\x58\xe1\xae\xe4\x22\xa3\xe1\x7a\xe1\xc7\xe3\xb8\xc1\x96\x7e\xfe\xe8\x1e\x99\xb6\x9b\x3d\x32\xd5\x15\xdf\xaa\xb6\xb1\x33\x2b\x40\x86\x04\x68\xf8\x7e\xb0\xc5\x0e\xdb\xed\x93\x23\x50\xfe\xa1\xfc\x3f\x55\xd2\xb2\x6e\xfa\xdf\xe2\x51\xa3\xf5\xaa\xcd\xfb\xa2\xb1\x96\x1a\xbf\x96\xb3\x29\x0a\x6a\x9e\x5c\xab\x38\xa0\xb0\x2f\x84\x96\x4e\x35\x80\x33\x83\x7a\x7d\x00\xba\xff\x2a\x45\x14\x5e\xd0\x53\xc9\xee\x59\xdb\x67\x95\x03\x30\xac\x26\xbc\x77\xc2\x06\x2e\x35\x8f\x58\x75\x57\xc2\x4d\x7e\xb2\x6e\xe8\x1a\xeb\x49\x1b\x05\xfc\x6d\x7d\x5d\x0e\xfd\xba\xc9\xd7\x8c\x06\x3d\xfa\xbf\xfa\x40\x22\x7a\x0c\x91\xb5\xe1\x10\x7d\x38\x7e\x7e\x1c\xa2\x1c\x33\x83\xac\x00\x15\x19\x37\x9d\x91\x57\x5c\xca\x16\x27\x62\x9a\xae\x01\x2d\x47\xca\x6e\x81\x52\x3c\xc6\x45\x11\xe5\x97\x74\xb1\x40\x04\xec\x82\x92\x5b\x17\xfc\x15\x83\xb7\x68\x74\x91\xe5\x9f\x98\x94\x37\x5f\x26\x25\x59\x24\x5a\x24\x07\x33\x76\x8a\xdf\xbd\xd1\xf0\x21\xf2\xda\x72\x7f\x23\x4c\xb9\x59\x1d\xa6\x19\x83\x68\xde\xb0\x21\x55\x8d\xe1\x98\x6d\x1c\xe6\xc9\x44\x96\x1a\x08\x1c\xf9\x1c\xd8\xac\xd3\xce\x9d\xba\xb0\x67\xbe\xf3\x43\x15\xac\xc5\x4e\x91\x63\xdf\x68\xf6\x93\xfb\x73\xf2\xd5\xd4\x60\x06\xe9\xad\xa7\xf2\x08\xa5\xea\xe7\x04\x6f\x8f\xc9\x01\xf0\x1c\xb9\x59\x8e\x0f\x1b\x2c\x47\x32\x3d\x6e\xd2\x63\x76\xd1\x63\xf9\xc9\x8b\x15\xb8\xb4\x22\xc5\xf8\x7c\x5c\xd5\x1e\xc4\xea\xa7\x9b\xe0\x9a\xf1\x2a\x18\xcf\x90\xab\xe8\x05\xa9\x20\x27\x97\x2b\x0f\x5b\x16\xbc\x83\x81\x23\xcd\x6e\x14\x7f\x1e\x18\xec\x48\x7d\xec\x21\x01\x20\xb8\x10\xfc\xbf\x27\x52\x25\xcb\x61\x3f\x64\xba\xc6\x68\xc4\x4f\x53\x8e\xc4\x9f\xf9\xf3\x6e\x97\x9b\x33\x34\x28\xcf\x44\x15\xfc\xb9\x82\x23\x77\xc2\x1d\xf0\x60\xa4\x3b\x0d\xa7\x8c\xf9\xbb\xfb\x9b\xd1\xfb\x9b\xd1\xbf\xf6\xcd\x28\xbf\x16\xe5\x4f\x7e\xff\x2b\xe2\xeb\xdd\xa9\xc7\x70\x38\x04\x3c\x44\x87\x59\x7a\x8e\x29\x2b\x8a\x78\xc8\x63\x38\x07\xc3\x59\x00\xe2\x16\x8b\x40\x2e\x94\x80\xa3\xa4\xc8\x50\x94\x24\xd9\x45\xc1\xc2\xb3\x83\xa2\xae\x18\xac\xd1\x8a\x84\xe0\xff\x9a\x7c\xc6\xf1\x35\xcb\x5a\x73\xef\x35\xd6\xf8\x8d\x6a\x99\xd9\x41\x8e\xb9\xca\x52\x1e\x38\x7b\xa6\x4a\x14\x5d\x5d\x89\x00\xe9\x2a\xa3\x2b\x75\xa8\xdd\xbe\xad\x0c\x60\x67\x39\x2e\x22\x31\xc5\x2c\xeb\x43\x4f\xe8\x17\x8d\x86\x98\x1e\xe2\x78\x02\xaa\xe6\x3e\xd4\xbe\xe9\xd4\x09\x90\x9c\xef\xeb\x2f\x5a\x8d\x3b\x23\x11\x44\x49\xb6\x03\x47\x2e\x2a\x6a\x52\x4e\x2b\x2e\x7f\x6c\x4b\xd8\x4c\xea\xf7\xf9\x61\x2b\xa0\x93\x70\x8e\x73\x32\x01\xbf\x1e\x39\x1e\x47\x94\xe3\x68\x81\x6a\x1e\x3c\x40\x49\xf4\xeb\x25\x4a\xb2\x28\x46\xf1\x65\x1a\xcd\xc9\x18\x65\x29\x2e\xa0\x35\x3e\x21\xaa\x21\x1e\xcc\x3a\x93\x7a\x02\x80\x12\xf6\xf5\xa2\x71\x07\x8a\xce\xd6\x14\x97\xc7\xf2\x90\xec\xf1\xe0\x4c\x27\x46\x09\xac\x4d\xee\x01\x74\x65\x02\x9f\x12\x71\x4c\xae\xbe\xf5\x30\x34\xfd\x95\x57\x2f\x2c\xbb\xb8\x20\x10\xaf\x44\xeb\x15\x02\x1d\x44\x81\xd9\x09\x3a\x74\x5e\x56\x31\xe1\x7d\x99\x63\xae\x5e\x0c\xe0\x02\x6f\xcc\x56\x07\x3b\x1c\xcf\xf0\x67\x9f\xda\x40\x69\x4d\xad\x04\xcb\x13\x65\x8b\x22\x86\xe6\x93\x87\xd5\xae\x54\xca\x5b\x0a\x7f\x11\x84\xfb\x29\x0f\x4f\x4e\xab\xe2\x8b\xac\x8f\x42\xb1\xde\x38\x98\x2b\x2b\xf9\xae\x09\x3c\xcf\xeb\xa0\x9b\xa1\xd5\xed\xc0\x81\xa3\x4b\x40\x41\xd1\x2f\x17\xa6\xcc\xf4\x7a\xdc\xfc\x48\x44\x65\x16\x40\x91\x1e\x93\xd9\x6e\x0d\xee\xaf\xc2\x95\xae\xb5\x06\xb2\x5c\xdf\xd7\xbb\x9b\xd4\xc8\x4b\x99\xfa\x29\xed\xa0\xc3\x28\xb0\x98\x51\x0a\xf4\x20\xdc\x22\x75\xa1\xaa\x39\x88\x63\xf6\x2c\x42\x2a\xd1\xa2\x34\x46\x05\x2e\x0b\xb4\x5c\x40\x06\x3f\x8d\x00\xcb\x20\x25\xce\xe9\xde\x91\x9d\x73\x61\x8b\xbb\x31\x1d\xac\xad\x69\x4f\x23\x5e\x65\xd3\xe2\xa0\x7c\x5f\x46\x79\xb9\x66\x6b\x1a\x0b\x9c\x4c\x64\xe2\xc4\x7d\xbf\xcc\x59\xb8\x59\x8b\x11\x27\x0c\x27\x13\xc7\x87\x8f\x78\x64\x37\xc5\x25\xd3\x67\xd1\xc2\xd6\x4b\x3b\xd0\x2f\xa8\x61\x16\xd0\x3d\x24\x4e\x9e\x16\xcf\xa0\xad\x0c\x7c\x8c\x03\x32\xa6\xb8\xec\x59\x6f\x7e\xb8\x25\xa3\x73\xca\x19\x0e\x51\x9c\xa5\x5d\xfe\x4a\x94\xf6\x91\xa3\x0d\xcc\x26\xe1\xa2\x5b\x24\x0a\xb3\x23\xf0\x84\x31\x18\x0c\xd0\x2f\x4b\xe6\x08\x98\xb6\x49\x79\xaf\x73\x5e\xae\x78\x18\x59\xf3\x28\xf2\xda\x7e\x01\x6b\xad\x74\x39\x0c\xff\x19\x93\x65\x7a\x0f\xa6\xcc\x90\xb3\xe9\x99\x26\x7b\xbc\x63\x9a\x7d\x1a\xfd\x6b\xf6\xc3\xfa\xf5\x48\x77\x91\x25\x09\x23\x1f\x3f\xd9\x72\xda\x54\x60\x36\x5d\x4a\x95\x08\xa8\x6d\xd3\xd7\xd2\x0c\xd7\x20\x96\xac\x82\x5c\xf8\x8c\x66\xce\x9c\x0a\x23\x0b\x4a\x7a\x62\xac\xbe\x49\xf0\x3d\x9b\xf2\xd1\x44\xd6\xfa\x24\xdf\x96\x3a\x6e\x46\x19\xca\x4c\x19\x86\xa6\x94\xd7\x4f\xad\x04\x55\x49\x28\x0a\xb9\xa4\x73\x2b\xf4\xdc\x8e\x48\x2b\xf5\x01\xd0\x27\xdb\x19\x35\x65\x3c\x6f\xb3\x24\xa1\x7c\x46\xf5\x84\xd1\x60\xc8\x8a\xd0\x73\x1a\x9e\xe3\xb4\x84\x23\xe7\x80\x52\x1c\x0c\x4d\xed\x25\x0b\x6e\x68\x7f\xca\x30\x05\xe4\x78\x14\x9f\x05\xe2\x8a\xca\x48\x0e\x14\x31\xf2\x1c\xdd\x8d\x11\x53\x10\x03\xfd\xd2\xcd\x5a\x44\x2d\x74\x48\xdc\x92\xc9\x02\xe4\x84\xf7\x10\xcb\xcd\x73\x3b\x10\xf0\xd3\xd4\x61\x8e\x61\x4c\x60\xaf\x7d\xe8\x79\xe8\x08\xcc\x8e\x69\xb0\xb5\x0b\x57\x03\x1f\x9a\x86\x6f\x15\x55\x59\xa5\xae\xab\x52\xd9\xe3\x57\xaa\x99\x9d\xd1\x6c\x09\x50\xa5\xc7\xf8\x4a\x6b\x4c\x25\x6c\x2a\x31\xd8\x12\x7d\x35\x68\x07\x0d\x66\x82\x06\x29\x66\xde\x7d\x32\x26\x57\x08\xb7\xac\x91\x19\x7c\xcb\x3d\xac\xca\x57\x6c\xcf\xc9\xd2\xaf\x9d\xe4\xef\xca\x7e\x0f\x52\x7c\xc1\x6f\x9d\x74\x1c\xe8\xbe\xc2\x18\x93\x24\xb1\xe1\x1a\xcf\xcf\x1c\x1b\x96\x7d\x6f\x3c\xf2\x88\xb9\xe3\x51\x23\x1f\x44\x9c\x23\xf3\x73\x61\x0d\xf5\x5a\x0e\x49\x5d\xf6\x52\x53\xd6\xdf\x8d\x7a\xbd\xb3\xb1\xb4\x29\x11\xc4\xae\x1f\x40\xdd\x55\x43\x8e\xe1\x92\x81\x9e\x39\x16\x38\x8d\xc1\xc0\x4d\x4e\x72\x54\x80\xa2\x25\x2d\x28\x85\x4a\x5f\x30\xaa\xa2\x6c\x02\xc0\xb4\x10\x95\x7a\xfa\x4c\xb9\x22\x5b\x5f\xa6\x51\x51\x90\x69\x8a\xe3\x81\xdb\x47\x9b\xa2\x7c\x3c\xd9\x37\x3b\x52\xc6\x1a\x8f\x1a\x26\xc8\xdb\x8c\x6e\x32\xa6\x8d\x44\xd9\x13\xa3\x44\x97\x0e\xa3\x24\xc7\x51\x7c\xa9\xde\xab\x2b\x41\xb1\xb8\x3d\xa5\x99\x82\xac\x90\x5e\x9b\xc6\x45\x26\x3d\xab\x35\xe9\x03\x6e\xd3\xf5\xc8\xa5\x56\x26\xe3\xe2\x3e\xb7\x90\x54\x8a\x2e\x33\x3e\x36\x32\x9f\xe3\x98\x44\x25\x4e\x2e\xed\x66\x39\xb9\x8f\xdb\xd2\xb6\x29\x9d\x40\xf5\xbd\x0a\x4f\x13\x3e\xaf\x55\xba\x26\x9b\xb1\x7c\xba\xfd\xb0\xc1\x68\x77\xb9\x17\x4e\x94\x0e\x7b\x33\x37\x79\x1b\x33\xec\xd3\xfa\x21\xd4\x31\x06\x73\xd4\x47\x63\xcd\x13\xbf\x2e\x75\x07\xa2\xe1\x5a\xbb\x13\xbe\x6e\x3b\x10\x7d\xdf\x6d\x1e\x8f\x23\x39\x64\x0b\x21\x38\x98\x03\x69\xe0\xef\xf0\xb4\x7c\x9e\x9d\x0b\x95\x26\x8a\x8a\xcb\x74\x2c\x0f\x3f\x3e\xc1\xc8\xc7\xb7\x97\x29\xbc\x9d\x36\x10\xa0\xc9\x18\x16\xb6\x1c\xde\xa5\x1b\xc2\xaf\x52\xb3\x21\xf8\x3b\x18\x9d\x5a\x21\xdb\x7d\xce\x13\x1c\x99\xc2\x6b\x72\x22\x4b\xda\x42\xb9\xb5\xa3\x56\xd8\x51\x0e\x87\xe8\x68\xa2\x38\x23\x29\xe4\xbb\xbe\x4b\xcc\xdd\xaf\x20\x52\x22\xe5\xa5\x4b\x95\xbb\x98\x61\x30\xc6\xe0\xa3\xef\x23\xc6\x54\x0b\x44\x4a\x93\xad\x7a\x37\x6a\x87\xd8\xe5\x32\xf3\xed\x1e\x3e\xf4\xf3\x1a\xed\x09\xd5\xf7\x63\x88\x90\xe2\xe1\x6f\x5f\xd1\x3f\x8f\x25\x8f\xe7\xd8\xb6\xde\x4b\xb2\x69\x55\xbb\xc8\x62\x4c\x35\x67\x0b\xa8\x25\xa4\x7b\x42\x85\x3b\x3e\x7f\xc0\x12\x13\xc4\x39\x00\xd8\x03\x6b\x4f\x47\x8e\x9b\x29\x2e\x88\x1f\x3d\x67\x09\x21\xa7\xb1\x5e\x7f\xc0\x76\xe4\x71\x24\x1c\x16\x82\x5b\x15\x1c\x23\xba\xba\x67\x79\x96\x66\xcb\x42\x7a\x2f\xe4\x86\x01\x74\xb7\xb7\x3d\x11\xb1\x6a\xb8\xb0\xdb\xf5\x9a\xd7\x82\x53\x89\x4c\x59\xe9\xb5\x21\x20\xd7\x86\x8e\xd6\xd0\x3c\x87\xb7\x98\xb7\xeb\x06\x7e\xec\x5c\x91\x32\xdc\x3a\xb1\xdf\x6a\x2e\x48\xaf\xcf\x82\x9d\xcd\x36\x57\xa0\xdd\x65\xc1\xf4\xe2\xe3\xb2\xbb\x76\x7f\x21\x7a\x7f\x21\xfa\x27\xbe\x10\x55\x4f\x45\x35\x95\xf5\x4d\xde\x8b\x72\xe0\x15\x6e\x32\x7d\xb1\xdf\x5a\x3f\x31\x4d\x27\x64\xea\x85\x63\x59\x02\xf0\x68\x14\x59\x31\x5d\xc8\x28\x4a\x3d\x71\x5a\x40\x9b\xcc\x02\x4d\x31\x1b\x69\x76\x99\x39\x22\x53\xee\xc1\xc0\xb2\x62\x64\x40\xcf\xc8\xd4\x52\xea\xeb\xd6\x8c\x4c\xe3\x7c\xc5\x20\xae\x24\xec\xb5\xe9\xb4\x4a\xa5\xeb\x96\xb8\xa0\x67\xac\x68\xc3\x90\x8a\x58\xef\xbc\xcf\xd0\xca\x4c\x96\x15\x60\x7b\x52\x09\x44\x8a\xb7\x39\xe6\xd7\xa0\xda\xed\x84\x51\xf7\x48\xa5\x5b\x0d\x8c\xf4\x12\xf4\x20\xc1\xbd\xdf\xa3\xab\x2b\x37\x8f\x9f\x4d\xfd\x99\x38\xca\x13\x42\x8b\x6a\x5d\x4b\x17\xcb\xf2\x39\x9e\x44\xcb\xc4\x7b\x71\xd2\xd4\x47\xba\x23\xdb\xed\xc8\x2b\x5f\x6f\xf4\x16\x4a\x32\x83\x58\x6b\xd1\xe3\x7b\xaa\xfa\x1e\x47\xef\x82\x35\x8a\xdf\xa2\xfb\xf6\x8b\x2e\x26\xa0\xd0\x5a\x2a\xe6\xd8\x68\xd4\x53\xa1\x96\xed\xc1\x83\xa0\xad\x97\xf8\xb3\x67\xe4\x7c\x55\xb1\xc1\x16\x9a\x51\x64\x36\x41\x91\xe1\x1a\x30\x4a\x63\x71\x53\x5a\xc0\x4d\x09\xb3\x0b\xa0\xeb\xee\xe5\x8b\x7f\x5a\xcb\x0d\xea\xa0\x72\xb1\x77\xa1\x09\x5d\xbe\xe1\x62\xd7\x31\x7c\x17\x57\xe4\x42\xdb\xef\xd6\xe9\x0d\xfc\xfd\xc5\xb8\x3c\x86\xeb\x3f\xed\x0a\x16\x3e\xaf\xae\x2c\x1a\x3a\x18\x43\xd8\x05\xcd\xf1\x99\x0e\xef\xf1\xb8\x25\xaa\x85\x3e\x71\x37\x54\xfe\xab\x47\x53\x0e\xd2\xab\x2e\x33\x1e\x2f\x9a\x94\x68\x4e\xa6\x33\x26\x38\x4a\xe7\xc5\x5c\x49\xe5\xb4\x5c\x66\x8d\xed\x96\x99\xd9\xea\x69\x77\x1a\x15\x6f\x73\x32\xc6\xdd\x00\xd1\xdf\xf4\x3f\x98\x3e\xfa\x23\xcd\xd2\x31\xf6\xbd\x4e\xfc\x84\x2f\x6b\xde\x27\x7e\xc2\x97\x6d\x5f\x28\x42\x4d\x0e\x0e\x59\x0d\xfb\x9a\x3d\xc5\x73\x3c\x26\xf3\x28\xe9\xe9\x00\xee\x0b\x31\xf3\x12\xf5\x6b\x13\xb1\xe6\x4a\xf3\xae\x69\xd9\x57\xf5\xdd\x93\xf4\x4d\xa9\xf6\x9e\x5e\x7f\x4b\x7a\xe5\x42\x8c\x43\xb0\x70\x5f\x2a\x62\xff\x70\x6a\xf5\x8a\x36\xad\xe9\xf4\xb3\x29\xce\xf0\xf4\x35\x43\x86\x69\xa4\xcc\xf2\x73\xff\x8b\xd4\xe4\x7d\x1e\xe8\xdb\xdf\xba\x38\x4d\x2b\x0d\x96\x09\x20\x3d\x5a\x64\x02\x7f\x26\x80\x7c\xb3\x41\xd3\x35\x5c\xc0\xeb\x30\x7f\xf5\x0e\x94\xb7\x0d\x1b\x4a\x28\xeb\x3e\x0f\x80\xa4\xfc\x85\x20\x4b\x41\x4e\xa3\xc2\x0f\x37\x8d\x0a\x03\x0a\xc8\x57\x03\x55\xa2\x9d\x96\xaf\x4a\x08\xe3\x35\x2f\xb8\xfe\x54\x56\x9c\xa4\x3f\xaf\x4c\x4a\x22\xca\xcc\x4d\x48\x8a\x07\xbc\xa9\xa5\x2c\x19\x7f\x69\x15\xf2\xb2\x2b\xb6\xdc\xae\xe8\x81\x73\x54\xa0\x9c\x06\x7a\xf3\x41\xb9\x73\xe6\x81\x52\x94\x27\x32\x5b\x90\x5f\x25\x68\x75\x93\x15\x84\x28\xe3\xb9\x2c\xe7\xcb\x24\x2a\xc9\x39\xfe\x29\x2a\x4e\x0a\x78\x97\x56\x55\x95\x03\x6b\xd5\x35\x6d\xac\x61\x2a\xcb\x89\xc1\x9b\xa6\x06\x02\x2e\xc9\xa6\xb6\xe5\x9e\xca\xd0\xa2\xd1\x38\xaa\x25\xd0\x0f\x79\x15\x4b\x9e\xd7\xad\x14\xb6\x49\x8b\xc4\x5b\x6a\xb5\x00\x60\x76\xdb\x93\x3c\x48\xdd\xb5\x54\x0e\x15\xb6\xa0\x71\xb3\x26\xdd\x3e\x05\x6a\x90\x06\x2a\xc3\x21\x92\xae\x7d\xc0\xc7\x1d\x3f\xbd\x22\xc4\x9a\xa2\xf3\xf3\x8a\xcc\x49\xe9\x99\x42\x13\x80\xe3\x4a\x26\x56\xcc\xbb\x91\x6f\x94\x29\xc8\xaf\x3e\x26\xa8\x32\x0d\xe8\x92\xcc\x71\x51\x46\xf3\x45\x65\x11\x09\xa1\xd6\x15\xcb\x48\xab\x56\xae\x91\x5d\x55\xad\x3c\x1a\x6b\x9d\x89\xc9\x64\x42\xc6\xcb\x04\x9e\x67\xb8\x3c\xd4\x06\x32\x07\x92\x95\x51\xf2\xbc\x4d\x05\x16\xa4\x2e\x24\x99\x6b\x86\x83\xab\x65\x6e\xae\x1c\x37\xdb\x15\x41\x48\x89\xe7\x7d\xfb\x61\x96\x63\x1d\x07\x50\xee\x3d\xa4\xb1\xbe\x7c\x9b\x39\x2b\xd8\xb4\xd0\x46\xec\x68\xdd\x62\x99\x25\xd9\xd4\xbb\x9e\xf4\xb5\xed\x5b\x4d\x49\x36\x55\x5a\x14\x77\x49\x41\xbd\xc6\xb2\xd2\x2b\xd4\x17\x95\xa6\xbd\x26\x13\xfa\x65\xf0\x75\x39\x4e\x2b\xc3\x43\x63\x76\xd1\x3d\xbd\x4e\x87\x71\x1b\x15\xb7\xd8\x01\xbc\x95\x18\x4d\x24\xd9\xd4\x53\xb5\x48\xad\xa8\x52\x16\x32\x85\x4e\xd0\xc8\x37\x1f\x90\x2e\x66\xa4\xa0\x7c\x71\x91\x15\xe5\x0d\x4e\x48\x6f\xb3\xa2\x5e\x30\x70\x23\xe9\xd4\xf2\x4f\xb7\x52\x7d\xa2\x69\x27\x75\xe6\x49\xbf\x07\x8b\xe8\x12\xcc\xe3\xf7\x0d\x6d\x87\x9e\xc5\x91\x0c\x49\x65\x99\x78\xc5\x78\x91\xa9\xc3\x5e\x64\xf9\xa7\x0f\xd9\xdb\x3c\x3b\xc7\xd5\x65\x34\x20\xbd\xec\x22\x27\x59\x4e\x34\x8e\xe2\x14\x14\x10\x9a\x83\xf8\x89\x1e\x3f\xc8\x30\x88\x65\xac\x85\x75\x92\x79\xcb\xd0\x79\x8a\x96\x8e\xf6\x8d\xaf\xa7\xe8\x54\xfb\x3c\x43\xa1\xbc\x8d\xbe\x56\xad\x32\x55\x2a\xd3\xaa\x26\x49\x76\x01\xaf\x03\xc4\x31\xb6\xae\xfa\x7a\x6b\x76\x16\x01\x8f\x12\x13\xca\xd2\xe4\x92\xb9\xf5\x2f\x0d\x23\x7b\x61\xe8\xce\x0c\xda\x7d\xef\x33\x84\xb5\x3b\x0a\xed\xb7\x17\xba\x9d\xbb\x7d\xe2\xa2\x7d\x6c\xc5\xbb\xa4\x5a\x1f\xe8\x9f\x1b\x1b\x7a\xb9\x59\x13\xa5\xfb\xc9\xda\x54\x0f\xd7\x13\x36\xa7\x6b\xc0\x2f\xfe\xbc\x20\xf9\xa5\x67\xc5\x6b\xb9\x3a\xb9\x15\xcc\x0b\x88\x17\x9a\xe6\x55\x2d\x01\x0b\xd4\xb3\x00\x80\xb2\x7d\xb2\x84\x05\xd1\xdf\xf3\xad\xca\x77\xd1\x85\x20\x19\x9e\xe2\x05\xd3\xaa\x3f\x28\xc6\x84\xd8\xcb\x57\x94\xd1\x77\xdb\xbf\x17\x1c\x71\x12\x4e\xc5\xd1\x50\xab\x42\x35\x00\x6e\x71\x21\x96\x95\x8f\x39\x0c\x87\xab\xac\x08\x58\x9b\xfa\x6a\xac\x5c\x8c\x6a\xb9\xdd\x62\x25\x59\xda\x5c\x86\xa2\x76\xf4\x2f\x99\xaa\xad\x80\xf1\x05\xbd\xd0\xad\x35\x08\xbb\x8d\x49\xf1\x05\x5c\xcc\xf4\xcc\x90\xc8\xa0\xb1\x1e\x45\xe9\x80\x14\xff\x88\x12\x12\xf7\x20\x64\x01\x4f\x79\x4e\x72\x3c\x2e\x7b\x3e\x75\x35\xf7\x0c\x05\x80\xbc\xc6\x5e\xdf\xd1\x85\xeb\x82\x8e\x8a\x24\x23\x7a\xe0\xa9\xd6\x70\x3e\xe6\xa9\xa8\x45\x15\xbc\x67\x66\x4d\xfc\xda\xd5\x32\x05\xe0\xee\xa1\x05\x6c\x57\x84\x76\x56\x0b\xfd\xfd\x65\x3a\x26\xa9\x5f\x5a\xe1\xfe\x98\xb5\x0b\x84\x75\x33\x09\xb9\xee\x69\x0c\xf1\x0c\x9c\xd9\x80\x2d\x19\x49\xa7\x70\xd4\xf0\x9e\xf0\x5c\x30\xd3\x25\x10\xf7\xce\xd3\x50\x81\x0e\x65\x96\x9f\x91\xe9\x0c\x17\x4d\xe5\x75\x28\x8d\x76\x78\xee\xa7\x34\xbb\x48\xdf\x97\x51\x69\x47\xea\xb7\x73\xab\x1b\xd0\xab\xd8\xb3\x6b\x58\x2c\x93\x04\xc7\x4d\x55\xe8\x50\x15\x87\x4e\xe5\x25\xa8\xc2\x11\x7c\xd3\x3d\x58\xd8\x08\x11\xa8\x7a\x6a\x2a\x68\x28\x69\x5c\x91\x84\x9e\x34\x0d\xd6\x77\x36\x08\xab\xb3\xb4\x92\x36\x2f\x09\xfd\xc9\x5a\x09\x63\xe7\x0b\x3d\x69\x0c\xb6\xea\x16\x35\xac\xcc\xd1\xcb\xf9\x07\x54\x9d\x57\x51\xd6\x56\x4b\x79\xaa\xb0\x41\x8c\xde\x1b\x87\xff\xd0\x9b\xaa\xc3\xeb\xa7\x9a\xd0\x93\xa6\xc3\x5a\x68\xf4\x24\xea\xd0\x36\x17\x0a\x2b\xd2\x19\xf7\x32\x4c\x6f\xd8\x7d\x42\x27\xdc\x7a\x52\xe5\x50\x86\xb2\xf0\x4e\xb8\xb3\x73\x7d\x16\xec\x6c\xdd\x3b\x23\xb8\xb7\xbd\xf9\xaf\xb1\xbd\xe1\x94\x7e\x17\x51\x45\x56\x73\xc1\xde\xd2\xe0\x86\x05\x3d\x31\x2d\x69\x58\xda\x57\xf0\xe5\xde\xde\xfb\x7a\x94\x24\x43\x2b\x3e\x21\x3c\xab\xb4\xa3\x9b\xb8\x3e\xd9\x85\x6d\xb0\x1b\xc8\xa9\xc6\x17\xbb\x2f\x92\xd3\x47\xb6\xb9\x71\x5f\xe1\x7a\x08\xd3\xd5\xfd\x78\xab\x4a\xf9\xee\xa0\xd7\xca\x92\x6e\x57\x2d\x84\x3f\x8b\xe0\x58\x07\x75\x8a\x6f\x1d\x46\x84\x38\xe5\x20\xfc\x53\x87\xb8\x13\x3f\xf2\x74\x7f\xb0\x27\xc3\xf0\x38\x08\xd7\xc0\xea\x25\x8e\x7e\xb2\xc8\xa7\x85\x2e\xeb\xde\x20\x70\xaf\x90\xf1\x55\x94\x33\x78\xce\x0f\xbc\x9e\xbd\x1d\xc9\xa7\x05\x73\x16\xbf\xce\x85\xb7\x76\x1d\xd6\x85\xb1\xda\x4e\xeb\xdd\xfb\xc1\x21\x25\x91\xa3\xc7\x6c\xe3\x8f\xd4\xdc\xc1\xf9\xc7\x66\x3f\x41\xaf\x11\x13\x7b\x0a\x0f\x2d\x11\x51\x17\x99\x4d\x8f\x25\xeb\x0b\x3f\x44\x0a\x34\xce\xf2\xdc\xf5\x0c\x08\x27\xa0\xa8\xc4\x07\xf9\xb4\xf0\x05\x6b\x53\xd1\xa2\x1f\xa2\xbf\xc1\x09\xaa\x40\x5f\xe0\xfc\x74\x4d\xdb\x23\x25\x37\xcd\x37\x9c\x07\x7a\xa6\x4a\x6f\xa7\x72\x8e\xd4\x21\x97\x41\x01\x8a\x1c\x13\x81\x48\x21\x9e\x9e\xf1\x99\x3d\x37\x28\x84\x0c\xaf\x1c\xb0\x79\x82\x2f\x38\x15\x84\x89\x6e\xb5\x11\xbc\xe0\xca\xa3\x4b\xf1\x1e\x89\xcf\xdd\x7a\xcf\x89\xe1\x17\xf5\xa5\x67\x69\x7a\x2e\x76\x54\xf4\xd6\x8d\x01\x07\x67\x5e\x62\x6b\xe1\xd5\xa5\x84\x51\xc6\x2a\x60\x3d\x86\x84\xb3\xba\xc0\x8e\x20\xc4\xf5\xfd\xfd\x2a\x42\x36\x1f\xa0\xd0\xb3\x2f\x0f\xa3\x55\x13\xec\xaa\xe7\xbc\x0b\xaf\xf3\xa4\x2a\x94\x32\x60\x92\xa2\x93\x8a\x11\x0b\x20\x7b\xcb\xc0\x3c\xe4\xe5\x6c\x1a\xca\x83\x72\x85\x57\xd5\x28\x91\xad\x2a\x0b\x9d\x5a\xca\x93\xed\xd7\x92\x9d\x11\x3d\x72\x75\x86\xb1\x2a\xbf\x30\x83\x3e\x56\x44\x95\xbc\x56\xdc\x5c\x5f\x3e\x3d\x4f\x88\xc7\x32\xf3\x3b\x70\x37\x42\x40\xee\xa3\x0a\xe7\xec\x3e\x1f\xdf\xfc\xf9\x81\x36\x5c\x23\xb6\x64\x8d\x49\x4c\x45\xf0\x11\x81\xfa\x9b\x05\x59\xf0\x16\xaf\x9d\xf7\x1b\x85\x5a\xe0\x5e\xa2\x37\x03\xf4\x44\x68\x83\x6a\x9a\x58\xa6\x8b\x68\xfc\xe9\x98\xe9\xa1\x0d\xa3\x2f\x48\x32\x74\x43\x66\x92\xea\x82\xe9\x2d\x47\x54\xc5\x7e\x48\xd2\xdb\x47\xdb\xe8\xa9\x48\x14\x8e\xac\x91\x38\x07\xaa\x97\xdd\xd2\xfd\x74\x95\x1f\x6b\x5d\xc8\x09\x78\x71\x73\x46\xb9\x2e\x45\xf7\xc2\x2b\x43\x98\x9d\x6e\x9e\xa1\xd0\xe7\x6b\xf9\x10\x22\xf8\x46\x5a\xd0\x64\x81\x2c\x3b\x2c\x73\x94\x24\xfa\xe2\x1e\x0c\x06\x62\x7d\x1f\xda\x65\xad\xcd\xc7\xf1\x72\x72\xc4\xb6\x3b\x88\xce\x2a\x40\xe9\x6e\x14\xc9\x1a\x02\x33\xfa\xba\x48\x66\x2e\xbd\xe0\x09\x99\x38\x74\x45\xc6\x23\x9f\x28\x8d\x4d\x57\x18\x02\x8c\x85\x2f\x66\x27\x23\x5a\x07\x8b\xfd\x46\xc1\x39\xda\xbc\xb4\xcb\x67\x15\xc2\xaf\x36\x51\x2d\xf4\xaa\x2a\xc4\xed\x2a\xf1\x6b\xfd\xfb\xa6\x90\xc1\x2c\x23\x3a\xb9\xc7\xc0\x41\x46\xc9\x7f\xdc\xf3\xad\x21\x16\xea\xec\x07\xbc\xf7\x9a\xd2\x97\x5e\x44\x17\x7f\xec\x62\x4a\xa1\xcf\x3c\xee\x0a\x2e\x2d\xe0\xd4\xb5\xfc\xba\xef\x45\xa5\xd2\xd1\x4a\xc6\x87\xcb\x19\x43\x02\xaf\x3a\x30\xba\xe6\xbe\x9f\x82\x52\xfa\x12\xee\x19\xeb\x41\xf3\x19\xed\xbc\x78\x6c\xd3\x60\xe0\xfa\x29\x71\x79\x80\xe6\xa5\x44\x18\xc6\x1b\x6f\xd9\x03\x66\x5c\xb1\x67\xfa\x86\x65\x9d\xc6\xb1\xe3\x07\xbb\xcc\x2f\xad\xe7\x5f\x1a\x28\xbc\xf8\xaa\x1e\x2f\x32\x9e\xa8\x8d\xe1\x8d\x70\xcf\xf1\x34\xc2\x28\x7e\x1f\x61\xaf\x8f\x13\xbb\xf3\xa2\x75\x4d\x92\xa9\xdd\x28\xda\x9c\x2b\xed\x6d\xc3\x2c\xd2\xb8\x2b\x58\x2d\xfc\xa9\x96\x5a\xe3\x9a\x11\x24\xc5\x01\xb9\x45\xe2\x0f\x68\x13\x0e\x35\xc6\x59\xd3\x95\x0e\xf5\xc0\x8f\x51\xca\x9e\xdb\xa6\x31\x77\xc9\x07\x91\x3b\xd3\x47\xe2\xa0\xea\x84\x30\x6d\x58\xae\x46\xd8\x2e\xba\x6e\xac\x79\xe8\x99\xb7\x78\xbc\xba\x46\xf0\xf6\xfe\xd2\x71\x51\x92\x79\x54\xe2\x9f\x22\x50\x20\x36\x51\x95\x06\xde\x44\x51\x7a\xcd\x77\x41\x4d\x5f\x9f\x3a\xda\xcd\x90\x36\xae\xa6\xd9\xf1\x80\x56\xcd\xcc\x3b\xd1\x0c\x16\x11\xa0\x58\xcc\x78\xae\x0b\xe4\xf2\x81\x3f\x3a\xbc\xbb\xb3\xda\xd5\x34\xcd\x55\x53\x0c\xf9\x9b\xcd\x53\x2b\xc4\x8b\x1b\x5d\xbe\x32\x6b\x02\xae\x7b\xa5\xe6\x5b\xc4\x91\xd3\x8b\x0a\x3c\x6b\x64\x5f\x8b\xb0\xdf\x36\xa8\x9c\xac\xff\x46\x71\xe5\x64\xa1\x55\x07\xf9\x35\x83\xcc\x29\x1d\x0d\x1d\x60\xbe\x18\x0b\xef\x45\x05\x3b\x35\x37\x31\x22\x0e\x5d\x6d\x95\x52\x33\x2e\x5e\xf6\x8f\xcd\x95\x90\x11\x6c\x43\x80\xe9\x62\x8a\x11\x33\x42\x9f\x13\xd7\xdd\x97\xa5\x71\x7d\x0a\x3e\x44\x3f\xd2\x1e\x77\x51\xc8\x3e\xac\x9d\xa4\x1b\x38\xc2\x4b\xa8\xdc\x6c\xc9\x3c\xe9\x20\x8c\x0f\xe7\x4c\x65\xb1\x8e\x0b\xf7\x93\x8c\x41\x36\x12\x83\x88\x8e\x51\xb5\xfd\xc8\xb8\x33\xf5\x5b\x8f\x27\x44\x8c\x3e\xc1\xa5\x21\xe8\xac\x9b\xd8\x51\xd6\x38\xb0\xcd\x97\xba\x0c\x25\x5c\x2c\xa8\xb4\xaa\xad\xc2\x42\xe7\x20\x5a\x2c\x92\x4b\xee\xf0\xa5\x15\x61\xf5\x6d\x33\x36\xb6\x05\x58\xcd\xd0\xc4\x1b\xd5\xdd\x30\x0f\x3c\x8c\x8a\x62\x3c\x2a\x92\xca\xad\x43\xa8\x78\x26\xec\x6b\x45\x51\x11\xe9\x6a\xc5\xeb\x1e\x6f\x2a\xc1\xf9\x61\x53\x61\xb8\x0a\xd0\x95\x9a\xbd\x93\x5f\x55\xdc\x14\x91\xd8\x48\x54\x52\x65\x31\xb5\x5b\x0b\x4f\x2d\xf4\xf3\x4f\x19\x42\x46\x94\x05\x02\x27\xf9\x78\x99\x44\xf9\xfa\xfa\xfa\x7a\x7d\xe0\x18\x41\x41\x7b\x77\x12\x3a\x86\x69\x7f\x3b\xe1\xf6\x63\xbf\x1f\x8e\xed\xfb\xdb\xff\xfb\xdb\xff\xbf\xf6\xed\x3f\xbf\xfa\xa7\xb0\x22\xb4\x8f\x3f\x20\xc1\xef\x16\x6a\xc0\x67\x59\x50\x6f\x08\xb0\x36\x1c\x42\xe8\xa2\x28\xa7\xa4\x4c\x77\xb0\x65\x61\x0e\x91\x12\x5c\x4c\x26\x13\x9c\xe3\xb4\x44\x38\x3d\x2f\xa0\xd0\x28\xcf\x2e\x0a\x9c\xaf\x69\x7e\x19\x2f\x48\x1a\x67\x17\xa0\xb1\xd0\x1c\xf6\xa3\x07\x0f\x78\xce\xe0\x9f\xaf\x5f\xbd\x2c\xcb\x05\x77\xf9\xc9\xb8\xa6\x99\x86\xf6\xfd\xb0\xc0\xfa\xb8\xbf\x79\x32\x4d\x33\xca\x08\x12\x92\x62\xda\x93\x34\x8b\xf1\x9a\xe6\xe4\xc9\xa9\x51\x0e\xfc\xf3\x3c\xa1\x23\xe3\x1b\x5b\xb7\xdf\xb6\x91\x6b\x86\xc9\x7f\xbe\x7c\xb7\x6d\x54\x37\xcb\xb7\xbb\xfd\xca\x52\x42\x72\xa0\x2d\xbc\x15\xc8\x74\x4d\x22\x40\x7e\xa2\xa2\x3d\x78\x39\x64\x3e\x91\x69\x2f\xa5\x01\x84\x51\x5e\xdf\xf2\x67\x59\x51\x06\xa8\x24\x73\x9c\x2d\xcb\x80\x56\x98\x07\xa0\x64\xbe\xc8\x72\xee\x76\x02\x36\x13\x0a\x87\xf6\x11\xfc\x77\x75\x85\xba\x9c\xd8\x93\x6c\x1c\x25\x34\x31\x7c\xf2\xcd\xee\x37\x10\x1f\x94\xed\x3d\xac\x42\xba\x13\xf2\x5f\x57\x57\x68\x53\x66\xd3\x66\xd0\x3e\xb4\x26\xd3\x44\xa3\x68\x5f\xb6\xdf\x28\x3c\x2d\x72\xbc\x80\x80\x5b\xf8\xc2\x9a\x32\x4b\x76\xe2\x80\xef\xb4\xb3\x8c\x16\x5e\x1e\x47\xe9\x35\xdc\xb1\xb2\xf8\xf2\x6c\xce\x15\x96\xb9\x77\x3d\xed\xc0\x67\xb6\x65\xb8\x70\xd1\x31\xad\xc9\x5d\x66\x07\xcc\x8b\x40\x5a\x3d\x43\x35\xbb\x41\x61\x84\x44\x79\x18\xdc\x00\xaa\x6c\x84\x94\x78\x05\x43\x7e\xf9\x6e\x5b\x85\xe7\x64\x92\x96\x86\x79\x6d\x22\x28\x8c\xe1\xc3\xcc\xaa\xc8\x18\x0f\xab\x12\xe4\x61\x51\x6b\xb6\xc0\x69\xaf\xfb\xf6\xf8\xfd\x07\x11\x51\x90\x11\x0e\xeb\xdc\xde\x9a\xe6\x10\x0d\xe6\xf6\xc1\x03\x73\x52\x8d\x43\xdf\x12\x0c\x6a\xba\xcf\xa2\x82\x8c\x51\x17\x6d\x40\x17\x9e\x2d\x29\x7b\xd0\xaa\xd8\x40\xdd\x50\x5e\x15\xca\x7a\x06\x65\xc6\x1f\xad\x75\x47\x51\x81\x1f\xef\x76\xad\xf1\x2b\x77\xc0\x2f\x71\x14\xe3\xbc\xd7\x3d\x00\xbe\x4a\x7e\x8d\xd8\x69\x0b\xda\x67\x23\xac\x29\x44\xe5\x63\x9c\x96\x8f\xe8\x41\xbb\x1b\xa0\x2e\x95\xfc\xc9\x18\xaa\x18\xfe\x52\x08\xb5\xa3\xbc\xb1\xe2\x53\xd6\x40\xae\x2c\x70\xc4\x65\x3a\xd6\x0e\xd5\xb6\x26\xd9\x77\xf1\xbc\xd0\xae\xaf\xfd\x21\x82\xeb\x48\xaf\xb0\x43\xc6\x09\x5d\x9a\x4d\x72\x82\x66\x4c\x5a\xe5\x31\x67\x81\x1e\x95\x76\x5f\x61\x2e\x8d\x7b\xf0\x20\x82\x05\x03\x21\x93\x4b\x59\x3f\x47\xb2\x50\xe4\xeb\xde\x7e\x7d\xd6\x00\x87\x59\x9a\x62\xfe\x6c\x41\x50\x98\xa2\x44\xe3\x72\x51\xb4\x2e\xfc\xee\x7f\xc0\x9f\x4b\xa7\x83\x1c\x56\x7b\xad\xc1\xad\xe3\xcd\x6e\xd5\x75\xe9\x1d\xaf\xbf\xe7\x6b\x50\x5f\x25\xed\x43\xc0\x02\x0d\x44\x0d\x44\x70\x20\x39\x4e\x0d\x25\xf0\xac\x1f\x9d\xa0\x0b\xa8\xcc\xc9\x74\x8a\x73\x16\x29\x86\xce\x3e\x88\x2d\xd2\xed\x23\xc5\x41\x13\xc1\x40\x0f\x7c\x54\x63\x06\x7c\x6d\x43\x3f\x60\xbc\xb2\x67\x70\x93\x14\x5c\xf4\x16\x65\x54\xe2\xf1\x2c\x4a\xa7\x7e\x05\x02\x33\xef\x17\x88\x8f\xe2\x4b\x30\xac\x87\x1b\xe1\x5d\xca\x61\x6c\x96\xb7\x6e\x06\x6c\x6d\x41\x31\x0a\x50\xdc\x2a\x69\x91\x80\xec\xcb\xac\x06\x8a\x82\x33\x99\xf7\xd6\x4a\xde\x58\xad\x48\x5b\x48\xbf\xda\xb2\x2f\xb6\x8c\x96\xe9\x59\xf0\xda\x42\xb1\xda\x08\x5c\xcc\x9a\x95\x15\x03\xb5\xf4\x3e\xb0\x52\x3d\x7d\xf3\xe0\x0b\xf9\x6e\x39\x80\xdd\x85\x3a\x26\xc0\x57\x9a\xbe\xae\xd4\x65\x79\x72\x49\xe9\x9d\xbd\x2d\x85\xc5\x45\xa9\xba\x62\x6d\x91\x82\xd7\x8f\x4d\x66\x2a\x85\x00\xa1\xe0\xb4\x85\x01\x7a\x7e\x48\xbb\x25\x9a\x44\x24\xc1\xf1\x00\x1d\xd3\x73\xda\x05\xa1\x67\x8f\x08\x82\x3b\x55\xaf\x26\xad\x4d\xcf\x5c\x28\x7c\x4a\x7d\x86\x0c\x22\x40\xe2\x10\x7d\x27\xff\x84\xbe\x8f\xee\x3e\xf9\x62\x1c\xa2\xee\xf6\x60\x53\x2a\x0f\x85\xfe\xb1\x9b\xe2\xf2\x63\x42\x8a\x12\xa7\x24\x9d\xca\x6c\xa9\x3d\x3c\x33\x0c\xba\x84\x82\x2b\x67\x91\xaa\x5c\xf2\x15\x56\x85\x74\x90\x6a\x12\x1c\x75\x81\x3e\x74\xa1\x2a\x30\x4e\xfb\x54\xcc\xed\x84\x4f\xe8\x2f\x43\x7e\xee\x84\x5b\xdf\xd2\x93\xff\xce\xfd\xc9\xff\xfe\xe4\xff\x17\x3f\xf9\x2b\xc3\x7f\x78\xb4\x78\x47\x46\xff\xd2\x90\x53\x3f\x55\x8e\xc8\x94\xd9\xe0\x0e\x7e\x61\x27\x74\x76\x0f\x12\xbf\xc2\x13\x73\x43\x90\x21\xfb\x2e\xb5\x87\x73\xc6\xc6\xc9\x20\x18\xbb\xb8\x98\xd1\xde\xf7\x4c\x03\xad\xef\x59\x61\xf4\x10\x6d\xbb\x2f\xef\xc0\xe2\x8f\x4a\xf1\xe6\xfb\x43\x54\x1b\x37\xff\x2d\x3f\xd5\x45\x29\x3a\x7a\x76\xf0\x86\x4f\x72\x8c\xbe\xfb\x16\x8d\xb3\xf9\x62\xc9\xc3\x65\x8c\x2e\xd1\x3c\x3b\x27\xe9\x54\x0b\x0a\xb5\x8b\xc6\xb3\x28\x87\xbd\x80\xdd\xcc\xc6\xcc\x94\x4a\x98\xab\x0b\xe8\x04\xb3\x47\x0b\x65\x46\x1b\x64\xb8\x2a\x50\xef\x00\xed\xa3\xad\xcd\x00\x3d\xa3\xff\x6f\x05\x68\x30\x18\x04\xe8\xff\xd0\x3e\xda\xf9\xa6\x4f\x0f\x3b\xa8\x58\xe0\x31\x99\x10\xb6\x90\x8e\xde\x1f\x6f\xed\x3c\xde\x7a\x6c\x9b\x98\x91\x22\x83\x74\x3e\x0e\xd7\x39\xe8\x35\x7b\x13\x4b\x3b\x42\x07\x68\x5e\xad\xe9\x37\xcb\x5c\x92\x8a\x05\x18\x77\x6d\x60\xd6\x6f\xc6\xe7\x1f\x45\xa9\x3e\x8f\x74\x44\xdd\x83\xee\x80\xa2\xe5\x30\x8b\xf1\x41\xd9\xdb\xd4\xb4\xd6\x74\x6c\xdd\xff\x73\xb2\x19\x03\x64\xef\x76\x81\x58\xcb\xec\x64\xb1\xc0\xf9\x61\x54\x28\x55\xb6\x96\x5d\x2c\x47\x45\x99\xf7\x76\xfb\xe2\x45\x2e\x4f\xd8\x0c\x76\xad\x1b\x33\x96\xbb\x48\x48\xd9\xeb\x76\xfb\xe6\x63\xe5\xb4\x6f\x5a\x57\x8d\xb3\x98\x0e\x2e\xf5\x75\x5e\xc8\x87\x00\xf3\xc3\x3e\x3a\xa0\x02\x21\x7c\x7c\xbf\x8f\xfe\xaf\xef\xb8\x72\xf7\xcc\x2c\x9f\x58\x03\x52\xfa\x06\x8d\x31\x7a\x84\x0e\xd0\x06\xda\xda\xd4\xec\x8c\x7c\xee\xcd\x45\x08\x49\xdb\x86\xe9\xba\x3f\xf8\x25\x23\x29\x1d\xa6\x6d\xa9\x38\x5e\x82\xe3\x54\x98\xe2\xd7\xc7\xcf\x29\x61\x6f\x6d\x0a\xa6\xc4\x2d\xfc\x80\xf2\x3d\x14\xf7\xed\xe6\xe3\x5d\x9b\xe0\xe6\x59\xfc\xdd\xb7\x5b\x9b\x55\x84\x66\xd2\x97\x72\x47\xcb\xa8\x89\x17\xae\xa5\xa2\x1c\xcf\x23\x92\x32\xdd\x11\xcd\x53\x77\x8f\xdc\xb9\x8b\xc9\x1e\x38\xb0\xb2\x5b\xde\xee\x5b\x6e\x6b\x80\x59\x09\x30\x69\xf1\xfa\x9d\x61\x22\xa7\x9a\x04\x59\xfb\x28\x2d\x99\x47\x9c\x00\x6d\x6d\xf6\xd1\xff\x9f\x62\x6d\xc3\xa9\x85\x39\xc5\xe1\xe6\xe7\xbe\x17\xb8\xb2\x2e\x59\x52\xd5\x67\xcc\x53\xf3\x3b\x24\x66\x82\x0e\xeb\x80\x1b\xfc\xc3\x85\x3a\x24\xf0\xb7\x0e\x9c\x7d\x8a\xf9\xf2\x4f\xce\x50\x77\x5a\xec\x9f\x04\x6e\x09\xad\x96\x9c\xdb\x55\x27\x58\x68\x53\x3f\x31\xc4\x92\x59\xce\xc5\xeb\x1c\x8b\xa8\x28\xcc\x81\xcc\x71\xfa\x1e\x69\x59\x42\x8c\xa6\x43\xb8\x96\x6c\x4d\xd7\x8a\xd1\x9c\x81\x56\x63\x93\xcf\x80\xa8\x78\x26\x7c\x06\x68\x2f\x15\x78\x50\x47\xb4\xf5\x58\x63\x61\xa3\xa8\xc0\x3b\x8f\xd1\x3e\x94\x51\xea\xa1\x9d\xc7\x86\x09\x40\x1c\x63\xa6\x59\x84\x3d\xb0\xc7\x0a\x05\x68\xeb\x1b\x53\x12\x96\xfd\x7c\x36\x8a\xd2\x1e\x2b\x66\x32\x3f\x6b\x31\x73\xb7\x24\xda\xc2\x7d\x46\x87\x5e\x66\xc6\xee\x45\xa7\x0f\x81\x27\xcd\xfc\x52\xac\x68\xa6\x4c\x02\x7b\xdd\xb7\xcc\xa5\x7f\x9a\x95\x5c\x28\xfb\x9e\xfc\xd0\x99\x82\x44\xc2\xdc\xdd\x4c\x14\x52\x8b\x59\xc4\xa4\x35\xd8\xdf\x3e\x8f\x93\x65\x41\xce\x65\x04\x42\x32\x22\x09\x29\xa5\x80\x33\x8a\xd2\x4f\xc3\x51\x1e\xa5\xe3\x19\x2a\x70\x7e\x4e\xc6\x62\x03\x8c\x98\x63\xcf\xce\xf7\x43\xf2\xc3\xc0\xa6\x21\x19\x0d\xa0\x10\xbb\xd0\x04\xe7\x74\x1b\x8a\x92\x69\x96\x93\x72\x36\x47\x31\x2e\xc6\x39\x19\x31\xb6\xc4\xe5\x1f\x9c\x0e\x2e\xc8\x27\xb2\xc0\x31\x89\x40\x08\xa2\x5f\xc3\xa3\xb4\xc4\x79\x1a\xb1\xa7\x13\x1f\x9f\x45\xe9\xa7\x8f\xdc\xab\xe8\x47\x36\xaf\xff\xbf\x9f\xf8\x48\xd3\xe9\x47\x3a\xc4\x8f\xf0\x96\xe8\x63\x4c\xa6\xc4\x79\xca\x21\xa6\xc6\x47\x91\x23\xb1\xa7\x8a\x19\x10\x3e\x63\xca\xcc\xb3\xcd\xb6\xa0\xd5\x67\xf6\x8a\x1c\x59\x6c\x91\xcf\xe8\x21\xdb\xa7\xba\xff\x7c\xd1\xdd\x5b\xf3\xf2\x4c\xce\x63\x7b\xd6\xce\xdd\xd3\x2b\xd8\x40\xdd\x4d\x10\x95\xa0\x15\xdd\xdc\x85\xa2\xe3\x39\xc5\x06\xda\x47\x3d\x26\x4e\xf5\xbe\x7b\x82\x1e\xa9\x26\xfa\xe2\xd9\xc0\xa3\x6d\x6b\xbf\x95\x5e\x37\xcc\xa6\xb4\x3a\x79\x83\x0d\x6a\x2b\xce\x44\x34\x5c\x01\x61\xb3\x38\xd0\x24\x2d\x4a\x52\x2e\x4b\xe1\x1b\x97\xc4\x38\x2d\xe9\xa6\x65\xfb\x4f\x67\xb5\x1c\xa5\x31\xc9\xb1\x69\xc0\x60\xbe\xb1\x29\x02\x21\xcb\xca\x47\x36\xf0\x6a\xaa\xa3\xb5\xd4\x81\xa6\x3a\xaa\xad\xce\x2a\xbc\xc8\xec\x89\xd7\x5f\xae\x79\x04\x36\x39\x43\xf7\xc5\x87\x97\x74\x1e\xc4\xeb\x16\x1d\x03\x5a\xaa\xec\x5b\xdf\xe2\xd7\x59\x1d\xbf\x16\x4f\xe9\x18\x72\x79\x90\x65\x52\xb0\x97\x72\x3a\x1f\x77\xe4\x4e\xf0\xa0\x52\x29\x6f\xca\xbd\xc8\xa3\xf8\x10\x0a\x0f\xf6\x9c\x8e\xb5\x24\x85\xce\x23\xcd\x3f\x4b\xad\x9c\x40\x74\x3f\x2f\x84\x91\x95\x2e\xfc\x29\x27\x2f\x8d\xba\x72\x89\x05\xe8\x7a\xe5\xeb\x41\x7d\xcc\xca\xb9\xbe\x77\x54\x01\xca\x3c\x5a\x1b\x18\x9b\xae\xad\x71\x47\x69\x51\xc2\xf0\x3f\xff\x7c\x71\xba\xf9\xe8\xbb\xb3\x2f\xdb\xd7\xbd\x17\x1f\x5e\xd2\xdf\x07\x8f\xfe\xef\xec\xcb\xd6\xce\xf5\x95\xfc\xd8\xd9\x0c\x76\xb6\xae\xfb\xff\x33\x1c\x94\xa0\x04\x95\x1b\xb8\xf1\x2e\xaf\x8a\x31\x68\xe0\xec\x79\xde\xd6\x8a\x08\xe3\x4f\x30\xe1\xf4\xef\x45\xdb\x73\xb5\x04\xef\x06\x6f\xcf\xdd\x95\x64\x21\x4e\x0d\x4a\x7f\xdc\xb3\xb3\x0b\xe1\xda\xfd\x79\xdf\xdc\x70\xd8\x13\x44\xd2\x8a\x81\x1b\xdc\xe7\x6e\x86\xee\x65\x23\xad\x06\xbf\xbd\xd9\xca\x6a\x93\x89\x94\x74\xa4\xc5\x72\x4e\x01\x4f\x0a\x7e\x7c\x98\x67\xf1\xa3\xef\xbe\x7d\xb4\xb5\x29\xb3\xe1\x8c\x0b\xbd\x1b\x67\x09\xea\x1d\xbd\x3f\x1e\x1e\xbd\x38\x44\xf4\xdc\x10\x6e\x6f\x6e\xee\xf4\x6d\x9e\xac\x55\xeb\x9e\x42\xb5\x5c\x67\xe0\x22\xaf\xe5\xb0\xf9\x99\x70\x3b\x40\xdb\xed\x6c\x55\x75\xa6\x6a\x6c\x29\x08\x4f\x07\xe8\x9f\xef\x5e\xfc\xe4\x38\x12\x94\x05\xfc\xa3\xa9\xac\xd1\x9d\x54\x0d\xb2\x69\x78\x8a\x00\x7a\xe0\x65\xce\x19\xf2\xb7\x01\xda\xed\xa3\x10\x75\xbb\xad\xc6\x3d\x4e\x08\x3c\x24\x93\x1d\x04\xe5\x13\x49\xed\xf1\x51\x2c\xfc\x74\xf0\x8f\xe3\x1f\xff\x75\xfc\xee\x7f\xed\x59\x85\x3a\x2a\xe6\xd4\xae\xdf\x3b\xb9\x0c\xe8\xd6\x63\xdf\xda\x5a\x7d\xe4\x7c\x35\xf9\xcf\x25\xee\xc1\xc3\x1d\x9a\x53\x81\x33\xbc\xc8\x73\x0e\xd1\xef\x9d\xf8\xe0\x7c\x0e\xc8\x8c\x43\x87\x3b\xe0\x5d\xed\x10\x5b\x79\x94\x11\xe7\x0f\x79\x4a\x31\x4e\xa8\xec\x8c\x62\x9e\x67\xb6\x1e\xf7\x03\xb4\xbd\x29\x5d\x9c\x19\x52\x9e\x40\xaf\x35\x48\x51\xb8\xdd\x02\xad\xf0\x4a\x75\x04\x59\x4c\xa9\xaf\xeb\x15\x3b\xa1\xf9\x79\x7d\x16\xec\xec\xde\xab\xf1\xef\xd5\xf8\x7f\x71\x35\x3e\x57\xe1\x2f\xc6\xf5\xf6\x7b\xb7\xb0\xb8\xeb\xa8\x48\x73\x9d\xbd\x95\x22\x64\x35\xd8\xe9\x31\x3d\xd3\x62\xec\xb5\x04\x5b\x44\xe5\x2c\x40\x29\x36\xac\xbf\x3f\x82\xe6\xc2\x79\x78\x2a\xae\xaa\xf5\x18\xbd\xc2\x6b\x01\xb7\xd7\x01\x1b\x1f\xfa\x1f\x4b\x55\x59\x63\x79\xc3\x0b\x5c\xb1\x14\x09\xbd\x2f\x14\x3a\x54\xe5\xa5\x2b\x4a\xab\xd8\x20\x4b\x7b\x5d\x18\x55\x57\x8f\x69\xd9\x37\xec\xa7\x8b\x8c\x32\x31\xf6\x96\xf0\xe8\xed\x21\x52\xf7\xca\xec\x85\x61\x37\x40\x7a\x28\xf8\x8f\x8c\x0d\xf2\x0b\xef\x9e\xed\x0c\xd3\xdb\x83\x34\xd6\xdb\xd7\x9a\xaf\xac\x0c\xad\xc9\x37\x06\xaf\x8e\xde\x7f\x78\xf1\x06\x56\xd0\xe1\xf1\x9b\x37\x2f\x0e\x3f\x1c\x1d\xbf\x41\xef\x5e\xbc\x7f\x7b\xfc\xe6\xfd\x8b\xf7\x95\xad\xc6\x51\x19\xe9\xcd\xd2\x6f\x7d\x73\x1a\x3e\xe4\x66\x84\xf3\xe8\xf3\x38\x9b\x2f\x12\xfc\x99\x94\x97\x21\x7a\x0c\x94\x65\xf5\x10\x74\xa1\xd2\x0e\x81\x56\xa5\xf6\x9b\xbe\x27\xa0\x09\xb7\x39\xf8\x62\xc6\x23\x86\x83\x5f\x6c\xdb\x4e\xf0\xee\xb0\x38\xcd\xc0\x5f\x62\x74\x31\x23\xe3\x19\x9a\x47\xe5\x78\xc6\xc5\x57\xb6\x09\x51\x86\x16\x1b\xe5\x3c\xf1\x03\xa0\x69\x7f\xc0\x62\xb8\x8e\x72\x7a\x0b\x16\x08\xfe\x70\xa2\x64\xd2\xfb\xe8\x27\xe4\x53\x78\x1b\x47\xe2\x33\xd7\x1d\xba\x2c\x4c\xc7\xca\xc1\xf6\x1c\x28\x3b\xf4\x73\x65\x48\x54\xa8\x86\xf7\xdd\xae\xe8\xda\xc1\xe2\x84\xe4\xd8\xf0\x08\x60\xa3\xab\x6a\x3c\x74\x28\x9e\xd6\x6b\xc0\x55\x7c\x56\xdd\xb4\x45\xfd\xc5\x38\xc1\x25\xae\xab\xc1\x1e\x8c\x8d\x1b\xfd\x15\xf6\xcf\x74\xd7\x02\x42\xe4\x04\xc1\xea\x03\xe5\x0e\xb3\x95\x4a\x99\xb3\x1c\x94\x31\x57\xcb\xa4\x1c\xac\xad\x09\x61\xd0\x24\xe1\x35\x5b\xed\x01\x2f\x32\xa9\xf0\xa7\x78\x9e\x26\x1e\x99\x85\x75\xcf\xaa\xfa\xaa\xb2\xd9\x60\x60\xc9\x6b\xff\x60\x9e\xcd\x95\x83\x5e\xb1\xc4\x9f\xbf\x78\x74\xf8\xf2\xe4\xcd\xff\xbe\x78\x27\xeb\x89\xf1\x78\xb6\x4c\x3f\xe1\x98\xbf\x2a\x61\x2f\x46\xf9\xdf\x20\xc7\x8b\x24\x1a\xe3\xde\xf0\xdf\xd7\xa7\xff\x4e\xff\x9d\x9f\x3d\xfd\xf7\x97\xe1\x34\xe8\x5e\x5f\x3d\x7a\x74\xf5\xa5\xdb\x07\xd7\xc2\x5f\xbc\xf0\xff\x3e\x13\x25\x4e\x79\x99\x33\x5a\xe8\x54\x94\x3a\x3b\xf5\x97\xb3\x4b\x19\x85\x2a\xca\xa8\xb6\xb4\x96\x64\x43\x5a\x19\x7e\xcd\x47\xb3\xbb\x82\x93\x1a\x18\x70\xd7\x2c\x20\x5e\xe3\x2f\xc3\x21\xdc\x81\x62\xee\x0e\x03\x3c\x6d\x40\x05\x6b\x0e\xe9\xd3\xbc\x43\x9a\x65\xae\x5c\xee\x77\xc6\x82\x41\x1b\x88\xbd\x7f\x35\x44\x75\x79\x67\x6d\x71\x32\xd7\xa9\x81\xcf\x16\x0c\xfa\xae\x95\xe2\xd6\x34\xcc\x98\x66\xcd\x5d\x7c\xaa\x33\xfb\x76\x67\x90\x11\xe6\x9d\x1b\x72\x81\xeb\xbb\x74\x8c\x13\x70\xa8\x2f\x1e\x71\x1a\x65\xc6\x09\x8e\x72\x61\xc2\x65\xb5\xc2\x93\xad\x05\xed\x07\x02\x5f\x0d\xa5\xa8\xc8\xb7\xc7\x99\xe5\xed\xbd\x4e\xff\xab\xb5\xab\xe4\x38\xd3\xe1\xaf\x03\xb4\xb5\xb9\xb9\x89\x1e\xb2\xcb\x19\xcf\x5d\xab\xd7\xf1\x03\xbc\xdb\x03\xec\x08\x7c\x51\x0e\x52\x60\x4e\x2f\x2c\x28\x0a\x7f\xd7\xb7\x3a\xaa\xdc\x19\xb3\x48\x04\xa2\xfd\x70\xcb\x4a\xa7\xc3\x8c\x45\xb0\xb8\xde\xa6\xdd\x9e\xa5\xad\xd7\xc1\xb9\xf3\x1f\xca\x23\x7f\xe2\x5b\x68\x14\xc7\x85\x1e\xf0\x99\x5b\x39\xb8\xd2\x18\x53\x0f\x07\x6b\x6c\xc3\x15\x07\x03\x7e\xd6\x26\xcc\x5d\x3d\xe7\x7a\x73\x11\xbd\xde\xe0\xbe\x47\x31\x2b\x15\xe5\x39\x39\xc7\x3a\xc3\x8d\x62\x39\x7b\xa2\xbd\x1a\x0e\xeb\x81\x36\xbc\xd5\xfb\x6d\x4a\x35\x99\x42\xbc\x56\x27\x31\xba\xba\x12\x5f\xa7\x9b\x67\x72\xcb\x84\x2b\x6c\xd6\x37\x05\xcd\x13\xcc\x12\x2c\x51\x97\xe8\xbc\x9b\x17\xda\x97\xbd\xa9\x93\x78\x29\xe8\x40\x36\x2c\xea\x16\xbb\x9a\x58\x47\xfa\x4a\x65\x61\xe1\xb9\x59\x0a\x13\xcb\xe1\xf4\x05\x1a\x77\xba\xbf\xc7\x1a\x9a\x39\x11\xd7\xa0\xd6\xb5\xef\x94\x47\xfb\x49\x96\xf7\x28\x5e\x3e\xe1\x4b\x76\x52\xf4\x0d\xc0\x74\x02\xd3\xf3\x03\x0d\x66\x51\x71\x7c\x91\xbe\x85\x90\x47\xe5\x25\x84\xb4\xb3\xb8\x40\x05\x7a\x3e\xe1\xcb\xb3\x6a\xdb\xce\x6e\x96\xa2\xa3\xb7\x87\x5d\x3b\x16\x3b\x97\x2d\x6a\xea\x74\xcc\x2c\xd4\x32\x39\xd4\x7d\x10\x72\x77\xe3\x48\x3b\x6e\x90\x02\x15\x25\x61\xc1\x48\x48\xac\x11\xb5\x6e\x16\x5a\x89\x70\xbf\x1d\x67\xaf\xfa\xb4\x24\xe4\x00\xba\x7b\xe4\x98\xf7\x23\x60\x54\x60\xf6\x6a\x9a\xa5\x98\x6b\x9e\x7a\xeb\x1f\x6d\xb1\xff\x22\x27\x25\xf8\x4b\xb1\xb8\x91\x06\x62\x1d\xa1\x3e\xba\x67\x28\xe1\x62\x70\xbd\xaa\x76\xae\x40\xf2\x0e\xbd\xee\x05\xc1\x9a\x4e\x3f\x56\xbd\xf8\xbd\xf6\x74\x05\x19\x9b\xec\x9e\xc1\xb9\x57\x40\x91\x40\x53\x33\x96\x90\xe7\x08\xd5\x78\xd6\x14\xbd\x8c\xb5\x67\xbf\xbe\x51\xd5\x58\x3c\xdf\x4c\x6c\x90\x54\xad\x4b\x0d\xe6\x50\x1a\xf7\x51\x64\xfd\xf9\xf6\x49\xcb\xec\x8e\x6b\x13\xad\x33\x8a\xe3\x8e\xe7\x5f\xd9\x12\xac\xac\xd5\x6b\xb3\x4e\x77\xc3\x66\xb7\x1b\xdd\x0e\x2a\x74\xc3\xec\x81\x6e\xa7\xad\xf8\x20\xbc\xd8\xca\x4a\x54\x2c\x17\x8b\x2c\x2f\x41\xb7\xc6\x6e\x6a\xdf\x1e\x22\xa9\x55\xe9\x1a\x8e\x20\xab\x09\xb3\xf5\x4b\x85\x9b\x2c\xc6\x66\x2a\x5b\x89\xc2\xbc\xc7\x7a\xa0\xa9\x5a\x0b\x7a\xcd\xa1\xae\xbd\x9b\x56\x7a\xbb\x71\xf5\xb8\x0a\x83\x8e\x93\xf6\x8a\x57\xda\xd7\x67\xc1\xce\x37\xf7\x2a\xdd\x7b\x95\xee\x7f\x85\x4a\x97\x3f\xac\xb8\xd5\x73\xec\x83\x28\xcf\x52\xf4\xbf\xcb\x79\x74\x4e\x0a\xf4\x7d\x44\x3f\xff\xf6\x89\x7d\x0e\xe6\xd8\xab\xee\x1d\x0e\xd1\x51\x4a\x4a\x12\x25\xe4\x57\x8c\xfe\xce\x7a\x41\x09\x35\x42\x05\x58\x62\x09\x83\x1b\x18\x28\x5d\xaa\x86\x93\xf3\x01\x68\x75\x45\x31\x11\x45\x84\x07\xa0\x3a\x8a\x43\xb4\xd9\x74\xf3\xc6\xac\x3d\xe8\xf0\x6d\xb7\xba\x5e\x33\x13\xaf\x3b\x5d\xf5\x0a\x4d\xc4\xba\x9a\x08\x84\x42\x4b\xca\xa0\xc7\xe3\x84\x97\xbd\x4e\x09\x34\x55\xcf\x44\x54\x23\xb2\x84\xef\x5d\xaf\x1b\x22\x6d\x04\xb4\x3d\xa7\xf7\xc3\x35\x8e\x9e\x0a\x57\xbc\xac\xad\x80\x37\x66\x38\x4d\x65\x59\xfd\x2a\xd5\xb2\x68\xd2\x31\xe6\x91\x66\xbb\xeb\x5d\x2d\xea\x54\x14\x9f\xd3\x33\xaa\x98\x1d\x74\xf4\x1c\x72\x44\xef\xe4\xa4\x6d\x6c\x54\xf9\x19\xf2\xbf\xfe\x61\x6f\x85\x9c\x6a\x54\xb6\x78\x1e\xc4\x47\x2a\xd3\xc5\xbb\x20\xf6\x3f\x3d\x30\x89\x17\x42\xed\x0d\x2f\x04\x0e\xe4\xe1\x51\x18\x10\xf9\x4d\x75\xa4\xb2\xae\x29\xf2\x99\xe7\x65\xb6\xd5\x80\xdf\x3c\x43\xa0\xc1\x6a\xcf\x8a\x09\xcc\x12\xad\xcb\x50\xe6\xd3\x47\xd3\x39\x73\xa0\xa7\xb2\xed\x01\x3e\xc7\xf9\x65\x4f\x78\x43\x7e\x4f\xd2\x69\x82\x5f\x33\x84\xf7\x51\x88\xbc\x19\xaa\x26\x3e\xad\xb2\x23\x7e\x70\x3e\x81\xf2\xa0\x25\x85\x77\x41\x37\x9a\x05\x91\x48\x63\x14\x69\xd8\x16\xf1\x0c\x31\x3f\xfb\xfb\xfb\x8c\x6a\x74\x20\xee\x76\x41\xc0\xd2\x33\x37\x05\x63\xd7\xba\x5d\x5f\x75\x5c\x86\xb5\x7c\x4a\x0e\x87\x2c\x34\x9f\x4c\xe2\x5e\xd9\x75\xe6\x22\xd6\x63\x2b\x7f\xf2\x8c\x11\x8d\xe0\x3d\x5a\x03\x3b\x7a\x46\x81\xaa\x5d\x7c\xf3\x8e\x5b\xfc\x85\xd5\x55\x30\xa6\xca\xab\x12\x02\x4e\xdc\x07\xe5\x11\x5f\x14\x3d\xc1\x7b\xfa\x68\x42\x70\x12\x5b\xa6\x07\xbc\x15\xa3\xa7\x16\xcf\xd1\x3b\x68\x31\x1e\xd6\x35\x8b\x0c\x45\xb2\x15\xf5\x41\x90\x85\xeb\x08\xcb\x61\x6f\x02\x76\x20\x58\x9b\xf8\xe6\x2c\xce\xd4\xc3\x3b\xb2\x22\xaf\x8f\xcb\x89\x54\x0c\x7c\x7c\x2f\x06\xde\x8b\x81\x7f\x6d\x31\x50\xbd\xcf\x63\x8b\xe6\xae\x5e\xe8\xdd\xcd\xdd\x3d\x05\x79\x2d\xd4\x8d\x95\xc6\xca\x70\x4e\xe4\x91\x5a\x84\x15\x32\xfd\xd4\x4e\x91\xdc\xe5\x9a\xc8\xa5\x9f\xc6\xc5\x3d\xf0\x3c\x95\xaf\x24\x83\x4d\x0d\x0c\x7c\xf2\xeb\x41\x09\x95\x21\xb4\x9e\xa1\x95\x60\x9e\x9e\x7d\x45\xac\x1c\x43\xe9\x0a\x1a\x83\xd7\x51\x1a\x4d\xb1\x7a\x9d\x4f\x59\x16\x43\x85\xa1\x0a\x10\x2e\x3c\x14\xb8\xb6\xdf\xcf\x0d\x0c\x39\x15\xe7\xf3\x06\xfb\xf7\x18\x53\x0e\x43\x52\xd3\xbf\xa7\x25\xfe\x8d\xa2\x82\xf9\x5c\xa8\x8a\x44\x31\xc5\xe0\xa5\xd2\xb3\x49\x99\x9e\xe6\x6d\xc7\xa2\xa2\x4d\xb3\x3d\x20\x31\x07\x11\xbc\x8d\xca\x58\x13\x86\x3b\x51\x2d\x7c\x8e\x24\x0e\x69\xc7\x27\x7c\x19\x16\x54\xb0\x91\x29\x4d\x6e\x8e\x99\x33\x4e\x75\x49\xc1\x43\x73\xf0\x6d\x57\x8e\x73\x80\x5e\x53\x56\x4e\x70\xc1\x83\x48\x03\x3e\x1c\x2f\x94\x86\x67\xcf\xd6\x78\x13\x83\xba\x7a\xb3\x4c\x12\xe5\x18\x23\xa0\x52\x24\xfe\x4c\xe0\xda\xcc\x87\xbb\x3f\x66\xfc\xa1\x3b\x0b\xbb\x83\xd6\xbe\x56\xdc\x1d\x07\x93\xad\xa2\xed\xd8\x01\x4e\x64\x28\x19\xf3\x20\x86\x1a\xc2\xc7\xbc\x7b\x7b\xc8\x23\x4c\xd4\xc7\x8e\x51\x68\xe3\xae\x5e\x19\xe1\x01\xd2\xe5\x89\xd3\x46\x13\x03\x3d\xa2\x90\x2e\x96\x0c\xa2\x93\x49\x1e\x74\x68\xb5\xd4\x62\x63\xdd\xc3\x5d\x2b\x28\xc8\xf7\xb8\xd1\x53\xda\x92\x21\xa5\xd3\xc5\x00\x41\x90\xec\xba\x10\x52\xe8\xa9\xfa\xcd\xa8\x1b\x8a\x9c\x51\x76\xa0\x7d\x36\x78\xd6\x77\xb0\xce\xf8\xbd\x8c\x5e\xab\x63\xde\x45\x3c\x73\xc0\x5b\x7f\x56\x34\xdd\x11\x57\xe0\xde\x13\x23\xc5\x0c\x96\xab\xa3\xd0\xde\xac\xc0\xd9\x0c\x1c\x7b\x9e\x7a\x01\x64\x55\xde\xd8\x24\x1c\x17\xbe\x90\x45\xe2\xfd\x94\xa0\xc3\x15\x22\x17\x45\x62\xdd\xb6\x42\x42\xbb\x18\x44\xba\x3b\x56\xbe\x8f\xd8\x5e\x92\x57\x76\xbe\xcc\xe5\x09\x00\xd6\x96\x81\x0e\x08\x79\x46\x80\x21\x79\x4c\xf1\x6b\x41\x84\x3a\x03\x34\x4b\x15\xca\x8c\x3a\xb7\xca\xba\x8a\xc3\x41\x95\x70\x91\xcb\xf0\x69\x4a\x5b\xa3\x5f\x74\x74\xd1\x0c\x31\xb4\xd1\x92\x24\x31\x20\x8c\x0f\x8a\x66\x3a\xfe\x6d\x81\xe1\x7f\x38\x7e\x7e\xbc\xbe\xbe\x0e\xe2\x7d\xb7\x40\xcb\x69\x72\x39\xe0\x51\xc4\xe8\x81\x60\x59\xd0\x3d\xb1\x94\xad\xa4\x9a\x4b\x59\xfa\x5b\xd8\xd5\xc8\x1b\x12\xca\x38\x20\x43\xbe\xb7\xde\x32\x22\x3d\x8d\x7e\x39\xa5\xd9\xa7\x9b\x67\x67\x54\xec\xd2\x3f\xaf\xae\xa4\xdd\xa6\x0d\xca\x7e\x6c\x41\x19\x3a\x96\x3d\xff\x55\x91\x55\x3b\x40\x22\x8d\x0b\x3b\xe8\x95\x88\xaa\xba\x45\x95\x97\xea\xca\xe8\x94\x85\x40\x49\xfd\xcf\xb2\x90\xe3\xe7\x9b\xcb\xef\xea\x34\xbc\x8a\x1f\x68\x64\x45\xb0\xf0\x85\x2a\x30\xce\xea\xd0\x96\x29\x51\xaa\x8b\x29\x75\x3f\x63\xc4\x62\x91\xe6\x75\x1e\xd3\x3c\xbb\x61\x06\xcf\xdb\xd1\x99\x99\x34\x8a\xb4\x0c\x68\xbc\xe1\x54\xcc\xee\x1a\xd5\x54\x0f\xc1\xbe\x86\xe2\xa4\x42\xb3\xda\x7a\x7a\x16\x61\xae\x70\xda\xec\xce\x51\x70\xc8\x65\x8e\xe1\x86\xf4\xdd\xdb\x43\xe9\x81\x89\x99\xb2\x8c\xa3\x54\x0a\x9b\x24\xe5\x4a\x17\xbf\xaf\xa7\xdc\xf5\xf5\x38\x18\x0c\xae\xf5\xf8\x6e\xb6\x2f\x3d\xa5\xc9\x14\x45\x3d\x9c\xb4\xc9\xa7\x7d\xa5\xbb\xf9\x55\x88\x50\xd0\x80\xe9\x93\x5e\x9f\xb5\x2a\x44\xf3\x92\x15\x8a\x9d\xd7\xc2\x06\xa6\xed\xf5\xdf\xb7\xf7\x7a\x9f\x7b\xbd\xcf\x5f\x5b\xef\xc3\x95\x3e\xf1\xe8\x16\x37\x7f\x3e\xbd\x8f\xd4\xd6\xe8\x8a\x1f\xc6\x9c\xa4\x46\xe7\xf9\x33\x83\x8f\xd0\x61\x98\x2e\x3f\x1c\x4d\x05\x8c\xd4\x4a\xde\xab\x09\x14\xb6\xa6\xe4\xa5\xa2\xe7\xb1\xe9\xe7\x17\x5c\xe8\x0b\xb2\xa4\x2b\x4b\x0e\xea\xd1\x9a\xb5\x9d\x85\x03\x39\x6a\x97\x9e\xaf\x83\x96\xbe\x59\xed\xf2\xd5\x01\x8b\x16\xcb\x52\x3e\x5e\x4b\xf1\x05\xc7\x66\x4f\x6d\x97\x54\xe8\x08\x51\x57\xc2\x59\x71\x34\x42\xd4\x8d\x47\x1f\x7d\xb9\x42\x4c\xdc\x91\x7d\x92\x8d\x4e\x71\xbb\x46\x25\x9c\xb7\x51\x5f\xae\x68\x74\xdb\x6d\x74\xb1\x2c\x5f\xe2\xcf\xcd\xc3\x7c\x89\x3f\x57\x8d\xd1\xcc\xaa\x1f\x60\x73\x5b\x0c\xa8\x6a\x68\xfe\xb6\xac\x71\xf1\xcd\xe8\x54\xc1\x89\x89\x08\x14\x92\x03\x3e\xf4\x80\x77\x0b\x80\xcf\x2a\x76\xae\xe7\xcf\xd4\xae\xc5\x68\xa7\x13\xee\xc0\x16\xf5\xe4\x7e\x8b\xba\xdf\xa2\xfe\xda\x5b\x94\xba\x9a\xc0\xe5\xec\x46\xf7\x12\x1c\xf8\x6e\x5f\x25\x56\x44\xff\xf7\x85\xff\xf7\x5d\x82\xf8\xef\x41\x1a\xb6\x4d\x0a\x22\xcc\x91\x2d\xa0\x05\x4f\x16\x60\xe3\xaa\xf6\xc6\x59\x3a\x21\x53\x01\xa6\x85\xc2\xd1\xa1\x45\x64\x15\x01\x76\xc1\x9f\xad\x19\x17\x34\x3c\x51\xc0\xfc\xc8\x42\x81\x5b\xc8\x80\x44\x01\x72\x54\xbc\xbf\x4c\xc7\x6c\x8b\xd1\xa1\x0a\x96\x2a\xc0\x28\x2b\xce\xb1\x0d\xc4\x53\x65\x5d\xcc\x41\x91\x0e\x41\x46\x51\x2a\xb2\x99\xd7\x43\xa7\x3f\x22\x59\x0a\x21\xe0\x33\xad\xcd\x9d\x81\xd4\x79\xf3\x37\x82\xa0\x04\xdc\x3c\xeb\xa3\x07\x0f\x10\xff\x3d\x00\x9d\xe0\xf1\xa4\xd7\xdd\xfc\xdc\x65\xae\x4b\x36\xfb\xe8\x29\xea\xe0\x72\x46\x77\x0f\x08\x4c\xfa\xec\xf2\x65\x54\xcc\x3a\x28\xb4\x93\x99\x46\xb7\xa3\xa4\x04\x2d\xfe\xd3\x8f\x79\x36\x7f\xf6\x1b\xf4\xb4\xcb\xbb\xa4\x85\x15\x7a\x76\x09\x0d\xd3\x4e\x1f\xa4\xf1\x11\x2d\x27\xa3\x79\x79\x21\xd9\x38\x24\xac\x1a\xcf\x32\x1d\x27\xf8\x37\x1a\xc0\x09\x6d\xab\xa1\xeb\x3a\x4c\x65\xa7\xc5\xfc\x68\xe3\x3c\xcc\x96\x69\xab\x6b\xa6\x3b\x18\x87\xb7\x6d\x46\x42\xfa\x50\x2a\xc0\xd8\xa8\x9c\x29\xf8\x0d\xfb\x7f\x22\x1b\xd4\x26\xc3\x99\x04\x1d\xc0\xe8\xb3\xec\xde\x8b\x72\x76\xd7\x07\x84\xd6\x87\x83\x3b\x3a\x1b\x40\x00\xe0\xea\xb3\x01\x53\x7d\x30\x2e\x4e\xb0\xb7\x47\x0b\xbd\x33\x8b\x86\x7e\x2c\x6e\xd0\x05\xed\x8e\x9b\x71\x57\xe6\xff\x02\xe9\x1e\xba\x8f\x9e\x1d\xbc\xb1\x82\x91\x71\x9e\xca\xb4\x32\xec\x01\x2d\xd7\xcd\x5c\xaf\xad\xb1\xde\x0d\x98\x65\x94\x7c\x4b\xf3\xa2\x9c\x29\x6d\x50\x80\xba\x7a\xe8\xe6\x6e\xc0\x87\x39\xc5\x65\x58\xa1\xf3\x14\xbe\x4a\x07\x7a\x41\x3e\x92\x80\xeb\xe9\x8c\xc2\xe7\x51\x62\x44\x19\x1b\x58\x81\xb3\xcf\xa3\xc4\x71\x46\x22\xd3\xae\xd7\x00\x3d\x2b\x0d\x85\xfb\xf9\xbb\xc9\x60\x78\xd1\x9b\x0c\x87\x17\x6d\x39\xa0\x36\x67\x51\xca\x5d\xa2\x04\x2c\x37\x1b\x4f\x4e\x1c\xd0\x3d\x3d\x09\x36\xe5\xe4\xcb\x23\x94\x66\xcd\x69\x5c\xe1\x85\xe8\x54\x09\x54\xec\x7a\x8f\xbb\xd1\xfc\x51\xdd\xe6\xd9\x10\x7a\xe4\x73\xc6\x4f\x24\xb0\x16\x3a\xd2\xba\xc7\x0a\xf5\x6a\x58\x9e\xf4\x59\x23\x81\x2a\x8e\xcd\x59\x1e\x4d\xf1\x41\xd9\xe6\xe4\xcc\x41\x2b\x71\xe4\x83\x90\x87\xda\x1a\x2c\xb1\x75\xc7\x38\x76\x99\xc1\xc9\x72\x15\xb4\x78\x07\xc6\x9d\x3b\x36\x8c\x89\x42\x55\x0e\xc7\xca\xfc\xed\xe7\xdb\x3b\x30\xb1\xea\x9b\xe8\x99\xb1\x23\x6b\x68\x52\x64\xbc\xdd\xb0\x7c\xbd\x0d\x9c\x25\xae\xec\x5f\xe9\xe2\x45\xd7\xab\xd1\x2f\x6d\xa2\x9e\x76\x61\xff\x6d\xc6\x04\x80\x39\x98\x90\x12\xdd\xd7\xc0\x84\x46\xca\xb7\x18\x74\xb0\x56\x41\xd9\xf3\x05\x49\xd8\xe1\xad\x91\xbc\x39\x68\x0d\x8d\xbb\x10\x02\x0f\x9b\xd5\xf4\x67\xcb\x6b\x2d\xe9\xd1\x2e\xe6\x74\xab\x4e\x64\x75\x3b\xb8\x75\xcb\x89\xaa\x9b\x1b\x31\x85\xcf\xf1\x98\xcc\xa3\xa4\x1a\x15\x4a\x0a\x6c\x89\x04\x55\xa0\x82\x28\xff\xb8\x03\x36\x85\xa7\x86\xc1\x56\xc7\x4a\xae\x38\x82\x81\x7c\x5d\x3b\xe8\xe6\x15\xa4\x55\x58\xcf\x3c\x3e\x78\x4e\xa8\x2b\x8d\x49\x96\x72\x06\x57\x75\xf8\xfd\x23\x71\x9a\x9b\xe0\xe9\x1d\x1e\x63\xb2\x68\x41\xe6\x6e\x99\x36\x04\xe0\x82\xde\x96\x02\x78\x8d\xad\x07\xd8\x72\x15\x37\x72\x31\xcf\xe0\x6c\xc0\x36\x14\xc0\xc4\xa2\x3b\x12\x10\x1b\x97\x37\x3d\x20\xbd\x8b\x2e\xda\x2f\x71\xb7\x80\x1f\x11\xb5\x70\x6d\x38\x1b\xc5\x83\x47\x16\x72\x43\x4b\x37\xf5\xb6\x55\x57\x6f\xde\x4f\x7b\xa6\x7c\x6b\xcc\x37\x0e\x32\x4d\x57\x18\x87\x09\x5d\x31\x8e\x4a\xa0\xaf\x3c\x8e\x16\x9d\xaf\xee\xf1\x9d\xcb\xda\x15\x84\xc3\x8d\xbb\xea\x3a\x0a\x81\xff\xbd\x1d\xb5\x72\x6e\xd2\x51\xba\x17\xdc\xd9\x89\xc0\x8c\x90\x5e\x37\x26\x0d\xd2\x3f\x34\x3f\xc0\x4d\x28\xc6\x18\xe1\xad\xb8\xd2\x98\xc9\xa7\x22\xae\x79\xd3\xb4\x31\xe8\x81\x08\x76\x5e\x31\x85\x66\x9d\xbe\xb1\x56\x76\xe4\xd5\xab\x57\x2d\xfb\x90\x54\x52\x90\xac\x69\xa5\x96\xdf\xe3\x7c\x81\x1b\xb7\x27\x89\x01\x06\x5d\x8f\x00\x07\xa6\xa6\x17\xc5\x72\x34\x27\xe5\xcf\x59\xde\x24\x25\x29\xc0\x8a\x95\xee\xcb\xaf\xbf\xfa\x6e\xd1\x2a\x87\xaa\xdc\x8a\x2b\xda\xb3\x8e\x38\xce\xf5\xb7\x52\xfc\x04\x7a\x9a\x54\x74\x18\xa9\x87\x59\x6c\x82\xc1\x12\x36\x52\x40\xf6\xb7\x0a\xf1\x03\x98\x5b\xd2\x16\x1f\x5c\x08\x75\x94\x30\xf2\xb4\x82\x55\xe9\x52\x30\xad\x02\xe0\xb2\x53\x55\xb6\xd5\xa8\x69\xd0\xab\x31\x12\x95\xe8\x8a\x01\x5a\x9e\xb9\x5f\x99\x85\x2a\x4b\x68\xcd\x9b\x2b\xda\xc9\x78\xf5\xea\x95\x0b\xcc\xa8\x5f\xab\x52\x12\xa6\x31\x68\x9a\x00\xdf\xdc\xc2\x81\xc5\x64\x93\xca\xee\x3a\x1f\xcd\x8a\x8e\x28\xe9\x4a\xad\xab\x69\x3a\x2a\x17\x2e\x49\x47\x51\xa1\x2b\x2a\x78\x07\x80\x51\xf2\x75\xcb\x61\x24\xc8\x75\x3f\x58\xa1\x8d\x39\x49\x4d\xeb\x16\xa7\x05\x0e\x71\xc3\xfa\x67\x51\x31\xcb\xa3\xb2\x76\x0c\x15\x30\xad\x76\x86\xd5\x7b\x24\x6e\x67\x6b\x3a\xe4\x07\x69\x3e\x67\xf0\xeb\x60\xf3\x70\xb1\x7a\x0f\xa7\x51\xf1\x36\x27\xe3\x5a\x9c\x55\xc0\xdc\x58\x09\xbc\x7a\x2f\x79\xd8\xa1\xa2\xae\x97\x12\xe6\x86\x6d\x8c\xb4\x2b\xb4\x9a\x66\xaa\xc1\xbe\x12\x0d\x89\x98\x0c\xff\x60\xb6\x36\x75\x7d\xb3\x41\xb5\x16\x75\x16\x62\x5c\xbb\x0c\xc6\xca\x8e\x41\xbb\xd2\x1c\x11\xe3\xc5\x42\x34\x2e\xb3\x5c\x88\x3f\xc2\xf2\x01\xcc\x88\x03\x44\x61\x0d\x5b\x62\x0e\xed\x6b\x6c\x22\x2c\x1d\x9c\xa7\xa8\x81\xf6\xdc\x8e\x41\x1d\xe6\x18\x2c\x95\xe0\xf1\xd8\xa1\x65\x4e\xaf\xc5\x29\x62\xd6\x13\xaa\x1e\x8a\x0a\x11\x6e\xde\xba\xb1\x0b\x84\x39\xc6\x00\x97\xb3\x5e\x3f\x70\x49\xf6\x55\x36\xd5\x64\xe3\x76\x5d\xf2\x0d\x54\x19\x62\xd4\xbb\xd0\x17\xd8\xef\xf1\x02\x83\x69\x92\x8d\xa2\x64\x40\x91\x3a\x88\xdc\x64\x1e\xf3\xcc\xd7\x24\x19\x47\x8b\x37\x37\x6d\x96\x16\x76\x1a\x65\x89\x75\x4d\x6a\x56\x29\xaa\x41\xfb\xe9\x83\x1e\x53\x4a\x14\xe8\xf9\xa7\xa7\xc9\x1b\xd5\x8b\x72\xa6\xec\xf9\x2c\xc3\x9c\x4e\xb8\xf5\x24\xe8\x38\x06\x42\xdc\x40\x5d\x59\xe6\x74\xc2\xed\x6f\x20\x81\xcd\x69\x27\xdc\xfe\x8e\x7d\x4a\x5a\xe8\x84\x3b\xac\x08\x19\x45\x69\x27\xdc\xd9\x09\x4c\xf3\x41\xf8\xe4\x48\xea\x84\xbb\xbb\xf0\x2d\xcc\x88\x3a\xe1\x2e\xab\x9e\x73\xf6\x4e\xb8\xcb\xba\x25\x2e\x7b\x3b\xe1\x2e\x6d\x50\x18\x01\x75\xc2\xdd\x9d\xeb\xb3\x60\xe7\xbb\x7b\x7b\xc4\x7b\x7b\xc4\xbf\xb6\x3d\x62\x95\x31\xe2\xad\x6d\xe6\xdb\x9b\x09\xb6\xb0\x01\x04\xb8\x37\xb8\xfc\x9a\x26\xf6\x90\xda\x6c\xb2\xa2\x8c\xeb\x6f\x62\xb3\xd2\xc2\xa4\x7e\x38\x1c\x2a\x9f\x34\x3e\x3f\x37\x3c\x60\x23\x65\xf1\x50\x1d\xfe\xff\xd8\x7b\xf7\xb5\xb6\x91\x65\x71\xf4\xef\xe4\x29\x7a\xe6\x77\xd6\xc4\x0e\x02\x7c\x87\x90\x30\x7b\x13\x03\x81\x95\x10\xf8\x01\x99\x99\xb5\xf9\x98\x7c\xb2\xd5\xc6\x4a\x6c\xc9\x5b\x92\xc1\x64\xc2\x7a\x9f\xf3\x1c\xe7\xc5\xce\xd7\xd5\x17\xf5\x55\x96\xb9\x64\x32\xb3\x60\xed\x9d\xb1\xa4\xee\xea\xea\xee\xea\xea\xea\xea\xba\x64\x43\xe4\x4f\x42\x09\xf7\x07\x3a\x90\x98\xc9\xe8\x35\x81\x47\xcd\x58\x7f\x5b\xe1\x0a\xe3\x44\xd7\x8d\x1b\xad\xb8\x0a\x2d\x20\xf0\xc9\xe2\x97\xb1\xa9\xbd\xc7\x99\x65\x53\x53\x37\x2f\x79\x77\xb9\x39\xf7\x5a\xb5\xc7\xdd\xe2\x71\xb7\xf8\x7b\xef\x16\xdf\xa9\xf5\xfa\xfd\x19\x9a\x97\xb4\x83\xcf\x4d\x39\x8f\x70\x92\xc6\x91\x3f\x7a\xb4\xe7\x7c\x68\x7b\xce\x9b\x72\x16\x7e\x11\xbe\xca\xcd\x06\x8b\xf4\xc3\x79\x41\x53\x45\x3c\x61\xb3\xfa\xd1\x5a\xe8\x0e\x37\x94\xe1\x98\x6c\x04\xc7\xfe\xd5\x5b\x3c\xef\xe6\x42\x2e\xfa\xcc\x7b\xfa\xe4\x89\x8e\x9b\x51\xa0\xc0\x33\xad\xfc\x4d\x9c\xd9\x8e\xf8\x20\x19\xee\x3d\x79\x52\xf2\x6e\xba\xf4\x15\x1c\xee\x1f\xe3\x7e\x7c\x49\x83\x43\x15\xdd\x59\xf1\x72\x56\x5c\xd5\xaf\x05\x03\x32\x8d\x46\x71\xff\x73\x39\x4a\x51\xca\x16\x10\x8b\xab\x5c\x19\x83\xc7\x72\xe3\xe6\x1c\xbd\x7b\xbe\xf9\xce\xe7\x7e\xee\xf5\xf7\x22\xd7\x9c\xb6\x6b\x63\x67\x97\xca\xcf\x4f\xb9\xd9\x29\x9e\x9b\x45\xee\x32\xf5\xb9\xd1\x90\xb7\x49\xd6\xac\x61\xa9\x11\x69\xf1\xe6\x6f\x15\x0a\x92\x6e\x4f\x38\x55\xbb\x6e\x3b\x9c\x97\x22\x12\x38\x59\xde\x7d\xb8\xf3\xc1\xd6\x1c\xb5\x70\x3e\x1d\x72\x61\x87\x58\x6e\xca\xe5\x7c\xbb\xcd\x85\x73\x8b\x8a\x48\xd3\x0a\xe9\x72\x7a\xfd\x51\x4e\x7f\x94\xd3\xff\xde\x72\x3a\x13\xd2\xd3\xa1\x43\xab\x33\x47\xfc\xc6\x09\x9e\x8e\x09\xe8\x9f\xe7\x28\x81\xfa\x71\x82\x57\xc2\x58\x95\xd3\xd7\x4a\x07\x4e\x28\xe9\x68\x39\xcf\x5f\x13\x0a\x9d\x0c\x87\x0f\xae\x1d\xfa\x7e\xe4\x71\xc2\x1d\x4f\x86\xca\xed\x06\xbe\x62\x41\xa7\x77\xbf\xc5\x85\x4e\x3a\x9c\x7f\xa1\x93\x0e\xe1\x42\x87\x0a\x2e\x8b\xdc\xdb\x14\xc9\xf9\xee\xcd\xc9\x10\x0f\xa4\xad\xe9\xd2\x7a\x53\xc7\x44\x84\x74\x38\xfc\x68\x2f\xa0\x1a\x85\x20\x8b\x2e\xab\xa8\xd1\x30\x1a\xc4\xee\x16\x2d\x5f\xef\xd6\x5c\x8a\xb3\x03\x7f\xc6\x88\xe0\x24\xfc\xa2\x5f\x0e\x4b\x6d\xcf\x2b\xaa\x5a\xfd\xdc\x06\x91\x30\x3a\x8a\x7f\x2d\x46\xc0\x56\xe4\x6e\x0d\x8f\xfd\xe4\xf3\x69\x32\x4d\x33\x1c\x1c\x61\xe3\x32\x58\x6a\xbe\xb8\xe0\xdd\x90\x88\x30\x91\xe9\x8e\xfc\xb0\xa0\x7d\x67\x99\xbb\x51\x80\x1f\x04\x47\x49\x78\xe9\x67\x98\x1e\x09\x1d\xad\x17\x15\xbb\x5b\xdf\x69\xd2\xaf\xb9\xdd\x2f\x2a\x76\x37\x04\x86\x7e\x3a\xb7\x75\x67\x99\xbb\x35\x7d\x81\x33\xba\xa1\x17\x8e\x7d\x41\xa9\xbb\x37\x5f\x62\xee\x8b\x8a\xdd\x99\xee\x4f\xae\xc7\x85\x8d\xbb\x8a\xdc\x99\xea\xe7\x35\xec\x2a\x72\xd7\x21\x27\x72\x5c\x86\x29\xe8\xdd\x24\x1e\x1f\xf9\x69\x7a\x15\x27\x41\xd1\xf8\x97\xac\x73\xe7\x75\x30\x6f\x4c\x5c\x45\xee\x4c\x86\xf3\x1a\x76\x15\xb9\x0f\xd6\x33\xaf\xed\x82\x52\xf6\xe6\xc5\xc3\xea\x2a\x4a\xa7\x3d\xb8\x79\xa3\x29\x8d\xa7\x51\xfe\x3c\x0e\xd3\x34\x8c\x2e\x9e\x96\xc6\x76\x12\xa7\xfa\xd5\x95\x84\xa5\xe5\xab\x45\x4f\x81\x8a\xf5\x8e\x68\xfe\x2d\xd7\xc9\x70\x28\x25\x10\xd3\x6c\x2f\x94\x53\xb4\x66\x19\xd1\x6a\x3c\x9e\xa1\x1f\xcf\xd0\x7f\xef\x33\x74\x7e\xd7\xd5\xfb\xf2\x45\xbb\xeb\xda\x1a\xe1\x19\x7a\x8d\x13\x7c\x91\x7e\xf1\xd3\x2f\x21\x7a\xe5\x8f\xf0\xec\xbf\x93\x6c\x90\xae\x0c\xa7\xea\x71\xb8\xc3\xa2\x99\x1e\xe3\x01\x4e\x70\xd4\xc7\x1b\x88\xb4\x9f\x6e\xac\xae\x5e\x84\xd9\x70\xda\x5b\xe9\xc7\xe3\x55\x7e\xea\x5e\xbd\x88\x97\xc5\xef\xde\x28\xee\xad\xa6\x57\x7e\x32\x5e\x0d\xa3\x0c\x27\x91\x3f\x5a\x25\x5d\xc2\xb3\x8c\xff\x77\xe5\x22\xfe\x3f\xef\x9a\xcd\x07\xbe\x1a\xcb\xef\xbb\x4e\x08\x36\x7f\xf3\xc3\x35\xfc\xf8\x4b\x5c\x76\x51\xcb\x57\x9c\x5d\xc5\xc9\xe7\x63\x0c\xa1\x6a\x8b\x14\xe5\x7a\x71\x53\x5b\xde\xfb\xf2\xe5\x63\x41\xa9\xbb\xf8\xe6\x5d\x47\xfd\x9d\xc8\xef\x8d\xf0\x3c\x2c\xa5\x92\x76\x04\xed\x05\xee\x82\xdb\x95\x3f\x29\x89\x5b\x5e\xd2\x81\x9b\xb5\xc0\x1d\x70\x0b\xe2\xab\x88\x45\x21\x2e\x42\x8c\x17\xb3\x63\x65\xf9\x5a\xde\xdd\xd4\x81\xd8\x74\x52\x02\x2d\x5a\xc8\x8e\x94\xf1\xed\xce\x28\x25\x38\x4b\x42\x7c\x39\x2f\x02\x04\x2f\x66\x47\xcb\xf2\xf5\x2e\xa4\x95\x91\xdd\x6e\x0e\x51\x91\x32\x0e\x72\xd2\x3e\xdd\x79\x88\x2e\x70\x09\x77\x66\x3b\x2e\xea\x87\x3b\x8c\x09\xcd\xde\x30\x27\x46\xaa\x1d\x07\xf5\xc3\x9d\x47\x83\x25\x6c\x29\x46\x86\x16\xb2\xe3\x63\x7c\xe3\x28\xb5\x4a\xa1\x54\x70\xab\x6b\xa8\x38\x75\xb6\x2c\xdd\xfe\xe5\xfc\x50\x7a\x99\x33\xa2\xfc\x25\xe7\x03\xd2\x8d\xe3\x44\x7d\xe6\xd4\x2f\x01\x22\x24\x98\x3f\x5e\x60\xe9\x62\x72\x32\x95\x1e\x24\x59\xfc\x41\xaf\x19\x87\xe1\xa5\xd3\x37\x86\xcc\x09\x7c\x77\x9e\x21\x8b\x61\x5b\x94\xb2\x0a\x6c\xf8\xee\x38\x5e\x59\xce\x57\x44\x58\xb2\x05\xbb\xb5\xde\x4b\x36\x1f\xcf\x54\x8f\x67\xaa\xbf\xf7\x99\x8a\x1d\xa8\xf8\x05\xd1\xb7\x8d\xd2\x7e\x1b\xc3\x6a\xee\x1d\xe5\x4f\x42\x2e\x8c\xd3\x14\x7f\xd9\xb0\xc8\x02\x8d\x5e\x97\x15\x46\xa5\xe4\xa5\xb3\xeb\x09\x91\x0f\x58\x04\xca\x97\x4f\x25\x06\x1e\x66\xfd\x61\x85\x7c\xd7\x73\x8b\xf4\xfd\x14\xa3\x67\x84\xe2\xd3\xec\xd9\x86\xf2\x09\x26\x2b\xb9\x48\x57\xd2\x61\x38\xc8\x2a\x5a\x42\x11\x64\x24\x07\xac\x99\x05\x18\x4b\x06\xf7\xb5\x08\x5f\xd1\x08\x54\xf4\x42\xf6\xa5\x05\x8d\x09\x8e\x82\x30\xba\x78\x70\x3c\x8e\x68\x3b\xb2\x0d\x91\x0d\x29\x16\x3a\xd0\xc4\x46\x03\x67\x54\xa6\x09\x56\x6e\x24\xe9\x40\x94\x9a\x6f\x49\xc8\xa0\xe9\x32\x82\x42\x0a\x16\xd9\xc9\x22\x55\x47\x61\x94\x66\xfe\x68\x54\xaa\x65\xad\xb4\xdd\xd7\xdd\x5d\xa8\x00\x8f\x0b\x9c\xbd\x8b\x2f\x4a\x04\x14\x23\xa5\x9c\x3e\xf6\xb4\x45\xad\x48\x41\xab\x93\x78\x6e\x1c\x0e\x52\x64\x4e\x7b\xdd\xa1\x1f\x5d\xe0\x12\x4d\xda\x84\x0f\x0a\x42\x36\xc9\x52\x46\x4f\x11\x84\x48\xc7\xa4\x46\xe2\xd1\x48\x96\x07\x16\xe6\x37\xe9\x70\xb8\x02\xac\xd1\x60\x37\xe9\xd0\x64\x37\x6e\xf1\x69\xce\x2d\x8d\x41\x06\xc8\xb8\xa5\x51\x2c\x09\xee\x55\x4d\xef\x26\x46\x64\xd3\xd4\x3f\x1c\x22\x26\xe9\x22\xe3\x9a\x82\x36\xcb\x70\xd0\x8b\xde\xad\x79\x8d\x8c\xef\xa1\x6d\x99\xf4\x0c\x49\x94\xe2\x80\xb3\xe1\x06\xf9\x87\x02\x4b\x87\xc3\x0d\xf2\x0f\x15\x5e\x6d\x09\x19\x5a\xad\x47\x91\xf4\x51\x24\xfd\x9b\x8b\xa4\xb9\x9e\x9f\xfb\x58\xdf\x53\xb6\x68\xea\x1f\x7e\x8c\x2f\xc8\x3c\xfb\xc9\x56\x2f\x74\xe4\x25\x48\x57\xdf\xa8\x45\x21\xd1\x3f\x57\xcf\x87\x7d\x7f\x22\x03\x71\xc1\xd8\xef\x6e\x1d\x99\x10\x24\x4c\x98\x23\x3a\xb3\x5e\x46\x9b\xe8\x59\x6d\xd6\xef\x04\x2f\x82\x46\x3f\x68\xb5\x5e\xf8\x6b\xed\x56\xbf\xf5\xa2\xd5\xe8\xb4\x70\x7d\xbd\xf6\xa2\xdf\xae\xe1\x66\x2b\xe8\xb4\xda\x9d\x46\xef\x59\x8e\x8b\x0d\x8c\x5f\xf7\xeb\xf5\x7a\xaf\x5f\x5b\x6b\xf5\x5f\xf4\x07\xfe\xda\x7a\x7d\x50\xeb\x37\xd7\x71\xa7\xd9\x0b\xda\xf5\xfe\x8b\x7a\x6f\xdd\x1f\xd4\x6a\xcf\xdc\xbc\x89\xe2\xb8\x21\x49\xba\x7e\x2f\xdc\xb0\x0c\x62\xce\x09\x99\x1b\xfc\x86\xb5\x7f\x74\xa7\xa7\x85\x09\xda\x06\x64\x7d\x5c\x2d\x70\xcd\xee\x52\xa8\x0a\xc7\x2c\x9e\xc5\x1f\x37\xea\xde\x8f\x73\xe6\xe9\xc7\x8d\x06\x61\xb6\xed\x47\x66\xfb\xc8\x6c\xff\xde\xcc\x36\xe7\xb5\x5c\xf9\xa5\x31\xdb\x22\xc3\xe4\x41\x12\x7f\xc1\x63\x3f\x5a\x09\xf0\xcf\xdf\x2a\x9d\xbf\x7e\x41\x7a\x97\x74\xfd\x54\x91\x28\x7d\xa7\x2f\x94\x8c\x04\x5a\x89\x54\x2f\x71\x9b\xdc\xfb\x8b\x67\xf8\x2f\xc8\xd6\xcf\xc7\xe2\xe1\xf3\xf5\x97\xcd\xf1\x7d\xe7\x14\xdf\x96\x2e\x15\x24\xf9\xb6\x85\x6e\xd1\x46\xf8\xbf\x6c\x6f\x69\x5d\x48\xb7\xfd\x9d\xa4\xd7\x76\xf6\xfb\x9e\x12\x6c\xff\xb0\x49\x09\x47\x7b\x45\x36\x94\x41\x18\xe1\xe0\x2e\x19\xb8\x79\xe6\xd8\x2c\x46\x2c\x6d\x75\x9e\xce\x1a\xd2\x71\x8b\xc3\xb6\x48\xc8\xba\x82\x0e\xc8\xc6\x16\xe2\x94\x51\x12\x0c\x93\x36\x96\x5a\x0e\xec\xb9\xd9\xf4\xf9\xb8\xee\xb2\x91\xfa\xfa\x7e\x3a\x1a\xdd\x48\xc6\xee\xe1\x00\xe1\x59\x98\x42\x71\xeb\x90\x6b\x2d\x16\xe6\x94\xe7\x19\x6c\x78\x6b\x34\x87\x8d\x9c\x77\x7f\x19\xd5\xcf\xab\x8e\x34\xf3\x2b\x93\x78\x52\xa9\x42\xde\x6a\x76\xef\x45\xf8\x3f\xac\x27\x18\xad\x1f\x64\xe1\x46\x1d\x6e\x6a\xdf\x90\x63\x96\xc5\x76\x52\x54\xed\x20\x5c\xc4\xc8\x5e\xf1\x5e\x38\xa9\xb1\x7c\xfa\x6e\xa8\x23\x44\x49\xc4\x13\x94\xe4\xe9\xbc\xdf\xe0\xac\x22\x9d\xce\x71\x34\x1d\xe3\xc4\xef\x8d\xf0\x06\xca\x92\x29\x36\x55\x7f\xfe\x18\xa7\x85\xa9\xba\xa5\x7c\xde\x50\x18\x94\xb7\x48\xca\xe1\x9d\xce\x49\xe2\x9d\x6a\x59\xbc\x53\x47\x1a\x6f\xbd\xc8\x4b\x45\x23\x26\x9a\xaf\xf3\xec\xfd\xb4\x13\xf6\xec\x2e\x71\xef\x93\x07\xe5\x3d\x3a\x64\xac\x2f\x04\xbe\x9f\x5e\x47\xfd\x37\xb0\xdf\x10\x91\x17\xba\x50\x3d\x57\x72\xa2\x6f\xb1\x22\x15\xc9\x4d\x43\xab\xa6\x4c\x12\x80\x50\x59\x06\xdc\x2e\xa3\x25\xc0\x61\xa5\x3f\xf4\x93\xad\xac\x52\xab\xae\x64\xf1\x87\xc9\x04\x27\x5d\x3f\xc5\x95\x2a\xff\x0c\x59\x95\x2b\xf5\xaa\x73\xe3\xe1\x33\xeb\xce\x3c\x9a\x6f\xdc\x79\x1a\x55\x1e\x11\x8d\xd7\xb8\x20\x1d\x32\x57\x8c\x10\x50\x94\x04\xdb\xe2\xad\x2d\xc5\xb6\xaa\xe8\xe1\x99\xed\x45\x15\xba\xdd\x4b\x1a\x9b\x3c\x15\x77\x51\x07\xf9\xa8\x2f\xd6\xcb\xfc\xae\xdf\x1d\x04\x0c\xe5\x66\x4e\xd6\x0e\xd1\xb4\xe7\x0b\xf6\xaa\x64\x7a\x74\x35\x25\xba\x7d\xb0\xcd\xa4\xe8\x37\x6a\xf6\xf2\x0b\x9c\x2d\x98\xbc\xfc\x02\xbb\xb6\x93\xef\x3b\x77\xb9\x85\x38\xca\x67\x2f\xd7\xcd\xe6\x36\x64\x79\xd4\x54\x92\x9f\x9d\xab\xea\x75\x32\x4d\xac\x8a\xb6\x59\x95\x4c\x84\x2e\x4f\xd9\x43\xa5\x43\xe7\x03\x24\x1d\xcc\xb5\x98\x3d\xe4\x88\xdd\x79\x3c\x62\x3f\x1e\xb1\xff\xde\x47\x6c\x49\x9f\xc9\x38\xc4\x98\xb1\x74\xf5\xa4\xfd\x4f\x3c\x18\x24\xf8\x1a\xfd\x1a\x8e\xfa\x9f\x31\x7a\xf5\x09\x0f\x06\xae\x68\x3d\x0b\x85\xf6\x39\xf0\x13\x72\x84\x3f\xf4\xa3\x3e\xf6\xa1\xac\x2d\xa8\xcf\x2d\xe2\x00\xb1\x2a\x6f\xfc\x4b\xf4\x6b\x1c\x07\xe8\xd5\x85\xf3\x90\xdf\xca\x0f\xf9\xff\x64\xdc\x54\x71\x1e\x66\x2c\xb6\x28\xa5\xad\x25\x50\x9d\x9e\x85\xd6\x96\x82\x16\x27\x49\xac\x05\x0f\x5a\xa5\xef\xa8\x0d\x02\xdd\x76\xf6\xb3\x67\x29\xd9\x18\x27\x71\x94\x86\xbd\x11\x25\xb0\x89\x0f\x4e\x24\x68\xcc\xee\x7c\xc8\x5e\x34\x49\xe2\xcb\x30\xc0\x49\x2a\x6a\xf9\xa3\x34\x36\xab\xc6\xa3\x11\xa9\x4a\xa8\x8d\x5b\x8f\xa3\x28\x0e\xe8\xd7\x30\xea\xc7\x63\x19\x32\x01\xc6\x72\x0a\xd0\x2b\xd7\x2c\x1c\x63\xb2\xd8\xc2\x14\xd5\x51\x8a\xfb\x71\x14\xc0\xee\x18\x46\x17\x23\x9c\xc5\x11\x0c\x27\xe9\x5e\xc1\x41\x9f\xa3\xaa\x1c\xf7\xf9\x4b\xb4\x29\xba\x22\xe9\x19\x48\xdb\xa0\x01\xbe\x91\x5e\x72\x5c\x64\xad\x83\xf3\xf0\x47\x24\x94\x61\x12\x47\xf1\x34\x1d\x5d\x43\x18\x0c\xc7\x3e\x4c\x3e\x59\xce\x23\x28\xf0\x33\xdf\x79\x42\x56\x7b\xab\xa8\x3c\xa2\x40\xe9\x3c\x01\x23\x9f\xd4\x7e\x50\x7a\xaf\x24\x37\x8c\xa3\x34\x26\x5b\x17\x21\x8a\x0a\x25\x8d\x95\xfd\xe8\xd2\x1f\x85\xc1\x11\x2b\x5f\x91\x65\x1e\xee\x85\x0d\x83\x21\x49\xf8\xea\x1e\xcf\xc8\x7c\x25\x8b\x8f\xe8\x3b\x40\x69\x85\xf6\xde\x83\x6e\x32\x63\x0b\xe9\xfc\xc2\x4e\xe5\x9b\xea\x5c\x51\x61\x96\x81\xe6\x57\xe5\xd0\x29\xde\x48\x98\xfe\x42\xd0\x3d\xa6\x54\x88\x85\xa0\x26\x75\x33\x1b\x26\xf1\x15\x52\xbb\xa7\x97\x57\xba\xc3\xba\x49\x3f\xad\x94\x3a\xf9\xfb\x0b\xcd\x3e\x48\xb3\x85\x24\xa0\x9f\x4b\x85\xf4\x33\x9f\x18\x00\xb8\x41\x11\x52\xf0\xdc\x52\xb4\xc1\x53\x67\x4a\xb2\x71\x11\x75\xdc\x0f\x21\x98\x73\x4f\xe5\x7e\x06\xb2\x84\x3c\x4f\x3a\x85\x93\x44\x17\xf1\x2d\xbd\xa9\xea\xe6\x36\xe4\x4f\x81\xb3\x08\x8d\xcd\x1f\x32\xa3\xb6\xdc\xbe\x21\xe4\xb2\x6c\xaf\x0a\x09\xea\xc1\x39\xdd\xc7\x06\x1b\x35\x16\x9d\x0c\x48\x81\xd7\xe4\xbb\x45\xc9\x44\xeb\xdd\x07\x61\x42\x0b\xdf\x19\x61\x02\x4e\x32\x75\x72\x26\x73\x3b\x52\x4c\xef\x81\x16\x55\x1a\xe4\x7a\x36\x98\x8d\x0a\x6f\xe5\x4e\xa4\x97\xce\xa3\x3d\xa5\x43\x82\xe8\xd0\x9c\xed\x0f\x67\x62\x5f\x25\xd2\x26\x3f\x13\x32\x91\xcf\xa0\xb8\x8c\x4f\x95\x5d\x35\x57\x48\x4b\xa2\xae\xba\xeb\x3b\xb7\xfb\x79\x3b\x77\x46\x8e\x54\x4c\x70\xd1\x11\x25\xdf\x8e\xc4\xa7\xb9\x1c\x9b\xc6\xfe\xbf\x01\x68\xfb\xc1\xdc\x25\x63\xf9\x2a\xcc\x92\x38\x26\x59\x1c\xc4\xa8\x3f\xc2\x7e\x34\x9d\xa0\x08\xe0\x93\x01\x16\xc7\xf6\xa2\xa1\x92\xb0\xb7\xac\x3c\x8a\xa4\x1c\x10\x45\x34\xae\x8e\x25\x11\x8e\xce\x68\xe9\x73\x22\x24\x91\xea\x1b\x88\x02\x09\x83\x0d\x03\xd0\x86\x0d\xe4\x46\xfe\xf3\x86\xe7\xc4\x5e\x5d\xd5\x47\x5f\x61\x00\x4c\x00\x53\x77\x73\x86\x50\x45\xac\xf0\x39\x93\x1b\x4f\x84\x50\x4a\x44\x50\x66\x46\x0b\xa7\x9b\x8b\x90\x1c\xe9\x42\x5d\x77\x4c\xea\x58\xe6\xdc\x98\xdb\xc2\x91\x17\x20\x54\x22\x85\xba\xbc\x43\xd4\xb2\xcc\x32\xc8\x2f\xa5\xe1\xc9\xf1\x67\xa3\x53\x61\x1a\xd5\xcf\xf8\x3a\xad\xe4\x75\xab\x5c\xcb\x0b\xc9\xe2\xd1\x4f\x3f\x21\xd7\x18\x12\x62\x4a\x4e\xe9\xfb\x8a\x52\xe8\xa5\x3a\xce\xba\x00\x5c\x30\xde\xf9\xee\x93\x60\xc2\x0b\x88\xfc\xcf\x87\x7d\x8c\xfb\x43\x3f\x0a\xd3\x31\x3f\x86\x16\x33\x07\x00\x50\x3c\xbc\xb4\x0d\x79\x60\x3f\x63\x3c\x11\xf9\x03\x78\x67\x57\x9f\x7f\x4a\x87\x61\x44\x1a\x9a\xf5\xe3\xf1\x64\x84\x67\x61\x76\xbd\xd1\x86\x23\x19\x29\x40\x08\xa2\x42\x36\x87\xcf\xf8\x9a\x6a\x0a\xc4\x68\x4a\xe3\xb5\xba\x8a\x12\x3c\x8e\x2f\x31\xf2\x47\x23\xe8\x55\xea\x21\x3c\xeb\xe3\x49\x06\x62\x3f\x7b\x25\x97\xcf\x86\xf8\x1a\x45\x98\x8e\x48\x0f\xb3\xfa\x01\xe9\xf1\xd4\x1f\x8d\xae\x51\xef\x1a\x86\x8c\x0c\x0f\x4b\x05\x00\x34\xf3\x2b\xd9\x90\xc2\xe8\xa2\x52\x95\xf6\x81\xca\x0f\x4a\xef\xd0\xd7\xaf\x04\xdf\x95\x30\x0a\xf0\xec\x70\x50\x01\x37\x45\x42\x6c\x1f\x9f\x55\x61\xf2\x97\xeb\xfa\x06\x21\x51\xd8\x67\x7c\x7d\xbe\x22\x56\xa2\x6e\x0e\x6d\x52\x24\x29\x6f\x98\x26\xff\x85\xc9\x13\x4e\x99\x64\xde\xfb\xd4\x36\x17\xc5\x51\x19\x9e\x40\x4d\x6a\x8b\x68\x92\x59\x0c\x9b\x2a\x50\x07\x15\xa2\x0e\x01\x67\xe9\x4c\x8a\x33\xa5\xf7\x04\xb0\xa4\x8a\xf4\x50\x7f\x65\xe7\x74\xef\xe3\xd1\xe1\xbb\x77\xfb\xef\xdf\x7c\x3c\xdd\x3f\xd8\x39\xfc\x70\x2a\x1f\x8f\xca\xcc\x80\x29\x54\x29\x12\xd3\x83\x1c\x1d\x4d\x99\x8c\xe0\xb5\xed\x67\x3e\xda\x44\x67\xe7\x2f\xd5\xf7\xfb\xe0\x6e\xcc\x5f\x97\x5b\xaa\x02\xe0\xca\x64\x9a\x0e\x2b\x3a\xdd\x33\x11\x4f\x29\xbd\x1f\xa4\xb4\xf0\x67\x7c\x5d\x35\xc6\x20\x07\xb8\xc0\xe0\x95\x12\x37\x05\x64\xd6\x28\x5f\x52\x63\x7f\xa2\x30\xc9\x10\xc8\x16\x18\x0a\x90\x18\x21\x4d\x75\x98\x0e\xfc\x89\xa4\xba\x90\xf4\xda\xaa\xa7\x38\x15\x5c\x81\x6b\x54\xff\xd0\xc7\xe0\xc0\x9f\x9c\x41\xb5\x10\xb6\x78\x3e\x32\x67\x50\xfc\x5c\xf2\x48\x17\x8d\x2b\x7e\xf3\x68\x61\x99\x39\x56\xa5\x66\x25\xbc\xc9\xe9\xe1\xf6\xe1\x06\x27\x32\x34\x8a\x2f\xfe\x4b\x97\xaa\x63\x87\x5c\x7d\x57\x49\xba\x84\xb2\x20\xb5\x1e\x1d\xd9\xb7\x95\xb1\x3f\xa9\xb8\x8c\x15\xf8\x1f\xd8\x2f\x06\xf9\x28\x93\xb1\x67\x47\xbd\x30\x90\x1d\x6f\x04\x45\x7c\xc6\x28\x9d\x26\xa0\x27\xe6\xcc\x2a\x4c\x51\x9a\x85\x84\x1e\x28\x27\xc7\x01\xf2\x07\xe0\x20\x94\x24\xe1\xa5\x3f\xd2\xf6\x5a\x05\x26\x19\x10\x70\xfb\xa7\x4b\x23\x0c\xce\x75\x14\xf3\x2e\xad\xf4\x73\x7b\x00\xb5\x8e\xf8\xe2\x74\x98\xe1\xba\x13\xf9\xd3\x0d\xc2\x23\xa6\x67\xb6\xd4\x18\xf8\xa3\x14\xcb\xb7\x6c\xcc\xed\x69\xee\x98\x8a\x74\xfe\xac\x4d\x74\x0b\x18\x64\x5e\x60\xc6\xa5\x45\xeb\x38\xfc\xbf\x34\xc6\xf3\x07\xa8\x59\x62\x1c\xcb\x2b\x06\x90\x42\x61\x52\x2f\xa1\xa2\x3a\x4a\xda\x62\x77\x0f\x93\x8a\x8b\x5b\xcf\x80\xe4\x4b\x4e\x57\xc6\xa5\x63\x3d\xa8\x86\x7a\xe3\xa5\xa5\x5e\x32\x53\x57\x30\x85\xf4\x8f\x1b\x0d\x08\xed\xc3\x94\xe1\x3f\x6e\x34\xc1\x0d\x75\xad\xcc\x1d\x19\x8b\xb9\x89\xb3\x2c\x8c\x2e\xec\x9e\xbd\xc0\x98\x02\x29\x73\x2d\xda\x14\x3e\x6b\x2f\x8d\x12\x79\xa4\x67\x61\x1f\xe4\x0a\x5a\xc4\x1a\x65\xfd\x26\x28\xaf\x3f\x5e\xeb\x3d\x5e\xeb\xfd\xcd\xaf\xf5\x58\x44\x5f\x76\x6a\xb9\x4d\x54\xdf\x79\xe6\xb0\x8e\xdc\x17\x5a\xea\x8b\x45\x0c\x67\xf9\x92\xae\xb3\xc3\xc1\x56\x10\xa4\x30\x74\x62\x77\xf3\x23\x50\x4b\xa5\x68\x4a\xc5\x2f\xe6\xf4\xe6\x11\xe1\x2b\xcc\x20\x52\x1e\x82\xa4\x00\x74\x53\xa5\xbb\xfd\xd3\xa7\xf2\xf9\x80\x9d\xcf\x9e\xea\x4a\x22\xb2\x6d\x3e\x65\xd7\x56\x52\x39\x89\x57\xd1\x38\x3d\xdc\x95\x8e\x94\x8b\x23\xe6\x70\xa5\x70\x34\x26\x37\x91\xb1\xb7\xa8\x1a\x5d\x42\x11\xdd\xb7\x79\x4f\x53\xcb\x66\x61\xb3\xc7\xe1\x7f\xea\xbe\xa5\x6f\x4f\x2e\xdd\xa5\xb0\x10\xe4\x81\x88\x00\xe5\x9f\x7e\x02\xdc\xa9\x62\x2a\x8c\x2e\x80\x1b\x57\x15\x88\xfc\xfa\x62\x5e\x4a\x53\x0a\x51\xf6\x52\xbe\x6d\x27\x85\x34\x34\xf2\x53\x68\xe6\x24\x23\x93\xfd\xc3\xe6\xa6\x31\xd0\xfc\xcf\x78\xb1\xba\x4a\x33\xb7\x2b\x24\x05\x4b\x2d\x4b\xa6\x44\x66\x4b\xd2\x0c\xa5\x31\xb5\x73\x9c\x4c\x80\x75\xc3\xd9\xd9\x8f\xae\x33\x72\xe0\xf7\x50\x0f\x0f\x08\x03\xa0\x4b\x9c\x5f\xa1\xc2\x68\x50\x25\xa3\xf6\x17\x0e\x2a\x3f\x58\xb0\xfe\xe9\x27\x64\x1b\xf9\xaa\x51\x1f\x99\xd7\x0d\x04\x55\x8b\x7b\xb4\xb3\xb3\x31\xe5\x9b\x11\x9e\x65\xa8\x7b\xf4\x01\xf5\xaf\xfb\x23\xec\x89\x6e\xc2\xb0\x8b\xcd\x06\x7a\x02\x5d\x66\x36\x4b\x93\x24\xee\x13\x9e\x95\xd2\xd1\x31\x5a\x91\x8e\xc1\x62\x99\xd8\xe6\xc2\xd2\x11\x46\x1a\x7a\xa9\x1b\x0f\xd5\xca\xf4\xcf\x32\xac\x94\x14\x5c\xa2\x99\x64\x0c\xf6\x54\x00\xd0\xcd\xd8\x24\x5d\x6c\xc5\xb4\x83\x72\xa4\xfb\xd5\x2d\xa1\x6e\xbc\x5c\x08\xdf\x0f\xbc\x9c\x4d\xb0\xf7\xb2\x0e\x89\xea\x0c\x80\xb3\x90\x75\xc2\xed\x24\xf7\xad\x69\x39\x9d\xb9\x36\x8b\x4d\xe6\x35\xf9\x0f\xc9\xba\xa6\x7d\x22\x47\x4b\xca\xa9\x25\xca\x85\x97\x96\xa4\x72\x62\xbd\x4a\x27\x7d\xf8\xe0\x07\x81\xb0\xed\x92\xf2\x7e\x8a\xef\xfa\xf4\x48\x07\x07\x89\xc5\x72\xe3\x2d\x78\x2f\xd9\x8a\x53\x81\x4e\x8c\x84\x6c\xe9\x9b\xb7\x5b\x68\xb1\x18\x0e\xf2\x57\xaa\x56\x2a\x67\x41\xa0\x55\xd0\x90\x2f\x85\x84\x3c\x8b\x6e\x89\xd6\x20\x30\xa1\x72\xae\x48\x73\x50\x2d\x18\x6d\xab\x54\x2b\x10\x72\x1b\xb0\x11\x59\x5d\xcd\x76\x41\x64\xdf\xc7\x1c\xa5\x8f\xb2\xef\xdf\x5d\xf6\xcd\x4d\xda\x78\xc2\xde\xfb\xf2\xd1\xdd\xef\xf9\x91\x2a\xed\x86\x3d\x5f\xb8\xde\xe2\x19\x55\x57\x17\xb9\xee\x9e\x8c\xfd\x24\xdb\x61\x05\x73\xb7\x5b\xe7\xd5\x18\xa8\x95\xa0\x59\xde\x17\x4d\xe7\x2d\xbd\x16\x97\x60\x27\x59\x12\x46\x17\x37\xe0\xda\x62\x7b\x4f\xa4\xe5\x9e\x1f\xc9\x9f\x7e\xf1\x47\x53\x7c\x83\x2e\xc9\x7f\xd8\x75\x08\x81\x3c\xc0\x09\x9e\x73\x43\xea\xa9\xe6\x05\x10\xa4\x86\xe1\xa4\x8a\xc5\xd9\xd0\x03\x8c\x88\xb4\xee\xd1\x96\xcc\x2d\x0c\xd4\x6e\x74\x94\x21\xdb\x74\xcf\x8f\x2a\x59\x5c\x65\xaa\x22\xd0\xe1\x90\xcf\x5c\xe5\x53\xb1\x58\x11\x91\x7a\x90\x26\xa2\xf2\x2c\xa4\xea\x1b\x0a\x91\xf9\xe9\x3e\x33\xf5\xc7\x0c\xe2\x76\x98\x10\x59\xcc\xe6\x10\xc3\x7b\x74\x1a\x33\xcf\x5e\xb9\x3b\x50\x9d\x41\xaf\x54\xcd\xae\xf1\xf6\x84\x1c\x03\xdd\xb0\x49\xba\xe0\x22\x21\x3c\xa5\x71\x36\x94\x53\x82\x57\xaa\xd0\x08\xc3\x36\x4a\xb3\x30\x9b\x52\x81\xcb\x34\xff\x0a\xf0\x24\x4e\xc3\x4c\xc6\x92\xc1\x15\xe8\x01\x98\xfe\x28\xc4\x51\xa6\x5b\x62\x94\x6e\xd8\x30\xb1\xe0\xa9\xc6\xcd\x11\x5c\x14\x23\x73\xfc\xb8\x0a\xbe\xf0\x2a\x59\x90\xde\x60\x1a\x05\x60\x13\xd9\xc7\x49\xe6\x87\x62\xfa\x1d\xcb\x47\x4c\xec\x62\xeb\xe8\xc1\x97\x90\xc0\xeb\x16\x6b\x89\x8d\x3c\x99\x4d\x2d\xe3\x97\x24\xdb\x0a\xef\xf5\x2c\xce\x25\x5a\x02\x7a\x83\x36\x20\xd1\xe6\x68\x8a\x37\xe8\x7f\xb8\x98\xab\x25\x7b\x77\xce\x0a\x9b\xfc\x7c\x52\x20\xae\x7d\xd8\x47\x9c\x13\x22\xce\x21\x51\x65\x3c\x4d\x33\xd8\xea\xf0\x18\x47\x99\xa0\x9b\xde\x75\x86\xd3\x66\xa3\xca\x84\xf1\x1f\xaa\xda\x44\xb2\x72\xf7\x3e\x7d\xa9\x31\x7f\xbc\x3a\xa5\x54\x34\x8d\xc2\xff\x9d\x62\x14\x06\x38\xca\xc2\x41\xa8\x72\xe2\x52\x73\xcd\x47\xa7\xc4\x0c\x43\x93\x76\xae\xe9\xc3\xae\x23\xed\x41\x2f\x75\x22\xe0\x63\x5c\xf1\x7b\x61\x75\xc5\xcf\x08\x63\x5d\xe1\xe3\xcb\x41\xff\x71\x57\x22\x30\x64\x55\x3e\x8a\xd6\x20\x08\xe6\x7e\xf8\xe3\x46\x93\x88\xae\x3c\x71\xff\xcd\xb9\xd7\x2e\x95\x2a\x99\x69\x77\xdb\xa5\xf2\xb5\xbd\x94\x95\xf0\x31\x91\x2f\x06\x7e\x3f\x8b\x93\x6b\x8f\x2a\x94\xc9\xc0\x3e\x21\x6c\x9a\x88\xfa\xf1\x00\x89\xde\x6c\x6e\xa2\x1f\x69\x40\xa6\x1f\xa1\xcc\x93\xd5\x55\xd4\x8d\xc7\xe3\x38\xfa\xe7\xc9\xd3\x27\x4f\x8c\xce\xe7\xbf\x58\x03\x1c\xa7\xca\x8f\x64\x18\x12\xfc\x63\xd5\x43\xd2\x2b\x1c\xf5\x97\x7b\x7e\x8a\x3b\x2d\xed\xc3\x38\x68\xeb\x45\x2f\x27\x9f\x83\x81\xf6\xb2\x1f\x4e\x86\x38\x59\xa6\x90\xab\x2f\x9f\x3e\xb9\x79\xfa\x04\x8f\x52\x8c\xa4\xce\x50\x85\x39\xed\x0b\x1f\x86\x1f\xd1\x4f\x3f\xb1\x0f\x2b\xfe\x38\x10\x7d\xdb\x3a\xd8\x7e\xfa\xe4\x09\xfd\x50\x39\xe3\x38\x7b\x48\x45\x15\x9e\x09\x86\xf4\x03\x45\x0c\x7e\xcb\xf8\x9c\x8b\x51\x96\x11\x63\x0d\xd1\x68\x18\xa8\xd2\x4b\xe2\xab\x14\x27\xd5\xa7\x4f\x9e\x88\x11\x8b\xe3\x6c\xa5\x9b\x5c\x4f\xb2\xf8\x9f\x27\xb4\xea\x0d\x9c\x9e\xe4\xed\x47\x7c\x47\x7f\x3c\x7d\xfa\xa4\xa2\x1e\xc7\x9e\x20\xaa\x11\x39\x19\xc6\x49\xd6\x9f\x66\x29\x7d\x43\x96\x4d\x17\x6d\x22\x5e\xf7\xa5\xf4\xfa\xe3\x28\xec\x91\x4f\x2b\xa3\xb0\x27\xbd\x07\x65\x58\x17\x3a\x45\xbe\x92\x52\x2b\xd2\x3b\x05\x82\x3f\xba\x88\x01\x04\xf9\xf1\xf2\xa9\xc0\xe2\x5d\x1c\x7f\x9e\x4e\x50\xe6\xf7\x46\x58\xc2\xe4\xe4\xf5\xe1\x6f\xec\xcc\x27\xde\xed\xbf\xff\xe5\xa3\xed\xfd\xc9\x87\xd7\x1f\x0f\xf6\x7f\xfb\x58\x73\x7d\xa8\xbb\x3e\x34\x5c\x1f\x9a\xd6\xb6\x5d\xed\xc8\x1f\x8d\xb6\xe4\x8f\x46\x7b\xf2\x47\xde\xa6\x18\x9a\x6e\x3c\x9e\x90\x83\xe2\xc8\x1c\x22\xdb\x94\x6a\xb5\x82\x78\xda\x23\x52\x3f\xa9\x95\x17\x00\x16\x2b\x63\x81\x64\x4b\x85\x10\xa2\x09\xa2\x10\xbd\x42\x8d\x76\xe7\x25\x0a\x97\x96\x14\xf0\x42\x46\x44\xaf\x50\xbd\xb1\x6e\x7c\x23\x7f\xc1\x59\x78\x8e\x36\x09\x8c\x57\xa8\xfe\x52\xfd\x4e\xaf\x52\x0b\x6a\x55\x68\xb5\x2a\xfa\x1d\xd5\x66\xf5\x7a\x4f\xaf\x9f\x3f\xde\x3c\x55\x7a\xfd\xab\x3f\xfa\x8c\xde\xec\x56\x1a\xbf\xaf\x57\xd5\xde\xce\x68\x84\x44\xf5\x5d\xa8\xbd\x5c\x68\x04\xa4\x41\x4e\x7b\xf1\x4c\xfd\x08\x86\x06\xa4\xcd\x59\x88\x7e\x47\x95\x59\xde\x21\xf6\xbb\x21\xfd\x6e\x4a\xbf\x5b\x55\xad\xb3\x00\xa5\x92\xce\xd0\xcf\x3f\xff\x8c\xd6\xa1\x64\x3a\x43\x3f\xa1\xda\x6c\x30\xa0\x03\xd4\x69\x6a\x55\xc8\xea\x38\x9b\x91\x81\x4c\x67\xda\x27\xbe\x78\xce\x52\xf8\x3e\x7b\xf9\xd4\xd9\xa9\xf1\x74\x94\x85\x93\x51\xd8\x07\x2d\x81\xd9\xbd\x19\x21\xe3\xe0\x6c\x76\xfe\xd2\xf2\xad\x45\xbf\x35\xac\x1f\xd7\xe9\xc7\xd6\x79\x41\xeb\xe9\xb4\x87\x40\xbe\xf1\xd0\x38\x9c\xa1\x7e\x3c\x9a\x8e\xa3\x54\xa1\x7e\x19\x26\x91\x14\x2a\x01\xf4\xea\x39\xa1\x99\x5a\x9d\x8f\x14\x7b\xac\xd5\x6b\x35\x7d\x68\xc5\x4a\xa6\x83\x55\xc9\x60\x62\x5a\x55\xf4\x95\xfc\xa6\xe3\xed\xa8\x52\x97\xab\xd4\x3b\x52\x95\x7a\xc7\x55\xa7\x21\xd7\x59\xaf\xa2\xbc\x4e\xc3\x98\x75\xc1\x0d\x68\x9d\xac\x60\xa4\xc2\xe8\x52\x1e\x2d\xf2\x58\x7a\xc4\x66\xeb\xd2\xf8\x30\xf2\x6c\xb1\x57\x35\xfe\xa2\xa1\x0c\x69\xe1\x88\x2a\xfc\x91\xd1\x58\x99\x61\x55\x58\xa7\x52\x6f\xce\xd8\x2a\x6c\x55\xa9\x38\x67\x80\x15\x96\xcb\x2a\x16\x8d\x32\x5c\x16\x80\x1e\x18\x27\x26\x27\xfc\x61\x66\x65\x82\x8c\x01\x6c\x2e\xc0\x01\xa1\x4a\x03\xfd\x8e\x82\x33\xf2\xbf\xd9\x3a\xfa\x1d\xcd\x1a\xe7\xe7\xfa\x42\x82\xb2\x21\xfa\x7d\x13\x0a\xce\x42\xa3\x80\xc2\x24\xe1\xe7\x0d\x9c\x69\xc5\xbe\x72\x94\xe0\x3e\xed\x5c\x80\x8e\xfb\x71\xc4\x36\x98\x7c\x57\x3a\xee\x1e\xbe\x27\x7b\x44\x6d\x56\xab\x79\xa8\x36\xab\xd5\xe1\xdf\x06\xfc\xdb\x82\x7f\xd7\x3d\xa0\x05\xf2\x6f\x03\xfe\x6d\xc1\xbf\xeb\xf0\x6f\xbd\x47\xfe\x6d\x76\xf2\xcd\xec\xf9\x73\x86\xd4\x73\xb4\xb5\x73\x42\xe3\xb1\x23\x2a\x0e\x21\x22\x10\x24\x61\x36\x1c\xaf\xf0\x32\xab\x39\x2a\xa4\xf4\x26\x13\x1f\x56\xe8\x83\x24\x61\xac\xe0\x59\x46\xa3\x07\x88\x2e\x7f\x0c\xe2\x63\x9c\xe2\x6c\x03\x39\xb6\x48\x36\x08\x27\x9f\xc3\x09\xb3\xfc\x8d\x07\x28\x3a\x8e\xe1\x34\x36\xf4\x53\xd4\xc3\x38\x02\xef\x00\x76\xbf\xe5\x47\x01\x98\xf0\x05\x61\x80\xa2\x38\x63\x66\x98\x26\x29\xd0\x64\x2e\x1c\x12\x37\x17\xfd\xf8\x19\x5f\x1f\x25\x61\x9c\x1c\x53\x0b\xe0\xcd\xcd\xfc\xbd\x95\x74\xb8\x59\x98\x36\xa7\x66\x07\x54\xf1\x8d\xff\x71\x83\xc3\x4d\x7b\xf3\xf9\x5b\x0b\x7f\xfe\x8c\xaf\x7f\x8d\x13\x30\x62\xfc\x8c\xaf\x57\xae\xc8\x6f\x7b\xb1\x93\xf0\x0b\x66\xa5\xd2\xf0\xe2\x35\x61\x40\x68\x15\xb5\x8a\x96\x91\xf0\x03\x48\x60\x80\x4c\xb0\x7c\xe4\x38\x8e\xf9\x33\x6f\x70\x09\x75\x4a\xb5\x40\xfa\x9f\xf6\x87\x98\x1c\x3f\x10\x11\xa1\x2d\x7d\x48\x8f\xe3\x2b\x02\xbb\xc2\x9b\x59\x22\xbb\xf4\xf3\xc2\x3e\xc8\x70\xed\xc3\xc2\x1b\x95\xc6\x59\x7a\x77\xa6\x2f\xd5\xdc\x44\x94\xa0\x43\x45\x0f\xfa\xf3\x15\xc3\x90\x3d\x5b\xa4\x10\xc4\xc8\x4e\x94\xa7\x83\x64\x2d\x47\xfe\x24\x54\xce\xa0\xce\x39\x1d\x59\x98\x71\xf6\xc6\xc2\x6a\xdc\x0c\x0b\x49\xfb\x89\x01\x1c\xa2\xe9\xe8\x43\x29\xa3\xfd\x03\x43\xfc\x1f\x02\x71\x27\xe6\x6c\x16\x8e\xe3\x0c\x11\x92\x74\x17\xca\xe4\x3d\x40\xdd\x02\x0a\x21\x9f\x4c\x7b\x65\x20\x83\xf8\xc4\x61\x9e\x4b\x7b\x1b\x7c\xc8\x77\x2a\x26\xa3\x9d\x4b\xbb\x98\x5c\x62\x5d\x29\x00\x98\x32\xc8\xec\xf5\x1c\x6c\x0f\xc2\x19\xb0\xed\x22\x6c\x7f\xdf\x04\x26\x7e\xc6\x06\x79\x35\xa7\x8e\xaf\xa8\xc6\x50\xb7\x4c\x36\xca\x27\x1c\x48\x8b\xad\xbb\x9f\x51\x87\xf0\x33\x6d\xc2\xd0\xe6\x26\x6a\xcd\x9b\xb4\xef\x6e\x68\xed\x7d\x76\x8c\xb8\x6b\xcd\x18\xb4\xce\x86\xe4\x1c\xfd\x4e\x64\x09\x73\x11\xcd\xe5\xe6\xb2\x4c\x57\xcc\x66\xc2\xe8\xf2\xad\x85\xd3\x18\xaf\xdd\xcc\x86\x14\xcd\xf9\x8d\x78\xca\x59\x0e\x7f\xe5\xe0\x3a\x32\xc3\x62\x7c\x74\x59\xd4\xb1\x11\x2f\x1c\x19\x79\x33\xff\x28\x20\x1a\x27\x3b\xb9\x5f\xce\xd4\xb2\x82\x9b\x87\xf8\x2b\xd4\x02\x47\x16\xfa\x50\x44\xfb\xea\x5c\x9c\x71\x08\x4c\xd2\x5c\xb0\x23\x05\xc0\x54\xa1\x5b\x5d\x43\x84\x14\x55\xe1\xda\xb1\x94\xce\xd1\xef\xee\xc5\xe9\xf8\x53\x85\x6f\xfb\x0a\xd4\x11\x68\x9e\xa9\x4b\xd1\x3e\x07\x4e\x49\xd6\x93\xa6\x07\x47\xfd\xe4\x7a\x42\x2d\x63\x65\x39\xef\xc0\x43\xf1\x60\x90\xe2\xcc\x98\x19\xba\x46\x82\xb8\x2b\xea\xe5\x85\x3d\x73\xaf\xf6\xf2\x13\x62\xfe\xb3\x9e\xff\x6c\xe4\x3f\x9b\x1e\xb0\x18\xf9\x94\xa1\xe0\x1a\xe0\x45\x71\x25\x5c\xf3\xca\x9f\xa0\x46\x14\x80\xec\xd9\xca\x86\x0e\x21\x86\xd0\xf7\xc1\x19\x05\x43\xe4\x17\x7d\x48\x95\x6f\x6a\xd9\x66\x41\xd9\xa6\xf5\x48\x54\x66\x08\x55\x5a\xf5\x54\x02\x55\x1f\xeb\xea\x63\x43\x7d\x6c\x7a\x42\x61\x61\x6c\xde\xab\xab\x68\x9f\x9c\x7c\xbf\x8b\x31\xb2\x4f\xba\x32\x4c\xd6\x59\xf7\xd0\xdd\xc8\xcd\x46\x34\xec\x40\x50\x5a\xb2\xb6\x0c\xec\x1b\xcc\x62\x85\xc2\x85\x24\x15\xd5\x09\xa6\x16\x1d\x57\x4d\x1a\xac\x73\x78\xfd\xbb\xc2\x6c\x6b\x36\x0d\x50\x5a\xd7\xa7\x43\xab\x65\xcc\x0f\xd4\x6a\xa8\xb5\x1a\x7a\x2d\xab\xb6\x29\x6d\xea\xd3\xa9\xd5\x6a\xda\xd4\x50\x6f\xb5\xb3\x83\xfd\xe8\x2f\x6f\x81\xb6\x13\xc3\xb1\xe5\x8c\x23\xf6\x5f\x3a\xaa\x9b\xa8\xfe\x92\xfd\x7c\xc5\x67\x88\xbd\x70\xec\xbb\x30\xc7\xe1\x20\x03\x4a\xf7\x1c\x8a\xb2\xc2\x89\xe3\xa8\x67\x64\xf2\x24\x75\x4d\x4d\x48\x5e\xbf\x4b\x8a\xae\x4a\x5a\x37\xe4\xae\xdf\x25\xa5\x56\x25\x6d\xe8\x52\xd7\xef\x92\xfe\x2a\x6d\x4a\xaf\x8d\x6d\x78\x69\xc9\xb6\x01\x00\x72\x75\x15\xb9\xba\x03\xb9\xc6\x1c\xe4\x9a\x85\xc8\xd5\x6e\x89\x5c\x43\x45\xae\xe1\x40\xae\x39\x07\xb9\x5a\x21\x72\xf5\x5b\x22\xd7\x54\x91\x6b\x3a\x90\xab\xcd\x41\xae\x5e\x88\x5c\x63\x2e\x72\x56\xd2\xfd\x30\x01\x1b\xa2\x34\xf3\x33\x6c\x16\x00\x76\x92\xd5\x2c\x1d\x03\x96\x91\xe9\x7a\x34\xf8\x42\xe6\x22\x6b\xd8\xbe\x90\x81\xc8\x74\xed\xb8\x55\x89\x62\x5d\x4f\x73\x78\x1f\x2c\x9f\x0a\x3d\x79\x48\x6b\x47\x3f\xb5\x58\x96\x8f\x7e\x6c\x31\x57\x90\x72\x6e\xc9\x97\x50\xb5\x1c\x25\x88\xf5\xc3\xb1\xab\xbb\xb1\x33\xd7\x8f\x81\x9d\xb1\x84\x54\xec\x6a\xb7\xc1\xae\x21\x61\xd7\x70\x63\x67\x2e\x20\x03\x3b\x63\x0d\xa9\xd8\xd5\x6f\x83\x5d\x53\xc2\xae\xe9\xc6\xce\x5c\x41\x06\x76\xc6\x22\x52\xb1\x6b\xcc\xc7\xce\xa4\x56\xcc\x03\x5b\xdb\xe5\x12\xba\x0d\x5b\xd6\x91\x2e\xe4\x18\xcb\x49\xdd\x5c\x2d\xab\xca\x10\x7d\x9a\x2e\xd9\x87\x1d\x85\x37\x50\xa3\xdd\x59\x6d\x36\x98\x06\xba\x6a\x53\x05\x73\x89\x45\x08\x48\x29\x73\x1c\x66\xaa\xe1\x67\x29\xcb\xf7\x84\x20\x85\xf7\xc0\xef\x63\xa1\x23\x16\x40\xfe\x1b\xcf\xfc\xf1\x44\x9c\x94\xf3\x0f\x7c\x4e\x29\xac\x0c\xcf\x32\xe9\x76\x7b\x65\x6b\xe7\x64\x85\x9d\x23\x2a\x63\x6e\x91\xfe\x19\x5f\x7b\xa8\x3f\xb8\x10\xd2\x7c\x0e\x65\x32\xf2\x09\x12\xb3\x0c\xe9\x50\x98\x84\x5f\xc9\xdb\xb1\x01\x62\x3a\xed\xae\x45\x89\xfd\x91\x46\x4d\xdd\xc3\xa3\x09\x4e\x2a\x5b\x3b\xf4\x5a\x9f\xea\xec\x9f\x3e\x61\x36\x2b\x72\x93\x2f\x9f\x3e\x85\x08\xb8\x60\x40\xa2\x58\x15\x6c\xb4\x1b\x1e\xb7\x4b\xd8\x68\x83\xed\x88\x64\x99\xb0\xd1\x6e\x79\xb9\x49\xc2\x46\x1b\x5c\x18\xc7\x41\xfb\xc7\x8d\x4e\xfd\xe6\xdc\x6b\x37\xee\x64\x2d\xf2\x2d\xcd\x44\x1e\xcc\x98\xe3\x1b\x9a\x65\xd0\x95\xf0\x1c\x31\x03\x0a\xd2\x3c\xea\xc7\xe3\x49\x1c\x41\xc8\x75\xf2\x6d\xf5\xe9\x13\x31\xef\xa3\xb0\xb7\xc2\x8a\x7e\xfd\x2a\x1b\x00\x08\xa7\xcf\x7b\x36\xee\xf0\x53\x9c\x5b\x75\xf8\x29\x96\xbe\xfd\x1a\x27\x01\xb8\xa5\x8b\x02\xe2\x8d\x0c\x61\x3a\x00\x7b\x31\xa0\xf5\x2d\x7e\xcb\x93\xc3\xb4\x7e\x56\x30\xc3\xe0\x59\xd5\x25\x0b\x55\x7a\xff\x21\x1b\xac\x03\x14\x1c\xf5\x57\xc8\x83\x86\x75\xa7\x25\xbe\xd2\xc7\x22\x43\x14\xf1\x65\xe7\x72\xf2\x76\x7b\x37\xbf\x6c\xa2\xcf\xd6\x1b\xac\x5e\x4a\xcd\xf3\xc8\xb2\xe2\xb7\x58\x19\x1e\x4f\x46\x7e\x66\x63\x50\x22\xc8\xf4\x1f\x11\x0b\xc8\xc3\x35\xa8\xe0\x54\x20\x78\x1d\xe8\xfd\xc2\x2f\x78\x85\x07\x98\xdc\x40\x2d\x54\xa9\x37\xd6\x51\x2f\xcc\xd2\x6a\x11\xc0\xf0\xd2\x02\x6f\xff\x97\xdb\x82\xfb\xb8\xf3\xbe\xfb\xf1\xb7\xdd\xc3\xe3\x83\x8f\x07\x87\xdb\x3b\x68\x0b\x42\x1b\x64\x7e\x94\xa1\x04\x4f\x12\x9c\xe2\x28\x0b\xa3\x0b\xae\x88\x21\x64\x38\x8e\x83\xbc\xef\x56\x98\xdb\x3b\xa5\x60\x32\x76\x6a\xc0\x94\x2e\x05\x35\x93\x23\xf1\x68\xa7\x28\xcb\x25\x61\x3e\x9b\x14\xdd\x2e\xb8\x7d\x4f\x13\x30\x78\x10\x39\x3e\xe4\x22\x4a\x71\xa9\x77\x82\xee\xc9\x1c\xa0\xd3\x21\x26\xa3\x9e\xc5\x68\xca\xdc\x04\x08\x0b\x40\xa4\x30\x80\x56\x40\xae\xe6\x0f\xfd\xc1\xc5\x06\x90\x2e\xc7\xb5\x2a\xef\xa8\x06\xb6\xb0\x5d\xa4\x14\x36\x23\xbf\x30\x72\x4d\x86\x0d\x7d\x6a\x8f\x29\xe1\x4e\x48\x8f\x20\xff\x19\x5f\xaf\x58\xcb\x72\xcf\xd0\xfe\xe0\x02\x55\x0e\xa1\x15\x7f\x54\x85\x3a\x7d\xdb\xe0\x95\x1c\x03\xb5\x2d\x1e\x47\x94\x4e\xe8\x0d\x21\x11\xde\x3b\x42\x28\xfd\xa2\x3e\x91\x73\x45\xd8\x77\x7f\x57\xa5\x04\xb3\x00\x52\xa4\x05\x79\x8f\xe7\x57\xcf\x2b\x74\x9b\xde\xa1\xc3\x1c\x27\x15\x76\x79\x06\x43\xe8\xa1\x3f\x50\x78\xb9\x81\xc2\xcb\x9c\x37\xde\x28\xa6\x07\xca\x7c\xab\x90\x36\x94\xb0\x50\x4c\x72\xd0\x35\x00\x72\xe2\x10\x5a\x9f\xdd\x38\xab\x6b\xd5\x22\x7b\xe8\x12\x5a\x49\x7a\x72\x2c\xc4\x47\x7a\xba\x5f\x7a\xda\xc6\xf7\x45\x4f\x02\xd2\xdd\xe8\x49\xe5\xd3\xb7\xa0\xa7\xfd\x28\xcc\x42\x7f\x14\x7e\xc1\x29\xf2\x51\x84\xaf\x46\xd7\x0c\xc3\x80\x0d\xc7\x7c\x5a\xe2\xbb\xc6\x6c\x10\x27\xe3\x83\x38\xc0\x68\x87\xfa\xaa\x41\x98\xe6\x9c\xd3\xc5\x89\x4c\xa7\x60\x5d\x0d\x6e\x7e\x9c\x6a\xc5\x26\x63\x27\xc3\xef\x8e\x64\xef\x8d\xac\x2a\xe6\x07\x1b\xa7\xb8\x25\xc1\x85\x51\xa8\x58\xd8\x88\x69\x92\xc8\xc5\xa2\xa2\xde\x9a\x4c\x08\x2d\xc0\x68\xf1\x6c\xd3\xa9\xe5\x9a\x81\x0c\xf1\xa6\xf8\xc9\x37\x45\x4a\x83\xe6\xa9\x38\x23\x92\x33\x35\xac\x8f\x93\x31\x9d\x76\xdf\xa6\xbb\xa1\xf4\x9d\x93\xd4\x66\x4e\x5e\x2f\x6d\x25\xa9\x1d\x0d\xd8\xca\x58\xcf\xe2\x21\x25\x74\xea\x01\x60\xeb\x07\xd8\x17\x55\x4a\x2f\x1c\xb0\xd1\x51\xf9\x30\xc4\x72\x48\x45\x4b\xa0\x3d\xbb\x23\xf9\xb0\x25\x68\xe2\xa6\xcc\x70\x52\xc6\x88\x8a\x1a\x15\x05\x7e\xe6\xa3\x1e\xc8\x5e\x6a\x09\x87\x3c\x06\xa0\x69\xa6\x0b\xee\xed\xac\x03\x3e\xc2\x09\xcc\x65\x3f\x8e\xfa\x09\xce\xf0\x32\x1b\x8e\x51\x7c\xa1\x30\x65\xe9\x5e\xea\x78\xb1\xb1\x86\x78\x1a\x80\x39\x75\x6f\x61\x3c\x05\x07\x12\x4b\xc1\xc1\x02\x9b\xde\xd7\x94\xb9\xc2\x10\xa0\x4c\xd9\x49\x78\x03\x6f\x83\x35\x20\x81\x2f\xb1\x73\x49\xfc\x49\xc0\xa2\x41\xb3\x58\x30\x82\x30\xba\xb8\x07\x6e\x92\x77\x7e\x93\x93\x07\x83\x5f\x79\x46\xda\x7c\xa6\x92\x49\x99\x7a\x57\x1c\x73\x27\x85\xb1\x92\x1b\x5a\x94\x57\x3a\x74\x0e\xee\x81\xa3\xc0\x36\xfb\x3e\x7c\x91\xab\xdb\x68\x8a\xb6\x87\xfc\x4b\x3f\x1c\xf9\xbd\x11\xa6\x66\x88\xa9\x7b\x5b\xfc\xc8\x3b\x53\x9a\xaa\x76\xc3\x88\x6d\x7c\x85\xfb\x14\x83\xab\xee\x33\xef\xe3\x8c\x79\x47\xd3\xa0\x69\x14\x52\xbe\x6b\xa0\x30\x45\x78\x30\xc0\xfd\x2c\xbc\xc4\xa3\x6b\xe4\xa3\x00\xa7\x59\x32\x85\x67\x0f\x25\xd8\x0f\x96\xe3\xa8\x8f\x4b\xed\x33\x65\xa9\x17\xd0\x78\x28\x1a\xa6\xc0\x1f\x9a\x92\xf9\x48\x56\xca\x13\xb1\xa8\xb2\x28\xf5\x8b\x8a\xf3\xc9\x9f\x17\x2d\x4f\xff\xbb\xf9\x5c\x4c\xa1\x90\x5a\x22\x1c\x14\x02\x40\x85\xab\x45\x29\x6a\xb9\x28\x59\x80\x21\x43\x3c\x24\x82\x2a\x5b\x70\x38\x60\xf1\x32\x39\xa7\xde\x95\x26\xc4\xba\xf8\xcc\xda\x73\x95\xcd\xf5\xc6\xfa\x6a\xb3\x21\x7f\xa2\x2a\x11\xdb\x17\x4d\x0e\xda\x40\x75\xe5\xab\x2a\xff\x6e\xa0\x46\x99\xb3\x53\x6a\x55\x65\xfb\xf3\x15\xd9\xc8\xb9\x36\xf9\xa9\x85\x8d\xf4\xe9\x10\x4b\x42\x01\x4b\xb4\xe5\xa3\x21\x68\x8d\x89\x90\x59\x62\x29\x72\x11\x76\x2b\xe2\xf8\x40\x80\x01\xbe\xac\x89\xd0\xc4\xd6\xb5\xa5\x43\xdf\xe0\xb0\xc4\xac\xbd\x4d\x95\xa7\xa6\x23\x37\x64\x5b\xe7\x2a\x53\xea\x6d\x38\xfd\xa6\xc8\x9f\xf8\x94\xe2\x11\xee\x67\xb4\xe1\x93\x2c\xf1\x33\x7c\x71\x5d\x71\x99\x6b\x4b\xda\x67\x10\x17\x37\xd1\x33\xca\x4a\x9f\x39\xcd\xc3\xd8\x6c\x1c\xf9\x69\x4a\xd8\xc4\x6b\x3f\xc5\x81\xe2\x31\x27\xff\x15\x1b\x87\x31\x50\x27\x38\x81\x03\x17\xd9\xd5\xdc\x90\x8a\x17\xb9\x9e\xdb\x8f\xdd\x67\x14\xd8\xa8\xbb\x90\x62\xe4\x24\x33\x36\xf3\x86\xa5\xc8\x6e\x34\x0f\x02\x66\x9f\x07\x71\x71\x43\x51\xf4\x90\xfb\x02\x47\x1f\x03\xcf\x61\xe9\xc9\xc8\x7e\xc3\xe8\xbf\x76\x9f\x73\x27\xb4\xd5\x9b\x22\x0f\x15\xde\x18\xe9\x98\x5b\x26\x54\x67\xdb\x32\x97\xac\x54\x99\x86\xd7\x7e\xf5\xa6\xea\xb0\xd3\x2c\xc1\xfe\xf8\x56\xaa\x6c\x90\xa1\x98\xf2\x59\xb6\xc1\x6f\x36\x96\x7b\x21\x35\xd8\x56\x4f\x34\x54\x3a\x81\x30\xd6\x92\x66\xba\x8e\x2a\xcd\x86\xaa\x98\x96\x14\xbe\x27\x80\x9f\xa6\xf6\xd5\x5f\x16\x78\x84\xec\x5a\xf6\x5a\xdb\x0e\xcb\x45\xc4\x89\x9f\xc0\x71\xcb\x26\x20\x9a\xdb\x1b\x1c\x6f\x72\xeb\x2a\x2e\x34\xfe\xf0\xc3\xb3\xc1\x68\x9a\x0e\x9f\x95\xdb\xe6\x28\x14\xd7\x46\x27\x86\x79\x03\xd5\x8b\xe6\x15\xce\xb5\x90\xd5\x74\x22\xdf\x96\xca\xca\xf3\x8f\x63\x7a\xf6\xed\xae\xb0\x1f\x7f\xdc\xcc\xa7\x10\xc5\x63\x07\xea\x19\x54\x22\xb5\x21\xdd\x6e\xb2\x83\xb6\xe1\x1c\xcc\xde\xcb\x4a\xef\x22\x05\xbd\xac\xa2\x1c\xf3\xe4\x5c\xb9\x7c\xbd\xf0\x6e\xba\xa5\xf6\xc8\xaa\x10\xd4\x33\xcb\xe4\x0a\x7e\xa0\xea\x6f\xb0\x1f\xf2\x99\xe2\xdb\x1d\xe8\x61\xbb\xaf\xbb\x86\x2a\x9a\x73\x94\xf0\x92\x7a\xed\xdc\x46\xf3\x9c\xc3\x28\xd4\x15\x8a\xba\x5c\xd1\x24\xd5\xbb\x95\xc6\x59\x4c\x67\x7e\x40\xfa\xcf\x9c\xce\x5c\x13\xbc\xe0\x74\x5a\x15\xbf\x25\xa7\x53\xd4\xbd\xc3\x74\x16\x29\x7c\xcb\x5d\x1d\x7c\xd3\xe9\xbc\xf3\x74\x15\x2c\x81\x39\xf3\xa5\xeb\x4d\x0b\x26\x89\x6e\x26\x42\xcf\xdb\xb7\x89\x75\xcc\xea\xfa\x12\x6d\xa2\xf0\x52\x9e\xad\xa2\x2d\x82\xed\x98\x34\xae\x74\x77\xe8\x87\x11\xa4\x3c\x71\xdd\xb5\xbe\x06\xbb\x81\x8f\xbc\xf3\x68\xd3\x1d\x7c\x40\x57\xb1\x29\x3b\x08\xa9\x6b\x10\x83\x34\x34\x79\x63\xda\x2e\x21\xee\x44\x5f\x16\x71\x94\xd7\x5d\xbe\x1d\x68\x27\x21\xa9\x09\x65\xee\x48\xaf\x5e\x77\x2d\x7b\x8f\x09\x9e\x36\x71\x24\xc2\x7f\x66\x5c\x8d\x41\xa9\xd4\xcf\x98\x51\xf7\x8a\x5e\xc7\x80\xa1\xd1\x2c\x95\x8e\x84\x56\x84\x09\x4b\x31\x97\x91\x90\xca\x09\x91\xf5\x86\x84\xd9\x65\x11\x20\xec\xe7\xd5\x10\xb3\xc8\xfb\x14\x3f\x08\xe4\x99\x96\x40\xce\x5c\x18\xf6\x82\xe4\x0f\xa6\x92\x89\x3a\xd4\x1b\x00\xd2\xe3\x41\x17\x84\x6b\x83\x2e\xcb\xca\x93\x81\x72\x15\xa0\x61\x26\xaf\x42\x71\xda\x42\x5b\x1d\x60\x91\x7e\x43\x22\x2f\x24\x87\xe1\x6c\x2e\xc4\x0a\x4d\x8e\x78\xe5\x30\x67\xfd\xed\xf0\x18\xce\xcb\x8c\xe8\xcc\x32\xb3\x38\x81\x7e\xe5\x8a\x6e\x0f\x29\xfd\xf2\xf2\x66\x6d\x42\x3f\xc3\x43\xf6\x75\xa9\xe8\xa3\x6b\xc5\xec\x18\x8f\x31\x48\xe1\xb0\xbb\x52\x12\x60\x57\x51\x70\xda\x07\x87\x76\x78\x6d\x56\xe7\x12\x2c\xbe\xe4\x61\xe7\x29\x33\xa5\xf9\xe4\x39\xde\xc2\x14\xd0\xd9\x01\xd9\x73\x67\xee\xba\x0d\x70\x89\x75\x2b\xf6\xa9\xc7\x75\xfb\xb8\x6e\xd1\xed\xd7\xed\x5d\x56\x07\x58\x08\x0f\xc3\x74\xe1\xb5\x61\xc5\x84\x51\x34\x70\x91\xdf\x0e\x8f\x9d\x1c\x40\xf6\x20\x33\x38\xc0\x5d\xd9\x8e\x15\xb3\xd3\x7c\x68\x7a\xb8\x1f\x8f\xd9\xd2\x21\x6c\x21\x8c\xa7\x69\x79\xe6\x21\x06\xab\x2c\x7b\x10\xa4\xc4\xbb\x51\x71\xe2\xbe\x90\x07\x14\x88\x48\x5c\x5a\xb2\x79\xf8\x0f\xe3\x38\xc5\x68\x1c\xce\x88\x2c\x64\xe9\x1f\x78\x82\x9a\x42\x1a\x92\x09\x91\x49\x61\x2e\xb2\x8b\x2f\x41\x3a\x25\x27\x9d\x74\xda\x4b\xf1\xff\x4e\x71\x94\x59\x55\x0c\x48\x15\xed\xa4\xac\x1e\xea\x28\x3a\x55\x83\x32\x4a\xda\xac\xcc\x57\xf5\x93\x9d\xcd\x86\x95\x2d\x46\x52\xbe\xda\xac\x91\x92\xc8\x1f\x4c\x60\x6e\x3d\x1e\x9e\xa3\xdf\x37\x69\xbd\xb3\xb0\x30\x74\x49\xfe\x9b\x9b\x40\xbf\xee\xb2\xf2\x4a\x40\x13\x49\xb4\x3d\xf2\x83\x80\x4c\xe0\x1c\x05\xc8\x04\xb2\x5c\x75\x57\xe8\x7f\xed\xea\x8f\xa3\xb7\xdd\x13\xf4\x7f\xda\xab\x6b\x68\xc2\x80\xa6\x4c\x97\x67\x83\x79\xf4\xb9\x9f\xae\x81\x9c\x3c\xf1\x83\x15\xfe\x54\x20\x1b\x1f\xf9\xfc\xfa\x79\x9a\xf2\xd0\xf9\x22\x10\x0a\x33\x57\x86\xb8\xc9\x02\x8f\x85\xec\xaf\x00\xb2\x7c\xfb\x4c\xd0\xb2\x56\xb2\xeb\xf1\x58\x08\x28\xe9\x3e\x12\x00\xa5\x22\x98\x25\x19\x14\x08\x67\xf9\xc0\xc7\x66\x71\xf8\x12\xe3\x4a\x7e\xe5\xd7\x6b\x9e\x16\x37\x4b\xb9\x60\xf6\x03\xfd\x72\xed\xd6\x0c\x44\x54\xa3\xb1\x4e\x36\xa5\xf1\x72\xc5\x0c\x99\x46\x99\xa0\x1d\xf0\x2b\x32\xa1\x46\x8c\x60\x0d\xa0\xf4\xc5\x32\x4d\x39\x2d\x22\xac\xfc\x43\x2b\x60\x6b\x96\xde\x0b\xf1\x76\xcd\xd0\x0b\x34\xd3\x1b\x7c\x25\xf4\x02\x11\x50\x14\x2c\x72\x5f\x17\xe3\x3d\x73\x70\x31\xde\x83\x5b\x8b\xf2\x76\x2e\x66\x85\x48\xa5\xc5\xe1\x0b\x72\xf6\xa3\xb6\x89\x42\xb4\xe4\x72\xcb\x97\xa1\xd3\x30\xf7\xd2\x9b\x02\xe9\x55\xc3\x0e\x6d\xe6\xb6\xef\xfc\xf0\x2f\x83\xf6\x54\x94\x6c\x66\x08\x5b\x41\x60\x1f\x04\x98\xeb\x7e\x1c\xf5\xfd\x8c\xc3\x2c\xad\x81\xf9\x10\x4d\x04\x43\x81\x25\x3b\xf4\x03\x1a\xc8\x88\x2d\xd4\x6f\xc3\x65\xa6\x91\xce\x67\xbe\x09\x47\x80\x66\x4b\x5c\xb9\x43\x39\x9d\x25\xd8\xf8\xc0\x1b\x9c\x29\x89\x8b\xa5\x45\x0c\x31\x60\xd1\xc8\x4f\x33\x78\x9e\xbf\xa6\x73\xf1\xfa\xac\xa2\x2e\xe7\x65\x54\xaf\x52\x17\xb3\x73\xe6\x0c\x66\xf3\x24\xa6\x82\x83\x9b\x62\x72\x70\x9b\xfa\x1a\x94\x36\x53\xba\x6d\x2e\xa8\xe7\xff\x33\x2e\x82\x6c\x2e\x0a\xf6\x9b\x05\xdb\xad\x42\xd1\x3d\xd0\xfd\x19\xfd\x1f\xc4\x01\xbe\xa1\xea\xc1\x53\x71\x5a\xa3\x97\x22\x70\x92\x90\xba\xd3\x7d\xdd\x75\x41\x61\x73\x75\x23\xe8\x8b\xc0\xd2\x85\x0d\x13\x22\x90\xbc\x83\xc0\xc1\x8f\x80\x0d\x80\x64\x38\xa9\x11\x38\xc1\x14\x30\xf3\xb4\x53\x1d\x6d\xdb\x68\xe2\x46\xf1\x46\x58\xc0\x30\x90\x4e\xb4\xfa\xb1\x2b\x59\x1f\x16\xdb\x00\x16\x04\x38\x53\xed\x43\x2d\x7e\x9c\x20\x37\x93\x11\x50\xd4\xa2\x48\x55\xec\x92\xef\x63\xb0\xfd\x74\xe0\x9f\x4f\xac\x79\x18\x30\x6c\x49\xb9\xa4\xad\x1a\x97\x38\x4f\x0c\x04\x2a\x6c\x89\xa0\xd1\x80\x53\xb9\x76\x37\x63\x97\xf6\x57\x9f\x17\x37\xaf\x5a\xaf\x54\xd1\xf3\xd5\x85\x31\x10\xaa\x16\xc7\x59\xe6\x2d\xc6\x13\xe4\x67\x68\x84\x09\x17\x8c\x23\xbe\x02\x58\x96\x0f\x6a\x09\x0a\xfb\x35\x30\x5c\x93\x6f\x21\x71\xbe\x19\x87\x11\x35\x12\x65\x87\x78\x23\x5c\xa2\xfa\xc8\x2a\xd1\xe9\x93\xf0\xa7\x84\x34\x06\xfb\x63\x7a\xe4\x0d\x2f\xd1\x4f\x3f\x59\xf5\xf1\x7a\xa0\x8e\xa3\x5b\xe9\x32\x72\x4c\x54\x65\x8a\xf3\x7c\xae\x37\x5b\xf6\x4a\xda\x2d\x92\xe6\x22\x89\x30\x94\x66\xaf\x2c\x04\xcd\x9b\xbb\x5f\x42\x5e\x5d\x25\x07\x19\x9a\xee\xcb\x25\x72\x81\xbc\xce\x4c\xbf\x40\x02\x87\xdf\x73\x75\x10\xfc\x2a\x9e\xda\x08\xba\x4e\xc9\xb7\xba\x8c\x7f\xb8\x65\xf5\xb0\x78\x5b\xdb\x03\xc9\x6f\xce\x0c\x50\xf9\xc8\xd6\xde\x3c\xcb\xbf\x3b\x5a\x2a\x80\xe9\x1d\x93\x3d\xec\x66\x28\xa8\x1f\x8f\x46\x98\xd2\x7f\x3c\xe0\xa2\x01\x88\x9a\x18\x72\xe9\x15\x89\x1e\x92\x28\x2a\x39\x79\x93\x6d\x34\xf1\xaf\xa4\x57\x56\xbf\x44\xbb\xeb\x07\x75\x40\x17\x42\x4a\x99\xda\xf9\xc5\x23\x64\x78\x60\x5c\x90\xd6\x27\xeb\xd3\x30\xc7\x75\x01\x4a\xfd\x11\xc5\x1e\x7e\x00\x30\x50\x49\xfa\x34\xfc\x28\x4e\xc2\x4b\x2a\xab\x70\x8e\x61\x05\xc8\xaf\x52\x73\x39\x5f\xb2\x1c\x34\x63\xad\x96\x93\x6b\x6e\xd3\xb3\x62\xf9\xa6\x3f\xc4\xe3\xdb\xc1\xb5\x0b\x9c\x4c\x65\x0e\x16\xd3\x03\x09\x9e\x15\x04\xcd\xc9\x78\x93\xe7\x6c\xa4\xa7\x18\x2a\x62\xf1\xb7\xba\x18\xd6\x8f\xa3\x4b\x9c\x64\x8a\x0c\x4b\xb3\xdd\x71\x63\x4a\xb0\xf8\xa4\xd6\x7f\x6e\xb7\xd5\x23\x5a\x45\x75\x5e\x15\x2f\x4b\xda\xc3\xcc\x77\xb1\x52\x51\x9b\x7f\xac\x13\xde\x4d\x32\x3e\x9a\x9d\xa8\x1f\x89\x24\x56\x93\x38\x4d\xc3\xde\x08\xbb\x57\xac\xa5\xa9\xc5\x9c\x9b\xf2\x81\x32\xed\x41\xe9\x37\x7e\x02\xff\xc3\x80\x82\x84\xfa\x9c\xac\xe0\x0d\xe9\x77\xee\xf0\x64\xad\xf4\x19\x5f\x6f\xa8\x7e\x51\xd6\x62\x9a\xa7\x94\xbd\x10\x59\xc6\x1b\xf0\xef\x9c\x82\x62\x55\x6e\x98\xee\x5c\xf6\x1a\x4c\x84\xd7\x2d\x13\xec\x85\x85\x5c\xaf\x1e\x9d\xdf\x76\x4f\xd6\xec\x15\x24\x16\xde\xb2\x97\x10\x0b\x47\x02\x4a\xdf\xad\x1c\x4e\x70\x74\x72\xf2\xce\xa8\x56\xde\x99\x4c\x9e\x7e\xbb\xe0\x35\x0e\x67\xfb\x91\x5a\xae\xb4\xe9\x11\x5d\xc5\xe9\x62\xcb\x18\x39\xd7\x8d\xc9\x4a\x34\xdf\x40\x07\x37\x21\x87\x3a\x37\x70\x6e\x60\xcb\xbd\x32\x60\x57\x80\xdf\xe1\x20\xd4\xd7\x78\x01\x1c\x48\x02\x96\xd2\x0c\x60\x90\x3d\x0e\xe7\x5e\x94\x39\xc6\x51\x4c\xdf\x68\x0c\x90\xe5\xec\xc7\x45\xdc\xa3\xec\x92\xa6\xc8\x8b\x6b\x3a\xb6\xb6\x97\xd0\xb3\x67\x76\xdf\x0a\x6b\xf9\x95\x2c\xa6\xf9\x86\x5c\xae\x1c\x73\x6a\x39\x48\xd5\x49\x98\xbc\xa2\x4c\x9c\x62\x6c\x5c\x56\x55\x79\x09\xf4\xf5\x2b\x25\xd7\xbc\xce\x0a\x9f\xc4\x6b\x7e\xec\x35\x74\x34\x56\x39\x89\x52\xd9\xbc\x7b\x0d\xda\x0e\x5c\x6d\x88\x9f\xf6\xdb\x0d\xd6\x73\x1b\x71\xda\x40\xb3\xe2\x22\x95\x31\xec\x5e\xea\x20\x16\x5f\x77\x88\x55\xe7\xbb\x97\x5c\xc4\x9b\x59\xee\xc7\xe3\x89\x9f\xc1\xf6\x52\x76\x19\xca\xdb\x82\xb6\x89\x49\xe2\x4f\xd9\x3d\xd1\xb6\xfc\x6e\x83\xdc\x7d\x19\x0e\xc6\xb4\xed\x13\x4e\xde\x0e\x42\x96\xa8\xcb\xc5\x1b\x15\xfa\x16\xc5\x4b\x73\xdf\x39\x6a\x19\x39\xd2\x92\xb2\x04\xf3\x2f\xb6\x40\x8d\x44\xdc\xd5\x2a\x90\x77\xb6\x63\x2c\xf4\xd7\x3c\xc4\x92\xe2\x4e\x55\xcb\x95\x14\xad\xc6\xd0\xde\x9f\xd5\x66\xed\x66\xa7\xde\xe9\xaf\x41\x62\x83\x4e\xbb\xd3\x6a\x0f\xda\x83\xf3\x2a\x57\xc5\x03\x68\xfe\x90\xf7\xc3\x71\x8e\x2c\x81\x82\x73\x2c\x1c\x87\x2f\x51\x37\x67\x64\x34\xac\xcd\xe2\x7b\x5e\xd1\x1a\x93\xfd\x95\x16\x15\x1e\xf9\x3a\xc9\xe9\xf4\xd6\x4b\x46\x8d\xd9\xc0\x17\xf4\x2d\xd6\xf0\xfd\x06\x70\x30\x85\x51\x6d\xe9\x4d\xfc\x24\xc5\x15\x65\xa1\x16\x5c\x4c\x26\xa9\xa2\xf8\xc9\xab\x59\xbd\x12\x48\x71\x44\x63\x78\xcd\x59\x74\x94\x30\x0c\x64\x8a\xd4\xab\x45\x10\xf9\x65\x9c\x74\x18\x66\x49\x21\x0c\x70\xa7\x38\xcd\xa8\x6d\x83\x3f\xb2\x2c\x50\x0d\xe6\x59\xed\x1c\x6d\x6e\xa2\x7c\xed\xa1\x9f\x7e\xd2\xdb\x3d\xab\xb3\x32\x7c\x4d\xba\x54\x50\x3b\x33\x7a\x81\x61\xb6\x8c\x54\x0e\x63\x2c\x7e\xad\x45\x66\xca\xd3\xf0\x50\xab\x5a\x60\x5d\x17\x5f\xb2\x23\x3a\x5c\x05\xe5\x30\xcc\xf2\x06\xfc\x09\x34\x50\xd3\x6f\xad\x8d\xe2\xca\xad\x4e\xbd\x53\x8e\x51\x58\x8f\x46\x8e\x63\x90\x27\x9d\x4e\x54\xd1\xbc\xf0\xae\x88\x2f\xc2\xab\xc4\x9f\x4c\x40\x8e\xf4\x33\xd6\xbc\xac\x32\x41\x3e\xd9\xe9\x53\xc9\x2b\xad\x70\xf5\x2a\xae\x3e\x86\x2b\x5b\xee\xf0\x63\xfb\x54\xd6\x81\xe4\xd6\x97\x3d\x42\xe8\xe1\x32\x7e\x91\x54\xcf\x75\x04\x72\x6f\x59\x67\xa9\x43\x68\x14\x50\xaa\x11\x07\x8c\xfc\x62\xc7\x72\x70\x2a\x0a\x11\xa5\x7b\x2f\x02\x42\x1b\x86\xa8\x26\x4d\x6c\x61\x50\x29\x76\xed\x40\xe6\x8d\x79\xd3\xdd\xc5\x43\x35\x57\x3e\x59\x8e\x3a\x05\xde\xe7\xac\x69\x6a\x83\xc2\x7e\xe7\x7e\xe7\x7f\x91\x18\x2e\xf6\x2d\x6c\xeb\xcf\xdd\xc0\xc8\xb2\xb4\x6b\x54\xcc\x65\x25\xfc\x2b\x4d\x6d\x84\xe2\x6a\xe9\x38\x85\x3d\x5c\x83\x79\x90\x1a\x5d\x9d\xf0\x4d\x1b\xf7\xc4\x6a\x73\x48\x03\x05\xca\x0e\x8b\x73\xac\xdb\x8b\xf5\x76\x21\x74\x16\x8a\x9e\xb3\x63\xb3\x5f\x97\xa2\x1b\xc4\xb9\xf3\x89\x2d\x00\x9a\xd5\x67\xd5\x10\x4b\x72\xcf\x0c\x11\x20\x81\x75\xf6\x36\x92\x49\x17\xfa\x97\xc3\x84\x2b\x60\x03\x0a\xb3\x37\x22\x1c\x57\x38\xe6\xba\xf6\xa3\xf2\xdb\x69\xd1\xa6\xad\xec\xaf\x66\x41\xae\x5a\xb4\x7c\x22\x64\x25\xfa\xb6\x12\x5e\x5a\x8a\x48\x3a\x42\x46\x2f\x66\x19\xaa\x15\xcc\x01\xc1\x85\xa8\x59\x4c\xe8\x03\xf3\x92\xec\x95\xa5\xb0\xa4\x0b\xd4\x2d\xac\x2d\xa5\x25\xbd\x20\x21\xbd\x81\xe5\xb8\x76\x53\xfa\xd8\xc2\xee\xa1\x53\x31\x71\x42\xf1\x25\x5f\xcb\xa0\x07\xdb\x9e\x64\x02\x10\x3b\x94\x76\xd1\x24\x3d\x42\x6a\xef\xbf\xe2\x3e\xa5\x05\x68\x11\x91\x8e\xbf\xc1\xde\x94\x47\x55\x9e\xcf\xa6\xb9\xf7\xbc\x85\x4d\x73\xb2\x63\x61\x14\x24\x8f\xfa\x5b\xb3\xec\xfb\x46\x51\xdf\x97\xee\x71\x4b\x71\xc6\x2e\x70\x44\x18\xf8\x06\xbb\x0a\xd3\x38\x48\xaa\x05\x79\x31\x69\x80\xe5\x9d\x82\xdd\x7e\xc3\xf9\x55\x46\x3e\xe7\x26\xb6\xe6\x18\xa7\x30\x37\x0c\x79\xf2\x94\x4d\x4c\x89\xba\x48\x87\x25\xdf\x9b\x24\x26\xa3\x28\x7c\xac\xdb\x84\x68\x62\x61\x6d\x8c\x95\xad\xe9\x63\xa5\xde\xbf\x80\x8e\xc9\x4f\xd3\xe9\x18\x07\xea\x7d\xa2\x3f\x4a\xb0\x1f\x5c\x4b\xfb\x9d\x72\x20\x9b\x46\x34\x6d\x65\x89\x88\x66\x8b\xb1\x3d\x3b\xff\x5a\xe8\xd0\x44\x18\x17\x98\xa8\x27\x29\x5e\x98\xd7\xbb\xf5\x45\xd3\x68\x51\x58\x7f\xa2\xc4\x6d\x90\x3c\x55\x21\x1d\x72\x2a\x40\x82\xf8\xed\x3c\xe0\xa3\xa1\x53\x92\x57\x0f\xab\x6c\x4b\xe5\xcd\x62\xd7\xc8\x8b\x70\x4e\x08\x1b\x6e\x13\x42\xd9\x93\xb9\x54\xf5\x8b\x0d\x54\xa8\x1d\x65\xd0\x0a\x94\xa2\x86\x66\xc2\x7a\x43\xf2\xd6\x6e\x22\x31\xef\xca\xe4\x73\x30\x80\xfb\x12\xfa\xdf\xe2\xcb\x92\x79\x56\x18\xe6\x85\xc9\x5b\x0a\x9d\xb4\x52\xee\x9e\x64\x9b\x80\x87\x3b\x7d\xd2\x18\x59\xcb\xfb\xbf\x70\x85\xc1\x84\xc5\x0b\x2a\xaf\x8e\xe5\x35\x98\xe5\x05\x7b\x00\x39\x85\x34\x03\x80\x8b\xbd\x42\xf2\x40\xe5\x98\xda\x56\x84\x11\xb3\xe4\x65\x76\x00\xcc\x64\xe6\x02\x47\x60\xcc\x5b\x0c\x4d\x44\x29\x77\x00\xa3\xa1\xb3\x8b\x61\x99\x3a\x03\x50\x61\x49\x42\xd2\x16\xea\xb4\xc0\xe4\x18\x3e\x70\xfb\xd9\xfd\x01\x8a\xc7\x21\x91\x11\x3c\xe4\xd3\x4f\x57\xe1\x68\x84\x7a\x58\x34\x18\xa0\xc4\x8f\x82\x78\x3c\xba\xbe\xa7\xc3\x3d\xb5\x9a\x60\xc3\xe4\xa1\xfd\x5f\x3c\x98\x52\xd2\xf8\x37\xe0\x42\x74\x92\x03\x93\x05\x49\xd4\xb8\x82\x67\xb8\x3f\xcd\x70\xe5\x19\x8f\x46\xf5\xcc\x63\x89\x3b\x3c\x66\xbe\xe5\x10\x8b\xee\x09\xba\x87\x9e\x91\xe1\x20\xff\xff\xcc\x7d\x66\xa6\x60\x64\xee\xc6\xa9\xd9\xe3\x24\xea\x31\xea\xa2\x8a\x4d\xbb\x51\x3f\x9d\x66\x36\xcb\x0e\x45\xf5\x0f\xce\xab\x24\x43\x89\x4c\xe1\x54\x3a\xad\x55\x23\xad\xb9\xc5\xad\x8e\x2e\x6d\x69\x5d\x9b\xd2\x0a\x8d\x37\x4b\x13\x0f\xe4\x0a\x5c\x11\xe3\x2e\x4f\x83\xcc\x16\xd2\x4d\x75\x85\x25\xf2\x96\xc6\x03\xf0\xb7\x06\xac\x25\xb4\x99\x15\x63\x00\x76\xd3\x86\x9a\x5c\x24\x83\x66\x0a\x72\x9e\x4c\x96\x8f\x39\x7a\x6e\xea\xb3\x95\xd4\xd0\x79\x0a\x67\xbb\xb3\xd4\x31\x13\xa5\x16\x3c\x8c\xe7\x47\x6a\x21\x45\xdf\x4e\xab\x6d\xd3\x0c\x28\x2a\xee\x80\xf1\x65\xce\xf2\x34\x96\xec\x09\x58\x0e\xf1\xeb\xf6\xfa\x70\x4b\x94\x38\xa1\x10\xb7\x7f\xb3\x69\xb8\x1e\x50\x3f\xfe\x76\x7b\xf7\x06\x91\xed\x93\x5b\x50\xda\x76\xe1\x5c\xca\xe3\xcc\xb6\x78\x8b\x5b\x48\x2b\x6e\xe9\xb0\xdb\xf9\xe1\x73\x30\xd8\x90\xb6\x67\x89\x42\x16\x54\x8f\x33\x97\xaa\x45\xf6\xe5\xef\x43\x5f\x5e\x28\x1d\x7c\x07\xea\x88\xbf\x88\xda\xdc\xb2\xf8\x4a\x69\x92\x9f\xf1\xa1\x76\x85\x95\x7d\xf8\x86\x3d\xf4\xc7\x03\x6b\xb0\xf3\xed\xe8\x1b\x29\x1c\xb4\xdd\x35\xce\x5c\xca\x5d\x9b\xec\x42\xc0\x13\xb1\x85\x8b\x2b\x12\xf6\x74\x78\x85\x8c\xc1\x9e\xe9\xb6\xe7\xf2\xee\xa4\x62\x2c\xed\x9b\xd1\xa5\x15\xd8\x62\x15\x0c\x56\xac\x21\x09\x9c\x8a\x79\x45\x5f\xe2\xbe\xce\x90\x03\x40\x18\xf3\xa3\xb6\x2f\xe9\xf1\x0d\x34\x0e\xc2\x19\x4d\x06\x02\x15\xac\x43\x2a\x9d\xad\xa9\x61\xa6\x02\xdd\xa5\x37\xb1\x9e\xf8\xee\xa0\x0f\xfe\x13\xf8\xf1\x3d\x2b\x88\xbf\x77\xc6\xfc\x3d\xea\x89\x6d\xcc\x70\x51\x45\xf1\x9d\x18\xe3\xbd\xa3\x68\x2a\x8a\xef\x8b\x71\x97\xd4\x13\x7f\x73\xde\xfd\xcd\x95\xc5\xdf\x7e\xab\xf0\x14\xdb\x1e\xc7\x09\xed\xfe\xf6\x8e\x52\xfa\x70\xf7\xfd\x85\x6d\xeb\x90\xc7\xb7\xe4\xee\x51\xa4\x20\xcf\x55\x79\x22\xd3\xa5\x9c\xd2\x92\xe5\xaf\xbc\x39\xf7\xda\xcd\xef\x35\x29\xe5\xbd\xe7\xa0\x5c\x34\xf7\xa4\x92\x73\xd2\x40\xcc\x4c\x3f\xa9\xa5\x9d\xe4\x15\x1d\x89\x27\x41\x3f\x9a\x03\x17\x3f\xd5\xe4\x93\x07\x7e\x36\xf4\x90\x25\x05\x65\x7e\xbc\x7e\x17\xf7\xfd\x11\x9a\xc4\xa3\xeb\x41\x38\x42\xf1\x00\xd1\x4d\x8b\x9d\xe2\x2d\x47\x5e\x16\xdb\x7e\x53\x2d\xa8\x35\xac\x30\x26\xf1\x7a\x97\xbc\xbf\x79\x69\xc6\x0e\x92\x6c\x2d\x7b\x9f\x0c\xa6\x06\x36\x82\xd3\x1e\x99\x41\x9d\x88\x77\x57\x26\x49\x9c\xc5\xe4\x13\xda\x24\xa7\x0f\xbd\x00\xab\x87\x36\x51\x84\xaf\x08\x02\xc5\x10\xa2\xe9\x68\xe4\x58\x28\x02\x83\x7c\x99\x48\xf1\x8e\x6c\x91\x3c\xf9\x9c\x14\x2b\xb9\x9d\x8a\xed\x77\x61\x2f\xf1\x93\xeb\x79\x3a\x72\x29\x3f\xa8\x13\x14\x64\x0b\x65\x5a\x4f\x22\x5c\xf0\x2e\xfb\x23\x14\x46\x43\x9c\x84\x4a\x00\x57\x25\xa2\x83\x9e\x67\xd4\x8c\x30\x6a\x4e\x67\x89\xb0\x7f\x3c\xc6\x30\xb8\xc7\x09\x3f\x83\xa1\x9f\x71\x84\x58\x28\x0f\x2a\x06\x19\xa7\x4a\x84\x8a\xe2\x00\x72\xb9\x2b\xbe\xc4\x49\x12\x06\x38\x45\x47\x54\x21\x12\xe2\x94\x32\xf0\xc9\x35\x0a\x23\x96\xcd\x38\x47\xa0\x44\x0b\x7a\xae\x86\xd3\x45\x01\x18\x32\x97\xa3\xdc\x22\x51\x03\xc9\x44\x1d\x5c\x9f\x52\x12\x56\xa4\x9b\x02\x93\x44\xd9\x5f\x2c\xc4\xa3\x60\x03\x3d\x83\x4c\x59\xcf\x74\xc3\x11\x7b\x9b\xe4\x6f\x8c\xb3\x61\x1c\x14\xfa\xc8\x4b\xa5\xf5\x18\xf9\x36\xc7\x33\x84\xcc\x70\x86\x14\x7d\xc5\x20\x9b\xcf\xab\x33\x88\xe1\xc4\xbf\x8a\xcc\x2f\x12\x23\x21\xc2\x42\x9e\x56\xcf\x65\x4e\xbc\x35\xbd\x18\xe3\xc8\x62\x3a\x4c\x76\x94\x62\x2c\x50\xce\x7c\xd8\xb9\x2b\x2f\x6f\x4d\xff\x60\x45\x80\x99\x49\x71\xd7\xaf\x50\x38\x96\x26\x76\x9c\x7e\xe0\x4d\x0e\xfd\xf4\xf0\x2a\x62\x64\x7f\x5d\x79\x46\x6a\x3e\xab\x0a\x9f\x27\xf2\x08\x9b\x20\x2f\x4f\x5e\xcc\xed\x07\xad\x55\x38\xdd\x96\x5a\xff\x4f\x3a\x9d\x10\x51\x2b\x0a\xb3\x15\x9f\x08\xa7\x6c\xeb\xf3\x93\x8b\x29\x19\x5d\xeb\x78\x20\x4b\x06\x85\x82\x71\xca\x3d\x6e\x93\x67\x29\xca\x39\x7a\x48\x95\xc2\x7c\xd2\xe9\x2a\x35\x21\xc8\x1d\x54\xf6\x03\xc7\xb6\x83\xb8\x62\x7c\x80\x13\x1c\xf5\x49\x03\x30\xce\x13\x7d\xbd\x1a\xc3\xc0\xe4\x62\x1b\x40\xe7\x3e\x83\x6c\xa9\x31\x6c\x4c\x75\x07\x56\x4a\x2a\x33\x4d\xaa\xf2\x9e\x46\x74\x1c\x60\x02\xe9\xaa\x35\x43\xa0\x6e\xf1\xf9\xc8\x33\xd8\x54\xaa\xe2\x1a\x8e\x88\xd2\x10\x52\x0e\x80\x54\xaa\x7f\x65\x5e\xc9\x23\x96\xa3\x4d\xc6\x36\xf9\x9d\xc5\x5c\x5e\x44\xcb\x15\x73\x3c\xb3\x11\x58\x72\x79\x9c\x6c\x73\xe5\xf2\x08\xea\xd2\x1a\xe1\xef\xd4\x75\xe2\xa4\x1a\x5e\xfc\x36\x64\x53\xe4\xae\xee\x98\x2b\x74\xc8\x98\x19\x4b\x12\x00\x24\x05\x26\xf4\x41\x80\xd2\x78\x8c\x69\xea\x29\x74\x35\xc4\x11\xba\x8e\xa7\x89\x30\xb3\xf7\x89\x38\x4b\x81\xdf\x73\xec\xdc\xbb\xee\x82\xba\xa3\x73\xd1\x5e\x86\x28\x03\x58\x59\x31\x47\x46\x0c\xfd\x2d\xb7\xbb\xb9\x68\x94\x9a\xd3\x6e\x3c\x21\xc2\xce\x24\x97\x7b\x98\xbc\x73\x07\x71\x4a\x02\x06\x1a\x26\x45\xa6\x1a\x83\x26\xf2\x9e\xa7\x94\xad\x4e\xba\x7f\x96\x95\x5f\x6e\x39\xee\xd0\x88\x72\x89\x2d\xfa\x67\x5d\xe3\x22\xe2\x21\xbf\x6c\x7b\xef\x8f\xc1\x68\x62\x4e\x3d\xc4\xb6\xea\xbc\x98\xbe\x59\xcb\x00\xab\x85\x5b\x2c\x99\xce\x33\xb9\xf8\x39\xda\x94\xda\x57\x3f\x2d\x90\xba\xc8\xb1\xc9\xee\xa0\xab\x38\x7a\x96\x51\xf9\x99\xbb\x3b\x4a\xc1\x0b\x47\x71\x3c\x41\x7e\x2f\xbe\xb4\x6c\x83\xc5\x5d\x7e\xc6\xa1\x3d\x73\x77\x18\xb8\xa8\x68\x55\xee\xa7\x78\x5b\x22\xaf\x56\xa9\xc5\x23\x0e\x27\xd0\x53\xb0\x7f\x59\x64\xdd\xd8\x36\xbe\xfe\x28\x8e\xf0\x03\x70\x3c\x80\x8b\x36\xf3\x3d\x04\x5e\x94\xd8\xc9\x48\xb1\xb9\x1b\x99\x9c\x8b\x44\x15\x8e\x38\x3f\xb5\xda\x93\xd9\xcf\xc8\xd6\xdb\xfd\x08\xf9\xe0\x79\xab\xc5\x22\x2c\x8c\x2c\x64\xc4\x79\x2f\x06\x61\x0b\x4f\x23\x8c\x1f\xd4\x70\x88\x69\x78\x11\x85\x83\xb0\xef\x47\x19\x0b\x28\x19\xd2\xde\x03\x48\xda\x8e\xed\x98\xfc\xab\xe4\x41\x4c\xcf\xca\xf2\x9b\x7b\x08\x1b\x63\x36\xaf\x93\x85\x23\x0c\xbe\x6c\x7a\x35\x67\xac\x91\xd5\x2c\x4c\x8c\x94\x76\x83\x31\x77\xd0\xf0\xbd\xa5\x7a\x91\xfd\xb3\x95\x8d\xdd\xb0\x85\x71\x68\xff\xcb\x03\x38\xab\xcd\x6a\xb5\x5a\xbd\xd6\xa8\x35\x3d\x54\x9b\xd5\x5a\xb5\x76\xad\x53\x5b\x3b\x7f\x30\xc0\x1e\xea\x94\x0e\xbd\xc2\xc2\xd7\xf1\x19\x31\x56\xec\x15\x73\x08\x86\xe5\xca\x1f\xe8\x7f\xbf\x7e\x85\x98\xbd\x9a\xa8\x31\x40\x15\x31\xbd\x3f\x6c\x5a\x14\x85\xf2\x1f\x40\x95\x8c\x86\xf8\xcf\xd2\xc6\xa4\x3a\x00\x4a\x1e\x23\x1c\x5d\x64\x43\x6a\x7a\xe4\xe4\x22\xe5\x63\xc6\xe4\x0b\x65\xb1\x48\x31\x3b\x51\x3f\x0e\x08\xbd\x63\xfa\x43\x27\x77\x78\x5d\x1c\xfb\x53\x10\x00\x8e\xfa\x2b\x7b\x78\xe6\x6e\x73\x5e\x00\x99\x52\xab\x7d\xe1\xe0\x2e\x39\xb1\x96\x88\xec\x62\x89\x6b\x30\x2f\xac\x8b\xa5\x8a\x32\x24\x1f\xb2\xc1\xfa\x42\xd1\x5c\xd8\x54\x38\x63\xb9\xf0\xa9\xfa\xfa\x15\xed\xe1\x59\x61\xf8\x96\x39\x04\xd4\xf7\x33\x1c\xb1\x3d\x5f\xa5\x20\x07\xf3\x77\x13\x92\x74\x0f\x9b\x0f\xf8\x29\xe3\x86\x12\x65\x42\x9a\xdf\x45\xef\x75\xcb\xe2\x52\x86\x36\x04\x76\x75\x1e\x3f\x43\xbc\x69\xb8\x53\x9a\x41\x49\x9d\x29\xd1\xc0\xce\x8b\x85\x23\x21\x03\xfb\xab\xc1\xb0\x2c\xbe\x8a\xd9\xd0\x17\xa1\x0e\x72\x12\x73\x97\x0e\xd3\x93\x9c\xc7\x28\x3c\xc7\x01\xfc\x44\x65\x49\x14\x7e\x5e\xc7\xe8\x54\x77\xe4\x8f\x27\x08\xcf\x20\x92\x64\x2f\xd4\x3b\x47\xef\x55\x49\x19\xf3\xb6\x81\xde\xa7\xf6\x6d\x41\x52\x14\xc4\xff\xe1\x08\x94\x0e\xf5\x89\x48\x1a\x61\xd8\x6a\x91\x9f\x21\x1f\x65\xe1\xd8\x22\x71\xdb\x42\xb2\xcb\xdd\x75\x27\x85\x90\x07\x87\x14\x45\x9b\x04\x3d\x36\x0b\x67\x21\x8f\x8a\x4d\xfe\x53\x69\xb4\xd0\x32\xaa\x84\x14\xe3\xe7\x68\xbd\x5a\x15\xd1\xb2\x9d\x52\x3c\x85\xa3\xf6\x78\x09\x85\x22\xdc\xf6\xd7\xcd\xbc\xe9\x57\xaf\x78\x1b\x96\xf2\xa2\xd1\x12\x82\xbf\x73\x5b\x92\xc7\x94\x2e\xae\x3b\x8d\xa9\x3b\xca\x7d\xd9\xee\x6f\x22\x73\xb0\xcb\x64\x0c\x36\xa9\x50\x6c\xb6\x4b\x9b\x2a\x9a\xb6\x1c\x2b\x7e\x18\xf9\x3d\xfd\xe4\x21\x1d\x00\xca\xb2\x53\x1a\x83\x83\x08\x81\x8a\x60\x18\x66\x77\x15\x05\xf3\xc5\x29\x56\x97\x83\x49\x91\xcf\x65\x43\xf7\x5a\x58\x93\x29\x47\xd9\xe2\x22\x39\x99\x8c\x9d\x61\x58\x44\xb5\x33\x01\x83\xc7\x99\xdf\x84\xa5\x43\xff\x80\xf4\x9b\x0d\x42\xfa\xa9\xc2\x17\x2c\x04\xaf\x88\x52\x9b\xe8\xc0\xcf\x86\x2b\x7d\x1c\x8e\xf2\x9a\xab\x68\x81\x88\x44\xf6\xf3\x6f\xa9\x9d\xc7\x61\x8e\x64\x1c\x7f\x6f\x6b\xf7\xc9\x8e\xbb\x32\x2d\x18\xe7\x5d\x95\x16\xe6\x9d\x73\x65\xb0\x70\x52\xa3\xb8\xca\xd1\xcf\xcd\x93\xf3\x8a\x49\x23\xcc\xfc\xbe\xe6\x34\xa9\x23\xf5\x16\x9f\x02\x49\x6c\x18\x84\xa3\x11\x0f\x3b\xcb\xdc\x24\xe0\xbc\x35\x5f\x28\xe1\x87\xb9\xc8\x76\xe8\x95\x41\x39\x5d\x7c\x4a\xcd\x32\x83\x54\x8a\x50\xee\xcb\xf8\xac\xc4\x11\x8c\xb9\x82\xd4\xdd\x27\x2d\x5a\x42\x26\x93\xc8\x7e\xc4\x92\xd9\x83\x79\xa0\x22\x5f\x13\xf5\x86\x7c\xfc\xf1\xca\x1d\x65\xfe\xe3\x15\xda\x24\xff\x3a\x12\xa8\x8d\x3f\x7e\x21\xdb\xcc\xac\xe9\x07\xb8\xb3\xde\xd3\xc3\xaf\x8b\x62\x7e\xfa\x19\xc9\x9c\xa3\xe0\x9e\xa0\xc4\xdd\x1d\x6d\xb5\x52\x9b\xbd\xa8\x75\x5e\xa0\xe7\xa4\x0b\x5f\x60\x4f\xdf\xdd\xdd\xdd\xad\xa2\x25\xfa\xe2\xe7\x9f\x51\x6d\x56\xaf\xc1\x76\x4f\x10\x70\x6c\xf7\xb4\x8b\x95\xda\xac\xd5\x69\xd7\x28\xb0\x2b\x1d\xd8\x55\x59\x60\x30\xbc\x38\x9d\x82\xa7\x4f\x05\xd0\x78\xf5\x8a\xd6\x44\x4b\x08\x46\xba\xb0\x3e\xab\xbb\xba\x09\x75\xd8\x5f\x71\xd9\xa5\x4d\x54\x5b\x69\x3b\xcb\xc0\x98\xb2\xa2\xcf\xa9\xbd\x0d\xa7\xb6\x2a\xfa\x19\xad\xb4\xd1\x7f\xa1\x3a\xda\x40\xcb\xf5\x32\x22\x8a\xc1\x39\x54\x71\xc3\x43\x49\xdf\xef\x0f\x31\xcb\xae\x33\x5f\xe0\x20\x35\x3f\x12\x7a\x4c\x2a\x15\x5a\x95\x1c\x95\x14\x24\xc9\x6e\x22\x0d\x86\xfd\x8a\x89\x56\xdd\x44\x1f\x93\x0a\x2d\x0f\x04\xb9\xd6\x5b\xb3\xf4\xe9\x2a\xcf\xe1\x53\x11\xe5\x73\xf8\xe8\x2b\xaa\x95\x0c\x6b\x1e\xe1\x2b\xc9\xd9\x09\x6e\x1d\x99\x02\x24\xe2\xe9\x7b\x9e\x68\x23\x69\x77\x3e\x65\x47\xfb\x79\x86\x34\x38\xea\x83\x21\x0d\xfd\xaf\xdd\x90\x66\x0f\xcf\x4c\x4d\x80\x0d\x1c\x29\xb8\x49\x81\xae\xd0\xdf\xe5\xe2\x6f\xea\xea\x8b\x21\x9e\x95\x56\x61\x94\x38\x79\x2e\x18\x55\xb3\x54\xeb\xf7\xc5\xc8\x87\x78\x66\x86\xd0\x64\xe3\x27\x1d\xed\xe7\x27\x12\xb2\x06\xce\xbc\xed\x31\xf5\xaa\xf4\xc9\x33\x5d\xf4\x18\x49\x67\xdd\x04\x34\xc4\xb3\xee\xd0\x4f\x4a\xe7\xd9\x4a\xe7\x1e\xe8\x20\x47\x5a\x48\x0f\x72\x57\x77\x3c\xc4\x71\xec\xd8\x1a\x07\xb0\x04\x48\xab\x9a\xab\x7d\xea\x9d\xaa\x8d\xdf\xd9\xaa\x92\x76\x6a\x83\xe2\xba\x0e\x06\x21\xc0\x7d\x8a\xc3\xa8\xf2\xec\xd9\x2d\x22\x6e\x4a\x14\x4e\xd7\xdb\x22\x9a\x1e\xbe\x52\x28\xe1\x96\x5f\x30\x0e\xe1\xe9\xcf\x97\x9a\xf8\x62\xa3\x36\xdb\x62\x3d\x96\x8f\x94\x49\xab\x2c\x96\x28\x85\xd6\x79\xc7\x8f\x2e\xf4\x91\x1d\x65\x16\x59\x35\x57\x8b\xa4\xa6\x93\x1b\x65\x5b\x68\xa3\x20\x3f\x26\x5d\x2d\x4d\xd0\x4c\x40\xa7\xf7\xa3\x8c\x75\x76\x25\x9d\xf6\xd2\x2c\xa9\x84\x1e\x6a\x54\x3d\x48\xc2\x97\xab\x2c\xc8\x8a\x5a\xaf\xda\x1c\x70\x17\xde\xf3\x94\x61\x5a\x45\x8d\xb2\xee\xb3\xef\xfc\x2c\x8c\xea\xe5\x36\x2d\x56\x96\xef\x5b\xe2\xf1\x76\x5b\x17\xab\xfe\xe7\xed\x5e\x65\x11\xb8\xaf\x35\x35\x82\xf6\xec\x7b\x18\xc5\xe5\x3f\x6a\x1b\xa3\xc3\xf1\x1d\xef\x64\x12\x82\x74\x47\xa2\x53\xb7\x32\x48\xe2\x31\x79\xdb\x8d\x03\x0c\x9b\x54\xd9\x0d\x49\x06\x78\x87\x3d\x49\xa1\xdb\xdb\x6f\x4b\x82\x1c\x17\x5a\x0c\xdf\xf5\xe6\xc4\x56\x11\xdd\x9f\xe4\xe5\x56\x7e\x8b\x12\xb5\x16\xdb\xa5\x44\x35\xb1\x51\x89\x37\x0f\xbd\x57\x69\x4d\xcf\xcb\xe5\x1c\x4a\x5a\xf4\xbc\xb7\x2b\x7d\x46\xd0\x5b\x59\x25\xe4\x6b\x42\xdf\xaa\xec\xba\xc5\x85\xb7\x2a\x0d\xe1\xb2\x3b\xd5\x87\xd3\xdd\xe5\xf5\x72\x1b\xd5\x87\x6c\xb0\x2e\xb6\x29\xf6\x70\xbb\x4d\x8a\x36\xfa\xe7\xed\x51\x25\xdb\xbf\xaf\x95\x35\xcd\x06\xeb\xf6\x0d\x8a\x8c\xe2\x43\x6e\x4f\x59\x72\x5d\x60\x60\x14\x60\x72\x44\xff\x70\xbc\xdf\xe5\x9e\x4e\x15\x9c\xf6\xfd\x09\xae\x14\x6c\x9c\x26\x5b\x46\x7d\x3f\xeb\x0f\x51\xc5\x4c\x1f\x0d\x28\x0c\x93\xf8\x0a\xe8\x16\x32\xae\x54\x9e\x1d\xf8\xa3\x41\x9c\x8c\x71\xc0\xa6\x21\xf0\x33\xdf\x4c\x41\xb7\x38\x03\x97\x27\xf5\xf6\xfc\x9b\xcd\xd5\x22\x64\xf2\x5d\x33\x6f\xa0\x30\xca\xba\x73\x32\x2c\xcf\xb8\x59\x1d\x97\x31\x80\xb2\x35\x4c\x23\x46\x3d\xd4\x42\x40\xa1\x2b\x0e\xa7\x5a\x3a\x00\x8d\x48\xc1\x0b\xb9\x30\x71\xc0\xb2\x99\x49\x5e\xe8\xce\x4c\xbc\x92\x9d\xec\xb5\x94\x12\x6d\x3c\x4d\x33\xd4\xc3\x28\x24\x23\x3a\xc6\x51\x46\xf3\xac\xf9\x70\xbd\x9e\xe0\x4c\x78\x2c\x94\xca\xed\xab\xe5\xe9\x54\x95\xfb\x34\xc7\x21\x75\xad\xca\x13\xc4\x7f\xc6\x93\x0c\x4d\xa3\x09\x4f\x1a\xa8\x66\x07\x95\x6c\x5a\x6a\x16\xee\xfb\x9a\x8d\x03\x64\x1a\xdc\x12\xa3\x20\xbc\xc4\x5c\x9f\x4b\x9a\xc1\x41\x76\x57\x66\xcd\xa3\x8d\xf4\x33\x96\x44\x9b\x25\x31\xcd\x62\x14\x66\x29\xf7\x8a\x41\x84\x82\xef\x7a\xc7\xd4\xb3\x22\x4f\x13\xe2\xba\x2f\x99\x4a\x65\xdd\x65\xe6\x7d\x08\xac\x94\x6d\x36\x03\x90\x81\x93\x79\x2a\x6a\x3b\xab\xce\x94\x68\xf9\x68\xdb\xcf\x7c\x2e\xac\xd7\xca\x4a\x9a\x5b\x41\x90\x42\x1b\x3c\x2f\xb8\x63\xa4\x19\x2d\x94\xdf\x14\x45\x90\x05\x23\xf3\x38\x33\x76\x41\x74\xcd\x33\x27\x00\xca\x2f\xa9\x4f\x89\x2f\x59\x50\x52\x7b\x62\xe0\x78\x0f\x33\x99\x1f\x29\x3a\x95\x67\x26\xbf\x2f\x55\x6f\xfe\xde\xc8\x4a\x96\x49\x66\x6e\xba\xd7\xe7\xe9\xe8\xe4\x80\xa2\xd2\x00\xb1\x60\xa2\x2a\x28\xd9\xc7\x19\xc8\x68\x4e\x9c\x48\x46\x6b\x12\x53\x06\x0c\xe7\x47\x4a\xdb\x82\xae\xb9\xc8\x97\x9b\x12\xd9\x80\x19\x44\xbb\xb4\xa9\x26\x49\x2f\x4b\xc1\x3c\xd7\x69\x8a\xfc\x4b\x3f\x1c\x41\xc4\x2e\xca\x17\x80\xd9\xb9\xa9\xe6\x54\x72\x56\x09\xa3\xcb\xf8\x33\x4e\xf5\x24\xc3\x15\x96\x1c\xd8\x43\x57\xc3\xb0\x3f\xb4\xb2\xea\xde\x75\x01\xab\x36\x5b\xe5\x0b\xa5\x17\xc7\x23\xec\x47\x37\x28\x88\x77\x47\xd3\x74\x88\x7e\x1d\xe2\x8c\xc6\x33\xe1\xb9\x68\xc1\x5d\x6b\xe2\x27\xc0\x28\xd8\xab\x9c\x6b\x0b\x76\x7d\x8b\x70\x20\x82\xd3\xc3\x88\xdf\x7d\x9b\x17\x00\xb7\x29\x21\xb9\xd6\x0c\x4f\x95\xeb\x8a\xcb\xb1\x20\x18\x7b\xa6\x60\x35\xd6\x2a\x2d\xaa\x2c\x3e\x3a\xe0\x0b\xea\x4c\xd8\x12\xc9\x89\xdb\xa2\x2d\x21\xaf\xb9\x71\x1a\x8c\xac\x4b\xad\x42\x3e\x4a\x86\x66\x2e\xba\xe7\xc5\x73\x59\x61\x53\x4b\xc9\x5c\x54\x98\x43\xcf\x6b\xdb\x23\xfa\x75\xe3\x69\x94\x71\xfa\xb2\x30\x13\x02\x34\xa2\x89\x84\x8f\x21\x6e\xf1\xa6\x8a\xff\xaa\xd6\xe4\x4b\x93\x17\xb9\x86\x9c\x61\x70\x1c\x4f\xa3\x00\x4d\x27\xd4\xa1\xb0\x3f\x9a\x06\x58\xa3\x7b\xb3\x9a\x86\x51\x6e\xe4\x22\x7f\x28\x1f\xdb\x56\x60\x11\xc4\x57\x91\x8c\x47\x1c\x8d\xae\xd1\x60\x2a\x16\xa5\x25\x92\xfe\xea\x2a\x1a\xe1\x94\x3a\x55\xda\x65\x2d\xe0\x1b\x09\x1e\xfb\x61\xa4\x0a\x57\xe5\xfa\x35\xf6\x67\x15\xa5\x5f\x70\x71\x8a\x96\x6d\x99\xd9\xbd\xf9\x57\xaa\x62\xce\xa9\xe6\xc1\x35\xe5\x40\xc9\x1c\x0f\xa5\xf5\xe7\x48\x22\x40\x17\x3d\x01\x6d\x38\xc9\x89\x7c\x55\xfb\x18\x46\x15\xb9\xc9\xe7\xa8\xe5\x29\x74\x66\x33\x9f\xe4\x19\xbc\x6d\x44\x42\xe8\x4e\x02\x58\xec\xb6\x45\xf9\x3c\x55\xb3\xb0\xdf\xaf\xe4\x11\x10\x6f\x97\xa4\xf5\xe4\x34\x9a\x20\x98\xe1\x84\x9c\x26\xc5\xc6\xb0\x9c\x1f\x10\xc0\x19\xd2\x5e\x91\x71\x17\x75\x0f\x12\x5c\xc5\x96\xab\xde\x35\xc7\x48\x49\x81\x95\x33\x7c\x98\x72\xb3\xa8\xc2\x7d\x65\x16\xa6\x27\xc3\x92\x47\xd4\x82\x86\xc2\xc9\xd0\xf2\xa6\x3c\xd3\xf3\xa9\x92\xc7\x16\x2d\xc2\xd6\xad\x70\x52\xf1\xf7\xe4\xa6\xef\x6a\xec\x56\x3a\x0b\x65\xa1\x93\xd7\x1d\xad\xdc\x1c\xbb\xe1\x9f\x64\xf2\xf6\xd1\xd8\x10\x73\x4c\xac\x33\x56\x68\xf1\xa6\xf2\x30\x71\xd2\x74\x64\xa2\xe7\x67\xf0\xa1\x9f\x42\x86\x5c\xe7\x89\x7b\x6e\x2a\xf2\x9c\x5d\xcb\x3e\x50\x74\xd2\x19\x74\x1a\x76\x0d\xa7\x28\x8e\xa4\xa3\x70\xbd\x83\x2a\xed\x7a\x03\x2c\x59\xab\x96\x63\xf1\x1e\xad\xcc\x8f\xc1\xe2\xd1\x7e\x1e\xbe\x97\xa8\xaf\x45\x19\xc8\x0a\x03\xa6\x16\xb9\x9a\xd1\x41\x58\x20\x27\xf9\x6d\xa3\xdb\x91\x86\x10\x0d\x91\x3c\x2f\xc8\x5d\x69\x1b\x12\x31\x07\x4a\xe8\xb6\x93\xbd\xad\x46\xbb\x63\x77\x12\x2b\x4a\x75\x7d\xeb\x08\x6b\x3c\xb6\x5a\xf9\x30\x6b\x27\x58\x84\xf7\x70\x6b\x08\x4c\x35\xc4\x1c\x4b\xec\x5c\x93\xc2\x17\xce\xfd\xab\x4c\x18\xbd\xdc\x87\x8a\x04\x10\x96\x55\x3c\x6a\x09\xc7\x4a\x02\xd0\x0a\xf3\x32\xa5\x06\x7d\x6f\x66\xc3\x61\xd9\x98\xf9\x86\x7c\xbc\xd8\x58\x7f\x98\x04\xc0\x32\xe4\xc1\xa6\x69\xf9\xcb\x67\xec\x73\x46\x10\xa6\xc0\xf5\x38\xc2\xa5\x5d\x88\x28\x2b\x62\xfe\x43\x73\x97\xf7\x02\x73\x3e\x05\xbc\x2a\xcf\x18\x52\x36\x5d\x8a\x5a\x72\xbe\xea\x84\x16\x94\x09\x45\x19\x03\xc7\x7a\x74\x68\x24\x98\xc2\x46\x85\x60\x21\x0f\x36\xbe\x44\x48\x27\xf8\xda\x40\x49\xe7\x58\x53\xfc\xbd\x37\xdf\x89\x5d\x96\xe4\x26\x15\xb8\x38\x19\x24\x7a\x1f\x03\xca\x7e\x46\xf3\xc5\xb3\x9a\x79\xcc\x50\x14\xa6\x08\x0f\x06\xb8\x9f\x85\x97\x78\x74\x8d\x7c\x14\xe0\x34\x4b\xa6\xf0\xec\x81\x9c\xbe\x1c\x47\x7d\x5c\x2a\xca\x68\x49\x0a\x55\x12\x3d\x00\x4a\x79\x40\x6e\x28\xb1\xb8\xe6\x82\x0c\xc2\x3d\xed\x0c\x68\x93\x93\xa3\x48\x26\xe4\x50\x4b\x38\x4a\x17\x11\x7a\x41\xb5\xf9\x54\xcf\x8b\x2e\x44\xf7\xbb\x96\xf1\x35\x0f\x44\xc5\x60\xd0\xbc\xb5\x32\x4f\x80\x5f\x80\xb3\x4a\x23\xc4\x99\xec\xae\x34\x0f\xd6\xc5\x43\xca\xbb\x16\x8f\x94\xfc\xae\x5d\x6f\xac\x36\x1b\xe5\xc4\xfc\x94\x69\x7c\x94\xf8\xf7\x3e\x9b\xb4\x67\x22\x70\x52\x18\x65\x38\x19\x48\xd6\xc2\xc8\xb9\x2a\x38\x7f\x65\x5d\xe7\x54\x4b\xb7\x5b\x16\x1f\xd1\x47\x43\x3c\x9a\xe0\x84\x88\x3f\x25\x16\xc1\x2e\xc3\x8d\xf9\x06\xeb\x28\x7f\x83\x7b\x3c\x2a\x33\xe9\x4e\x15\xb4\xab\x2b\x1f\x69\xaf\xf6\xa0\x4b\x15\x9b\xb0\xe5\xd6\xcf\xc9\x55\x15\xe3\x41\x00\xed\xba\xdf\x33\xd6\x85\x3d\x00\x2e\x52\xcf\x8b\x6c\x25\xc2\x61\x51\xcd\x22\x96\x67\xb8\x54\x29\x7c\xf1\x63\xa3\x95\x9e\x08\x4b\xde\x3b\xd8\xea\xde\x3f\x3d\x11\x11\x9a\x07\xa5\x20\x2d\x30\xba\xfa\x4b\xd0\xd4\xde\xd8\xef\x97\xa2\xab\xb1\xdf\xbf\x0b\x6d\x89\xea\x77\xa2\xaf\xcf\xd8\xae\x42\x92\xe8\xab\xfb\x11\xd0\x22\xf3\x40\x89\x8c\x36\x42\xeb\x2e\x46\x6c\x85\xc7\x5f\xa1\x49\x9a\xe3\xc3\x40\xb0\x01\x27\x06\xf6\x23\xf7\x62\xe0\x99\x5a\x20\xa4\xef\x81\x9f\x0d\x69\x58\xdf\x27\xfc\x3d\x1b\xe6\x97\x79\xa4\xdf\x9b\x73\xaf\xdd\xfa\x5e\xc3\xfb\x32\x64\x2a\x3c\x1c\x71\xf5\xde\xe3\xfd\x72\xc8\x8b\xc6\xfd\x15\x18\xca\xf1\x7f\x5d\x41\x7f\xc5\x77\x08\xfe\x6b\x0b\xa0\x6b\x5e\x51\xf0\xa8\xb1\xf9\x94\x49\x04\x20\x45\x83\x95\xde\x17\x84\xa7\x51\x6a\x4b\x2e\x30\xae\x30\xb2\x9d\x56\x39\x13\x2d\x56\x96\x1b\x69\x89\xc7\xdb\x99\x69\xb1\xea\x7f\x9e\x9d\x56\x59\x04\xee\x8b\x53\xf6\xa0\x3d\xbb\xa9\x16\xc5\xe5\x6f\x60\x4b\x6c\x94\x1f\xfb\x13\x21\x1c\x8e\xfd\xc9\xe2\xb1\x17\x2c\x2e\xe2\x26\x08\x97\x55\x26\x1d\xf3\xdb\x1a\x2c\xa3\xa5\x4d\xd4\x74\xdb\x2c\x5f\x67\xb8\x6e\x31\x5a\xa6\x7f\x2e\xd3\x65\xfa\xe7\x34\x60\xe6\x80\x1b\x39\xe0\x4a\x88\x96\x50\xbd\x6a\xb1\x89\xe6\x5f\xca\x58\x46\x73\xc0\x4d\x0d\x70\xc3\x09\xb8\x61\x05\x6c\x87\x9c\x25\xe1\x64\x04\x57\x2f\x15\x3a\x2c\xaf\x5e\x81\xdf\xc4\x57\xfa\xdc\x20\xcf\xeb\xe4\x11\x50\xb0\x41\x11\x53\xf1\x89\x4e\x45\xe5\x13\x7a\x45\x5a\xff\xe9\x27\x04\xd8\x7c\x42\xcf\x51\x6d\x65\xad\x2d\xcd\x50\xf5\x25\xfa\x54\x10\xee\x42\x9a\x7b\x6a\x0b\x3e\xf6\x27\x60\x33\xbb\x95\x55\x2a\x1c\x61\xe8\x74\x07\x3d\x47\x95\x26\x5a\x46\x9f\xaa\xac\xa7\xcd\x81\xd5\xdb\xc9\x88\xcf\x60\x2a\x2e\x82\x80\xa7\xfb\x36\xa9\x91\x7d\x20\x28\xa1\x4d\x24\xa1\xd3\x31\x9c\x49\x20\xb6\x5e\x5e\xdc\x6e\x1c\x3c\x0c\x47\x18\x55\xe4\x7e\xb2\x70\x01\xae\x58\x23\xd6\x61\x91\x9b\x59\xbc\xcf\x8c\xb3\xca\x50\xef\x60\x27\xaf\xf0\xe4\xdb\xdb\x59\x0a\x56\xbb\x10\xa3\xff\xae\x4d\x2d\xd9\x0e\x41\xed\x7a\xe4\xad\xa4\xbc\xb9\xa5\xa8\xb5\xe0\xe6\x20\xea\x09\x43\x79\xf1\x46\x18\xca\xcf\xe7\xfb\x46\x89\x04\x5f\xe2\x24\xc5\x07\x52\xc1\xfc\x95\x2d\xae\xd9\x0f\xf9\x67\x27\x75\x17\x02\xb5\x6d\x01\xfc\x4f\xe7\x3f\x84\xfd\x90\x15\xca\x3a\x58\xc8\x69\xd4\x86\xcf\xf8\xc2\x66\xb6\xf9\x9f\xaa\xe7\x68\x13\x7d\x2a\x17\xab\xd3\xc2\x52\xf6\x2f\xa2\x38\xc1\xdf\x8c\xab\x48\x20\xf7\xa3\x00\xfc\x9c\xf3\xe9\x0e\xc9\x9b\xc3\xc1\x3c\x9e\x21\xb5\x43\x61\xfc\xb0\xb9\x89\x96\xeb\x73\x78\x92\x4c\x61\x72\xed\x5b\x31\x62\xab\x48\x90\x88\xb4\x97\x29\x7e\x17\xc7\x93\x7c\x49\x78\x3a\x0e\x9e\x34\xa3\x8a\xc8\xa1\xdd\x78\xfa\x93\x0d\xf4\x6c\xeb\x75\x77\x7b\x67\xf7\xcd\xde\xfe\x3f\xdf\xbe\x3b\x78\x7f\x78\xf4\x7f\x8f\x4f\x4e\x3f\xfc\xf2\xeb\x6f\xff\xfa\x1f\xbf\xd7\x0f\xf0\xe0\x62\x18\x7e\xfa\x3c\x1a\x47\xf1\xe4\x7f\x93\x34\x9b\x5e\x5e\xcd\xae\xbf\xd4\xea\x8d\x66\xab\xdd\x59\x5b\x7f\xb1\xb4\xba\xc9\x22\xdc\x8a\xa3\x9d\x58\xb4\x0b\xa3\x9a\x0f\xb1\xc3\x2b\x25\xb7\xdc\x50\x2c\x4c\x6d\xa2\x90\xd6\x8e\xcd\x4d\x85\xcc\x74\xe8\xd8\x6f\x98\x63\x57\x4a\x84\x24\x69\x79\xe4\xd4\x24\x3b\xb0\xa0\x65\x54\xaf\x9e\x83\xf7\x4a\x2e\x30\x35\x4c\xe2\xe2\x40\x1b\x65\x80\x56\xcf\xf9\x06\x2f\x8b\x61\x16\xa8\x54\x20\x8a\x94\xc8\x3d\x5f\x89\x30\x03\xe8\x7f\xa5\x2d\xca\xbe\x35\x51\x71\xf0\x1e\xc4\x86\x78\x69\x49\xf9\x20\xc8\x56\xfc\x60\x14\x69\xc4\x96\xb4\x86\x45\xb8\xc9\x73\xf7\xe8\x87\x7c\x69\x8f\x78\xe9\xcc\xec\xd3\x7e\x3c\xfa\x3f\x1e\xfd\xc5\xd1\xff\xc3\xe9\xee\x72\xbd\x83\x5e\xef\x94\x76\xd0\xaa\x77\x5e\xef\xc8\x3e\x5a\xf5\x8e\xfa\x04\x5f\x6f\xef\xb4\x45\x91\xf9\x73\x1d\xb7\x4a\xe2\x70\x8f\xce\x5b\xf5\x8e\xd3\x7b\xab\xde\xf9\x1b\x68\x04\xca\x1f\xd6\x61\x30\xee\x72\x56\xb7\xfb\xfb\x83\x65\x54\x1c\xe0\xa3\x38\x8c\x32\x97\x93\x71\xbd\xe3\x70\x32\xb6\x1e\xa6\x73\x4c\xdd\x5e\xc6\xa2\xc9\xb2\xae\xc6\x12\xd0\x3b\x9c\xa0\x74\x22\xbe\x93\xb3\x1a\xd0\xe6\xa2\x6b\xe3\xbb\x3e\x46\xd1\x55\x25\x5c\xd6\xf8\xe2\x5b\xc8\x67\x0d\x2a\x2d\xe6\x6b\xcc\x6b\x09\xf9\x96\xbf\x78\x68\x4f\x63\xb5\xe1\x72\x8e\xc6\x75\x90\x7d\x04\x86\xaa\x9b\x31\x11\x81\xf2\xc5\xd2\x20\x8b\x45\x0b\xc2\xe6\xa6\x70\x97\x94\xa3\x8d\xce\xf3\xf2\xa1\x30\x18\x59\xbe\x2b\xb1\x87\x49\xfb\xd4\xbb\x3b\xef\x53\xef\xbe\x83\x7d\xaa\x0c\x0e\xf7\xbd\x4f\x59\x97\xd3\xbb\x9d\xc7\x6d\x4a\xfc\xdd\xdb\x36\x95\x5e\xf9\x93\x9d\x28\x08\xfd\xa8\xb2\xe8\x8e\x65\x3b\x92\x7f\xff\x5b\xd6\xbb\x87\xd9\xb2\xca\x2c\x93\xef\x7f\xcb\x7a\xb7\xa3\x6d\x5a\x8f\x3b\x96\xb1\x63\x49\x2b\x66\xa1\xcd\xeb\x9b\xee\x5e\x62\x5e\x24\x6c\x09\x20\xa5\x8f\x3c\x1a\x3e\x7c\x61\x77\x27\x74\x71\xd7\x6a\xe4\xff\xe1\x62\x85\x7e\x24\xdd\x67\x5f\xe9\xb7\x7c\xf9\xcf\x53\x17\x00\x61\xb9\xb5\x05\x9d\x3b\x69\x0b\x58\x8e\xda\x6f\xa9\x34\xf0\x90\xf4\x2a\x1d\xfa\x75\xed\xd5\x70\xec\xf7\x1f\x50\xb5\xe0\x21\xde\x2c\xfc\x82\xd6\xfe\x0e\xea\x06\x23\x5f\xec\x2d\x54\x11\x8a\x11\x8b\xf4\xe5\x60\xbb\x0d\x35\xc1\xe4\xe6\x60\xbb\x6d\x93\xf1\xc0\xc4\xf9\x33\xbe\xa6\x59\xb0\xa9\x1d\xac\xe8\x2b\x38\xff\xfa\x51\xc6\x93\x78\xc7\xc9\x98\xda\x68\xef\xfc\x72\xf4\x11\x36\xdd\xd3\xf8\x2d\xce\x85\x41\x74\x75\x75\xb5\x12\x4f\x70\x94\xa6\xa3\x95\x38\xb9\x58\x0d\xe2\x7e\xba\x0a\x49\xb8\xe3\x55\xad\xce\x30\x1b\x8f\x2c\x8a\x90\x9d\xcb\xc9\xdb\xed\xdd\x1c\x6d\xf1\x5c\x32\x18\xc2\x7c\x1f\x10\x6d\x8f\x33\xbc\x5f\x58\xca\x73\xd8\xa3\xc8\xc0\xa4\xe4\x21\x8c\xb8\xdb\x8b\x14\xee\x39\x77\x75\x69\xa1\x4a\xbd\xb1\xae\x78\xba\x18\xf0\x1d\x46\x6a\x72\x58\x0c\x3d\x41\xca\xc1\x76\x7b\x1e\xb6\x61\xc6\x6c\x91\xf5\x20\xd5\xd2\x87\x2c\x46\x13\x6a\x75\x2a\x7b\xe7\x38\x76\x38\xc3\x2f\x46\xdb\x1d\xd8\xf0\x6c\xa0\x7a\x63\x1d\x4c\x48\x95\xaf\xb4\x73\x80\xb9\xf6\x25\xc7\x47\x69\xfb\xe6\xd6\x6e\x37\x0e\xa2\x7d\x68\x3f\x1c\x2c\x35\x7a\x0f\x66\xd6\x9f\x83\x81\xe1\x7d\x43\x69\x7e\x4e\x8a\xa6\xf9\x15\xff\xc8\xe7\x6a\x5d\xcb\xe7\x77\x5b\x30\x9e\x3a\x8d\xb5\x5a\x4d\x07\xbc\xa0\x77\xd0\x5c\xbf\x9f\x72\xf2\xee\x36\xa4\xf0\x27\x34\x42\xa8\x02\x12\x61\xfb\x90\x81\x95\x2c\xda\xdb\x58\xe9\xf3\xba\x34\x16\x80\x0d\x50\x41\xe5\xd4\x1f\x65\x68\x0b\xfe\xb3\xb8\x58\x0c\xd4\x45\xc9\xfb\x3e\xc8\x0b\x93\xcd\xe3\x73\x30\x58\xa1\x6e\x11\xb8\xc2\x3b\xe3\x01\x7e\x05\x79\x6b\xa0\xb8\x92\xdf\x51\xad\xb9\x90\xc0\xab\x4e\xb1\x45\xbc\x25\x2b\x9d\x71\x0f\xb3\xb6\xf0\x52\x23\xe4\xc1\x4c\x94\xf3\xd5\x61\x85\xe5\x72\x0b\x83\xd0\x02\x74\x88\xdf\xc2\xd8\xd8\x52\xa2\x2d\x72\x46\xce\x81\x09\x9f\x60\xf1\xc6\x79\x5c\xe6\x7b\x0c\xed\x11\x7b\xb2\x94\x93\x98\x38\x2d\x9a\xbf\xb0\x60\xf9\x86\x6d\x4c\x04\xbc\xfa\x91\x19\xb3\x68\xb8\x72\x83\x96\x57\x1c\x1f\xeb\x51\x80\x88\x71\xe0\x39\xe0\xbc\x60\x56\x5d\x96\x68\xd9\xf9\xd7\xca\x48\x0e\xc6\x90\x3b\x81\x30\x28\x9c\xd8\x24\xa3\x60\x83\x5e\xd5\xe6\x85\x3f\x9d\x59\x82\xd0\x84\x18\x38\xf3\xb3\x72\x50\xaa\xd3\x83\x92\x34\xd0\x85\x69\x7f\x34\xec\x05\xb2\xce\x51\xb0\x61\x6c\x19\x2a\xf3\x9d\x44\x56\x2c\x66\x8c\xb5\x0d\x6d\x94\xa5\x5a\x92\x8e\x86\xd3\x9f\x25\xda\x85\x08\x30\xc7\xeb\x95\xb5\xb9\x2e\xc5\x83\x65\xbf\xe3\x5b\xf1\xde\x05\xf9\xee\x3d\x7a\xdf\x5a\xfc\xca\xa4\xde\x94\xe7\xe6\x52\x25\x45\xbb\x21\xbd\x57\xb9\x7b\xfe\x01\x29\x5c\x5d\x6c\xda\x74\xbf\x76\x71\xf6\xc5\xaa\x79\xc8\x21\x36\xdc\x05\x4c\xa1\xd8\x20\x54\xc8\xb9\xac\xef\xda\x73\x4c\x17\x16\x36\xec\xaa\xc4\x02\x8e\x2b\xc5\xfb\xdd\xcd\xcb\x82\xe3\x3b\x85\x66\x3f\xbb\x7b\xfc\xf0\xb9\xd1\x5e\xf7\xf8\x91\x74\x63\x6d\x8d\x9c\xe9\xd7\xfe\xd2\x67\xfa\x7e\x38\x19\xe2\x64\xf9\x81\x4d\x04\xe0\xf4\x2e\x37\xf5\xe7\x1c\xe2\xcd\xcc\x9d\xf7\x72\x9a\xef\x42\xc7\x8e\x08\xc7\x49\xc5\xa1\x5d\x7e\xe9\x36\x21\x10\xef\xb5\x4c\x18\x4a\x0d\x72\x86\xf3\x33\xa8\x44\x7f\x72\x46\xcc\x2a\xee\xc2\xcb\x8c\x45\x55\xa0\x45\x16\x48\xa7\x41\x4e\x37\x74\x6e\x32\x3c\xcb\xc8\x29\xd2\x67\xcf\x68\x42\xfb\xc4\x7c\xb3\x78\xaa\x0d\x3f\xc0\xfd\x70\xec\x8f\x46\xd7\x2c\x0d\x68\x50\xfa\xe6\x46\x1e\x95\x1b\xd6\x0a\x1b\xb8\x53\x81\x86\xda\xec\xe2\xc9\x38\x6e\x83\xdf\x83\xa6\xe7\xc8\xa7\x44\xba\xd5\x91\x3b\xbf\xd8\xc5\x8e\x52\xd3\xe1\xa8\x25\x97\x59\xc9\x67\x37\x4f\x20\xb1\x87\x67\xb7\xcc\x04\x61\x19\x5e\x89\x7c\xe4\xfb\x86\x05\xa7\x53\xbb\x79\x08\xa3\xc9\x34\xbb\xcb\x9c\x72\xf2\x50\x89\xee\x16\x74\x76\x5f\xc4\xd1\xd7\x18\x85\x85\x3e\x6e\x9d\x54\x02\x46\xcb\x1e\xc2\x26\x9f\x9c\x4d\x94\xb7\x41\x2b\xbc\xb4\x52\x4f\x57\xa1\x1e\xae\x11\xc8\x01\x6d\xc8\x40\x6f\xec\xba\x79\xf7\x4e\x9b\x77\x57\xdb\x6d\xa5\x0d\x62\xa3\xdd\xf0\x34\xe5\xf9\xfa\xa3\xa9\xdd\xdf\x5d\xf7\xed\xda\x1d\x8d\x48\xe6\x45\x9a\x70\xf3\x90\x02\x0e\xc0\x42\xe3\x6a\x4d\x44\x45\x4a\x6c\xca\x8e\xaa\xf7\x93\x90\x1e\x5c\x5e\xe7\x72\xbc\xd2\x4a\xe2\x92\xaa\x28\x22\xab\x83\xf3\x32\xee\x27\x38\xbb\x27\xa5\x12\x91\x7f\xf7\xec\x81\x83\xa0\x97\x8c\x4d\xd8\x3c\x91\xa9\xa3\x6f\x59\x8d\xa1\xec\x1c\xec\x08\x10\x6c\xd5\x19\x09\x7d\x11\xf5\x51\x10\x8f\xba\x87\x7b\x81\xb7\xdb\x7d\xc6\x97\x85\x03\xd3\x9c\xf0\xb2\xf4\x50\x25\x45\x97\xd5\xc7\xc9\x6e\x88\x5f\xa0\x98\xa2\x1d\x7d\x2d\xc5\xc5\x64\x5d\x2f\x8a\x8c\xa9\x55\xe2\xfa\x02\x1d\x96\x3d\x4a\xe6\xd6\x68\x14\x5f\x21\x3f\xe9\x85\x59\xe2\x27\xd7\x88\xa9\x97\x3e\xe3\x6b\x4b\xdc\xc1\xcf\xb2\x46\xe2\x67\x6b\xc3\x05\x03\xa5\xab\x5b\xca\x8d\xd6\x1c\x67\x48\x82\x52\x81\x1b\x24\xc4\x7f\x03\xdd\x46\x9c\xa0\x30\x8a\x70\x02\xd1\x67\xe3\x69\x06\x02\x84\x1e\x85\x0f\x62\x26\x52\x1d\x23\x25\x43\xf6\x40\x5b\x31\x02\xd2\x71\x8d\x9f\x5c\x23\xb4\xd4\x58\x84\x04\x62\x49\x2b\x19\x17\xe9\x23\x43\xa9\x60\x28\x15\x34\x1a\xfb\xed\xf0\x18\xe6\x93\x5e\x03\x4e\xfc\x00\xf5\xe3\x28\xcd\xfc\x48\x6f\xde\x9a\x44\x4a\x9d\x63\xb7\x62\x4d\xe0\x7d\x16\x9e\xa3\xdf\x37\x51\x6d\xd6\xee\xd3\xff\xd9\xdc\x61\x8c\xc2\xcd\x0e\xfd\x5f\xb1\x66\x2c\xd6\x74\x62\xa1\xf6\x6c\xa3\xc8\x3f\x21\x0e\x19\xec\x40\x0f\x11\x85\x4c\x30\xf1\x7b\x89\x44\x56\x90\xaf\xcc\xc6\x8c\x2d\x03\x09\x9d\xb6\xf1\x71\x87\x9e\x54\xd5\x17\xe7\x0b\xe6\x76\x11\xc8\x60\x98\xbf\x9b\xf8\x63\x07\x5b\x5d\x16\x7d\x0c\xf0\x0a\x61\x89\x15\x46\x42\x59\x70\xca\xcb\x04\x22\x33\x4a\xdf\x7f\x30\x32\x99\x24\x78\x2b\x73\x83\x8f\x3d\x54\xf4\x30\x18\xea\xff\xf4\xe8\x61\x73\xc4\xd4\x45\x44\x44\xc2\x43\x73\x1a\x9a\x1b\x41\xcc\x5d\x63\x6e\x14\x31\x77\xd5\x07\x8a\x24\x76\x77\x6e\xd7\xa5\xea\x69\x18\x6f\xcb\x7e\x4c\xa4\x8b\x3d\x7b\x70\xb4\xc2\x80\x63\x85\x1c\x53\x1e\x2b\x0d\x68\x2e\xa1\x70\x49\x83\x5f\x32\x09\x54\xaa\xce\x90\x63\x63\xbf\x6f\xbf\x24\x12\x07\x7f\x87\x11\xdc\x8b\xbf\xb4\xc2\x7c\xd6\x69\x2d\x5b\x5e\x8f\xc2\xde\x32\x41\x25\x00\xdb\xd6\x54\xfb\x8a\xa3\xfe\x32\xd8\x34\x5a\xde\x53\x37\x4b\xed\xc3\x38\x68\xcf\x37\xbe\x4b\x87\x7e\xa3\xad\x83\x24\x2f\x1b\x3a\xb8\x74\xe8\xb7\xeb\x0d\xf3\x65\x73\xdd\x52\xb2\xa9\xbd\x4a\xc2\x09\x1e\x07\xf5\x4e\xcd\x6a\xfb\xa7\xbc\x9a\xf4\x3e\x07\x03\xbd\x1d\x7c\x39\xf9\x1c\x0c\x8a\xee\x1d\xd4\xae\xc7\x01\x5e\xee\x0f\x7a\xd6\xd7\x59\xe2\x78\xbd\x7c\x31\xf2\x83\xb1\x1f\xd9\x3e\xc7\x76\x60\xb8\xaf\xbf\x9e\xf8\xc1\xb2\x1f\xa5\xe1\xec\x45\x43\x1f\x04\xf2\x29\x4c\xe3\x7a\xad\xde\xd0\x47\x9c\x7d\x7a\xb1\xf6\x62\x4d\x9f\x21\xf2\xe9\x0b\x4e\x62\xe6\x7a\x6d\xf9\x1a\x39\xbe\x51\x1d\xd9\xf2\x10\xcf\xb4\x0f\x3e\xd6\x89\x8b\xc6\xdd\x08\x8c\xf7\x49\x5f\x9f\xdc\xc4\xef\xf5\xc2\xcc\xfa\x72\x79\x84\x2f\xfc\xfe\xf5\x43\xdf\x01\x89\xd5\x03\x4f\xfa\xa2\x81\x97\xf9\x5a\x11\x8f\x6c\x89\xc0\x33\x59\x19\x9a\x59\x28\x5b\x07\xe2\x77\xa3\x25\x7e\x13\xaa\xe7\xbf\x09\xb1\x8b\xdf\xf4\x57\x4e\xda\xb9\x7d\x29\xfc\x62\x84\x4c\x31\xa0\xf4\x6b\xdc\x61\x51\x74\x38\xb5\x4a\x4f\x59\xa2\x3e\x09\xda\xcc\xdf\xc6\x4a\x0d\x42\x89\xb4\x59\x99\x00\xc5\x1b\x41\x77\xf2\x1b\x4a\x6e\xe2\x8d\x4c\x65\xe2\x65\xa4\xbe\x92\x68\x0a\x9e\x09\x29\xc1\x8f\x9c\x82\xe8\xa8\xf4\xd9\x40\x31\x7a\x91\x7e\x73\x32\x59\x54\x11\xa9\x28\x20\x65\x5e\xbb\xb8\x62\xd2\x1d\x8a\x8d\x75\x69\xa3\x5d\xf7\x8a\xb5\xc9\x9e\x4a\x57\x1b\xed\x96\xa7\x10\xde\x46\xbb\xed\xe5\x13\xbf\xd1\xee\x78\xea\xe8\x6d\xb4\xd7\xf4\x1b\x61\x9d\x94\x37\x3a\x35\x8f\x51\xeb\x46\x07\xf0\x11\x94\xb2\xd1\x69\x78\x32\xad\x6c\x74\x5a\x9e\x8d\x5a\x36\x3a\x4d\x4f\xa6\x90\x8d\x4e\xdb\x93\xe9\x67\xa3\x03\x78\x29\x34\xb3\xd1\x59\xf3\x74\xaa\xd9\xe8\xac\x7b\x3a\xdd\x6c\x74\x5e\x78\x06\x91\x6c\xac\xd5\x3c\x0b\x39\x6d\xac\x01\xfe\x6c\x49\x6c\xac\x01\xf6\x8c\x34\x36\xd6\x5a\x9e\x41\x1c\x1b\x6b\x80\x38\x21\xa3\x8d\x35\xc0\x39\x5f\x67\x1b\x6b\x1d\xf9\x02\xdd\xcb\x97\xec\xc6\x1a\xbf\x5a\x27\x8b\x79\x63\xed\x85\xc7\x97\xea\xc6\x7a\xcd\xcb\x97\xf0\xc6\x7a\xdd\xcb\x17\xf7\xc6\x3a\xa0\x93\x53\xf0\xc6\x3a\x34\x2e\x18\xcd\xc6\x7a\xeb\xe6\xdc\xeb\xd4\x1e\x2f\x0f\xfe\xfc\xcb\x83\xee\x10\xf7\x3f\x93\x4e\xc1\x4a\xa1\x6e\x40\x34\xcd\x59\x3a\x9d\x90\x81\xc1\x2c\x3e\xb5\xd4\x6f\x90\xe3\x69\x48\x73\xf4\xc3\x26\x7a\xc6\x21\x3f\xb3\x58\x84\x08\x27\x8d\x7b\xbc\xae\x28\x34\xc7\x17\xed\x1c\xe3\x01\x4e\x30\x1c\xf4\x92\xf0\x02\xce\x64\x61\x14\x66\x39\x98\x74\x3a\xc1\x09\xa8\xae\x37\xb5\xf4\x1c\x12\x94\xad\xe9\xc5\x18\x47\x99\x56\x00\x65\x31\x1a\xfa\x51\x30\xc2\xca\xb8\xc9\xb0\x7b\x56\xc8\x8a\x4d\x0d\x54\x35\xdd\x01\x25\xdd\x37\x8d\x25\x4f\x4d\xa0\xc2\x28\x5b\x97\x34\xf4\x03\xb9\xbe\x50\x4c\xa8\xb3\x63\x1e\xf3\xf3\x1a\x54\x09\xff\x81\x40\x85\x17\x32\x36\xca\x21\xc2\x8a\x58\x4c\xd3\x7f\x01\xa4\xcb\x10\x5f\xb9\x50\x74\x36\x2f\x21\xbc\xcf\x51\x40\x5f\xbf\xaa\xe5\x39\xc1\x01\x96\xa0\x33\xe6\xd5\x7f\x20\x6b\x4e\xd8\x8e\xc0\xa2\xb3\x03\x37\xaa\x56\x8d\x56\x9c\x58\xd5\x3b\x76\xb4\xdc\x2d\x2d\x56\x63\x3f\xca\x9a\x8d\x45\x9b\x58\xac\xc6\xee\x28\xf6\x6f\x53\xa5\xd3\x82\xf7\x79\xf9\x5b\x92\xd2\x0a\xa5\x60\x0f\xc9\xaf\xae\x33\x7c\x08\xc9\x81\x8c\xd7\xb6\xbc\xcb\x0a\xfd\xed\xd1\x45\x97\xb7\x55\x66\x45\xe4\xa5\x17\x53\x21\xe4\xd0\x5e\x0b\xdc\xd0\xa6\x1d\x67\x8b\x66\x61\x67\xc6\xb2\xaf\x5e\x67\x36\xe3\xe7\x85\xdc\x05\x6d\xa8\x2c\x92\x4f\x3b\xaf\x7f\x16\x9e\xdf\x2a\x79\x76\x6e\xce\x1d\x7e\xc1\x54\x55\x9b\x3b\x8e\xaa\x45\x05\x63\xcd\x53\x5b\x78\x88\xb9\x11\xda\x3a\xa2\xcc\xb7\x35\xeb\x19\x19\x4d\xf2\x9a\xc0\x43\x11\x91\xfa\x64\x66\x6e\xb6\xeb\x4f\x26\xa3\x6b\xd6\xb0\x9f\x5c\x4c\x09\x0b\x4f\x8b\xfc\x15\x19\xbf\x5e\x99\x24\x71\x16\x13\x1c\x65\xce\x5d\x64\x38\xa1\xef\x3e\x76\x05\x4b\xa7\xfe\x28\xeb\xfc\x39\xb2\x0e\x04\x8c\xfe\x13\xe2\x12\x59\x73\x2a\x95\x30\x91\x80\x2d\x96\xde\xe3\xa1\x2c\xd7\xad\x93\x2a\xa7\x8c\x59\x48\x25\xa9\xea\x52\xbb\xf9\xb3\x49\x7a\x2e\xbe\xd2\x69\xd9\xb9\xc8\x29\x61\x13\x9b\x74\xf8\x56\xfc\x5e\x4a\x7f\xa4\x61\xc4\x82\xb1\x12\x96\x51\x9b\xd5\x6b\xec\xaf\x8a\xbe\xaa\x69\x7c\xd9\xf2\xaa\x54\xad\x16\xea\x07\xdb\x6d\xcd\x9a\xc2\x66\x00\xa2\x7b\x4d\xa2\x4d\x36\xaa\x16\x03\x10\x9e\xf6\xa6\xf0\x76\x2c\xd7\x04\xdb\x73\x15\x9f\x99\x9c\xb4\x36\xeb\xac\xb5\xda\x8d\x66\xad\xee\xa1\xda\x0c\x0f\xfa\x81\xdf\x5b\x7f\x61\xc9\xab\x58\x9b\xbd\x58\xef\xf9\x41\x7f\x80\x3d\x18\x98\x66\xa3\xdd\x5a\xeb\xa8\xe5\xce\x9d\x37\x62\x5a\x1a\x3d\xb9\x17\x07\x22\x93\x9e\x6d\xef\xba\xf2\x27\x08\x83\x7b\xf5\xfc\x3d\xa4\xde\x71\xef\x18\xee\xeb\x6b\x3e\x1b\x14\x89\x8f\x04\x1e\x4f\x2f\x88\x42\x47\x04\xde\x83\x8f\x52\xe9\x83\x33\xfe\x70\x6e\x73\x09\x91\x3e\x13\x82\x33\x0b\x90\xbf\x4a\xa5\x22\xc1\xa4\x9e\xe2\xe8\x2b\x92\x5f\xc2\x5e\xd7\xaa\x6a\x3e\xe2\xe8\x6b\x49\x80\x8d\x56\xd5\x02\x10\x42\x19\x2b\x2e\xe9\x26\xb8\xbb\x19\x87\xec\x29\x37\x14\xf6\xeb\x7e\x65\x48\x6b\x48\x1a\x53\xb4\x84\x6a\xba\xf8\xa0\x94\xae\x6b\xa5\xeb\x85\xa5\x1b\x5a\xe9\x46\x61\xe9\xa6\x56\xba\x59\x58\xba\xa5\x95\x6e\x15\x96\x6e\x6b\xa5\xdb\x85\xa5\x3b\x5a\xe9\x4e\x61\xe9\x35\xad\xf4\x5a\x61\xe9\x75\xad\xf4\x7a\x61\xe9\x17\x5a\xe9\x17\xc5\xb3\x53\xd3\x66\x67\xce\x64\xd6\xb5\xe2\xc5\xb3\x59\x6f\x68\xc5\x8b\xa7\xb3\xde\xd4\x8a\x17\xcf\x67\xbd\xa5\x15\x2f\x9e\xd0\x7a\x5b\x2b\xde\x36\xb8\xc1\xea\x2a\x61\xc8\x9f\xc3\xe8\x82\x54\x0d\xfd\x51\xcf\x26\x36\xfb\x64\x1b\x38\xb3\x0e\x54\x0f\x3e\x59\x07\xa5\x0f\x9f\xac\x03\x10\xc0\xa7\xa6\x0d\x9d\x6e\x7e\x07\xad\x7e\x23\x48\xec\xee\x56\x7c\x0f\xf5\x3c\xd4\xf7\x50\xe0\x49\x0b\xd4\x43\x68\xcd\x23\x5b\x68\xed\x5c\xe7\x0d\x01\xad\x17\x78\x48\x54\xcd\x47\xc8\x43\xa8\xde\xf0\xd0\xe9\x59\xdd\xa8\xd7\xa7\xf5\x68\x4b\xb4\x6a\xbe\x68\x49\xbd\x35\x52\xaf\x61\xd4\xeb\xd1\x7a\x02\x49\x5f\xaa\xd7\xf4\x10\x6a\x40\x7b\x4d\xa3\x5e\x51\xff\x5a\xa2\x7f\xad\x85\xfa\xd7\x16\xfd\x6b\x2f\xd4\xbf\x8e\xe8\x5f\x67\xa1\xfe\xad\x89\xfe\xad\x2d\xd4\xbf\x75\xd1\xbf\xf5\x85\xfa\xf7\x42\xf4\xef\xc5\x42\xfd\xab\xd7\x3c\xd6\xbf\xba\x49\x30\x45\x1d\xac\xd7\x3d\xd6\xc1\xba\x49\x31\x45\x3d\x24\x58\xd2\x1e\xd6\x4d\x92\x29\x24\xd1\xa6\xc7\x49\xd4\xa4\x99\xc2\x3e\xb6\x44\x1f\x4d\xa2\x29\xec\x63\x5b\xf4\x11\xa8\xc6\xec\xe4\x9b\x37\x8e\x4e\x7a\x08\xb5\x69\x27\x4d\xba\x09\x68\x45\x6b\x27\x09\xbd\xbd\xa0\x15\x4d\xc2\xe9\xd3\x8a\xf6\x4e\xd6\x3d\x44\x3a\x7a\x7a\x56\x37\x29\xa7\x47\x2b\x5a\x3b\x49\x38\x46\xa3\x06\x15\x4d\xd2\x29\xea\x63\x5b\xf4\xb1\x61\xe7\x35\xae\x3e\x12\x9a\xa3\x7d\x6c\xd8\x99\x8d\xb3\x8f\x6d\xde\xc7\x86\x9d\xdb\xb8\xfa\xd8\x12\x7d\x6c\xd8\xd9\x8d\xab\x8f\x2f\xf2\x3e\xda\xf9\x8d\xb3\x8f\x2d\xd1\x47\x3b\xc3\x71\xf5\x91\x30\x46\xd6\x47\x3b\xc7\x71\xf5\x71\x3d\xef\xa3\x9d\xe5\x38\x69\xb5\xe9\xf1\x3e\xda\x79\x8e\xab\x8f\x0d\x41\xab\x0d\x3b\xd3\x71\xf5\x71\x4d\xf4\xb1\x69\x67\x3a\xae\x3e\x92\xe5\x4f\xfb\xd8\xac\xdb\x17\xe4\xde\x9e\x9b\x58\x5b\x80\x6b\xd3\xce\x75\xf6\xf6\xec\x9d\x24\xc3\x4a\xd6\xd6\xe9\x59\xd3\xce\x75\xf6\xf6\x0a\x16\x64\x07\x2a\xda\xb9\xce\xde\x9e\xa3\x93\x2d\x0f\x35\x9a\x50\xd1\x24\x9d\xa2\x3e\xd6\xf3\x3e\xda\x99\x8e\xab\x8f\xad\xbc\x8f\x76\xa6\xe3\xea\x23\x4c\x24\xed\xa3\x9d\xe9\x38\xfb\x58\x13\x7d\xb4\x33\x1d\x67\x1f\x9b\x1e\xeb\x63\xcb\xce\x74\x5c\x7d\xac\x89\x3e\xb6\xec\x4c\xc7\xd5\xc7\xa6\xe8\x63\xcb\xce\x74\x5c\x7d\x24\xac\x9c\xf6\xb1\x65\x67\x3a\xae\x3e\xbe\x10\xf3\xd8\xb2\x33\x1d\x57\x1f\xc9\xf2\x60\x7d\xb4\x33\x1d\x27\xad\xb6\x39\xad\xb6\xec\x4c\xc7\xd5\xc7\x46\xde\xc7\x35\xfb\x82\xdc\xdf\x77\x0b\xaa\x1d\xda\x49\x3b\xd7\xd9\xdf\xb7\x77\x12\x68\x0e\x78\x40\xcb\xce\x75\xf6\xf7\x0b\xc4\x80\x36\x88\x80\x76\xae\xb3\xbf\x6f\xef\x24\xe1\x1d\x0d\x18\xd6\xb6\x5d\xd4\x71\xf5\x91\xcc\x07\xed\x63\xdb\xce\x74\x5c\x7d\x6c\x8a\x3e\xb6\xed\x4c\xc7\xd9\xc7\x9a\xe8\xa3\x9d\xe9\xb8\xfa\x58\xcf\xfb\x68\x67\x3a\xae\x3e\xae\x8b\x79\x6c\xdb\x99\x8e\xab\x8f\x40\x73\xb4\x8f\x76\xa6\xe3\xea\x23\x88\xe4\xb4\x8f\x76\xa6\xe3\xec\x63\xd3\xe3\x7d\xb4\x33\x1d\x57\x1f\x5b\xa2\x8f\x1d\x3b\xd3\x71\xf6\xb1\xce\xfb\xd8\xb1\x33\x1d\x57\x1f\x1b\xa2\x8f\x1d\x3b\xd3\x71\xf5\xf1\x85\x98\xc7\x4e\xd3\x5c\x90\x70\x8d\x92\xe1\x64\x8c\x83\xd0\xcf\x98\x53\x19\xb8\x2b\xa8\xe5\xc8\x11\x17\x6d\xa2\x0a\xfc\x77\x09\xf9\xba\x86\x95\x96\xa9\xb3\x32\x75\x52\xa6\x67\x2f\xd3\x60\x65\x1a\xa4\x4c\xdf\x5e\xa6\xc9\xca\x34\x49\x99\xc0\xd0\xe6\x6a\xaa\xca\x5d\x8b\xa5\xee\x82\x01\x6d\x21\x53\xba\xc8\xa6\xeb\x67\xbe\xed\x60\xee\x67\xbe\x08\xe5\xe3\x67\xbe\x5b\x39\x16\xbd\x0e\xb3\xf4\x34\xce\xfc\x91\x80\x19\x6d\xfb\x99\x4f\x3d\x48\x9e\xa3\x75\x0b\x74\xa8\xf3\x0e\x0f\x32\x0e\x5d\x78\x9c\x40\x79\xa3\x33\xce\x94\x57\x02\xcd\xb3\x1c\xe4\xcf\x3f\xff\x8c\xda\x70\xf1\x56\x9b\xad\xd7\xf2\xfb\xb6\xbc\xc4\x3f\x50\xb3\x61\x10\x87\xda\x97\x3d\xb4\x89\x40\xed\x3e\x18\xc5\x71\x52\x91\x3a\xb9\xaa\xe8\xde\x5d\x9d\x83\xb2\xef\xd0\xa6\xf4\xa4\x2f\x1c\x81\x7a\xa5\x52\xc9\x71\x5b\x42\x9d\x16\xcd\x97\xf6\x02\x82\x89\xb6\xaa\x54\x61\x63\xd7\xcf\xf2\xaa\x0c\xe7\x5c\x39\x2b\xbf\x2d\xaf\x9d\x35\xc1\x31\xd5\xac\x0e\x6e\x9e\x6e\xd6\xe0\x12\x8b\x74\xb6\x55\xa6\xb3\xef\xac\x9d\x7d\x77\xdb\xce\xbe\xb3\x76\xf6\x5d\xd9\xce\x9a\xbd\x95\x9d\xa8\x2a\xa2\xfb\x3c\xd8\x14\xe4\xd4\xb3\xfb\x0f\x82\xc1\x3b\x75\x63\x00\x1f\x45\x9b\x27\x55\x61\x5e\xf9\x39\xde\x90\x8a\xce\xdb\x42\xbe\x7b\xcc\x30\xde\xe9\xfd\xb6\xd0\xbd\x87\xe3\x8a\x0b\x15\x5d\xff\x0b\x4c\xe0\x0a\x63\xef\xcc\x7e\x77\xb1\xc7\x6e\xc9\x2a\x95\x3d\xe5\x5a\x62\x6f\xe1\xfb\x08\x4a\x0b\x7b\xca\x5d\xc4\x9e\xf3\x12\x62\xfe\x8d\xc3\x31\xcb\x0d\x0c\x73\xc8\x22\xf0\x04\x30\xa6\x6a\xd1\x12\xc9\xca\xc1\x0d\xa1\x90\xd5\x83\x82\x15\x9c\x32\xc5\x0d\x1d\x3c\xe6\xd7\xff\xc6\xc6\x0b\x9f\x3f\x1a\xb4\xe0\xf2\xae\xe4\x11\x34\xc8\x57\xbb\x87\x03\xfd\x25\x90\xd4\x54\x5f\x33\x0f\xa5\x1e\x52\xaf\xd0\x80\x4f\xa2\x4d\xe4\xa3\x25\x54\xa9\xf4\xd0\x4f\x74\x73\xac\xfc\x9b\xfc\x0c\xaa\x84\x0d\xcc\xd0\x12\xca\xa4\xf6\x44\xc0\xe2\x88\x4c\x53\x4a\x57\x2a\x8d\x53\xde\x6c\xa0\x65\x94\x56\xa1\x5a\x4f\x33\x7a\x13\x58\x69\xe7\xff\x72\x58\xc1\x76\x5c\xe9\xa3\x9f\xd0\xbf\x1f\x06\x2b\xed\x10\x34\x17\xab\x1e\xfa\x1d\xf5\xd1\xef\x04\xb1\xfb\x47\x46\x13\x00\xe7\x22\x43\x10\xa9\xf4\xd0\xd7\x7b\x1e\x1c\xf9\xb6\xfa\xc4\x95\x26\x7d\x6e\xe2\xfd\x32\x41\xd6\xb8\x9f\x98\xe6\xa2\x08\xab\xc1\x04\xe3\x70\x16\x73\x94\xbe\x6d\x58\x33\xb6\x2e\x85\x91\xcb\xc1\x76\xdb\xe2\xfb\x55\x5c\xde\x74\xf8\xca\xe3\x8b\x29\x97\xf9\x6a\x46\xfe\x83\xed\xb6\xd5\x64\xc0\x39\x09\x73\x72\xd5\xdf\xd7\x14\xdc\x2a\xb4\xc3\xfc\x89\x93\xbd\xfc\xee\x63\xe2\xa8\x53\x99\x98\x88\xbd\xb1\xdf\x27\x93\xa1\x64\x86\x37\xe7\x83\x15\x33\xe7\x24\xcf\x66\x4f\xe7\xa5\x30\x03\x3b\x8b\x6c\xed\xb0\x80\x6a\xfc\xa5\x5d\xcc\xfe\xfe\x31\xd9\xe8\x62\x7b\xce\xe2\x0c\xa1\x5d\x8c\x83\x9e\xdf\xff\xcc\xe2\x6a\x8e\xe3\x00\x96\x14\xa1\x19\x31\xdf\xf0\xb2\xbb\xfb\x9a\x88\x40\x16\xf1\x00\xcc\x9c\xe0\xab\x62\x2d\x07\x16\x2e\xb4\x95\x03\x02\x80\x19\xf3\x88\x55\xdf\xdd\x7d\xbd\xb2\x13\xd1\x58\xe5\x60\x40\xb5\xfb\xda\x62\xf0\x33\x71\x98\xcb\x30\x33\xc3\x02\x93\x19\xb7\x68\xca\x42\x50\x71\x81\x84\x3e\xda\xee\x99\xa5\x50\x1e\xb4\x90\x1c\xca\x43\x2d\xcf\x63\x94\xbf\xc5\xd7\x69\x96\x60\x7f\xbc\x15\x05\xac\x77\x16\xeb\xc8\x98\x99\xc5\x0a\x70\x1e\x6b\xc0\x26\x64\x1f\xe3\x31\x86\x20\xe3\x60\x8c\x49\xe7\x89\xc5\xca\x04\xff\xf9\x08\xcf\x32\xfa\xda\x2e\xbe\xe3\xcb\xd7\x2c\x66\x2a\xb4\xbe\x92\x8e\xc2\x3e\xae\x70\x14\xc4\x4d\xbd\xc0\xc5\x66\x3f\xa9\xcc\xda\x36\xfe\xbb\xcc\xda\x1d\x46\x17\x0c\x87\x87\x61\xba\xf0\xd8\x7e\x33\xba\x39\xcd\x3b\xd4\xc3\xfd\x78\xcc\xbc\xee\x09\x41\x84\xf1\x34\x2d\x47\x32\xa2\x8b\xa5\xc4\xf1\x82\xde\x54\xe6\x76\x41\xf3\x8d\x30\x0f\x6c\x70\xde\xbb\xcc\x83\xb5\x5c\xbe\x54\x8d\xc6\xe5\x70\xcc\xb4\xf9\xfc\x33\x64\x76\xbd\xb4\x1e\x69\x44\x69\xb4\x89\xc2\x4b\x36\x85\x35\xc7\x4a\x8c\x2f\x31\xda\xff\x05\xce\x9f\xe9\xb4\x97\xe2\xff\x9d\xe2\x28\x2b\x38\x3d\x03\xbe\xc2\x81\x61\xae\x01\xb4\x8e\x8f\x36\x21\xe6\x24\x90\x3f\x46\xe5\x98\x0e\x34\x14\xac\x08\x20\x1e\x52\xbb\xb2\xba\x8a\xd8\x8c\xe4\xef\xac\xd9\x72\x8b\xa3\xc6\x50\xd3\xf3\xdc\x42\x10\x22\xc1\x88\x46\xe1\x1c\x6d\xd0\x0b\xc3\x82\x8b\x13\xbb\xaf\x8b\x0c\xae\xf9\xa6\xb3\x48\x9c\xba\x4e\xf3\x51\xf8\xf8\xde\x85\x0f\xf4\xdf\x93\x04\xa7\x38\xb9\xc4\x54\x0c\x89\xa7\x44\x94\x97\xc4\x0f\x50\x63\xf8\x59\xd8\x1b\x31\x0e\x8c\xb6\x13\xf4\x3a\x09\xfd\x08\xbd\xa1\xee\x99\x68\x10\x8e\x30\x8e\xfa\x2b\x7d\x00\xc1\x43\x3e\x43\x04\x6c\x8d\x7e\x4e\x8f\xa1\xc8\x3f\xfd\x08\xed\x25\xd3\xde\x35\xfa\x34\x24\xff\x59\xb9\xc2\xbd\xff\xbe\x18\xfb\xe1\x68\xa5\x1f\x8f\xed\xf2\xce\xe9\x31\x6f\xae\x40\xec\x91\x0b\x95\x96\x7e\x9e\xe4\xf9\x5e\xa2\x3e\x39\x28\xd0\x94\x49\x4f\x9f\x3c\x21\x83\x0e\xa4\x27\xd2\x21\x81\x92\x88\x2a\x85\xaa\x30\xeb\xf4\xd7\x1f\x68\x75\x35\xbe\xc4\xc9\x60\x14\x5f\x91\x3a\xb0\xf1\xd5\x79\x3a\x50\x52\xaf\xde\xa9\xfe\x44\xca\xbe\x14\x9f\x1b\xf2\xe7\x75\xfd\x6b\x93\xed\x61\xac\x31\xc0\x13\x50\x21\x60\x45\xbb\xab\xab\x88\x37\x8b\x7a\x75\x52\x04\x50\x86\xa6\x6b\x2f\x45\x95\x46\x5e\x45\x94\x79\x02\x08\xd0\x42\xb4\x54\x53\x2d\xc5\x8a\x3d\x01\x54\x58\xb9\x1b\xf8\x97\x10\xa4\x5c\x62\x69\xa9\xd7\x94\xbe\xc3\x3f\xbc\x0c\x2d\xb2\xb4\xd4\x6b\xbc\x7c\xea\x2e\xb0\xb4\xd4\xab\xb3\xef\xe4\x5f\xe8\x38\x6f\x14\x1e\x96\x36\xa1\xe7\xaf\x5e\xb1\x7c\x90\xf2\xeb\x06\x55\x01\x2a\x6f\x19\x42\x66\x4b\xa2\x5a\x6d\x56\xab\x33\xad\x5f\x5e\x94\x71\x3d\x52\x88\xbc\xbc\xd1\xa9\x83\x2d\x8f\x4a\x9f\xfe\x57\xa5\x11\xf6\x92\xde\x20\x71\x52\xca\x5f\x56\x19\xc1\x48\x53\xb0\xba\x8a\xc8\x2e\x01\x37\x31\x28\x94\x16\x12\x5d\x3c\xc6\x4a\x7b\x96\x22\x80\x97\xa2\x38\x1a\x5d\xd3\xe5\xb8\xfd\xeb\xe1\xf1\x36\xfa\x84\x5e\xa1\x75\x80\xc9\x1b\xac\xdb\xb0\xa0\x77\x71\x6a\x67\xd9\x37\xde\x5f\xbe\x96\x94\xb3\x80\x58\x57\x2b\x8e\xd7\x7f\xa2\xcc\xb9\xa8\xc8\x69\x14\xd7\x64\x18\xb3\x55\xc6\x13\x45\xb3\x7c\xc0\x0c\xd4\x8b\x24\x1e\xe4\x96\x7a\x40\x68\xb0\x37\x52\x2c\x03\xa1\x5b\xc8\x41\x68\xbe\x2c\xc4\xa5\x03\x42\xd8\x26\xcd\x53\x56\xf4\x44\x17\x8d\xd8\x67\x09\x57\x55\xf5\xbc\x88\x50\x84\x1c\x82\x11\xba\x9d\x70\x84\x16\x14\x90\x90\x2a\xcf\x99\x87\xae\x9c\xee\xe5\xb3\x97\x58\x1a\x2f\x35\xc9\x4a\x14\x97\x04\x2c\xa7\x88\x25\x15\x5e\x40\xd2\x6a\x3d\x4a\x5a\xdf\xbb\xa4\xe5\x90\xaf\x1c\xea\x9d\xd3\xe3\x62\x39\x67\x51\xf5\x8e\x85\xa5\xeb\xbc\xfc\x91\x89\xff\xfd\x98\x78\xe1\x69\xf6\x01\x58\xf6\x7e\xd4\x4f\x30\x44\x6e\x60\xc0\x35\x90\x4c\x0e\xc9\x27\x77\x19\x51\x63\x1a\xc7\x17\xb8\x2d\xff\x8a\x6a\x7f\xa9\xcd\xa1\xec\xae\x30\xff\xbc\x4d\xca\x2c\xb0\x0b\xb4\x1f\x77\x81\xbf\xc4\x2e\xb0\x33\xc2\xfd\x2c\x89\xa3\xb0\x8f\xba\x71\x80\x7b\x71\x3c\x5f\xe1\xbf\xd3\x2d\x52\xf8\xd3\xaf\x0b\xed\x08\x3b\x5d\x55\xe1\x4f\x9e\xef\x6b\x07\x90\x59\xbb\xca\x40\xd4\x7a\x45\x5a\x4c\x82\x8f\xb2\x90\x1e\x0a\xbf\x00\xdf\x0a\x3f\x9e\x7a\xa9\x3b\x5f\x6f\x06\x65\x16\x58\xc7\x7f\xed\xe4\xc8\xff\x39\xeb\xf8\x70\x9a\x4d\xa6\x59\xf9\x4b\xbb\xc3\xc2\x4b\xbb\xc3\xc5\x2f\xed\x74\xa9\xee\x50\xbb\xc4\x3b\xfc\x73\xaf\x83\x1e\x5c\xaa\x33\x75\xf3\xe2\xcd\xfd\x4a\x76\x05\x0d\x7d\x2f\xd2\xdd\xdf\xe9\x84\x7d\xa8\x5d\x6b\xba\x84\xa8\xc3\x12\x97\x16\x87\x0b\x5e\x5a\x3c\x66\xb1\xfb\x6b\x30\xdf\xad\xf7\x27\xfb\xe8\xb7\x95\x17\x8d\x26\x37\x10\x47\x69\x46\x96\xf7\xc5\xb5\xc1\x7d\x27\x7e\xb0\xb2\x15\xa5\xe1\x6f\xa4\xb4\xc8\x05\x37\xf1\x03\x99\xfd\x05\x7e\xe6\x4b\x17\xa1\xae\x0b\xd0\x54\xbd\x01\x25\xb5\x4e\x72\x83\x5f\xc5\x00\xf8\xa5\x5a\xb4\xa7\xa7\x15\xe9\xb9\x12\x8a\x00\x51\x4c\xa3\x4c\xf4\x4c\x0b\x66\x05\xb6\x78\x47\xf4\x9b\x01\x8c\xbe\x58\x56\x31\xfb\x87\xf6\xdd\x68\x8d\xc6\xb4\x19\xf9\x29\x8d\x9c\x85\x26\x71\x1a\xaa\x1e\xf8\xa4\x51\xf2\x9d\xd4\x3f\x8a\x79\x67\x45\x0b\x4b\x1a\x46\xcb\xa8\xae\x35\x72\xe4\x07\xf9\x33\x0c\x94\xc8\x36\xa2\xbe\xa6\xac\x44\x6e\x2b\x0f\xa9\xa5\x36\x92\x87\xd4\x92\x4b\xdb\x82\x6b\xa9\x96\xd9\x4b\x1a\x20\x6e\x87\xc8\x2d\x70\xa7\x91\x85\x38\x74\x8a\x78\x83\x33\x29\xe1\xbc\x32\x55\x54\x81\x2f\x46\xb3\x78\xe6\xa4\x3e\x57\x54\x34\x97\xc9\xf1\x97\xf5\x3d\xbf\x08\x92\x50\x60\xfb\x8a\xe1\x21\xa1\x81\x71\xf4\xf6\xe9\x93\x1b\x2b\xdf\xe4\xcb\x65\xf6\xa2\xd1\x5c\x88\x77\xde\x2d\x31\xd9\x23\xef\xfc\x56\xbc\x73\xff\xe4\x10\x41\x48\xdc\x72\xac\x73\x9f\x05\xd0\xbd\x2b\xeb\xfc\xd3\xd9\x61\xbe\x24\xe6\xf0\x43\x0b\xab\xa2\xe9\x00\xec\x11\xe8\x56\x12\x3f\x0a\xe2\x71\xc5\xe0\x80\xd5\xea\x8a\x26\x29\x15\xc3\x61\xa9\xc3\xce\x0c\x2e\xd7\x68\x9d\x7b\x04\xdc\x23\xa3\xd2\x19\x15\x27\xce\x85\x18\xd5\x5f\x3b\xf3\xc2\x7f\x14\xa3\x5a\xdd\xdf\xe9\xa2\x17\x6b\x2f\xd6\x96\xeb\x88\xd1\x06\x3a\xc0\xd9\x30\x0e\x50\xc3\xc5\xad\x20\xb4\xf7\x6d\xb9\xd5\x56\x10\x50\xff\x41\x75\x41\x94\xe0\x02\x7c\xf5\x92\xda\xf4\x8f\x2f\x5a\xa5\x81\xff\xc1\x49\x0c\xb9\xc3\xb2\x21\x46\x09\x4e\x25\xbe\xa8\x74\x84\x94\x63\x3d\x26\xcf\x06\xde\xb7\xe2\x05\x6c\x21\x7e\x61\x38\xa8\xab\xd1\xd9\x3c\x80\xa6\xf0\xec\x0b\x3b\x8e\x30\x1a\xc7\x09\xa6\xc2\xe3\xf2\x32\xf4\xcd\x35\x8a\x7c\xbd\x2f\x2f\x97\x5c\xe0\x30\x9f\x8b\x2c\xf0\xb5\xbb\x45\x39\x7f\x5c\xe0\xdf\xec\x14\x87\xa2\x38\x9e\x94\x13\x43\xde\x73\x72\x74\xae\x6c\x41\xec\xee\x35\x91\x17\x29\xa2\x39\xd1\xd4\x42\x44\x77\xb7\x70\xb3\x8f\x44\xf7\xad\x88\xee\x7f\x24\xe6\x57\x4c\x72\x12\x0f\xfc\x13\x85\xdf\xd2\x07\x67\xf9\x7c\x6b\x08\xc0\x95\x4a\xb1\x08\x5c\x45\x5f\xbf\xea\xaf\x6e\xb5\xc5\xd8\x7b\x3c\x3f\xae\xc0\xea\x2a\xfa\x40\xe0\xab\xf5\x42\x23\x52\x00\x68\x16\x44\x99\xab\x61\x38\xc2\xa8\xf2\x43\x25\xf7\xb5\xce\x63\x70\x83\xc7\xa1\x11\x73\x5b\x98\x70\x1a\x8a\xcc\x50\x6c\x49\x48\x55\x51\xea\x8e\xdd\x10\x8f\xb7\xcc\xee\x25\x51\xd0\x42\xbc\xe4\xaf\xed\xb8\x65\xc9\xd1\x45\x93\x64\x3d\x2c\x5f\xc9\x33\x21\x41\x6b\x7f\x7e\x9e\x8f\x87\x4d\x12\x5e\x2e\x26\xb6\x11\xf3\x5a\x7c\x39\xd9\xdb\xaa\xe7\xb1\x9e\xc9\x93\xf4\xd1\x4c\x04\x6e\x73\x10\x3d\xf2\xd3\x94\x2c\xe4\x65\x82\x5a\x80\xde\xe2\x6b\xb4\x8d\x93\xf0\x92\xe6\x84\xdc\xe5\x83\xd2\x28\x8e\x39\x7d\xf4\xfa\xed\xf6\x6e\x23\x6f\x4d\x3c\x97\x4c\x3c\xde\x8d\xa3\x41\x78\x31\x65\x99\x28\x63\xc8\x0a\x99\x16\xe5\x97\x4c\xe2\x09\x4e\xb2\x6b\xf4\x07\x3d\x16\x83\x37\x29\x30\xdf\xd3\x21\xcd\x71\x9c\x92\x87\x30\x62\xe9\x02\xb2\x58\xf8\xd2\xac\xa0\x6d\x3c\xf0\xa7\xa3\x6c\x03\xb5\x50\xa5\xde\x58\x87\x44\xca\x55\x17\x7c\x47\x42\x73\x9c\xf0\x44\xe6\x39\x38\x32\xfe\xf3\xd0\x0c\x33\x96\x3c\x33\x05\x50\xf9\xa1\x5e\xfa\x90\xc5\x68\x82\x93\x41\x9c\x8c\x25\xe0\x0a\x64\x29\xfd\x63\x7f\x70\xb1\xe1\x1a\x65\x44\x2f\xbe\x4e\x20\xe6\x4c\xbd\xb1\xbe\xda\x6c\x68\x21\xb8\x69\x57\x28\xea\xda\xa7\x1c\x21\xa5\xf1\x9b\x6a\x51\x42\xd2\xa2\x04\xf2\x64\x56\x82\x9c\xb4\xf8\x7a\x9b\x9f\x45\xf4\x10\xf8\xdc\x0d\xe9\xaa\x9c\x31\x94\x8c\x5f\xdf\x46\x37\xdc\xdf\x6c\x10\x27\x70\x8a\xc9\x1b\xbd\x87\xc4\xa0\x9f\x83\x81\x91\x34\x9e\x52\x3b\x3f\x3d\x2a\x66\x58\x8b\x54\xfc\x23\x9f\xac\x75\x9a\x7e\xf2\xce\x60\x3c\x75\x1a\x6b\xb5\x9a\x0e\xb8\x20\x7b\x7d\x7f\x70\x61\x37\xbc\x20\x13\xb1\x29\x7e\x72\xc2\x23\xc5\x5d\xc1\x30\xcc\xf5\x0e\xd7\x15\xd4\x83\xae\x2c\x0b\xba\x4d\xbe\xd9\x09\x83\x0d\xd4\xc2\x1f\x56\x4a\x56\x4e\xfd\x51\x86\xb6\xe0\x3f\x8b\x27\xa2\xe5\x6e\x34\x92\x5f\xfb\x5d\xc8\x8e\x26\x52\x0f\x06\x2b\x2c\x2a\x49\x85\x77\xc6\x03\xfc\x9c\x93\xca\x8a\xcb\xf3\xaa\xd5\x5c\x28\xb7\x8b\x3a\xf5\x56\x03\xc2\x30\x73\x24\x85\x65\x5e\xf6\xe0\xbb\xcf\x68\x95\x90\x0f\xe5\x41\x9e\x98\x1d\xbb\x59\xa2\x3b\x41\x39\xc8\xa6\x74\xb0\x69\xba\x79\x43\x9f\x63\x0b\xf5\x04\x72\xf2\x7e\x14\xe0\x99\xad\xc6\x59\x6d\xc6\x14\x40\x96\x68\x9d\x73\x42\x74\x09\x54\x84\xb0\x2c\xde\x38\xf3\xd7\xe7\xd8\xf0\x4a\xf9\x1b\x67\x25\xbe\xe5\x6d\x92\x59\x59\x61\x4f\x36\x23\x8c\x7c\x6b\xa1\x45\xf3\x17\x73\x8c\x2c\xd4\x8f\x4c\x50\xd7\x3a\xc8\xe3\x22\xbd\xe2\xf8\x58\x8d\x0b\x44\x27\x59\x9e\x63\x9e\x2c\x1b\x28\x30\x4f\xe3\x9b\xf7\x5a\x9f\x33\xc4\x32\x7a\xe7\xa9\x81\xcd\xef\xf3\xb3\x31\x00\x7c\x65\x88\xad\xa3\x6b\x16\x17\x59\x8c\xf2\x57\xac\xe3\x0e\x44\xf6\xc5\x18\xdb\x41\x87\x72\x34\x3b\x06\xd6\x82\x85\x62\xcb\x51\xa7\xb6\x1c\xd2\xf4\x39\x8d\x39\x10\xf0\x73\xa5\x09\x18\x3d\x31\xd2\xf2\x47\xdb\x58\x97\x19\x6f\x34\x2f\x14\x94\xad\xb3\x7c\xf4\xe5\x77\xf6\x80\x55\x52\x13\xbf\x1d\x1e\xab\xdd\x01\xd7\x29\x8b\xc7\xb5\x31\x6e\x9f\xa8\x0d\xcc\x27\x6e\x03\x23\xcd\xe6\x4b\xf4\xa9\x60\xf4\xc8\x5f\x5e\xe3\xec\x13\x98\xc3\x18\x1d\x39\xfb\xa4\x9b\xc5\xf0\xbf\x1b\xf3\xb5\x1e\x70\x8a\xfc\x49\xcc\x81\xe9\xa6\xa1\x51\xdb\x94\x68\x4c\xe2\xac\x76\xbe\xb4\x54\x6c\x52\x24\x01\x97\x8e\xbe\x9c\x6f\x58\x82\x98\xb1\xbd\x2c\xaf\x57\x64\x40\x29\x1f\x23\xee\xb4\xa1\x97\x09\x36\x53\xb8\x91\x2f\xb8\x89\xdf\x97\x68\x19\xa6\xb6\x74\xfb\xf3\xa3\xd7\x58\x44\x83\x7b\x08\x62\x43\x45\x04\x21\x19\x52\xa1\xd0\x25\x26\x2c\x56\xcd\x43\x0e\xd9\xf4\x2e\x60\x0a\x65\xd3\x3c\xc8\x8e\x38\x4a\xba\x04\x18\x0f\xe9\x82\x2a\x1b\x76\x55\x2c\x26\x85\xe6\x08\x4f\x37\x45\xb6\x68\x14\x9a\x3d\x50\x8f\x9e\x42\x97\xe7\x84\xbd\x39\xf7\xd6\xfe\xda\x3e\xf4\x0b\xa4\x75\x9f\x9f\x1c\xfd\x61\x75\x47\xce\xf4\xda\xae\xac\xd7\x7f\x07\xed\xd2\x09\x18\x67\x76\xb9\xf1\x2e\x55\x22\xc9\x2f\x8b\xf4\x48\x02\x8f\x63\x3c\x4d\xfd\xde\x08\xb3\x70\x60\x12\x3a\x27\x48\x4e\xb5\x48\xa1\xe8\x6f\xde\x20\x35\xc3\x9a\xb4\x2d\x1c\x43\x36\x65\xc4\x0c\x6d\x99\x8d\xb1\xa9\x49\x12\xe5\x21\xc6\x4a\x98\x22\x1f\xd1\x04\xcc\xe8\x12\x27\x29\x44\x2d\x1b\xfa\x19\x8a\xf0\xc5\x08\xf7\x33\x1c\x10\x36\xdc\x67\x29\x55\x33\xa6\xf0\xc9\x62\x34\x0a\xb3\x6c\x84\x97\x69\x80\xcb\x15\x15\x28\x4e\x92\x38\x41\x41\x8c\xd3\xe8\x59\x86\xfc\xc1\x00\xf7\x69\x5d\x8a\xd4\xb3\x14\xa5\xb8\x3f\x4d\xc2\xec\xda\x13\x15\x7b\xd3\x0c\x85\x19\x54\xe2\x35\xc2\x2c\x15\x01\x15\xc2\x51\x98\x31\x27\x6e\x9a\xd7\x35\x24\xfc\x79\x8c\x23\xba\x1f\xa4\x36\x45\x19\x1d\x90\x77\xb4\x73\x42\x5d\xa6\xbd\x95\xe7\xef\xb6\x49\xdb\x8a\x0f\x29\x6f\x65\x33\x68\xe7\x01\x23\xb7\xde\x86\x53\xc3\x65\xd1\x69\x21\x64\x27\x34\xb2\x7b\x61\xe7\x39\xed\x37\xd1\x2e\xf9\x65\x49\x1c\xf7\xf6\xac\x76\xee\xa1\xca\xdb\xb3\xe6\x39\x0b\x16\x80\xbe\x92\x47\x76\x15\x50\xef\x54\x2d\x49\xe4\xde\x9e\xd5\x69\xa5\x9a\x5a\xa9\x59\x5c\xa9\x41\x2b\xd5\xd5\x4a\xb5\xe2\x4a\x4d\x5a\xa9\xa1\x56\xaa\x8b\x4a\x6a\x1d\x5b\x76\x24\x63\xc8\xb8\x97\xa1\x6b\xd0\xba\x62\xd0\xba\xf6\x41\x33\xf1\x91\x86\x8b\xf5\x89\x5e\x98\x0c\x06\x3c\xed\x20\x45\x9a\x06\x59\xad\xd5\xc8\x17\x5b\x7f\xcd\x89\x68\xaa\x90\xeb\x56\xc8\x8d\x52\x90\x6b\xce\x81\x97\x60\x68\x90\x9b\xa5\x20\xd7\x5d\xb3\xe3\x49\x30\x34\xc8\x35\x0d\xf2\xfc\x89\xec\xfa\x49\x72\x8d\x7a\x7a\x3a\x55\x3a\x55\x3d\x1a\xff\xc2\xd4\x64\x64\x74\xf2\x09\xeb\x49\xaf\xd3\x0c\x8f\xd1\x20\x9e\x26\x28\x0b\xc7\xfa\xdc\x2f\x18\x94\x37\xc2\xb3\xec\x84\xac\x3e\x77\xfc\x58\x4b\xc4\xdb\x83\x38\x08\x07\xd7\x94\x13\x52\x3a\x2c\x81\xc5\xba\x1b\x8b\xee\x19\x75\x1c\xf8\xed\x0c\x52\x5e\x42\xb4\x15\x23\x53\x9c\x2d\x49\xee\x2f\x28\xc5\xd9\x74\xa2\x7e\x28\xf0\xe8\x98\x7f\xd8\xdf\xff\x85\xba\x76\x14\x9d\xf0\xf7\x7f\xf9\x58\x43\x9b\x68\xff\x17\x33\x35\x9a\x54\xa4\x4e\x8b\xd4\xad\xd1\x8c\xe5\x25\x0d\x53\x99\x4e\x7b\x97\x98\x88\x0a\xae\xa3\x7f\x8d\x06\x3f\x86\xb6\x69\xf4\xe3\xaf\x88\x3e\xb9\xa2\x1f\xcb\xc5\x59\x98\x63\x51\x3e\xbf\x0e\xb5\x87\x39\x16\xcd\x36\x44\xb3\x75\xa5\xd9\xfa\xbc\x66\xeb\x6a\xb3\xf5\xc5\x9a\x85\x30\x3a\x61\x8d\x2f\x41\x02\x24\x6c\xa8\x2b\xd0\x55\xb5\x09\x55\x1b\x7c\x31\x43\xd5\x9a\xba\x4c\x1d\x33\xc2\xc8\xba\x88\xb5\x22\xa0\xd6\x1a\x3d\xd7\xeb\xb1\xfd\xe9\xc7\x3a\xfd\x58\xb7\x7e\x6c\xd0\x8f\x0d\xeb\xc7\x26\xfd\xd8\xb4\x7e\x6c\x15\xb5\xd9\x2e\x6a\xb3\x53\xd4\xe6\x9a\x68\xb3\x40\x23\x55\x8a\xf3\xa0\xc5\xb9\x0f\x2a\xc7\x81\x90\xa9\xa4\x90\xfd\x88\xee\x25\xb9\xab\x53\x79\x2d\x49\x1f\xa5\x38\xb3\x5a\xc4\xde\x3b\xf7\xf6\x0e\x83\x9b\x7b\x99\x01\x17\x52\x4b\x9f\xd0\x50\x43\xbf\x01\x11\xa2\xca\x6f\x64\xee\xf9\x2a\x81\x67\xb1\xf7\xbe\xd4\x2b\xd6\x69\xc5\x06\xab\xb8\xa6\x55\x6c\x3b\x2b\x36\x68\xc5\x16\xab\x58\xd7\x2a\xae\x39\x2b\x36\x69\xc5\xce\xb9\x40\x4d\xa9\x58\xcf\x2b\xde\x69\x17\x2b\x8a\x52\x4f\x11\xe1\xb1\xe3\x4f\x58\x4a\x76\x16\x3c\x1e\x1e\x6f\x13\x3d\x9e\xc3\x61\x0c\x4e\xc0\xb1\xc5\x8f\xb7\xe2\x6b\x75\xc2\x43\x52\x8e\x5e\xe1\x4d\x77\x52\xec\x45\x27\x53\xbf\xb0\xe3\xc9\x6f\x6e\xf3\x8f\xe1\x25\xfd\xd2\x69\xad\x36\x1b\xba\x5a\x4e\x2c\x13\x41\xb0\x95\x92\xae\x50\xca\xfa\x50\xbe\x48\x22\xa8\x66\xf0\x73\xe2\x5f\x62\x14\x8f\x02\x27\xab\x5d\x40\x7e\xe8\x7e\xa4\x93\xdb\xd5\xe3\x1d\x2a\x2d\x76\xfd\x51\x7f\x3a\x22\x2b\x2c\xc2\x57\xce\x66\xbb\x2c\x11\x4c\x97\x26\x82\xa9\xcd\x5a\x41\x13\xfe\x0f\x2d\x71\x09\x4d\xcf\xd7\xd2\x65\x79\x61\xba\x34\x2f\x4c\x6d\xc6\x6a\x34\x21\xa6\x7c\x97\x0b\xa8\xb5\x2a\x7a\x85\x2a\xdd\x8f\xd2\xf3\x7f\xa1\x3a\xda\x40\xb5\xaa\x09\xb1\xc1\x20\x36\x28\x44\x06\xb0\xc5\x20\xd6\x35\x88\xf5\x12\x10\x9b\x0c\x62\xd3\xe8\x56\x85\xb6\xa3\x40\x6c\x94\x80\xd8\x62\x10\x5b\xd6\x5e\x37\x35\x88\xcd\x12\x10\xdb\x0c\x62\xdb\xda\xeb\x96\x06\xb1\x55\x02\x62\x87\x41\xec\x58\x7b\xdd\xd6\x20\xb6\x4b\x40\x5c\x63\x10\xd7\xac\xbd\xee\x68\x10\x3b\x73\x21\xe6\x62\x3f\x05\xaa\x54\x5f\xd3\xab\xeb\xde\x31\x82\xa6\xc9\xee\x73\xb1\x7c\x87\x45\x44\x4a\x5d\xcc\x80\x57\x87\xa4\x6b\x5d\x4b\x12\x0e\x9e\x2e\x3f\x99\xf6\x33\x34\x0c\x2f\x86\xc8\x8f\x02\x34\x8a\xaf\x90\x9f\x5c\x4c\x21\xfc\x0b\xb8\x39\xff\xef\xd4\x4f\x8c\xc4\x3d\xd0\x80\x8f\x36\x49\x2b\x5c\x8a\xb3\x28\x0f\x2e\x7a\xb4\x08\xdd\x25\xac\xc7\x27\xde\x67\x05\x83\x04\xa7\xd3\x51\x86\xe2\x41\x51\xf3\x43\xba\x05\x54\x2e\x7c\xf4\x1c\x5d\xf8\xd4\x75\xa5\xbe\x56\x45\x4b\x88\xbe\xea\xb1\x57\x6d\x78\xd5\x83\x57\x36\x24\x47\x14\x90\xd4\x15\x7a\x24\x7c\x8e\x2e\x66\x30\xc3\x55\x20\x08\x5e\x40\x88\x9d\x52\x01\x5b\x22\x18\xd2\xa1\xdf\x0e\x8f\x11\x84\x93\x94\x3f\xbe\xa1\x1c\xee\x62\x88\x7e\x47\x17\xa3\xb2\x4c\xce\xae\x54\xf9\x8d\xb1\xb8\x37\x94\xc5\x55\x2a\x6f\xf2\xed\x9b\xec\x64\x6f\x24\xb1\xa0\xca\x0a\x74\xd4\x02\x9d\xbc\x80\x4e\xcf\xbf\x31\x6e\xf8\x86\x72\xc3\x0a\x6d\x26\xdf\x6f\xdf\x70\xfe\x07\xfb\xed\x12\x22\xad\x99\x30\x1a\x0c\x46\x83\xc3\xa8\xab\x08\xd4\x0d\x0c\x6b\x6a\x81\x5a\x11\x86\x4d\x06\xbd\xc9\xa1\x37\x54\x0c\x1b\x1a\x86\x75\x0b\x86\x2d\x06\xa3\xc5\x61\x34\x55\x04\x9a\x06\x86\x0d\xb5\x40\xa3\x08\xc3\x36\x83\xde\xe6\xd0\x5b\x2a\x86\x2d\x0d\xc3\xa6\x05\xc3\x0e\x83\xd1\xe1\x30\xda\x2a\x02\x6d\x03\xc3\x96\x5a\xa0\x55\x84\xe1\x1a\x83\xbe\x76\xae\x90\x88\xc0\xb0\xa3\x61\xd8\x56\x30\x2c\x95\xf8\x23\xe5\x49\x27\x84\xae\xb5\x44\xda\x89\x79\xd7\x5d\x14\x56\x86\x67\x99\x7c\xef\x24\x6b\x52\x79\x28\x05\x25\x8d\x03\xbd\x2d\x32\xef\xaf\x26\x23\x9f\x60\x33\xcb\x90\x13\x1c\x8b\x33\x53\xc9\x5b\xb6\x41\x14\x17\x57\x45\x4a\x5d\x35\x79\x87\x5c\xb2\x5a\x74\x07\x25\x17\x2c\x6d\x8c\xec\xa9\x77\x23\x1b\xed\x96\x97\x5f\x8a\x6c\xb4\x3b\x1e\xbb\x2b\xd9\xe8\xd4\x6f\xce\xbd\xb5\xbf\x76\x24\xc2\xc7\xfb\xaa\xc7\xfb\xaa\x07\xbb\xaf\xd2\x96\x78\x7e\x9f\xa3\xdf\xe4\xfc\xb5\xee\x70\xee\x2b\x2b\xdc\x5b\x71\x34\x7f\xab\x1e\xcd\xdf\xde\xf6\x68\xfe\x56\x3d\x9a\xbf\x2d\x3a\x9a\xcf\x53\x30\x3f\xde\x54\x3d\xde\x54\x3d\xde\x54\x29\x5f\x1e\x6f\xaa\x1e\x6f\xaa\x1e\x6f\xaa\xf2\x66\x1f\x6f\xaa\xf4\x8f\x8f\x37\x55\x8e\xc7\xc7\x9b\xaa\xc7\x9b\xaa\xc7\x9b\x2a\xf8\x7b\xbc\xa9\x2a\xa7\xc4\x7d\xbc\xa9\x7a\xbc\xa9\x7a\xbc\xa9\x92\xfe\x1e\x6f\xaa\x1e\x6f\xaa\x1e\x6f\xaa\x1e\x6f\xaa\xfe\x93\x6f\xaa\xee\xed\x8e\xea\x76\xb7\x53\x65\xee\xa5\x4a\xdc\x48\x3d\xd4\x5d\xd4\x5f\x3b\x1f\xca\xe3\x5d\xd4\xdf\xff\x2e\x4a\xbe\x3b\xea\xb6\xe6\x3a\x3a\xc9\x37\x47\xdd\x96\x74\x6d\x04\x0f\x0f\x7f\x67\x44\xbd\x34\xc5\xad\x91\x3d\xa8\x00\xf7\xd0\x2e\xba\x56\x02\x37\x4e\xd9\xa3\x58\x8a\x99\x6e\xea\x2b\xa2\x30\x43\x69\x2f\x9e\x99\x70\x4e\x04\x3a\x27\xf2\x35\x1d\xff\xb3\x49\x93\x8d\x76\xc7\x7d\x28\x67\x87\xee\x70\xbe\x1a\xf7\x2d\xbe\xb6\xe9\x71\xd5\x16\x3d\xee\x3f\x3e\xb7\x61\x36\x28\x64\x08\x78\x54\x89\x10\xfd\x43\x1e\x27\x87\xea\x90\x55\x22\x5b\x1b\x1f\xfb\x33\x05\x90\x19\x09\x4d\xf9\x6c\x04\x45\xb3\x9d\xfd\x49\x2f\x2a\x9f\xd0\x12\x1d\x9f\x25\xde\x68\x15\xfd\x03\x7a\xe5\x88\xa5\x70\xe5\x4f\xec\x38\xc3\xbe\x61\x6a\x08\xa4\x09\x38\xb1\x3b\xc6\x93\xd7\x64\xc6\xe7\x4f\x4f\xd7\xaa\xe2\x67\x59\x35\x04\xd1\x7c\x62\x59\x66\x05\xa0\x3b\xab\xe5\xb8\x26\x04\xb4\x20\x46\xfe\x75\x32\x3d\x76\x95\xa1\xd2\xb2\x70\x72\x6e\xb4\x3b\x0e\x85\x48\xcd\xa9\x0c\xb1\x36\x5a\x56\x31\x22\xad\x27\x4d\x31\x92\x0f\x5a\xa8\x7d\xf9\x94\x0f\xe7\xdc\x0c\xf0\xa0\x1c\x54\xab\x7f\x96\xf1\xd4\xe6\x43\xac\xa6\x88\x2e\xa3\x88\xaa\xd4\x22\xcb\x22\x0a\x41\x83\x4e\x13\xc6\x31\xaa\x54\xbe\x2b\x24\xec\x20\x5c\x2b\xd1\x16\x10\xac\x9b\x58\x73\x42\x55\xdf\xab\x9d\xfd\x4a\xea\x56\xd8\x9a\x22\x55\x18\x5e\xe7\x79\x5e\x83\x48\xcf\x63\xa0\x1d\x9f\x3e\x40\x1c\x14\xcb\x8d\x56\x4e\xea\xa1\x71\x76\x27\x63\xa1\xcc\x15\x13\xcb\x14\xec\xbe\x57\xb9\xb7\xdb\xba\x0f\xa1\xb7\xdb\x5a\x58\xe2\x35\xf7\x58\x4d\xdc\xed\xb6\xac\xb1\x2d\xe0\x86\x26\xc4\xc1\x2d\x76\xf8\xed\x24\x9e\x28\xbb\x3c\x7b\x01\x83\xf0\x0d\xa2\xe2\x05\xa4\x39\x35\xd0\x9c\xa6\xe7\x27\x13\x4f\x4a\x89\x50\x73\xa8\xfe\xa2\x21\x83\xd5\x63\xcd\x11\xd4\xa5\xa8\x5f\xda\x2a\x26\xa0\x36\x54\x10\x6a\xc4\xb8\x52\x42\x0c\x69\x83\x17\x2c\xbe\xc3\x20\xe3\x59\xb0\x81\x0b\xc3\x17\x82\x17\xd9\xc5\x7f\x86\xcd\x7c\x79\xd9\xba\x87\x2f\xc0\xee\xd1\x9c\x04\x48\xdf\xd1\x6a\x23\x43\x74\x3f\x2b\x0e\x20\x2d\xbe\xea\x18\xcd\x17\xaf\x3c\x52\xa8\xf8\xa4\xd9\x6d\x3d\xd4\x31\xf3\x6e\xe9\xfa\xbe\xe5\xf9\xf2\xc1\x4e\x81\xdf\x36\x88\x33\x61\x55\x38\xc5\xc9\x25\x7e\xfa\xa4\xd2\xaf\xa2\x46\xad\xde\x40\xbd\x6b\xd4\xfd\xff\xfe\xdf\x20\x09\xfb\xe8\x00\xa7\x51\x38\x5a\x41\x5b\xa3\x11\x4a\xc2\x8b\x61\x96\x22\x56\x3e\x58\x79\xfa\xf4\xc9\x31\x0e\xc2\x34\x4b\xc2\xde\x14\xe0\xfb\x51\x00\x41\x79\xc2\x08\xa5\xf1\x34\xe9\x63\x78\xd3\x0b\x23\x3f\xb9\x26\xec\x60\x9c\x7a\x2c\x4a\x43\x02\xff\x8d\xa7\x19\x1a\x03\x4f\xef\x03\x67\xf5\x90\x9f\x60\x34\xc1\xc9\x38\xcc\x32\x1c\xa0\x49\x12\x5f\x86\x01\x0e\x68\xd0\x09\xb2\x4e\x07\xf1\x68\x14\x5f\x85\xd1\x05\xea\xc7\x51\x10\xd2\x35\x4c\x2a\x8d\x71\xb6\xc1\x56\xfc\x32\x52\xd1\x4a\x41\x31\x4c\xf1\xe9\xc7\x01\x46\xe3\x69\x9a\x91\x8d\xda\x0f\x23\x00\xea\xf7\xe2\x4b\xf2\x69\x72\x0d\x5d\x44\x51\x9c\x85\x7d\xec\xd1\xb8\x42\xa3\x30\x05\xcd\xb2\xdc\x5e\x14\x68\xc8\x04\x61\xda\x1f\xf9\xe1\x18\x27\x2b\x2e\x1c\xc2\x48\x1e\x08\x8e\xc3\x24\x89\x83\x69\x1f\xdf\x3b\x1a\x88\x75\x2d\x88\xfb\x53\x11\x07\x83\xd4\x58\x8d\x13\x16\x23\x63\xec\x67\x38\x09\xfd\x51\x9a\x0f\x33\xcc\x0d\x54\x93\x50\x27\xf3\x7c\xba\xb7\x7f\x82\x4e\x0e\x77\x4f\x7f\xdd\x3a\xde\x41\xfb\x27\xe8\xe8\xf8\xf0\x97\xfd\xed\x9d\x6d\xf4\xfa\x5f\xe8\x74\x6f\x07\x75\x0f\x8f\xfe\x75\xbc\xff\x66\xef\x14\xed\x1d\xbe\xdb\xde\x39\x3e\x41\x5b\xef\xb7\x51\xf7\xf0\xfd\xe9\xf1\xfe\xeb\x0f\xa7\x87\xc7\x27\xe8\xc7\xad\x13\xb4\x7f\xf2\x23\x7c\xd8\x7a\xff\x2f\xb4\xf3\xdb\xd1\xf1\xce\xc9\x09\x3a\x3c\x46\xfb\x07\x47\xef\xf6\x77\xb6\xd1\xaf\x5b\xc7\xc7\x5b\xef\x4f\xf7\x77\x4e\x3c\xb4\xff\xbe\xfb\xee\xc3\xf6\xfe\xfb\x37\x1e\x7a\xfd\xe1\x14\xbd\x3f\x3c\x45\xef\xf6\x0f\xf6\x4f\x77\xb6\xd1\xe9\xa1\x07\x8d\x9a\xd5\xd0\xe1\x2e\x3a\xd8\x39\xee\xee\x6d\xbd\x3f\xdd\x7a\xbd\xff\x6e\xff\xf4\x5f\xd0\xde\xee\xfe\xe9\x7b\xd2\xd6\xee\xe1\x31\xda\x42\x47\x5b\xc7\xa7\xfb\xdd\x0f\xef\xb6\x8e\xd1\xd1\x87\xe3\xa3\xc3\x93\x1d\x44\xba\xb5\xbd\x7f\xd2\x7d\xb7\xb5\x7f\xb0\xb3\xbd\x82\xf6\xdf\xa3\xf7\x87\x68\xe7\x97\x9d\xf7\xa7\xe8\x64\x6f\xeb\xdd\x3b\x6b\x2f\x09\xee\x4a\x1f\x5f\xef\xa0\x77\xfb\x5b\xaf\xdf\xed\xd0\x96\xde\xff\x0b\x6d\xef\x1f\xef\x74\x4f\x49\x77\xf2\x5f\xdd\xfd\xed\x9d\xf7\xa7\x5b\xef\x3c\x74\x72\xb4\xd3\xdd\x27\x3f\x76\x7e\xdb\x39\x38\x7a\xb7\x75\xfc\x2f\x8f\xc1\x3c\xd9\xf9\xbf\x1f\x76\xde\x9f\xee\x6f\xbd\x43\xdb\x5b\x07\x5b\x6f\x76\x4e\x50\x65\xce\x90\x1c\x1d\x1f\x76\x3f\x1c\xef\x1c\x10\x9c\x0f\x77\xd1\xc9\x87\xd7\x27\xa7\xfb\xa7\x1f\x4e\x77\xd0\x9b\xc3\xc3\x6d\x18\xe8\x93\x9d\xe3\x5f\xf6\xbb\x3b\x27\x2f\xd1\xbb\xc3\x13\x18\xad\x0f\x27\x3b\x1e\xda\xde\x3a\xdd\x82\x86\x8f\x8e\x0f\x77\xf7\x4f\x4f\x5e\x92\xdf\xaf\x3f\x9c\xec\xc3\xa0\xed\xbf\x3f\xdd\x39\x3e\xfe\x70\x74\xba\x7f\xf8\xbe\x8a\xf6\x0e\x7f\xdd\xf9\x65\xe7\x18\x75\xb7\x3e\x9c\xec\x6c\xc3\xe8\x1e\xbe\x87\xae\x9e\xee\xed\x1c\x1e\xff\x8b\x00\x25\x63\x00\x83\xef\xa1\x5f\xf7\x76\x4e\xf7\x76\x8e\xc9\x80\xc2\x48\x6d\x91\x21\x38\x39\x3d\xde\xef\x9e\xca\xc5\x0e\x8f\xd1\xe9\xe1\xf1\xa9\xd4\x47\xf4\x7e\xe7\xcd\xbb\xfd\x37\x3b\xef\xbb\x3b\xe4\xeb\x21\x81\xf2\xeb\xfe\xc9\x4e\x15\x6d\x1d\xef\x9f\x90\x02\xfb\xb4\xd9\x5f\xb7\xfe\x85\x0e\x3f\x40\x97\xc9\x1c\x7d\x38\xd9\xa1\x3f\x25\x8a\xf5\x60\x26\xd1\xfe\x2e\xda\xda\xfe\x65\x9f\xa0\xcd\x0a\x1f\x1d\x9e\x9c\xec\x33\x3a\x81\x21\xeb\xee\xb1\xe1\x5e\x79\xfa\xe4\xf9\xaa\xaa\xf3\x3a\xf0\xb3\xe1\xfd\xea\xbd\xca\x45\x9d\xa6\x81\x8f\x45\x11\xfa\x58\xca\x3a\x1b\x2e\xec\xfc\x28\x4b\x51\xe6\xf7\xb8\xc4\x42\xaa\x7c\xfc\x32\xb2\x06\xdb\xcc\xe5\xa8\x9a\x87\x50\xdd\x43\xa8\xe1\x21\xd4\xf4\x10\x6a\x79\x08\xb5\x3d\x84\x3a\x1e\x42\x6b\x1e\x42\xeb\x1e\x42\x2f\x3c\x54\xaf\x79\xa8\x5e\xf7\x50\xbd\xe1\xa1\x7a\xd3\x43\xf5\x96\x87\xea\x6d\xc9\xc2\x72\x8d\xd6\x25\xdf\x08\x3c\x52\x9e\xc0\xa8\xb7\x29\x5c\x52\x0f\xda\x7a\xc1\xe0\x37\x18\x8c\x3a\xb4\x91\xc3\x69\xb2\xb6\x5a\x0c\x97\x17\x0c\xc6\xba\x84\xe7\x1a\x83\xd5\x61\xb8\xd4\x29\xcc\xba\x1c\x6b\xb9\xce\xea\x72\x5c\x6a\x14\x06\xe0\xc1\xf1\x6c\x52\x58\x04\x7e\x5d\xee\xb7\x0c\xa7\xc5\xea\xb6\x19\xee\x6b\x0c\x46\x43\xc2\xb3\xce\x60\xad\x33\x5c\x58\xbf\xeb\xcd\xf3\xea\x4b\x79\x2e\x92\x39\x73\xc1\xf1\x58\x93\xc6\xaa\xc1\x60\x72\x9c\x3b\xea\x78\x40\xdf\x9a\x5a\xdf\x3b\xac\x4e\x33\x87\x05\x75\xdb\x39\xce\x1c\x06\x1f\x0f\x68\xab\xae\xf5\x1d\x0a\xb5\xa5\x0e\xae\x31\x04\x3b\xf9\xe0\x0a\x20\x0d\x69\xa0\x29\xb2\x39\xa0\x75\x56\x47\x1a\x2c\x98\x98\x76\x3e\xb8\x02\x46\x53\x1a\x68\x8a\xac\x84\x50\x83\x8d\x6c\x4d\x02\xc6\x47\x63\x4d\xcc\x9e\xa0\x50\xc4\x46\x87\x22\xab\xce\x46\x3a\x6f\x65\x50\x14\xd9\x58\x01\x7a\x72\x4b\x9c\xb6\x9a\xd2\x78\x76\xf2\x6f\x0a\x4d\xaf\x79\xf0\x09\x86\x8a\xd3\xeb\x8b\x9c\xf6\x38\x4d\xd5\xdb\xd2\xb0\xae\xb1\xb2\xca\x7c\xd4\x73\x22\x10\x73\xf1\x82\x15\xe4\xc4\xb3\x2e\x95\xe1\x88\xaf\xc1\x6f\xf9\x2c\x25\xd6\x72\x2b\xaf\xca\xdb\x17\x6b\x5e\x5e\x13\xeb\x0a\xc8\x1c\x14\x5f\x9f\xed\x9c\xf6\x45\x3f\x1b\x39\x0a\x62\x9c\x18\xc9\x50\xb8\x48\x9b\x92\x79\x0b\x84\x21\xa6\x0c\x7e\x3b\x47\x00\xfa\xb9\x96\x2f\x44\x68\xb0\xc5\x10\xe9\x68\x48\x37\xd5\xc1\x17\x9d\xae\xe7\x70\xc4\xd8\x89\x05\x0d\xdf\x15\x38\x82\x81\xd4\xa5\x41\xea\xe4\xed\x8a\x85\xc7\x16\x70\xbd\x69\x99\x0f\xd1\x01\x0d\x71\x0e\x48\x2c\xb8\x86\xf4\xdf\xb6\x58\xc5\xea\x00\xb5\x2d\xe5\x5a\xea\xcc\x88\x99\xcc\x3b\x85\xea\x75\x74\xae\x64\xc9\xfe\x38\x24\x2b\xc4\x32\x1f\x48\x84\x6a\xae\x79\xa8\x36\x6b\x6f\xad\x37\xd6\x5e\xbc\x78\x41\x7e\x77\x76\xb6\x5f\xec\xbc\xde\xaa\x93\xdf\xeb\xbb\xf5\xd7\xaf\xbb\xdb\x5d\xf2\x7b\xeb\x45\xbb\xb9\xbb\xdd\xda\x51\xe7\x7b\x98\x38\x1b\x68\xd7\xb6\x1a\xeb\xaf\x77\x3a\xd0\x40\xb7\xb5\xbd\x5d\x6f\xb4\xa0\x81\xed\xb5\x5a\x73\x67\xb7\x49\x7e\xaf\x6d\x75\xb6\xd7\x3a\x3b\xd0\x30\x47\xe8\xdc\xaa\x0f\x38\xde\x3f\xda\x39\xd8\xae\x77\x6a\x10\x7e\x7f\x8e\x0e\x49\x94\xcd\xb5\x48\xd2\x2b\xba\x2b\xdf\xf6\xae\x88\x2a\x13\x01\x09\x47\x10\xec\xce\x5a\xab\xdd\x68\xd6\x60\x04\x77\x76\xbb\xdb\x5b\xaf\xd7\xa1\x83\x2f\xd6\x5f\x6f\x6d\x77\x77\x77\xc8\xef\x7a\xad\xd9\x68\xb7\xd6\x60\x70\xba\xcd\xed\xc6\x4e\x7d\xb7\x76\xee\x54\x8d\x97\x55\xca\x5b\x15\xbb\xa5\xbd\x94\xea\x05\x37\x35\xf3\xcd\xf1\x29\x16\xa0\x7b\xcd\xcd\x22\x1d\xd7\x37\x07\x1f\xa5\xd2\xfc\xf2\xe0\xa3\x69\xc8\x84\x8a\xee\x54\xa4\x7a\x68\x13\x55\xcc\x02\x88\x1a\x80\x4a\x8d\xe5\x86\x0f\xd2\xcb\xc5\x8c\x4a\x0d\x80\xcc\xae\x54\x03\x68\x5a\x97\x9a\xe0\x0a\x54\x63\x68\x9e\xad\xf3\x1e\x12\xf7\x0f\x84\x14\x9d\x57\x8e\xc0\x00\x3e\x0e\x47\xee\x02\x09\x14\x48\x9c\x05\x40\xfc\xfc\xf8\xc5\x0d\x01\x64\xa2\x8f\x5f\xdc\x10\x60\x9b\xfe\x98\xba\x21\xc0\xa6\xf1\x31\x4d\xec\x11\xad\x57\x57\xc9\x2a\xfb\x4c\x0e\xcd\x97\x7e\x12\x12\xe9\xd8\x72\x49\xeb\x8f\x3c\xd4\x1b\x79\xa8\x3f\xf2\x50\x30\xf2\x10\x1e\x59\x1a\xf2\x13\x0f\xf5\x12\x0f\xf5\x13\x0f\x05\x89\x87\x70\xa2\x37\xe6\x13\x54\x7c\x82\xf0\x9e\xe9\x32\xd2\x4b\x20\xe8\x38\x7c\xac\xeb\x1f\xfb\xe4\x63\x9f\x7e\x6c\xe8\x1f\x03\xf2\x31\xa0\x1f\x9b\xfa\x47\x38\x30\x60\xfa\xb1\xa5\x7f\x14\x69\xaa\x7d\x35\x2f\x35\xef\x92\x7e\x2b\x68\x35\x25\x84\xff\x2e\x6d\xa2\xba\x75\x6d\x67\x64\xf9\xf8\x23\xb4\x94\xaf\xa9\xa5\x2f\xa3\xb3\xf0\xfc\xbc\xfa\xd5\xe6\xc4\x00\x5e\x3b\xaf\xea\x9d\xea\x1f\x4f\x9f\xa8\xac\x91\xb4\x81\x06\xf5\x4a\x6f\xe4\xf5\x47\x5e\x30\xaa\xa2\x25\x34\x1c\xd9\x7d\x6f\x6e\x90\x50\xc8\x85\xaf\x9a\x0d\xaa\x6a\xb3\x40\x6b\xe8\xd0\x8c\x91\x37\xa0\xb5\xd6\x9d\xd0\x9a\x3a\x34\x63\xaa\x0c\x68\x9d\x96\x13\x5a\x4b\x87\x66\xcc\xad\x04\xed\x8f\xd5\x55\x06\x71\xbd\xe6\x84\xd8\xd6\x21\x1a\x04\x81\xec\x61\xd2\xc9\x24\x66\xd6\xe9\x22\x5f\x50\x12\x67\xa3\x4a\xe6\xa5\x64\x5a\x6d\x4e\x1b\x40\x03\xd9\x12\x1e\xd9\xa7\x1c\x56\x84\xb1\xa4\xc8\x1f\xd0\x6d\x60\xfb\x02\xe4\x0e\xed\x92\x35\x59\xb7\xba\x01\xc1\x7a\xe9\xd9\x6a\xc3\x32\x33\x6e\x12\x05\xaa\x7e\x82\x96\x24\x6a\x4d\x6e\x4f\xad\xed\x4a\x2f\xf1\xfa\x89\x17\x24\x30\xe2\xc9\xdd\xa8\xb5\xa5\x43\xbb\x2b\xb5\xaa\xd0\xee\x44\xad\x0d\x1d\xda\x9d\xa9\xb5\xae\x43\xbc\x67\x6a\x4d\xe0\xd6\xba\x80\x5c\x13\x07\xb9\x02\x47\x4d\x6c\xe4\x0a\x8c\xd8\xf6\x05\x58\x34\x25\xd7\xc4\x49\xae\xb0\x01\xd8\x6a\xc3\xd6\x60\x5a\x68\xe8\xac\x7c\x5f\x4e\xc7\x00\x32\x24\x58\xfd\x6a\x12\x26\xf9\x67\x13\x55\xf6\xa8\x69\x6e\x9f\x70\xe6\xc0\xd2\xd3\x3d\x66\xc2\xbb\x47\xcd\x6f\x03\x52\xce\x36\x22\x7b\xcc\x4c\x77\x8f\x1a\xd2\x62\x52\xce\xb7\x96\x6b\xb2\x72\x60\x2c\x0b\x3b\x42\xcf\x5a\xae\xc5\xca\x81\x61\x72\x8f\x94\xeb\x5b\xcb\x81\x01\xb3\x32\x2c\xba\x58\xbb\xcb\x52\x6b\xdc\xc1\x3c\x2b\xf0\x33\x5f\x08\x43\xe4\xc1\xb2\xf1\xcf\x4f\xc3\xc8\x4b\x46\xaf\xc3\x2c\x3d\x8d\x33\xe0\x78\x14\x66\xb4\xed\x67\x3e\xb5\xda\x7a\x8e\xd6\x2d\xd0\xa1\xce\x3b\x3c\xc8\x8c\xa4\x8d\x50\xde\xe8\xcc\x56\x10\x98\x59\x88\x11\xcb\xb7\x48\x8d\x99\x72\x90\x44\x9a\x6c\x9f\xa3\xaf\x9b\x34\xb1\x70\x6e\x23\x21\x4a\xfc\x03\x35\x1b\x3a\xb5\xe6\x90\x2a\x95\x4a\x5e\x74\x09\x11\xfe\x40\x40\xbe\xa8\x12\x50\x2d\xb2\x6e\xeb\x2d\x87\x00\xcd\xab\xd2\xe1\xc8\x85\x67\xe9\x65\x79\xe1\xd9\x00\xc6\x04\x67\x0d\xd8\x3c\xc1\xd9\xd6\x51\x39\x4f\x47\x9e\x0f\x93\xe7\xd8\x01\xe3\x18\x4b\xda\x8e\xd5\x55\x38\x09\x22\xc8\xee\x42\x1d\xb2\xac\x86\x53\x13\x7a\xf2\x32\xb3\xb9\x14\x93\x25\xac\x6e\x59\x46\xb7\x10\xce\x1e\xda\x44\xb2\xf8\x7e\xb7\xf3\x5b\xbb\xd4\xf1\xcd\x7e\x22\xdb\x83\xa3\xd8\x9e\xc5\x99\x04\x15\x9d\xc1\xf6\x84\xbb\xde\x9e\x72\xbc\xda\x5b\xf8\x5c\x45\x29\x64\x4f\x39\x53\xed\x39\x0f\x53\xf3\x4d\xe1\x8e\xe9\x4d\x38\x9d\x5c\x96\xc1\x22\x80\xc1\x56\x8b\xb2\x1b\x73\x6d\x82\x14\x36\xd5\x1f\xc5\x51\x31\x83\x02\x53\x02\x52\x2a\xd7\x2e\xc0\xa3\xdb\x0c\x82\x7e\xfe\x68\x10\x09\xad\x67\xd2\x1a\x43\x13\xbe\x2a\x76\x51\xf0\xf3\x86\xde\xfe\x23\xd9\x22\x6e\x50\xaf\xcc\x3c\x74\xed\xa1\x2f\xb6\x34\x1f\x95\xca\x0c\x3c\x3b\xaf\xe1\xdf\x2f\x79\xb6\xf6\x1b\x03\x4e\xa3\x18\x4e\x65\x56\xfd\xa9\x72\x5d\xa5\xee\xe4\xff\x26\x0f\x5f\xaa\xd5\xea\x4b\x17\xb4\xe6\x5c\x68\x04\xd0\xbf\x09\xc4\x1c\x35\x07\xac\xd6\x7c\x58\x3f\x01\x04\xc0\xed\xba\xfa\x53\xe5\xdf\x80\x9c\x1b\x62\xbb\xcc\x98\x91\x41\xfb\x9a\x83\x72\xc0\x02\x51\x62\xe6\x45\x56\x48\xb3\x57\xaf\x22\xc0\x6a\xf6\xf3\xcf\x3f\x57\x9a\x8d\xe5\x48\x46\x8a\xfe\x28\xb4\x86\xe1\xc6\x30\x34\x0f\x5c\x39\x63\x18\x67\xb6\x1f\x66\xdf\x02\x36\x4f\xfc\x77\x16\xff\xff\xec\xbd\x7d\x77\xd4\x38\xd2\x28\xfe\xf7\xf0\x29\xb4\xfb\x3b\x0b\x1d\xd2\x49\x2c\xf9\x4d\x06\x32\xbf\xcb\x64\xe0\x61\xee\xc0\xc0\x81\xcc\x85\xe7\x70\x60\x56\xb6\xe5\xb4\x87\x4e\x77\x9e\x6e\x87\x24\xbb\xc3\x9e\xfb\x35\xee\xd7\xbb\x9f\xe4\x1e\x95\x64\x5b\xb6\x25\xb9\x3b\x84\x79\x76\x76\xc9\x9e\x65\xba\xdb\xa5\xaa\x52\xbd\xa9\xac\x97\x92\x8c\x4c\x43\x34\x96\xfb\x79\x2c\xd0\xd7\xbd\x98\x47\x79\x46\xbb\x93\xa5\x9e\xc0\x9b\xdc\x51\x2c\xde\x31\xec\xc2\xb1\xb7\xba\xa8\xb9\x35\x6d\xb7\x19\x4e\x0e\xf6\xb6\xda\xd4\x00\x9b\x6d\x55\xaa\x95\xf3\xe4\xd9\xc3\xa3\xdf\x41\x35\x8e\xe6\x1f\xf8\x15\x34\x5d\xf3\x6c\xc5\x2b\xcb\xdd\x49\x16\x85\xc2\x95\x83\x37\xa8\x50\x79\x91\x61\xa3\x9a\x27\xa7\x2c\x6b\xd5\xa3\x6f\xb1\x32\x68\xa8\x03\x3c\xd4\xd2\x29\xcb\x0c\x9a\xfa\xe6\x93\x5c\x07\xb6\x6c\x8d\xaa\x21\xcd\xb7\x13\x7d\x7a\x37\x8d\xe3\xaf\x5b\x9c\xfe\x15\x8e\xac\x7c\xe9\xa5\xfb\x5e\x61\x35\x8d\xb0\xb5\x64\xda\xab\x27\x0f\xf7\xf0\x06\x2b\x19\xc3\xbb\xaa\x6f\x72\xfd\xe2\x10\x4e\x9f\xb6\x4b\x18\xe5\xa2\xac\x26\x86\x02\x54\xdd\x25\x0d\x5e\x64\x39\x4b\x69\x62\xa8\xcd\xe4\x5d\x26\x34\x65\x79\x56\xf0\xce\x1a\x87\x09\x30\xf3\x73\xc2\x71\xe1\x75\x9f\x7d\xfe\x12\x88\x2d\x43\x37\x27\xdf\xc3\x19\xf4\x01\x82\x4d\xe6\x9e\xcd\xd3\xc5\xe2\x51\x6a\x9e\x2c\x86\x84\xd1\x3c\x55\x0c\xaf\xab\xe6\x89\x62\xf1\x88\x37\xd3\xc4\x03\x4e\xad\xf3\xc4\xd6\x39\x61\xcb\xdb\x02\xcc\xfb\x20\x79\xc2\xd4\x52\x0b\xe6\xb5\x4c\xfc\xbb\x25\x30\xba\x67\x4f\xeb\xbf\x7a\x42\xc9\x8c\xa8\x3e\xe7\xf0\xfa\x6d\x89\xf6\x90\xff\x0e\xbd\x57\x1f\x69\xfb\x11\x07\xda\xe7\xc8\x76\x77\xa4\x62\x69\xb2\x80\xc3\xb1\xf2\xdd\x12\x5e\x1f\x7c\x6c\x2e\x53\x63\x7e\x13\x82\xa9\xa5\x09\x13\x48\x42\x40\xc2\xe4\x9b\x4c\x0c\x07\x64\x39\xda\x05\x42\xb6\x89\x46\xf4\x00\x11\xcf\x2a\x35\x98\x36\x9b\x4c\x52\x74\x1b\x65\x32\xcf\x15\x1f\x73\xc0\xec\x5d\x86\x4c\xae\xc2\x8e\x4c\xf1\xa1\x07\x28\x18\x23\x91\xa2\xf7\x28\x43\xef\x51\x2e\x31\x47\x3c\x4f\x78\xca\x4c\x45\x87\x7a\x98\xa3\x2d\x98\x97\xbc\x8b\x4f\x99\xea\xc5\x1e\xf2\x2e\x63\x8f\x07\x81\x4f\x02\x3b\xad\x83\xbb\x0d\x39\xea\xed\xa0\xbb\x07\x1b\xf7\x45\xe0\xf7\xc3\x24\xf7\x39\xe9\xcf\xf2\x20\x8b\x4a\x85\xbf\xe4\xa6\xe9\x3e\x74\x88\x32\xd3\x14\x1f\x02\x92\x0f\x1e\x20\xdf\x53\xbd\x04\xf5\x1b\xef\x16\x45\x87\xc8\xc4\x07\xdb\xec\xb4\xd6\x46\x93\x81\x6a\x12\xad\x9e\x6c\x63\xfd\x13\xde\xa8\x33\x11\x08\x13\x86\x83\xca\x27\xa8\x33\x09\x08\x93\x85\x99\x19\xc6\xd7\x27\x0a\x73\x33\x4c\xa0\x4f\x12\xf2\x3e\xcc\xd7\x09\xbe\x7f\xd6\x09\x3e\x91\x0b\xef\x17\xf3\xe5\x72\xa5\xcf\xb9\x1d\xc0\x40\xad\xfe\x3e\x8b\x08\xd4\x42\x68\x31\x8f\xcc\xd3\x0d\xa6\xe9\xbe\xd0\x0c\xdd\x96\xf3\x40\xc6\xe9\xba\x3f\xe2\x6c\xd0\xd7\x29\x84\xc1\x64\x80\x48\x9f\xb7\x9a\x3d\x80\x06\xae\x89\x83\x6e\x42\xde\x9d\x33\x10\xcf\xbe\x4e\x17\xdc\xe8\x74\x01\xe8\x63\x83\x99\x02\xb3\x5a\xda\x49\x02\xa5\x1a\xfb\xb1\x29\x01\x60\x9f\x16\xa0\x7f\xe8\x02\x1b\xeb\x19\x23\x61\xf4\xa5\x6b\x63\x28\x2a\xff\x3e\xd3\x07\x83\xe9\x01\xfd\x1d\x9e\x84\x51\xe7\x2d\x5e\x3b\x85\xdd\x9f\x15\x20\x24\xd8\x6c\x5e\x40\x00\x76\x70\xc2\x77\x89\xfc\x77\x9d\x1b\xc8\xb0\x17\x26\x3c\xa7\xe2\x95\xdf\x8f\xe2\x2c\x0f\xbd\x18\x3e\x7b\xb1\x97\xe7\x18\x3e\x17\xb1\xc7\xc3\xc4\x37\xcf\x19\x14\x45\xe6\x79\xa9\x0f\x93\x0b\x11\x0d\x29\x0e\xb1\xfc\x1c\x14\x09\x2d\x18\x20\x48\x79\xc1\x82\x82\x05\x5b\x4c\x17\x6c\x94\x79\x6a\x61\x5f\x89\x4e\x6b\xe9\x38\x45\x0b\x11\xb5\x49\x67\xf6\x0e\x87\xc9\x8b\x65\x61\xe9\xeb\x10\x3d\x32\xe2\x12\x12\x6c\x3b\x48\x8b\x26\x23\xc3\x74\xc7\x3b\x06\x03\x35\x21\xe6\x43\xec\x5f\x87\xea\xcf\x18\xaa\x85\x56\x36\x1b\xac\x8d\xca\xe9\x0c\xd7\x52\x41\xce\x01\x9b\x90\xfe\x51\x67\xed\x5c\xb3\x1a\x8e\xee\xc5\x89\x18\xc0\x93\xaf\xf3\xfa\xff\x3d\x03\xf3\x1f\xef\x58\xde\x0f\xf2\x12\x87\xf2\x6f\xcd\xa9\x5c\xb4\x5a\x9e\x2f\x72\x94\x75\xcf\xeb\x69\x3d\x78\xd2\xbf\x3a\xe5\xc7\xee\x32\x40\x3d\x51\xcb\x5b\x1c\xf2\x89\x29\x83\x41\xfa\x92\x72\xb9\x7e\xb1\x2a\x4f\xf9\x64\x61\x1c\xc6\xd6\xff\xb5\xaa\x7e\xaa\xdf\xf3\xc5\x97\xc9\xa2\xff\x9e\xd9\x4c\x04\x4b\x75\xa2\x43\x44\xee\xd7\x9f\x1f\x1c\x4a\x0c\xf5\x0f\x8e\xb9\xe1\x3f\x4d\x16\xe8\x2f\x0a\x6c\xc7\x3a\x5f\xa8\x7c\xb4\x60\xf3\x35\x1f\xdf\x15\xd8\x9f\x1f\xab\xdf\xc7\x57\xe7\xdd\x37\x5c\x83\x58\x4e\x78\xf5\x78\xc5\xe0\x33\x9b\x7f\x57\x56\x6b\x83\x80\x9a\x25\xfc\x05\xda\x43\x93\x05\x54\xf6\xdc\x41\x77\x3b\x93\x1f\xfd\x99\x2c\x8d\x56\x3d\x4b\xad\x57\x66\x87\xdf\x40\x21\xbd\xfa\x3d\x17\xb3\x72\xce\xd1\x44\x3d\x7b\x80\xd4\x96\xcc\xbe\x14\x5b\x6d\x5a\x05\xdd\xa0\xa0\x56\x29\x3f\x79\x2b\x81\xa0\xec\xe8\x40\x10\x60\x0b\x67\xcb\x8b\xc9\x62\x8a\x30\x3a\x40\x64\x67\x83\x8a\xed\x08\x6e\x42\xd9\x06\xad\xbf\x63\x2c\x9e\x2d\x51\xec\xee\x8e\x4c\x85\x2e\x3a\x10\x75\x86\x34\x69\x71\x5e\x7f\x8d\x4d\x24\xde\x9b\x65\xd3\xc3\x0c\xfd\x8b\xaf\xb4\x3d\xd9\x5f\xcf\xcb\x8c\x4f\xbc\x9d\xaf\xab\x5e\x1b\xaf\x7a\x0d\x1e\x15\xf0\x28\x34\x3d\x3a\x81\x47\x83\x05\x23\xc8\x59\xe0\x51\xfc\xd9\xcb\x68\x91\xa3\xd6\xfd\xef\xbd\x8c\x76\xc2\x4e\x4f\x99\x77\xd9\x2c\xa6\xe1\x81\x50\x86\xd0\xb0\xd1\x78\x52\xb7\x7c\xf0\x00\x11\xb9\xe8\x55\xff\xf2\xed\xb7\xdf\xa2\x78\x67\x07\xa1\xf7\x66\x4c\xdd\xbf\x0e\x26\x1c\x0c\x30\x61\xba\xb3\xb3\x19\xa6\x6e\x3b\xdf\x18\x5e\x3a\x3d\xc1\x6d\xbf\x8d\x9b\xe4\xbb\xc0\x5a\xb7\xb1\x64\x56\xeb\x36\xbe\xac\xeb\x4d\x6f\xc8\x6c\x17\x93\x3f\xc4\x94\x6c\xd9\xed\xba\x9d\xf9\x4e\x02\xd4\x1a\x8e\x52\xe2\xae\xea\x39\x14\xf9\x55\x3d\xdc\x75\x2e\x98\xda\x56\x3f\x33\x38\xd5\x38\xe1\xe8\x36\x2a\x60\xb3\xdb\x3f\xc4\xc7\x13\xdb\x15\x2e\xa7\x0c\x2a\xcc\x31\x74\x1b\xa5\x00\xce\xe4\xea\xe0\x7b\xa4\xd6\x09\x4d\xfc\x43\xb2\x52\x9e\x08\xc6\x9b\xa5\x56\xb5\xd8\xa6\xd6\x5a\xe5\xd6\x3f\xf9\x04\x27\xda\x13\xec\x77\x1e\x75\x1a\x99\xc7\xb6\x86\x18\xdc\x53\x33\xe1\x60\xe3\xb2\x72\x32\x87\x76\x91\xc2\x28\x9f\x60\xed\x09\xc6\xfa\xa3\x58\xee\x6c\x95\x8f\x48\x68\x1e\xf1\x60\x01\x59\x50\x9a\xa1\xdd\x9a\xec\xae\x10\xea\xae\xbc\xe8\xcd\xba\x78\x0c\x0d\x09\x3a\xac\x05\xb3\x2b\x44\x6b\xa2\x20\x02\xd7\x89\x01\x81\x88\x75\xfd\x3a\xed\xe2\x4f\x84\x47\x53\xfa\x05\xb5\x33\xe1\xb6\x04\x6c\x5a\xe6\x43\x23\x4b\xa4\xfd\x6a\xeb\x68\x64\x39\x74\x52\x09\x41\x54\xc4\x44\xeb\xdf\x65\x69\x54\xc2\x84\x0a\x06\x4a\x86\x17\x66\x98\x48\xc1\x40\x49\xf0\x13\x33\x4c\xac\x60\xc0\xe7\x67\x5f\x97\x61\xbf\x2e\xc3\x7e\x5d\x86\x1d\x66\x9b\x5f\x97\x61\xff\x29\xe7\x78\xc3\x68\xeb\x39\xde\x30\x1a\x9d\xe3\xd5\xdf\xd9\x86\x73\xbc\x61\xf4\x75\x8e\xf7\xc6\xe7\x78\xc3\x68\xd3\x39\x5e\x93\x72\xba\x73\xbc\xa0\x20\xf7\xa6\xed\x66\xed\xcc\xbc\x34\x4b\xbd\x3f\xf4\xd2\xec\x65\x14\xfc\x2e\x17\x17\x34\x74\xbe\xce\x02\x77\x67\x81\x2f\x23\x58\x53\xdd\xbf\x8c\x02\xed\xf7\x37\x51\xa0\xaa\x74\x03\xc4\xbe\x56\x27\x7a\xab\x9a\x6e\x5a\xff\x5e\x3e\x79\xfe\xcb\xf3\xc7\x8f\x5f\x3d\x3a\x7e\xd5\x9f\x2d\x7e\xf1\xc3\x2f\x3f\xfc\xf4\xfd\xa3\x37\x8f\x86\xb7\x72\xbf\x7c\xfe\xf3\x4f\xdf\xff\x72\xf4\xfc\xa7\x57\xc7\x0f\x7f\x6a\x5a\x6a\xe4\xe4\xb4\xf2\xd1\x66\xd3\xca\x5a\x8b\xd5\x6c\x59\x17\x6d\xe9\xcd\x49\xd7\xa4\xc5\xdb\x35\x9e\xa2\x2b\x5b\xa9\xf2\x4a\x4e\x89\x54\xe8\x01\x22\xc1\x7d\x54\x19\xa6\x44\xb4\x3e\xbf\xbd\x44\xbb\x28\x44\x77\xd1\x95\x3c\x3d\x58\xd5\x87\x34\xe1\x13\xd9\x81\x99\x4a\xf4\x17\x14\x0d\x72\x11\x48\x03\xf9\xc5\x1b\x74\x88\xae\xd0\x5f\x50\x68\xca\x12\xf9\xc5\x7f\x0a\xac\x04\xdd\x45\x82\x8e\x2f\xe8\xec\x18\x80\x2f\xe5\xb4\xdc\x9b\xde\xcf\x57\xf2\xe7\xff\xb4\x4c\x05\x6b\x62\x3b\x2b\x51\x09\xd7\x09\x18\x84\xd6\x48\xe6\x52\x4a\xe6\x52\x1e\xd0\xbc\x34\x08\xa6\x01\x95\xd2\x45\x57\x12\xf4\xca\x32\xad\xd4\x1a\x48\x57\x8c\x57\x70\xc1\xcf\xb0\xd7\x42\xae\xfd\xae\x7f\x1a\xed\x5b\x6f\x95\xa3\x6b\x0d\x4f\x1f\xbf\x7a\x29\x78\xbd\xf4\xb0\xc9\x18\xf4\x7b\x27\x2c\xf3\x63\x02\x0c\x48\xd4\xc6\xfa\x6c\x7d\xd1\xb3\x2d\x23\xd8\xd3\x1a\xcc\x22\x42\x75\xf3\xc4\xaf\xe8\x01\x8a\xef\xa3\x5f\x1d\x33\x73\xd0\x07\x38\x9a\x6a\xae\x8a\x52\x93\x4f\xcb\xea\xc5\x72\x0d\x75\x5c\x85\x55\xc1\x65\xb9\xbf\xee\xa0\x3d\x64\xda\x4d\x5d\x23\xd7\x1b\x3d\x40\xaa\x5e\x84\x09\x58\xfc\x0d\x3a\xf8\xfe\x10\x01\x19\x0d\x8b\x85\x56\x77\x47\xb5\x4e\xf5\xdb\x43\x20\x6b\xdf\x5c\x3d\xa0\xfc\x4c\xa3\xdc\x41\xb5\x67\x78\xef\x69\x18\xd8\x6c\x6a\x49\x33\xac\x05\xbf\xac\xc0\x80\x46\xd4\x42\xed\x3b\xd1\x0f\x0e\xd0\x8b\x55\x79\x5a\x56\xe5\x47\x8e\xce\x96\xf3\xab\xc5\xf2\xb4\x64\x73\xb4\xfc\xc8\x57\xe8\x3f\x1e\x4f\xc8\xce\x3d\x74\xf9\x9e\xa2\x5d\x74\xf9\x3e\x82\x7f\x43\xf8\x37\x10\x61\xc6\x8c\x52\x59\xb4\x24\x2f\xcf\x0f\xbc\x47\xde\x65\xec\xd8\x32\x6f\x61\x4e\x61\x38\x34\xda\xc7\xc8\xa2\x57\x2f\xc0\xcb\x39\x3e\x35\xfc\xd4\x05\xc6\xfa\x3a\x9b\x0e\xec\x67\x67\xdb\xd5\x94\x35\xf8\x4f\xc5\x4f\xcf\x96\x2b\xb6\xba\xea\xdc\x44\x27\x5c\xe0\x58\x1f\x88\xac\xab\x94\xc6\x5b\x67\xcc\xde\x7f\x6c\xec\xd9\x18\xdf\xbd\xb5\x1d\x7f\xb3\x95\x1d\xbf\xb3\xae\xe3\xbb\x56\x75\x6e\xfe\x2a\x81\xe5\x79\x75\x76\x5e\x3d\x85\x57\xeb\x0e\x2c\x82\x24\x3d\xe7\xeb\x72\xc5\x73\xed\xa2\x81\xb4\xac\xd6\x75\x41\x68\xd9\xb8\xf3\xb6\x50\x37\x7e\xbe\x98\xd7\x6a\xd2\x6a\x70\xb3\x15\xbf\x87\x08\x09\xa6\x88\x84\xd1\x14\xf9\x34\x98\xa2\x10\x93\x7e\x63\x75\x67\xc1\x3d\xf1\x4c\x7f\xd4\xbf\xb4\xa0\x7e\x69\xb6\xde\x5b\xa0\xf7\xae\x87\xed\x1a\xf7\x17\xc0\x4c\x2d\xdc\x84\x58\xbf\x7b\xd7\xdf\xde\xbe\xb3\x44\xfb\x0d\x4c\x4d\xfc\x01\x1e\x69\x72\x0b\x7e\xd1\x98\x1d\x2c\xc2\x8d\x95\x12\x00\x4e\x9a\xdb\x7a\x61\x04\x88\x3c\x0f\xed\x21\x31\xd0\x36\x37\x25\xe8\x92\x10\xd9\x8b\x4f\xbe\xd4\x8a\x9e\x61\x62\xce\x20\x34\xe3\xe4\x59\xdd\x89\xa7\x6c\x01\x73\x3f\xbd\xae\x1d\x20\x62\x9a\x43\x4b\xd7\xcb\x55\x3a\x2e\xff\x1e\xfa\xcf\xa9\x24\xf8\x8c\x94\xa8\xbb\x28\x26\x64\x6d\x9d\x36\x7f\x46\xe0\x0e\xfa\x3e\xb8\x88\xf5\xae\x62\x16\xd6\x2b\xa8\x05\x79\x67\x3d\x41\xd2\x29\x24\x48\xae\x53\x41\x90\x74\x4a\x07\x92\xeb\xd7\x0c\x54\x0c\xe3\x31\x8e\x71\x97\x65\x7c\x2d\x9e\x71\x97\x69\xbc\x0d\xd7\x46\x3d\x48\xe3\x6a\xa6\x46\xca\x45\xb5\x94\xd6\x6c\xd6\xf4\x9c\xc1\x64\x5e\xed\xce\x06\x51\x08\x88\x7d\xb8\x6f\xf6\xfd\x21\xc8\xc5\x06\x33\x5f\x5e\x20\x05\x33\xbe\x1a\xf1\x52\x0c\xb0\x6b\x8b\x0f\xc8\x44\x19\xfc\x40\x7e\x94\x49\x2f\x7c\xb6\xbb\xc0\xf1\x8c\x57\x6c\xf8\x64\x8b\xb7\x06\x0d\xd9\xb3\x52\xbc\x82\xcc\xcf\x4f\x17\xd0\x39\x83\x5b\xd5\x12\xac\xd3\xec\x29\x6a\x33\x69\x23\xf0\x96\xef\x24\x3a\x8d\x8e\x96\xda\x37\x14\x0b\x21\xf1\x57\xa7\x9e\x8d\xf6\x5c\xb0\x4f\x35\xd8\xf9\xf2\xc2\x9a\x97\x5a\xa5\x75\x6c\xcc\x73\x4c\x3d\x39\x16\x5a\x38\x7e\x7b\x69\xe3\xfd\xf8\x52\xda\xda\x21\xf4\xc0\x0e\x04\xc6\x76\x08\xac\x6f\xb6\xfb\xe6\x7a\x66\xe0\x08\xab\x6d\x8f\x02\xe8\xd2\x44\xe8\x25\x80\xd7\x43\xd7\x62\xf9\xf1\x25\x6e\xc1\xf1\x26\xe0\xd2\xbe\x8e\x2f\xb1\x4b\x8f\x0a\xf6\x69\x03\x0b\x7a\x74\x9a\xf7\xfa\x7c\x05\x1e\x25\xaf\x13\x11\xa6\x3e\x6e\xe5\xc7\x97\x81\x8a\x05\x68\x32\x51\xbc\x35\x47\x83\x15\x7d\x75\x3e\xd8\xf6\x7a\x03\xd8\x9e\x36\xd8\x64\xd4\x90\xd8\x9e\xf6\xb0\x3d\x1b\xc7\xf6\xbb\x3a\x55\x27\x14\x3a\xec\x13\xf5\x43\xa2\xc5\x4c\xd1\x56\x6f\x7b\x2f\x67\x4b\xf4\xa2\x74\x58\xb6\x20\x59\xdf\xf9\x88\xef\x6b\x5f\x65\x2a\xd7\x7c\xff\x6c\x93\xef\x48\xae\x41\xeb\x32\x63\x01\x24\x2d\x68\x2c\x20\xd5\xd0\x4f\x5b\x68\x7b\x48\x82\xc1\x62\xb6\x7c\x2e\xb3\x94\xc3\xce\x7c\x98\xce\x97\xb5\xb3\x2f\x97\x90\xe8\x39\x42\xbc\x78\x81\x6e\x49\x8c\x4e\x3c\x68\xbe\x32\xa9\x3b\xfd\xe0\x41\xcb\x24\x98\x76\xdd\x3f\xb8\x4a\xd3\x27\x68\x4f\x7b\x6e\x33\x74\xd4\x75\x9d\x06\x87\x11\xf9\xb3\x2d\x91\x77\xe7\x3c\xda\xee\x6e\x34\xe3\xd1\xef\xb2\xe2\x4a\x43\x03\xb3\x1d\x43\xe6\xa2\xe0\xda\x3d\x7f\x36\x42\xe3\xe9\x96\x34\x5c\x63\xdb\x8a\x2d\xd6\x67\xcb\xb5\xd3\x4a\x20\xfc\xbe\x28\x9f\x4a\xc7\x38\x7e\xab\x4d\x28\xb6\x76\x68\x1d\xf3\x64\xc3\x4d\x06\x3e\x05\x39\x36\xfa\x59\xe3\xc7\x59\x89\x58\x05\x43\x20\xc4\x4b\x73\x4e\x78\xec\x41\x1f\x8c\x45\x5b\x9b\x97\x23\xaf\x09\x00\x46\xb8\x63\xaf\xee\x8e\x84\xb6\xb9\xfc\xb1\x57\x77\x46\xc1\x59\xc6\xad\x83\x03\x74\x34\x73\x05\xbf\xcd\x87\xf5\x6b\x0e\x19\xe3\xa1\x11\x69\xe1\xab\x8e\xc3\xcd\xb8\x32\x62\xdc\xdb\x85\xd4\xba\xd5\x71\x63\x70\x9b\x37\xb9\xc4\x4d\xa3\x89\x96\x84\xec\x6c\x32\x00\x4a\x04\xa4\x87\x80\x0c\x10\x38\xa5\x28\x72\x8f\xd5\xf2\xc2\x21\xc4\xb9\xe6\x0d\xc7\xad\x6b\xbc\x47\x93\x7f\x28\xf6\xe5\x0f\xb7\x6b\x66\xe0\xab\x2b\x7e\xcc\x35\xaf\x39\x6e\x5d\x48\xc7\x08\x3f\xb4\x18\xe7\xcb\x8b\xcf\x9f\xa0\xfd\x61\x69\x7a\x23\x19\xe8\xdb\xea\x69\x9d\x69\x48\x31\xbe\xf5\x26\x33\xe1\xf9\xe8\x4b\x5b\x07\x8b\xcd\x11\x3b\xf9\x4a\xb7\x85\x70\x49\xc7\x62\xc7\x3f\xd7\xb6\x28\xc3\x24\xcd\x8d\xef\x8a\x1a\xc0\x37\x33\x3e\xa2\xdd\x70\x1a\xe8\x2e\x4c\x5e\x0d\xe7\x81\xae\xbb\x97\x0a\x5f\x67\x2b\x15\x6c\x92\xca\x78\x39\xef\xee\x77\xc2\x3b\xe8\xa0\xcb\xff\x0e\xba\xdb\xff\x01\x88\xc3\x02\x4d\xb3\x9b\xeb\x9f\x64\x13\xd4\x67\xcf\xe1\xe9\xd3\x8c\x35\xf3\xc6\x39\x48\x74\x60\x54\xbd\x0e\x52\xcf\x02\x0e\x71\x1e\x18\x37\xd3\xbd\xfa\xaf\x73\xce\xff\xc6\x87\x48\x67\x6c\x3d\xab\x8d\x7b\xa3\xbb\xe8\x07\x5c\x7c\xce\x64\xe1\xf8\x9c\xd0\xe6\x29\xbd\x2d\x9d\xdf\x7e\x0e\xb1\xa5\x67\x9f\x95\xd3\x52\x43\x35\x31\xa7\x27\x9c\x5b\xcd\xcd\x69\xa8\xd4\xf4\x9c\x8e\xea\xba\xf3\x8a\xad\x28\xdc\x9d\x78\x3a\xe8\xc4\xd3\xeb\x76\xe2\xe9\xa0\x13\x4f\xb7\xeb\x84\x59\x55\xd2\x74\x95\x93\x55\x4b\xb4\xe2\xd5\xaa\xe4\x1f\xb9\x61\x03\x22\x52\x87\xbb\x65\x3c\x38\x3b\x5f\xcf\x6a\x36\x4c\x22\x32\x40\x3e\x1b\x42\x7e\x7e\x79\x62\xc3\xe9\xa1\x86\xf4\x74\xe8\xc2\xd6\xf3\x44\x37\xb4\x6b\xd2\x1e\xbf\xd4\x16\x4a\x43\x38\x6b\x0e\x3b\x6d\x10\x21\x36\x5c\xcc\xa9\x3f\xb6\xfb\x33\x9d\x62\xff\xba\x5d\xf3\x9a\xdb\x35\xfd\x6d\x37\x6b\xfa\x63\x5b\x35\x7d\xc7\x46\x4d\xff\xeb\x36\xcd\x9b\xde\xa6\xe9\x6f\xb8\x49\xd3\xa0\x96\xce\x16\x4d\x7f\x93\x0d\x9a\xbe\xfd\x18\x7e\xb3\xf1\xf0\x1e\x0d\x3e\xbd\x9b\x52\xfc\x2f\xb2\x5d\xb3\x5f\x60\x27\xc4\xe4\x77\xdb\xc3\x59\x97\xdb\x11\x34\xff\x58\xe5\x76\xae\xb5\xdb\x52\x3d\x6e\x77\x7b\xd6\x30\x5b\x15\xe4\x09\x31\xe9\x6c\x0b\x09\x31\xb1\x6e\x33\xa1\x1b\x16\xe4\x11\x80\x9d\xad\x26\x54\x55\xb5\x08\x31\xb9\xb1\x23\xc4\x7a\xf7\xad\x35\x79\x06\x9b\x1c\xbc\xcb\x2c\x4d\xd3\x24\x0f\xf3\xa9\x56\xb0\x67\x67\x6a\x82\x8c\x48\xc2\x48\x42\x98\x5e\xce\x67\xc7\x50\xb7\xc7\xd0\x34\xc1\x61\xe2\xe1\x90\xe9\xd5\x7f\xcc\x44\x70\x48\x0a\x9e\xc9\x9a\x41\x75\x6d\xa0\x0d\x89\x44\xb1\xef\x93\x28\x92\x65\x85\x54\xe5\x20\x33\x11\xca\xd3\x20\x60\x34\xd6\xeb\x0a\x6d\x48\x24\x4f\xbd\x8c\x70\x2f\xd7\xcb\x10\x99\x89\x04\x71\x1a\x06\x14\xe7\x7a\x91\xa2\x5e\x6a\x7a\xd3\x55\x8a\x84\x3d\x5d\xb3\x4a\x11\x8e\xbe\x96\x29\xba\xa1\x9c\x88\x6e\x5d\xa6\x48\x34\x19\xcb\x8b\xf4\x98\x31\xcc\x8c\xe8\xd7\x32\x45\x37\x9f\x1b\xd1\x4d\xcb\x14\x19\x95\xd3\xcd\x8f\xe8\x68\x99\x22\x9f\xba\xcb\x14\x89\x61\xfc\x1e\x25\xa6\x6c\x89\xfc\x8b\x64\x4b\xff\xd2\x87\x5b\x6e\xf6\x60\xcb\x17\x3a\xb2\x72\xfd\x24\x4a\x3e\x6a\xba\xab\x10\xfd\x52\xef\xe0\x35\xdc\x75\xd3\xdd\xe4\xbb\xcf\xce\xce\xe6\x57\x13\xf5\xe3\x14\xb1\xd5\xc9\xf9\x29\x5f\x54\xeb\xfe\x9d\x3c\xfa\xf1\x99\x96\x1f\x28\xa5\xd4\x92\xe8\x91\xf7\x2e\x03\x42\x19\x29\x12\xc8\x2b\xf2\x98\x50\xc6\x09\xd9\x99\x0e\xe1\x62\xec\xc7\x41\x90\x40\x99\x41\xe2\xf3\x22\x0a\xb3\x5c\x4f\x0d\x06\x0d\xd2\x30\xf3\x8a\x34\x2b\xe0\x02\x84\x2c\xc8\xfd\x94\x14\x26\xc4\x3c\x49\xc3\x3c\x65\x21\xdc\x9e\x8d\x69\x92\xa7\x69\xe6\x44\xec\x27\x61\x94\x91\x30\x85\x74\xc6\x0f\x68\x1a\xfa\xd4\x84\x38\x4c\x0a\x8c\x71\x01\x1c\xa7\x91\x17\xe6\x1e\x4e\x9c\x88\x13\xe2\x17\x94\x30\xb8\x72\x9b\x15\x38\x09\x8a\x24\x35\x21\x66\x29\xce\x42\x9e\x03\xc7\x39\x8b\x72\x8a\x31\x75\x22\xce\xa9\x17\x33\x26\x65\xcc\x7c\xcf\xf7\x48\x60\x94\x31\x26\xd4\x0f\x53\x79\x67\x44\x10\xc6\x5e\x54\xa4\xdc\x89\x98\x04\x3e\xa6\x61\x0a\x77\x47\x04\x9c\x07\x29\xa1\x99\x51\x14\xa1\x97\xc5\x79\x06\x17\x88\xe7\x61\x51\xa4\x01\x27\x4e\xc4\x31\x49\x79\x98\xc7\x20\x8a\x82\xc4\x29\x4d\x22\xa3\xf2\xa8\x97\xf3\x14\xcb\xcb\x2b\xfc\x14\x47\x49\x94\x62\xb7\x8c\xd3\x3c\xf3\x22\x59\xa1\x92\x84\x59\x8c\x89\x1f\x9a\x10\x67\x38\x49\x0b\x2c\x19\xc8\x8a\x28\x21\x51\x12\x38\x11\xf3\x20\x49\xa3\x24\x03\xd9\x25\xbc\xc0\x01\xcb\x8d\x32\xe6\x45\xca\x83\x98\xc2\x35\xe2\x3e\x0d\x0a\x12\x72\xdf\x89\xd8\x2b\x32\x9c\xe4\x19\x34\xa0\x29\xcd\xf2\x30\x35\x72\x4c\x02\x2f\x63\x38\xcb\xe0\x92\xf6\x98\x65\x49\x16\x85\x6e\xe5\xe5\x3c\x21\x59\x04\x0e\x12\x26\x24\xf5\x48\x6c\x44\x1c\xb0\x38\xa0\x01\x83\x77\x84\x88\xb3\x88\x07\xd4\xcd\x71\x98\xa5\x1e\x4b\x72\xe0\x24\xcd\x03\x5c\xa4\x79\x60\x74\xe9\xa8\x48\x28\xcd\x01\x31\xf5\x31\x0e\xfd\xd4\xcd\x71\x42\x7d\x1e\xe2\x90\x80\x4b\xf3\x28\xca\x0b\x66\x76\x10\xea\xe3\x2c\x8a\x20\xc3\x27\x79\x1a\xf8\x04\x7b\xee\x58\xe1\x79\x3e\x89\x33\x2a\xef\x7c\x2f\x52\x82\x7d\xa3\xb9\xa5\x45\x98\xc4\x45\xa6\xea\x9b\xf2\xc2\xe3\xdc\x6d\x15\x59\xc4\x3d\x2f\x2d\xc0\xf0\xfd\x9c\x51\x5a\x64\x46\xab\xc8\x43\x16\x27\x38\x00\xc4\x89\xef\x31\x16\x13\xb7\x28\xbc\x28\x63\x91\x1f\xca\xeb\x5d\x3c\xcf\xa7\xc4\xec\x20\x38\x20\x09\x49\xe4\xbb\x97\xc7\x3c\x1e\xf1\xd8\x2d\x0a\x12\xa7\xb1\xc7\x28\x04\x97\x20\xca\x09\x29\x0a\xa3\x4b\x13\x8e\x85\x98\x40\x64\x61\x46\xa2\x2c\x21\x91\x13\x71\x90\x93\x2c\xca\x0b\xb0\x8a\x90\x65\x01\x61\x3c\x37\xc6\x0a\xdf\xa7\x5e\x8e\x41\x64\x49\x9e\x84\xa9\x9f\x17\x4e\xc4\x51\xe8\xb1\xd8\x0f\x03\xe9\x20\xac\x88\xfc\x9c\x9b\xcd\x2d\x62\x1e\x4b\x21\x6e\xfb\x59\x1c\xa7\x84\xb9\xc3\x26\xc5\x19\xc9\x12\x22\xa3\x5b\xcc\x73\xc6\x79\x64\x42\x9c\x90\x98\x90\x4c\x8a\x0c\x07\x94\xf8\xa1\x9f\x3a\x11\x33\x92\x16\x9c\x32\x19\x67\xb3\x02\x7b\x7e\x64\x74\x10\x46\x31\x8b\xa2\x00\x38\x4e\xb3\x80\xf8\x9e\xe7\x8e\x6e\x19\x09\x52\x9a\xc6\x1e\xc4\x59\xaf\xa0\x49\x9c\x60\x63\x74\x8b\xa3\x2c\xc4\x0c\x64\xec\x45\x61\x90\x72\xdf\x6d\x15\x39\x4e\x08\xa7\x38\x01\xc4\x11\x2f\x42\x82\x8d\x63\x5e\x1e\x25\x89\x17\x11\xd0\x45\x18\x46\x21\x4b\x46\x3c\xaf\x08\x3c\xee\x87\x52\x76\x61\x1c\x63\xe2\x11\x66\xb4\x63\x2f\x62\xcc\x93\x3d\xf3\x49\x9a\xe6\x38\x75\x2b\x0f\x27\x2c\xc8\x30\x86\xb0\x99\xd2\x9c\xe4\x5e\x66\xe4\x18\x73\x3f\x8e\x32\x4f\xda\x31\x0e\x30\x4b\x43\x77\x74\x23\x71\x40\xe3\x38\x00\x3b\xce\x0b\xca\x79\x9a\x24\x26\xc4\x7e\x90\x7a\x69\x96\x42\xcf\x38\x4e\xd2\x80\x8e\x98\x9b\x9f\xe0\xcc\xcb\x52\x50\x4a\x16\x66\x49\xc8\x22\xdf\x18\x8f\x79\x4e\x19\x0b\x20\x6c\x72\x3f\xc0\x94\x65\x6e\x73\x0b\xd3\x24\xcb\x58\x50\xc8\x91\x21\xf2\xb9\x1f\x1b\x11\x47\x94\xf0\xa8\x90\xc1\x2a\x8f\x52\x92\x52\xe6\x16\x45\x1c\xd0\x82\x12\x0e\x0e\x12\xe6\xbc\x48\x89\x39\x56\xc4\x94\x85\x91\x2f\x47\x9a\xc0\xc7\x31\x29\x22\xb7\x55\xd0\x20\xa3\x31\xc5\x32\x13\xc2\x85\xc7\xd2\xd8\x18\x36\x69\x96\xc5\x1e\x91\xca\xc3\x2c\x0a\xfc\x84\xbb\x73\xb7\xc4\x4b\x79\x51\x14\x4c\x66\x91\x91\x8f\x39\x31\x5a\x05\x0b\x42\x2f\xca\x38\x78\x5e\xce\x29\x49\x73\xee\xce\xdd\x52\x5e\x24\xcc\x2f\xe4\xc8\x40\xb2\x28\x4e\xb0\x39\xaf\x88\x62\x1c\xd3\x42\x0e\x61\x7e\x4c\x42\x9f\xb8\x95\x97\x31\x12\xfb\x3c\x03\x19\x73\x46\xa2\x08\x27\x46\x19\xe7\x98\x46\x29\x95\x43\x13\x11\x86\x44\xba\x93\x80\xc3\x44\x84\xe5\x2c\xce\x73\x70\x90\x2c\xe7\x1e\x4f\xb1\x31\x6c\x16\x61\x9c\x07\x45\x5c\xa8\x41\x97\xe7\x38\x76\xdb\xb1\x17\x15\x5e\x14\xcb\x7c\x21\x26\x38\x8e\x8a\xd4\xe8\xd2\x1e\x8b\xfc\x38\xcf\xc0\x41\x18\xc9\x68\x42\x99\x7b\x04\xc1\xd8\x2f\x12\xea\x05\x6a\xe2\x2e\xf1\x72\x66\xe4\x18\xa7\x31\xf6\x52\x5f\xc6\x63\x1f\x67\x41\x8c\xdd\x32\x26\x34\x4f\xe3\xb8\x08\xa5\x55\x78\x41\x9c\x53\x63\x3c\xf6\x49\xc6\x58\x1a\x83\x55\x04\x5e\x16\x93\x20\x71\x3b\x88\x9f\x25\x3c\xe5\x1e\x88\x02\x87\x59\x92\xf2\xd4\xa8\xbc\xc0\xc7\x79\x14\x67\xd0\xb3\x24\xc3\x9e\x97\x07\x6e\x3b\x0e\xb2\x2c\xcc\x03\x99\x78\x67\xa9\xcf\x03\x92\x1a\x87\x26\x91\xae\x90\x24\x81\x60\x55\x64\x51\x18\x73\x11\x5e\x5d\xb1\xa2\xc8\xd2\xa8\x60\x72\x90\x64\x79\x54\x30\x6e\xe4\x38\xca\x82\x00\x27\x14\x10\x07\x2c\x88\x43\x8a\x63\x35\x89\xfa\xce\x71\x6c\xb5\x7d\x2f\x7c\x7d\xdd\x13\xaa\xb6\x6b\xd0\x5e\x77\x4e\xa8\xfe\x72\xbd\x13\xaa\x21\x26\x9b\x2d\x1d\x18\x96\x23\x6e\xbe\xfa\xe8\x75\x97\x0e\x22\xe6\x25\xbc\x9e\x70\xf7\xd3\x2c\x4b\x3c\xcb\xd2\x41\x9a\x46\x31\xe3\x72\xf8\xa5\x41\xc6\x58\xdc\x4d\x5d\x1c\x44\xfc\x2c\xe2\x85\x1f\x43\x24\x2b\x78\x12\x14\x54\x44\x32\x13\x24\x0b\x83\xa2\x08\x7d\xf0\x82\xb0\xc0\xb9\x1f\x15\x9b\xce\xea\x87\xd8\xe3\x21\x91\xc1\x87\xe5\x3c\xa2\x24\xb7\x2c\x1d\x24\xa9\x17\x46\x54\x1a\x24\x49\x7d\x1e\x65\xb8\xd8\x90\x08\x2e\xa8\x9f\x27\xd2\xe6\x8b\x34\xc0\x69\x1e\x59\x7a\x12\xa6\xdc\xcb\x72\x99\x06\x61\x3f\xe6\x04\xc7\xc9\x36\x4b\x07\x37\x7d\x8e\x74\x93\xd2\xb0\x00\xe7\xd9\x2b\xbf\x3e\xc1\xf6\xd2\xaf\x4f\x88\xbd\xf6\xeb\x13\xdf\x5e\xfc\xf5\x49\x60\xaf\xfe\xfa\x24\xb4\x97\x7f\x7d\x12\xd9\xeb\xbf\x3e\x89\x2d\x05\x60\x65\x07\xa1\x3c\xac\x71\x1f\xb8\x7c\x3e\x97\xcf\x87\x87\x3d\xa4\x0c\xa0\xb9\xf1\x08\x94\x7c\x3e\x97\xcf\x2d\xcd\x09\x34\x27\xd6\xe6\x64\x2e\x9f\x5b\x9a\xfb\xd0\xdc\xb7\x36\xf7\xe7\xf2\xb9\xa5\x79\x00\xcd\x03\x6b\xf3\x60\x2e\x9f\x5b\x9a\x87\xd0\x3c\xb4\x36\x0f\xe7\xf2\xb9\xa5\x79\x04\xcd\x23\x6b\xf3\x68\x2e\x9f\x5b\x9a\xc7\xd0\x3c\xb6\x36\x8f\xe7\xf2\xb9\x61\x5b\xdf\x86\x45\x8f\xa5\x65\x98\x90\x33\x69\x14\xfd\x8a\x7b\xb0\xe5\x56\x1a\x84\xa9\x55\x2a\x6d\xc1\xd4\x2a\x93\x76\x60\x6a\x95\x49\x13\x30\xb5\xca\xa5\xfa\x4d\xad\x72\xa9\x79\x53\x2b\x2e\xb5\x6e\x6a\xc5\xa5\xc2\x4d\xad\x0a\xa9\x6c\x53\xab\x42\xea\xd9\xd4\xea\x44\xea\xd8\xd4\xea\x44\xaa\xd7\xd4\x6a\x26\x55\x6b\x6a\x35\x93\x5a\x9d\x9b\xea\x0e\xba\x8e\xee\x6e\x78\x1d\xaa\xb5\x9e\x76\x4d\xff\x75\x29\x6b\x0f\xdb\x8e\x9b\x3f\x82\x11\xbc\x5e\x3e\x1b\x82\x6c\x50\x28\x5a\x92\x11\x22\x78\x5d\xd6\xa7\x0d\xf4\xaa\xd1\xe8\x2e\x22\xef\x00\xd2\x5c\xcb\xb5\xc5\x31\x97\x38\xd4\xf9\x82\x3e\x0e\x38\x35\x7f\xad\x0a\xd4\x07\x07\xe8\x3f\xa0\x1a\xb1\x9d\x78\x5d\xd2\x79\xab\x0a\xd5\x97\xb3\xa6\xce\xf1\xe5\xd8\x59\x3c\x05\x36\xd7\x5a\xb8\xcf\xe3\x49\xa8\x59\xa7\x0a\xf6\x4c\x16\xff\xd5\x8b\x57\xcf\xa1\x44\x71\x5d\x0e\xb8\x03\x47\x07\x70\xb0\xe9\xf5\x3d\xea\x82\xc5\xae\x13\xa6\x12\x72\xde\xe1\x62\x3e\xe4\x62\x66\xe2\x62\x3e\xe4\x62\xa6\x73\xd1\x85\x8b\x87\x70\x96\x4a\xc6\xba\x4a\x2d\x35\x73\x3e\x6a\xb5\xb7\xb7\x29\xbe\xdd\x6a\x14\x6f\xa6\x51\xdc\x6a\x14\x6f\xa4\x51\x3c\xeb\x14\xf8\x9e\xd5\x55\xb8\xb5\xc2\xdc\x73\x55\xab\x5b\x13\x12\x56\x12\xee\x82\xc1\x3e\xe6\x44\x53\x69\x8d\x2f\x1a\x55\x29\x9e\x77\xd8\x98\x1b\xd8\x98\x99\xd8\x98\x0f\xd8\x98\x75\xd8\xe8\x22\x8c\x06\xf8\x48\xe4\xd4\xe9\x56\xb5\xc3\x5d\xa1\x24\x6e\xd5\x1e\xbb\xd4\xfe\xba\x8c\x65\xe4\x32\x0e\xcc\x3d\xc8\xb9\x82\x74\x9c\x09\x97\x90\x38\xd2\x02\x89\xf5\x56\xe8\x1a\x56\x32\x80\x8d\x99\x45\x1f\x76\x5e\xc3\x8e\xf2\xd0\x46\x9a\xb9\x10\x5a\x19\xf7\x47\xae\x2e\x78\x1b\xca\x66\x12\x7c\x06\x35\xdb\x04\x1e\xa1\x49\x6f\x07\x3d\xa8\xbd\xb3\xf9\xe5\xff\x47\x18\xdd\x43\x83\x6d\xd3\x43\x3e\xc4\xbf\xb5\x06\xc7\xd9\x10\xff\xee\x36\xde\x62\xe1\x02\x5f\x97\x0b\x90\xe2\x86\x3c\x48\xed\x0c\x39\x90\x9a\x18\xd0\x37\x23\x6d\x47\xc5\xd7\xa5\x4d\xbd\xed\xa8\xf7\xba\x34\x31\x67\xaf\x89\xaf\x8a\xe2\xcf\xd0\x6d\x54\xcc\x54\x59\x7c\xf1\xc5\x7c\x8e\x4f\xb6\x91\xbe\xcf\xe7\xa2\xcd\x5c\xb5\x11\x5f\x4e\xe6\x8e\x62\xfa\x33\xa8\xa6\x2f\x50\xa7\x92\x0e\x7c\xce\xe4\xe7\x54\x7d\xb6\x37\x9f\x43\x73\x41\x25\x95\x24\xe1\x73\x26\x3f\xa7\xea\xb3\xbb\x24\xff\x4c\xd6\xe4\x57\x01\x47\x8e\x2b\x6c\x2e\xcb\x4b\xef\xc8\xe2\x07\x6c\x56\x57\xec\x57\x0f\x3b\x35\xfb\x67\xda\x2d\x12\xac\x1e\x75\x9c\x95\xf9\xe1\x6d\x6a\xd2\x20\x52\x34\x67\x5d\x9a\xf3\x0e\xcd\x59\x97\xe6\x5c\xa7\x39\xdb\x84\x26\x96\xfd\xe4\x6a\x68\x90\xe7\x4d\xb8\x1c\x14\x68\x5d\xf6\x7f\x56\x5f\x5a\xa1\x3d\x0c\xda\x87\x82\xa6\x5f\x3f\x93\x65\xb8\xdd\x34\x65\x3f\x15\x70\x4d\x73\xd6\xa5\x39\xef\xd0\x9c\x75\x69\xce\x75\x9a\xb3\x96\xa6\x31\xeb\x1c\xbf\x87\xc0\xcc\xeb\x8f\x50\x7d\xe9\x47\xfb\x61\xaa\x1f\xc1\x79\x7f\x2c\x5d\xc7\xa8\x7e\x84\x60\xf0\x63\x69\x0b\xa1\x1f\xe1\xa2\x04\x01\x33\x9b\x37\x2c\x9a\x9c\x52\x02\x0a\x82\xb3\xb6\x2f\x32\x5c\x54\x58\x0f\x17\xb3\x4d\x62\x55\x4b\x56\xfc\x2b\x24\xe2\xa6\x59\x01\xa9\x6c\x66\x22\x98\x5d\x8b\xe2\x8f\xc6\xd0\xd3\xa7\xf8\x63\x69\xa2\xf8\x63\x79\x1d\x8a\xe6\x60\xd7\xa7\xf8\xda\x48\xf1\xb5\x89\xa2\xd9\xda\xfa\x97\x57\x58\x48\xc2\xe4\x45\xed\xf6\x00\x68\xe5\x0e\xe6\x41\xea\xa8\xb4\x2b\xc3\x23\xb0\x48\x74\x16\x6b\x5c\x9b\xb1\xf9\xf3\x59\xce\x2a\x8e\x2e\xdc\x6f\xfa\xe2\x0f\xde\x37\x8d\xf6\x0d\xaf\x9b\x27\x26\xb6\x61\x00\x2a\x4c\x6d\xe0\xc5\xb6\x30\xb5\x81\x77\x68\x6e\x6a\x03\xaf\xd0\xdc\xd4\x06\x5e\xc9\x27\xf9\x1c\xae\xef\x98\xdb\xee\xef\x80\x77\xfa\x49\x3e\x03\x28\x29\x3a\xae\x4b\x2e\x1f\x08\xcd\x7a\x13\x88\xc0\x94\x99\x78\x84\x29\x85\xcc\xc4\x23\xcc\x5e\xa4\xa6\x36\x30\x79\x91\x9a\xda\xc0\x3c\x09\x33\xb5\x81\x69\x92\xc1\x6d\x06\xe2\x0f\xa6\x5d\x26\xd2\xd4\x2b\x62\x15\x06\x4c\xdc\x4c\xa4\x1c\x84\x65\xed\xb6\x23\x8e\x94\x46\x35\x4c\x76\x6e\xf4\xb2\x12\x6d\xce\x10\x32\x83\x27\x60\xff\x6c\x90\x0d\x3c\x69\x8a\x51\x4c\x9e\x80\xdd\x33\xc9\xec\x13\x4f\xe7\x96\x0d\x99\xed\xe3\xd1\x66\x19\x25\x41\x10\x51\x3a\x24\x88\x5b\x82\x20\x9e\x54\x11\xec\x44\x82\x74\x9c\xa0\x36\x2f\x29\x09\x12\x08\xb1\x43\x82\xa4\x25\x48\x66\xf5\xb8\x34\x01\x78\x2d\xbc\x8e\x13\xd4\x66\x32\x25\x41\x5f\x10\xcc\x87\x04\xfd\x96\xa0\x2f\x68\xe5\x8a\xa0\x3f\xe2\x0e\x7d\x3c\xda\xdc\xa7\x24\x18\x08\x82\x7c\x48\x30\x68\x09\x06\x82\x16\x57\x04\x03\x9d\x20\x1f\x27\xa8\xcd\x96\x4a\x82\xa1\x20\x58\x0c\x09\x86\x2d\xc1\x50\xd0\x2a\x14\xc1\x50\x27\x58\x8c\x13\xd4\xe6\x57\x25\xc1\x08\x5e\x2a\x86\x04\xa3\x96\x20\x64\xef\x27\x8a\x60\xd4\x79\x89\x18\x27\xa8\xcd\xc8\x4a\x82\xb1\x20\x38\x1b\x12\x8c\x5b\x82\xf0\xda\xa4\xc6\x64\x01\xef\x4a\x02\x3e\xfb\xec\xc5\xd7\x4b\x71\x6e\xee\x52\x1c\x2c\x92\x7b\x75\xb3\x99\x40\x06\x75\x58\x7c\xef\xa6\xaf\xc5\x31\x93\xc1\xff\x94\x17\xe3\x1c\x2d\x17\x1f\xf9\x4a\x56\xf9\x45\xd5\x12\xf9\x64\x2f\x2d\x2b\x91\xa0\xe4\x88\xc1\xfe\xec\x94\x17\xcb\x15\x57\xdb\xa9\x07\x5a\xd3\xce\x9a\x68\x6b\x77\xd5\xf2\x8d\x4f\x6e\xe2\x22\x9e\x3f\xea\x15\x3c\x3a\x9f\x4d\x7d\x90\x7b\x08\x7b\x24\x38\xf0\x55\x9d\xe2\xaf\xa7\x9b\xac\x47\x95\x42\x4c\xb6\x3d\xdd\x24\x9a\x8c\x9c\x6e\xea\x6c\x6b\x18\x9c\x6e\x0a\x31\xf9\x7a\xba\xe9\xa6\x4f\x37\x09\xad\x6c\x76\xba\xc9\xa8\x9c\xce\xe9\x26\xa9\x20\xe7\xe9\x26\x79\x8e\x76\xc3\xd3\xdf\xfe\x1f\xfa\x3c\x13\x5f\x64\x7b\x29\x5b\xf3\x28\xe8\x3d\x38\xcd\xc3\x3e\xe8\xc7\xb3\x0f\x79\xd1\xfb\x31\x2b\xcf\x66\x7c\xf5\xbb\x1c\x89\xd2\x58\x85\xef\x82\x43\xf9\x40\x32\x06\x9f\x75\x7e\xfe\x15\x8e\x4e\xbd\xde\xe8\x4e\x20\xd8\x3c\x73\x04\x5d\x6f\xe0\xb4\xdf\xc6\x8f\x42\x1d\x1c\xa0\x17\x7c\x75\x0a\xa3\xe8\xd1\x6c\x59\x66\x1c\xe1\xfe\xb5\x29\xa2\xf9\x8b\x23\xdc\x3d\xbb\x14\xc6\x53\x14\x24\x53\x14\xe0\x29\xf2\xfd\x29\x22\xe1\x14\xe1\x78\x8a\x92\x29\x42\x58\xdb\x6a\x14\xd2\x29\x0a\xbd\x29\x0a\xc8\x14\xf9\xc1\x14\x91\x68\x8a\x30\x9d\x22\xec\x4d\x11\xd1\xe1\x92\x29\x0a\xf1\x14\x05\xfe\x14\xf9\xe1\x14\x91\x78\x8a\x70\x32\x45\x58\xe0\xd7\xe0\x22\x6f\x8a\x42\x32\x45\x41\x30\x45\x7e\x34\x45\x91\x3f\x45\x61\x38\x45\x41\x3c\x45\x7e\xa2\x01\xfa\x78\x8a\x88\x3f\x45\x38\x9c\xa2\x78\x8a\x50\x44\xa6\x28\x0c\xa6\x28\x80\xab\x05\x74\x40\xc1\x09\x99\x22\x1c\x4c\x51\x24\x00\xf1\x14\x85\xfe\x14\x05\xe1\x14\xf9\xb1\x06\x48\x92\x29\x22\x78\x8a\xb0\x20\x39\x45\x88\xd0\x29\x22\xde\x14\x61\xc1\x8e\x04\x7b\xe7\x90\x2b\x31\xcb\x95\x74\xe5\x2a\xb8\x10\x72\x14\xfd\x26\xe2\xf3\x14\xa1\x50\xe7\x56\x11\x16\xdd\x12\xdc\x02\x43\x9e\xce\xa5\xaf\x04\x27\xb8\x12\x00\xd1\x14\xe9\xdd\xc5\x91\x94\x87\x10\x30\x70\xef\x77\x15\x21\x14\x2a\x04\x2c\xe4\xe7\xc7\x52\xb0\x61\xd8\x93\x57\xe0\x29\x6d\x85\x52\xfb\x81\x4e\x41\xa8\x46\x98\x86\x2f\x54\x1a\x49\xb5\x87\xba\x0e\x85\x0a\x84\x3d\x08\xbb\x10\x3a\x14\x82\xad\xb3\x9a\xce\x8d\x50\xe7\xa7\xe7\x73\x06\xd7\xa4\x88\xa4\x72\x3d\x2b\x8b\xc1\x0d\x4f\xe0\x05\x3f\x1c\xff\xf2\xea\xc9\x0f\x8f\xe5\x9d\x52\x42\x62\x64\x8a\xa0\xf3\x42\x42\x54\x58\xa4\x52\x13\x48\x57\x59\x2a\x56\xea\x24\xca\x7a\x41\x20\x54\xa7\xff\xea\xbb\xe7\x6f\xf8\x1a\xb1\x45\xae\x6a\xa3\x9f\x81\x4a\xe5\x7d\x1a\x06\x3e\x04\xfc\x2f\x2f\xba\xfa\xec\xa5\x94\xde\xa5\x77\x0f\x5e\x46\x28\xf1\xbc\x69\xff\x59\xfd\xae\x20\x41\x0c\x00\xa4\x03\x40\x3d\x8f\x0c\x40\x7c\x0d\x64\xf8\x34\xd0\x9f\x1a\x08\x84\x5d\x02\xc4\x40\x20\xea\x32\x69\x02\x89\x7b\xfd\x30\x10\xa2\x1d\x46\x86\x28\x92\x3e\x95\x21\x0a\xa6\x83\x98\x00\xd2\xbe\xb4\x86\x20\x59\x8f\xcc\x00\x20\xef\x77\x65\x08\xc2\x35\x90\x21\x85\xa2\xcb\xe5\xb0\x39\x75\xb5\xc6\x74\x54\x1f\x84\x8e\x10\xf0\xe9\x88\x55\x05\x7d\x22\x06\xbb\xa0\x6e\xbb\x89\xe8\xa8\x61\xc6\xd4\x65\x98\x94\x8e\xea\x3b\xa1\x23\xfa\x66\x7d\x26\x0c\x26\xd1\x27\x33\xe4\x24\xa3\xa3\x1a\xcf\xe9\x88\xd5\x70\xea\xb6\xee\xa2\x4f\xc3\xa0\x79\xab\xba\x54\x94\xc0\x66\x41\x12\xed\xa9\x45\x99\x7e\x07\xc4\x48\x3d\xe8\x62\x31\xf5\x31\xd4\x41\x8c\x36\xa1\xf3\x69\x78\x1e\x77\xd9\x70\xf8\x06\x76\x98\x7f\xd2\xe7\xd4\x1a\x28\xb0\x43\xa3\x69\xb7\x33\x06\xab\xe8\x74\xc6\x1a\x27\xb0\xc3\x7e\x79\x0f\xc4\x16\x2a\xb0\x39\x14\xd0\x51\x51\x60\x3a\x2a\x0a\x42\x47\x55\xef\x53\xb7\xda\x82\x1e\x0a\x5b\xac\x70\x89\x3b\xa2\x2e\x13\x8e\xe9\x88\x32\x28\x1d\x91\x64\x42\x47\x4d\x8b\x51\xb7\x42\xd3\xbe\xbc\x0d\x83\x47\x9f\xca\x10\x24\xa7\x2e\x95\x72\x3a\xe2\x42\x45\x5f\xa3\xfa\x1d\x55\xd3\xb1\x2c\x23\xf0\x3c\x1a\x78\xd8\x1a\x41\x14\x8c\x35\xcd\x68\x14\x68\x8b\x20\x35\x11\xcf\x44\x24\xe8\x12\x31\xc2\x84\x5d\x3c\x46\x66\xa2\x2e\x1e\x23\x4c\xdc\xc2\x18\xa8\xe8\xc1\xd6\xd8\x3c\xe9\x93\x30\x20\x61\xfd\xee\xd8\x13\x0e\x45\xc8\x80\x24\xeb\x08\xd6\x00\x90\xb7\x00\xd6\x00\x22\x59\x30\x34\x2e\xfa\x5a\xb1\xe6\x5d\x4e\x61\x62\x3a\xd2\x0b\x42\x5d\xd2\xf6\xfb\x24\x4c\xb6\x41\x7b\x7a\x37\xd9\x06\x1d\x17\x78\x44\x47\x0c\x35\xa6\xe3\x86\x4a\xe9\x88\x52\x12\xea\x50\x0a\xa3\x6e\x5f\x4a\xfb\x1c\xd8\x03\x89\xd3\x55\x72\x3a\x62\xc4\xbc\x2f\x53\x7b\x3c\xb1\x5a\x90\xfe\x02\x62\x78\x8a\x37\x70\x7b\x4c\x36\x70\x26\xec\x6f\xe0\xf8\x38\xd8\xc0\x9e\x71\xe8\x74\x7d\x1c\x8d\xb9\x24\x8e\x47\x82\xa1\x9e\x82\x9b\x31\x24\x63\xe1\x12\xb3\x31\xbf\xc7\xe9\x06\xd1\x12\x67\x63\x81\x0c\xe7\x1b\x04\x4b\xcc\x37\x08\x65\xb8\xe8\x6b\xc8\x68\x2e\x63\xa1\x02\xe3\x31\x0f\xc5\x64\x03\x07\xc1\xfe\x88\x97\xe1\x60\x93\xc0\x16\x6e\x10\x76\x70\xe4\x8c\x6e\x38\xde\x20\x2c\x61\xba\x81\x2f\xe2\x64\x03\xaf\xc7\x6c\x83\x68\x8a\xd3\xb1\x08\x86\x33\x57\x08\xc3\xf9\x58\x58\xe0\x1b\x84\x51\x5c\xf4\x22\xd4\x36\xa9\x0a\xf6\x02\x4b\x30\x32\xb3\x4c\x3a\x52\xc1\xd6\x14\x45\xe2\x36\x61\x0f\xb4\xe7\x9e\xe1\x79\xd8\x53\xce\x10\x22\xea\x08\xcd\x44\x23\xee\x40\x8c\x0f\xc7\xf6\xdc\xa4\xa5\x62\xcb\x4c\xea\x9e\xda\xb2\x92\x96\x8b\x21\x9f\x59\x4f\x9a\x43\x88\xbc\x23\x2d\x5b\x6a\x02\x18\x2c\x69\x89\x6a\x6b\x96\x80\xab\x7b\x98\x8e\xb1\x4f\xa8\xdd\x50\x7c\x3a\x66\x28\x01\x1d\x53\x74\x48\xdd\x9d\x8f\xa8\xdb\x94\x62\xed\xf9\xf0\x29\xa5\x76\xd1\x25\xd4\x25\x3a\x46\xc7\xcc\x2b\xa5\x6e\x27\xc8\xa8\xdb\x74\x72\x3a\x66\x18\x9c\x8e\x39\x41\x41\xc7\x4c\xbc\x93\x56\x58\x8c\x00\x8f\xb8\x2b\x26\x23\x16\x8a\xfd\xd1\x90\x81\x03\xa7\xa5\xe2\x70\xd4\xe1\x71\x34\x1a\x35\x70\xec\x8a\xc4\x74\xd4\x13\x71\x32\x1a\x32\x30\x73\x78\x23\x4e\x47\xc2\x05\xce\x46\xa3\x16\xd6\xc3\x81\x81\x04\x1f\x89\xbd\xb8\x18\x0d\x49\x2a\xb5\x70\x76\x13\x3b\xfd\x0a\x93\xf1\xd0\xe2\x3b\x22\x07\x0e\x46\xdc\x1a\x87\xa3\xb1\x05\x47\x4e\x07\xc6\xf1\x68\x6c\xc3\x74\x24\xf8\xe0\x64\xd4\x03\x31\x1b\x09\x03\x38\x1d\x8d\x81\x38\x1b\x0d\x05\x38\x1f\x8d\x47\x98\x3b\x82\x1d\x2e\xba\xd1\x68\x9b\xfc\x81\x7a\x92\xa4\x39\xb6\xd4\xd9\x27\xf6\x02\x4b\x2a\x51\x33\x6d\x78\xee\xb7\x18\x02\xb3\x21\x06\x76\x23\x0a\xbb\x12\x31\xe7\x10\x4d\x72\x6c\x22\x1f\x7b\x9d\xf4\xcf\x3e\x7e\xd6\x2b\x2a\xe6\x0c\xa2\xd5\xad\x39\x7f\x90\xcf\xcd\xb9\x43\x2b\x3e\xdb\x0a\x4a\x2b\x1e\x03\x8e\x5c\xf3\x52\x4b\xe6\x50\x9b\xb7\x39\x77\x68\x15\x6c\xe9\xbf\x53\xbf\x98\xda\xbb\x47\xe8\x18\xf3\x3e\x1d\x13\x40\x40\xdd\x2a\x0e\xe9\x58\x17\x22\x6a\xb5\x9f\x98\x8e\x19\x1f\xa5\x2e\xf9\x25\x5d\xe2\xb6\x24\xc2\x61\x1d\x29\x75\x69\x2f\xa3\x63\xd6\x97\x53\xb7\xfd\x72\xea\x76\xbf\x82\x8e\x79\x08\xf6\x46\x5c\x04\xe3\x11\x2f\xc4\x64\xd4\x0d\xb1\xef\x1a\x29\x9c\x16\x8e\xc3\x51\x17\xc1\x91\x37\xa6\x27\x1c\x8f\x46\x32\x4c\x47\xbd\x05\x27\xa3\xe1\x02\xb3\xd1\x80\x87\xd3\x91\x98\x89\xb3\xd1\xb8\x81\xf3\x91\xb0\x84\xb9\x23\x2e\xe1\xc2\x19\x36\x64\xf6\xe0\xee\x03\x1e\xf5\x4b\x4c\xec\x8e\x89\xfd\x11\xb7\xc7\xc1\x88\xe1\xe3\x70\xd4\x77\x70\x34\x1e\xdd\x62\x47\x78\xc3\x74\xdc\x79\x12\x67\xfc\xc0\x6c\x34\xfe\xe1\x74\x34\x88\xe2\xcc\x19\x44\x70\x3e\x1a\xa5\x30\x1f\x09\x53\xb8\xe8\xc6\x91\xed\x92\x07\x63\x4c\xa9\xf9\xb5\xad\x90\x34\xdc\x18\x53\x86\x7b\xda\x76\x0d\x63\xc6\xa0\x00\x60\x3e\xc5\x98\x37\x34\x39\x9f\xe1\x79\x54\x23\xb0\x01\xc4\x2d\x83\x86\xa7\xba\xce\x6d\x29\x43\xcb\x9f\x25\x67\x68\x7b\x68\xa0\x90\xb6\x0c\x9a\x59\xc8\x3a\x00\xa6\x81\xc3\xea\x7b\x5c\x57\x8e\x01\x75\xd1\x11\x8e\x79\xce\xc1\xd5\x1e\xd3\x11\xe1\x12\xea\xd9\x0c\xc7\xa7\x6e\xc3\x09\xa8\xcb\x70\x42\x3a\x62\x17\x11\x1d\x91\x5a\x4c\x47\x4c\x8f\xd2\x11\xd5\x26\xd4\x26\x77\x46\x47\x74\x9a\x52\xb7\xd5\x66\x74\xc4\x6a\x72\x3a\xa2\x39\x4e\xdd\x86\x5b\x50\x97\xd9\x63\xcf\xe9\xb6\x18\x7b\x56\xbd\x62\x32\xe6\xd3\xd8\x1f\xf3\x49\x1c\x8c\x78\x35\x0e\xc7\x9c\x02\x47\x63\x91\x03\xc7\x23\xbe\xdd\x8c\x7b\x56\x35\xe2\x64\xcc\x81\x30\x1b\x89\x8f\x38\x1d\x8b\x20\x38\x73\x46\x28\x9c\x8f\x45\x18\xcc\xed\x83\x73\x31\x12\x21\x20\x3f\x70\xeb\x0a\x8f\x58\x1a\x26\x23\x9e\x8e\xfd\x31\x67\xc6\xc1\x98\xb3\xe2\x70\x2c\x54\x45\xf6\x50\x84\xe3\xb1\x60\x81\xa9\xdb\x5d\x92\x31\x87\xc7\xcc\x1a\x2c\x70\x3a\xe6\xcb\x38\x1b\x09\x17\x38\x77\x06\x4b\xcc\xc7\x42\x19\x2e\x7a\x01\x67\x9b\xac\x40\xb1\x4d\x4d\x51\xa4\xc6\x69\xca\x0b\x64\x5b\x62\xee\xb3\xdf\x3e\x27\x26\xdc\x41\x2b\x11\x23\xfe\x50\xef\x8f\x29\x2b\x68\x9e\x0e\x71\xc7\x1d\x83\xb6\x8e\x8a\xc6\x6c\x40\x63\x6a\x88\x98\xd5\x64\x8d\x2c\xa7\xca\x40\x4d\x19\x80\x26\xab\xe1\xf3\x5c\x43\x3b\x7c\xca\x9b\xbe\x0e\x9f\x15\x1d\x29\x9b\x7a\xea\x54\x12\xa6\x6e\x25\x11\x6a\xe9\x91\x4f\x5d\xda\x09\xa8\xab\x3f\x21\x75\x5b\x5d\x44\xdd\x96\x11\x53\xbb\x3c\x28\x75\xd9\x45\x42\xed\xf6\xcc\xa8\x5b\xf5\x29\x75\xeb\x30\xa3\x16\x9b\xca\xa9\x5b\x45\x9c\xba\x6c\xaa\xa0\x6e\x53\xc6\xde\x88\x1f\x61\x3c\x62\x7c\x98\x8c\x78\x2a\xf6\x1d\x06\x88\x03\xa7\x9f\xe2\x70\xc4\x15\x71\xe4\x8d\xc4\xa0\xd8\xe9\x73\x4d\x06\x6b\xe1\x3d\xb1\x46\x6d\x66\xf3\x56\x9c\x8e\x84\x36\x9c\x39\xe2\x22\xce\x47\x62\x08\xe6\x23\x3e\x8b\x0b\x67\x70\x13\x23\xba\x85\x71\xec\x34\x25\x4c\x9c\x4e\x8b\xfd\x11\xbf\xc4\xc1\x88\x63\xe2\xd0\xe1\x99\x38\x1a\x89\x35\x38\x1e\x0d\x56\x23\x9e\x84\x93\x11\x1f\xc5\xcc\x11\x00\x70\xea\x8c\x5a\x38\x73\x86\x16\x9c\xdb\xfc\x1f\xf3\x31\x17\x2e\xba\xa1\x67\xfb\xa1\xdb\x60\x23\x35\xab\x81\x87\x0d\x43\xb7\x4a\x35\x0c\x83\xb6\x42\x6a\x6a\x16\x34\x49\x8e\xe9\x69\x68\xe9\x7e\x24\x51\x1a\xc6\xe8\x36\x65\x1a\x3e\xa5\x5a\x07\x4c\xc3\x74\xd3\xf7\x61\x53\xa6\x19\xf9\xf0\x69\xaa\x75\xc2\xf4\xaa\xae\xe5\x71\x86\x61\x5a\xca\x6d\x88\x95\xb7\x72\x33\xbd\xa4\x6b\x99\xef\xb0\xa7\x2e\x31\x60\x6a\x16\x2a\xa1\x2e\xfd\xfa\xd4\xd5\xc7\x80\x3a\x0c\x27\xa4\x2e\xe1\x45\xd4\xd5\x93\x98\xda\xc4\x43\xa9\xc3\xac\x12\xea\x52\x35\xa3\x2e\x8d\xa4\xd4\x61\x08\x19\xb5\x99\x79\x4e\x5d\x96\xcc\xa9\xd9\x62\x0b\xea\x50\x32\xf6\x9c\x5a\xc6\xd8\xe9\xae\xc4\xe9\xaf\xd8\x77\xfa\x0a\x0e\x5c\xee\x80\x43\xa7\x2b\xe1\xc8\xe9\x10\x38\x76\x45\x04\x35\xde\x18\x1f\x25\xce\x68\x81\x99\xcb\x63\x70\x6a\x09\x1a\x38\xb3\x05\xd9\xdc\xe9\xb9\x98\x3b\x83\x02\x2e\xac\x11\x11\x7b\x4e\xad\x63\xa7\x23\x62\xe2\xf6\x6e\xdf\x62\x69\x38\x70\x3a\x1a\x0e\x5d\x2e\x8c\x23\xab\x1f\xe2\xd8\x19\x19\x30\x75\x7a\x3f\x4e\x9c\xbe\x88\x99\x25\x58\xe1\xd4\xe9\x6e\x38\x73\x45\x07\x9c\x5b\xbd\x18\x73\x67\xe4\xc0\x85\x16\x1c\xb6\x19\x53\xa9\x18\xe0\x89\x01\x61\x23\x9c\x61\x3c\xbe\xd7\x2e\x6e\x0c\xc3\xb1\x6c\x37\x0c\xc4\x0a\x9f\xe1\x51\x28\xf1\x11\x23\x1f\x51\xf3\xd0\x14\x84\x15\x27\xe6\x71\x86\x7a\x66\xfe\x93\xa6\xdf\xa6\x10\x2c\xf9\x34\x3d\x4a\x1b\xa4\x06\x3e\xb3\x7b\xf2\xb0\xc7\x30\xfc\x9a\xed\x84\x37\x42\x34\xb4\x29\x14\x13\x86\x47\xf5\xa2\x92\xb5\xe7\xf2\x31\x76\xc9\x54\xc1\x10\x97\xfe\x15\x8c\xef\xd2\xb5\xfa\x3d\x70\x09\x5b\xc1\x84\x76\xb1\x2a\x88\x68\xb4\xcf\xb1\xc5\xb4\xd4\x63\xea\x92\xa8\x82\x49\x6c\x5a\x52\xcf\x99\xdd\x4a\x15\x44\xea\xb2\x47\x05\x93\x99\x55\xae\x9e\xe6\x2e\x33\x52\x30\xdc\x65\xa2\x0a\xa6\xb0\x7b\x68\x9d\x11\x1b\x1d\x1b\xbb\x7a\x80\x89\x45\xc8\xd8\xb7\x59\x1c\x0e\x5c\xcc\xe2\xd0\xa5\x16\x1c\xb9\x84\x81\x63\x47\x17\x6d\xf1\x37\xb1\xab\x10\x33\x97\xa5\xe2\xd4\x19\x0f\x33\x97\x47\xe1\xdc\x6e\xdf\x98\xdb\x8c\x0e\x17\xe3\xde\xd5\xbe\xdc\x58\x21\xb0\x3b\x16\x60\x32\x6e\x70\xd8\x1f\xf3\x3e\x1c\x38\xbd\x0f\x87\xe3\x41\xa0\x56\xb6\xb3\xbb\xf1\x78\x50\xc2\x74\x3c\xb8\xe1\x64\x3c\x1a\xd4\xe6\xe0\xf2\x32\x69\x14\xd6\xa7\xd9\x58\x58\x93\x86\xe1\xe0\x93\x8f\x45\x9c\xda\x48\x80\x8a\x36\xb2\xcb\x8f\x7a\x5d\x83\x67\x6c\xfd\x61\x8d\xaa\x19\xab\xd0\x9a\xcf\x79\x56\x41\x3d\xa2\x57\xdf\x3d\x7f\x83\xca\xc5\x59\x7d\x4d\x44\x53\xd1\xe0\xd9\xc3\x57\xbd\x8b\x8b\xdb\x83\x89\x53\xd4\x6e\xfc\x87\x0b\x14\xd5\x17\xf8\xac\xbe\x4c\xf5\x86\x9e\xfa\x55\x02\xc8\x2f\xf5\x67\xf1\x65\xaa\xf5\xa7\xcf\xb9\x56\x55\xe9\xfb\x47\xaf\x64\x61\x2c\x24\x0b\xbf\xb8\xef\xa8\x12\xd0\xcd\x05\x55\xf2\x8b\x56\x25\xe5\xba\x57\x54\xb9\x4b\xeb\x7d\xe0\x57\x4d\x09\xb0\x0f\xfc\xca\x50\xfa\xee\x03\xbf\xaa\xeb\xea\x7d\xe0\x57\xe6\xb2\x7a\x82\x86\x54\x51\x18\xa1\xb4\xac\xd6\x88\x65\xd9\x72\x95\x97\x8b\x13\x54\x2d\xd1\x8b\x23\x6c\xc4\xfb\x5d\x09\xa5\x80\xde\xf6\x6b\x20\x9b\xee\x0e\x09\x23\xfb\xdd\x21\x2d\xba\x17\x4b\x81\xf0\xc5\x11\x7e\x5b\xbe\x43\x7b\x08\x1b\x6a\x94\x2a\xba\xb2\x3c\xff\xa4\xee\xdd\xdb\xb6\xbd\x2a\xc7\x27\xfe\x33\xf1\x31\xda\xd3\x50\x43\x1d\xbe\x1d\x74\x7b\x80\xd8\x50\xb0\xf4\xe1\x7a\xcd\x4f\xd3\x39\x47\x38\x42\xeb\xf3\xf4\x03\xbf\x32\x88\x7f\x7d\x9e\xfe\xc8\xaf\xd6\x8d\x0a\xda\xef\x76\xa1\x2c\x5e\x01\x90\x14\x4d\xfd\xe5\x01\xc2\x51\xf3\xcd\x7e\xc5\xca\x11\x54\x9c\x52\xfc\x98\x05\xb9\xae\xb1\x2b\x5e\xde\x2a\xa4\xef\x14\x53\x46\xbc\xee\xab\x5b\xd2\xb2\x7a\x05\x55\x51\x0e\xb5\x22\x28\x0d\x5e\x1b\x4a\x69\x50\x01\x35\x1a\x14\x19\xb6\x31\x59\x0d\x09\xec\x56\xd3\xa5\x53\xac\x96\xa7\x10\x60\xe6\xbc\xa8\x10\xa1\xe0\x19\x82\xb2\xb9\xa1\x14\xce\xdb\x49\x89\x0e\xe4\xdd\x10\x1e\x14\x70\xac\x8d\x6b\x32\x79\x71\x44\x94\x0d\xee\xa0\xdd\x46\x02\x3b\xe8\x2f\x88\xd0\x77\x50\xe3\x11\x6c\xab\x44\x7f\x81\x3b\x2e\x36\x66\x6f\x55\x9e\xcc\x36\xe7\x2f\x80\xf2\x9d\x2d\x93\x3b\x1d\x2e\x09\x85\xc7\x92\x57\xb4\x8b\x48\x60\x61\x78\xc7\xc0\xf1\x80\xac\xa9\xb2\xbf\xe8\x40\xb9\xc8\x38\xe2\x2c\x9b\x29\xb3\x43\xe5\x1a\xb1\xb3\xb3\x79\xc9\x73\xa1\x4b\xb6\x40\xfc\xf2\x8c\x2d\x72\x9e\xd7\x75\x19\x21\xbc\x4f\x8d\xd8\x84\x08\x14\x9a\x8c\x2d\x50\xca\x51\xba\x5a\x7e\xe0\x0b\x54\x2e\xaa\x25\xa2\xb2\x28\xf0\x1a\xad\x33\x36\x97\xe8\x25\xca\xb5\x19\xdb\xc5\xac\xcc\x66\x88\xcd\xe7\xcb\x8b\x35\xa0\x16\x78\xab\xa5\x40\x7b\xbe\xe6\x39\xba\x28\xab\xd9\xf2\xbc\x92\x0c\xae\xcb\xe5\x62\x88\x45\x09\x1a\xca\x6b\x4e\xda\x2f\x0f\x1e\xa8\x6b\x65\xda\x9f\x44\x40\xf1\xb1\x49\x72\x1d\xcb\xc5\xd2\x72\x63\xb7\xe1\x2a\xb4\x10\xc4\xda\xcf\x10\xb3\x26\xa5\x54\xe2\x5d\x24\xb4\xef\x9b\x55\x65\xeb\x47\xac\xf7\x23\x7e\xa7\x0a\x7b\xfe\xa6\xff\x04\x97\x02\x0c\xae\xda\x31\x44\xc0\x23\x59\xf8\x12\x95\x8b\x8f\x7c\xb5\xe6\xf6\x28\x58\x2e\x3e\xbe\xea\x05\xc2\xce\x4f\x1b\x0d\x10\xd8\x31\x40\xb4\xd8\x74\x89\xad\xdf\xe2\x50\x18\x74\x1f\xfb\xa7\xce\x84\x43\xfb\x85\x2f\xb2\xd5\xd5\x59\xb5\xc5\x55\x80\xaa\x62\xed\xf2\xa8\x69\xd7\x02\x4f\xbb\x21\xdf\x5a\x42\x37\xe7\x5f\x82\x6a\x2b\x11\x57\xed\xde\x23\x37\xe5\x69\x2d\x48\x53\xd2\xf1\x1f\xbc\xd2\xf3\xb4\x2e\x73\x73\x40\xaa\x5d\x8d\xd5\xd7\x81\x04\x5b\xf5\xc1\xe0\xe6\x2c\x43\xf6\xf1\xc3\xa2\xac\x4a\x36\xd7\x4b\x5f\x75\x61\xf8\x65\x36\x63\x8b\x13\xfe\xf4\x65\x5b\x16\x55\x56\x1e\xf3\x2e\xbd\x42\xfe\xaf\x6f\xd2\xe6\x36\xf2\x7e\x6a\x78\x63\x2d\x0a\x6b\x9b\x97\x4f\xf5\x36\x04\xe8\xf8\xea\x6f\xb3\x36\x54\xf2\xe6\x15\x85\xf8\xff\x86\xbc\x41\x9b\x50\xfd\x19\x2b\xd3\xba\xae\x6a\x93\xe5\xc3\xc0\xa3\xe4\x47\xe9\x55\xf0\x79\xfc\xda\x36\xc3\x48\x64\xcc\x27\x00\x9d\xed\xda\x8b\xc6\x30\x74\x3b\xb1\xc0\xae\xba\xb0\x2b\x05\x6b\x64\xf2\x31\x2f\xd7\x15\x9f\x37\x56\x6c\xc6\x58\x40\xe7\x37\x4b\x2d\xa8\x3b\x40\x17\x62\xa0\x95\xa5\xd6\xde\x96\xef\xde\x4e\x26\x8a\xdb\xf7\x6d\xb8\x16\x89\x64\xf3\xea\x02\xdf\xa1\xac\xb6\x49\x34\x86\x80\xdd\x73\xa4\x95\x4d\x52\x3d\x4f\x9a\xd7\x6c\x14\xe3\x01\xfc\xe7\x45\xbe\x44\xeb\x0b\x76\x26\xd3\x8f\x39\x5b\x57\xd2\x18\x86\x21\xbc\x72\xab\xac\xc7\x6c\x57\x61\x2e\xc7\xaf\x0c\x36\x0c\x15\xc5\xb7\x75\xf5\x81\x6b\xdc\x98\x0b\x5e\xc7\xd5\xaf\x13\x52\x46\x42\x97\xe1\x8d\xac\x42\xcb\xf3\x6a\x10\x81\x9b\x90\xeb\x56\x59\x27\xe4\xda\x75\xd6\x19\x32\x3e\xf0\x2b\x59\x02\x3a\x0a\x0e\x7c\xa2\x3f\x29\x3f\x5a\x1e\x68\x75\xa3\x23\x63\xd5\xe8\x03\xf4\x4a\x58\xa0\x7a\x09\x58\x2d\xd7\xeb\x36\x4d\x87\x9a\x87\x90\x10\xc3\x6b\xa9\x6c\xd1\x0c\x54\xad\xe0\x26\xf5\x78\x75\xca\xd6\x1f\x3a\x2e\x5b\xdb\xee\x64\xd2\x31\x51\xe1\x88\xf5\xe8\xfa\xbe\xd3\x75\xe1\xb4\x02\x8b\x26\x82\x8e\xc9\xbe\x07\x9b\xfd\xc6\x68\xf8\xe2\x99\xc8\xa8\x24\x66\x05\x55\xfb\xdd\x80\xed\x97\x4f\x37\x67\x7b\x65\x67\x7b\xee\x66\x7b\xee\x60\x7b\xb5\x01\xdb\xce\x22\xd2\xeb\xba\x8a\xb4\x9c\xfe\xd8\xac\x8e\xf4\x58\x11\x66\x89\xab\xe2\x97\x95\x5e\x8a\xf9\xfb\x47\xaf\xf6\x55\x82\xd6\xa9\xc5\x3c\x45\x59\x71\x62\x28\xae\x7d\x36\x67\x82\x89\xcb\x0a\xf5\xb1\xa8\x84\x6b\xd2\xd2\x31\x21\x6a\x2a\x3b\x0f\x27\x6a\xba\x45\xb7\xbf\x7f\xf4\xca\x58\x71\xfb\x78\x55\x9e\xcd\xf9\xde\x76\x53\x44\xb2\x51\x67\xa2\x48\xff\xe9\x8f\x33\x5d\xa4\x26\x22\x04\xdb\x25\x54\x28\xcd\xfa\xd7\x03\xa9\x2c\x96\xaf\x31\x3a\x14\x70\xfb\x52\xaa\x8f\xa4\x8e\x97\xab\x49\x7b\xcf\xba\xba\x38\xbe\x26\xbd\xbf\x9e\x97\x19\x9f\x78\x53\x44\x76\x06\x77\x61\x34\x68\xc9\x35\xd1\x92\x29\x0a\x1c\x68\xfd\x6b\xa2\x0d\xa6\x28\xda\xb1\x5f\xa4\x71\xed\x77\x0f\xbe\xc6\xfb\x7a\x63\xad\x85\x55\x32\xfb\xfa\x3b\xc7\x06\x0d\xfc\x0d\x28\xdc\xcc\x3b\x8d\xa0\xb5\x25\x73\x64\xdb\xee\xe3\x0d\x28\x98\x47\x3d\x9c\x90\x1b\x1b\xf6\xfe\x49\xc2\x6a\x13\x5d\x6e\x20\xb8\xb6\xb8\xb6\x0c\xb1\xb6\x10\xd7\x0d\xb4\x0d\x94\xb3\x7e\x7e\x03\xd5\x2b\xa1\xaf\x15\x66\xbf\x17\x92\x69\xaf\xaa\xbe\x56\xdc\xfd\x5e\x18\x4c\xdb\xaa\xee\xf7\xc2\x68\xaa\x8a\xbd\xdf\x8b\xf0\xa7\x77\x53\x1a\x7c\x56\xc1\xfd\xdf\xb3\xd2\xfe\x17\xab\x87\xff\xdf\x53\xd9\x1e\x6e\x2a\x28\x17\x3c\xbf\xd9\x12\xf7\xdf\xb1\x35\x6f\xab\xd6\xb3\x35\xd7\x9e\xbd\xf1\x89\xb3\x02\xfe\xd0\x97\x2f\xa3\x00\x2d\xd8\x29\x5f\x9f\xe9\x5e\x7a\xa0\xb3\x21\x40\x04\x1b\xf2\xbf\x7f\xff\x64\x42\xf3\x10\x45\x41\x73\x85\x8d\x09\xcd\x9b\x28\x10\x7c\x00\x53\x97\x51\xb0\xaf\xbe\x08\xfe\x0d\x99\x41\x8b\x5a\xa2\x57\xd3\x29\xe5\xdf\xf8\x1a\x31\xb4\xe0\x17\xf3\x2b\x24\x7d\x2d\x37\x11\xd6\x03\x0a\xea\xdc\xe6\xb1\x38\x3f\x4d\xf9\xea\x13\x82\x5b\xa5\xe0\x56\x15\xf1\xc1\x27\x90\xce\xef\x3b\x9b\xcc\x97\x17\xd0\x42\xfc\xd7\xd4\xa0\xdb\xb8\x1b\xdd\x86\x00\xb5\x5c\x2e\x5b\xb9\xd4\x11\xa1\x16\x4f\x3d\x30\xcb\xd5\x3f\x8f\x78\x3e\xbc\x95\x05\x5e\xe8\x45\x5e\x77\xbe\xb3\x96\x34\x84\xf8\x45\xd9\xc9\xa8\x44\x0f\xa7\x82\x6b\xf3\x18\xa6\xee\xd7\x32\xdc\xea\x09\x8f\x45\x6f\x0f\x51\xf7\xf6\x6d\xfd\xcd\xbc\xaf\xa9\xef\xca\xea\xa2\x5c\x73\xf4\xd3\xf3\xe3\x35\x60\x18\x53\x4c\x7d\x51\x8a\x32\x90\x4f\xe8\xa1\xd0\xaf\x90\xcb\x1e\x08\x46\x8d\x24\xac\xa8\xf8\x0a\x2d\xf8\x09\xab\xca\xc5\xc9\x0d\x08\x1e\x50\x71\x21\x78\xa5\x82\xfd\xc5\xb2\x9a\x58\xa5\x7a\x70\x80\x16\xcb\xd1\x4c\x15\xee\x64\x91\x02\xfd\x47\x23\xdd\xfb\x46\x30\x29\xd8\x7f\xd4\x42\x36\xa4\xa4\x4a\x32\x4a\x30\xb5\x35\xb4\xea\xbc\xdf\xe1\xae\x93\x01\xd8\xb4\xf2\xf0\xa7\xef\x35\xad\xc0\x72\x02\x8c\xdb\x67\x6c\x0d\xcb\x0b\x1b\xf9\x50\xa3\x29\xc0\x21\x5c\xa2\x51\x56\xb5\x14\x24\x6a\xbc\x37\xac\xfc\x87\x3f\x7d\x7f\x33\xaa\x97\x6b\x3b\xad\xe2\xd9\x22\x9f\xb0\xc5\xb2\x9a\xf1\x95\x62\xc4\x65\x06\x6c\x91\xeb\x66\x20\x7a\x38\x62\x0a\xad\x9f\xdd\x96\x02\x19\xb3\x8a\xc6\xf3\x14\xfc\xef\x66\x1f\xcf\x5f\x7e\x69\xf3\x78\xfe\xf2\x0b\x59\xc7\xf3\x97\x37\x63\x1c\xcb\x55\xc7\x36\x96\xab\x2d\x4c\x63\xb9\xba\xb6\x65\xfc\xb6\xa5\x65\xfc\xf6\x3b\x5b\xc6\x9b\x2f\x6f\x1a\x6f\xbe\x98\x6d\xbc\xb9\x29\xe3\xb8\xec\x59\xc7\xe5\x56\xe6\x71\xf9\x19\xf6\xf1\x7e\x4b\xfb\x78\xff\x3b\xd9\x07\x2c\xca\xeb\x96\xb1\x90\x33\xa3\xea\x85\x70\xce\x8b\x6a\xf3\xac\x6c\x01\x36\x21\xbf\xa1\x65\xd1\x60\x82\x2b\x6c\x6e\xca\x18\x00\xd9\xcd\x98\x03\xa0\xea\x18\x04\xfc\xf2\x74\x42\x42\x97\x1d\x48\x20\xdd\x14\x16\x26\x3b\x10\xaf\x40\x0b\xf4\x00\xf9\xc4\xb6\xd2\xa5\x59\xca\xa4\x35\x95\x07\x0f\xd0\x02\x96\xc8\x1b\x63\x90\x5b\x87\x08\xda\x43\x0b\xe3\x65\xf5\x66\x13\x12\x78\x86\xb6\xf6\x09\xd5\x2f\x4f\x6e\x86\x74\x34\x93\x05\xda\x33\xdc\x18\x3a\x20\xdd\x5f\xea\x12\xe4\xfe\x3b\xad\x17\xa6\xf2\xff\xed\xcc\xf7\xe5\xc4\xfe\x72\x51\x5b\xef\xcb\x1b\xb2\x5e\xa9\xf7\xae\xa5\x6a\xc6\x5b\xdb\xf3\x06\xc6\x3b\x88\x98\x80\xea\x1a\xf6\xab\x79\x41\x83\x67\xdc\x80\x15\xf9\xdf\xdd\x82\x5f\x2e\x2b\x56\xf1\x2f\x1d\x80\x57\x40\xe5\xa6\x4c\x18\xb0\xdd\x8c\x09\x4b\xc6\x74\x13\x5e\x2d\x47\xe3\xaf\x00\x19\xb5\x5f\xd5\x23\xb0\x03\x15\xd5\x17\x3b\x22\x1d\x6c\x7f\x79\x39\x89\x82\x81\x59\x7e\xae\xc2\x6e\x28\xe6\xfc\xb1\x34\x36\x12\x72\x04\xc4\xf6\x0a\x7b\x39\x50\xd8\xd3\xeb\x28\xec\x61\x9e\x7f\xe9\xcc\x97\xe5\xf9\x17\xca\x7c\xe5\x95\xdf\x37\xf1\xce\x9c\xf7\xde\x99\xf3\xad\xde\x99\xf3\x8d\xdf\x99\xfb\x23\xc2\x6e\x93\xc8\xc2\x86\x51\x73\xf2\x9b\xb1\xd5\xea\x4a\x34\xab\xc7\x10\x79\x31\x7c\x67\x58\x69\xaf\x87\x37\xe3\x18\x26\x52\xbb\x6d\xce\x8d\x76\x25\x0d\xc5\xc3\xe7\x46\x74\xf9\xcd\xbc\xba\xf2\x70\xa1\xae\x00\x5f\x16\xfa\xdc\xe6\xda\x74\xc3\xf1\x6a\x79\xc6\x57\xd5\x15\xfa\xbb\xba\x62\x18\x00\xc1\xbc\x1a\x14\x83\x69\x45\x65\x20\xeb\x7d\x13\x9e\x3a\xac\x34\x77\xa2\x77\xa3\xcb\xba\x3c\x59\x94\x45\x99\xb1\x45\x85\x52\x78\x5e\x2e\x34\xdf\x00\xa2\x8e\xd9\xdf\x76\x5e\xba\x66\xa6\xfe\xe5\x06\xe6\x81\x87\x1c\xd8\xdd\xb1\x23\xae\xc9\xf3\x33\x61\x96\x6c\xbe\xd3\x91\xfd\xa8\xe0\x90\x31\x20\x37\x92\xd3\xd0\x6e\x24\x44\xde\x55\xf3\x67\xf8\xea\x85\x2e\xea\x7e\x2f\x3a\x6b\xbe\x5d\x9f\xfd\x4c\x64\x6f\x07\xed\xc5\xdf\xb6\xd3\xda\xd3\x6d\xb1\x60\x8a\x13\xcc\x70\x0a\x67\x6a\x32\x9c\x63\x8e\x8b\x9d\x01\x92\x77\xff\x46\x5d\x9d\x22\xec\x6d\xbc\x3c\x00\x46\x37\x6d\xcc\x76\x10\x96\x2f\xd4\xe6\x09\x08\x8b\xf5\x17\xf9\xdf\xdf\x7e\x33\x1c\xc0\x10\x79\x7f\xe3\x03\x7f\x3a\x44\xc3\x55\x30\xfd\x4f\x8e\xcd\x35\xf8\x61\xc3\x46\x7f\x2f\xa0\x35\x69\xef\x23\x90\x3e\x34\xe7\x8b\x93\x6a\x86\xee\x22\xba\xe1\x56\xea\x7e\xa0\x39\x5a\x2e\x3e\xf2\x55\xfd\x6a\xa8\x85\x61\x15\x1f\xc4\xa0\x5d\x9f\x0e\xd8\x28\xf0\xd4\xa3\x76\xa3\xdd\xce\xca\xdc\x27\x74\xdc\x0d\xa2\x77\xd6\x28\x67\x15\x43\x6c\xbd\x25\x9d\x8d\x67\xb2\xba\x2b\x85\x97\x5a\x80\xde\xaf\x96\x6f\x7c\x62\x5f\x0a\x81\xc7\x9f\xb1\x67\x47\xd1\xea\x1a\x95\x61\xe7\x4e\x0d\xf7\x54\x2a\xb3\x61\xb2\x56\xaf\x69\x17\x8f\x54\x9b\x01\x97\xec\xee\xc6\x9b\xf7\xbb\xb4\xdd\x27\xbd\xda\x25\xbc\xba\xd5\xdb\xc1\x16\x7e\xf1\x57\xf3\xb0\x7f\x76\xbe\x9e\x4d\xea\x44\x4a\xe4\x08\xa6\xf7\x4a\x33\x74\x2f\x97\x40\x86\x7d\xb2\x75\x2a\xa2\x29\xb8\x8e\x20\x35\xce\x69\xd7\x6d\xac\x1b\x49\x06\x5e\x01\x68\x84\x49\x66\xcb\x33\x18\x24\x2d\x63\x3f\x1a\x4d\x5b\x1b\xb3\xe7\x28\x9b\x2f\x17\xae\x37\x95\x4d\x4d\x1a\xf0\xf4\x6d\x19\x7e\xb4\xdb\x32\x3c\x76\xda\xb2\x8e\x19\xb2\x14\xc9\x6e\xb3\xf3\xd5\xb4\xd3\xf5\x08\xe0\xff\x0c\x86\xfd\x67\x29\x99\x21\xd2\x3a\x96\x4a\x7c\xc3\x30\x5b\xef\x1a\xb3\x13\x80\x33\x4c\xf5\xc2\xba\x4c\x4e\x2c\x64\x1a\x17\xba\xe8\xf8\xcf\xa8\x1b\x5c\x6c\xe2\x03\x17\xca\xe4\x6b\xf4\x6f\xcb\x77\x26\xb1\xdb\x4d\x15\x80\x3b\xeb\xcb\x4d\x7a\x6c\xdd\x37\xd3\xdb\x2d\xa3\xb6\xc6\x7c\x7a\x37\xa5\xe1\x26\xfb\x5d\x0e\xee\xfe\x09\xcd\xaa\xea\x6c\x7d\xef\xe0\xe0\xb4\x9a\xad\xf7\x53\x7e\x70\x5e\x15\xf4\xd7\x35\xfa\x48\xf6\xf1\x3e\x41\xe9\x15\xfa\x1f\xa7\xac\x9a\x95\x6c\x2d\x2c\xa6\xdd\x20\x03\xbb\x42\xe4\x66\x8f\x83\x03\xf4\x3d\xaf\xe4\x71\x38\xce\x85\xb8\x4b\x96\xce\xf9\x1a\xfd\x55\x51\xfa\xeb\xad\x6f\x60\x1b\xff\x8a\xf3\x47\xcd\xfe\x97\xc1\x4e\x1a\x74\x47\x2a\xef\x0e\xba\x7d\xbb\xfe\xf9\xbe\x1d\x3d\xfa\xab\xec\x8e\x86\xfc\x19\xfc\xd0\xe2\x3e\x55\xdf\xbb\xa8\xd5\xaf\xb7\x6f\x1b\xf6\xe7\x1c\x76\x98\x6c\x80\x9d\x6c\x9c\xc0\xce\x99\xbf\x4e\xe5\x6e\xfc\x9f\x96\x39\xdf\xff\x75\x8d\x96\x2b\xf4\x9d\xdc\x4a\x53\x16\x25\xcf\x51\xb6\xcc\xf9\x14\xb0\xb0\x45\x8e\xce\xd7\x1c\x95\x95\x18\xd7\xfe\x2a\xe4\xa8\xf5\x41\xed\xc3\x69\xfa\x70\xa2\xbe\x77\xfb\x20\x7f\xbd\x2f\xf7\x24\xb5\xcd\xf6\x1b\xe8\x43\x1d\xd9\x6f\xbf\x69\xdf\xf6\x2f\xca\x45\x2e\xde\x2e\x3b\x30\x72\xeb\x90\xe0\x05\xe9\x3f\xc3\x66\x9f\x5b\xdf\x1c\xdc\xdd\xbb\xb1\xbf\xbb\x07\xb7\x64\x6f\xd7\xd5\xaa\x5c\x9c\x3c\x5e\x2d\x4f\x8f\x66\x6c\x75\xb4\xcc\x85\xe6\x5e\xc1\x8f\xfb\x85\xf6\xab\x12\xfe\x31\xfb\xc0\x17\x52\xc6\x7d\x93\x3d\x3b\x5f\x5c\x09\xf9\xde\xfa\xa6\x89\x60\xe7\xd9\x9a\xe4\x5c\xfc\x38\x91\x74\x64\x07\x61\x69\x13\x36\xdf\xd7\x43\x20\xfc\x94\x2d\xcf\x17\x15\x5f\xa9\x99\x4b\xf8\x69\x5e\xc7\x0a\xd9\xbc\x0d\x16\xf0\x14\xce\x33\xd6\x5f\xf8\x65\xb5\x62\xe2\xcb\xc5\xac\x9c\x73\x34\xa9\xb1\x3d\x50\x48\x24\xe9\x6f\xa0\x4d\x8b\x30\x53\xdd\x7b\x58\xd5\x0d\x76\x77\x85\xab\x7f\x03\x3a\x95\xc0\xdf\x1e\x22\xef\xf2\x7b\xea\x79\x42\xe7\xf2\xa7\x07\xf0\xd3\x77\x8f\x1f\x8b\x9f\x2c\x94\x84\xb8\xe0\x75\x7d\x7d\xbe\x5a\x2d\x4f\x58\xc5\xa7\x60\x75\xd5\x8c\xaf\x38\x9c\xf3\x44\x0b\x7e\x59\x21\xc1\x02\xcb\x2a\xbe\x82\x46\xd0\x8d\x4d\xf8\x03\x06\x27\x12\xfc\x36\xf2\x2e\x1f\x1f\x79\xde\x8e\xb0\x50\xef\xf2\x7b\xf8\xf8\x77\x11\x9c\xe7\xcb\x8b\x96\x3e\x34\xfb\x46\x4a\x5e\x0e\xe5\x13\xd5\x45\x81\xc0\x7f\xfc\x78\x07\x8e\x66\x7a\x3b\x68\x17\x69\x98\xe1\xc1\x6e\x5d\x71\x48\x51\x6f\xb3\x60\xd5\xd5\xf3\xc5\x29\xab\xb2\x19\xcf\x5b\x7a\xf7\xd1\x72\x31\xbf\x42\xec\xec\x8c\x43\xbf\xcb\x35\x38\x20\x3a\x5f\x94\xd5\x54\xbc\x68\x66\x6c\xcd\xe1\x6d\x53\x08\xa2\xc1\xd4\xc0\x08\x21\x55\xf5\xbe\xa8\x06\xab\x18\xea\x99\xf6\xf5\x8c\x95\xab\x61\xcf\xa0\x5f\x8a\xd7\x6f\x94\xe8\xf6\xf6\x14\xef\xb7\xfa\x1d\xb0\xb4\x14\x80\xe2\xff\x2a\xde\x4b\xa8\xda\x1b\xaf\xe3\x0c\x7c\x01\xce\x00\xa3\x70\xeb\x0b\x8d\x95\xcb\xbc\xa5\x6b\xe4\xe5\x22\xe7\x97\xe8\x10\xed\x61\xa3\xd9\x37\x7e\x74\xe7\x8e\x66\xfc\xbb\xbb\xb2\x99\xc5\xf8\x81\xce\x5b\x00\x79\xd7\x37\x76\x61\x4a\x8f\x85\xc6\xa5\x64\xe4\xaf\x7b\x87\xb5\xfa\xef\x6b\xf2\x42\xbb\x87\x86\xf8\x51\x23\xfa\xf6\x5b\x84\xbd\xda\x80\xd0\x6f\xca\x87\x94\x4a\x6a\x4e\xa4\xb1\xa2\xdf\x50\xc7\x0e\x1b\xe1\x6f\x40\x08\x10\xda\x94\xd4\x08\x3f\x9b\xf1\xec\xc3\xab\x8c\xcd\xd9\xea\x7f\x89\x56\x13\xa1\x87\x17\xcb\x72\x21\x77\x53\x83\x00\x9a\x9f\xba\x1e\xdf\xfe\x2c\xbd\xbe\x15\x4e\x35\x5b\x2d\x2f\xd0\xa3\xd5\x6a\xb9\x9a\x40\xaf\xee\x3c\x15\xa9\x50\x6b\x9a\x3f\xef\xde\x41\xbb\x2d\x82\xfd\x6a\x29\x23\xeb\x04\x47\x3b\xfb\xd5\xf2\xe7\xb3\x33\xbe\x3a\x62\x6b\x3e\xd9\x41\xbb\x12\x81\x30\xf9\xc5\xb2\x12\x06\x0e\xcc\x4a\xb9\xdc\x11\x0f\xeb\x8e\x7e\xfa\x02\x23\x41\x2b\x27\xc8\xaa\x45\x26\xde\x8a\x63\x2a\x97\xd9\xd4\xe0\x24\xa5\x6c\xd0\xc6\x44\x17\xe0\xb7\x75\x1b\xa9\x51\x98\xaa\xbc\xa4\xde\x4e\x5f\x2f\xd2\x21\x8e\xea\x86\x26\xb5\x68\x68\x6f\x2b\xe3\x7c\xfc\x98\xaa\x58\xa7\xc2\x1c\xde\x4b\xaf\x2a\x8e\xd6\xfc\xbf\xce\xf9\x22\x83\x40\x67\x67\xb4\xa5\x51\x9b\x0e\x0c\x84\x57\xa7\xe9\x72\xde\x38\x92\x8d\x32\xf5\xba\x94\xc9\x90\x72\x83\x69\x5c\x48\x91\x14\x10\x56\x02\x3a\xf2\x1a\x96\x9a\x8d\xc7\x06\x26\x20\x0c\xeb\x4c\xf8\x43\x26\x1c\x06\x7f\x7f\x4b\x26\x31\x91\x5c\x7a\x8a\xcb\x47\x5e\x07\xc5\xee\xa1\xc5\x6a\xa2\x0d\x3a\xf3\xc8\x1b\x74\x26\xf8\x2c\x89\x62\xaa\x98\x8d\x25\xb3\x8f\x37\x64\x16\x93\x6d\x3b\xd5\x42\x9a\xb8\xea\x76\xb4\xeb\x01\x8d\x6d\x02\x86\xbe\x4b\x88\xd4\x5f\x8d\x13\xfd\xa4\xa9\x41\x2a\x52\xf7\x61\x72\x35\xc8\x9a\x5a\xf8\xd1\x41\xa5\x01\xad\x7f\x10\x4a\x90\xd1\x6a\xc3\xc1\xa5\xed\xb1\x4e\x58\x1f\x65\x34\x94\xbb\x87\x0e\xd7\xef\x45\xf4\xb6\xd9\x97\x4a\x84\x1b\xd9\xaf\x38\xcb\x8f\x96\x8b\xaa\x5c\x9c\xc3\xe1\x59\xd0\x7e\x1b\x8a\x04\x27\x3f\x40\xdf\xbf\x3d\x04\xb6\x8e\x44\x62\x61\x18\x0d\xee\xfc\xb0\xf8\xc8\xe6\x65\x0e\x40\x52\xda\x77\x54\xb7\x1a\x79\x77\xa9\x20\x89\x10\x26\x0a\xde\x36\x74\xde\x29\x37\x11\x4d\x9b\x1f\x77\x77\x45\x32\x5e\x47\xa8\x1e\x9a\xdb\x32\x8c\xc8\x44\x50\x44\xc9\xbf\x6b\xc1\xd0\x08\xed\x3f\x6e\x18\x3b\x38\x40\x3f\x14\xe8\x82\x23\x91\xaf\x9d\x9f\x21\x91\xa9\x4e\x51\x59\xfd\xdf\xff\xfd\x7f\xea\x61\x49\x47\x01\x1c\xdf\xb2\xf4\x7c\x00\x78\x67\x10\xfc\xa5\xf5\xbe\x02\x2f\x98\xb4\x56\x2e\x80\xb1\x6e\x86\x44\xff\xe2\xeb\x5f\x02\x83\xf9\x0e\x75\xf5\x19\xaa\xea\x62\x3a\x1c\x6a\x5d\x49\xb6\x60\x73\x38\xfc\xd0\xc8\xf1\x25\x67\x39\x2a\xca\xd5\xba\xaa\xa5\x04\xdd\xda\x5e\xcd\xc3\xd1\x0d\x4d\x16\xcb\xa1\x78\xd7\x3b\xb5\x4d\x48\x42\xb7\x95\xfe\x55\x64\xd5\x78\x6d\xe4\x5b\xf3\x3a\x1c\xc3\x7a\x78\x1e\xd5\x06\x75\x54\xa3\x02\xb5\xa0\x43\x8b\xc3\xdc\xef\xc7\x03\x1d\x19\x96\xaf\x19\x50\x73\xa7\xd1\xae\x29\x01\x6b\xac\xb7\x35\x5f\x2d\x46\x75\x13\xf8\x2d\x4c\xb0\x4e\xeb\x65\xdf\xfd\xbe\x6c\x4f\xd9\x15\x2a\x17\xd9\xfc\x1c\x5e\x42\xc4\xcb\x85\xfe\x4a\x63\x92\xf2\xe3\x5a\x3a\x8f\xb6\x90\x0e\x98\xf2\xf5\x04\xe8\xa9\xf7\x34\x02\x7b\x93\x24\x2d\x5d\xa0\xbe\x4d\xa0\x1e\x24\x2f\x52\x60\x63\xf9\xc1\x97\x94\xf9\x70\x84\xef\x4b\x94\x2a\x89\x3e\xbe\x59\x89\x42\xc8\xb8\xa6\xd0\x63\x10\xba\x77\xd9\x17\xbb\x77\xe9\x1d\xed\xa0\xdf\x40\x22\x13\xc9\x83\xfc\xb5\xd1\x47\x60\xd5\x07\xbc\x51\x19\xde\x31\xb0\xa7\xbf\x82\x99\x35\x51\xcb\xd3\xa8\x85\x9f\x8f\x1f\xef\x51\x94\xc3\x4c\x19\xcf\x9b\xc8\x5b\x87\x4d\x75\x02\xab\xf9\x0e\x01\x4d\xfb\x0e\xf1\xe7\x7e\x2f\x27\x51\xb9\x46\x3b\x1a\x4b\xfe\x1a\x7c\xdd\x94\x44\x03\xab\xa3\x1a\x50\xd1\x03\xa0\x96\x94\x68\x31\xb6\x9d\xfd\xe9\xa4\x3b\xed\x3c\x51\x75\x7a\xa6\x65\x23\x93\xea\xf4\x0c\x1d\xf6\xc6\x92\x1d\xf4\xa7\xc3\x43\x19\x94\xfb\xd9\x89\x5a\xc4\xa8\x4e\xcf\xfa\x79\x86\xf6\x82\xde\x42\xef\x7c\xc9\xc9\x37\x21\x56\x74\x08\x0c\xde\xf9\xc8\x57\xeb\x72\xb9\xb8\x73\x0f\xdd\x81\x49\xdf\x3b\x53\xf1\xab\xe4\xe7\xce\x3d\x2d\x2b\x84\xdf\x65\x77\xd5\xef\xf2\xcb\xad\x6f\x3e\xa9\x49\xba\x57\xcb\x53\x8e\x1e\x3e\xfb\x1e\xa5\xe7\xe5\x3c\x47\xcb\xb3\xaa\x3c\x2d\xff\xc6\x57\xeb\x29\x9a\x97\x1f\x38\x5a\xed\xff\xba\x9e\xca\x57\x62\x98\x69\x5f\x9f\xf1\xac\x2c\xca\x4c\x38\x6f\x5e\x82\xc2\xcf\x58\x55\xf1\xd5\x62\x0d\xf8\xa0\x51\x35\xe3\xa8\x58\xce\xe7\xcb\x8b\x72\x71\x72\x4f\xce\x79\x0a\xf3\xeb\x9d\x8b\x44\x77\x6a\xa3\xb9\x23\x27\x77\x3b\x00\xfb\xec\x34\xef\xcd\xa2\x36\x47\x24\xc5\xb3\x5b\xdf\x48\x75\xa9\x43\x93\xcd\x34\x77\x77\x00\x13\x7d\x06\xdd\x81\x72\xda\xb7\x8b\xde\xac\xf1\x9f\xb4\xef\xfb\x8b\x65\xce\x8f\xaf\xce\x78\x9b\xcc\xb5\x73\xd5\xea\xc5\xa3\x5c\xe8\xf3\xc6\x2f\xcb\xc5\xc9\xf2\x7f\xbe\x42\x1f\xbd\x7d\xba\xef\xc1\xeb\x79\xdb\x42\x3b\x4b\xda\x30\xa3\x42\x63\x8d\x89\xad\x2e\x66\x6c\xde\xc3\x14\xef\x7b\x7b\x72\x22\x66\x55\xef\x8d\x92\xa7\x18\xd5\x6f\x33\xb6\x7e\x7e\xb1\x78\x51\x6f\x81\x39\x54\x40\xfb\xdd\xdf\x01\xbc\x59\x22\x81\xaa\x71\x52\x28\x75\xc4\xe8\x82\xcb\xf5\x21\xf1\x1c\x0e\x12\xef\x08\xd9\xe8\xb2\x7a\xfb\x41\x16\x30\x14\x10\xf0\xb9\x33\xf9\xd5\xeb\xd7\xcb\x59\xb9\x58\x8a\x5e\x31\x74\xc1\x53\xa4\x0e\xaa\xaa\x59\xeb\x7d\x65\xd0\x4a\x26\x9f\x6e\xa9\x23\xaa\xb0\x6c\xf2\x69\xfa\xf7\x4f\xef\xa6\x34\xda\x64\x49\x64\x70\x62\xf7\xcd\xb3\xa7\x4f\xaa\xea\xec\xa5\x18\x32\xd6\x55\x83\xed\xcf\x69\x79\x22\x37\xb3\xec\xff\xba\xfe\xf3\x26\x98\xef\x9c\xaf\x39\xbc\xb0\x65\xd5\x9d\xfb\xb7\x86\x84\xbe\x2b\x4f\x7e\x02\x84\xf7\x45\x87\x7f\x5d\xcf\x44\x50\x2e\x4f\x16\xcb\x15\xbf\x37\x2f\x17\xfc\x56\x43\xfa\x82\xa7\xfe\x46\x24\x85\x92\x5e\xf3\x54\x8e\x4d\xf2\x98\xf1\x9d\xfd\x83\x79\x99\x1e\x08\x14\x22\x38\xdf\x3a\x38\x40\xf9\x72\x51\xa1\xe5\x47\xbe\x5a\x95\x39\xaf\x17\x1c\xea\xf5\x8d\x5b\xda\x11\x64\xb5\x72\x20\x02\xdc\x9d\x66\x43\x03\xac\x47\x74\x00\xf6\x25\xc9\x2e\x94\x30\x10\x58\x26\xd3\x41\x80\xb9\xfb\xb7\x3e\x19\xa4\x21\x9f\xa8\x85\xad\x9a\xe3\x3f\xdf\x23\xe4\xd3\x3b\x21\x85\xe9\x5b\x29\x85\x77\x3b\xb7\x0e\x0e\xfe\x3f\xb4\x5e\x9e\xaf\x32\xfe\x8c\x9d\x9d\x95\x8b\x93\x9f\x5f\x3e\x3d\x14\x0f\xf7\xe6\xb0\x89\xf4\xd7\xf5\xfe\x29\x3b\xbb\xf5\xff\x02\x00\x00\xff\xff\x64\xb1\xb4\x44\x52\x1f\x06\x00")
+var _web3Js = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\xec\xbd\xfb\x77\x13\x47\xd2\x30\xfc\xf3\xe3\xbf\x62\xf0\xfb\xbe\x2b\x29\x08\xf9\x0a\x21\x72\x08\x6b\x6e\x89\x77\x01\x73\xc0\x4e\x76\x1f\xaf\x97\x33\x96\x46\xf6\x04\x59\xa3\x4f\x33\xc2\x38\xe0\xff\xfd\xeb\xaa\xea\x4b\xf5\x65\x2e\xb2\x0d\x61\x17\x73\x4e\x62\x4d\x77\xf5\xad\xba\xba\xba\xba\xba\xba\x6a\x96\xfc\x7f\xf3\x74\x96\x3c\x68\x8f\xe6\x93\x41\x91\x66\x93\x28\x69\x17\xdd\x49\x77\xd6\xf9\xa8\x53\xf2\x76\xd6\x9d\x77\x3e\xa6\xa3\xf6\xad\xc9\x41\x76\x48\xbf\x0a\xfc\xf5\x3e\x9e\x45\xf1\x83\xe2\x7c\x9a\x64\xa3\x68\x26\xeb\x7a\xb0\xac\x8a\x2e\xff\xe5\x2f\x32\x71\x0b\xca\xcc\xff\xf2\x97\xb8\x33\x4b\x8a\xf9\x6c\x12\xc5\xa2\xd2\x5b\xab\x1d\x48\x4f\x55\x5a\x2a\xd3\xa0\xd6\xd1\x83\x49\x72\x16\x3d\x9d\xcd\xb2\x59\x7b\xf9\x71\x3c\x99\x64\x45\x34\x4a\x27\xc3\xe8\x34\x1b\xce\xc7\x49\xd4\x5a\xbe\x9d\xdd\x5e\x6e\x2d\x77\xb6\x8a\x93\x59\x76\x16\x8d\x7a\x83\x6c\x98\x3c\x58\x7e\xb1\xfb\x64\xff\xf9\xd3\xb7\x2f\x77\xf7\xde\x3e\xdb\xdd\x7f\xf9\x64\xb9\x3b\xba\x80\xfa\xc6\x0f\xa0\xef\x0f\x3e\x26\x1f\xa6\xd9\xac\xc8\xfb\x1f\x2f\x2e\xb6\x60\x0c\x07\xab\x87\xbd\x41\x3c\x1e\xb7\xc7\x3d\x99\xd5\x55\xbd\x6f\x27\x34\xc0\xc9\x03\x04\x5c\x3b\x3c\x48\x0e\xb7\x64\x57\xf3\xf6\xe4\xe1\xa4\x9f\x74\x2e\xba\xe3\xae\x29\x99\x74\x09\x77\x17\x12\x0a\x9a\x54\x99\xd8\x8b\xb4\x11\xae\x46\x62\xcc\x00\x9d\x3d\x58\xdd\xca\x7e\x9c\xf5\xc6\xc9\xe4\xb8\x38\xd9\xca\x6e\xdf\xee\xe4\xed\x19\x20\x5e\x77\xe3\xa2\xd3\xfe\xb8\xd6\x3f\xd0\x5d\x96\x55\x74\x09\x4b\x5d\xd9\x76\xe7\xe3\x12\x25\xa8\xce\x3c\x38\x58\x8a\xa2\x8f\xe2\xbf\x28\x5a\x1e\x64\x93\xbc\x88\x27\xc5\x72\x3f\x2a\x66\xf3\xa4\x4b\xa9\xe9\x64\x3a\x2f\x72\x91\x76\x80\xdf\x0a\x1a\xf3\x26\xf1\x69\x22\x72\x96\xdf\x66\x67\x93\x64\xb6\xdc\x35\x39\x30\x3a\xc8\x89\x87\xc3\x59\x92\xe7\xcb\x32\xe7\x02\xff\x1e\xca\xaa\x55\x71\xfc\x2b\xd3\xb2\x79\x51\xdf\x5e\xf6\x96\x15\xb1\xda\x3b\x3a\x2f\x92\x7c\x63\x3d\xdc\x9e\x02\xd2\x98\x16\xc9\x17\xdd\x6b\x41\xc0\xa5\xfa\xa3\x87\xc3\xb0\xd7\x0c\x01\x0b\xa3\xfa\x6b\x1d\xba\x68\xb7\x48\x44\xb3\x57\x1d\xfc\x7f\xe4\xbc\xc3\x8c\x7d\x35\xd3\x3e\x8a\xc7\xf9\x97\x1b\xba\xe8\x72\x32\x7b\x1f\x5a\xf5\x5f\xfb\xa4\xe5\xf3\xa3\xd7\xc9\x71\x9a\x17\xb3\xf8\x1b\x98\xbc\x6e\x55\x1d\xc9\xd9\xee\x95\xf8\xbe\x40\xe1\x24\x1f\x05\x59\xdf\x7f\x0a\x0e\x66\x0e\x29\x2c\x8e\x84\x3c\x29\xde\x54\x93\xd4\xb5\xe1\xc2\x6d\xfa\x8b\x34\xfa\x99\x27\x20\x6e\x82\xf8\xaa\x0a\xa6\xb3\xf4\x34\x9e\x9d\x07\xfb\x91\x65\xe3\xda\xc9\xdb\x96\x6d\xfd\xe7\xa2\xd0\xde\x83\x2f\xc1\x11\x93\xe2\x71\xe9\x36\xfe\xd5\xef\x44\xc3\x34\x17\xf2\xd7\x15\x7a\x1e\x4f\xb2\xc9\xf9\x69\x36\xcf\x17\xe8\xba\x38\xbf\x24\x1f\x92\xa1\xb5\x77\x5d\xdb\xc4\x9a\xca\x59\x77\xec\xda\xcf\xd2\xc9\x55\x18\xf7\xf6\x1c\x31\xf1\x54\x34\x34\x5c\x76\xd0\x94\xbc\x07\x42\xf8\x2f\xc0\xd1\x51\x3a\x1c\x36\xc3\xd1\xe5\xea\x7f\x1f\x8f\xe7\xc1\xee\xcf\xd3\x49\xb1\x7e\xf7\x5e\xf5\x14\xbc\x4c\xce\x1e\xa5\x7f\x22\xf2\xaf\xb4\xe6\x1e\x9f\xc4\x93\xe3\xe4\x3f\xa0\xf7\xdd\x4b\xd4\xcd\xa4\xfa\xc5\x57\xd6\x2b\xda\x8d\x6a\x11\xb4\x74\xb8\xb4\x74\xd1\xfd\x78\x71\xd8\x5d\xff\xd3\x0e\xfd\xff\x45\x67\xde\x3f\x49\x76\x14\x3d\x1b\x5e\x9a\x54\xae\xbc\x71\xdd\x1c\x7b\xff\xb3\x8f\xbd\x37\x87\xbe\xaf\xf9\xcc\x51\x75\x5e\xf8\xda\xa4\xcd\xcf\xbb\x99\x9b\xbd\x6a\xe3\xda\xf6\xaa\x45\xe7\x7d\x34\xcb\x4e\xaf\x38\xed\x45\x76\xc5\xa3\xe6\xd5\x04\xbe\x3f\x77\xdd\x7c\x0d\xf8\x13\x64\x2c\x08\x66\x50\xec\x0c\xaf\xd8\x93\xab\x4d\x44\x3a\x88\xa7\x7b\x7f\xea\x64\x84\x31\xd9\xec\xb4\x9b\x4c\xb3\x3c\xad\x3a\xa8\x4f\xe3\xf3\xf8\x68\x9c\xd8\x42\xc1\x9f\xc2\x95\xca\x68\xee\x5a\x8e\x5f\x57\xa3\x81\x6d\x35\xde\x27\x36\x3e\xbf\xfc\x49\xe6\x5a\x90\x54\x52\x77\x33\x3a\xfb\x13\xd0\xff\xd5\x62\xfd\x3a\xce\x8f\x97\xe6\x93\x9f\x1b\xeb\x2e\xd3\xbb\x41\x7b\x43\xb4\x5f\x79\xe3\xfa\xdc\x33\xbb\x13\xd8\xd2\xaa\xe4\xb8\xcd\x26\x72\x1c\x1a\x6f\x44\x0f\x94\x85\x43\xbb\xd5\x5b\x19\x65\xb3\xd3\xb8\x28\x92\x59\xde\xea\x6c\x21\xc0\x9b\x6c\x9c\x0e\xd3\xe2\x7c\x4f\xb4\x66\xc3\x42\xfb\x00\xb5\xb4\xf2\xdd\x77\x4b\xd1\x77\x16\xa4\xd4\xb9\x47\x69\x1e\xc5\xd1\x74\x96\x65\x00\x1c\x15\x27\x71\x21\x6a\x98\xc2\x21\x6b\x52\x88\x2c\x09\x05\x99\x50\xc3\x4e\x11\x89\xd6\x07\x27\x49\xde\x87\x4f\x99\xcd\x7e\x1e\x1c\xf2\x8f\x4d\xeb\xeb\xd0\xce\xdc\x70\xbe\x0f\x0f\xee\x89\x94\x6e\xd4\xeb\xf5\x44\xf2\x8a\x37\x36\xd5\xe3\x07\x91\xb6\xa6\x69\x77\xe4\x14\x17\x27\x69\xde\x7b\x8b\x0b\xe3\x99\x42\x10\x00\xf6\x08\x5d\x3b\x90\xb1\x33\x29\xb6\x18\x30\xed\xdb\x21\xe8\x5d\xcc\x91\xcd\x6d\x2d\x5d\x08\x04\x06\xfa\xd1\x13\x48\x2b\x08\x6b\x0f\x22\xb0\xaf\xe1\x30\xed\x8f\x17\x02\xef\x95\xa5\x7a\x28\xbd\xcc\xe6\x83\x22\x83\xc6\x03\xb0\x75\xed\xf6\xd2\x5c\xce\xb9\x41\x08\x90\xa3\x42\x8a\xb4\x6b\xb9\x75\x0b\x12\x7b\x38\x6f\xed\x15\x89\xed\xf6\xbf\x0e\xda\x07\xab\x77\x7e\x38\xfc\xae\xf3\xaf\xc3\xce\xc3\x95\x0e\x8d\xd3\x3e\x38\x94\x76\x4b\x90\xf0\x32\x27\xc5\xe5\xfe\x0f\xdd\x65\xa2\xb7\xe5\xfe\xda\xa6\x20\xef\xbb\x7f\x32\x79\x3f\xca\xb2\x71\x0d\x6d\xc3\xd5\x54\x19\x61\x43\x9e\xfa\x4b\x54\x8a\xbf\x36\xcd\xcf\x43\x96\xbc\xc1\x3f\xea\xc8\x18\x7b\x76\x59\x1a\x86\xc2\x8b\x10\x31\xc1\xbb\x14\x0c\xa9\x0b\x92\xaf\x5d\xa4\x82\x76\xa9\xc5\xaa\xb2\x97\xa1\xda\x7f\x03\x6a\x6d\x9a\xfd\xee\xff\x36\x22\x5a\xd9\x9f\x7a\x8a\xbd\xf7\x67\x53\x2c\xec\x61\x9a\x64\x8b\x30\xcd\x16\x27\x49\x84\x9b\x1d\x12\x6e\x2f\x44\xb9\x90\xab\x7f\x48\xba\xc4\x9f\x9b\xec\xf7\x21\xcf\xd8\xb0\xbe\x6c\xfa\x8d\xe4\xd6\xaa\x7f\xde\xb7\xea\x91\x45\x03\x54\x8e\x9d\xbc\x34\x99\x43\xe9\x85\xe8\x9c\x0a\x78\x84\x0e\xc9\x8b\x52\xba\x5d\xa6\x8a\xd4\xa9\xd1\xca\xd2\x97\x23\x76\xa8\x84\x48\xfd\xe3\x5a\xf7\xa2\x73\x39\xc2\x97\xbd\xab\xa7\xfc\xef\x9b\x50\xfe\xca\x77\xd8\xe1\x3d\x31\x1d\xd1\x28\x1d\x27\x40\xa9\xd3\x78\x56\x44\xd9\x28\x3a\x4b\x8e\x36\x7a\xbf\xe7\xbd\x25\x04\x91\x5f\x00\x30\x9a\x25\x49\x94\x67\xa3\xe2\x2c\x9e\x25\xfd\xe8\x3c\x9b\x47\x83\x78\x22\x46\x3d\x04\xa3\x89\xf4\x68\x5e\x88\x7a\x8a\x28\x9e\x0c\x57\x04\x76\x45\x9b\xe9\xe8\x1c\xeb\x10\x89\x73\x21\xb0\xcd\x90\xe0\x45\xaf\x4f\x73\x68\x07\x3e\x7e\x7e\xb9\x1f\x3d\x17\xbb\x90\xc8\xfb\x39\x99\x24\xb3\x78\x1c\xbd\x9a\x1f\x8d\xd3\x41\xf4\x3c\x1d\x24\x93\x3c\x89\x62\xd1\x31\x48\xc9\x4f\x92\xa1\x20\x53\x49\x45\x49\xf4\x0c\x3a\xf3\x46\x76\x26\x7a\x96\x89\x06\x62\x18\x73\x37\x4a\x52\x91\x3f\x8b\xde\x0b\xec\xc0\x0c\x6d\xa8\xb6\x64\x8d\xdd\x28\x9b\x61\x2d\x6d\xb1\x18\xc5\x18\x66\x51\x36\x85\x82\x1d\xd1\xf1\xf3\x68\x1c\x17\xa6\xac\x8f\x02\x33\xd2\x61\x94\x4e\xb0\xda\x93\x4c\xad\x6c\x31\xcc\xb3\x74\x3c\x8e\x8e\x92\x68\x9e\x27\xa3\xf9\x98\x04\x47\x01\x1d\xfd\xb6\xb3\xf7\xcb\xee\xfe\x5e\xb4\xfd\xf2\x9f\xd1\x6f\xdb\xaf\x5f\x6f\xbf\xdc\xfb\xe7\x96\x80\x2e\x4e\xc4\x5a\x88\x40\xa2\xc4\xba\xd2\xd3\xe9\x38\x15\x55\x8b\x31\x09\xe1\xb3\x38\x17\x5d\xc7\x2a\x5e\x3c\x7d\xfd\xf8\x17\x51\x66\xfb\xd1\xce\xf3\x9d\xbd\x7f\x8a\x11\x44\xcf\x76\xf6\x5e\x3e\x7d\xf3\x26\x7a\xb6\xfb\x3a\xda\x8e\x5e\x6d\xbf\xde\xdb\x79\xbc\xff\x7c\xfb\x75\xf4\x6a\xff\xf5\xab\xdd\x37\x4f\x7b\x51\xf4\x26\x81\x8e\x25\x58\x43\x3d\xa2\x47\x38\x67\x02\x97\xc3\xa4\x88\xd3\xb1\x9a\xff\x7f\x8a\x59\xce\x45\x2f\xc7\xc3\xe8\x24\x7e\x9f\x88\xd9\x1e\x24\xe9\x7b\xd1\xc7\x38\x1a\x64\xd3\xf3\xc6\x13\x89\x95\xc5\xe3\x6c\x72\x8c\xc3\xd6\x54\x16\x45\x3b\xa3\x68\x92\x15\xdd\x28\x17\xdd\xfd\xf1\xa4\x28\xa6\xfd\x95\x95\xb3\xb3\xb3\xde\xf1\x64\xde\xcb\x66\xc7\x2b\x63\xaa\x20\x5f\xf9\xa9\xb7\x24\x78\x93\x64\xb6\x7f\x45\xb2\x05\xcb\xe6\x99\xa8\x06\x53\xe2\xb9\x40\xe7\x2c\x7a\x21\x08\xe2\x5d\xf4\xf7\xac\x48\xce\xd2\xc1\x1f\xd1\x8f\xa7\xf0\xfd\xd7\xa4\x38\x19\x26\xef\xc5\xfa\x3f\xfd\x09\x81\x05\xb5\x24\xd1\xfa\xea\xda\x5d\x64\x78\xf5\x5b\x41\x85\x00\xcb\xca\x48\x79\x2c\xb4\x77\x48\x49\x81\x01\xc3\x2e\x18\x82\x14\xa2\xad\x0d\x28\x4e\x2c\x21\xb8\x7d\x0f\x70\x5e\x02\xf9\xe4\x5c\x70\xa4\x74\xa0\xd8\x38\x2b\x31\xa4\x1c\xe4\x51\xa1\x92\x6f\x04\xb9\x8b\x29\xb3\xca\xe4\x98\x16\x82\x7e\x9d\xc4\xce\x18\x67\x22\x25\xd8\x77\x1f\x74\x5e\x06\x1b\xe8\xb6\xee\x2f\x02\xa7\xb9\x1c\xa0\xc5\x99\x73\x56\x45\x17\x77\x58\xc9\xa7\x95\x85\x38\xcb\xef\xe9\x2a\x70\x1b\x21\xe0\x4f\x9f\xf4\xe9\x31\x2a\x81\xde\x16\xeb\xf4\x9c\xc0\x89\x89\x3b\xa2\xc0\x63\xa0\x4f\x26\x01\xc8\x95\x44\x1c\x62\x18\x15\x59\x94\x4c\x80\x86\x57\x86\x09\xfc\xd1\xad\x00\x33\x8e\x89\x4d\x02\x57\x92\x72\xad\xbd\x31\x53\xdd\x7c\xc4\x00\x96\xdb\x3b\x33\x26\x09\x20\xfc\x6b\x75\x11\x79\xff\xa9\x58\x16\xd9\x30\xd0\x2d\x52\xae\x8b\x15\x10\x91\xe4\x92\x59\x33\x22\xca\xe3\x22\x92\xc5\xdf\xca\x99\x91\x59\xd1\x5f\xb1\xf7\xd1\x47\x22\x9e\x0b\x2d\x96\xff\x95\x30\x9f\x8b\x1c\x56\xd9\x05\x66\xe1\x5b\x05\x91\x83\xef\x1a\x44\x11\xfa\x4c\x81\x37\x90\x44\x04\x64\x88\x7d\x81\x9d\x08\xd8\x3d\x22\xc4\x42\x06\xdb\xa9\x79\x97\x3c\x1c\x29\x14\x01\x36\x73\x5b\xbc\x63\x58\xeb\x09\x0e\x23\x56\x7f\x9b\x95\xed\x30\x1d\x84\xa4\xa2\x42\x0a\x05\x8a\x08\x50\xa7\xd0\x39\x58\x3d\xdc\x22\xfe\x29\x46\xd0\xbe\xc5\x1b\xe1\x75\xd0\x03\x0d\x7a\xca\xd1\x4a\x27\xef\x63\x01\x66\x68\x00\x6a\xbc\xd5\x8f\x5a\xd1\xed\x88\x57\xbe\xc4\x65\x0d\x5e\xb3\x4d\x81\x25\x94\x16\x4d\xc7\xb1\xd8\xb9\x70\x86\x9c\x69\x24\x80\x57\x32\xa7\x7c\x16\x65\xfa\xee\xd1\xef\xc9\xa0\xb8\x70\x2a\x54\x93\x6c\xca\x51\xb5\x43\x07\xae\x7c\xea\x58\x37\xbc\x99\xeb\x52\x79\x47\xe0\xc2\x49\x63\xc5\x84\xb4\x05\xc0\x42\xec\x3d\x40\xf0\xc3\x4e\x33\xd4\x08\x29\x03\x25\x20\x5a\x7c\xe5\xd8\xc9\x39\x1a\x90\x05\x10\x76\x42\xe9\x53\x53\xa0\x0c\x31\x5e\xb3\x8d\x70\x93\xfb\x4b\x5f\x62\x27\x2f\xa3\xef\x5c\x11\xf8\x71\x52\xf0\x15\x98\x4b\xce\x21\x49\x16\x8a\xc9\xbe\x41\x09\xab\x06\x21\xd5\x4e\xdb\x65\x3c\x16\xb5\x72\x81\x35\x62\xf1\x4e\xaa\xb9\x4d\x3d\x3d\xc0\x22\x87\xc4\x9e\xd5\x97\x5e\x45\xac\x3f\x72\x9f\xda\x1d\x8d\xf2\xa4\xf0\x3a\x25\x44\xd0\xf9\x20\x61\xfd\x8a\x07\x83\x6e\x54\xd3\x39\xc4\x4e\x21\x84\xc6\x81\xc0\x67\xf1\x1c\x5f\x12\x39\x35\xf7\xdc\xfc\x76\xa0\x9f\xaa\xae\x19\x30\xa5\x64\xf8\xc6\xaf\xf2\x45\x5c\x9c\xf4\x46\xe3\x4c\xac\xf2\xb6\xd7\xe2\xed\x68\x63\xad\x13\xad\x44\x1b\xeb\x1d\x41\x24\x1b\xeb\x72\xd0\x0c\x7d\x62\x30\x02\xaa\xad\x37\x1d\x0b\xeb\x25\x28\x8c\x1e\xb2\xbd\x2b\x12\xd5\x46\x7d\x2b\xa1\xa4\xb3\x0a\xf5\xdd\x68\x95\x63\x5f\xc8\x34\xf3\x71\xa1\xa8\x87\x66\xf0\x85\x48\x49\x7f\x13\xa2\x1c\xcd\x89\xa2\x40\xab\x6f\x5d\x4d\x47\x5d\x7b\x06\x55\xe5\x72\x84\x54\xbf\x7d\xe2\x0b\x93\xbe\xd3\x6a\x68\x0d\x34\xec\x01\x5b\x23\x7a\x78\xcb\xcb\x5b\x66\xe1\x24\xe3\x91\x1c\xb1\xec\xac\xdc\x15\xb2\xd9\xd3\x58\x1c\xec\x5c\xc6\x94\x72\xda\x02\xae\x5f\x3a\x5f\x66\xae\x0e\x3b\xbc\x10\x21\x04\xbb\x72\xdb\xd7\x76\xb6\xed\xee\xab\x75\xc4\x88\x50\xaf\x5d\xa0\x62\xd1\x7b\x09\xe2\xce\x11\x76\xc0\xef\x92\xc2\x13\x7e\xb8\x93\xc5\x9b\xb0\x97\xa2\xe8\x69\x22\x9f\xe1\x09\x22\x5e\x37\xa0\x82\xbf\x8d\xc5\xb1\xc2\x1e\xde\xca\x4a\x34\xcc\x26\xad\x02\xd4\xc4\x91\x2c\x25\xf8\xaf\x55\x65\x4f\x1c\xa4\x5a\xb9\x38\x2b\x08\x79\x70\x78\x2e\x44\xfc\xf9\x44\x1c\xb7\x4a\xb0\xf4\x99\xc6\x79\x61\x16\xa1\xe8\xf2\xde\xee\x93\xdd\xbe\x38\x27\x1f\x0b\x11\x35\x82\x03\xdb\x24\xc9\xe1\x04\x08\xa7\xb4\xf3\xdc\x66\x56\x5f\x82\x48\xbe\x9e\x49\xb6\x27\x23\xe1\x08\x54\x58\x29\x59\xe6\x1a\xad\xb3\x64\x14\xa3\x3a\xe6\xec\x24\x13\x27\x3a\xec\xa1\xd8\x1e\x6f\xd5\x30\x82\x0a\x1e\xe0\x72\x7e\x39\x68\x71\xf0\xf7\x56\xbe\xb5\xc8\xd5\x9c\xd4\x8a\xfa\x81\x2d\xae\xed\xab\xc6\x18\x01\x51\xc3\xe2\x58\x6f\xc8\x5a\x7c\x7b\x73\x4a\x64\xf5\x32\x3e\x4d\xdc\x7d\xc8\xe4\x70\x39\xd3\x2f\xfb\x66\xd1\xfd\xcc\x54\x1c\xa8\x53\xf3\x45\x89\x41\x23\xd5\xaa\x7f\x15\xc3\x56\x95\x4c\x67\xc9\xfb\x34\x9b\xe7\xba\x43\xeb\x5b\x80\x12\x21\x0a\x8a\xc3\xaa\x57\xa2\x0e\xff\xac\xbf\xa1\x06\xe1\x1f\x28\x32\xf0\x91\x70\x2a\x1a\x5b\xdb\x12\x7f\x7e\x54\x03\x50\xef\x85\xa3\xf4\xf6\xed\xb2\xe2\xf0\xcf\xe9\xb3\x20\xed\xdb\x6d\x85\x83\x34\xba\x13\xad\x1d\x82\x84\x2f\x0e\x88\xd1\xea\x56\x69\x25\x15\xac\x5c\xd2\xc3\x6d\xd1\xb7\xef\xca\x66\xee\xb6\xdb\x0b\x10\x0e\xca\xd8\xbe\xbd\xec\xca\x53\x2e\x3a\xed\x8e\x33\x85\x62\x2e\x46\xe9\x4c\x48\xa0\xc9\x38\x39\x4d\x26\x05\x9c\xaf\x08\x4d\x62\x1d\xbd\x4b\xa7\x82\x1b\x2f\x32\xe5\x16\xf6\x57\x43\xd8\x07\xfc\x55\xce\x00\x3e\x9d\x1f\x0a\x0a\x10\x8d\xc4\x63\xbd\xc8\x25\x3e\x3d\xfe\xd3\x74\x53\x30\xa4\x53\xc2\x20\xc4\xd4\xde\x16\x53\xab\xf8\x84\xf8\xf0\xba\x11\x40\x7b\x2d\x82\x1d\xe6\x17\x80\x94\x5b\x65\x80\xf6\x89\x2a\x2e\xcd\x7a\xbe\x6a\xa6\x02\xc2\x96\x8d\xa9\x2b\x2e\xfe\x1a\xca\x2c\x65\x48\xab\x55\x0c\xa9\x19\x4d\xd7\x72\x14\xd1\xc0\x20\x1e\x0f\xe6\xa0\x5f\x56\x82\x0f\x1c\xf9\x64\x5f\xc4\x22\x4b\x4e\xaf\xc0\x8e\x80\x15\x1d\xfc\x07\x31\xa5\x8e\x0b\x7b\xb1\x74\xbd\x4c\xe6\x2b\x65\x30\x9c\xb9\x7c\x76\xde\xe2\x6b\x8b\x64\x3f\x6a\xb4\x21\x52\x17\x29\x6f\x26\xb3\x0a\x8d\x11\x41\x36\xd6\x18\xa9\x74\x7d\xab\xa9\x55\x22\x61\x5d\x52\xb9\x1e\x84\x35\x1c\x10\xff\xb0\xfe\x90\x8e\x88\x15\x33\x3a\x22\x82\x46\xd9\xa6\x09\x5a\x2a\x95\x44\x25\x08\x29\xd3\x11\x95\x23\x44\x96\xc0\x13\x06\xb6\x66\x10\x93\x37\xc5\x4c\x50\x43\x64\xe1\x66\x71\x05\x91\x2a\x45\x54\xcc\xe1\x89\x88\xf3\xe0\x29\xdc\x39\xee\x5f\xb3\x46\x89\x86\xdc\xc6\x91\xa9\xf5\x65\xd4\x22\x96\x5e\x44\xd5\x68\x34\x4c\x55\x2a\x07\x33\xaa\x5a\x3d\x03\xc7\x28\x71\x20\x28\x73\xdd\x23\x6d\xa2\x8e\xd2\x27\xd1\x90\x1c\x2c\xbb\x56\xca\x24\xc5\x36\x95\xcf\x4f\xe9\x86\x2e\xb0\x4b\x49\x11\x51\xc3\xcb\xea\x04\x5f\x03\xae\xa8\xbf\x70\x4b\x0a\xf1\x1f\xd5\xbc\x8d\x08\x25\x6d\x7a\x28\x10\xbd\x99\x25\xa7\xd9\x7b\xbc\xc6\x8c\x06\xf3\xd9\x0c\xe4\x53\x2d\x9c\x66\x98\x2c\xbb\x29\xa4\x56\xe8\x79\xa0\xb7\xf9\x22\x1a\x3f\x85\xcc\xc6\x9a\x3f\x6b\x64\x62\xe0\x6e\xb9\xc6\x94\xf6\xc6\x59\x87\x25\xd7\x3a\xc1\x53\xab\xe2\x71\x01\x2a\x2b\x9c\x2b\x07\x45\x56\xb0\x83\xf1\x4b\x12\xfb\xfe\x82\x7a\x0b\x6d\x0d\xe4\x2d\x13\x37\xb5\xc0\xde\xb7\xc9\x5e\xd5\x35\xc1\x90\xd7\xa2\xed\x4e\x37\x98\x0d\xb7\xa0\x65\x79\x20\x84\x94\x64\xed\x57\xe4\xf1\xcb\xcd\xd2\x66\xab\x32\x89\x0b\x97\xe5\xc2\x45\x65\x69\xb7\x28\x73\x09\x08\xc2\xb7\xdf\x40\xd4\x69\x9b\x0d\x65\x38\xdb\xdf\x04\x83\x0d\xf4\xa4\xd0\xbf\x8b\x3f\xa1\x6f\xcb\xfd\x7b\xf0\x9b\x5f\xc7\x2e\xf7\xef\x77\x43\xb6\x1e\x29\x3c\x91\x58\x5b\x85\x9f\x70\x63\x2a\x7e\xaf\xc3\x6f\xba\x95\x15\x5f\x1b\xf0\x35\x27\x28\x6c\x60\x2e\xc1\xee\x5d\x1c\x76\xef\x7f\x49\xbb\xa8\x9a\x6b\xe8\xcb\x59\x13\xf1\x4a\x16\x31\x2a\xb2\xcb\xb9\xb6\x45\x3c\x77\x41\x13\xa3\x70\xd1\x0a\x4b\x23\xbb\x27\x4d\xea\xba\x82\xdd\x51\x89\xb1\x51\xa3\x46\xd9\x95\x78\x70\xba\x14\xdb\x99\xcd\x93\x06\x26\x4c\xce\xb0\xeb\x2d\x99\x7e\xb8\xb1\x64\xba\xb1\x64\xfa\x56\x2c\x99\xcc\x42\xb8\x2e\x73\xa6\x47\xe9\xf1\xcb\xf9\xe9\x11\xb2\x42\xcd\x9d\x8f\x52\xd1\x19\x48\x14\xad\x28\x4e\x3e\x2f\x04\x92\x2c\x16\x2e\xf6\x0c\x48\xa3\xff\x2b\xb0\x41\x10\x44\xb0\xb9\x51\xea\x19\x03\xa9\x93\x19\xdb\x15\xf0\xec\x82\xdb\x82\x1a\x38\xf1\x6a\x41\x8d\xc0\xd6\x23\x7c\xb0\x01\xe7\x2c\xe0\x5b\xc6\x4a\x16\x97\x02\xcc\x0d\x2a\x67\xbe\x03\x1c\x13\xa4\xa0\xe1\x49\x72\x2c\xb2\xdf\x8b\x85\xa0\xdc\x64\xc2\xc5\x51\x71\x96\x89\xff\x0b\xcc\x4c\x95\xb4\x8a\xa5\x60\x6e\x75\xc9\xd1\x38\x13\x25\xc5\x0c\x4e\x33\x51\x6f\x97\xae\x43\x81\xec\x87\xd9\xd9\xc4\x39\xd3\xd9\x6a\x12\xff\xf8\xf6\x89\xb0\xfc\x49\xe3\xfb\x42\x8d\x05\x96\xd2\x24\x81\x5b\x74\x18\x96\x99\xe3\x61\xd8\x18\x06\x91\x76\xa1\xed\x7c\xec\x76\x2d\x06\x8c\xf5\x2b\x2e\xac\xdb\xed\xd1\x5c\xb4\x07\xbd\xa7\x7b\xbf\xbc\x7d\xb4\x23\x28\xf9\xc5\xa3\xa7\xaf\xdf\xbe\x06\x6f\x9f\x3b\x2f\x7f\x7e\xfb\x62\xf7\xc9\x53\x76\x86\xd3\x9a\x38\x9c\xc9\xde\x34\x1e\x3e\x4f\x46\x45\x9b\xbe\x8a\x6c\xef\x2c\xcb\x1f\x6b\x2c\xca\x36\x45\xba\x14\x97\xd6\xee\x75\xba\xd1\xbd\x4d\xfb\x86\x87\xef\x96\x38\x9c\x36\x35\x62\x1b\x60\xd8\x13\xaf\x0e\xbf\x25\x38\x7f\xa4\xcf\xc6\xf6\xa1\x79\x51\x1c\xfa\x52\x87\x85\xc5\x00\x42\x8a\xec\x97\xe4\x83\x1a\x77\x3e\x3f\x12\x0c\xb3\xbd\xce\xf0\x37\x76\xae\xf6\xa9\xb8\xd2\x72\xdf\x8e\xee\x6d\xc0\xbd\x3e\x43\x91\x8b\xee\xd7\xe9\xf1\x49\x21\x8b\x75\x45\x75\xdf\x7d\x66\x7c\xca\x1d\xf8\x5a\xd1\x5a\x2a\xd3\x5d\x19\xbb\xea\x78\x66\xa3\x55\x6b\xe7\xfe\xb4\x19\x70\xd4\xa6\xd4\x58\xa7\x47\x6b\xfe\x76\x54\x3f\x41\x75\x9c\x8e\x24\xf9\xf2\x15\xf1\x46\xe5\x5f\x75\xee\xb4\x71\x67\xf3\x59\x83\x07\x87\xfb\xc5\xe8\xfe\xcd\xc4\x05\x26\x4e\xbe\x33\x2a\x63\x64\xf2\x15\x92\x9a\x34\xf8\x4e\xe2\xc9\xe2\x8c\xcc\x7d\x72\x54\x3e\x67\xad\xd5\xab\xfd\x03\xd3\x43\xaa\x3e\x7a\x18\x45\xad\xb5\x56\xd4\x17\x75\xb6\xae\xce\xa3\xea\x30\x09\x27\x56\x28\xf5\x2b\xc0\xe5\x11\x08\xc6\xa7\x60\xf9\x43\x42\xe5\xd1\x79\xb4\xfe\xef\x53\x10\xcf\xb5\x0d\x5d\x0c\x35\x17\xc9\xb1\x90\x9f\xca\x67\xe0\xb5\xac\xb5\x6e\xff\x5e\x74\x46\xa4\x2d\x73\xc9\x8c\x48\x34\x39\xd4\x07\x58\xd3\x2d\xea\xcd\x35\x3d\x15\x47\x36\x3b\x6b\xbd\xd3\x9b\x66\x67\xed\xb5\xf5\xfb\x9d\x8e\x8d\xd2\xc7\x27\xc9\xe0\x1d\x5c\x9a\x71\x9c\x32\xb1\xc8\x41\x44\x2e\x44\xbf\x64\xb8\x93\xbf\x34\xd9\x9e\x22\x5a\xd7\x71\x92\x7c\x90\x3d\xb6\x91\xa1\x88\x16\x0f\x7d\xd8\x76\x61\x4b\x62\x19\x1c\x59\xce\x52\x10\xc3\xe1\x65\xae\x46\x97\xdb\x7a\x2d\xbe\x42\x18\x52\xec\x66\xb5\x1b\xad\x09\xe9\x43\x88\x20\x46\x1e\x59\xef\x58\xb9\x9d\xe8\xc1\x83\x07\x40\xb2\x41\x2a\x9c\x01\xfb\xb8\x13\x8f\xb1\x53\x11\xa9\x0e\xcc\x85\x07\x89\x9a\x3e\x11\x91\x22\xc1\x15\x02\x2d\xf2\xf0\xec\x60\x01\x67\x46\x30\xac\x68\x57\x0b\x87\xb8\x2c\xd2\xe3\x88\xe4\x74\x87\xde\x74\x17\x2c\xfc\x59\x46\xb1\x00\x4c\xf3\xf8\x80\x7a\xc3\x74\x99\x6d\xb0\x6a\x8f\x96\x57\x97\xa5\x8e\x18\xae\x25\x35\x15\x81\xf0\xac\x26\x52\xb7\x4e\x40\x69\x41\x13\xad\x25\x6d\x5f\xc8\x56\xf7\xb7\xce\x3c\xcb\xb9\x0d\xa8\x20\x03\xf3\x4b\x53\x7a\x9a\x4e\xe6\xee\x2a\x68\x8d\xae\xf8\xaf\x85\x75\xab\xca\xd7\xf4\xf5\x58\x83\x0e\x5d\x82\x82\xe6\xd5\x24\xb4\x5f\x49\x43\x21\xea\x49\x16\x22\x1f\xd9\xbc\x4f\x38\xfb\xd7\x41\x39\x9f\x07\x65\x92\xe5\x97\xa1\xcc\xe3\xdd\xb5\x28\x43\x8c\x31\x91\xd8\x46\x91\x6c\xce\x47\x91\xc7\xcc\x43\x16\xe7\xce\x62\x94\x30\xbd\x61\xfa\x3e\x15\xbb\xd1\xa3\xf3\x0a\x1e\x7e\x19\x6a\xaa\xc1\xcd\xfe\x75\x23\x67\x5e\x8a\x9d\xfd\x85\xd1\xb3\x7f\x15\xfc\xf8\xb7\xb0\x54\xb5\x46\x51\x99\xc4\x65\x1e\x4c\x37\xc6\x8b\xda\xd9\xec\xb9\x28\xc5\x91\x6c\xda\x47\x91\x27\x9f\x85\x30\x14\x58\x5e\xb8\x5f\x5d\x51\x60\x13\x12\xda\x43\xda\x9a\xa5\x67\x8c\xc5\xb0\x59\x7a\x72\x64\xef\x72\x2b\xf6\xbe\x71\x32\x32\x88\x03\x09\xa2\xe2\x6c\xe3\x89\x1e\xa0\xbb\xa6\x07\x3e\xf0\xcb\x11\xc1\x24\x0c\xd4\xa9\x6b\x08\x60\xde\x3b\x84\x62\x1b\xdd\x88\x2b\xcb\xa1\x90\x7c\x62\x0d\xea\xfd\x92\x97\xba\xdf\x75\x56\xd8\x91\x26\x4f\xff\x90\x3c\x31\xc7\x5b\x2a\x59\xfe\x60\xed\xd0\x16\x85\x5b\xab\x1f\x40\x64\xf6\x27\xb7\x97\x83\xee\x0f\x24\x93\x75\x31\x30\xa8\xae\x73\xad\x33\xc3\x4f\xe1\xd7\x36\x41\x8b\xa2\xbf\x54\x15\xe0\x6d\x32\xfa\x88\xe8\xf0\x01\x42\x9c\xbc\x04\x73\x31\x27\x0e\x78\x72\x0f\x2f\x32\x09\x0f\x2f\x26\xd6\xcb\x67\xc0\xa9\x88\xa4\xc3\x7b\x9b\x5d\xd9\xfe\x62\x53\x50\x71\x2a\xa7\xe1\x07\x8e\xe5\xd7\x8a\xfd\x38\x1f\xa4\x69\x15\xfe\x03\xc7\xf9\x2f\x88\x79\xa5\xd5\x41\xed\x40\x33\xfc\x2f\x36\x01\xc6\x3d\x4d\xd9\x0c\x6c\x1b\x07\x36\x25\x53\x50\xca\xdb\x4b\x50\xae\x2b\xf4\xb1\x1d\x72\x60\xb3\x80\x34\x65\xe1\x6e\x79\xf5\xc3\xb2\xa0\x5a\x79\xc6\x41\xb4\xd3\x6f\x6d\x56\xb0\x29\xa6\x81\x27\x95\xf9\x0c\xf8\xa8\x4c\x3f\xd8\x59\xb3\xef\x7c\x77\x5d\x18\x5c\xb1\x7d\x2f\xc5\x83\xe3\x0b\xbc\x5f\x96\xe1\x95\x22\x64\xf6\xfd\x24\xbf\x1f\x62\xe7\xec\xbb\x09\x1e\x14\x48\x20\x7d\x37\x81\x43\x69\xb1\xac\xef\x26\xf8\x50\xfb\x1e\xd8\x7e\x10\x8e\x37\x6a\x52\x02\xf5\xf9\x80\xfb\x61\x48\x3e\x58\x93\x12\x80\xe3\xd8\x66\x49\x3e\x64\x68\x7a\xfc\x1c\xbf\x9c\x3d\x41\x3c\xcd\x87\x95\x54\xdf\x0f\xae\xbb\x0b\x75\xad\x6b\x5f\x0e\x2d\xf7\xd7\xc0\x92\xc1\xba\x54\x5a\xee\xaf\xa3\x05\x03\x2e\x0c\x01\xb0\xd6\x5d\xe6\x57\x53\xcb\x7d\xfb\xf3\xe2\xb0\xbb\xb6\xfa\x27\xbb\x74\xd9\x21\xdb\xf8\x0a\x1f\x44\xe2\xd4\x55\xe6\x82\x48\xde\x5e\x89\x3f\xe4\x9d\x05\x7e\x6c\xea\x5f\x87\x26\x71\x83\xfd\x76\x9c\xb7\x88\x24\x72\xdd\x22\x7e\xdc\xdb\xd4\x60\xf7\x4d\x45\xeb\x77\xef\x95\xd4\x05\xf0\x35\xae\x8c\xdc\xa3\xe1\x67\xf4\xc6\x85\xe0\xae\x19\x82\x48\x5c\xd0\xf2\xc2\x2a\x51\x61\x70\x81\xcd\x55\x94\xbc\x94\x79\x85\x40\xaa\x12\x15\x1f\x5e\xca\xa5\x0b\xf5\xaa\xde\x0c\x62\x6d\xed\xc6\x0e\xe2\xc6\x0e\xe2\xbf\xdb\x0e\x22\x32\x86\x10\x24\x2a\x5d\x93\x0d\x44\x03\xd3\x06\x97\xd5\x93\xe9\x42\x86\x06\xe9\xc6\x73\x47\x2f\x20\xa1\x9e\x9d\x24\x13\xfd\x5e\xb1\x4b\xb6\xdf\x20\x80\x6b\x07\x0e\x4a\xb2\x5c\x09\xda\x46\x38\xea\x6f\xf7\x79\x22\x72\x52\x25\x3f\xd2\xdf\x4f\x9f\xa2\x56\x8b\xf1\xd9\x4c\xbd\x5c\xa0\x1f\x5b\xec\xa9\xa1\x20\x65\x6a\xbd\xb1\xc7\x8f\xe3\xa4\xe0\x26\xbf\x68\x40\xde\xca\xd5\x43\x50\xe4\x25\x50\x89\x65\xed\x6e\xe4\x7b\x32\x76\xb5\xa5\x68\xa5\x66\x32\xb5\xf2\xca\xa2\xb6\xea\x63\xc7\x32\x68\x47\xf4\x70\x83\x76\xb7\x91\x4a\x53\x34\xb4\xf2\xb7\x8e\x1d\xfc\xfa\xb1\x31\x32\x06\xb3\x04\x88\x49\xad\x07\xdb\x2d\x0b\x91\xbb\xe0\x85\xa3\x04\x0d\x92\x09\xe5\xce\xb9\xe4\x4c\xbf\x0b\xe1\xc7\x11\x85\x12\x39\x4b\xca\x76\x79\x12\x3c\x84\xd8\x47\x17\xd8\x0e\x43\xfd\x88\xa7\xc4\x61\x74\x2f\xca\x51\x79\x16\x7e\x33\x6b\xd3\x5d\xe9\xad\x9e\x21\x48\x4d\xaa\x8b\x60\x34\x3b\x3d\x4a\x27\xbe\x87\x9b\x22\x13\x84\x77\x82\xf7\x1c\x51\x72\xdc\xa3\x45\x25\xc6\x94\x4c\x70\x2d\x89\xdd\x08\xdf\x40\x38\xd8\x95\xb5\xd5\xdd\xc3\x48\xc6\x74\x92\x0e\x80\x3d\xa9\x5e\xd5\x17\x96\x17\xa8\x62\xe6\x09\x16\xf7\xa1\x4a\xd4\xaa\xe1\xd5\xe9\xfd\xaa\xd0\xaa\xf5\x16\x74\x65\xb2\x15\xd5\x63\x17\xe2\xf3\x4a\xfc\xaa\x6b\x1c\x1a\xd1\x49\x6c\x96\x2e\xa8\x9b\x7a\xd2\x0a\x4a\xec\x68\x22\xab\x0b\x7f\x15\xa1\xa1\xfb\xdf\xc0\xbd\x1d\xc7\xb7\xb6\x05\x0d\xeb\x4c\x2b\x51\x13\xf6\xce\x14\x5a\xb8\x72\x55\xdc\x7a\xf0\x00\xa5\x8b\x91\x40\xe8\x70\x6b\x11\x24\x18\xaf\x45\xb2\x2a\x79\xc3\xec\x2a\x0d\x28\x77\x3b\x7f\x54\x7e\xf4\x27\x8d\xaf\xaf\xa1\x69\xb0\xcc\xac\xda\xab\x06\x7d\x0b\x47\x6d\x5c\x00\x74\xa2\x87\x82\xc1\x47\xfd\x66\x06\x59\x0c\x65\x41\xb3\xac\x05\xf0\x06\xbc\x9f\x94\x13\x5a\x66\x0c\x3d\xf7\x32\xfa\x8b\x30\xce\xd4\xde\xa3\x6e\x85\x63\xce\xf0\x41\x0f\x6b\x88\x0c\x49\xbc\x12\x8b\xa6\xb1\x20\x0a\xd1\xaf\x92\x8b\xbf\x90\x7f\x26\xbd\xbc\xc4\x7a\xa2\xc7\xb6\x25\x74\x27\x27\xac\xbd\x38\xea\xac\x6d\xad\x04\x77\x6c\x53\x0a\x23\x4f\x25\xc4\xea\x12\xbe\x01\x16\x93\xd3\xa9\xe0\xa4\x4c\x25\xd8\x60\x13\xad\x5d\x85\x36\x3d\x32\xf6\xd4\x47\xe9\x63\x01\xdc\x28\x8f\x53\xa5\xbe\xa6\x82\x98\xa8\x1c\x88\xac\xb2\x6e\x0c\xd6\xc5\xca\xed\x80\x58\x70\x99\x71\x98\xc7\x78\xe5\xfe\xa1\x9e\x8b\xe3\x84\xf7\xf2\xef\xc0\x1a\xcd\x61\xc0\x29\x54\xe5\xe8\x4d\xcd\xfe\xf6\xa2\xdf\x05\xa9\x9b\xfa\xf9\x74\x48\x96\xad\xf2\x1d\x9c\x56\x45\x0a\x04\xb2\xb7\xae\x54\x58\x09\x47\xe4\x77\xc8\x7e\xdb\xa7\x9f\x10\x4a\x50\xfb\x59\x91\xb5\xb7\xe9\xf5\x48\xdb\x57\xc9\x82\x74\x6f\xbf\x5c\x67\x21\x76\xf3\xf0\xbe\xc9\xf4\xd8\xc0\xf2\x61\xdc\x7e\xe0\x2b\xfa\xe5\x6b\x2d\xff\x85\x16\x41\x1a\x11\xa8\x9d\xf1\x57\xb7\xfa\xd5\x9c\x40\xa1\x9c\x6e\x71\x86\x9b\x9d\x17\x27\xe8\x8b\x84\xd5\xc3\xb1\xe3\x3b\x9e\x52\x16\x69\x1e\x7e\xac\x97\xba\xe1\x1b\x0a\xed\x7b\xe9\x5a\x9b\xf0\x95\xce\xa2\x85\x56\x4b\x29\xdf\x2b\x94\x14\xaf\x68\x96\x1c\x9d\x9e\x56\xdf\x81\x6a\x62\xfd\x4f\xd6\xc9\xe1\x6d\x74\xb5\x52\x0e\xae\xb2\xcb\xb4\x72\xca\xcc\x0c\xfe\x92\xaa\x0c\x7f\x6d\x9a\x9f\x87\x2c\x79\x83\x7f\x38\xba\x39\x48\x23\xe5\x1c\xfc\x52\xda\x39\xf8\x7d\x9f\x55\xc7\xf4\x73\x5e\x8d\x0d\x34\x74\xde\xdd\xfb\x22\x2a\x3a\x28\xbc\x88\x8e\x8e\xe0\x5d\x25\x1d\xa4\x2e\xa8\xa5\xb3\x8b\x54\xa8\xe9\xa8\xc5\xaa\xb2\x97\x51\xd4\x01\x6e\x4b\x14\x75\xcd\x1c\xe5\xcb\x6e\x35\x50\xd4\x6d\xfc\x99\x8f\xeb\x02\xb7\x7f\x8b\x90\x07\x15\x5f\x84\x40\x54\x09\x97\x44\x28\x7d\x41\x22\x71\x0b\x55\x90\x89\x6a\xb7\xba\xfc\xa5\x74\xba\x24\x49\x35\x79\x33\x17\x68\xef\x7a\x5f\xcb\xe9\x51\x36\xa0\xbb\xeb\x8f\x3e\x52\xf9\x7e\x27\xc0\x87\x99\x8b\x5b\xc1\x8d\x1b\xfb\xb6\x1d\x24\xb3\x02\xfc\x1a\x04\xfd\xdb\x7a\x88\xa4\xdb\xa4\x1a\xa2\x26\xa0\x9e\x9d\x5e\x4d\xd6\xb2\x88\x93\x51\xeb\x0d\x02\xd4\xde\x70\xe4\x4f\x47\x58\xb3\xdd\xef\xa1\xf4\x5a\x1b\x1d\xa7\xa0\x0a\x96\x26\x2d\xf6\x91\xba\xcc\x5d\xae\x63\xff\x42\xc7\x6c\x63\x71\x8b\x58\xa6\xca\xbd\x76\xc3\xf6\xb7\x1c\xa2\xf9\x12\x21\xe7\xb4\xcb\x45\x50\x38\xce\x84\x70\x34\x3b\x9b\xa5\x02\x53\x68\xee\x45\xbd\x82\x4b\x6c\xa8\xa2\x31\xee\xce\x50\xcb\x9e\xf3\x87\xfc\x68\x05\x61\x8e\xa2\x82\x3a\x08\x85\x85\xef\x77\xd8\x7d\x6b\xdf\x08\x99\xbe\x56\xd2\x69\xce\x68\x6d\x4b\xf0\x16\x70\x21\x10\xc6\xa0\x90\x25\x41\x15\x0e\x86\x4d\x69\x8e\x5e\x0f\xa5\x36\x0b\xc6\x0b\x9c\x20\xa1\x3b\x86\x71\xfa\x4e\x9c\x2e\xa2\x5c\x74\x18\x3c\xe0\x49\x3f\x5c\x08\xd9\xb3\x4c\xa2\x91\x82\xc9\xcd\x0c\xb9\xe5\xa0\xd6\xc0\xf9\x4f\xeb\x60\xed\xb0\x75\xd8\xd1\xc2\x60\x8d\x1b\x00\xd9\x3d\x1b\xef\xf0\xc5\x5d\x1b\x96\x88\xee\x64\x03\x45\xa8\x40\x5b\x85\xb5\x6e\x74\x07\xed\xb1\x57\xb1\x2f\x6b\xdc\x11\x8d\xe9\x90\x27\xc8\x2a\x47\x0d\x5d\xe5\xda\xa1\xec\xb4\xa0\x1c\x3a\x7c\xa7\x00\xb7\xb8\x8b\x88\x18\x2e\x68\xe2\x5c\xcc\x20\x39\xbb\x86\xdb\xa3\x8d\x75\xa9\xc0\x81\xeb\x09\xd3\x9b\x2e\x78\x2b\x5a\x68\x61\x4b\x8e\xa6\x4e\xe0\x4a\x17\x19\x25\xc7\x28\x40\x62\x50\xa8\x83\xc3\xe5\xe8\xc1\x4f\xb8\x3e\x4d\xda\x26\x25\x56\x2a\xd3\xb6\x55\x6d\x8b\x72\x80\x93\xa4\xb4\x67\x15\xab\x9d\xb7\x5a\x4a\xb3\xc6\xed\x97\xe5\x10\xc6\x23\xba\x2d\x67\x1b\x65\x45\xfe\xf2\x97\x88\x7f\x1f\xb0\xdf\xcc\x05\xdc\xa1\xda\x75\x75\x64\x8c\x95\xe3\x4b\xcd\x8d\x5c\xbe\x55\x53\xa3\x66\xc1\x9e\x1b\x39\x61\xf6\xd4\x30\x8f\x6b\x57\x9c\x19\xa7\x5f\x15\x13\xc3\xda\xfc\xdc\xf3\x72\x9d\x13\x63\xbb\x3e\x31\x8c\x94\xcd\x04\x9e\x8d\x96\xd1\x16\x61\x9d\x90\x4e\x87\xa4\x65\x69\xac\xb0\x26\xa7\x62\x6d\x53\x03\xae\x1f\x8a\x63\x11\x81\xaa\x34\x02\xd1\x10\x6b\x87\x4e\x82\xf9\xf6\x77\x07\xc4\xea\x25\xb6\x07\x3e\x16\x39\xc4\xfa\x3d\x81\x35\x76\x4d\x13\x09\x6a\x15\x96\xc5\x38\xa4\xcb\x8f\x2f\x39\xb1\xc8\xb0\x43\xaf\x21\xd6\x2a\xa6\x5c\x6e\x12\xea\x54\x1d\x9a\x67\x9c\xb7\xd0\x54\x2f\xeb\xf8\x7b\xde\x84\xcb\xcf\x65\x6b\xde\xad\x8a\x0e\xec\xca\xf9\x74\x1b\xef\x6b\x8d\xe6\x59\x67\xd0\x50\x64\x7e\xe9\xfc\x5a\x5e\x14\x4b\x77\x7b\xe9\xad\x68\x1c\xe7\x45\x74\x70\x08\xc2\x04\xd5\x7b\xa9\x69\xbf\x15\x9e\x77\x3d\x07\x28\x67\xf9\x3e\x96\xf0\x40\x63\x5e\x42\xe1\xa7\xa6\x81\x26\x44\x52\x61\x1c\xcb\x8e\x30\x9a\x03\xbb\x37\x4d\xf0\x3a\x6f\x98\x8c\xe2\xf9\x18\x15\xa1\xf9\x1c\xe4\x54\xbd\x31\x2f\x4b\x37\x35\x5d\x19\xe6\x71\xb9\x6c\x6f\xbc\xa4\x70\x6c\x76\xc4\x05\x45\xe1\x86\xa7\xb7\x52\xa3\x7a\xe5\xab\x5d\xe9\x88\xd9\x12\xc9\xdd\x35\x82\x14\x2f\x49\xf9\x60\x19\x28\x5e\xa0\x03\x16\x01\xfc\x39\x5c\x3e\x34\xd4\x2e\x21\x58\x1a\x16\x9a\xcc\xc7\xe3\x20\xf9\xef\x95\x79\x98\xf3\xd0\xe6\x3a\x98\x73\xfa\x5b\xb3\x10\x7c\x27\x55\xde\x4a\xa0\xbd\x41\x3a\xcb\xa3\x59\xcf\xf1\x86\x17\x86\x63\x8d\x17\xfe\x8f\xab\xde\x21\x62\xc9\xad\xc4\x71\x9c\x4e\xe3\xe2\x30\x5e\x2f\x22\x48\xe5\xad\xf6\xf6\x50\x7e\xef\xe0\x84\xb1\x50\xd0\xcd\x59\x87\x7a\x7b\xea\xdf\x65\x39\x38\x0b\x5c\x5a\xc8\x7b\x74\x77\x63\x08\xfa\xa3\xb6\x6f\x65\xa4\x2b\x54\xe5\x04\x75\x41\x6f\xb0\xda\xfe\x9b\x1b\x13\x6f\x45\x51\x85\xdf\xd2\x80\x63\x54\xdf\xaf\x2c\xb8\x13\x63\xfe\x64\x25\x2b\x2b\x77\xfe\x7a\x70\x18\x72\x22\xde\x9b\xce\xf3\x93\xb6\xe7\x99\x54\xbd\xd4\x56\x6e\x46\xfd\x9a\x61\x2c\xbe\xcf\xf5\xf7\x21\x07\xa0\xbc\x25\xe6\xc7\xb3\xfd\xbe\x1b\x71\xff\xb2\x8e\x7b\xd2\x2b\x39\xf5\x95\x13\xc8\x9d\xf9\x5e\x79\xfe\xb0\xeb\x9e\xd4\x21\x11\xff\xe5\xe7\x2f\xe4\x91\xb5\xc6\x13\x6b\xe9\x44\xc0\x6c\xa2\xab\xd4\x8a\xf9\x58\x78\x36\x96\xbc\x3b\x42\x47\x77\x64\x2d\x49\xe6\xd1\xb6\x89\x4f\x50\xba\x1f\x05\xdf\x08\x41\x73\x03\x82\xaa\x76\xfc\x19\x36\x10\xb2\x2c\x83\x16\x78\x30\xa5\x98\x1a\xb5\xdc\x80\x45\xc9\x81\x70\x16\x65\xf9\xd3\xac\x61\x55\x9f\x85\x57\xe1\xde\xc4\x6f\x2c\x49\xd0\x95\x4f\x7c\xb0\x7b\x4a\xd0\x91\xe8\xfa\x2e\x5a\x47\xe3\x87\x8e\xf2\xe8\x2c\x91\x57\xb6\x88\x2a\xeb\xe4\xcd\x7b\x15\x87\x56\x14\x16\x10\x8b\x1c\xef\xd8\x79\xe9\xdb\x70\xbd\x8a\xaa\x0a\xdc\x6d\x73\x00\x11\xe2\xd0\x08\xd4\x8f\x6a\x91\xf0\xfe\x5e\x6a\xad\x36\xf7\xdd\x6d\x38\x47\x89\xcf\xee\xa8\x12\x7b\x32\x74\xcc\xcb\xb2\xfa\xb9\x5f\x8f\x52\x37\xd2\x6e\xcc\x9b\x4a\x46\xd3\x90\xd3\xc0\x45\x5a\x55\xdf\x00\xf6\xf6\x83\x6a\x98\x46\x8c\xca\x76\x38\xcb\xa6\xfd\x76\x94\x5e\x2f\xd7\xfa\x2a\xf6\x90\x66\xf2\x80\x45\xfd\xca\x20\xb5\xe1\xbf\xaf\x8a\xf8\x6f\x68\xff\xcf\xa6\xfd\x30\xe9\xf3\x00\x8d\x97\x25\x7d\x37\x8c\xd8\x82\x9b\x8a\x47\xac\x97\xdb\x59\xec\x5e\x2c\x52\xbf\x9c\xbf\x20\xbd\x8d\x3d\xfa\xda\x5a\x70\x09\x4f\xe5\xad\x9f\x6f\xa4\x5a\xd7\xfd\x76\xb4\x06\x1e\x94\x78\xef\x3a\xe4\x93\x89\x22\x89\x05\xa6\x9e\x3c\x10\x3b\x97\x6e\xd6\x83\xed\x0a\x7f\xf6\x16\xae\x1d\x8b\x2f\x8b\x8b\x2d\x2d\x38\x36\x3e\xe7\x7a\x65\xad\x77\x6c\xb5\x6a\xf0\x22\xd1\xe9\x7a\xed\x05\x6f\xf9\xd5\xae\x7e\x13\x07\x77\xbb\x77\xff\x64\x0b\x9b\xfd\xfa\x67\x6f\xf3\x8a\x77\x6f\xd2\x13\x09\xfe\x25\x5b\x97\xb9\x79\xfa\x36\x67\x6f\xdf\xe6\xfc\xc1\xda\x3c\xf0\xfa\x6d\xae\x9f\xbf\xcd\xd9\xfb\xb7\x39\x7b\x00\x37\xb7\x5f\xc0\x79\x35\x36\xb0\xb0\xd9\xff\x82\x8f\xe0\xf6\x83\xaf\xe0\xf6\x17\x7f\x06\xb7\xdf\xf4\x1d\xdc\xbe\xff\x10\x6e\xff\x1a\x5e\xc2\xcd\xaf\xfc\x14\x6e\xbf\xf1\x5b\xb8\x7b\x7f\xf6\x72\x68\x60\x71\x36\xaf\x32\x39\x53\xae\x55\xe8\x87\x24\x4e\x66\x75\x36\xe7\x66\x67\x73\xcb\x4a\x6c\x1e\x32\x3c\x9b\x1b\xcb\xb3\x39\x37\x3d\x9b\x73\xdb\xb3\xb9\x63\x7c\x16\xa8\xb7\xc9\xe2\xf8\xa2\xf6\x67\xfb\x61\x03\xb4\xfd\x4b\x58\xa0\xed\x37\x36\x41\xdb\x0f\xd8\xa0\xed\x5f\x83\x11\xda\xbf\xe7\x15\x66\x68\x4d\x17\x49\x73\x43\xb4\xef\x9b\xac\x92\xd6\x5c\xc8\x57\xa0\x98\x1d\x14\x2d\x0a\xc8\x77\x0c\xea\xd3\xf7\xd1\x30\x4b\xd0\x5a\x01\x5f\x07\x82\x93\x3d\xf0\x61\x1b\xfd\xe3\xc5\xf3\x5f\x8a\x62\xfa\x5a\x54\x27\x84\xbc\x25\x14\xcc\x44\x93\xd9\xc8\xc9\x21\x3f\x36\xfa\xfd\x46\x4b\xe1\x45\x36\xdc\x73\xa1\xa3\x8f\x62\xe4\x56\xb0\xc8\x52\x48\x3b\x01\x25\xf5\xdf\xf3\x13\xd8\x7d\xd2\xe3\x49\x36\x4b\xfa\x63\x78\xd6\x73\x41\x16\xab\x80\x87\xfb\x37\x2f\x67\x6f\x5e\xce\xfe\x97\xbf\x9c\xa5\x57\xb3\xd2\x86\xcd\x7a\x36\x4b\x1b\x4e\x74\xb9\xd7\xb3\x72\xef\xdb\x07\x6b\x6f\xf3\xee\x13\xd7\x0e\x3d\x4f\xf2\xc0\xc0\xb4\x4f\xad\x36\x53\x64\x30\x8e\xf3\x3c\x3a\xc0\x22\x87\xb2\x9b\x94\x61\x98\x30\x55\x25\x38\xd0\x8a\xda\x86\xa5\x72\x15\x38\x48\x54\xe1\xcc\xba\xb9\x9f\x73\x84\x84\x9a\xf6\x5f\xee\xec\xbd\x81\xb3\x35\x4e\x42\xeb\x2c\x49\x5b\x44\x9a\xad\x77\xec\xf7\x0b\xf6\xfb\x67\xf6\x3b\xff\x23\x3e\xca\xd4\x87\xe8\xdd\x24\x39\xd7\x5f\xc9\x69\x91\xe1\x53\x46\x95\x32\x4d\x07\x76\xc2\x24\x9e\xd8\x09\xe2\x14\x35\x73\x53\xc6\x82\xea\xdd\x42\x16\xbc\x05\xaa\x3e\xac\x22\xc7\x62\xc1\x0c\xf5\x50\xac\xac\x9f\xad\xaf\x3d\xeb\xeb\x95\xf5\xf5\xd4\xfa\xfa\x5f\xeb\xeb\x9f\xd6\xd7\x4b\xeb\xeb\x89\xf5\xf5\xab\xf5\xb5\x4f\x5f\x4b\x87\xe5\xae\x6b\x60\x8e\x5e\x6d\x3f\x81\x29\xee\x8b\x33\x6c\x57\x27\xbe\x11\x24\xb0\xbd\xb7\xff\xfa\xe9\xdb\xe7\x4f\x5f\xfe\xbc\xf7\x4b\x3f\xda\x34\x99\x38\xab\x7d\xf3\xd3\xe4\x94\x50\x4e\x3f\xfa\x18\x39\x09\xc6\x8f\x3a\x66\xbc\x7d\xb2\xfb\xdb\xcb\xe8\xc2\xd4\xf4\x6a\xf7\xf9\x73\x80\xde\xdb\x79\xf1\x54\xf0\xaf\x7e\xb4\xb6\xba\xba\xba\x22\x7b\x28\x6f\xbc\x1f\x8d\xb3\xc1\xbb\x7e\xd4\x02\xd6\x99\x17\x2d\x2b\x6f\x7b\x80\xa1\x8c\xfb\xe6\x6d\x23\x3d\xc0\x80\xfd\xbc\xce\xf7\xc9\x4d\x28\x8c\x9b\x8d\xec\xdb\x71\x01\x91\x9f\xc4\x1b\xd7\xe5\x01\xe2\xf1\xec\x7c\x5a\x64\x7f\x7b\xc3\x37\x87\x01\xa6\xdd\x31\x11\x30\xa0\xc1\x20\xc0\x0a\xe4\xb4\x82\xd1\x9d\x7c\xf7\x0d\x48\x71\x39\x7f\xa0\x2a\x93\xc0\xf2\x4e\xfe\xec\x29\x7f\x11\x24\x26\x9f\x24\x1f\x5a\xee\x2b\x3a\xcb\xf3\xd7\x4f\xd1\x3a\x94\x76\xbd\x1f\xaf\x2b\x77\x91\x76\x71\x7d\x19\xae\x2f\xf8\x1d\xff\xec\xee\x6b\x3b\x02\x55\x38\x82\xce\xf5\x7e\x49\x3e\xf4\x50\x7b\x29\x3d\xf7\x86\x6c\x8c\x00\x2b\x6a\xd8\xa6\x75\x3a\xd1\x91\xfa\xad\x1f\x89\xe3\xa6\x7c\x59\x67\x1e\x27\xab\x37\x67\xc0\xf2\x34\x8e\x97\xfb\x77\xc5\x01\xc6\x46\xf9\x72\xff\x3e\xbc\x2f\x5b\x5f\xbd\xe1\x7b\x37\x7c\xef\x5b\x09\x01\x44\xef\xfc\xaf\x81\xef\x39\xb2\xfb\xe2\xa2\x7b\x40\x72\x57\x05\x43\x82\xfb\x42\xd1\x86\x5c\x5e\xdb\x5b\x91\xec\xde\x84\x23\x1a\xdd\xe7\x00\xf0\xad\x45\xf8\xf9\x24\x2d\x5e\xc4\x53\x2d\x2e\xb6\x94\x44\xdd\x27\x1e\xd4\x5a\x55\xb2\x26\x48\xed\x7d\xc3\x16\x5b\x6b\x96\x9c\xdf\x67\x19\x10\xe8\x40\xe6\xfd\xbd\x22\xef\x28\x3e\x3a\x8a\x8f\x13\xdd\x12\xcf\x63\xc2\x7f\xdf\xcd\x3b\x0d\xd4\xc9\xb2\x5f\x54\x67\x8f\xb3\xf7\xc9\x38\x1e\xa8\x66\xdd\x6c\x73\xc6\xe8\x87\xb2\x8f\xc3\x95\x33\x88\x9f\x6b\x21\xc4\xf4\x4c\x26\xd9\xc4\x1a\xb7\x0d\x61\xce\x35\xfd\x0a\x88\x9a\x56\xf0\x64\xd5\x0f\x40\x70\x54\x9a\xf3\x52\xbf\x1a\xa8\xae\x26\x79\x76\xeb\x07\x81\xac\xca\xf4\x79\xac\x5f\x0f\x18\x1e\xa2\x0d\x68\x91\x6b\x00\x8e\x81\xbe\xb3\x61\x43\xa0\x7c\xae\xf1\xd4\x57\x59\x31\xef\x6f\xa3\xba\x79\xf5\x4d\x0b\xb0\x32\xc5\x02\x65\xa0\x58\xd8\xd8\xca\x38\x62\x98\xc6\x43\x69\x4a\x8a\xa6\x9e\x1f\xa6\xc9\x00\x36\x2f\x6d\x9e\xcf\x8d\xae\xa4\xf7\x94\x90\xe5\x94\xa9\xe2\x08\xbc\x53\x0c\x87\x32\x22\x97\x63\x83\x35\x38\x11\xdf\x03\x50\xb1\x2a\x15\x3f\xde\xcb\xcb\xd2\x6c\x1f\x09\xb6\x21\x58\x5f\x97\xd9\x42\x47\xab\x25\x6e\x3f\xc0\x23\x6d\xa4\x3c\xd2\x3a\xde\x7d\xe5\x18\x2c\x69\x93\x40\xba\xd8\x3b\x88\x42\x2c\x0a\x06\x7c\x0c\x91\x85\x08\xc2\x60\x50\x5a\x7c\xad\xaa\x6f\x88\xd7\x3a\xbd\xdf\xb3\x74\x82\xc1\x1a\xa2\x87\x58\x47\xd4\x07\xe7\xfe\xe0\x2c\x23\x67\xef\x7a\xaf\x6f\x2e\x30\x60\xcf\x7f\xfa\x64\xe0\x20\x16\x9c\x0d\xd9\xc3\xdb\xe4\x71\xf9\xb2\xf3\xd2\xa9\x9d\x0f\x70\x44\x83\x5b\x27\x9a\x21\x62\x30\x0f\xdf\xc7\xb4\x33\x2f\xe4\xad\xb9\x62\x56\x84\x58\x28\x2a\xa9\xf7\x47\x1d\x70\x94\x04\x8d\x1b\xb3\x43\xf2\x40\xb2\x4f\x5b\xbb\x16\xe9\x4f\x28\xde\xf3\xca\x4a\xf4\x2c\x9d\x0c\x23\x7a\xdc\x25\x3b\xaa\xe3\x35\x83\x44\xb1\xbc\x6c\x6e\xf2\xd1\xf6\xa5\x8b\x21\xa4\x44\x79\x65\xc2\xac\xcf\x5c\x90\x46\xa7\x1e\x38\x71\x94\x9f\x95\xa0\x9a\x75\xfe\xee\x05\x8d\x6b\xa4\x4d\x0d\x18\xad\x3e\x58\xe7\xe0\x2a\x16\x32\xb7\xed\x30\x4d\xb5\x53\x68\x47\x86\xbe\xe0\x87\x39\x2a\x22\x9a\x5f\xed\x58\xa7\xb4\xa3\x59\x12\xbf\x33\xa0\x30\xca\xdb\x0f\x22\xf9\xaa\x1c\x66\xf0\xb1\x20\x11\x88\xb3\x8c\x35\x04\x0f\x61\x30\xd9\xca\x1c\x47\x94\x6f\x46\x21\x34\x69\x0b\x91\xc8\x36\x14\xf9\x72\x34\x82\xcd\x7d\x3b\x44\x72\x99\x99\x17\x65\x1a\x4d\x76\xc0\xc7\x3c\x3c\xba\x1d\xa5\x1f\x28\x88\xd6\xaa\x40\xac\x98\x05\xe4\x1a\x61\xf7\xf6\x32\xda\x5b\xf9\xec\x07\x6d\x97\xf1\x08\x2a\x0e\x4d\xc0\xcd\x2b\x83\x09\x84\xa2\x7c\x5a\xbe\xf6\xa5\xdb\x75\xd9\x0d\x4e\x15\x40\xf1\x12\xf3\x34\xfb\xb8\x1c\xa4\xd9\x36\x2d\x07\x35\x23\xd0\x92\xa1\x0e\x31\x83\xae\x09\x9d\x00\xef\x95\x18\xab\xb3\x19\x05\xa8\x81\x9c\x9b\xed\xa2\x9d\x5e\x6e\xa5\xa3\x75\xa2\x0c\x0e\x6e\x79\x6d\x33\x20\xd0\x5f\x41\x12\x13\xb5\x2f\xfc\x18\xad\x83\x63\x38\x8c\xc6\x06\xfb\xc1\x24\x44\x13\xd2\x85\xbc\x28\x7b\x9d\xa4\xe1\xc4\x1c\x70\x69\xa3\x86\x35\x7c\x31\xe2\xf0\x78\x06\xa3\x8e\x2f\x43\x01\x7f\xda\xb4\x3a\x1e\x4b\x47\xf3\xf1\x58\xa3\x61\x05\x74\x31\x05\x3d\x14\x40\x96\xff\x7b\x2e\x10\x19\x1f\xa5\x2e\x8f\x57\x6e\x13\xf7\xb2\x67\xa2\xbc\xfb\x86\x52\x3d\x26\x80\xd2\x77\xa8\xb4\xff\x18\x8a\x1a\xf6\xda\x35\x8c\xdd\x6f\xc3\x12\xa4\xa0\x72\xae\x3a\x85\xef\x1e\x9a\x50\x08\xa6\x9f\x7c\xd8\x1d\xb5\x5b\x6d\xc1\x9a\xc1\x37\xe4\x9d\xb5\xc0\x73\x48\x0d\xef\xd9\x09\x82\x95\x82\x6c\x0e\x81\x90\x8a\x6c\x7f\x66\x62\x2a\x3f\xaa\x10\xc2\x3d\x80\xdf\x02\xb5\x25\x8a\x62\xb6\xe5\x9f\x6e\x45\xcc\x89\xe8\x0f\xb8\x96\x53\xb5\x8b\x84\x4e\xab\xd1\xda\x1b\xa6\xf9\x74\x1c\x9f\xd3\xbc\xa0\x8f\xd1\x49\x01\xb2\xad\xc6\x86\xfb\x66\xed\x03\x66\x3f\xa1\x62\x55\x2f\x5c\xa1\x36\x7b\xf2\xc3\xcb\xcb\xea\x01\x6c\x69\x0e\xc5\xc0\x33\x3d\x19\x6f\xf1\xbc\x2d\xcd\xea\x3a\xd1\x9d\x9f\x74\xa2\x9e\x56\xbf\x6f\x95\x0f\x9f\xb5\xcd\xa6\x37\xb3\x16\x9a\x25\x18\x4d\xb6\x58\x24\xce\x9b\x56\xf9\x1e\x0c\xd6\x8c\x71\x36\x22\xe4\x03\x3d\x50\xf0\x4e\x32\xce\x62\x78\xf9\xa2\x14\xc1\x81\x35\xc1\x07\xb0\x67\x88\xa4\xec\x4d\xe3\x4a\xb4\xb7\xfb\x64\xb7\x1f\x9d\xc6\xef\x50\x35\x9c\x4e\xde\xcf\xc7\xa0\x1d\x3c\x1a\x27\xd7\x39\x40\x73\x1a\x70\x5f\xef\xae\x89\xff\x58\x76\xa7\xd3\x13\x3c\x12\x94\x43\x82\x12\x5b\xe8\xd4\x0d\x4e\x0b\xcb\x76\x90\xc8\x6c\x22\x90\x51\xe4\x26\xe4\x26\xca\x7d\x42\x6e\x4c\x4f\x05\x17\x76\x98\x6c\x3a\x09\x33\xfb\x22\x7b\x42\x05\x16\x0c\x9f\x69\xbb\x35\x24\x01\x4f\xd6\x54\x1b\x00\xb2\xc8\xfc\xf8\x98\x3a\xfc\x4c\x93\x31\xd6\xca\xb6\xc0\x13\xaf\x6b\x5c\xac\xae\xea\xe0\xac\x13\xa5\x25\xf5\xc7\x17\x08\xcd\x2d\xd5\xa7\xf6\x8e\x62\x1d\xf6\x09\x60\x9c\xe4\xf9\xde\x49\x3c\x69\xaf\xa2\x13\xd9\x3b\x64\x75\x2e\xad\xf7\x25\x61\xad\x75\x30\x7c\x2b\xcb\xb1\xb0\xb8\x3d\x47\x37\xcd\x12\x95\x70\x7f\x40\x8e\x77\xa4\x3b\xd2\x49\x39\x5a\x7b\x12\xaf\xdb\x10\x90\x15\x8a\x10\x0d\xa5\xa3\xf3\x5c\x3a\x52\xcf\x05\x17\x14\x7c\x26\xe9\x79\x74\xf5\x8b\x3c\x3a\x54\xe3\xfe\x93\xdc\x83\x6a\x48\xeb\x17\xdc\xe7\x2d\xe4\xeb\xf5\xfb\x9d\x34\x15\x3b\x8d\x3f\x50\xd8\xca\x0f\x69\x71\xde\x8f\xee\xa3\x0a\x5b\xed\x3a\x69\x2e\x5d\x1a\x63\xd1\x8e\xbb\xc9\xb0\x49\x6e\xdf\x06\x88\x2d\xab\x28\x9f\xce\xea\xc2\x4e\x59\x69\x48\xf7\x1e\x68\x07\x4e\x21\x84\xb4\x56\xb0\x0a\x8c\xaf\xf4\xb7\x37\xbb\x2f\x7b\x1a\xcb\xd4\x9e\x71\x60\x89\xae\x63\xc1\x44\xd5\x89\xe6\x09\x3e\x23\xf3\x1c\x78\x17\xf8\x76\x9a\x1f\x9f\xd8\x2b\x40\x0f\x44\xd2\x1a\xd6\xea\x5f\x4e\x1a\xae\x76\x07\x4f\x4b\x01\x99\xb7\x74\xc4\x0a\x40\xbe\xed\xb0\xab\xab\xa9\xed\xbd\xb2\x1f\x65\x15\x44\xed\x5b\x69\x2e\x8e\x85\x69\x91\x38\x48\x77\xba\x81\x12\x22\xeb\x84\x2d\x65\xf9\x1d\x65\xeb\xe2\xb5\xdc\x54\x68\x1d\xc0\x79\x69\x82\xdc\x3f\xfa\x2d\x71\x05\x29\xb1\xbb\x63\xc4\xe2\xdd\xd1\xbe\x80\x09\xed\xd2\x58\x16\xee\x98\xe8\x87\x5e\x70\xe2\x67\x57\x6b\xa7\xb4\x43\xf4\x60\xd4\x46\xdd\x0f\x5d\x4d\x9b\x3a\xd3\xc1\x22\xe8\xb6\x2b\x8f\x92\xd9\x4c\xec\xeb\x29\x83\x82\x30\xbd\x59\x21\x1a\x9c\xcd\x04\x71\xf5\xcf\xf4\xba\xb1\x7b\x6d\x2d\x20\x28\xa8\x48\x60\x4e\x99\xf8\xe7\x21\xfe\x11\x6c\xe9\x79\x76\x96\xcc\x1e\xc7\x79\xd2\x46\xe6\x42\xfa\x5e\xc3\xc7\x00\xea\x57\x79\xcb\x2c\xaf\x6e\x0e\xe0\xef\xa1\x39\x8a\x33\x10\xee\xf7\x9b\x13\x1e\x79\x22\x03\xf5\xd9\x53\x18\x55\xbb\x85\x57\xbd\xd8\x11\xb4\x55\xfd\x57\x4b\xe0\xec\x43\x9a\x17\xb9\xa0\x70\xb1\x7a\x73\x14\x8b\x71\xe4\xd9\x44\xa3\x6a\x94\x8d\xc7\xd9\x19\x28\x22\xa0\x64\x0e\x5c\xd0\x59\x46\xb2\x87\x5d\xf4\xaf\xd0\x35\xcf\x3e\x2a\xa2\xc4\xea\xde\xa3\xf7\x2b\xdb\xab\xb0\x90\x13\x70\x11\x12\xc3\xc7\xcb\xe8\x14\xed\x69\x35\x93\x25\x12\x00\x56\x8b\xbe\x2a\x68\xe2\x09\xb5\x92\xb2\x5e\x65\x79\x9e\x1e\x8d\x69\x0a\xd1\x85\x86\x34\xea\x7b\xb3\x03\xf2\xa5\x20\x1c\xfc\x09\x22\xb5\xc2\xd6\xd3\xd1\x28\x3d\x3e\x97\x1f\xbb\x8a\x94\xee\x44\x70\xef\xa5\xde\x91\xe9\xeb\x2a\x3a\xa2\xd2\xcd\x16\x81\x9d\x1a\x30\x7d\xb9\x44\x9f\xea\x2a\x8a\xe0\x8e\x0d\x9c\xbe\xff\x91\x2a\x0d\xba\x30\x32\x79\x54\xe0\xce\x1d\xbd\x30\xcd\x3d\x0e\x15\x80\xbb\x1f\x2b\x2f\x50\x42\xdd\xc3\xd0\x00\xf0\xfa\x86\xe7\x51\x09\xd6\x0b\x56\x98\x3e\x25\x16\x18\x48\xe4\x40\xb0\x0f\xbc\x4c\x91\x08\x49\x0c\x0e\x8f\xd9\xef\x42\xfd\x76\x45\x0a\xe2\x0b\xce\xc9\xf7\x93\x96\xd3\x89\x1c\xc0\xd8\xe0\x28\xd1\xe4\xd1\x55\xe9\x52\x57\x26\xd6\x6e\x1c\xfd\xf2\xf4\x1f\x78\x08\x57\xd2\xda\xb5\x31\x14\xbd\xcf\xaa\xa3\xdd\x6f\xe0\x8f\x8e\xb6\xfb\x98\x5d\xe5\xca\x28\x28\x2c\x58\x00\xac\xa7\x38\x17\x94\x0c\x0b\xc4\x38\x58\x51\xc3\x58\x62\xd2\x90\x40\x03\x3f\x92\xab\x71\x72\x96\x42\xa2\x0e\xd4\xac\x98\x04\x17\x8a\xa8\x04\x8f\x1a\x6b\x73\x2a\xe2\x4e\x8e\x3c\xc4\xb7\x61\x59\x81\xe0\x56\xbc\x91\x3e\xff\x62\x92\x55\xed\xf2\x8d\x09\xc7\x81\x15\x2c\x96\xc2\xcd\x82\xfd\x56\x96\x78\x91\x55\x2d\x70\x76\x5e\xf8\x62\x4b\x1d\x56\xdb\x9f\xb8\xd8\x11\x21\xd7\xb3\xd4\x8b\xf4\x34\xc9\xff\x8c\x65\x3e\x91\xca\x45\x58\xdc\x5a\x55\x95\xd3\x31\x1f\xb7\xe8\x48\xdb\xb2\x78\xe4\xa0\x7b\xd2\x88\x28\x0c\x19\xa8\xbb\x43\x98\x7b\x43\x0b\x76\x6d\xda\xcb\x95\xa9\xc0\x00\x68\xfc\x73\xe3\x1b\x67\x16\x6a\xce\x3f\x77\x41\x08\xc4\x65\xaf\xca\xcb\x1f\x9f\x3e\x45\xab\x5b\xc1\xc3\x8d\xac\xd7\x3b\x9c\x50\xba\x75\x22\x92\x38\x57\x3d\xf9\xcb\x5f\x22\xf9\x3b\x24\xf4\x43\x93\x6e\x2e\x3f\x61\x84\x7c\xa0\x59\xb2\x98\x2c\xac\x75\x22\xa2\x6c\xb7\xd5\xe2\x17\x2e\x8e\xa7\xb4\x50\x69\x4e\x28\xa5\x32\xdd\x44\xc5\x8e\x0d\x90\x8a\xa6\x13\x02\x53\x51\x3c\xf4\x51\x4c\x9a\x4d\x22\x6c\x71\x96\x89\x03\xf5\x40\xc7\x74\xf1\x48\xcb\x0e\xf9\xd2\x84\xbe\x4a\xa8\x86\x1d\x92\xed\x3a\x6d\x85\x97\x45\x32\x00\x7e\xc4\x28\x2b\xb4\x60\xf1\xc9\x77\x9b\x79\xad\xd3\x05\xb8\x4a\xa2\x76\xea\x96\x47\x57\xfc\xb7\x8c\xb3\xdc\x9b\x8e\xe7\xb9\xe9\x82\xfc\x0e\xba\x37\xd4\x40\xb6\x26\x09\xa2\x28\xe5\xea\xd8\x44\x3c\x52\x5d\x73\xe6\xf2\xb1\xdc\xf8\x1c\xfd\xf8\x06\xa3\x11\x13\xc9\x0f\x82\x91\x88\xed\x98\xc2\xac\x01\x58\xff\xb1\x81\xe7\x8e\xed\x30\xb8\x92\x9c\x39\xa7\x6e\x6b\xe2\x64\xa5\x8e\x06\x6d\xe5\xdf\xab\x1f\xe0\x99\x61\x7c\x67\x74\xf8\x71\x73\xf5\xe2\xff\xae\xa4\x3d\x78\x97\xa0\xc1\x17\x18\x7b\xc5\x90\x3f\xcf\x60\x1b\x0c\x13\xcf\xff\x2b\xff\x6e\xaf\x7e\xe8\x3c\xac\x1c\x27\xa7\xbf\x95\x15\x13\x2b\x8b\xa2\x61\x61\xef\xc8\x83\xb0\x34\x3a\x3c\xc5\x77\xbc\xb0\x1f\xb3\x51\x3b\x4a\x05\x72\x04\xe8\xb8\xaa\x08\x76\xc6\xee\x0b\xb0\x39\x06\xb6\x7d\xe7\x59\x10\xcc\xe9\x32\x86\xee\x12\xbc\x02\x9c\x1d\xe7\xa7\xf0\xff\x41\x3c\xcd\x51\x76\x10\xbf\xd5\x77\x97\x7b\x68\xf4\x22\x21\xa1\xe3\x79\xd6\x61\xab\x81\x5d\xbd\xbd\x13\x76\x92\x78\x70\x22\xea\xcc\xbd\x6a\xd2\x9c\x08\x65\x7e\x2a\x67\x88\x51\x13\xad\xb2\xe6\x34\x45\x6d\x89\xaa\x4e\xc1\x06\xb7\x84\xbc\x9c\xe6\xae\x99\xcc\x9c\xda\xab\xc8\x4d\x60\xe9\x71\x00\x37\xb1\x2e\x29\x7f\x79\x3b\x90\xd1\x87\x48\x88\x5f\xe2\x1c\x9d\xd1\x80\x19\xbd\x2a\x64\xe9\x52\x94\xb4\x13\x72\xf8\xb2\xb9\x8a\x77\x94\x91\x43\x21\xe8\xbc\xbb\x38\x89\xc6\x09\x3e\xa7\x66\x11\xf8\xa6\xd3\x64\x06\xbd\x55\xd3\x30\xc1\xe8\x85\xc7\x29\x05\xb8\x13\x19\xa7\xf1\x14\xa6\x63\xcd\xd2\xf4\xb5\xb5\x05\x03\xeb\x34\xba\x65\x13\x8c\x36\xfa\x29\xfa\x1e\xb6\x73\x99\x25\x92\x21\xf2\x3b\x34\x24\x75\x41\x70\xb3\x61\x32\x91\xe8\xab\x2b\xfc\xf1\x41\xa0\x46\xae\x5d\x72\x6a\x2c\xf1\x15\xce\x96\xa5\x61\xf9\x17\xe1\xf8\x0b\x9a\x4a\x5f\xc8\xa3\x9e\xa2\xc6\x12\x52\x2c\xb2\xeb\x24\x45\xa5\xbd\xd6\xfb\xf2\x02\x94\xc8\x74\xc6\x9a\xfa\xdc\x57\xd7\xb2\x1d\x08\xd9\x55\x42\xa6\x16\x7e\x2f\x45\xb4\x0c\x68\xe0\xf5\x1e\x2a\xaa\x20\x63\xd5\x0b\x76\xed\x1e\x85\x98\xde\x89\x31\xfd\x03\x22\xfd\x01\x0e\x3e\x23\x72\xa0\xaf\x6e\xe2\x34\x85\x5b\x04\x5c\x47\xbf\x36\x05\xb9\xfd\xbf\xfd\xa0\x94\xb8\x19\x79\xb9\x8c\xb4\xb6\xa6\x4a\xd2\x74\x4a\xda\x92\xa7\x91\x34\x2d\x36\x2a\xb0\x21\x24\xca\x74\x18\xad\xaf\x92\xcf\xa0\x3b\xf2\x82\x90\xda\xa4\xf7\x09\xab\x1f\x22\x32\xee\xf0\x8d\xbb\x6a\x29\x39\x7c\xdb\x2f\xbd\x0f\x70\xae\xad\x0d\xb8\x9a\xd1\xaf\x23\xce\x04\x37\x3e\xe5\x54\x57\xe1\x83\xc9\xf0\xb4\xdb\x56\x6d\xb4\x81\x8a\xe4\xe5\x57\xa8\x3e\x1b\x42\x85\x5e\xc4\x2b\x25\x5d\xa3\x79\x4d\xd5\x15\x5c\x38\x7c\x4b\xa0\x1c\x11\xab\xd3\x74\x6e\xa4\xdc\xfa\x20\x9b\xc1\x93\x64\xe8\xea\xcb\x0a\xbc\x49\x1b\x59\xe8\x0c\x18\xb5\xa9\x1b\x8e\x5c\xa8\xfa\x36\xc9\x8b\x78\x32\x00\x2e\x62\x0a\x7f\xfa\xa4\x91\x26\x0b\xe3\xeb\x35\xfc\x65\x39\xce\x08\xa6\x92\x6d\x04\xf2\x22\x5d\x65\xab\x29\xa2\xd4\x79\xb8\x0e\x4b\x6f\x3c\xe3\xa2\x86\x28\x0a\x84\x48\x0a\xe2\x47\xb2\x56\xd9\x33\x1c\x0d\xf5\xad\x75\x7d\xe8\xa1\xbe\x34\xc6\x8d\xea\x71\x3d\x76\x9e\x19\x23\x92\x45\xf1\xa3\x8a\x5e\x0a\x43\xaa\x44\xab\xe1\x88\xa0\x4f\x45\xfd\x70\xa8\xeb\x97\x18\xcc\xae\xec\x5b\xc3\xc5\x00\xe2\x05\x5c\xfb\x60\x94\x84\xb6\xbf\x42\xd0\x70\x1b\x55\x18\x81\xb1\xcb\xfb\xda\x86\x23\x3f\xa2\xce\x36\x60\x07\x04\x78\x19\x66\x40\x49\x97\x9a\x48\xd9\xbd\xa6\xa3\x41\x17\x80\xf5\x63\xf1\x22\x6a\x34\x1c\x89\x1f\x15\xa3\x21\x4b\xc3\x82\x95\x63\x70\x8f\x23\xb4\x8f\xa2\x8d\x5c\xa6\xa8\xce\x1e\xc4\xdf\x72\x7b\x5d\x19\x0b\x84\xca\x31\x40\x31\xf7\xd5\x80\x76\x9d\x34\x3b\xf7\xf7\xa9\x5b\xb7\xf0\x66\x92\x9e\xe1\x1a\x63\xd6\x0b\x21\xad\x43\xd8\xa7\x76\x12\xd8\xdb\xf8\x81\x74\x91\x51\xc7\x72\xdc\x4f\x61\x02\x13\x21\xfc\x1e\x81\x0f\x83\xe8\x24\x89\xe1\x91\xab\xf8\xc8\x4e\x7b\x3e\x65\x42\x72\x48\x68\x00\xe3\x10\xe5\x17\x1d\xcb\xc2\x8b\xdc\xc2\x55\x66\x06\x08\x16\x61\x2d\xf7\xc3\x90\x52\x79\xd2\xbf\xbb\xb6\x6e\x8e\xfa\x04\x5e\x83\x97\xf2\x83\x3a\xd5\xc5\xab\x0a\x9d\xd3\x7d\xa8\x50\xc4\x61\x73\xce\xae\x8e\x1c\xd0\x74\x66\xc6\x19\x88\x79\xd3\x74\xe0\xcf\xc3\x1e\x24\xd7\xcd\x43\xa1\x81\x2a\x26\x00\x6b\xb2\x26\x00\x8b\x55\x4e\xc0\xbd\x4d\x83\x7f\x82\xbe\x34\xfe\xb1\x2a\x5e\x53\x08\xfd\x1e\xd0\xa5\xb0\x5f\xe2\x78\x44\x8a\x7c\x7d\xf5\xa3\xab\x52\xf1\xd1\x4f\x5f\xff\xa2\x74\x14\x0c\xfb\xf4\x47\xa5\x48\x0b\x92\xbe\xf9\x49\x39\xcc\xba\xa4\xcf\x3f\x54\x39\xb0\xe8\xe9\xcb\xbf\x2a\x0d\xed\x55\xfa\xea\x87\xa9\x87\x60\xd5\x2f\x93\x2e\xe1\xf5\x4f\x59\x8f\x6f\x74\xdb\x0f\x25\x76\xa5\xdf\x2d\xd7\x96\xb3\x1f\x48\xb3\x60\x95\xd9\x64\xdf\x4d\x50\xe3\xf8\x2d\xc1\x51\x88\x3f\xa6\xaf\x98\x26\x7f\x28\x38\x2d\x2d\xf6\xf9\x87\xca\xb5\x55\xd6\x7d\x2f\x45\x63\x8d\x04\xf5\xbe\xf9\x49\x39\x4c\x3a\xee\xf3\x0f\x95\x6b\x9d\x44\xfa\x6e\x82\x82\x62\xf9\x4e\x8e\x73\x74\xef\xfb\x49\xaa\x87\x1e\xa4\x97\xa4\xea\x54\xc2\x58\x9f\xfd\xe6\xfd\x9d\x1c\xf7\xf5\x2f\x95\x4e\x7b\x6a\x5f\xff\xd2\xa3\xa7\xf5\xde\x37\x3f\xf5\x98\x60\x97\xec\xab\x1f\x2a\x15\x36\xac\xbe\xfc\xab\xeb\x00\x7e\xd7\x57\x3f\x54\x2a\xb2\x8d\xbe\xfa\xd1\xc5\x05\x46\x0e\xea\xe4\xab\xee\xe5\xfe\xda\x0f\xdd\x4a\xff\x36\xdd\x65\x78\xba\xb1\xdc\xbf\x7f\x17\x3c\x3e\xac\x35\xf1\xf8\x60\x2f\xe1\x07\xb4\x80\x97\xa5\xa3\x83\x65\x78\x31\xd7\x5b\x5f\xed\xad\x2d\x83\x2f\x38\x72\x05\xb7\xbe\x7e\xe3\x49\xe2\xc6\x93\xc4\xb7\xe2\x49\x42\xd6\x12\xf0\x05\xf7\xb7\x64\x24\xe8\xf5\x3c\xfa\x2d\x1d\x0f\xde\x89\x8a\x7f\x17\xdf\xae\x3b\x89\x86\x1e\xe3\x10\x2c\x15\x94\xbe\x0b\x12\x77\x8c\x50\xe2\xd3\x07\x7b\x16\x1f\x01\xd8\xaf\xd9\xb1\xd8\xfe\x8b\x64\x3c\x16\xd8\xf8\x71\x84\x89\x3e\xf0\xcf\xf1\xfb\xe8\xb7\x4c\x08\x37\x3f\x1e\x97\xba\xb9\xd8\x34\xee\x7d\xa4\x2f\xc8\x17\xf1\x24\x3e\xb6\x7d\x4f\xf4\x56\x00\x0b\x2b\x33\x02\x38\x25\x00\xe5\x63\x62\xe7\x08\x0f\x47\x2e\x70\x2a\x92\x15\xc8\x53\x34\xe3\x77\x21\x48\xf2\xca\x57\xc4\x1f\x05\xf8\xe4\x51\x05\xdc\xf0\x48\xfb\x9b\x3d\xa9\xaa\x2f\x3f\xd1\xf5\xbd\x44\xcf\xe4\x65\x80\x93\xa4\x50\x80\xaf\xc4\x72\xc4\xa7\x54\xe5\xd0\x53\x09\xa2\x3b\x21\x96\xd0\x69\x55\x37\x20\x5f\x03\x27\x45\x81\x51\x9b\x7c\xf8\x5c\x66\x29\x50\xc5\x55\x2c\x48\xc5\x2e\xe0\x44\x65\x9c\x7b\x88\x35\x64\x83\xb1\xb0\xf2\xe5\x3e\x42\x08\x48\x79\x63\x92\x0f\x37\x92\xc9\x30\xd0\x37\xca\x50\x60\x8f\xf0\xc4\xe4\x43\x1d\x41\xba\xc6\xe4\x4c\x70\xab\x59\x71\x1e\x80\x9b\xca\x2c\x05\x0a\x0e\x48\x05\x38\x58\xa4\x85\xc8\x0d\x16\xea\x54\x66\x6b\x62\x9b\x0e\x2a\x4a\xa4\xd3\x81\x5b\xa0\x99\x47\xc3\xa5\x25\x2d\xab\xff\x26\x2a\x82\xf7\x7b\x54\x8d\xed\x95\x77\xe6\xae\x10\xb8\x79\xb7\x97\x8d\x29\xc9\x1c\xf4\xca\x50\xab\xac\xe7\x0a\x8a\x01\x25\xf4\xd6\x05\x2c\x73\x8b\x13\x74\xd4\xcf\xab\x18\x1e\xc9\xcc\x27\x8f\xbc\xbc\xfc\x44\x95\x14\x0b\xc3\xcb\x9d\xe0\x1a\x80\x5c\xb1\x1a\xbc\xdc\xa9\x21\x7c\x00\x51\xeb\xc0\x83\x3b\xfa\xe3\x0f\xd5\x06\xd0\xb5\xdf\x07\x43\xe0\x08\x24\x3f\xdb\x1c\xc6\x50\xb6\x39\x6a\xc4\x53\x21\xc0\x2a\xea\x96\x7f\xe9\xc8\xc1\x3b\x29\xb1\x95\x5b\x45\x39\xf9\xf4\xad\xaf\xae\x86\x61\xf4\xd2\xe7\x1f\x5e\x13\x6f\xf5\x1a\xa0\x1f\xde\x00\x25\x40\xdb\xb4\x2f\xc9\x39\x4d\x84\xe8\x69\x7e\xb7\x3b\x4b\x2a\xca\xd5\x05\x7a\x02\x06\x91\x28\x4f\x22\x33\x86\x8c\x76\x62\xf4\x09\xb4\x04\xe4\x16\x18\x5f\xdd\xd8\x4a\xc7\x05\x42\xa3\xaa\x53\xc5\xd3\x4c\x38\xe5\xf1\x98\xc1\xf5\x34\xce\x0b\x2f\x4c\xda\x81\xbe\xc0\x4b\x17\x5b\x91\xf8\x2e\x49\xa6\x3b\xf9\x9b\xf3\xc9\x40\xd0\x4b\x65\x57\xb0\xac\x03\xdf\x8c\x02\x03\x1d\xe1\x7c\xe1\x91\xb1\x5f\x71\xa0\xd4\x33\x18\x72\x27\x85\x5f\x01\x18\xf5\x64\x56\x41\xe1\x77\x00\x4e\xbe\xbb\x56\x60\xf0\xe9\x41\xf1\x27\xb8\x04\xa8\x53\x82\xb0\x56\x9d\x2a\x21\xd0\xb6\x79\x4f\xa5\x9a\x97\x29\xc1\x5a\x5d\x68\x96\x16\xa8\x9b\xe3\x52\xd5\x5e\x85\x53\x32\x71\x54\x90\xe2\x2b\xd8\x07\x0e\x25\xbf\x3d\x38\x6e\xda\x42\x90\x3a\x25\x00\xeb\xdf\x0a\xab\x32\x8f\xdd\xdb\x42\xaf\xcf\x65\x65\xbd\x9c\x40\xbb\x3b\x8f\xb6\x5f\xb2\xc6\xe0\xd3\x83\x32\xee\x69\x08\x4c\x7e\x87\xe0\x94\xe7\x14\x0d\x88\x09\xb0\x8b\xb9\x0b\x9f\xb6\x7e\xfe\x92\x9b\x2e\x85\x6c\xef\x8a\xf6\x75\x1d\xde\x49\xbb\x90\x65\xd7\xa7\xc3\x74\x86\xaa\xe2\x41\x3c\xc5\xd7\x17\xec\x02\x33\x30\xa3\x3b\x8f\xb7\x5f\x59\x6b\x1f\xca\x71\x0b\xb9\x94\x44\x49\x58\xbe\x20\x55\x52\xbe\xf5\xd8\x13\x20\x7a\xb2\x19\xb5\xb2\xd1\xa1\x8c\xe6\xbf\x55\x11\x47\x0f\x34\xef\xc6\xbd\x4e\x8a\x23\x6d\xfb\xce\x79\x82\x3a\x98\x96\xda\x93\x26\xd9\x30\x69\x75\x2d\x88\x63\x34\x0b\xe9\x83\x5f\xb4\xa3\x8d\xb7\x03\x71\x9e\x9a\x14\xbf\x12\x78\x8b\xc5\x59\xe9\x5e\xa6\xb5\xa4\x38\xcb\x66\xef\xca\x1a\x14\xd9\x6f\x25\xa8\x03\x62\x07\x0c\xe8\xbb\xab\xfc\x8a\xdd\x4a\xa4\x42\xbb\xac\x5f\x22\xff\x2d\xce\xf5\x20\x1b\xff\xfa\x27\xf4\xef\x4c\x6c\x00\x53\xed\x1b\xd9\xeb\x9e\x10\x90\xae\x8c\x36\xfc\x79\x18\xdc\x4b\x04\xff\xc8\x26\x13\xf2\xd9\xc4\x96\x5b\xc7\xa2\xbd\x76\x70\xbb\x84\x3b\xb0\x40\x3a\xaf\xb2\xdd\x09\xef\x60\xe4\xa5\x40\xc9\xe4\xa5\x34\x8f\xc6\xa1\x18\x39\x41\x4a\x34\x41\xbd\x2d\xd4\xad\xbc\x89\xf2\x13\x02\xa9\x9c\xac\x83\xc5\x72\x7f\x7d\x15\x92\xf8\x91\x44\xa4\xad\x41\x9a\x39\x0e\x88\x94\x4d\x9d\x42\xa2\x93\x48\xba\xaf\x93\xb8\x70\xbf\xdc\xdf\x58\xd7\x19\xb0\xc2\x45\xc2\x86\x49\x30\x42\xbd\x48\x37\x95\x9a\x63\xa1\x48\xfe\xde\x4b\x16\x7f\x44\xfa\x7d\x2f\x5d\x2c\x21\x91\xfe\x83\x97\xae\x04\xe1\xe5\xfe\xe6\xaa\x97\x29\x08\x48\xa4\xaf\xf9\xe9\x20\x0b\x8b\x1c\xd3\x7d\x75\xc6\x11\x89\x77\x75\xa2\x7d\x70\x16\x59\xf7\x74\x96\x92\x5a\x44\xe2\xf7\xdd\x3a\xdf\xd5\xeb\x1b\x37\x9a\xb7\x1b\xcd\xdb\xb7\xa2\x79\x03\x47\x1f\xe0\x60\xe2\x6a\x7e\x5c\x99\x82\xcb\x53\x85\x84\x74\x21\x2a\x4c\xcc\xd3\xf7\x64\xd1\xcf\x74\x0c\xd8\x1b\x05\x67\x82\xc6\xd4\x45\x47\xf2\xf5\x34\x41\x45\xcd\x33\xbc\xdc\x75\x2a\xc3\x34\x05\x71\x46\xb1\x8f\x6c\x10\xc5\x8a\x64\xa6\xf6\xee\xba\x3d\x1e\x5b\x43\xb1\x05\x23\xfb\x68\xd5\xc5\x9b\xfa\x6e\xe4\x98\x96\x95\x28\x3d\xec\x04\x7e\x44\xfe\x9d\x2e\xe7\xe1\x0f\x4f\xf6\xcc\x25\x69\x13\xf2\x7a\x58\x1d\xe6\xdb\x91\x5a\x95\x3f\xf0\x07\xfa\xd7\xa7\x4f\x18\xff\xc6\xf3\xfb\x00\x89\x98\x7a\xd0\x02\x29\x14\xe3\x0a\x80\x8b\x0f\x71\x48\xc1\x9f\x87\x3d\x42\x33\x8b\x77\x38\x0a\xdc\x86\xca\x66\x0e\x46\x87\x68\xe0\xa2\xed\x43\xb7\x94\x6b\x6e\x3f\x68\xb6\x53\x0d\xf4\x07\x8a\x3f\x60\xc4\x43\x2e\x74\xb0\x23\x74\x5e\x32\x41\xf0\x4c\x83\xca\x66\xc1\x3c\xdc\x42\x5f\x14\x06\xaf\x16\x9e\xed\xd7\x5d\xdc\x3b\x45\x15\xc6\x03\x51\x8b\xc5\x12\x8a\xd5\x08\xe0\x77\x0f\xfe\x27\x20\xcc\x6f\x81\xf2\x96\x7c\xa2\x8e\x89\x78\x95\x9c\x2b\x10\xf9\x25\x80\x74\xf4\x4d\xd4\x36\x42\xd3\xea\x8e\x9c\x01\x1e\xac\x1e\xf6\x72\x60\x08\xda\xc5\x3a\x40\x9f\x4a\x01\xc7\x50\x98\x3f\x5d\xbf\x07\xa6\x8b\xb7\xf2\x40\x9a\xda\x4a\xe9\xce\xbf\xb4\x15\x35\x68\x1c\x8b\x6e\xb0\x87\x57\xb7\xb0\x7d\x51\x3f\x3e\x76\x80\xb7\x9d\x05\x3c\xa7\x1c\x52\xbf\xc0\xba\x00\x1b\x7a\xa8\x9b\x07\x13\xb5\x4c\xc8\x56\x82\xcd\x4f\xda\x2d\x5e\x82\xbc\xe5\x00\x2b\x1e\x67\xc7\x2d\x66\xfa\x2a\x7b\x0c\xa8\xf0\x3c\x2e\x25\x92\x0d\xf1\xc8\x5c\x38\x77\xed\xd0\xea\xec\x52\xb7\xba\x36\x41\xd8\x47\x28\xac\x51\x39\x3b\xc4\x29\x6e\xb0\x1c\x3f\x24\x03\x90\x00\x02\xeb\xb1\x8b\x1e\x99\x8e\xe2\xc1\x3b\x1d\x43\x14\x5d\x11\xc8\xc3\xae\xba\x6e\x6d\xc7\xb3\xe3\x39\xbe\x05\x39\xd0\xbf\x98\x37\x1e\xdb\x08\x5d\xd5\x88\xb1\x9f\x2b\x8b\x71\xbf\x71\x6d\x0f\x02\x26\x7e\xcd\xf6\x63\x61\xd8\x06\x58\xa8\x79\xe8\xce\x14\xa5\x49\xef\x77\xe6\x00\xac\x20\x46\x2c\xca\x1a\x69\x66\x11\x93\xbd\xa3\xd4\x56\x1a\x32\xf1\x9b\x38\x7b\x3b\xeb\xe2\x41\xa9\xd5\x8d\xc2\x53\x27\x39\x38\x1c\xb6\xda\x9d\xae\x69\x88\x61\xb8\x7e\xa6\x44\x09\xc1\x83\xf6\xb2\xc7\xca\x11\x16\x9f\x32\xe5\x1d\x8b\x9f\xb9\xcd\xd4\xd2\xb8\xe9\xd3\x1b\x8e\x2a\xda\x13\x5d\xd1\xfb\x89\x04\x2e\x39\x53\x78\xdd\xd4\x07\x8c\xc0\x09\x23\x78\xc4\x40\x52\x15\x1f\x28\xdd\xd3\xaa\x17\x5f\x28\xbb\xf3\x98\x6d\x1b\x08\xec\x6c\x84\x42\xa0\xde\x00\x91\x79\xf3\x46\x64\xbe\x11\x99\xbf\x99\x70\x2f\x78\xf6\xbe\xae\x78\x2f\x60\xb2\x32\x9b\x0e\x6c\x79\xf3\x77\x4a\xd4\x57\x87\xe0\x45\xc6\x11\x49\x29\x4d\x4b\xa2\xbe\x8a\x02\x06\x6b\x09\x99\x9e\x8c\x89\xe8\x78\x5b\x2a\x69\xca\x8c\x5c\x06\xf6\xae\x71\x14\x28\xb6\x41\xe5\xd3\x11\xd8\xb1\x2c\x8c\x6e\xb2\xb1\x6b\x32\xc1\xb1\x08\x14\xa5\xca\x3c\x37\x02\x52\xb0\x50\x61\xac\x5b\x57\x96\x70\x9c\x9c\x15\x0f\x87\x21\x99\x3b\x34\xf0\x9c\xa2\x82\x2b\x88\xda\x11\x29\xa6\x5d\xd5\x7f\x09\x13\xec\x5a\x68\x3f\xb7\xbd\x0b\x68\xfc\x5a\xdd\xdc\x2a\x9b\xb2\x5e\x9e\x4c\x86\xa4\x66\x52\xb0\x5d\x56\xb7\xa0\x88\xae\x94\x44\x73\x5f\x26\x26\xf2\xa1\x5f\x52\x58\x34\x00\x12\x3f\xdc\xc5\xa4\x46\x25\x3c\xfd\x1e\x92\x4b\xe3\xd2\x80\xe8\xf9\x01\xc2\x1c\xf2\xe3\x41\xa9\xc0\x4e\x45\x4a\xaa\x05\xb1\x46\xf5\x47\x56\xda\xf3\x64\x1f\xb7\xc0\x2d\xb9\x88\x84\x28\xf4\x2b\x58\xa0\xbe\x4e\xf2\xa9\x10\x28\x12\xd9\x54\x27\x14\x28\xde\x1a\x43\xb8\xbd\x36\xad\xb1\xde\xce\xe4\x7d\xb0\xd6\x2d\xaf\xd2\x8b\xa5\x40\x40\xfa\x92\xca\xc9\x67\x93\x37\x58\xda\x73\xd1\x37\x44\x28\x43\x36\xde\x93\x7d\x40\xaf\x11\x3c\xc1\x8b\x62\x6f\xa6\x42\x9f\x6f\xa2\xb0\x28\x81\x94\x65\xf4\x93\x34\x78\x21\x0a\xa0\x1e\x4d\xae\x48\x21\x07\xa0\xd5\xdb\xdd\x9b\x0d\xff\x66\xc3\xff\x56\x74\x64\x5a\x2c\xbf\x26\x15\x59\x43\x5d\x15\x9c\x78\x66\x0e\x58\x2e\x65\xfd\x15\xcc\x5c\x54\x9d\xa6\xe0\xd8\xbb\x29\xae\x07\x53\x0f\xa2\x24\xf4\x36\x3b\x84\x30\x30\xad\x31\xb4\x42\x8e\x87\xf6\x4f\x52\x2f\xf1\x47\x66\xb8\xcd\xbb\xcf\x94\x09\xee\xb1\x01\x7b\xa5\xa0\xb4\x5c\x80\xc6\xbe\x17\x91\xf2\xe5\x6c\xa7\x06\x1b\x90\xde\x7e\xc3\x55\xdb\x4f\x3d\x8f\xd2\xae\x2c\xe7\xdc\xea\x88\x9c\x80\x1a\x84\xf9\x7d\x76\x1d\x2d\x53\x80\x6f\x78\x93\xc4\xfa\xd4\x82\xfb\x27\xee\xc8\x99\x9d\x9b\xa9\x59\xcb\xdb\xbf\xd8\x32\xed\x6d\xb8\xaa\xc1\x80\x6b\x68\x70\xae\x28\x12\x4b\xb6\x6b\xcc\x23\x8f\xd1\x81\x9d\xc1\xa9\x08\xc0\xf2\x40\xd3\x48\x7b\xf2\xf0\x4e\x08\x25\x50\x8d\x46\xa6\xe5\x51\xda\xab\x06\xd2\xa3\x0e\xe8\x25\x5d\x45\xd1\xd1\x3a\xf8\xb2\x49\x80\x2b\x1a\xce\xcd\x31\x9c\xd3\x86\x4a\xbb\xd0\x35\x95\xd2\x13\x91\x4a\xb8\xca\xca\x93\xbd\x39\xae\x5f\x9e\x50\xd8\x2b\xc4\x45\x66\x9f\x6b\xaa\xb4\xf6\x47\xf7\xe7\xad\xc0\x92\x52\x37\x9b\x4e\xda\x5e\x2f\x6a\x55\xa9\x23\x4f\xcd\x61\x10\x60\x54\xa5\x0d\xe6\x95\xb8\x45\xa3\x49\x25\x7e\x73\x7d\x33\xea\xd6\x57\xaf\xa8\x51\x0c\xef\x3a\xe6\x96\x78\xaf\xa3\x56\x96\x9c\x55\x6a\x1b\x35\x8f\xb5\x27\xcf\xd7\x5b\x51\xc7\x4a\xa7\x53\xd4\x53\x39\x5d\xf4\x06\x86\xf4\x58\x0b\x13\x18\xa9\x42\x6b\x3a\xb8\x38\xb5\x59\x8f\x02\x93\xc6\xd4\x56\x45\x6e\xda\x51\x0e\xda\xfe\xa9\x49\x3f\x4e\xc4\x94\xd2\x9d\xf1\x18\x42\x2a\x4d\xc7\xd9\xb9\x80\x97\x26\x82\xf8\x7c\x70\x70\x12\xa7\x13\xf7\xb9\x1a\xd6\xf6\x2c\x9b\xa9\x1e\x05\x7c\x0f\xa8\x03\x6b\x88\x24\xd5\xba\xbc\xd0\xaa\x45\xdb\x45\xee\xbe\xba\xdc\x30\xcf\xda\x4a\x5a\xe4\x06\x0f\xaa\x25\xa6\xb0\x34\xe4\x8b\x01\x9b\x31\x92\x8c\xa7\xe6\x7d\x86\x51\xca\x1f\xa8\x87\x65\xca\x41\xcc\x59\x9c\x92\xba\x1c\x45\xae\x69\x61\x54\xb0\xea\xa6\xcc\x9e\x77\xb9\x14\x74\xc0\x68\xd3\xb1\xa4\x63\x7b\x5e\xbe\x95\xe0\xd3\x64\xab\x7d\x77\x57\xc2\xfe\xde\xbe\xbd\x65\x1f\x9b\x44\xb7\xf2\x22\x9b\x92\xae\x16\xb4\xff\xf1\x08\xba\x72\x77\x95\xe6\x2a\x17\x3b\x53\x7a\x9a\x08\x99\xb6\xe3\x1d\x1d\x09\x01\x3f\x09\xd8\xe0\x61\x91\x7a\xdf\x83\xda\x7f\x93\x95\x9b\x98\x0a\xa2\xc4\x45\xe0\x4c\xe7\x22\x90\x1e\xec\x05\xcf\xa1\x7a\x46\x82\xa7\x4d\x73\xf2\x33\x8e\x81\x35\x63\xc2\xfb\x92\x98\x56\xc6\x00\x08\x1b\x9d\x04\x1f\x81\xc4\x2c\x0e\x1d\x2e\x06\x5a\xa1\xc3\x67\x32\x66\x0e\x45\xf8\x3f\xcf\x1d\xf1\xa5\x5b\x85\xe5\x67\x1d\x2f\x95\x3f\x11\x1f\x6b\x16\xd5\x88\x15\xb8\x67\x9a\x7a\x4d\xa4\x66\x38\x0a\xeb\x06\x38\x3d\xe1\x44\xd5\x55\x84\xd9\x09\x1f\xe1\xd3\x51\x5b\x02\x84\xa9\x2d\x58\x28\xd8\x41\x0c\x61\x24\xeb\xea\x0d\xec\x05\x68\xf7\x08\xe3\x1c\x7d\x2c\xad\xb9\xca\x7b\xfb\x3d\xed\xbd\xbd\xec\x9f\x18\x90\x4d\x71\x42\x80\xba\x85\x2d\x56\x16\x33\xac\x7b\xab\xba\xfe\xcb\x2c\x81\x4b\x2c\x07\x77\x48\x70\x7b\xa3\x43\x44\x6f\x54\xce\x0c\x77\x7c\x26\xef\xf9\xc6\xd9\x31\xa3\x5a\x3c\x56\x28\x36\x7e\xab\xd5\xd9\xaa\xaf\xcb\xdd\x97\xf0\x48\x13\x9c\xea\xad\x46\x1d\xa3\xcd\x4a\x89\x0b\xe0\x38\x51\xde\x8c\x44\xf1\xb1\xd8\x49\xe4\xca\x39\x4b\x64\x84\xbf\x9a\x7f\x61\xd9\xd3\xdd\x00\x6b\x6a\x70\x65\x1d\x6f\xfb\xaf\xc3\x35\x39\x65\x93\x97\x82\xb0\x25\xc0\xee\x98\x27\xa2\x1a\xb1\xb7\xa6\xa7\xf5\xc3\x60\xa4\x5b\x4f\xac\x36\x47\x44\x5d\x58\xd3\x0e\x07\xbd\x18\x5d\x73\x87\xb8\xef\x76\x26\x4a\xc8\x13\x2d\xe3\x94\x62\x21\x89\x85\xa0\xfd\xb8\x93\x04\x82\x1a\x9f\xe3\x58\x50\xc3\x29\x6c\x48\xbd\x20\xbf\x6e\xcc\xbf\x6b\xf9\xf9\xf5\x74\xb1\xba\x87\x17\xa5\xb9\x65\x1c\xc3\x2f\x71\x51\xae\x9d\x6c\x7a\xa0\x60\x57\x0c\x8a\xd0\x9f\xc5\x70\xcc\x3e\xd7\xbe\x34\x1c\x29\xce\x01\xab\x39\x34\xb8\x95\xf2\x03\x03\x9e\xaa\x04\xb0\x75\xb9\x40\x2f\x8a\x12\x75\x7c\xc7\x34\x01\x03\xb7\xea\x47\xa9\x92\xb3\xbe\x23\x4d\x68\xfd\x98\xcc\x09\x09\x7c\x1d\x1b\xd1\x54\x16\x57\xb5\xa8\xb1\x89\x22\x3a\x49\x8d\x69\x72\x7e\xc1\x89\xc1\xd1\x8d\xac\x29\x9b\x82\x78\xdc\x59\xb0\xe8\x7a\x45\x51\x57\x0e\xb6\x24\x0b\xe9\xeb\x44\x29\x8a\x87\x5e\xe9\x90\xa4\x2c\x21\xb4\xb4\x6c\x8e\x7f\x2a\x49\xb7\x65\x26\x1e\x0b\xad\xf0\x67\x59\x67\xc1\xeb\x97\xaa\x2d\xfa\x7b\x55\x03\xfc\x73\xa2\xaa\xe9\x53\x24\xa7\xbf\xb6\xa2\x83\xae\x9e\x7d\xce\xc1\x04\x6b\x45\xbf\x28\xc6\xca\xc2\xaa\xd4\xd8\x4a\x7c\xbc\xd8\x02\x60\x85\xa5\x5b\x76\xdb\x12\x31\xac\x62\x3c\xe3\xce\xf0\x2d\x0e\x12\x32\x7f\x94\x00\xea\x4c\xbc\x6a\x30\xf6\x1a\x4e\xf8\xbf\x90\xed\x08\xda\x7f\x94\x5b\x8c\xf8\x56\x23\x79\x6f\x9a\x4d\x2d\x07\x73\x76\xf7\xc6\x71\x5e\x48\x48\xaf\xea\x70\x77\x88\x90\xda\x50\x10\x9d\x17\xdd\xd2\x2f\x4e\x30\x10\x2d\xa6\xbb\x7d\x32\x28\xac\xe9\x12\x34\x24\x81\x7b\x14\x95\xe4\xa7\x68\xd5\xad\x4d\xce\xb4\xa2\xfd\x6d\xb5\x96\xeb\xb5\x00\xd7\xa2\x12\x64\x68\x72\x98\xa5\x52\xa7\x69\x53\x3b\xeb\xb4\x65\x77\xb9\x37\x8d\xcf\x21\xce\x57\xa8\x7b\xfe\x71\x80\xec\xa7\xe0\x76\xd1\x44\xa4\x9a\x64\x93\x3b\xb2\x12\x8e\x8e\x56\x05\x0b\xb7\xa6\x1e\x7d\xfb\x01\x67\x0c\xab\x60\xdb\x6a\xa9\x74\x71\xc4\xac\x56\x35\x41\x68\xfb\x76\xfb\x01\x55\xb4\x65\x4f\x62\xe9\x8d\x20\x3f\xd1\x5a\x3a\x00\x25\xf7\xc5\xc3\x89\xab\x25\x10\xdb\xe5\x59\x9c\x6b\x81\x72\xc9\xc6\x15\x2d\x6d\xbc\x7a\x65\x47\x18\x63\x98\xe5\xdc\xbf\x9e\x88\xf3\x4b\x08\xe9\xd0\x6b\x01\x50\x76\x13\xc9\xaf\x1c\x43\xf7\x8a\x55\x12\x0f\x88\xa3\xc3\x21\x5d\x7b\x31\xae\x0b\x3d\x59\xaa\x94\x85\x9d\x63\x17\x84\x9e\x15\x7f\x4a\x84\xaf\x52\x09\x71\x94\xce\xe0\x9e\xbf\x4c\x40\x5c\x50\xc6\x2b\xd1\x80\x84\xd4\x1e\xa1\xeb\xd7\x32\x69\x23\x88\x40\x8c\xb4\x49\x03\xaf\x9b\x67\xa7\xb1\xa6\x28\xaf\x45\xf5\x22\x43\x0f\xf3\x34\xad\x93\x27\xa0\x2d\xf5\xe8\xd6\xda\x04\xe1\x7c\xfb\x84\xec\x4a\x31\x49\x7e\x5a\x06\x68\x97\x16\xbc\x5c\xad\x59\x9d\x76\x36\xb0\xa9\xcb\x9a\x2e\x6c\x19\x68\xa4\xeb\x5f\x59\x5a\x59\x71\x76\x60\xeb\x02\xc7\x78\x3c\x66\xea\x4b\xa7\xf2\x36\xed\xcb\x2b\x2b\x96\x2b\xdd\xd2\xb8\xd3\x83\x01\x7a\xc5\xcd\x28\x50\x13\x68\xa1\xca\x65\x33\x5b\x8d\x6d\x8f\x9c\x26\xf1\xc2\xe7\x44\x5c\x1c\xaa\x12\x85\xa2\x8f\x4c\xea\x6a\x2a\x11\xc1\x6d\xa7\xa9\x01\xd8\x1b\x44\x52\x13\x87\x00\xa8\xc2\xb8\xbe\x03\x88\x9c\x2d\x69\x9b\x97\x69\xc2\xc3\x19\x70\xd0\x69\x99\x43\x86\x7c\xa7\xda\x36\xab\x8b\xcb\x50\xc6\x52\xde\x68\x2b\x8b\x13\xe6\x5a\x12\x83\xd5\x60\x88\x30\x65\x54\xa0\xbb\xd4\x55\x05\x8e\x92\x41\x0c\xa1\xb5\xc4\x49\x7c\x28\x9a\x12\x98\x98\xa0\x4d\x52\x3e\xcd\xd2\x31\xdd\x86\x4f\xc4\xa6\x3e\x8a\x07\xda\x37\x76\x83\x93\x78\x93\xd3\xb6\xbb\x4d\xd5\xf3\x43\xdf\xbd\xae\x5e\xd3\x6c\x6d\xfe\x0c\xca\x6c\xe8\x38\xec\x8f\xdd\xe8\xec\x24\x1d\x9c\xa0\xd1\x00\x2c\x6f\x31\x32\xda\xc6\x22\x08\x24\x50\x7f\xf5\x2a\xf9\x40\xcd\xfc\x1a\xe6\x11\x36\x64\xaa\x11\x61\x17\x97\x53\x97\x9a\xcb\x8f\x57\x91\x1d\xcb\xe5\x46\x66\xac\x7c\x29\x39\xa6\x4a\x86\xb1\x5f\x3a\xf4\xc8\x20\xbd\x39\xf3\x0d\x9c\x7a\x82\xc7\xdd\x06\xd7\xe7\x65\xac\xc9\x3b\x0c\x07\x4f\xc1\x25\x2f\x59\x42\xe7\x61\x7f\xf7\x33\x76\xe1\x84\x3f\xff\xf1\x4a\xe8\x30\x1d\x34\x5b\x72\xe8\x76\x4b\x9b\x3f\xdb\xb6\x12\xcb\xfd\xef\xcb\x2c\xa0\xb5\x45\x83\x48\xd8\xf0\x4d\xa2\xe5\xc8\x97\xfb\x1b\x6b\x60\xfa\x74\xef\xc6\xf4\xe9\xc6\xf4\xe9\x9b\xb1\x75\x96\x26\x90\xd7\x60\xec\x5c\xe2\xc6\x52\x1a\x57\xd2\xbb\xac\xdd\x91\xba\x73\xde\x16\xcc\xb4\x5f\xa2\xb9\x61\x32\x9e\x3c\xc1\xca\x4a\x78\xec\x3b\xb5\x9d\x00\xfb\x54\x95\x46\xdc\xc4\x09\xf9\x7c\x37\xd4\x87\xd7\xaf\x1e\x13\x73\xbf\x4a\x07\x28\xde\x12\xb2\xda\x04\x1f\x30\x8a\x7e\x88\x8a\xe5\x3d\x41\xb8\x03\xf2\x39\x3a\x3a\x51\x34\x2d\x9f\x64\x39\xbf\xfd\xf2\x1b\x7f\xbc\xfb\xf2\xe5\xd3\xc7\x7b\x3b\xbb\x2f\xa3\xa7\xaf\x5f\xef\xbe\xee\x0b\x9e\xa8\xd4\xbf\x03\xaa\x92\x4e\xf4\xe2\xa4\xdb\xba\x1d\x41\x7d\xd1\xed\x56\x2f\xdc\x07\xe3\xf1\xa6\xe9\xd8\xf5\x3b\x7b\x52\x22\x14\xb0\x7a\x52\xaa\x2c\xdc\x84\x32\xa4\xed\x47\xae\x51\x30\x1b\x26\x3e\x4b\x13\x04\x1f\x1f\x83\x05\xf5\xad\x5b\xf2\xa5\x21\x6c\xeb\xf2\x77\x8f\x42\xc6\x7a\x29\x3d\x55\xec\x61\x14\x4c\xee\x47\x7a\xba\xc0\x6b\x31\xce\xca\x4c\x77\x29\x10\x66\x55\xf6\x6d\xab\x1c\x07\xb2\x6a\x7b\xb4\x66\x36\xf7\xe8\xba\x9a\x8f\xf7\x34\x6f\x3a\xa5\x7b\x3b\x2f\x9e\x0a\xd6\xd6\x8f\xe4\xa5\x37\x10\x17\x74\x52\x30\xf9\xdb\xe2\x87\xf8\x13\x0f\x4e\x80\x63\xb4\xac\x80\x36\xd2\x8d\xe4\xf7\x37\xbb\xd5\xcd\x6e\xf5\xad\x18\xea\xd2\xab\xcb\xaf\xd5\x4a\xb7\xf9\x63\xf6\x46\x6f\xe8\xaf\xf1\x29\xbb\xf2\x39\x04\xeb\x5f\x1f\xce\x78\x44\xa6\xdc\x3a\x86\xc8\x37\xb6\xd8\x96\x39\x2c\xb8\x46\xc8\x9f\xfb\x1d\xfc\x54\x99\xf2\x32\x45\x3a\xcf\xa7\xd0\x15\x51\xc5\x73\x64\x50\xdf\xd7\x3c\xa1\x67\x99\x93\x6c\x72\x7e\x9a\xcd\x75\x8b\x3a\xa1\xe4\xf4\xa6\x90\x06\xda\x24\xc2\x95\xf8\xa6\xa3\x17\x5a\xcb\xba\xa1\x6d\x64\x9a\x3e\x9e\x81\xe3\xe3\x0b\x0c\xaf\x3a\x44\x17\xe4\xb4\x49\x24\x04\x39\x64\xb3\x83\xef\x43\x92\xa1\xe5\x30\x5d\x9d\xf8\x30\x18\x01\x2c\x4a\x59\xfb\xca\x92\x35\x4d\xdc\xfb\x16\x40\xd8\x8e\xa3\xa8\x5e\x37\x00\x03\x43\xbe\x7f\x25\x92\x06\x44\x85\xb4\x27\x6b\xc2\xfb\x0d\xf9\xbb\xc4\xdc\x35\x5c\xde\xd8\x2b\x97\xde\x10\x73\x6c\x13\x7d\x0e\xc9\x05\x38\x7a\x31\x72\x70\x3d\x34\xde\xc1\x83\xd1\x82\x82\x15\x94\xa3\x8e\x55\x57\xe5\x24\x88\x28\xd1\x77\x50\xde\x36\xf4\xda\x7c\x74\xb8\x42\x03\x43\x2b\xa4\x3f\xf3\x8a\x71\xf1\xa2\xd5\xf4\xb0\xd0\x88\x94\x27\xf5\x4b\x0d\x27\x4f\x8f\x27\xb1\xa8\xd3\x1d\x0e\x4f\x2f\x1b\x0f\x87\x29\x1f\x8f\x86\xaa\x1a\x10\x3a\x30\x68\xde\x7f\xf9\xc2\x41\x91\xb7\xe4\x48\x60\x0d\xa4\x54\x4b\x02\x08\x82\x12\x8f\x52\xf0\xfe\x19\xb4\x7a\xa6\x3a\x42\x36\xa5\x7c\x5d\x3b\x59\xb2\x7a\x0b\x29\x2a\x4f\x20\x65\x76\x5e\x9c\x90\xc6\xfa\xf4\x28\x45\x96\x91\x51\x94\x68\xec\x9b\x0c\xb3\x50\x89\xad\x80\x6b\x10\xd9\x1d\xcf\xb3\x9d\x5e\xdc\xfa\x17\x7b\x04\x78\xe3\x40\xc4\xb8\xeb\xd0\xfe\x39\xea\x3c\x8b\xe8\x3b\x5d\x67\xe7\xf1\xfb\x29\x2b\xa7\x87\xad\xd2\xb7\x20\xf9\xe9\x54\xd4\xde\xee\xf8\x2a\x4d\x39\x4f\x7b\xaa\x63\x57\x65\xe9\x2c\x84\x45\x25\x3f\x47\xc7\xcb\x32\x98\xb6\x2c\x7f\x90\x62\x88\x29\xc7\x18\x40\x02\xf1\xf9\x03\xba\x31\xc9\xa0\x3e\x2c\x79\xe1\x62\x34\x8b\x81\xcb\x7f\x5b\x21\x8c\xf5\x95\x05\x66\xc4\x4c\x67\x34\xef\x2b\x6e\xfb\x25\xf6\xbd\xb7\x31\x6d\xda\xbe\xba\xd1\x7b\xf7\xe6\xbc\xe3\xc6\xd6\x5b\xa8\x36\xec\x7b\x0d\xc5\xd9\x44\x32\xc8\x26\x83\xb8\x68\x5b\xb3\xdf\x29\xf7\x63\x53\xca\xf5\xa4\x13\x9b\x72\xae\xe7\xee\xb6\x49\x19\x87\x1b\xd2\xdd\x83\xcf\xc3\x24\x57\x90\x86\x43\x78\x42\xa0\x5a\x86\xba\x59\x71\xec\x07\x7d\x83\xdd\x8b\xea\x6d\xba\xdc\xf9\xce\x75\x7b\xdf\x89\x67\xc7\xce\xea\x32\xe2\xe3\x43\xab\x64\x9f\x7f\x49\xcf\x3c\x6b\xcc\x13\x8a\x1c\x9f\xbc\x7f\xd1\xf5\xba\x2f\xb5\x68\x32\xa3\x8f\x5a\x4a\xe3\xf5\xad\x77\xb6\x60\x65\xfe\x9e\xa5\x93\xf6\xf2\xb2\x5f\xb9\x7e\x14\x47\xe4\x46\x78\xe2\xd7\x1b\x28\x1b\xb6\x61\x99\x77\xba\xbc\x47\xfc\xaa\x46\x4c\xc6\x8e\xd5\x57\x8d\xc2\x80\xbf\x21\x03\xdc\xb0\x6d\x7c\xb5\x20\x1a\xb7\x5b\xe1\xed\xeb\x8d\x84\x71\x6d\x71\x9a\x16\x6c\xfb\x79\x76\x6c\xd8\xb5\xf4\xc5\xc3\x56\x8b\x72\xfe\x43\x7e\x66\x98\x58\x66\x83\x19\x6e\x8d\x63\x72\xdd\x40\x11\x0c\xdd\x72\x59\xfc\x54\x48\xfd\x73\x31\x7a\x76\x3d\x3a\x18\x74\x23\xe9\x8a\x92\xf3\x13\x91\x7e\x20\x93\x89\x27\x02\x52\xe4\xb7\xa2\xf2\x87\xf6\x94\xf5\xf2\x93\x74\x54\xb4\xe1\x15\xb8\x8b\x51\x95\xe5\x29\xad\x44\x33\x4a\x4b\x45\xc6\xde\x44\xda\xc9\x38\x29\x12\x35\x0e\xe3\x24\xc9\x4e\x27\xaa\xba\x04\xcb\x60\xf7\x57\xf2\x5d\x89\x5c\xda\xb0\xd5\x93\x18\x57\xea\x58\xe1\xba\xe4\x22\xab\xe1\x6a\xe1\x27\xe0\xb8\xc1\x95\x7e\xbe\x76\x8f\x4c\xeb\xf5\x1e\x99\xaa\x1d\x3a\x95\xda\xd8\xd9\x15\xd8\x12\xa0\xe5\xfb\xa1\xce\xed\x93\x27\x50\x7e\x55\xfe\x9f\x4a\x69\x99\x9b\xfe\x37\x78\xd4\xe8\xbc\x6a\x0b\xbe\x68\xac\xa4\xc6\xcf\xe5\x6c\x0a\x40\xed\x93\x6b\x19\x07\x54\xf6\x85\xd8\xd2\x01\x03\x38\xb4\xa8\x37\x04\xc0\xfd\x57\x19\xa2\x08\x82\x1e\x68\x76\x4f\x6d\x1f\x96\x0e\xc0\xb2\x9a\x08\xde\x09\x5b\xb8\x64\x1e\xb1\xaa\xae\x84\xeb\xfc\x64\x5d\xd2\x35\xd6\xfd\x26\x0a\xf8\xab\xfa\xba\x5c\x09\xeb\x26\x5f\x10\x0d\x06\xf4\x7f\xd5\x81\x44\x78\x0c\x11\x88\xae\xb0\xb7\xfb\x64\x17\x1c\x8d\x90\x41\x56\x37\xca\x33\x69\x3a\xa3\xaf\xb8\x8c\x2d\x4e\x4c\x9a\xae\x1e\x94\x4b\x8b\x56\x2e\x16\x27\x58\x52\xc5\xb3\x73\x58\x2c\x18\x01\x3b\x07\x72\x6b\xa1\xbf\x62\xf4\x16\x1d\x81\x37\x6e\x92\xf2\x4e\xc5\x5e\x92\x4e\xc7\x2c\x92\x83\x1d\x3b\x25\xec\xde\x68\xe5\xbb\x28\x68\xcb\x7d\x57\x99\x72\x53\x1d\xb6\x19\x83\x6a\xde\xb2\x21\x35\x8d\x25\x43\xda\x38\xec\x93\x89\x2e\xd5\x53\x38\x0a\x39\xb0\xb9\x05\x9d\x3b\xf0\x61\x0f\x43\xe7\x87\x32\x58\x87\x9d\xfa\xf6\x8d\x76\x3f\xa5\x3f\xa7\x50\x4d\x35\x66\x90\xc1\x7a\x4a\x8f\x50\xa6\x7e\x49\xf0\x9d\x50\x75\x1c\x20\x70\xe4\xa6\x9c\x10\x36\x28\x47\x33\x3d\x69\xd2\x63\x77\x31\x60\xf9\x29\x8b\xe5\x49\xe1\x44\x8a\x09\xf9\xb8\xaa\x3c\x88\x55\x4f\x77\x9a\x54\x8c\xd7\xc0\x04\x86\x5c\x46\x2f\x2c\xc8\xc9\xf9\xc2\xc3\xd6\x05\xaf\x61\xe0\xdc\x6e\x34\xf9\xd0\xb3\xd8\x91\xf9\xd8\x8a\x14\x80\xe2\x42\xf8\x77\x4b\xa5\x6a\x96\x43\x3f\x74\x3a\x63\x34\xea\xa7\x2d\x47\x42\xd0\x0f\xb2\xcc\xf4\xb8\x39\xa1\xc1\x78\x26\x2a\xe1\xcf\x25\x1c\x59\x7c\xa1\x07\x23\xee\x34\x1c\x18\xf3\x0f\x37\x37\xa3\x37\x37\xa3\xff\xe5\x76\x3c\x74\x2d\x2a\x9f\xfc\x7e\x13\xf1\xf5\xae\xd5\x63\x38\x1e\x02\xbe\x03\x43\x0e\x41\xe8\xf0\xce\x57\x86\x3c\xc6\x73\x30\x9e\x05\x30\x6e\xb1\x0a\xe4\x02\x04\x1c\x8f\x85\x70\x24\x4e\x12\xd9\x59\x4e\xe1\xd9\x51\x51\x27\x88\x04\x2a\x52\x82\xff\x8b\x54\x9c\x7b\x2e\x28\x6b\xc9\xbf\xd7\x58\x92\x37\xaa\x45\xe6\x06\x39\x96\x2a\x4b\x7d\xe0\x6c\xdb\x2a\x51\xd0\x43\xc9\x00\xe9\x26\xa3\xa5\x75\xa8\xad\xce\x52\x50\x59\x2a\x45\x24\x52\xcc\x52\x1f\xda\x4a\xbf\x68\x35\x44\x7a\x88\xdd\x11\xaa\x9a\x3b\x58\xfb\xaa\x57\x27\x42\x4a\xbe\xcf\x5f\xb4\x5a\x77\x46\x2a\x88\x92\x6e\x07\x8f\x5c\x20\x6a\x02\xa7\x55\x97\x3f\xae\x25\x6c\xa6\xf5\xfb\xf2\xb0\xd5\x85\x49\x10\x73\x23\x18\x28\x18\x3e\x8b\x35\x17\x03\xc7\x61\x81\x6a\xfe\xf2\x17\xc1\xaa\xfe\x10\xfc\x2a\x8b\x87\xd1\xf0\x7c\x12\x9f\x0a\x84\x66\x93\x24\xc7\xd6\xe4\x84\x98\x86\x64\x30\xeb\x4c\xeb\x09\x10\x4a\xd9\xd7\xab\xc6\x3d\x28\x98\xad\xe3\xa4\xd8\xd5\x87\xe4\x80\x07\x67\x98\x18\x23\xb0\xd6\xb9\x07\xe0\xca\x04\x39\x25\xea\x98\x5c\x7e\xeb\x61\x69\xfa\x4b\xaf\x5e\x28\x3b\x17\xdc\x05\xe2\x95\xb0\x5e\x91\x0e\x22\x4f\xe8\x04\xdd\xf7\x5e\x56\x91\xf0\x0e\x57\x68\xa4\x08\xea\xe2\x05\xde\x80\x56\x07\x1d\x8e\xc5\x72\x08\xa9\x0d\x8c\xd6\xd4\x49\x70\x3c\x51\x36\x28\x62\x69\x3e\x65\x58\xed\x52\xa5\xbc\xa3\xf0\x57\x41\xb8\x1f\xca\xf0\xe4\x50\x95\x5c\x64\xa0\x43\x93\x3f\x25\x98\x2f\x2b\x85\xae\x09\x02\xcf\xeb\xb0\x9b\x7d\xa7\xdb\x5d\xdf\x17\xc9\x0c\xc2\x13\x2b\x28\xf8\xea\x06\xea\xe2\xf5\xf8\xf9\xb1\x8a\xca\xac\x80\x62\x1e\x93\xd9\x6d\x0d\xef\xaf\xfa\x0b\x5d\x6b\xf5\x74\xb9\x4e\xa8\x77\x97\xa9\x51\x96\xb2\xf5\x53\xec\xa0\x43\x14\x08\xf1\x45\xfb\x21\x84\x3b\xa4\xae\x54\x35\xdb\xc3\x21\x3d\x8b\xd0\x4a\x34\xb8\x90\xc8\xe1\xb1\xc4\x7c\x8a\x19\xf2\x34\x82\x2c\x23\x15\x1d\x82\xbd\x23\x7b\x2f\x85\x2d\xe9\xc6\x54\xec\xe5\xec\x69\xc4\xf3\xec\x38\xdf\x2e\xde\x14\x42\xde\x5b\x72\x35\x8d\x79\x32\x1e\xe9\xc4\x91\xff\x7e\x59\xb2\x70\xbb\x16\x2b\x4e\x98\xa8\xc0\xf3\xe1\xa3\x1e\xd9\x89\x62\xa4\xcf\x82\xc2\xce\x4b\x3b\xd4\x2f\x98\x61\xe6\xd8\x3d\x7d\xf2\x74\x78\x06\xb4\xd2\x0b\x31\x0e\xcc\x10\xcd\xb4\x9d\x37\x3f\xd2\x92\xd1\x3b\xe5\x88\x9e\x0d\xc5\x51\x5d\xbe\x12\x85\x3e\x4a\xb4\xa1\xd9\x24\x5e\x74\xab\x44\x65\x76\x84\x9e\x30\x7a\xbd\x5e\xf4\xfb\x9c\x1c\x01\x43\x9b\xc0\x7b\x97\x1a\x3e\x8c\xac\x78\x14\x79\xe1\xbe\x80\x75\x56\xba\x1e\x46\xf8\x8c\x49\x99\xc1\x83\x29\x19\x72\xd6\x3d\xd3\xa4\xc7\x3b\xb6\xd9\x67\x43\x4f\x07\x9d\xcf\x4e\xba\xd3\x6c\x3c\x26\xf2\x09\x93\xad\xa4\x4d\x03\xe6\xd2\xa5\x56\x89\xa0\xda\x76\xf2\x42\x9b\xe1\x5a\xc4\x92\x95\x90\x8b\x9c\xd1\x6c\x56\x72\xb3\x8a\xa4\xa7\xc6\x1a\x9a\x84\xd0\xb3\xa9\x10\x4d\x64\x8d\x4f\xf2\x4d\xa9\xe3\x72\x94\x61\xcc\x94\x71\x68\x46\x79\xfd\xd0\x49\x30\x95\xf4\x55\x21\x9f\x74\xae\x84\x9e\xab\x11\x69\xa9\x3e\x00\xfb\xe4\x3a\xa3\x06\xc6\xf3\x4a\xd0\x10\xf0\x19\xd3\x13\xa2\xc1\x3e\x15\x81\x73\x5a\x02\x97\x0b\x78\xe4\xec\x01\xc5\xe1\xd0\xcc\x5e\x32\x95\x86\xf6\x07\x84\x29\x24\xc7\x9d\xe1\x61\x57\x5d\x51\x59\xc9\x5d\x43\x8c\x32\x87\xbb\x31\x22\x05\x31\xd2\x2f\x6c\xd6\x2a\x6a\xa1\x47\xe2\x8e\x4c\xd6\x8d\xbc\xf0\x1e\x6a\xb9\x05\x6e\x07\xba\xf2\x34\xf5\x18\x6e\xb4\x44\x25\x68\xaf\xfd\x38\xf0\xd0\x11\x99\x1d\x69\xb0\xd9\x85\xab\x85\x0f\xa6\xe1\x5b\x44\x55\x56\xaa\xeb\x2a\x55\xf6\x84\x95\x6a\x76\x67\x98\x2d\x41\xb9\xc7\xf8\x52\x6b\x4c\x23\x6c\x1a\x31\xd8\x11\x7d\x19\xb4\x87\x06\x3b\x81\x41\xaa\x99\xf7\x9f\x8c\xe9\x15\x22\x2d\x6b\x74\x86\xdc\x72\x1f\x97\xe5\x1b\xb6\xe7\x65\xf1\x6b\x27\xfd\xbb\xb4\xdf\xf0\xb0\x5a\xde\x3a\x71\x1c\x70\x5f\x61\xc4\x24\xd3\xa1\xe5\x1a\x2f\xcc\x1c\x6b\x96\x7d\x7b\x70\x14\x10\x73\x07\x47\xb5\x7c\x50\x71\x64\x79\x2e\xac\xa0\x5e\xc7\x21\xa9\xcf\x5e\x2a\xca\x86\xbb\x51\xad\x77\xb6\x96\x36\x10\xc1\xd0\xf7\x03\xc8\x5d\x35\xcc\x12\xbc\x64\x80\x33\xc7\x54\x08\x21\x68\xe0\xa6\x27\x19\x1c\x19\xe1\x7b\x57\xa0\x50\xed\x0b\xc6\x54\x24\x86\x0e\xc0\x50\x08\xa4\x9e\x0e\x29\x57\x74\xeb\xf3\x49\x9c\x83\xcd\x14\x5c\x5c\x78\x7d\x74\x29\x2a\xc4\x93\x43\xb3\xa3\x65\x2c\x91\x5b\x3d\x41\xc1\x66\xb8\xc9\x18\x1b\x89\xb1\x27\x86\x10\x47\x46\x3a\x8c\xc7\x62\x62\x86\xe7\xe6\xbd\xba\x11\x14\xf3\xab\x53\x9a\x2d\xc8\x2a\xe9\xf5\xa8\x96\xf0\xda\x4e\x6b\xda\x07\xdc\xaa\xef\x91\xcb\xac\x4c\xe2\xe2\x21\xb7\x90\x20\x45\x0b\x29\x88\xc6\x96\x9e\x9e\x26\xc3\x54\x48\x43\xe3\x73\xb7\x59\x49\xee\x83\xa6\xb4\x6d\x4b\x27\x58\x7d\xbb\xc4\xd3\x44\xc8\x6b\x95\x6b\x21\x4d\x0a\x0d\x1a\x0c\xbb\xcb\x3d\xf3\xa2\x74\xb8\x9b\xb9\xcd\xdb\xc8\xb0\x8f\xf5\x43\xa9\x63\x2c\xe6\xc8\x47\xe3\xcc\x93\xbc\x2e\xf5\x07\xc2\x70\xcd\xee\x84\x2f\x9a\x0e\x84\xef\xbb\xf5\xe3\xf1\x24\x87\x6c\xaa\x04\x07\x7b\x20\x35\xfc\x1d\x9f\x96\x9f\x0a\xc9\x57\x2d\x80\x38\x3f\x9f\x0c\x2a\x9f\xdb\x87\xf8\xf6\x7c\x82\x6f\xa7\x2d\x04\x30\x19\xc3\xc1\x96\xc7\xbb\xb8\x21\xfc\x22\x35\x5b\x82\xbf\x87\xd1\x63\x27\x64\xfb\xa0\x89\x4c\x11\x34\x39\xd1\x25\x5d\xa1\xdc\xd9\x51\x4b\xec\x28\x05\x92\x77\x46\x86\x33\xa6\xb9\x7e\xd7\x77\x9e\x48\xf7\x2b\xa0\xc1\x37\x5e\xba\x98\xeb\x9d\x93\x04\x8d\x31\xe4\xe8\x3b\x11\x31\xd5\x5c\xc0\xf7\x7c\xb7\x3a\x1e\x5b\x75\x89\xbd\x72\xf7\x08\xa1\x5f\xd6\xe8\x4e\x28\xdf\x8f\x31\x42\x4a\xe7\x8b\xfa\xe7\x71\xe4\x71\xd1\xbe\x63\xbd\x27\xd8\x78\xa7\xc2\x9b\x21\x67\x4c\x15\x67\x0b\xac\xa5\x0f\x7b\x42\x89\x3b\xbe\xce\x62\x1e\x73\xc2\xc4\xdf\x9c\x8e\x3c\x37\x53\x52\x10\xdf\x79\x42\x09\x7d\x49\x63\xed\x4e\x8f\x76\xe4\x41\xac\x1c\x16\xa2\x5b\x15\x78\xe3\x22\x56\xb7\xa8\x65\x92\xcd\x73\xed\xbd\x50\x1a\x06\xc0\x6e\xef\x7a\x22\xa2\x6a\xa4\xb0\xdb\x0a\x9a\xd7\xa2\x53\x89\xcc\x58\xe9\x35\x21\x20\xdf\x86\x0e\x6a\xa8\x9f\xc3\x2b\xcc\xdb\x45\x0d\x3f\xf6\xae\x48\x09\xb7\x5e\xec\xb7\x8a\x0b\xd2\x8b\xc3\xee\xc6\x6a\x93\x2b\xd0\x16\xf8\x4f\x01\x8d\xf7\xa0\x68\x2d\xdd\x5c\x88\xde\x5c\x88\xfe\x57\x3c\x15\x65\x2a\xeb\xcb\xbc\x17\x95\xc0\x0b\xdc\x64\x86\x62\xbf\x35\x7e\x62\x3a\x19\xa5\xc7\x41\x38\xca\x52\x80\x3b\x47\xb1\x13\xd3\x05\x02\xbd\x07\xe2\xb4\xa0\x36\x99\x02\x4d\x91\x8d\x34\x5d\x66\x1e\x89\x56\xc8\x83\x81\x63\xc5\x48\x40\x8f\xd2\x63\x47\xa9\xcf\xad\x19\x49\xe3\xfc\x89\x20\x3e\x69\xd8\x0b\xdb\x69\x95\x49\xe7\x96\xb8\xa8\x67\x2c\x69\xc3\x92\x8a\xa8\x77\xc1\x67\x68\x45\xa6\xcb\x2a\xb0\x2d\xad\x04\x4a\xf3\x57\x82\xdb\xd0\x35\x28\xbb\x9d\xb0\xea\x3e\x32\xe9\x4e\x03\x47\xbc\x04\x1c\x24\xa4\xf7\x7b\xb8\xbe\xf2\xf2\xe4\xd9\x34\x9c\x99\xc4\x33\xb1\x4e\xcb\x8a\xe2\x33\xb1\xf4\x8f\x64\xd8\x62\x3d\x87\x0b\x95\x27\xc9\x28\x9e\x8f\x83\xf7\x2a\x75\x43\x80\x0d\xdb\x6d\x48\xdf\x08\x07\x83\xbb\x00\x45\xf5\x86\xac\xc5\x80\x6b\xaa\xf2\x6b\x1e\xde\x05\x67\x14\x5f\xa2\xfb\xee\x83\x2f\x92\x5f\xa0\x96\x12\x12\xb0\x1a\x0d\x54\xc8\xb2\x03\x78\x50\xa4\xf7\x4b\xf2\x21\x30\x72\xb9\xe8\x68\xb0\x39\xb3\x99\x04\x07\x25\x96\xe7\x40\xd0\xf8\x0f\xb4\x99\x81\x36\x1b\x80\x65\xf9\xcb\xd3\x7f\x38\xab\x11\xeb\x00\xb1\x39\xb8\x0e\x95\xaa\xdf\xf2\xc0\xeb\xd9\xc5\xab\x1b\x74\x75\x19\xe0\xd7\x19\x8c\x0b\xfe\xd1\xba\x5b\xc6\xdb\x41\x76\x43\x8b\x9f\x82\xb0\x6d\x1a\xda\x1e\x60\x54\x06\xe6\x17\x8d\xc3\x07\x1c\x72\xa9\x6a\xb1\x4f\xd2\x4b\x55\xf8\x66\xd2\x16\x93\x78\xd5\x45\x26\xc3\x49\x8b\xbd\xf5\x34\x3d\x3e\x21\xb9\x52\xfb\x36\x96\x3a\xac\x25\xff\xd2\xb9\xb6\x5d\x51\xb3\xd5\xea\x41\xeb\x38\x16\xa4\x25\xf6\x1a\x78\xae\x28\x7e\xc3\x1f\x9c\x3e\xf8\x31\xc9\x26\x22\x23\xf0\x78\xf1\x5d\x72\x5e\xf1\x7c\x51\xe4\x36\x7d\xc0\x88\x35\x79\x38\xa4\x1a\x1e\x30\x73\x8b\x27\xc9\x20\x3d\x8d\xc7\x6d\x0e\xe0\x3f\x20\xb3\xef\x58\x3f\x37\x11\x33\x4f\x9b\xd7\x4d\xcb\xa1\xaa\xaf\x9f\xa4\x2f\x4b\xb5\x37\xf4\xfa\x25\xe9\x55\xca\x38\x1e\xc1\xe2\x75\xaa\x0a\x0d\x24\xa9\x35\x28\xf9\x34\xa6\xd3\x0f\xb6\xb4\x23\xd3\x97\x2c\x11\xa7\x96\x32\x8b\x0f\x9d\x8f\x5a\xd1\xf7\xa1\xc7\xb7\xbf\x5b\xea\xb0\x6d\x14\x5c\x36\x80\x76\x78\x91\x29\xfc\xd9\x00\xfa\x49\x07\xa4\x33\x5c\xe0\xe3\xb1\x70\xf5\x1e\x54\xb0\x0d\x17\x4a\xe9\xf2\x3e\xf4\x90\xa4\xc2\x85\x30\xcb\x40\x42\x38\x82\x20\x9c\xc8\xb0\xa0\x90\x7c\x19\xa8\x91\xfc\x58\xbe\x29\xa1\x6c\xdb\x82\xe0\xfc\x25\xad\x3a\x68\x7f\x58\x98\x94\x54\x10\x9a\xcb\x90\x94\x8c\x87\x53\x49\x59\x3a\x3c\xd3\x22\xe4\xe5\x56\xec\x78\x65\xe1\x71\x75\x4c\x1c\x9d\x1a\x7a\x0b\x41\xf9\x73\x16\x80\x32\x94\xa7\x32\x1b\x90\x5f\x29\x68\x79\x93\x25\x84\xa8\xc3\xbd\xcc\x4f\xe7\x42\x7c\x17\x27\xd1\x9f\xe3\x7c\x3f\xc7\x67\x6b\x65\x55\x79\xb0\x4e\x5d\xc7\xb5\x35\x1c\xeb\x72\x4b\x41\x4b\x04\x05\x07\x1a\x25\x47\xfa\x34\x19\x2c\x58\x8d\xa7\x79\x42\xf5\x51\x50\xef\x14\x78\xfc\x0a\xb0\x75\x4a\x26\xd9\x52\xa3\x05\x80\xb3\xdb\x9c\xe4\x51\xea\xae\xa4\x72\xac\xb0\x01\x8d\xdb\x35\x71\xf3\x15\xac\x41\xdb\xaf\x80\xcb\x60\xe5\xf9\x07\x5d\xe0\xc9\xc3\x2d\x28\x57\x00\x10\xe6\xe7\x79\x7a\x9a\x16\x81\x29\xb4\x01\x24\xae\x74\x62\xc9\xbc\x5b\xf9\x56\x99\x5c\x9c\xeb\x4a\x0b\x40\xa6\x05\x0d\xbe\xde\xf2\x22\x3e\x9d\x96\x16\xd1\x10\x66\x5d\x51\xc6\xa4\x6c\xe5\x5a\xd9\x65\xd5\xea\x93\x33\xeb\xcc\x30\x1d\x8d\xd2\x81\x10\x7b\xce\x83\x3c\xd4\x05\xb2\x07\x92\x15\xf1\xf8\x49\x93\x0a\x1c\x48\x2e\x24\xd9\x6b\x46\x82\x9b\x65\x6e\xaf\x1c\x3f\xdb\x17\x41\xd2\x22\x39\xed\xb8\xef\xb6\x3c\xe3\x39\x84\xea\x94\x5d\x14\x96\x6f\xe6\x54\xb0\x6e\xa1\x1d\xd1\xd1\xba\xc1\x32\x13\xcb\x36\xb8\x9e\xf8\xda\x0e\xad\x26\x51\xce\x28\x59\xfc\x25\x85\xf5\x5a\xcb\x8a\x57\xc8\x17\x15\x53\x6e\x0b\x34\x89\x2f\x8b\xaf\xeb\x71\x3a\x19\x01\x1a\x73\x8b\x6e\xf1\x3a\x3d\xc6\x6d\x55\xdc\x60\x07\x08\x56\x62\x35\x21\xfe\x0b\x54\xad\x52\x4b\xaa\xd4\x85\x6c\xa1\x13\x15\xf6\xf5\x07\xa4\xb3\x93\x34\x07\xbe\x38\x05\xaf\x9b\x8b\x9f\x90\x5e\x89\x62\xcd\x8f\x46\xe5\x93\x6d\x9d\x8d\xac\x4a\xf9\x44\x43\x27\x39\xf3\x84\x6f\x08\x25\x82\xd6\xf3\x0f\x2c\x6d\x07\xcf\x92\x48\xc6\xa4\xa2\x18\x07\xc5\x78\x95\xc9\x61\xe1\xdd\xe7\x5e\x06\xfe\x43\x93\xf2\x32\x0c\x88\x97\x9d\xce\xd2\x6c\x96\x32\x8e\xe2\x15\x54\x10\xcc\x7f\xfc\x88\x87\x17\xb2\xec\x65\x89\xb5\x50\x27\xc9\x99\x06\xe7\x29\x2c\x5d\xb4\xc7\xbf\x1e\x46\x07\xec\xf3\x30\xea\xeb\xcb\xea\x0b\xd3\x2a\x69\x5a\x49\xe9\x0a\x4f\x43\xf0\xf1\x80\x3a\xc6\x56\x55\x5f\x6d\xec\x4e\x01\xf2\x80\x98\xa2\x6c\x32\x3e\x27\xaf\xff\x85\x65\x83\xaf\xec\xe0\xc9\xde\x3d\xf4\x7c\x43\x19\xc3\x8b\x9e\x3b\x4f\x33\xb8\x19\xbc\x7b\xe2\x82\x3e\x36\xe2\x5d\x5a\xeb\x8f\xf4\x2f\x6d\x11\x83\xdc\xac\x8e\xd2\xc3\x64\x6d\x6b\x8f\xab\x09\x5b\xd2\x35\xe2\x37\xf9\x30\x4d\x67\xe7\x81\x15\xcf\x72\x39\xb9\xe5\xe4\x24\x24\x08\x0d\x79\x65\x4b\xc0\x01\x0d\x2c\x00\xa4\xec\x61\x59\x01\x03\xd1\xd9\x0a\xad\xca\xd7\xf1\x99\x22\x19\x99\xb2\x55\xb3\x78\xb7\xf3\x41\x9a\xba\xcb\x57\x95\xe1\xbb\xed\xdf\x72\x89\x38\x0d\x67\xc2\x6c\x98\x55\x61\x1a\x40\xaf\xb9\x18\xea\x2a\xc4\x1c\x44\x99\x05\x56\x04\xae\x4d\xbe\x1a\x4b\x17\xa3\x59\x6e\x57\x58\x49\x8e\x36\x97\x50\xd4\x8c\xfe\x35\x53\x75\x15\x30\xa1\x98\x18\xdc\x98\x23\xa5\xcb\x1a\xb8\x20\x87\x7b\x9b\xb6\x1d\x31\x19\x35\xd6\x22\x59\xcc\xc3\xaf\xe0\xaa\xb8\x8d\x11\x0d\x64\xca\x93\x74\x06\x06\x9c\x21\x75\xb5\x74\x1c\x85\x80\xb2\xc6\x76\xc7\xd3\x85\x73\x41\xc7\x04\x9a\x51\x3d\x08\x54\x6b\xf9\x26\x0b\x54\xd4\xa0\x0a\xd9\x33\xbb\xa6\xa5\xa0\xa5\x80\xf4\x1e\xad\x60\x5b\x2a\xf2\xb3\x59\xe8\x6f\xce\x27\x03\x41\x33\xc1\xb5\x2e\xdd\x35\xb3\x0b\x84\x5b\x76\x52\xc0\x7b\x8d\x25\x9e\xa1\xaf\x1b\x34\x35\x13\x4d\xe0\x51\x23\x78\xc2\xf3\xc1\x6c\x8f\x41\xd2\x79\x4f\x4d\x05\x1c\xca\x2e\x7f\x92\x1e\x9f\x08\x11\xbf\xa6\x3c\x87\x62\xb4\x23\x73\xdf\x4d\xb2\xb3\xc9\x9b\x02\xae\xab\x02\xde\xe3\x58\x6e\x79\x03\xbc\x8a\x2d\xb7\x86\xe9\x1c\x1e\xe6\xd5\x55\xc1\xa1\x4a\x0e\x9d\xc6\x89\x50\x89\x9f\xf8\xba\x7b\xb0\x7e\x2d\x44\xd7\xd4\x53\x51\x41\x4d\x49\xeb\x8a\xa4\x1f\x48\x63\xb0\xa1\xb3\x41\xbf\x3c\x8b\x95\x74\x79\x49\x3f\x9c\xcc\x4a\x58\x3b\x5f\x3f\x90\x46\xb0\x65\x97\xac\xfd\xd2\x1c\x5e\x2e\x3c\xa0\xf2\xbc\x92\xb2\xae\x5a\xaa\x5f\x0f\x62\xf5\xde\x3a\xfc\xf7\x83\xa9\x1c\x9e\x9f\x6a\xfa\x81\x34\x0e\xeb\xa0\x31\x90\xc8\xa1\x5d\x2e\xd4\x2f\x49\x27\xee\x65\x59\xe6\xd0\x7d\xc2\x72\x7f\xed\x7e\x99\xbf\x19\x60\xe1\xcb\xfd\x0d\x70\x22\xb3\xb1\x76\xe3\xab\xe0\xc6\x34\xe7\x9b\x31\xcd\x91\x94\x7e\x1d\x41\x47\x16\xf3\xd0\xde\xd0\x1e\x87\x62\xa2\xd8\x86\x36\x94\xf6\x19\x5c\xbd\x37\x77\xce\x2e\x76\xa1\x15\x27\x7c\x21\xbe\xba\x74\x83\x9f\xf8\x2e\xdb\x95\xe9\xb0\x1f\xe7\xa9\xc2\x55\x7b\x28\xd0\xd3\x5b\xda\xdc\xa4\x2b\x71\x1e\xe1\x74\x71\x37\xdf\xa6\x52\xb9\x3b\xf0\x5a\x29\xe9\x6a\xd5\x62\x74\xb4\x18\x8f\x75\x58\xa7\xfa\xb6\x9d\xcc\x53\x04\x54\x09\x22\x3f\xaf\xdd\xcd\x3c\xec\x0f\xee\x64\x58\x0e\x09\xf1\x1a\xd8\x3c\xd4\xe1\x27\x0b\x88\x3a\xc6\x64\xdd\x4b\xc4\xf5\x55\x32\xbe\x09\x82\x86\xaf\xfd\x91\xd7\xd3\xd3\x12\xf0\x6b\x89\xbe\xe4\x6f\x31\x13\xf6\xfa\x0e\x73\x61\xac\xb2\xd3\xbc\x7b\x3f\x79\xa4\xa4\x72\x78\x48\x37\xf9\x86\xcd\x1f\x5c\x78\x6c\xee\x0b\xf5\x0a\x31\xb1\x6d\xf0\xd0\x10\x11\x55\x81\xdb\x78\xa8\xd9\x50\x74\xa2\x14\xa2\xf4\xcd\x66\xbe\xe3\x40\x3c\x01\x09\x66\x06\x81\x90\x42\xb1\xdc\x4c\x30\x69\x91\x87\x27\xa8\x3c\xfa\x88\xe7\xa7\x0b\x68\x4f\xec\x5b\x64\xb9\x6f\xf9\x16\x0c\x4c\x15\x6f\xa7\x74\x8e\xcc\x21\x97\xa0\x10\x45\x9e\x89\x40\x6c\x10\x0f\x67\x7c\x32\xf7\x46\x85\x90\xe5\xb4\x03\x37\x4f\x74\x15\x67\x62\x34\xc1\x56\x1b\xe3\x03\x2f\x31\x3a\xf5\x5c\x49\xce\xdd\xad\xb6\x17\xe2\x2f\xee\x68\xc7\xd3\x70\x2e\xf6\x54\xf4\xce\x8d\x81\x04\x27\x27\xb2\x95\xf0\xe6\x52\xc2\x2a\xe3\x14\x70\xde\x4a\xe2\x59\x5d\x61\x47\x11\x22\xdc\xbf\x94\x10\xb2\xfd\x3e\x05\xce\xbe\x32\xca\x56\x45\x2c\xac\xb6\xf7\x6c\xbc\xca\xd1\xaa\x52\xca\xa0\x49\x0a\x27\x15\x2b\x54\x40\xf6\x8a\xc0\x02\xe4\xe5\x6d\x1a\xc6\xc1\x72\x89\xd3\x55\x21\x1c\xa8\x56\x8d\x85\x4e\x25\xe5\xe9\xf6\x2b\xc9\xce\x0a\x2e\xb9\x38\xc3\x58\x94\x5f\xd8\x31\x21\x4b\x82\x4e\x5e\x18\x6e\xce\x97\x4f\x3b\x10\x01\xb2\xc8\xc2\xfe\xdd\xad\x08\x91\x0f\xa2\x12\xdf\xed\x21\x17\xe0\xf2\x75\x02\x1b\xae\x15\x7a\xb2\xc2\x24\xa6\x24\x36\x89\x42\xfd\xe5\x62\x30\x04\x8b\x57\xce\xfb\xa5\x22\x31\x48\x27\xd2\xab\xdd\xe8\xbe\xd2\x06\x55\x34\x31\x9f\x4c\xc5\x3e\xb9\x4b\x7a\x68\xcb\xe8\x0b\x93\x2c\xdd\x90\x9d\x64\xba\x60\x3b\xd3\x51\x55\xd1\x0f\x4d\x7a\x0f\xa2\xf5\xe8\xa1\x4a\x54\x7e\xae\x23\x75\x0e\x0c\x84\x39\x28\x73\x73\xcd\x85\x9c\xae\x2c\x6e\xcf\xa8\xd4\xa5\x70\x27\xbd\x3a\xc2\x19\x44\x86\xef\x87\x5c\x31\x3f\xc6\x00\xbf\x31\x8b\xa9\xac\x90\xe5\x46\x6d\x16\x80\x7c\x71\xf7\x7a\x3d\xb5\xbe\x1f\xbb\x65\x9d\xcd\xc7\x73\x82\xb2\x43\xdb\x1d\x06\x6f\x55\xa0\xb0\x1b\xc5\xba\x86\xae\x1d\x9c\x5d\x25\x93\xc7\x2f\x7c\x61\xa6\x0e\x5d\xb1\xf5\x06\x08\xee\xcf\x2c\x4f\x19\x0a\x8c\xa2\x1b\xd3\xc9\x08\xea\xa0\xd0\x70\x00\x2e\xd1\x16\xa4\x5d\x39\xab\x18\x9d\xb5\x8e\x6a\xb1\x57\x65\x11\x70\x17\x09\x6f\x1b\xde\x37\x95\x0c\xe6\x18\xd1\xe9\x3d\x06\x0f\x32\x46\xfe\x93\x8e\x71\x2d\xb1\x90\xb3\x1f\x74\xee\x6b\x4b\x5f\xbc\x08\x17\x7f\xdc\x62\x46\xa1\x4f\x0e\x79\x15\x97\x56\x70\x4b\xcc\x27\x70\xe0\xc1\xa5\xd1\xd1\x6a\xc6\x07\x71\xd5\x11\x09\xb2\xea\xae\xd5\x35\xff\x79\x15\x96\xe2\x4b\xb8\x6d\xad\x07\xe6\x52\xda\x7b\x10\xd9\xa4\xc1\xae\xef\xc6\xc4\xe7\x01\xcc\x89\x89\x32\x8c\xb7\x9e\xba\x77\xc9\xb8\x62\xcb\x76\x1d\x4b\x9d\x4e\x86\x9e\x9b\xec\x62\x76\xee\xbc\x0e\x63\xa0\xf8\x20\xac\x7c\xbc\xf6\x0b\xb6\x01\x3e\x21\x6e\x7b\x8e\x48\x88\xe2\xc5\x81\x2c\xe8\x02\xc5\xed\xbc\x6a\xbd\xd3\x30\xac\x52\x93\x73\xa5\xbb\x6d\xd8\x45\x6a\x77\x05\xa7\x85\xff\xa8\xa5\x56\xbb\x66\x14\x49\x49\x40\x69\x91\xf8\x53\xb4\x8a\x87\x1a\xeb\xac\xe9\x4b\x87\x3c\x2e\x64\x3c\xa1\xd7\xb8\x93\xa1\xf4\xd8\x87\x81\x3d\x27\x77\xd4\x41\xd5\x8b\x70\x5a\xb3\x5c\xad\xa8\x5e\xb0\x6e\x9c\x79\x68\xdb\xb7\x78\xb2\xba\x5a\xf0\xe6\xee\xd4\x93\xbc\x48\xc5\xc1\x0b\x2c\xec\xe0\x64\x54\x47\x55\x0c\xbc\x8e\xa2\x78\xcd\xd7\x41\x4d\x9f\x9f\x3a\x9a\xcd\x10\x1b\x57\xdd\xec\x04\x40\xcb\x66\xe6\xb5\x6a\x26\x51\x01\xa2\x28\xa4\xbc\xd4\x05\x4a\xf9\x20\x1c\x3c\xde\xdf\x59\xdd\x6a\xea\xe6\xaa\x2e\xc4\xfc\xe5\xe6\xa9\x11\xe2\xd5\x8d\xae\x5c\x99\x15\xf1\xd8\x83\x52\xf3\x15\xc2\xcc\xf1\xa2\x0a\xcf\x8c\xec\x2b\x11\xf6\x65\x63\xce\xe9\xfa\x2f\x15\x76\x4e\x17\x5a\x74\x90\x9f\x33\x06\x9d\xd1\xd1\xc0\x00\x67\xd3\x81\x72\x6e\x94\xd3\xa9\xb9\x8e\x11\x49\xe8\x72\xab\x94\x8a\x71\xc9\xb2\x5f\x37\x57\xb2\x63\x71\x28\x30\x2e\xa6\x58\x21\x25\x96\x82\x4e\x31\x95\x37\x30\x47\xe3\xfa\x10\x5d\x8c\xbe\x85\x1e\xb7\x20\x1e\x33\x7c\x38\x3b\x49\xab\xeb\x09\x2f\x7d\xe3\x85\xcb\xf7\x1f\x26\x87\x73\x68\xb2\xa8\xe3\xca\x3b\xa5\xf2\x67\x56\x43\x0c\x2a\x78\x46\xd9\xf6\xa3\xc3\xd2\x54\x6f\x3d\x81\x08\x32\x7c\x82\x0b\x4b\xd0\xb9\x65\x63\xc7\x58\xe3\xe0\x36\x5f\x70\x19\x4a\x79\x60\x30\x69\x65\x5b\x85\x83\xce\x5e\x3c\x9d\x8e\xcf\xa5\x3f\x98\x46\x84\xd5\x71\xcd\xd8\x68\x0b\x70\x9a\x81\xc4\x4b\xd5\x5d\x33\x0f\x32\xca\x8a\x61\x3c\x26\xd0\xca\x95\x23\xac\x04\x26\xec\x73\x05\x59\x51\xe9\x66\xc5\x73\x87\x38\xa5\xe0\xf2\xb0\x69\x30\x5c\x06\xe8\x4b\xcd\xc1\xc9\x2f\x2b\x6e\x8b\x48\x34\x12\x93\x54\x5a\xcc\xec\xd6\xca\x91\x0b\x7c\xfe\x47\x46\x98\x51\x65\x91\xc0\xd3\xd9\x60\x3e\x8e\x67\xb7\xc4\xbf\xea\xb8\x32\x8a\x82\xb6\xae\x25\xb2\x0c\x69\x7f\xc5\xd7\xbd\xb0\x9b\x8e\xf5\x9b\xdb\xff\x9b\xdb\xff\x6f\x22\x52\x01\xc0\xaa\xc8\x3f\xe1\x78\x05\x7f\x5a\x24\x82\x90\x65\x41\xb5\x21\x00\x44\x40\x02\xf3\xdd\x78\x06\xa4\x0c\x3b\x18\x78\xd2\xe1\x43\x04\x82\x83\x77\x33\x09\x58\x00\x8a\xf3\xd2\xfb\x1c\x0b\x1d\xc1\x65\x5e\x32\x5b\x62\x6e\x1b\xcf\x04\x6b\xcd\xce\x50\x63\xc1\xfc\xf9\x83\xf2\x80\x72\x7a\xff\x78\xf1\xfc\x17\x51\xb3\xf4\x08\x4a\x5c\xd3\x4e\x83\xc0\x3e\x21\x58\x64\x7d\xd2\x1d\x7d\x7a\x3c\xc9\x80\x11\x8c\x45\xf5\xd0\x93\x09\x04\xee\x64\x3e\xa0\xbc\x1a\xf5\xc0\x3f\x9c\x8e\x61\x64\x72\x63\x6b\x75\x9a\x36\x72\x41\x98\xfc\xc7\x2f\xaf\xd7\xad\xea\x4e\x66\xeb\xad\x4e\x69\x29\x25\x39\x40\x0b\xaf\x14\x32\xf3\xa0\xd6\x0a\x45\x7b\x74\x82\x48\x2e\x93\xa1\x97\xda\x00\xc2\x2a\xcf\xb7\xfc\x93\x2c\x87\xe0\x02\xe9\x69\x22\x56\x73\x17\x2a\x9c\x75\x51\xc9\x2c\x26\x54\xba\x9d\xc0\xcd\x04\xe0\x44\x41\xfc\x03\x91\x42\x25\xb1\x8f\x33\xd1\x22\x24\xf6\xef\xdf\xdd\xbc\x8b\xe1\x43\x69\xef\xa1\x0a\x61\x27\x94\xbf\x44\xa1\x55\x9d\x0d\xcd\x80\x2d\x41\x4e\xbe\x26\xc8\x5d\xa9\x6c\x14\xcc\x99\xe5\xcf\x5a\xe1\x49\x4c\xc3\x14\xe3\x71\x25\x67\xce\x94\x39\xb2\x93\x04\x7c\xcd\xce\x32\x2c\xfa\x7c\x12\x4f\x2e\xf0\x8e\x95\xc2\xcf\xd3\x9c\x1b\x2c\x4b\xe7\x7b\xec\xc0\x67\xb7\x65\x79\x78\xe1\x98\x66\x72\x97\xdd\x01\xfb\x22\x10\xaa\x27\x54\xd3\x0d\x0a\x11\x12\xf0\x30\xbc\x01\x34\xd9\x51\x64\xc4\x2b\x1c\xb2\x20\x26\x13\xbd\x93\x24\x2d\x86\x79\x36\x11\x00\x63\xb9\x38\x73\x2a\xb2\xc6\x43\x55\xa2\x3c\xac\x6a\x15\x1b\xc5\xa4\xdd\x12\xcc\x79\x4f\x05\x1c\x24\xc2\xa1\xce\x6d\x2d\x31\x7f\x69\x38\xb7\x62\xbd\x5a\x93\x6a\x1d\xfa\xe6\x68\x50\xd3\x7a\x14\xe7\x82\xc7\xc2\x6d\x20\x74\xe1\xd1\x1c\xd8\x03\xab\xe2\x76\xd4\xea\xeb\xab\x42\x5d\x8f\x38\xbe\xc9\x47\x6b\xad\xa3\x38\x4f\xee\x6d\xb6\x9c\xf1\x1b\x6f\xc1\xbf\x24\xb1\x98\x83\x76\x6b\x1b\xf9\x6a\xfa\x47\x4c\xa7\x2d\x6c\x9f\x46\x58\x51\x08\xe4\x63\xc1\xab\xee\xc0\x41\x1b\xdc\x05\x80\xe4\x9f\x0e\xb0\x8a\x15\x30\x74\xa1\x66\xf5\x8d\x95\x9c\xb2\x1a\x72\xa5\xb8\x12\x02\x63\xec\x50\xed\x6a\x92\x43\x17\xcf\x53\x76\x7d\x1d\x8e\x20\x5c\x45\x7a\xb9\x1b\x51\x4e\xe9\xd2\x5c\x92\x53\x34\x63\xd3\xaa\x0c\x49\x8b\xf4\x68\xb4\xfb\x06\x73\x42\x14\xc6\x07\x11\x14\x2b\x44\xc8\x4d\xba\x7e\x89\x64\xa5\xc8\xe7\xce\x80\x43\xd6\x00\x02\xe5\x93\x44\x3e\x5b\x50\x14\x66\x28\xd1\xba\x5c\x54\xad\x2b\xb7\xfc\x7b\xc9\x87\xc2\xeb\xa0\x84\x65\xaf\x35\xa4\x75\xbc\xdd\xad\xaa\x2e\xbd\x96\xf5\xb7\x43\x0d\xf2\x55\xd2\x3c\x42\x2c\xd2\x40\x5c\x43\x04\xdb\x9a\xe3\x54\x50\x82\xcc\x7a\xe6\xc5\x64\x10\x58\x48\x8f\x8f\x21\x38\x21\x04\x92\x81\xd9\x47\xb1\x45\x7b\x85\x04\x1c\xd4\x11\x0c\xf6\x20\x44\x35\x76\x3c\xd8\x26\xf4\x83\xc6\x2b\x5b\x16\x37\x99\xa0\x07\xdf\x1c\xec\xe4\x07\x27\xf1\xe4\x38\xac\x40\x20\xf3\x7e\x85\x78\x51\x00\x0d\xeb\xf1\x46\x78\x13\x38\x8c\xcb\xf2\x6e\xd9\xf1\x5c\x1b\x50\x0c\x8b\x85\x28\x6f\x95\x58\xa0\x20\xf7\x32\xab\x86\xa2\xe8\xc1\x48\xe8\xd6\x4a\xdf\x58\x2d\x48\x5b\xd6\xd5\x96\x7b\xb1\x65\xb5\x0c\x67\xc1\x0b\x07\xc5\x66\x23\xf0\x31\x6b\x57\x96\xf7\xcc\xd2\xdb\xa3\x52\x6d\xbe\x79\xc8\x85\x7c\xbd\x1c\xc0\xed\x42\x15\x13\x90\x2b\x8d\xaf\x2b\x73\x59\x3e\x3e\x07\x7a\xa7\xb7\xa5\xb8\xb8\x80\xaa\x4b\xd6\x56\x9a\xcb\xfa\x13\x9b\x99\x6a\x21\x40\x29\x38\x5d\x61\x00\xce\x0f\x10\x14\x64\x24\x8e\x17\xc9\xb0\x17\xed\xc2\x39\xed\x2c\x85\xb3\x47\x8c\xb1\x9f\xca\x57\x13\x6b\x33\x30\x17\x06\x9f\x5a\x9f\xa1\x63\x0c\xa4\xc3\x7e\xf4\x83\xfe\xa7\xf4\x7d\xb0\xfb\x08\x51\xaf\x1f\xb5\xd6\x7b\xab\x5a\x79\xa8\xf4\x8f\xad\x49\x52\xbc\x15\x47\x4e\xb1\x79\x81\x6f\x36\x95\xad\xb5\x87\x87\x96\x41\x97\x52\x70\xcd\x28\x90\x95\x4f\xbe\xca\xaa\x10\x06\x69\x26\xc1\x53\x17\xf0\xa1\x2b\x55\x81\x75\xda\x07\x31\x77\xb9\x7f\x1f\x7e\x59\xf2\xf3\x72\x7f\xed\x7b\x38\xf9\x6f\xdc\x9c\xfc\x6f\x4e\xfe\xff\xe5\x27\x7f\x63\xf8\x8f\x8f\x16\xaf\xc9\xe8\x5f\x1b\x72\xf2\x53\xe5\x91\x38\x44\x62\xa2\x68\x05\x4f\xe8\x74\x0f\x32\x7c\x9e\x8c\xec\x0d\x41\x47\xf4\x3b\x67\x0f\xe7\xac\x8d\x93\x20\x88\x5d\x9c\x9d\x40\xef\xdb\xb6\x81\xd6\x8f\x54\x58\x74\x6f\xbd\x13\xda\x2d\x5b\xab\x20\xc5\xdb\xef\x0f\x4b\x5e\xc4\x49\xe6\xfe\x4a\x9e\xea\xc4\x32\xdd\x79\xb4\xfd\x52\x4e\xf2\x30\xfa\xe1\xfb\x08\x62\xc8\xcd\x65\x34\x8d\xa3\x73\x91\xfa\x1e\x1e\xd4\x9a\x98\x51\x9b\xe0\x52\x79\x86\x7b\x01\xdd\xcc\x0e\xc9\x94\x4a\x99\xab\x2b\xe8\x71\x42\x8f\x16\x8a\x0c\x1a\x24\x5c\xe5\x51\x7b\x5b\xf4\x77\x6d\xb5\x1b\x3d\x82\xbf\x6b\xdd\xa8\xd7\xeb\x75\xa3\xff\x15\x1f\x1b\x77\x3b\x70\xd8\x89\xf2\x69\x32\x48\x47\x29\x2d\xa4\x9d\x37\xbb\x6b\x1b\xf7\xd6\xee\xf5\xbc\x5d\x26\xc3\x74\x39\x0e\xdf\x77\xe8\x05\xbd\x89\x85\x8e\xc0\x00\xed\xab\x35\x7e\xb3\x2c\x25\xa9\xa1\x02\x93\xae\x0d\xec\xfa\xad\x09\x85\x8a\xf9\x3c\xc2\x88\x5a\xdb\xad\x1e\xa0\xe5\x71\x36\x4c\xb6\x8b\xf6\x2a\xd3\x5a\xc3\xd8\x5a\xff\xeb\x65\x13\x03\xa4\x77\xbb\x48\xac\x45\xb6\x3f\x9d\x26\xb3\xc7\xe2\xc8\xa5\x55\xd9\x2c\x3b\x9f\x1f\x09\x3a\x69\x6f\x76\xd4\x8b\x5c\x99\xb0\xda\xdd\x74\x6e\xcc\x28\x57\x30\x8c\xa2\xdd\x6a\x75\xec\xc7\xca\x93\x8e\x6d\x5d\x05\x1a\x66\x10\xc9\x42\x9d\xd7\xae\xf0\x01\xe6\xa7\x07\x62\x9c\x42\x20\xc4\x8f\x1f\x1f\x44\xff\xdb\xf1\x3c\xbd\x07\x66\x56\x4e\x6c\xc8\xa5\x10\x56\x74\x47\x14\xba\x2d\x0a\xd5\xf8\x67\x57\x11\x26\x3d\x8f\xe2\x9d\xde\xef\x59\x3a\x81\x61\xba\x96\x8a\xa0\x7c\x87\x25\x03\x53\xfc\x62\xf7\x09\x10\xf6\xda\xaa\x62\x4a\xd2\xc2\x0f\x29\x3f\x40\x71\xdf\xaf\xde\xdb\x74\x09\x4e\x2c\x8e\x1f\xbe\x5f\x5b\x2d\x23\x34\x9b\xbe\x8c\xb7\x5a\xa2\x26\x59\xb8\x92\x8a\x66\xc9\x69\x9c\x4e\x48\x77\x04\x79\x5d\xdb\x09\x8d\x9c\x62\xcd\x1e\x24\xb0\xb1\x5b\x5e\xef\x38\x6e\x6b\x90\x59\x29\x30\x6d\xf1\xfa\x83\x65\x22\x67\x9a\x44\x59\x7b\x67\x52\x90\x47\x9c\xae\x40\x56\x27\xfa\x7f\x80\xb5\xdb\x5e\x2d\xe4\x14\x47\x9a\x9f\x87\x5e\xe0\xea\xba\x74\x49\x53\x9f\x35\x4f\xf5\xef\x90\xc8\x04\x1d\xd7\x81\x34\xf8\xc7\x0b\x75\x4c\x90\x6f\x1d\x24\xfb\x54\xf3\x15\x9e\x9c\x15\xee\xd3\x38\x3c\x09\xd2\x12\xda\x2c\x39\xbf\xab\x5e\x2c\xd1\xba\x7e\x26\x18\x6a\x66\x7e\xaa\x5e\xe7\x38\x44\x05\x30\xdb\x3a\xc7\xeb\x7b\xcc\xb2\x94\x18\x0d\x43\xb8\xd0\x6c\x8d\x6b\xc5\x20\xa7\xc7\x6a\xac\xf3\x19\x10\xe7\x8f\x94\xcf\x00\xf6\x52\x41\xc6\x7c\x8c\xd6\xee\x31\x16\x06\x6a\xa0\x8d\x7b\x60\xc7\x2e\xca\x18\xf5\xd0\xc6\x3d\xcb\x04\x60\x38\x4c\x48\xb3\x88\x7b\x60\x9b\x0a\x89\x9a\xee\xda\x92\xb0\xee\xe7\x23\xf0\x54\x40\xc5\x6c\xe6\xe7\x2c\x66\xe9\x96\x84\x2d\xdc\x47\x30\x74\xb8\xd8\x65\xbb\x17\x4c\x5f\x84\x9e\x34\x85\xb8\x2f\x57\x34\x29\x93\xd0\x5e\xf7\x15\x79\xfc\x17\x02\x85\x14\xca\x7e\x4c\x7f\x5a\x3e\x46\x89\x84\xdc\xdd\x8c\x0c\x52\xf3\x93\x98\xa4\x35\xdc\xdf\x3e\x0c\xc6\xf3\x3c\x7d\xaf\x03\x14\xa6\x47\xe9\x38\x2d\xb4\x80\x23\x06\xf1\x6e\xe5\x48\x6c\x7e\x42\xa8\x17\x52\xce\xfb\x74\xa0\x36\xc0\x98\x1c\x7b\x2e\xff\xb8\x92\xfe\xd4\x73\x69\x48\x07\x0b\xc8\xd5\x2e\x34\x12\xc2\x3c\x88\x4f\xe3\x63\xf0\x34\x73\x72\x2a\xe4\xad\x7c\x20\x64\x4a\x62\x4b\x52\xfe\x49\x26\xbd\xb3\xf4\x5d\x3a\x85\x50\x35\x28\x04\xc1\xd7\x8a\x58\x6b\xc9\x6c\x12\xd3\xd3\x89\xb7\x8f\x44\x8f\xde\x4a\xaf\xa2\x6f\x69\x5e\xff\xcf\xcf\x72\xa4\x93\xe3\xb7\x30\xc4\xb7\xf8\x96\xe8\xed\x30\x3d\x4e\x8b\x10\x51\x3e\x52\x2c\xcd\xa1\xc8\x23\xb5\xa7\xaa\x19\x50\x3e\x63\xc4\x4f\x7f\x9b\x6d\x40\xab\x8f\xdc\x15\x79\xe4\xb0\x45\x39\xa3\x8f\x69\x9f\x6a\xfd\xe3\x69\x6b\x6b\x29\xc8\x33\x25\x8f\x6d\x3b\x3b\x77\x9b\x57\x70\x5b\x48\x4a\x28\x2a\x61\x2b\xdc\xdc\x05\xd0\xf1\x04\xb0\x21\x6a\x6a\x93\x38\xd5\xfe\xe1\xbe\xd8\x9f\x74\x13\x1d\xf5\x6c\xe0\xce\xba\xb3\xdf\x6a\xaf\x1b\x76\x53\xac\x4e\xd9\xe0\x56\xa3\xf7\x36\x0c\x57\x48\xd8\x14\x26\x1a\x62\xc1\xa4\xc5\x5c\xfb\xc6\x15\xc7\xc0\x49\x01\x9b\x96\xeb\x5e\x9d\x6a\xd9\x99\x0c\xd3\x59\x62\x1b\x30\xd8\x6f\x6c\xf2\xae\x92\x65\xf5\x23\x1b\x7c\x35\xb5\xcc\x5a\x5a\xc6\xa6\x96\x4d\x5b\xcb\x8b\xf0\x22\xbb\x27\x41\x7f\xb9\xf6\x11\xd8\xe6\x0c\xad\xa7\x7b\xbf\xc0\x3c\xa8\xd7\x2d\x1c\x03\x2c\x55\xf7\xad\xe3\xf0\xeb\xac\x8a\x5f\xab\xa7\x74\x84\x5c\x19\x83\x59\xac\x4e\xf2\x15\xc2\xf8\xb8\x27\x77\xa2\x07\x95\x52\x79\x53\xef\x45\x01\xc5\x87\x52\x78\xd0\x73\x3a\x6a\x49\x0b\x9d\x3b\xcc\x3f\x4b\xa5\x9c\x90\x72\x3f\x2f\x29\x91\x95\xf5\x44\x55\x3b\x79\xd9\x6a\xfc\xa0\x10\xbb\x5e\xfa\x7a\x90\x8f\xd9\xf8\xde\x0f\x8e\x4a\x9c\xa1\x03\x5a\x1b\x1c\x1b\xd7\xd6\xf8\xa3\x74\x28\x61\xe5\xdf\xff\x78\x7a\xb0\x7a\xe7\x87\xc3\x8f\xeb\x17\x6d\x41\x07\xf0\x7b\xfb\xce\xff\x1e\x7e\x5c\xdb\xb8\xf8\xa4\x3f\x36\x56\xbb\x1b\x6b\x17\x9d\xff\xbb\xd2\x2b\x50\x09\xaa\x37\x70\xeb\x5d\x5e\x19\x63\x60\xe0\xf4\x3c\x6f\x6d\x41\x84\xc9\x27\x98\x78\xfa\x0f\xa2\xed\x89\x59\x82\xd7\x83\xb7\x27\xfe\x4a\x72\x10\x67\x06\xc5\x1f\xf7\x6c\x6c\x62\x34\xf7\x70\xde\xdd\x4b\x0e\x5b\x7c\x4e\x4a\x06\x6e\x71\x9f\xeb\x19\x7a\x90\x8d\x34\x1a\xfc\xfa\x6a\x23\xab\x4d\x12\x29\x61\xa4\xf9\xfc\x14\x00\xf7\x73\x79\x7c\x10\x04\x74\xe7\x87\xef\xef\x08\xf9\x5d\x65\xe3\x19\x17\x7b\x37\xc8\xc6\x51\x5b\x1c\x19\x56\x76\x9e\x3e\x8e\xe0\xdc\xd0\x5f\x5f\x5d\xdd\xe8\xb8\x3c\x99\x55\xeb\x9f\x42\x59\xae\x37\x70\x95\xd7\x70\xd8\xf2\x4c\xb8\xde\x8d\xd6\x9b\xd9\xaa\x72\xa6\x6a\x6d\x29\x51\x72\xdc\x8b\xfe\xf1\xfa\xe9\xcf\x9e\x23\x41\x5d\x20\x3c\x9a\xd2\x1a\xfd\x49\x65\x90\x75\xc3\x33\x04\xd0\x46\x2f\x73\xde\x90\xbf\xef\x46\x9b\xf0\x58\xae\xd5\x6a\x34\xee\xc1\x38\xc5\x87\x64\xba\x83\xa8\x7c\x4a\x27\xee\xf8\x00\x0b\x3f\x6f\xff\xba\xfb\xec\x9f\xbb\xaf\xff\xee\xce\x2a\xd6\x51\x32\xa7\x6e\xfd\xc1\xc9\x25\xa0\x2b\x8f\x7d\x6d\x6d\xf1\x91\xcb\xd5\x14\x3e\x97\xf8\x07\x0f\x7f\x68\x5e\x05\xde\xf0\xe2\xc0\x39\x84\xdf\x3b\xc9\xc1\x85\x1c\x90\x59\x87\x0e\x7f\xc0\x9b\xec\x10\x5b\x7a\x94\x51\xe7\x0f\x7d\x4a\xb1\x4e\xa8\x74\x46\xb1\xcf\x33\xe2\xcc\x23\x16\xce\xaa\x76\x71\x66\x49\x79\x0a\xbd\xce\x20\x55\xe1\x66\x0b\xb4\xc4\x2b\xd5\x0e\x66\x91\x52\x9f\xeb\x15\x97\xfb\xf6\x27\xa8\xf1\x37\x6f\xd4\xf8\x37\x6a\xfc\x6f\xc2\x80\x2f\x9d\x0e\xaa\xed\xf7\xae\x60\x71\xb7\x6c\x02\xd1\x2d\x6f\x2d\x5d\x9f\xc3\x1e\xaa\x6a\x67\x3a\x08\x5a\x82\x4d\x63\xf0\x83\x33\x49\x2c\xeb\xef\xb7\xa8\xb9\xf0\x1e\x9e\xaa\xab\x6a\x1e\xc2\x57\x79\x2d\x90\xf6\x3a\x68\xe3\x03\x7f\x28\x95\xc5\x3b\xd5\x37\xbc\xc8\x15\x0b\x95\xd0\xfe\x08\xd0\x7d\x53\x5e\xbb\xa2\x74\x8a\xf5\x04\x73\x69\xe1\xa8\x5a\x3c\xe4\x65\xc7\xb2\x9f\xce\x33\x60\x62\xf4\x96\x70\xe7\xd5\xe3\xc8\xdc\x2b\xd3\x0b\x43\x51\x94\x47\x8a\x7f\x4b\x6c\x50\x5e\x78\xb7\x5d\x67\x98\xc1\x1e\x4c\x86\xbc\x7d\xd6\x7c\x69\x65\xd1\x92\x7e\x63\xf0\x7c\xe7\xcd\xde\xd3\x97\xb8\x82\x1e\xef\xbe\x7c\xf9\xf4\xf1\xde\xce\xee\xcb\xe8\xf5\xd3\x37\xaf\x76\x5f\xbe\x79\xfa\xa6\xb4\x55\x78\xb5\xc5\x9b\x85\x6f\xbe\x39\xad\x7c\x27\xcd\x08\x4f\xe3\x0f\x70\x59\x32\x4e\x3e\xa4\xc5\x79\x3f\xba\x87\x94\xe5\xf4\x10\x75\xa1\xda\x0e\x01\xaa\x32\xfb\x4d\x27\x10\xd0\x44\xda\x1c\x7c\xb4\xc3\x15\xe3\xc1\x6f\xe8\xda\x4e\x58\x61\x9c\x91\xbf\x0c\x41\x41\x3c\x38\x11\x3d\x2b\x84\xe4\x48\xe2\x2b\x6d\x42\xc0\xd0\x86\xae\x93\x72\x37\x7e\x00\x36\x1d\x8e\x67\x8c\xd7\x51\x5e\x6f\xd1\x02\x21\x1c\x6d\x54\x54\xff\x36\x4c\xc8\x07\xf8\x36\x2e\x1d\x1e\x76\x4a\xc3\x94\xe2\x58\x25\x58\x20\xd0\xa8\x1b\x44\xb9\x2c\x62\x2a\x56\x23\xfb\xee\x56\x74\xe1\x61\x71\x24\x56\xb3\xe5\x11\x60\xa9\xe1\x78\x60\x28\x81\xd6\x2b\xc0\x4d\xf8\x56\x6e\xda\x62\xfe\x0d\x93\x71\x22\xf8\x55\x45\x0d\xa5\x61\xa4\x03\xaf\xb0\x7f\x83\x5d\x0b\x09\x51\x12\x04\xd5\x87\xca\x1d\xb2\x95\x9a\x90\xb3\x1c\x78\xbb\x0e\x1c\x1f\x42\xec\x2e\x29\x61\xd0\x26\xe1\x25\x57\xed\x81\x2f\x32\x41\xf8\x33\x3c\x8f\x89\x47\x76\x61\xee\x59\x95\xaf\x2a\x97\x0d\x76\x1d\x79\xed\x57\xf2\x6c\x6e\x1c\xf4\xaa\x25\xfe\xe4\xe9\x9d\xc7\xbf\xec\xbf\xfc\xfb\xd3\xd7\xcc\x53\xc1\xe0\x64\x3e\x79\x97\x0c\xe5\xab\x12\x7a\x31\x2a\xff\x09\x5c\x4e\xc7\xf1\x20\x69\xaf\xfc\xeb\xe2\xe0\x5f\x93\x7f\xcd\x0e\x1f\xfe\xeb\xe3\xca\x71\xb7\x75\xf1\xe9\xce\x9d\x4f\x1f\x5b\x1d\x74\x2d\xfc\x31\x08\xff\xaf\x43\x55\xe2\x40\x96\x39\x84\x42\x07\xaa\xd4\xe1\xc1\xc7\xca\x76\x0e\x58\x4b\xba\xd0\x41\x5d\x5b\xac\x25\xdd\x10\x2b\x23\xaf\xf9\x20\xbb\xa5\x38\xa9\x85\x01\x7f\xcd\x22\xe2\x19\x7f\x01\xef\xce\xa2\xe9\x44\xba\xc3\x40\x4f\x1b\x58\xc1\x92\x47\xfa\x90\xf7\x18\xb2\xec\x95\x2b\xfd\xce\x38\x30\xd1\xed\x88\xde\xbf\x5a\xa2\xba\xbe\xb3\x76\x38\x99\xef\xd4\x20\x64\x0b\x86\x7d\x67\xa5\xa4\x35\x0d\x19\xd3\x2c\xf9\x8b\xcf\x74\xe6\x81\xdb\x19\x3b\x0a\xbc\x34\xe4\x42\xd7\x77\x93\x41\x32\x46\x87\xfa\xea\x11\xa7\x55\x66\x30\x4e\xe2\x99\x32\xe1\x72\x5a\x91\xc9\xce\x82\x0e\x03\xa1\xaf\x86\x42\x55\x14\xda\xe3\xaa\xf7\x3a\x3f\x3c\x72\x89\xed\x9b\xc4\x99\xc5\x2e\xe0\x3e\x6e\x75\x55\xc8\x45\x78\x39\x13\xb8\x6b\x0d\x3a\x7e\xc0\x77\x7b\x88\x1d\x85\x2f\xe0\x20\x10\xcd\x1b\xe9\x85\x82\xa2\xc8\x77\x7d\x8b\xa3\xca\x9f\x31\x87\x44\x30\xda\x8f\xb4\xac\xf4\x3a\x4c\x2c\x82\xc2\x7e\xdb\x76\x7b\x8e\xb6\x9e\x83\x4b\xe7\x3f\xc0\x23\x7f\x96\x5b\xa8\x38\xb4\xe6\x3c\x1e\xb4\xb4\x72\xf0\xa5\x31\x52\x0f\x77\x97\x68\xc3\x55\x07\x03\x79\xd6\x4e\xc9\x5d\xbd\xe4\x7a\xa7\x2a\xb8\xbd\xc5\x7d\x77\x86\x54\x4a\x70\x5e\x21\x70\x73\x86\x2b\xfa\xf0\xda\x69\xaf\x82\xc3\x06\xa0\x2d\x6f\xf5\x61\x9b\x52\x26\x53\xa8\xd7\xea\xe2\xe3\xd3\x27\xf5\x75\xb0\x7a\xa8\xb7\x4c\xbc\xc2\xa6\xbe\x19\x68\x99\x60\x97\xa0\x44\x2e\xd1\x05\x37\x2f\xf0\x5d\x24\xbf\xb7\x6a\x40\x7b\xba\x61\x55\xb7\xda\xd5\xd4\x3a\xe2\x2b\x95\xa2\xc6\x4b\xb3\x14\x12\xcb\xf1\xf4\x85\x1a\x77\xd8\xdf\x87\x0c\xcd\x92\x88\x2b\x50\xeb\xdb\x77\xea\xa3\xbd\x60\xab\x6d\xc0\xcb\xbb\xe4\x9c\x4e\x8a\xa1\x01\xd8\x4e\x60\xda\x61\xa0\xde\x49\x9c\xef\x9e\x4d\x5e\x61\xc8\xa3\xe2\x1c\x43\xda\x75\x02\xf1\xe9\x7d\xf4\x40\x14\xbb\x72\xdb\xce\x96\x90\xc3\x85\x58\xde\x72\x43\xb5\x4b\xd9\xa2\xa2\x4e\xcf\xcc\xc2\x2c\x93\xc7\xdc\x07\xa1\x74\x37\x1e\xb1\xe3\x86\x40\x73\x5e\xa4\x14\x8c\x24\x1d\x32\xa2\xe6\x66\xa1\xa5\x08\x0f\xdb\x71\xb6\xcb\x4f\x4b\x26\x30\xd3\x39\x9c\x5c\xa9\x70\x97\xa8\xc0\xee\xd5\x71\x36\x49\x54\xf4\x96\x5b\x6f\x5d\xb1\xff\x6c\x96\x16\xe8\x2f\xc5\xe1\x46\x0c\xc4\x39\x42\xbd\xf5\xcf\x50\xca\xc5\xe0\xad\xb2\xda\xa5\x02\x29\x38\xf4\xaa\x17\x04\x4b\x9c\x7e\x9c\x7a\x93\x37\xec\xe9\x8a\xbd\xc9\x6e\x59\x9c\x7b\x01\x14\x29\x34\xd5\x63\x29\x74\x84\xaa\x3d\x6b\x6a\x49\x8d\x3d\xfb\x0d\x8d\xaa\xc2\xe2\xf9\x72\x62\x43\x50\x6a\x58\x70\x1f\x75\xb7\xde\xd0\x3e\xe9\x98\xdd\x2d\x05\xcf\x28\x9e\x3b\x1e\xd0\xc4\x80\x95\xb5\x79\x6d\xb6\xdc\xba\xed\xb2\xdb\xdb\xad\xe5\x28\xe7\x86\xd9\x3d\x6e\xa7\x6d\xf8\x20\xbe\xd8\x02\xe7\x3e\xf3\x29\xe8\x04\x51\xb7\x46\x37\xb5\xe2\xa0\xae\xb5\x2a\x2d\xcb\x11\x64\x39\x61\x36\x7e\xa9\x70\x99\xc5\x58\x4f\x65\x0b\x51\x58\xf0\x58\x8f\x34\x55\x69\x41\xcf\x1c\xea\xba\xbb\x69\xa9\xb7\x1b\x5f\x8f\x6b\x30\xe8\x39\x69\x2f\x79\xa5\x0d\x2a\xdd\xbb\x37\x2a\xdd\x1b\x95\xee\x37\xa1\xd2\x95\x0f\x2b\xae\xf4\x1c\x7b\x3b\x16\xcc\x2e\xfa\xfb\xfc\x34\x7e\x2f\xa6\xf7\xc7\x18\x3e\xff\xfa\x8e\x3e\x05\x9b\x0c\xaa\x7b\x05\x57\xda\x99\xa4\x45\x2a\x18\xfa\x1f\x49\xf4\x37\xea\x05\x10\x6a\x1c\xe5\x68\x89\xa5\x0c\x6e\x70\xa0\xb0\x54\x2d\x27\xe7\x3d\xd4\xea\xaa\x62\x2a\x8a\x88\x0c\x40\xb5\x33\xec\x47\xab\x75\x37\x6f\x64\xed\x01\xc3\x77\xdd\xea\x06\xcd\x4c\x82\xee\x74\xcd\x2b\x34\x15\xeb\x6a\xa4\x10\x8a\x2d\x19\x83\x9e\x80\x13\x5e\x7a\x9d\xd2\x65\xaa\x9e\x91\xaa\x46\x65\x29\xdf\xbb\xe1\x00\x7f\x66\x04\xd0\x9e\xd7\xfb\x95\x25\x89\x9e\x12\x57\xbc\xd4\x56\x57\x36\x66\x39\x4d\xa5\xac\x4e\x99\x6a\x59\x35\xe9\x19\xf3\x68\xb3\xdd\x5b\x2d\x16\x75\x2a\x1e\xbe\x87\x33\xaa\x9a\x9d\x68\xe7\x09\xe6\xa8\xde\xe9\x49\xbb\x7d\xbb\xcc\xcf\x50\xf8\xf5\x0f\xbd\x15\xf2\xaa\xe9\x7a\xee\x89\xe4\x48\x3d\xaf\x42\xf4\x17\x0e\x4c\xea\x85\x50\x73\xc3\x0b\x85\x03\x7d\x78\x54\x06\x44\x61\x53\x1d\xad\xac\xab\x8b\x7c\x16\x78\x99\xed\x34\x10\x36\xcf\x50\x68\x70\xda\x73\x62\x02\x53\xa2\x73\x19\x4a\x3e\x7d\x98\xce\x59\x02\x3d\xd4\x6d\xf7\x04\x73\x9d\x9d\xb7\x95\x37\xe4\x37\x62\xbb\x1e\x27\x2f\x08\xe1\x70\xd1\x1d\xcc\x30\x35\xc9\x69\xd5\x1d\x09\x83\xcb\x09\xd4\x07\x2d\x2d\xbc\x2b\xba\x61\x16\x44\x2a\x8d\x28\xd2\xb2\x2d\x92\x19\x6a\x7e\xc0\xde\x04\xa9\x86\x03\x49\xb7\x0b\x0a\x16\xce\xdc\x00\x46\xd7\xba\xad\x50\x75\x52\x86\x75\x7c\x4a\x0a\xe2\xc6\xd0\x7c\x3a\x49\x7a\x65\xe7\xcc\x45\xad\xc7\x46\xfe\xe4\x89\x11\x1d\xe1\x7b\xb4\x1a\x76\xf4\x08\x80\xca\x5d\x7c\xcb\x8e\x3b\xfc\x85\xea\xca\x89\xa9\xca\xaa\x94\x80\x33\xec\xa0\xf2\x48\x2e\x8a\xb6\xe2\x3d\x1d\x21\xdb\x24\xe3\xa1\x63\x7a\x20\x5b\xb1\x7a\xea\xf0\x1c\xde\x41\x87\xf1\x50\xd7\x1c\x32\x54\xc9\x4e\xd4\x07\x45\x16\xbe\x23\x2c\x8f\xbd\x29\xd8\x9e\x62\x6d\xea\x5b\xb2\x38\x5b\x0f\xef\xc9\x8a\xb2\x3e\x29\x27\x82\x18\x78\xef\x46\x0c\xbc\x11\x03\xbf\x95\xc0\x3c\xb4\x68\xae\xeb\x85\xde\xf5\xdc\xdd\x03\xc8\x0b\xa5\x6e\x2c\x35\x56\xc6\x73\xa2\x8c\xd4\xa2\xac\x90\xe1\x93\x9d\x22\xa5\xcb\x35\x95\x0b\x9f\xd6\xc5\x3d\xf2\x3c\x93\x6f\x24\x83\x55\x06\x86\x3e\xf9\x79\x50\x42\x63\x08\xcd\x33\x58\x09\xf2\xf4\x1c\x2a\xe2\xe4\x58\x4a\x57\xd4\x18\xbc\x88\x27\x82\x75\x99\xd7\xf9\xc0\xb2\x08\x15\x96\x2a\x40\xb9\xf0\x30\xe0\x6c\xbf\x3f\xb5\x30\xe4\x55\x3c\x3b\xad\xb1\x7f\x17\xe4\x9b\xc0\xf3\x41\xdb\xbf\xa7\x23\xfe\x81\x55\x17\xfa\x5c\x28\x8b\x44\x71\x9c\xa0\x97\xca\xda\x30\x27\xae\x63\x51\xd5\xa6\xdd\x1e\x92\x98\x87\x08\xd9\x46\x69\xac\x09\xcb\x9d\x28\x0b\x9f\xa3\x89\x43\xdb\xf1\x29\x5f\x86\x39\x08\x36\x3a\xa5\xce\xcd\x31\x39\xe3\x34\x97\x14\x32\x34\x87\xdc\x76\xf5\x38\x7b\xd1\x0b\x60\xe5\xf0\x70\x9f\x82\x48\x23\x3e\x3c\x2f\x94\x96\x67\xcf\xc6\x78\x53\x83\xfa\xf4\x52\x10\x8c\x71\x8c\xd1\x05\x29\x32\xf9\x90\xe2\xb5\x59\x08\x77\x5f\x67\xfc\xa1\x6b\x0b\xbb\x13\x7d\xb6\xb8\x3b\x1e\x26\x1b\x45\xdb\x71\x03\x9c\xe8\x50\x32\xf6\x41\xac\x2e\x7c\xcc\xeb\x57\x8f\x65\x84\x89\xea\xd8\x31\x06\x6d\xd2\xd5\x2b\x11\x1e\x22\x5d\x9f\x38\x5d\x34\x11\xe8\x0e\x40\xfa\x58\xb2\x88\x4e\x27\x05\xd0\xc1\x6a\xa9\xc4\xc6\xad\x00\x77\x2d\xa1\xa0\xd0\xe3\xc6\x40\x69\x47\x86\xd4\x4e\x17\xbb\x11\x06\xc9\xae\x0a\x21\x25\xf8\xc0\xc8\x0a\x16\x75\x80\x45\x0e\x81\x1d\xb0\xcf\x1a\xcf\xfa\x1e\xd6\x89\xdf\xeb\xe8\xb5\x1c\xf3\x3e\xe2\xc9\x01\x6f\xf5\x59\xd1\x76\x47\x5c\x82\xfb\x40\x8c\x14\x3b\x58\x2e\x47\xa1\xbb\x59\xa1\xb3\x19\x3c\xf6\x3c\x0c\x02\xe8\xaa\xfa\x15\x4e\x80\x42\x21\x8b\xd4\xfb\x29\x45\x87\x0b\x44\x2e\x8a\xd5\xba\x6d\x84\x84\x66\x31\x88\xb8\x3b\x56\xb9\x8f\xb8\x5e\x92\x17\x76\xbe\x2c\xe5\x09\x04\x66\xcb\x80\x03\xfa\x01\x86\xf4\x31\xa5\xda\xdb\x32\x6a\x96\x4a\x94\x19\x55\x6e\x95\xb9\x8a\xc3\x43\x95\x72\x91\x4b\xf8\xb4\xa5\xad\xa3\xdf\x39\xba\x20\x43\x0d\xed\x68\x9e\x8e\x87\x88\x30\x39\x28\xc8\xf4\xfc\xdb\x22\xc3\xdf\xdb\x7d\xb2\x7b\xeb\xd6\x2d\x14\xef\x5b\x79\x34\x3f\x86\x9b\x12\x8a\xe0\x00\x07\x82\x39\xbc\xed\x48\x0b\xdd\xca\x84\xb9\x94\x85\xdf\xca\xae\x46\xdf\x90\x00\xe3\xc0\x0c\xfd\xde\x7a\xcd\x8a\xf4\x74\xf4\xfb\x01\x64\x1f\xac\x1e\xc2\xe5\xb6\xf5\x29\xc4\x3a\x65\xb7\xe9\x82\xd2\x8f\x35\x2c\x03\x63\xd9\x0a\x5f\x15\x39\xb5\x23\x64\xc4\xb8\xb0\x87\x5e\x8d\xa8\xb2\x5b\x54\x7d\xa9\x6e\x8c\x4e\x29\x04\xca\x24\xfc\x2c\xcb\xf7\xf3\x2d\xe5\x77\x73\x1a\x5e\xc4\x0f\xb4\x1b\xc1\x22\x14\xaa\xc0\x3a\xab\x63\x5b\xb6\x44\x69\x2e\xa6\xcc\xfd\x8c\x15\x8b\x45\x9b\xd7\x05\x4c\xf3\xdc\x86\x09\x5e\xb6\xc3\x99\x99\x36\x8a\x74\x0c\x68\x82\xe1\x54\xec\xee\x5a\xd5\x94\x0f\xc1\xbd\x86\x92\xa4\x02\x59\x4d\x3d\x3d\xab\x30\x57\xa2\xc8\x56\x43\xa5\xcf\x14\x42\x66\xc1\x7d\x98\xd8\xd8\xb5\x07\x26\x32\x65\x01\xcd\x80\x12\x36\xc5\x49\x99\x94\x2e\x61\x5f\x4f\x33\xdf\xd7\x63\xaf\xd7\xbb\xe0\xf1\xdd\x5c\x5f\x7a\x46\x93\xa9\x8a\x06\x38\x69\x9d\x4f\xfb\x52\x77\xf3\x8b\x10\xa1\xa2\x01\xdb\x27\x3d\x9f\xb5\x32\x44\xcb\x92\x25\x8a\x9d\x17\xca\x06\xa6\xe9\xf5\xdf\xf7\x37\x7a\x9f\x1b\xbd\xcf\x37\x71\xfd\x37\x3c\xba\xc2\xcd\x5f\x48\xef\xa3\xb5\x35\x5c\xf1\x43\xcc\x49\x6b\x74\x9e\x3c\xb2\xf8\x08\x0c\xc3\x76\xf9\xe1\x69\x2a\x70\xa4\x4e\xf2\x56\x45\xa0\xb0\x25\x23\x2f\x89\xf3\x91\x6f\x71\x2b\x2f\xb8\xa2\x8f\x91\x23\x5d\x39\x72\x50\x1b\x6a\x66\x3b\x8b\x04\xf2\xd4\x2e\xed\x50\x07\x1d\x7d\xb3\xd9\xe5\xcb\x03\x16\x41\x74\x79\xf5\x78\x0d\x0c\x51\x08\x9b\x6d\xb3\x5d\x82\xd0\xd1\x8f\x5a\x1a\xce\x89\xa3\x21\xb2\x86\x47\x6f\x43\xb9\x4a\x4c\xdc\xb0\x4d\x30\xa1\x51\x21\xf3\x36\x6a\x54\xc3\x05\x1b\x0d\xe5\xaa\x46\xd7\xfd\x46\x45\x1f\x7f\x49\x3e\xd4\x0f\x53\x00\x95\x8d\xd1\xce\xaa\x1e\x60\x7d\x5b\x04\x54\x36\xb4\x70\x5b\xeb\x41\x7b\xd6\x03\x03\xa7\x26\xa2\x6b\x90\xdc\x95\x43\xef\xca\x6e\x21\xf0\x61\xc9\xce\xf5\xe4\x91\xd9\xb5\x88\x76\x96\xfb\x1b\xb8\x45\xdd\xbf\xd9\xa2\x6e\xb6\xa8\x6f\xe5\x6a\x02\x82\x9d\x5d\xe6\x5e\x42\x02\x5f\xef\xab\x44\x13\xc1\xc3\xd9\xe5\x4c\x86\xba\xdd\x08\x5d\x82\x84\xef\x41\x6a\xb6\x4d\x00\x51\xe6\xc8\x0e\xd0\x54\x26\x2b\xb0\x41\x59\x7b\x83\x6c\x32\x4a\x8f\x15\x18\x0b\x85\xc3\xa1\x55\x64\x15\x05\x76\x26\x9f\xad\x59\x17\x34\x32\x51\xc1\x3c\xa3\x50\xe0\x0e\x32\x30\x51\x81\xec\xe4\x60\x13\x49\x5b\x0c\x87\xca\x29\x55\x81\x01\x2b\x9e\x25\x2e\x90\x4c\xd5\x75\x91\x83\x22\x0e\x01\x0f\xb9\x55\x36\x79\x3d\xf4\xfa\xa3\x92\xb5\x10\x82\x3e\xd3\x9a\xdc\x19\x68\x9d\xb7\x7c\x23\x88\x4a\xc0\xd5\x43\xf0\x68\x12\xc9\xdf\x3d\xd4\x09\xee\x8e\xda\x10\xe8\x99\x5c\x97\xac\xc2\x85\xc2\x32\x44\xbe\x12\x6c\x1e\x03\x93\x3e\x3a\xff\x25\xce\x4f\x96\xa3\xbe\x9b\x4c\x1a\xdd\x65\x23\x25\xb0\xf8\x4f\xcf\xc0\xf7\xce\x17\xe8\x69\x4b\x76\x89\x85\x15\x7a\x74\x8e\x0d\x43\xa7\xb7\x27\xc3\x1d\x28\xa7\xa3\x79\x05\x21\x69\x1c\x1a\xd6\x8c\x47\xf4\x79\x9c\x7c\xa1\x01\xec\x43\x5b\x35\x5d\xe7\x30\xa5\x9d\x56\xf3\xc3\xc6\xf9\x18\xbc\x49\x7d\xa1\x71\x04\xdb\x26\x12\xe2\x43\x29\x01\xa3\x51\x79\x53\xf0\x05\xfb\xbf\xaf\x1b\x64\x93\xe1\x4d\x02\x07\xb0\xfa\xac\xbb\xf7\xb4\x38\xb9\xee\x03\x42\xe3\xc3\xc1\x35\x9d\x0d\x30\x00\x70\xf9\xd9\x80\x54\x1f\xc4\xc5\xd3\x24\xd8\xa3\x29\xef\xcc\xb4\xa6\x1f\xd3\x4b\x74\x81\xdd\x71\x13\x77\x25\xff\x17\x96\x87\x6e\x70\x8c\xe5\x04\x23\x93\x3c\x95\xb4\x32\xf4\x80\x56\xea\x66\x2e\x96\x96\xa8\x77\x3d\xb2\x8c\xd2\x6f\x69\xc4\x7c\x1a\x6d\x50\x57\x88\xd7\x2c\x74\x73\xab\x2b\x87\x29\xc8\xa3\x5f\xa2\xf3\x54\xbe\x4a\x7b\xbc\xa0\x1c\x49\x57\xea\xe9\xac\xc2\xef\xc1\x84\x89\xbd\x92\xef\x39\x81\xb3\x45\xbe\xe7\x8c\x44\xa7\x5d\x2c\x21\x7a\x16\x1a\x8a\xf4\xf3\x77\x99\xc1\xc8\xa2\x97\x19\x8e\x2c\xda\x70\x40\x4d\xce\xa2\xc0\x5d\xe2\x31\x5a\x6e\xd6\x9e\x9c\x24\xa0\x7f\x7a\x52\x6c\xca\xcb\xd7\x47\x28\x66\xcd\x69\x5d\xe1\xf5\xa3\x03\x23\x50\xd1\xf5\x9e\x74\xa3\xf9\xcc\xdc\xe6\xb9\x10\x3c\xf2\x39\xf1\x13\x0d\xcc\x42\x47\x3a\xf7\x58\x7d\x5e\x0d\xe5\x69\x9f\x35\x1a\xa8\xe4\xd8\x9c\xcd\xc4\x52\xda\x2e\x9a\x9c\x9c\x25\x68\x29\x8e\x42\x10\xfa\x50\x5b\x81\x25\x5a\x77\xc4\xb1\x8b\x0c\x4f\x96\x8b\xa0\x25\x38\x30\xe9\xdc\xb1\x66\x4c\x00\x55\x3a\x1c\x27\xf3\xcb\xcf\x77\x70\x60\x6a\xd5\xd7\xd1\x33\xb1\x23\x67\x68\x5a\x64\xbc\xda\xb0\x42\xbd\xed\x7a\x4b\xdc\xd8\xbf\xc2\xe2\x8d\x2e\x16\xa3\x5f\x68\xa2\x9a\x76\x71\xff\xad\xc7\x04\x82\x79\x98\xd0\x12\xdd\xe7\xc0\x04\x23\xe5\x2b\x0c\xba\xbb\x54\x42\xd9\xa7\x53\x71\xa4\xc4\xc3\x5b\x2d\x79\x4b\xd0\x0a\x1a\xf7\x21\x14\x1e\x56\xcb\xe9\xcf\x95\xd7\x1a\xd2\xa3\x5b\xcc\xeb\x56\x95\xc8\xea\x77\x70\xed\x8a\x13\x55\x35\x37\x6a\x0a\x9f\x24\x83\xf4\x34\x1e\x97\xa3\xc2\x48\x81\x0d\x91\x60\x0a\x94\x10\xe5\xd7\x3b\x60\x5b\x78\xaa\x19\x6c\x79\xac\xe4\x92\x23\x18\xca\xd7\x95\x83\xae\x5f\x41\xac\xc2\x6a\xe6\xb1\x17\x38\xa1\x2e\x34\x26\x5d\xca\x1b\x5c\xd9\xe1\xf7\x6b\xe2\x34\x97\xc1\xd3\x6b\xd0\xa8\x4d\x8b\x85\xb0\x24\xcb\x34\x21\x00\x1f\xf4\xaa\x14\x20\x6b\x6c\x3c\xc0\x86\xab\xb8\x96\x8b\x05\x06\xe7\x02\x36\xa1\x00\x12\x8b\xae\x49\x40\xac\x5d\xde\x70\x40\x7a\x1d\x9f\x35\x5f\xe2\x7e\x81\x30\x22\x2a\xe1\x9a\x70\x36\xc0\xc3\x61\xb8\xc3\x8b\xf5\xb6\x51\x57\x2f\xdf\x4f\x77\xa6\x42\x6b\x2c\x34\x8e\xf4\x78\xb2\xc0\x38\x6c\xe8\x92\x71\x94\x02\x7d\xe6\x71\x34\xe8\x7c\x79\x8f\xaf\x5d\xd6\x2e\x21\x1c\x69\xdc\x55\xd5\x51\x00\x09\x77\xd4\xc9\xb9\x4c\x47\x61\x2f\xb8\xb6\x13\x81\x1d\x21\xbd\x6a\x4c\x0c\x32\x3c\xb4\x30\xc0\x65\x28\xc6\x1a\xe1\x95\xb8\xd2\x80\xe4\x53\x15\xd7\xbc\x6e\xda\x08\xba\xa7\x82\x9d\x97\x4c\xa1\x5d\x67\x68\xac\xa5\x1d\x79\xfe\xfc\x79\xc3\x3e\x8c\x4b\x29\x48\xd7\xb4\x50\xcb\x6f\x92\xd9\x34\xa9\xdd\x9e\x34\x06\x08\xba\x1a\x01\x1e\x4c\x45\x2f\xf2\xf9\xd1\x69\x5a\xfc\x96\xcd\xea\xa4\x24\x03\x58\xb2\xd2\x43\xf9\xd5\x57\xdf\x0d\x5a\x95\x50\xa5\x5b\x71\x49\x7b\xab\x35\xd7\xdf\x46\xf1\xd3\xe5\x69\x5a\xd1\x61\xa5\x82\xbe\xc0\x4a\xc0\x25\x6c\xa5\xa0\xec\xef\x14\x92\x07\x30\xbf\xa4\x2b\x3e\xf8\x10\xe6\x28\x61\xe5\xb1\x82\x65\xe9\x5a\x30\x2d\x03\x90\xb2\x53\x59\xb6\xd3\xa8\x6d\xd0\xcb\x18\x89\x49\xf4\xc5\x00\x96\x67\xef\x57\x76\xa1\xd2\x12\xac\x79\x7b\x45\x7b\x19\x62\xad\xf9\xc0\x44\xfd\xac\x4a\x4d\x98\xd6\xa0\x21\x81\x5b\x38\x50\x4c\x36\xad\xec\xae\xf2\xd1\x6c\xe8\x08\x48\x57\x6b\x5d\x6d\xd3\x51\xbd\x70\xd3\x09\xbc\x43\x62\x44\x2a\x3b\x80\x8c\x52\xae\x5b\x09\xc3\x3c\x33\x76\x17\x68\xe3\x34\x9d\xd8\xd6\x2d\x5e\x0b\x12\xe2\x92\xf5\x9f\x88\x03\xdc\x4c\xcc\x7b\x55\x0b\x25\x30\x8d\x76\x86\xc5\x7b\xa4\x6e\x67\x2b\x3a\x14\x06\xa9\x3f\x67\xc8\xeb\x60\xfb\x70\xb1\x78\x0f\x8f\xe3\xfc\xd5\x2c\x1d\x54\xe2\xac\x04\xe6\xd2\x4a\xe0\xc5\x7b\x29\xc3\x0e\xe5\x55\xbd\xd4\x30\x97\x6c\xe3\x88\x5d\xa1\x55\x34\x53\x0e\xf6\x99\x68\x48\xc5\x64\xf8\x95\x6c\x6d\xaa\xfa\xe6\x82\xb2\x16\x39\x0b\xb1\xae\x5d\x7a\x03\x63\xc7\xc0\xae\x34\x8f\x52\xeb\xc5\x82\xc8\xcf\x66\x4a\xfc\x51\x96\x0f\x68\x46\xdc\x8d\x00\x76\xcb\x0e\xfb\x8a\xd0\xa1\xc6\x46\xca\xd2\xc1\x7b\x8a\xda\x65\xcf\xed\x08\xea\x31\x98\x75\x8b\x2c\x7c\x3c\xf6\xd8\x31\xa7\x67\x71\x8a\xc8\x7a\xc2\xd4\x03\xa8\x50\xe1\xe6\x9d\x1b\xbb\xae\x32\xc7\xe8\x09\x98\x76\xa7\xeb\x93\xec\xf3\xec\x98\xc9\xc6\xcd\xba\x14\x1a\xa8\x31\xc4\xa8\x76\xa1\xaf\xb0\xdf\x96\x05\x7a\xc7\xe3\xec\x28\x1e\xf7\x00\xa9\xbd\xd8\x4f\x96\x31\xcf\x42\x4d\xa6\x83\x78\xfa\xf2\xb2\xcd\x42\x61\xaf\x51\x4a\xac\x6a\x92\x59\xa5\x98\x06\x07\xe5\x73\xa5\xcd\x58\xda\xe1\xe9\xa9\xf3\x46\xf5\x14\x1c\x83\x2b\x7b\x3e\xc7\x30\x67\xb9\xbf\x76\xbf\xbb\xec\x19\x08\x49\x03\x75\x63\x99\x23\x12\xee\x62\x02\xcd\xa9\xf8\xfc\x81\x3e\x35\x2d\x2c\xf7\x37\xa8\x08\xdc\xe5\x8a\x8f\x8d\xae\x6d\x3e\x88\x9f\x12\x49\xcb\xfd\xcd\x4d\xfc\x56\x66\x44\x22\x81\xaa\x97\x9c\x5d\x7c\x53\xb7\xd4\x65\xaf\x48\x80\x06\x95\x11\x90\xf8\xdc\x00\x7b\xc4\x1f\x6e\xec\x11\x6f\xec\x11\xbf\x09\x93\x79\xcf\x18\xf1\xca\x36\xf3\xcd\xcd\x04\x1b\xd8\x00\x22\xdc\xcb\xa4\xf8\x9c\x26\xf6\x0d\x4d\x56\xa2\x2b\xd9\xac\x34\x30\xa9\x5f\x59\x59\x31\x3e\x69\x42\x7e\x6e\x64\xc0\x46\x60\xf1\x58\x9d\x98\x8c\x28\x9e\xa6\xac\xef\x9f\xe9\x40\xe2\x07\xa3\x77\x04\x1e\x3b\x62\xfd\x65\x85\xab\x44\x6c\xea\x8e\x6e\xdc\x6b\xa5\x0c\x68\x01\x81\xaf\xd2\x46\x5d\xd0\x5a\x60\x53\xb3\x37\x2f\xbe\xbb\x88\xdd\x62\x73\xf5\x66\xb7\xb8\xd9\x2d\x6e\xac\xd7\xff\x04\xeb\xf5\xeb\x33\x34\x6f\x68\x07\x6f\x4c\x39\x5f\x89\x14\xf0\x28\x76\x63\xcf\xf9\xb9\xed\x39\x2f\x9a\x59\xf8\x89\x0d\xc6\x98\x0d\x56\xe9\x87\x0d\xa0\xaf\x22\x9e\xca\x59\x7d\x1b\x04\xba\xc2\x0d\xa5\xe0\x33\x62\x23\x78\x1d\x9f\xfd\x3d\xa9\xbb\xb9\xe0\xa0\xa2\xf1\xff\xf9\x1f\xb7\x6f\x1e\x40\xc5\xcb\xb4\xe6\x37\x71\x7e\x3b\x3a\x83\x19\xee\xfd\xcf\xff\x34\xbc\x9b\x6e\x7c\x05\x97\x0c\x5e\x27\x83\xec\x3d\x39\x87\xaa\xba\xb3\x52\x70\xc1\xbe\xda\xb9\x15\x08\x99\x4f\x40\x69\xd4\x8c\x52\x2c\xd8\x0a\x62\x29\x83\x6b\x62\xf0\xd8\x0c\x6f\xa5\xd8\xbb\xe6\x9b\x6f\x33\xf7\xb5\xd7\xdf\xeb\x57\xbc\x36\x2e\x1d\x52\xf3\xf9\x69\x36\x3b\xd5\x73\xb3\x76\x85\xb9\x39\xac\xb9\x32\x32\x4c\x84\x35\xc2\x16\xaf\x49\xb5\x28\x88\xdd\x9e\x28\xaa\x2e\xbb\xed\x28\xbd\x14\x61\xd5\x71\x79\xf7\xf3\x9d\x0f\xb6\x6b\xd4\xc2\x66\x3a\x38\x70\x89\x58\xee\xcb\xe5\x6a\xbb\x35\xc2\x79\x40\x45\xe4\x68\x85\x5c\x39\x7d\xed\x46\x4e\xbf\x91\xd3\xbf\x09\xad\x4e\x7e\x72\x72\xa9\x90\x96\xc9\x2c\x99\x9f\x42\xd5\x3f\xd5\x28\x81\x06\x02\x1d\xbd\x34\xb3\xe5\xf4\xef\x1b\x3b\x4e\x68\xf8\xd0\xb2\xee\xbd\x26\x02\xbd\x39\x39\xf9\xec\xda\xa1\xaf\x47\x1e\x07\xee\x28\x46\xcc\x6f\x37\x92\x33\xe9\x74\xfa\xd9\x97\xb8\xd0\x11\xa4\x55\x7b\xa1\x23\x60\xe0\x42\x87\x04\x97\x45\xee\x6d\xaa\xe4\xfc\xf2\xcd\xc9\x13\x0f\xd8\xd6\xf4\x3e\x78\x53\x27\x45\x04\xd1\xcf\xb7\x61\x00\xdb\x28\x24\xa4\xcb\xaa\x6a\x34\x9d\x8c\xb2\xf2\x16\x03\xb9\x57\x6b\x4e\x10\xd1\x8b\xf8\x83\x24\x82\x37\xe9\x1f\x49\x79\xdb\x75\xa0\xb6\xd5\xcf\x65\x3a\x92\x4e\x5e\x65\xbf\x55\x77\x20\x04\x72\xb5\x86\x05\x5f\x7a\xb7\x37\x9b\x0b\x8e\x36\x7c\x95\x78\x97\xc1\xac\xf9\x6a\xc0\xab\x75\x42\xe4\x0b\x99\xee\x55\x9c\x56\xb4\x5f\x0a\x73\x35\x0a\x88\x87\xc3\x57\xb3\xf4\xbd\xe0\xc3\x74\x24\x2c\x69\xbd\x0a\xec\x6a\x63\xa7\xa0\x5f\xb5\xc3\xaf\x02\xbb\x5a\x07\x4e\xe2\xbc\xb6\xf5\x52\x98\xab\x35\x2d\x44\x5c\xda\xd0\x2b\x71\x5f\x01\x75\xf5\xe6\x1b\xcc\x7d\x15\xd8\x95\xe9\xfe\xcd\xf9\x69\x65\xe3\x65\x20\x57\xa6\xfa\xba\x86\xcb\x40\xae\x8a\x72\x90\xe3\x20\x8a\x18\x54\x0d\xf6\x73\xaf\xe2\x3c\x3f\xcb\x66\xc3\x2a\xfc\x37\x2c\x73\xe5\x75\x50\x87\x93\x32\x90\x2b\x93\x61\x5d\xc3\x65\x20\xd7\xc1\x7a\xea\xda\xae\x80\x0a\x37\xcf\x63\xdb\xe5\xf3\x23\xbc\x79\xa3\x90\xc6\xf3\x89\xf9\x3e\x4d\xf3\x3c\x9d\x1c\x2f\x35\xee\xed\x34\xcb\x8b\xf2\x5e\x06\x72\x03\x7a\x8a\x1a\xbd\x63\x83\x5b\x2e\x21\x41\xb2\x00\x62\x8e\xed\x85\x75\x8a\x76\x2c\x23\x36\xd7\x6f\xce\xd0\x37\x67\xe8\x6f\xe5\xae\xeb\xe8\x8f\x3f\x9c\xbb\xae\xed\x71\xf2\x21\x7a\x04\xb6\x46\xf9\x1f\x71\xfe\x47\x1a\xfd\x18\x8b\x94\xbf\xce\x8a\x51\xde\x3b\x99\xdb\xc7\xe1\x7b\xd2\x9b\xe9\xeb\x64\x24\x0a\x4c\x06\x82\xa8\xa1\xfd\x5c\x74\xe0\x58\xf4\x71\x7e\x04\x37\x5d\x2b\xea\xd4\xbd\x72\x9c\xdd\xd1\xbf\x8f\xc6\xd9\xd1\x4a\x2e\xe6\xe9\x74\x25\x9d\x08\x92\x99\xc4\xe3\x15\x18\x52\xf2\xa1\x50\x7f\x7b\xc7\xd9\xff\x79\xbe\xb1\xf1\x99\xaf\xc6\xcc\x7d\xd7\x1b\xe8\xcd\xcd\x65\xd7\x57\x72\xd9\x45\x96\xaf\x49\x21\xe4\x87\x77\xaf\x13\x74\x55\x5b\xa5\x28\x77\xc1\x7d\x6d\xb9\x20\xf5\xb7\x15\x50\x57\x79\x9b\x77\x3e\x19\x3c\x9d\x40\xc4\xcd\xba\x5e\x32\xc8\x70\x07\xc3\x00\x57\xe9\xdb\x59\x3c\x6d\xd8\x37\x03\x59\xd2\xb7\x20\xc0\x15\xfa\x36\xcc\xce\x26\xd2\x0b\x71\x55\xc7\x14\x58\xb8\x57\x81\xdc\xe6\xcf\x4d\x4b\x3a\x36\x9f\x36\xe8\x16\x01\x85\x3b\xe5\xe5\x5d\xb9\x4b\xb3\x04\x22\xdb\xbe\xaf\xf3\x00\xa1\xc0\xc2\xdd\x0a\xe4\x5e\x85\xb4\x0a\xd8\xed\x6a\x88\x0a\x60\x4a\xc8\xc9\xc9\xba\x32\x8a\x8e\x93\x06\xcf\x99\xc3\x7d\xb1\x33\xae\x80\x13\x8a\xde\x50\xe3\x23\x35\xdc\x07\x3b\xe3\xca\xd8\x90\x01\x5b\xaa\x3b\x43\x40\xe1\xfe\x78\x79\xaa\x4b\x9b\x8d\xba\x54\x71\xab\xeb\xa9\x38\x5d\xb6\xcc\x6e\xff\x0c\x3f\x64\x89\x86\x11\x99\x44\xc5\x07\xd8\x8d\xe3\xd4\xfe\x56\xd4\xcf\x2a\x02\x12\xb4\xde\x3f\x75\xb9\x2f\x58\x16\x99\xd2\xc8\xe2\x9f\xf5\x9a\xf1\x24\x7d\x5f\xfa\x36\x06\xe6\x04\xf3\x2f\x69\x7c\x18\x50\xca\x5a\x75\x63\x7e\xe3\xdb\x4a\x14\x96\x42\xce\x6e\x83\xf7\x92\x1b\x37\x67\xaa\x9b\x33\xd5\x37\x71\x2f\xa9\x2e\x88\xbe\xac\x97\xf6\xcb\x18\x56\xab\xd7\x51\x60\x5f\x2d\x85\x71\x0a\xf1\x57\x9c\x54\x59\xa0\xd1\x75\x59\xa5\x57\x4a\x05\x0d\x97\x77\x02\x48\x7a\xa0\xdc\x5a\x62\x0c\x3c\x15\x58\x6a\x43\xbe\x1b\x5b\x64\x10\x8b\x69\x6f\x01\xc5\xe7\x45\xab\xef\x85\x1d\xc1\x40\x5c\xf9\x49\x3a\x2a\xda\x4e\x40\x11\x3f\x38\xe0\xaa\x0f\x20\x59\x32\x3e\x5f\x13\x63\x21\x0f\x54\x74\x21\xbb\x15\xe8\xc6\x34\x99\x0c\xc1\xdc\xfb\x73\xf7\xe3\x15\xb5\xc3\x6d\x88\x42\x9d\x92\xae\x03\xfb\x75\xd5\x79\x85\x2f\x54\x5c\x14\x7f\x22\xeb\x2d\x09\x65\x6d\xae\x8c\x60\x91\x42\xa3\x47\xf5\xf3\x49\x3a\xc9\x0b\x70\x96\xd0\xa4\x65\x07\x3a\xfc\xd6\xbd\x1c\xa8\xa2\x1f\x62\xd7\x7b\x9e\x1d\x37\x70\x28\x06\x50\xa5\x6f\xec\xa9\x45\x07\xa4\xa2\xd5\x69\x56\xeb\x87\x03\x40\x6a\xda\x7b\x7c\x12\x4f\x8e\x93\x06\x4d\x86\x84\x0f\xaa\x82\x9b\x64\x59\xd8\xb3\x04\x21\x18\x18\x6b\x24\x1b\x8f\xb9\x3c\xb0\x30\xbf\x01\x73\x0d\x64\x8d\x1e\xbb\xc9\x4f\x4e\xae\xe9\x22\x3c\x44\xab\xfe\x2d\x8d\x65\x49\x70\xad\x6a\xfa\x72\x62\x0c\x6a\xea\x3f\x5f\x47\x7c\xd2\xf5\xaf\x29\xa8\x59\xd9\x87\xfc\x5a\x9b\x77\xc8\xf8\x1a\xda\xae\xd4\xf4\x53\x1f\x44\x7f\xfa\xf0\x3f\xe9\x84\xf5\x44\x7c\x89\xff\x91\xf0\x1a\x0a\xc8\xb0\xb9\x79\x23\x92\xde\x88\xa4\xff\xe5\x22\xa9\xd1\xf3\xab\x37\xd6\xd7\x14\x2d\x9a\xde\x87\xbf\x4e\x8e\x61\x9e\xe3\xd9\xf6\x51\x5a\x12\x97\x20\x5f\xf9\xd9\x06\xed\x41\xf8\x32\xa5\x9e\x87\x17\xdf\xbc\x92\xb2\x3a\x76\x1e\x6f\xbf\xf2\x6b\x60\x3d\x91\x0f\xd1\xa5\xf5\xb2\xe8\x4b\x6b\xf5\xc3\xe0\xde\xf0\x87\xe1\xfa\x60\xb8\xb9\xf9\x43\xfc\xfd\xdd\xcd\xc1\xe6\x0f\x9b\xeb\xf7\x36\x93\xb5\xfb\xab\x3f\x0c\xee\xae\x26\x1b\x9b\xc3\x7b\x9b\x77\xef\xad\x1f\xb5\x4c\x5f\x42\xd5\xc4\x6b\xf1\xda\xda\xda\xd1\x60\xf5\xfb\xcd\xc1\x0f\x83\x51\xfc\xfd\xfd\xb5\xd1\xea\x60\xe3\x7e\x72\x6f\xe3\x68\x78\x77\x6d\xf0\xc3\xda\xd1\xfd\x78\xb4\xba\xda\x2a\xe7\x4d\xd4\xc7\x3e\x93\x74\xe3\xa3\xb4\x1f\x40\xa2\xe1\x84\xf2\x19\x7c\x3f\x38\x3e\xee\x6e\x1a\xba\xed\xd5\xec\xe2\x35\x50\xaf\x3f\x5c\x16\x0e\x51\x71\xcc\xea\x59\x5c\xee\xaf\x75\x97\x6b\xe6\x69\xb9\xbf\x0e\xcc\xf6\xee\x0d\xb3\xbd\x61\xb6\xdf\xca\x9d\xaa\x52\x7e\x39\xcc\xb6\xca\x30\x79\x34\xcb\xfe\x48\x4e\xe3\x49\x6f\x98\xfc\x74\x2d\x0c\x3a\xf4\x44\xdd\x79\x9f\xee\x5e\x90\x7a\x26\xb5\xd6\x2d\xa8\x8c\xef\x2a\xb3\xf0\x93\xc5\x1b\x20\x45\x22\xcb\xa7\x04\x2b\x22\x81\x03\x91\xbb\x10\x6e\x38\x63\x03\xea\xe4\xb0\x32\xb6\xfe\x99\x15\xb1\x33\x58\x09\xef\x32\x17\xf4\xd3\x24\x59\x2a\x5c\xf0\xe8\x97\xee\xe5\xa6\x1d\x9a\xf9\xd4\x42\x91\x57\x35\x28\x48\xaf\x14\xe3\xfb\xca\x21\xbe\x03\x43\xaa\x08\xf2\x1d\x72\xdd\xe2\x60\xf8\x61\x28\x95\xca\x62\xb8\xed\xaf\x24\xbc\x76\xe9\xb8\xaf\x29\xc0\x36\xc4\x80\x07\xc2\x71\x92\x60\x43\x81\x90\x13\xc3\xab\x44\xe0\x56\x91\x63\x05\xb2\x64\xd8\x6a\x13\xce\x1a\xc3\x71\xeb\xc3\xb6\x0e\xc8\xda\x8b\x5e\xc0\xc6\x06\x17\x11\x44\x49\x88\x26\x07\x97\x4e\x0c\xec\x70\xb0\x6e\xaa\xcf\xc2\xeb\x33\x89\xa9\x4f\x2f\xc5\x80\x2f\x98\xb1\x7b\x3a\x12\x75\x8a\xbd\x27\x2f\x43\xb9\xd3\x62\x65\x4c\x79\x15\xc1\x46\xb5\x46\x31\x6c\x50\xcd\x26\x03\x46\xdf\x89\xd6\x0e\x3b\x25\x61\xe6\x7b\xd3\x6c\xda\xee\x60\xdc\x6a\x79\xef\x05\xfc\x1f\xd7\x13\x62\xeb\x16\x17\x6e\x6c\x74\x93\x7d\x83\xe9\x99\x40\x7b\x90\x14\x6d\x3b\x88\x32\x62\x94\x49\x6a\x14\xa5\xd4\xd8\x3c\x7c\x37\x96\xd1\xa2\xa4\x0e\x50\x62\xc2\x79\xff\x9c\x14\x6d\x76\x3a\x4f\x26\x62\x0e\x67\x70\x39\x06\xbe\xb9\xe7\x89\xaf\xfa\x13\xac\x3b\xaf\x0c\xd5\xcd\xe2\x79\x23\x30\x2a\x6f\x79\x0c\xef\xbc\x26\x88\x77\xee\x44\xf1\xce\x4b\xc2\x78\xbb\x20\x5b\x96\x46\x4c\x37\xbf\x76\xa8\x82\xa1\xe0\x9f\x70\x74\x17\x51\x51\x17\xe1\xbb\x84\x32\x39\x16\xa8\x3f\x86\x5b\xc4\x9f\x71\xbf\x01\x91\x17\x87\xd0\x39\xb4\x62\xa2\x6f\x4b\x90\x36\x7b\xa6\xe1\x14\xb3\x26\x09\xab\xb0\x59\x06\xde\x2e\x47\xb7\xb1\x0f\xbd\xc1\x89\x10\xbc\x8b\xf6\x6a\xa7\x57\x64\xfb\xd3\x29\x68\x49\xf3\xa4\xdd\x51\xd9\x18\x55\xb9\xbd\xd6\x29\xdd\x78\xd4\xcc\x96\x47\x1e\x35\x1b\xb7\x09\xa3\xaa\x3c\xa2\xa9\x12\xc7\x30\x20\x7f\xc5\x68\x01\xc5\x0a\xb0\xad\x53\x43\x21\xb6\x6d\x45\x8f\x8a\x6c\xaf\x8b\xd0\x76\xcf\x34\x36\x26\x14\x77\xd5\x00\x15\xd6\x17\x1b\xa5\xb9\xeb\x2f\x77\x02\xc6\xcc\x9c\xce\xcb\xc3\x9e\x2f\x38\xaa\x86\xe1\xd1\xed\x90\xe8\x61\x64\xfb\x41\xd1\x2f\xec\xe8\xe5\xa2\xed\x05\x83\x97\x1f\x27\xc5\x7f\x64\xec\xf2\x00\x71\x34\x8f\x5e\xee\x9a\xcd\xf5\xb9\x3c\xea\x2b\xc9\x0f\x0e\x6d\xf5\x3a\x4c\x93\x2c\xe2\x6c\x56\x0d\x03\xa1\xf3\x29\xfb\x5c\xe1\xd0\x15\x82\xd8\xc1\xdc\xf1\xd9\x03\x47\xec\x7b\x37\x47\xec\x9b\x23\xf6\x37\xa3\xcf\x94\x1c\xe2\x54\xb2\x74\xfb\xa4\xfd\xb7\x64\x24\x68\xf6\x3c\xfa\x2d\x1d\x0f\xde\x89\xca\x7f\x17\xdf\x65\xde\x7a\x16\x72\xed\x23\x80\xe1\x08\xbf\x0b\x2e\xb2\x63\x84\x0d\x39\xf5\xb9\x84\x1f\x20\x59\xe4\xe7\xf8\x7d\xf4\x5b\x26\x58\xeb\x8f\xc7\xa5\x87\xfc\x4d\x73\xc8\xff\x9b\xe4\xa6\xd6\xe3\x61\xc9\x62\xab\x42\xda\x06\x1c\xd5\x0d\x82\x20\x76\x08\xda\x04\x9e\xb3\x3a\x4f\x95\x29\x8d\x6c\x10\x68\xdb\xd9\x29\x5a\x39\x6c\x8c\x53\x71\x0c\x4f\x85\x14\x8a\x04\x36\x8d\xf1\x11\x89\x60\xd2\x74\xe7\x03\x7b\x91\x60\xad\xef\x53\xc1\x1d\x72\x5d\x2a\x1e\xe7\x99\x5f\x34\x1b\x8f\xa1\x28\x50\x9b\xb2\x1e\x17\x84\x33\xa4\xdc\x74\x22\x10\xc4\x6b\x86\xca\x64\x4c\x01\xba\x72\x2d\xd2\xd3\x04\x16\x9b\x58\xc5\x6b\x82\xd6\xc4\x90\x86\xb8\x3b\x8a\x42\xe3\xa4\xc8\x26\x88\x4e\x18\x5e\xc5\x41\x5f\x75\xd5\x3a\xee\xab\x44\x01\xa9\x7e\x32\x3d\x03\xb4\x8d\x1a\xe0\x0b\x96\xa8\xfa\xc2\xb5\x0e\xa5\x87\x3f\x90\x50\x4e\x66\xd9\x24\x9b\xe7\xe3\x73\x74\x83\x51\xb2\x0f\x43\x56\xe0\x3c\x12\x09\x8a\x89\x4b\x4f\xc8\xf6\x68\x2d\x95\xc7\x64\x68\x0d\x1e\xaa\xe1\x27\xb5\x5b\xd6\xe8\xad\xe0\x86\x62\xde\x32\xd8\xba\x80\x28\xda\x44\x1a\xbd\x9d\xc9\xfb\x78\x9c\x0e\x5f\x49\xf8\x36\x97\x79\xd4\x2b\x6c\x44\x06\x93\xf0\xed\x3d\x5e\x92\xb9\x90\xa4\x5f\x51\x1a\x76\xa9\x47\xa3\xef\xe2\x30\xa5\xb1\x05\x3b\xbf\xc8\x53\xf9\x03\x7b\xae\x48\x98\x95\x55\xab\xab\x72\x1c\x94\x6a\x24\xcd\x7f\x85\xee\xbe\x26\x2a\x4c\xb4\xa0\xc6\x86\x59\x88\x59\x39\x8b\xec\xe1\xb9\xf0\xd6\x70\xe4\x30\x29\xab\xd7\xe8\xe4\x1f\x2f\x34\xfb\x28\xcd\x56\x92\x80\x7b\x2e\xd5\xd2\x4f\x3d\x31\x60\xe5\x1e\x45\x30\xe7\xb9\x8d\x68\x43\x85\xce\x64\xb2\x71\x15\x75\x5c\x0f\x21\xf8\x73\x4f\x72\xbf\xac\xb2\x81\x3c\x0f\x83\x12\x59\xae\x88\x1f\x18\x4d\xc7\x35\xb7\x81\x7f\x56\x3d\x8b\xd0\x58\x3d\xca\xbc\xd2\xbc\x7d\x4f\xc8\x95\xd1\x5e\x2d\x12\x74\x9d\x73\x96\x1f\x1b\x42\xd4\x58\x75\x32\x00\x80\x47\x90\x1f\x50\x32\x51\xb9\xeb\x20\x4c\x6c\xe1\x2b\x23\x4c\xec\x13\xa7\x4e\xc5\x64\x2e\x47\x8a\xf9\x35\xd0\xa2\x4d\x83\x4a\xcf\x86\xb3\xd1\x56\xad\x5c\x89\xf4\xf2\x3a\xda\xb3\x06\xa4\x89\x2e\xaa\xd9\xfe\xc4\x09\x5f\x6f\xb1\x42\xda\x54\x67\x42\x29\xf2\x79\x14\x57\xa8\xa9\x0a\xab\xe6\x2a\x69\x49\x97\xb5\x77\xfd\xd2\xed\xbe\x6e\xe7\x2e\xe0\x48\x25\x05\x17\xb7\xa3\x90\xf7\x4a\x67\xd5\x72\x6c\xf2\xfd\x7f\x81\xb5\xed\x0c\x6b\x97\x4c\x20\x57\x9b\x25\xa9\x9e\x14\xd9\x30\x8b\x06\xe3\x24\x9e\xcc\xa7\x62\xef\x85\xfa\x01\xc1\xfa\xd8\x5e\x85\x2a\xd6\xfb\xc0\xca\xa3\x4e\x72\x87\x28\xba\x71\x1b\x97\x20\x1c\x1d\x10\x34\xe8\xe1\x3e\x42\xf1\x7e\x44\x95\xa4\xa0\x82\x71\x2a\xea\x87\xaa\xec\x9b\x9f\x17\x2a\x26\x36\x3c\x65\xb6\xb1\x6f\x31\x00\x29\x80\xd9\xbb\xb9\xec\x50\x5b\xaf\xf0\x9a\xc9\xcd\xa6\x5a\x28\x05\x11\x54\x9a\xd1\xe2\xe9\xe6\x38\x85\x23\x5d\x3a\xf4\x26\x3d\x9b\x06\xe6\xdc\x9b\xdb\x4a\xcc\xeb\x2a\x6c\x22\xc5\xb2\x6a\x40\x64\x59\x16\x40\xf2\x16\x43\x8f\xe9\xbf\xc4\x4e\x5b\x6a\x54\xdf\x25\xe7\x79\xdb\x94\xed\x28\x2d\x2f\x06\x8b\x87\xab\x8e\x32\x1c\x02\x31\xcd\xf6\x28\xbd\x6d\x01\x6d\xd9\x78\x76\x05\xe0\x0a\x7c\x9b\xdd\x47\x70\x0e\xc1\x0b\x40\xfe\x57\x68\x3f\x4d\x06\x27\xf1\x24\xcd\x4f\xd5\x31\xb4\x9a\x39\x60\x05\xd5\xe8\xa5\x36\x38\x62\xdf\x25\xc9\x54\xc7\x0f\x50\x83\x5d\xf9\xee\xf7\xfc\x24\x9d\x40\x43\x1f\x20\xb2\xce\x38\xf9\x90\x16\xe7\xfd\xbb\x78\x24\x03\x00\x20\x88\x36\x6c\x0e\x02\x97\xa4\x29\xd0\xd8\x64\xf8\x12\xd3\x30\x4b\x4e\x33\x71\xac\x06\x33\x6a\xcc\xee\x46\xc9\x87\x41\x32\x2d\x50\xec\x97\x49\x1c\x5e\x8c\xf2\x3c\x9a\x24\x84\x91\xa3\x44\x96\x1f\xc2\x88\xe7\xa2\x92\xf3\xe8\xe8\x1c\x51\x06\xe8\x91\xa1\x00\x90\x66\x7e\x83\x0d\x09\x22\x20\x74\xd8\x3e\xd0\xbe\x65\x8d\x0e\x34\xf3\xa2\xbf\xbd\x74\x32\x4c\x3e\xec\x8e\xda\xf8\x4c\x11\x88\xed\x6d\xab\x83\x93\x7f\x67\xcd\xdd\x20\x18\x85\x89\x92\x87\x3d\xbd\x12\x5d\x73\x68\x9f\x22\x01\xde\x33\x4d\xfe\x0f\x26\x4f\x3c\x65\xc2\xbc\x0f\xc8\x36\x37\x12\xd4\xd3\x80\x27\x90\x49\x6d\x15\x4d\x4a\x8b\x61\x5f\x05\x5a\x42\x85\xd1\x3d\xa8\x2e\x30\x18\x41\xda\xd6\xe8\xa1\x62\xa6\x8a\x14\x6c\xb6\xf7\x74\xef\x97\xb7\xaf\x76\x9f\x3f\xdf\x79\xf9\xf3\xdb\xbd\x9d\x17\x4f\x77\xf7\xf7\xf8\xf1\xa8\xc9\x0c\xf8\x42\x95\x25\x31\x7d\x96\xa3\xa3\x2f\x93\x41\xbf\x9e\x88\x7d\x44\x0c\xfb\xe0\x70\xcb\x4e\xdf\xc1\xe7\xc6\x2a\xb9\xd9\x52\xd5\x15\xf6\xa6\xf3\xfc\xa4\xed\xd2\xbd\x14\xf1\x2c\x68\xd1\x0c\x01\x0b\x88\x8e\x87\x03\x53\xe1\x02\xc8\x6b\x24\x6e\xea\x9a\x65\xa3\x6a\x49\x9d\xc6\x53\x8b\x49\xa6\x48\xb6\xc8\x50\x90\xc4\x80\x34\x6d\x34\xbd\x10\x25\x8c\xea\x82\xe9\xb5\xed\x97\xe2\x24\xb8\x22\xd7\xe8\x7c\x74\x71\x20\xea\x38\xc0\x62\x29\x6e\xf1\x0a\x33\x07\x08\x7e\xc8\x5e\xa4\x87\xdf\xcd\x2f\x2e\x33\x67\xb6\xd4\x6c\xb9\x37\xd9\xdb\x7d\xb2\xdb\x57\x44\x16\x8d\xb3\xe3\x87\xae\x54\x9d\x95\xc8\xd5\x57\x95\xa4\x1b\x28\x0b\xf2\xe0\xd1\x51\xe6\xf5\xc4\xec\xb5\xcb\x8c\x15\xf8\x63\x9b\x74\x68\xb0\x0c\xb8\x97\x47\xbd\x74\xc8\x1f\xde\x68\x8a\x78\x97\x44\x39\x5c\x02\x01\x5d\x48\x66\x95\xe6\x82\xf9\x82\x1e\x5b\x72\x72\xd0\xf5\x8e\xf0\x81\xd0\x0c\xbc\x2a\x8d\x9d\xbd\xd6\xf6\xd5\x22\x10\x82\xcf\xfe\xff\x7f\xf6\xde\x75\xbd\x8d\x1b\x59\x14\xfd\x9d\x3c\x45\x67\xce\x99\x31\x19\xd3\x72\xdf\x49\x4a\xd1\xac\x2d\xeb\x12\x69\x59\xb6\xbc\x25\x39\xc9\x6c\x7f\x8a\xbf\x26\xbb\x69\x31\xa1\x48\x2d\x92\xb2\xa5\x24\x9e\xf7\xd9\xcf\x71\x5e\xec\xa0\x0a\x97\x06\xd0\x40\x5f\x28\xc9\xb1\x33\xca\x8c\x6d\x76\x37\x50\x28\x00\x85\x42\xa1\x50\x17\xba\x34\x48\xa3\x3a\x8a\x79\x97\xd6\x86\xb9\x3d\x80\x5a\x47\x7c\xb1\x3a\xcc\x70\xdd\x89\xfc\xe9\xa3\x93\x4d\x98\x9e\xd9\x50\x63\x94\x90\x8f\xf2\x2d\x1b\x73\x7b\xaa\x1c\x53\x56\xff\x9b\x6f\x58\x9b\xce\x0a\x30\x60\x5e\x70\xc6\xa5\x45\x6b\x39\xfc\x6f\x14\xc6\xf3\x1b\xac\x59\x63\x1c\xeb\x2b\x06\x54\x0a\x93\x7a\x89\x15\xd5\x51\xd2\x16\xbb\x7d\x98\x54\x5c\xec\x7a\x06\xe5\x92\xd3\x96\x71\xe9\x58\x0f\xaa\xa1\xde\x78\x69\xa9\x97\x8a\xa9\x2b\x98\x42\x9a\x3c\x61\x68\x1f\xa6\x0c\xff\xdb\x7a\x80\x6e\xa8\xdd\x3a\x77\x64\x2c\xe6\x66\xb6\x5c\x12\x11\xc4\xec\xd9\x8b\x8c\x29\x95\x32\xd7\x82\xa9\x30\xf3\x59\xdb\x28\x94\xc8\x23\x3d\x0b\xfb\x20\x5b\xd0\x22\xd6\x28\xeb\x37\xa0\xdc\x7b\xb8\xd6\x7b\xb8\xd6\xfb\xcf\x88\xe8\xcb\x4e\x2d\xab\x44\xf5\xad\x32\x87\xb5\xe4\xbe\xd0\x52\x5f\x34\x31\x9c\xe5\x4b\xda\x63\x87\x83\xad\x94\x08\x97\x30\x74\x62\x77\x83\x40\x6a\x44\xf2\x5e\x38\x57\x54\xfc\x62\x4e\x6f\x1d\x10\xbe\xc6\x4b\x8c\x94\xe7\x60\x52\x00\xba\xa9\xd2\xdd\xfe\xeb\xaf\xe5\xf3\x01\x3b\x9f\x7d\xad\x2b\x89\x60\xdb\xfc\x9a\x5d\x5b\x49\xe5\x24\x5e\x45\xe3\xf4\x70\x57\x3a\x28\x37\x9b\x32\x87\x2b\x85\xa3\x31\xb9\x09\xc6\xde\xa0\x6a\xb4\x09\x45\x74\xdf\xe6\x3d\x5d\x18\x36\x0b\x93\x3d\x8e\x49\x2f\x38\x9b\xeb\xdb\x93\x4d\x77\x29\x2c\x04\x79\x20\x22\x44\x99\x9c\xfa\xe0\x07\x55\x4c\x91\x21\x40\x6e\xdc\x56\x20\xf2\xeb\x8b\xaa\x94\xa6\x14\xa2\xec\xa5\xbc\x6a\x27\x85\x34\x34\x49\x16\xd8\xcc\xc9\x12\x26\x1b\x2c\x44\xf5\x81\x2e\x5c\x14\x48\x52\x1a\x66\x6e\x57\x48\x0a\x97\x1a\x18\xf5\x11\x2e\x3f\x27\x92\xfc\x62\x46\xed\x1c\x2f\x2f\x91\x75\xe3\xd9\x99\xb0\xbd\x25\x1c\xf8\x3b\x84\x71\x8d\x80\x01\xd0\x25\xce\xaf\x50\x71\x34\xa8\x92\x51\xfb\x0f\xd4\x02\x06\xac\xc9\x08\x9b\x46\xbe\x5d\xa8\x6f\xb8\x6e\x00\x54\x0d\xee\xd1\xd6\xce\xce\x28\xdf\x9c\x66\xd7\x4b\x67\xfb\xd5\x6b\x67\x78\x03\x09\xa4\x45\x37\x71\xd8\xc5\x66\x83\x3d\xc1\x2e\x33\x9b\x25\x72\x52\x18\x02\xcf\x5a\xd0\xd1\x29\xb4\x22\x1d\x83\xc5\x32\x31\xcd\x85\xa1\x23\x8c\x34\xf4\x52\x1f\x3b\xe4\xe4\x56\xa3\x7f\x86\x61\xa5\xa4\x60\x13\xcd\x24\x63\xb0\xaf\x05\x00\xdd\x8c\x4d\xd2\xc5\xb6\x8a\x76\x50\x96\x74\xbf\xba\x25\xd4\xc7\x4e\x2e\x84\x83\xc6\x55\xb0\x09\xf6\x5e\xd6\x21\x51\x9d\x01\x72\x16\x58\x27\xdc\x4e\xf2\xc0\x98\x96\xd3\x9a\x6b\xb3\xdc\x64\x5e\x93\xff\x14\x5d\xd3\x01\xc8\xd1\x92\x72\xea\x31\xe5\xc2\x8f\x1f\x4b\xe5\xc4\x7a\x95\x4e\xfa\xf8\x21\x49\x53\x61\xdb\x25\xe5\xfd\x14\xdf\xf5\xe9\x91\x0e\x0e\x12\x8b\xe5\xc6\x5b\xba\xad\x38\x15\xe8\xc4\x48\xc8\x96\xbe\x79\xbb\xa5\x16\x8b\x64\xf9\x89\x57\xaa\x56\x2a\x67\x41\xa8\x55\xd0\x90\xaf\x85\x84\x3c\x8b\x76\x89\xb6\x40\x60\x42\xe5\xdc\x92\xe6\xa0\x5d\x32\xda\x46\xa9\x56\x20\x64\x37\x60\x03\x59\x5d\xcd\x76\x01\xb2\xef\x43\x8e\xd2\x07\xd9\xf7\x3f\xc7\xa4\x8d\x27\xec\xbd\x2b\x1f\xdd\x83\x41\x32\x55\xa5\x5d\xc8\x2f\x2c\x8c\xb9\xae\xa9\xba\xba\xcc\x75\xf7\x84\xb4\xb5\xdc\x65\x05\x73\xb7\x5b\xeb\xd5\x18\xaa\x95\xb0\x59\xde\x17\x4d\xe7\x2d\xbd\x16\x97\x60\x27\x84\x0a\xa7\xef\x3e\xa2\x6b\x8b\xe9\x3d\x48\xcb\x04\xa4\xfc\xe9\x87\x64\x72\x95\x7d\x04\xa5\x05\x91\x84\xe8\x75\x08\x40\x86\x18\xa4\x15\x37\xa4\x1d\xd5\xbc\x00\x83\xd4\x30\x9c\x54\xb1\x78\x79\xde\x41\x8c\x40\x5a\xef\xd0\x96\x8a\x5b\x18\xaa\xdd\xe8\x28\x63\xb6\x69\xf2\xb3\x05\x8e\x07\x4e\xae\xf0\x86\xcf\x5c\xe5\xd3\x32\x58\x11\x41\x3d\x4c\x13\xd1\x7a\x34\xa6\xea\x1b\x0a\x91\xf9\xe9\x3e\x2a\xea\x8f\x19\xc4\x1d\x32\x5d\x44\x16\x33\x39\xc4\xf0\x1e\x9d\xce\x98\x67\xaf\xdc\x1d\xac\xce\xa0\x43\x06\x0b\xbd\x6b\xbc\x3d\x21\xc7\x60\x37\x4c\x92\x2e\xba\x48\x08\x4f\x69\x08\x28\x24\xf9\x14\xb7\xda\xd8\x08\xc3\x76\xba\x58\x8e\x97\x57\x54\xe0\x2a\x9a\x7f\xa5\xd9\xe5\x6c\x31\x5e\xca\x58\x32\xb8\x02\x3d\x04\x33\x24\x0c\x65\xba\xd4\x2d\x31\x6a\x37\x5c\x30\xb1\xe0\xa9\xc6\x8b\x23\xd8\x14\xa3\xe2\xf8\x71\x15\x7c\xe9\x55\xb2\x20\x3d\x82\x58\x8a\x36\x91\xc3\x6c\x4e\x38\x96\x98\x7e\xcb\xf2\x11\x13\xdb\x6c\x1d\xdd\xfb\x12\x12\x78\xad\xb0\x96\xd8\xc8\xc3\x6c\x6a\x19\xbf\x24\xd9\x56\x78\xaf\x13\x40\xe2\x25\x80\x5e\xa7\x0d\x48\xb4\x49\x1a\x59\xa7\xff\x70\x31\x57\x4b\xf6\x6e\x9d\x15\x36\xf9\xf9\xa4\x60\x5c\x7b\xc2\xfe\x39\x27\x74\x38\x87\x74\x5a\x17\x57\x70\x9f\x02\x37\x70\xe0\x0f\x20\xe8\x66\x70\xb3\xcc\x16\x81\xdf\x66\xc2\xf8\x37\x6d\x6d\x22\x59\xb9\x3b\x9f\xbe\x45\x61\xfe\x78\x75\x4a\xa9\x60\x30\x41\x44\x3c\x67\x9c\x92\x07\xf0\x17\x9c\x37\x9e\x6b\x3e\x3a\x35\x66\x18\x9b\x34\x73\xcd\x04\x77\x1d\x69\x0f\xda\xd0\x89\x80\x8f\x71\x8b\x14\x25\x4b\x7a\x09\x8c\x75\x8d\x8f\x2f\x07\xfd\xfb\x6d\x89\xa0\x20\xab\xf2\x51\x34\x06\x41\x28\xee\x87\x44\x52\x05\xd1\x15\x98\x01\xf9\x09\xe1\xe3\xa3\x5a\xa9\x92\x99\x76\x37\xaa\x95\xaf\x6d\x43\x56\xc2\xcf\x40\xbe\x18\x11\x74\x66\xf3\x9b\x0e\x55\x28\xc3\xc0\x7e\x05\x6c\x1a\x44\xfd\x19\x38\x65\xb2\xde\x6c\x6e\x3a\x7f\xa3\x01\x99\xfe\x86\x65\xbe\x22\xe7\xec\xed\xd9\xc5\xc5\x6c\xfa\xdf\x27\xe4\xa9\xd0\xf9\xfc\x17\x6b\x80\xe3\xd4\xfa\x1b\x0c\xc3\x3c\xfb\x5b\xbb\xe3\x48\xaf\xb2\xe9\xf0\xc9\x20\x59\x64\x71\xa8\x7d\xb8\x48\x23\xbd\xe8\xfb\xcb\x5f\xd3\x91\xf6\x72\x38\xbe\x24\xe2\xed\x13\x0a\x99\xcc\xc8\x57\x1f\xbf\xfe\x0a\xee\x72\x1c\xa9\x33\x54\x61\x4e\xfb\xc2\x87\xe1\x6f\xa0\x94\xa0\x1f\xd6\x92\x8b\x54\xf4\x6d\xeb\xc5\x0e\xf9\x41\x3f\xb4\xde\x70\x9c\x3b\x8e\x8a\x2a\x3e\x03\x86\xf4\x03\x45\x0c\x7f\xcb\xf8\x9c\x89\x51\x96\x11\x63\x0d\xd1\x68\x18\x4e\x6b\x40\xb6\x70\x22\x27\xb6\xc9\x5b\x31\x62\x64\x82\xd6\xb6\xe7\x37\x97\xcb\xd9\x7f\x9f\xd0\xaa\x1f\xf1\xf4\x24\x6f\x3f\xe2\x3b\x28\xe8\xbe\x6a\xa9\xc7\xb1\xaf\x98\x46\x84\x70\xa6\xf9\x72\x78\xb5\x5c\xd0\x37\xb0\x6c\xb6\xc9\xd4\xf0\xba\x1b\xd2\xeb\xb7\x93\xf1\x00\x3e\xad\x91\x7f\xa5\xf7\xa8\x0c\xdb\xc6\x4e\xc1\x57\x28\xb5\x26\xbd\x53\x20\x24\x93\x77\x33\x04\x01\x3f\x08\xf5\x73\x2c\x0e\x67\xb3\x5f\x41\x81\x09\x0e\xa5\x12\x26\x27\xcf\x8e\x7e\x62\x67\x3e\xf1\xee\xe0\xe5\x0f\x6f\x4d\xef\x4f\x5e\x3f\x7b\xfb\xe2\xe0\xa7\xb7\xae\xed\x83\x67\xfb\xe0\xdb\x3e\x04\xc6\xb6\x6d\xed\xc8\x1f\xbd\xb2\x8f\x7e\xd9\x47\xde\xa6\x18\x1a\xb2\x94\x2e\xe1\xa0\x38\x29\x0e\x91\x69\x4a\xb5\x5a\xe9\xec\x0a\x5c\x1c\xb0\x56\x5e\x00\x59\xac\x8c\x85\x62\xa9\x30\xc6\x68\x82\xe4\x9f\xef\x1c\x3f\x8a\xc9\x8f\xc7\x8f\x15\xf0\x42\x46\x24\x05\x3c\xbf\x57\xf8\x86\x06\x39\x6f\xc6\x70\x1f\x4f\x8a\x7c\x07\xca\xeb\xaf\x8a\x57\xa9\x25\xb5\x5a\xb4\x5a\xdb\xf9\xd9\x71\xaf\x3d\x6f\xa0\xd7\xcf\x1f\x3f\x7e\xad\xf4\xfa\xc7\x64\xf2\xab\xf3\xfd\x5e\xcb\xff\xb9\xd7\x56\x7b\x7b\x4d\x23\x24\xaa\xef\xc6\xda\xcb\x46\x23\x20\x0d\xf2\x62\x30\xbb\x56\x3f\xa2\xa1\x01\xb4\x49\xda\xf8\xd9\x69\x5d\xe7\x1d\x62\xbf\x7d\xe9\x77\x20\xfd\x0e\xdb\x5a\x67\x11\x4a\x8b\xfc\xfd\xcf\x7f\xfe\xd3\xe9\x61\x49\xf2\xf0\x0f\x32\x30\xa3\x11\x1d\xa0\x38\xd0\xaa\xc0\xea\x78\x73\x0d\x03\xb9\xb8\xd6\x3e\xf1\xc5\xf3\x66\x81\xdf\xaf\x37\xbe\xb6\x76\xea\xe2\x6a\xb2\x1c\x93\xd3\xf5\x10\xb5\x04\xc5\xee\x5d\x03\x19\xa7\x6f\xae\xcf\x36\x0c\xdf\x42\xfa\xcd\x37\x7e\xec\xd1\x8f\xe1\x59\x49\xeb\x8b\xab\x81\x83\xf2\x4d\xc7\xb9\x18\x5f\x13\x59\x68\x72\x75\x31\x5d\x28\xd4\xaf\xc4\x00\x85\x41\x4a\xb1\x57\xdf\x02\xcd\xb8\x1e\x1f\x29\xf6\x48\xfe\xe7\xea\x43\x2b\x56\x32\x1d\xac\xd6\x12\x27\x26\x6c\x3b\x7f\xc0\x6f\x3a\xde\x96\x2a\x9e\x5c\xc5\x8b\xa5\x2a\xe4\xc1\x52\xc7\x97\xeb\x90\x99\xcc\xeb\xf8\xa1\xad\x4e\x40\xeb\x2c\x4b\x46\x8a\x1c\xe8\xe4\xd1\x82\xc7\xda\x23\x46\xa6\x22\x1f\x1f\x46\x9e\x21\x7b\xe5\xf2\x17\xbe\x32\xa4\xa5\x23\xaa\xf0\x47\x46\x63\x75\x86\x55\x61\x9d\x4a\xbd\x8a\xb1\x55\xd8\xaa\x52\xb1\x62\x80\x15\x96\xcb\x2a\x96\x8d\x32\x5e\x16\xa0\x1e\x98\x48\xb3\x05\x4e\xf8\xcd\xb5\x91\x09\x32\x06\xb0\xd9\x80\x03\x62\x15\x9f\x0c\x74\xfa\x06\xfe\x47\x26\xe8\x67\xf2\x7c\x76\xa6\x2f\x24\x2c\x4b\x78\xcb\x26\x16\xbc\x1e\x17\x0a\x28\x4c\x12\x7f\x7e\xc4\x33\xad\xd8\x57\x5e\x91\x93\x3d\xed\x5c\xea\x1c\x13\xe9\x93\x6d\x30\xf9\xae\x74\xbc\x7d\xf4\x12\xf6\x08\xf7\xda\x75\x3b\x64\xce\x5d\x0f\xff\xf6\xf1\xef\x10\xff\xee\x75\x90\x16\xe0\x6f\x1f\xff\x0e\xf1\xef\x1e\xfe\xed\x0d\xe0\xef\x20\xce\x37\x33\x72\x16\xa2\x48\x7d\xeb\x6c\xed\x9e\xd0\x78\xec\x0e\x15\x87\x1c\x10\x08\xe6\xe3\xe5\xf9\xc5\x1a\x2f\xf3\x34\x47\x05\x4a\x6f\x32\xf1\x61\x8d\x3e\x48\x12\x06\x38\x55\xd3\xe8\x01\xa2\xcb\x6f\xd3\xd9\x31\xd8\xda\xae\x3b\x96\x2d\x92\x4b\x3f\xbf\x8e\x2f\x99\xe5\x2f\x91\x02\xa7\xc7\x33\x3c\x8d\x9d\x27\x0b\x72\xd6\xc9\xa6\xe8\x1d\xc0\xee\xb7\xe0\x9e\x15\x4c\xf8\xd2\x71\x0a\xda\x37\x66\x86\x59\x24\x05\x9a\xcc\x85\x43\xe2\xe6\xa2\x6f\x49\xd5\x57\xf3\xf1\x6c\x7e\x4c\x2d\x80\x37\x37\xf3\xf7\x46\xd2\xe1\x66\x61\xda\x9c\x16\x3b\xa0\x8a\x6f\xf2\x0a\x07\x6c\x37\xcd\xcd\xe7\x6f\x37\x8c\xf5\x7e\x9c\xcd\xd1\x88\x11\xcc\x75\x21\xb3\xda\xc2\x5c\x0c\xf2\x5e\xb2\x52\x8b\xf1\xbb\x67\xc0\x80\x9c\xa7\x4e\x58\xb6\x8c\x84\x1f\xc0\x1c\x07\xa8\x08\x96\x8f\x1c\xc7\x31\x7f\xe6\x0d\x3e\x76\xe2\x5a\x2d\x40\xff\x17\xc3\xf3\x0c\x8e\x1f\x0e\x88\xd0\x86\x3e\x2c\x8e\xc9\x7b\xe0\x19\xbc\x99\xc7\xb0\x4b\x7f\x5b\xda\x07\x19\xae\x79\x58\x78\xa3\xd2\x38\x4b\xef\xde\xe8\x4b\x35\x37\x11\x05\x74\xa8\xe8\x41\x7f\x7e\xc7\x30\x64\xcf\x06\x29\x84\x93\x9d\x28\x4f\x07\xc9\x58\x0e\xfe\x93\x50\x79\x83\x75\xce\xe8\xc8\xe2\x8c\xb3\x37\x06\x56\x63\x67\x58\xf2\x7e\x52\x00\x8e\xd1\x74\xf4\xa1\x54\x18\x27\x43\xfc\xef\x02\x71\x2b\xe6\x6c\x16\x8e\xc9\xda\x03\x92\xb4\x17\x5a\xca\x7b\x80\xba\x05\x94\x42\x3e\x21\x9b\x68\x0d\xc8\x28\x3e\x71\x98\x67\xd2\xde\x86\x1f\xf2\x9d\x8a\xc9\x68\x67\xd2\x2e\x26\x97\xe8\x29\x05\x10\x53\x06\x99\xbd\xae\xc0\xf6\x05\xd9\xe3\x81\x6d\x97\x61\x4b\x76\x07\x60\xe2\x6f\xd8\x20\x3f\xcd\xa9\xe3\x0f\xc7\x65\xa8\x6f\x98\x01\xb0\x09\x47\xd2\x62\xeb\xee\x9f\x4e\x0c\xfc\x4c\x9b\x30\xc2\xcb\x88\xd0\x5a\x31\x69\x9f\xdd\xd0\x9a\xfb\xfc\x75\xb3\x35\x53\xa0\x75\x36\x24\x67\x64\xcf\x5e\x9a\x16\x51\x25\x37\x97\x65\xba\x72\x36\x43\x4a\x3c\x37\x70\x9a\xc2\x6b\x3b\xb3\x81\xa2\x39\xbf\x11\x4f\x39\xcb\xe1\xaf\x2c\x5c\x47\x66\x58\x8c\x8f\x3e\x11\x75\x4c\xc4\x8b\x47\x46\xde\xcc\xdf\x4b\x88\xc6\xca\x4e\xee\x96\x33\x85\x46\x70\x55\x88\x93\x23\x1a\x3a\xb2\xd0\x87\x32\xda\x57\xe7\xe2\x0d\x87\xc0\x24\xcd\x86\x1d\x29\x01\xa6\x0a\xdd\xea\x1a\x02\x52\x54\x85\x6b\xcb\x52\x22\x05\xed\x8b\xd3\xf2\x9f\x2a\x7c\x9b\x57\xa0\x8e\x40\xf0\x46\x5d\x8a\x67\x75\xd6\x49\x2e\xc9\x76\xa4\xe9\xc9\xa6\x43\x50\x52\xa1\x20\x28\xcb\x79\x2f\x3a\x64\xf7\x1f\x11\x39\xa7\x30\x33\x74\x8d\xa4\xb3\x6d\x51\x2f\x2f\xdc\x29\xee\xd5\x9d\xfc\x84\x98\xff\xf4\xf2\x9f\x7e\xfe\x33\xe8\x20\x8b\x91\x4f\x19\x0a\xae\x69\xd6\x14\x57\xe0\x9a\x1f\x92\x4b\xc7\x27\x72\x27\xc8\x9e\xe1\xf2\xdc\x22\xc4\x00\x7d\xbf\x78\x43\xc1\x80\xfc\xa2\x0f\xa9\xf2\x4d\x2d\x1b\x94\x94\x0d\x8c\x47\xa2\x3a\x43\xa8\xd2\x6a\x47\x25\x50\xf5\xd1\x53\x1f\x7d\xf5\x31\xe8\x08\x85\x45\xdb\x20\x90\x1d\xc0\xc9\xf7\xb3\x18\x23\xf3\xa4\x2b\xc3\x64\x9c\xf5\x8e\x73\x3b\x72\x33\x11\x0d\x3b\x10\xd4\x96\xac\x0d\x03\x0b\xb1\xd2\x30\xc2\x23\x5e\x48\x52\x51\x1d\x30\x35\xe8\xb8\x5c\x69\xb0\xce\xf0\xf5\xcf\x0a\xb3\x75\x4d\x1a\xa0\x85\xa7\x4f\x87\x56\xcb\x33\xd6\xf2\xd5\x5a\xbe\x5e\xcb\xa8\x6d\x5a\x04\xfa\x74\x6a\xb5\x02\x93\x1a\xea\xb9\x76\x76\x30\x1f\xfd\xe5\x2d\xd0\x74\x62\x38\x36\x9c\x71\xc4\xfe\x4b\x47\x15\x74\x03\xec\xe7\x77\x7c\x86\xd8\x0b\xcb\xbe\x8b\x73\x3c\x1e\x2d\x91\xd2\x3b\x16\x45\x59\xe9\xc4\x89\x45\x01\x93\x27\xa9\x6b\x5c\x21\x79\xfd\x2c\x29\xba\x5a\x64\xb6\x74\xb9\xeb\x67\x49\xa9\xd5\x22\xf3\xa2\x49\x5d\x3f\x4b\xfa\x2b\x32\xfe\xf9\xeb\xc2\x36\xfc\xf8\xb1\x69\x03\x40\xe4\x3c\x15\x39\xcf\x82\x9c\x5f\x81\x5c\x50\x8a\x9c\xbb\x22\x72\xbe\x8a\x9c\x6f\x41\x2e\xa8\x40\xce\x2d\x45\xce\x5b\x11\xb9\x40\x45\x2e\xb0\x20\xe7\x56\x20\xe7\x95\x22\xe7\x57\x22\x67\x24\xdd\xd7\x97\x68\x43\xb4\x00\xf3\xc7\x62\x01\x64\x27\x4b\xd7\xd0\x31\x64\x19\x4b\xcf\xf4\x05\xe6\x62\xe9\x9b\xbe\xc0\x40\x2c\x83\x3a\x4a\x14\xe3\x7a\xaa\xe0\x7d\xb8\x7c\x5a\xf4\xe4\x21\xad\x1d\xfd\xd4\x62\x58\x3e\xfa\xb1\xa5\xb8\x82\x94\x73\x4b\xbe\x84\xda\xf5\x28\x41\xac\x1f\x8e\x9d\x67\xc7\xce\xaf\xc6\x2e\x28\xc7\xce\x5d\x05\x3b\x5f\xc2\xce\xb7\x63\x17\x54\x63\xe7\x96\x63\xe7\xad\x82\x5d\x20\x61\x17\xd8\xb1\x73\xab\xb1\xf3\xca\xb1\xf3\xab\xb1\x2b\x52\x6b\xc6\x03\x5b\x9b\xe5\x12\xba\x0d\x1b\xd6\x91\x2e\xe4\x14\x96\x93\xba\xb9\x1a\x56\x55\x41\xf4\x09\x6c\xb2\x0f\x3b\x0a\xaf\xc3\xfd\xd9\xd3\xc0\x67\x1a\xe8\xb6\x49\x15\xcc\x25\x16\x21\x20\x2d\x98\xe3\x30\x53\x0d\x3f\x5a\xb0\x7c\x4f\x0e\xa6\xf0\x1e\x25\xc3\x4c\xe8\x88\x05\x90\xff\x95\x5d\x27\x60\x20\x53\xf8\xc0\xe7\x94\xc2\x5a\x82\x1a\x3f\xbf\xdd\x06\x5d\xf2\x1a\x3b\x47\xb4\x2e\xb8\x45\x3a\xc1\xbd\xe3\x0c\x47\xef\x84\x34\x9f\x43\xb9\x9c\x24\x80\x04\x01\xa2\x43\x61\x12\x7e\x2b\x6f\xc7\x04\x88\xe9\xb4\xb7\x0d\x4a\xec\xb7\x34\x6a\xea\x7e\x36\xb9\xcc\xe6\x2d\xf2\x1d\xaf\xf5\xa9\xce\xfe\xeb\xaf\x98\xcd\x8a\xdc\x24\xd8\xc6\xc3\x57\x34\x20\x51\xac\x0a\xd6\x23\xbf\xc3\xed\x12\xd6\x23\xb4\x1d\x91\x2c\x13\xd6\xa3\xb0\x93\x9b\x24\xac\x47\xe8\xc2\x08\x96\x0a\xeb\xb1\x07\xd6\x22\xfe\xad\xac\x45\x3e\xa5\x99\xc8\xbd\x19\x73\x7c\x42\xb3\x0c\xba\x12\xbe\x75\x98\x01\x05\x34\xef\xc0\xbd\xcc\x6c\x8a\x21\xd7\xe1\x1b\x21\x1a\x31\xef\x60\x5c\xc1\x8a\xfe\xf1\x87\x6c\x00\x20\x9c\x3e\xef\xd8\xb8\x03\x32\x14\x0a\xab\x8e\x04\x7c\x15\xc4\x37\xd0\x19\xa3\x5b\xba\x28\x20\xde\xc8\x10\xae\x46\x68\x2f\x86\xb4\xbe\xc5\x6f\x79\x72\x98\xc6\xcf\x0a\x66\x19\x7a\x56\x6d\xc3\x42\x95\xde\xbf\x5e\x8e\x7a\x08\x85\xbc\x5e\x83\x07\x0d\xeb\x38\x14\x5f\xe9\x63\x99\x21\x8a\xf8\xb2\xfb\xfe\xf2\xf9\xce\x5e\x7e\xd9\x44\x9f\x8d\x37\x58\x83\x05\x35\xcf\x83\x65\xc5\x6f\xb1\x96\x19\x61\x42\x64\x15\x1b\x18\x94\x08\x32\xfd\xfb\x94\x05\xe4\xe1\x1a\x54\x74\x2a\x10\xbc\x0e\xf5\x7e\xe4\xf5\x1a\x0f\x30\xb9\xee\x84\x4e\xcb\xf3\x7b\xce\x60\x4c\x96\x5e\x19\xc0\xf1\x7b\x03\xbc\x83\x1f\x56\x05\xf7\x76\xf7\xe5\xf6\xdb\x9f\xf6\x8e\x8e\x5f\xbc\x7d\x71\xb4\xb3\xeb\x6c\x61\x68\x83\x65\x32\x25\xb2\x52\x76\x09\xb7\x66\x53\x70\x86\xe3\x8a\x18\x20\x43\xb2\x8a\xf3\xbe\x1b\x61\xee\xec\xd6\x82\xc9\xd8\x69\x01\xa6\x74\x29\xa8\x99\x1c\x89\x47\x33\x45\x19\x2e\x09\xf3\xd9\xa4\xe8\x6e\xa3\xdb\xf7\xd5\x1c\x0d\x1e\x44\x8e\x0f\xb9\x88\x52\x5c\xea\x9d\xa0\x7b\x98\x03\x32\xfe\x19\x8c\x3a\xd9\xc4\xae\x98\x9b\x00\xb0\x00\x07\x0a\x23\x68\x05\xe4\xd3\xfc\x81\xec\x14\xeb\x48\xba\x1c\xd7\xb6\xbc\xa3\x16\xb0\xc5\xed\x62\x41\x61\x33\xf2\x1b\x4f\x6d\x93\x61\x42\x9f\xda\x63\x4a\xb8\x03\xe9\x01\xf2\x70\xa1\x67\x2c\xcb\x3d\x43\x09\xa6\x4e\xeb\x08\x5b\x49\x26\x6d\xac\x33\x34\x0d\x5e\xcd\x31\x50\xdb\xe2\x71\x44\xe9\x84\x7e\x04\x12\xe1\xbd\x03\x42\x19\x96\xf5\x09\xce\x15\xe3\xa1\xfd\xbb\x2a\x25\x14\x0b\xa8\xd2\x82\xbc\xc7\xf3\xab\xe7\x35\xba\x4d\xef\xd2\x61\x9e\xcd\x5b\xec\xf2\x0c\x87\xb0\xe3\xfc\x4e\x08\x60\x9d\xfc\xc9\x79\xe3\x47\xc5\xf4\x40\x99\x6f\x15\xd2\xba\x12\x16\x8a\x49\x0e\xba\x06\x40\x4e\x1c\x42\xeb\xb3\x1b\x67\x75\xad\x1a\x64\x0f\x5d\x42\xab\x49\x4f\x96\x85\xf8\x40\x4f\x77\x4b\x4f\x3b\xd9\x5d\xd1\x93\x80\x74\x3b\x7a\x52\xf9\xf4\x0a\xf4\x74\x30\x1d\x2f\xc7\xc9\x84\x6c\x3d\x0b\x27\x01\x0f\x94\xc9\x0d\xc3\x30\x65\xc3\x51\x4d\x4b\x7c\xd7\xb8\x06\xaf\xbd\x17\x10\x8d\x79\x97\xfa\xaa\x61\x98\xe6\x9c\xd3\xcd\xe6\x32\x9d\xa2\x75\x35\xba\xf9\x71\xaa\x15\x9b\xcc\xda\x97\x41\xb2\x77\x46\x56\xad\xe2\x07\x13\xa7\x58\x91\xe0\xc6\x64\x8a\x65\x2a\x13\xd3\x24\x91\x8b\x41\x45\xbd\x75\x79\x09\xb4\x80\xa3\xc5\xb3\x4d\x2f\x0c\xd7\x0c\x30\xc4\x9b\xe2\x27\xdf\x14\x29\x0d\x16\x4f\xc5\x4b\x90\x9c\xa9\x61\x3d\xc1\x82\x4e\x7b\x62\xd2\xdd\x50\xfa\xce\x49\x6a\x33\x27\xaf\x0d\x53\x49\x6a\x47\x83\xb6\x32\xc6\xb3\xf8\x98\x12\x3a\xf5\x00\x30\xf5\x03\xed\x8b\x5a\xb5\x17\x0e\xda\xe8\xa8\x7c\x18\x63\x39\x2c\x44\x4b\xa8\x3d\xbb\x25\xf9\xb0\x25\x58\xc4\x4d\x99\xe1\x79\x1d\x23\x2a\x6a\x54\x04\xde\xfe\xce\x00\x65\x2f\xb5\x84\x45\x1e\x43\xd0\x34\xd3\x05\xf7\x76\xd6\x01\xbf\x22\xa7\x7d\x98\x4b\x42\x2a\x84\x9c\x97\xd9\x13\x36\x1c\x93\xd9\x3b\x85\x29\x4b\xf7\x52\xc7\xcd\xc6\x1a\xe3\x69\x20\xe6\xd4\xbd\x85\xf1\x14\xc2\xa2\x72\x96\x92\xa5\x0d\x36\xbd\x3f\x16\xcc\x15\x06\x80\x32\x65\x27\xf0\x06\xde\x06\x6b\x40\x02\x5f\x63\xe7\x92\xf8\x93\x80\x45\x83\x66\xb1\x60\x04\xe0\x86\x7d\x7b\x6e\x92\x77\x7e\x93\x93\x07\x83\xdf\x7a\x04\x6d\x3e\x52\xc9\xa4\x4e\xbd\x0f\x1c\x73\x2b\x85\xb1\x92\xeb\x5a\x94\x57\x3a\x74\x16\xee\x01\x91\xe8\x0d\xb3\x9f\xe0\x17\xb9\xba\x89\xa6\x68\x7b\x4e\xf2\x3e\x19\x4f\xc0\x36\x96\x9a\x21\x2e\xec\xdb\xe2\x5b\xde\x99\xda\x54\xb5\x37\x9e\xb2\x8d\xaf\x74\x9f\x62\x70\xd5\x7d\xe6\xe5\x6c\xc9\xbc\xa3\x69\xd0\x34\x0a\x29\xdf\x35\xc0\xa9\x3a\x23\x0b\x8a\x8c\xd5\xfb\x8c\xb0\xd1\x04\x92\xa0\x2f\xe7\x57\xf8\x0c\x7e\x2f\x49\xfa\x84\x2c\x97\xac\xd6\x3e\x53\x97\x7a\x11\x8d\xfb\xa2\x61\x0a\xfc\xbe\x29\x99\x8f\x64\xab\x3e\x11\x8b\x2a\x4d\xa9\x5f\x54\xac\x26\x7f\x5e\xb4\x3e\xfd\xef\xe5\x73\x71\x85\x85\x8a\x36\xa8\x25\x00\xca\x57\x4b\xd5\x45\x49\x03\x86\x8c\xf1\x90\x00\x55\xb6\xe0\xb2\x94\xc5\xcb\xe4\x9c\x7a\x4f\x9a\x10\xe3\xe2\x2b\xd6\xae\x54\x36\x7b\x7e\xef\x69\xe0\xcb\x9f\xa8\x4a\xc4\xf4\x45\x93\x83\x48\x11\xe5\xab\x2a\xff\xae\x3b\x7e\x9d\xb3\xd3\xc2\xa8\xca\x4e\xaa\x15\xd9\xf6\xb5\xc9\x4f\x2d\x6c\xa4\x4f\xcf\x33\x49\x28\x60\x89\xb6\x12\xe7\x1c\xb5\xc6\x20\x64\xd6\x58\x8a\x5c\x84\xdd\x9a\x72\x7c\x30\xc0\x00\x5f\xd6\x20\x34\xb1\x75\x6d\xe8\xd0\x27\x38\x2c\x31\x6b\xef\xa2\xca\x53\xd3\x91\x17\x64\x5b\xeb\x2a\x53\xea\xad\x5b\xfd\xa6\x70\x41\xf2\x4f\x8b\x6c\x42\x86\x86\x36\x7c\xb2\x84\x38\x5a\xef\x6e\x5a\x36\x73\x6d\x49\xfb\x8c\xe2\x22\x04\x90\x41\x56\xfa\xc8\x6a\x1e\xc6\x66\xe3\x55\xb2\x58\x00\x9b\x00\xbd\x4f\xaa\x78\xcc\xd5\x37\x0e\x63\xa0\x4e\xb2\x39\x1e\xb8\x60\x57\xb3\x43\x2a\x5f\xe4\x7a\x6e\x3f\x76\x9f\x51\x62\xa3\x6e\x43\x8a\x91\x93\xcc\xd8\x8a\x37\x2c\x65\x76\xa3\x79\x10\x30\xf3\x3c\x88\x8b\x1b\x8a\x62\xc7\xb1\x5f\xe0\x14\x02\x2a\x59\x2c\x3d\x19\xd9\xaf\x17\xfa\xaf\xdd\xe7\xdc\x0a\x6d\xf5\xa6\xa8\xe3\x94\xde\x18\x95\xcf\x1e\x0b\xde\xf4\x95\xed\x19\xae\x8f\xca\xae\xde\x54\x1d\x36\xf9\x9d\x11\x96\xb3\x8a\x2a\x1b\x65\x28\xa6\x7c\x96\x6d\xf0\x03\xff\xc9\x60\x4c\x0d\xb6\xd5\x13\x0d\x95\x4e\x30\x8c\xb5\xa4\x99\xf6\x9c\x56\xe0\xab\x8a\x69\x49\xe1\x7b\x82\xf8\x69\x6a\x5f\xfd\x65\x89\x47\xc8\x9e\x61\xaf\x35\xed\xb0\x5c\x44\x84\xf8\x3e\x70\xdc\x32\x09\x88\xc5\xed\x0d\x8f\x37\xb9\x75\x15\x17\x1a\xbf\xf9\xe6\xd1\x68\x72\xb5\x38\x7f\x54\x6f\x9b\xa3\x50\x6c\x1b\x9d\x18\x66\x32\x54\x65\xf3\x8a\xe7\x5a\xcc\x6a\x7a\x29\xdf\x96\xca\xca\xf3\xb7\x17\xf4\xec\xbb\xbd\xc6\x7e\x40\x1c\xe6\x2a\x0a\x51\x3c\x76\xb0\x5e\x81\x4a\x9e\x1a\x1d\x83\xd9\x41\xbb\xe0\x1c\xcc\xde\xcb\x4a\xef\xdf\x6b\xaa\x28\x2f\x78\x72\xae\x5c\xbe\x6e\xbc\x9b\x6e\xa9\x3d\x32\x2a\x04\xf5\xcc\x32\xb9\x82\x1f\xa9\xfa\x13\xec\x87\x7c\xa6\xf8\x76\x87\x7a\xd8\xed\x67\xdb\x05\x55\x34\xe7\x28\xe3\xf7\xd4\x6b\x67\x15\xcd\x73\x0e\xa3\x54\x57\x28\xea\x72\x45\x93\x54\x6f\x25\x8d\xb3\x98\xce\xfc\x80\xf4\x9f\x39\x9d\xb9\x26\xb8\xe1\x74\x1a\x15\xbf\x35\xa7\x53\xd4\xbd\xc5\x74\x96\x29\x7c\xeb\x5d\x1d\x7c\xd2\xe9\xbc\xf5\x74\x95\x2c\x81\x8a\xf9\xd2\xf5\xa6\x25\x93\x44\x37\x13\xa1\xe7\x1d\x9a\xc4\x3a\x66\x75\xfd\x1e\xfc\xed\xdf\x6f\x14\xfd\x3e\x8d\x5b\x04\xdb\x31\x69\x5c\xe9\xed\xf3\x84\xe0\x04\x29\x4f\x6c\x77\xad\xcf\xd0\x6e\xe0\x2d\xef\x3c\xd8\x47\xd9\x82\x0f\xe8\x2a\x36\x65\x07\x81\xba\x05\x62\x78\xaa\xfa\xe5\x53\xf8\xda\x2e\x21\xee\x44\x37\xca\x38\x0a\xa9\x99\x89\x69\xb1\x34\xa1\xcc\x1d\xf4\x8a\x3c\x17\xf7\x9e\x22\x78\xda\xc4\x2b\x11\xfe\x73\xc9\xd5\x18\x94\x4a\x93\x25\x33\xea\x5e\xd3\xeb\x14\x60\x68\x34\x4b\xa5\x23\xa1\x15\x61\xc2\xd2\x8c\xcb\x48\x8e\xca\x09\xcd\x37\x24\xcc\x2e\x0b\x80\xb0\x9f\x1f\x20\x89\x25\x22\x49\xf1\xc3\x40\x9e\x8b\x1a\xc8\x15\x17\x86\xb9\x20\x86\x01\x85\x99\x61\xa2\x0e\xf5\x06\xc0\xf4\x78\xd8\x05\xe1\xda\xa0\xcb\xb2\xf2\x64\x48\x2a\xc0\x82\x99\xbc\x0a\xc5\x6a\x0b\x6d\x74\x80\x2d\xdc\x90\xc8\x0b\xc9\x62\x38\x9b\x0b\xb1\x42\x93\x23\x5e\x59\xcc\x59\x7f\x3a\x3a\xc6\xf3\x32\x23\x3a\x83\x8f\xf6\x6c\x8e\xfd\xca\x15\xdd\x1d\x47\xe9\x57\x27\x6f\xd6\x24\xf4\x33\x3c\x64\x5f\x97\x96\x3e\xba\x46\xcc\x8e\xb3\x8b\x0c\xa5\x70\xdc\x5d\x29\x09\xb0\xab\x28\x3c\xed\xa3\x43\x3b\xbe\xb6\x29\x88\x2e\xe7\xd9\x7b\x1e\x76\x9e\x32\x53\x9a\x4f\x9e\xe3\x2d\x4c\x01\xad\x1d\x90\x3d\x77\x2a\xd7\x6d\x9a\xd5\x58\xb7\x62\x9f\x7a\x58\xb7\x0f\xeb\xf6\x16\xeb\xf6\x36\xab\x03\x2d\x84\x49\xb5\xc6\x6b\xc3\x88\x09\xa3\x68\xe4\x22\x84\x9b\x58\x39\x80\xec\x41\xd6\x2a\x9f\xa7\xe6\x6c\xc7\x88\xd9\x69\x3e\x34\x03\x08\x15\xc1\x96\x0e\xb0\x85\xf1\xec\x6a\x51\x9f\x79\x88\xc1\xaa\xcb\x1e\x04\x29\xf1\x6e\xb4\xac\xb8\x37\xf2\x80\x42\x11\x89\x4b\x4b\x26\x0f\xff\xf3\xd9\x8c\x50\xc0\xc5\xf8\x1a\x64\x21\x43\xff\xd0\x13\xf4\xbd\xd5\x1d\x76\xc0\x3a\x5c\x04\x9e\x93\x1d\xa4\x63\x23\xd2\x29\x9c\x74\x16\x57\x83\x05\xc4\x42\x9e\x2e\x8d\x2a\x06\x4d\xb4\x93\xb2\x7a\xd4\x8c\x25\x22\xa3\xa4\xcd\x4a\xb5\xaa\x1f\x76\x36\x13\x56\xa6\x18\x49\xf9\x6a\x33\x46\x4a\x82\xff\x70\x02\x73\xeb\xf1\xf1\x19\x38\xa7\x63\xbd\x37\xe3\xb3\x7a\xf1\x9d\xb8\x09\xf4\xb3\xed\x0d\x43\x40\x13\x49\xb4\x7d\x95\xa4\x29\x4c\x60\x85\x02\xe4\x12\xb3\x5c\x6d\xaf\xd1\x7f\xcd\xea\x8f\x57\xcf\xb7\x4f\x9c\xff\x27\x7a\xda\x75\x2e\x19\xd0\x05\xd3\xe5\x99\x60\xbe\xfa\x75\xb8\xe8\xa2\x9c\x4c\x4a\xaf\xf1\xa7\x12\xd9\x98\x60\xca\xae\x9f\xaf\x16\x3c\x74\xbe\x08\x84\xc2\xcc\x95\x31\x6e\xb2\xc0\xa3\x91\xfd\x15\x42\x96\x6f\x9f\x01\xad\x52\x43\x1b\x55\x8f\xc7\x42\x40\x49\xf7\x91\x08\x68\x21\x82\x59\xc2\xa0\x60\x38\xcb\x7b\x3e\x36\x8b\xc3\x97\x18\x57\xf8\x95\x5f\xaf\x75\xb4\xb8\x59\xca\x05\x73\x92\xae\x17\x52\x88\xae\xc8\x40\x44\x35\x1a\xeb\x64\x53\x1a\x2f\x5b\xcc\x10\xc8\xdf\xc1\x69\x07\xfd\x8a\x0c\x8e\x99\x8c\x60\x0b\x40\xe9\x8b\x27\x34\xe5\xb4\x88\xb0\xf2\x77\xad\x80\xa9\x59\x7a\x2f\xc4\xdb\x2d\x86\x5e\xa0\x99\xde\xf0\x2b\xd0\x0b\x46\x40\x51\xb0\xc8\x7d\x5d\x0a\xef\x99\x83\x4b\xe1\x3d\xba\xb5\x28\x6f\x2b\x31\x2b\x45\x6a\x51\x1e\xbe\x20\x67\x3f\x6a\x9b\xe4\xd5\x63\x9b\x5b\xbe\x0c\x9d\x86\xb9\x97\xde\x94\x48\xaf\x1a\x76\xa4\x69\xb1\xca\xf8\xe1\x5f\x06\xdd\x51\x51\x32\x99\x21\x6c\xa5\xa9\x79\x10\x70\xae\xe1\x7e\x35\x59\x72\x98\xb5\x35\x30\xaf\xa7\x97\x82\xa1\xe0\x92\x3d\x4f\x52\x1a\xc8\x88\x2d\xd4\x4f\xc3\x65\xae\xa6\x3a\x9f\xf9\x24\x1c\x01\x9b\xad\x71\xe5\x8e\xe5\x74\x96\x60\xe2\x03\xe0\x06\x2d\x27\x2e\x96\x16\x31\xc6\x80\x75\x20\x99\x03\x3e\x57\xaf\xe9\x5c\xbc\x7e\xd3\x52\x97\xf3\x13\x88\x38\x84\x2e\x66\x67\xcc\x19\xcc\xe4\x49\x4c\x05\x07\x3b\xc5\xe4\xe0\x36\xf5\x35\x58\xd0\x07\x35\xd4\xf3\xff\x19\x17\x41\x26\x17\x85\xa7\xb5\x43\x8e\x96\xdd\x03\xdd\x9d\xd1\x3f\x68\xa5\x3e\x52\xf5\xe0\xa9\x38\xad\xd1\x4b\x11\x3c\x49\x48\xdd\x21\x32\x8b\x0d\x0a\x9b\xab\x8f\x82\xbe\x00\x96\x2e\x6c\x14\x21\x22\xc9\x97\xf9\x11\xb0\x01\x90\x0c\x27\x35\x02\x07\x4c\x11\xb3\x8e\x76\xaa\xa3\x6d\x17\x9a\xf8\xa8\x78\x23\x34\x30\x0c\xa4\x13\xad\x7e\xdc\x96\xac\x0f\xcb\x6d\x00\x4b\x02\x9c\xa9\xf6\xa1\x1b\x16\x79\x1f\x46\x40\x51\x8b\x6a\x8a\x5d\xf8\x7e\x81\xb6\x9f\x16\xfc\xf3\x89\xb5\x45\x7b\x93\x6c\x49\xb9\xa4\xad\x1a\x97\x58\x4f\x0c\x00\x15\xb7\x44\xd4\x68\xe0\xa9\x5c\xbb\x9b\x31\x4b\xfb\x4f\xbf\x2d\x6f\x5e\xb5\x5e\x69\x13\xf2\x68\x8c\x81\x50\xb5\x58\xce\x32\xcf\xb3\xec\x12\x94\x27\x93\x0c\xb8\xe0\x6c\xca\x57\x00\xcb\xf2\x41\x2d\x41\x71\xbf\x46\x86\x5b\xe4\x5b\xf9\xf9\xe6\x62\x3c\xa5\x46\xa2\xec\x10\xef\x95\xef\xc4\xac\x12\x9d\x3e\x09\x7f\x4a\x48\x17\x68\x7f\x4c\x8f\xbc\x84\x00\xfe\xf1\x0f\xa3\x3e\x5e\x0f\xd4\xf1\x6a\x25\x5d\x46\x8e\x89\xaa\x4c\xb1\x9e\xcf\xf5\x66\xeb\x5e\x49\xdb\x45\xd2\x5c\x24\x11\x86\xd2\xec\x95\x81\xa0\x79\x73\x77\x4b\xc8\x70\x65\x4e\x04\x0d\x4c\xf7\x65\x13\xb9\x50\x5e\x67\xa6\x5f\x28\x81\xe3\xef\x4a\x1d\x04\xbf\x8a\xa7\x36\x82\xb6\x53\xf2\x4a\x97\xf1\xf7\xb7\xac\xee\x17\x6f\x73\x58\x05\x58\x62\x15\x33\x40\xe5\x23\x53\x7b\x55\x96\x7f\xb7\xb4\x54\x40\xd3\xbb\x52\x33\x14\x08\x5b\x02\xe6\x32\xb8\x05\x8f\xb8\x68\x80\xa2\x66\x86\xb9\xf4\xca\x44\x0f\x49\x14\x95\x9c\xbc\x61\x1b\x9d\x27\x1f\xa4\x57\x6b\x15\x95\x25\xd7\x0f\xea\x80\x2e\x84\x94\x3a\xb5\xf3\x8b\x47\xcc\xf0\xc0\xb8\x20\xad\x0f\xeb\xb3\x60\x8e\x6b\x03\xb4\x48\x26\x14\x7b\xfc\x81\xc0\x50\x25\x99\xd0\xf0\xa3\x19\x64\x49\xc6\x81\xe2\x1c\xc3\x08\x90\x5f\xa5\xe6\x72\xbe\x64\x39\x58\x8c\xb5\x5a\x4f\xae\x59\xa5\x67\xe5\xf2\xcd\xf0\x3c\xbb\x58\x0d\xae\x59\xe0\x64\x2a\x73\xb4\x98\x1e\x49\xf0\x8c\x20\x68\x4e\xc6\x8f\x79\xce\x46\x7a\x8a\xa1\x22\x16\x7f\xab\x8b\x61\xe4\x68\xf6\x9e\x54\x57\x64\x58\x9a\xed\x8e\x1b\x53\xa2\xc5\x27\xb5\xfe\xb3\xbb\xad\xbe\xa2\x55\x54\xe7\x55\xf1\xb2\xa6\x3d\x4c\xb5\x8b\x95\x8a\x5a\xf5\xb1\x4e\x78\x37\xc9\xf8\x68\x76\xa2\x90\xf5\x8b\x0d\xee\xe5\x6c\xb1\x18\x83\x0d\xbd\x75\xc5\xde\xda\xb9\x29\x1f\xa8\xa2\x3d\x28\xfd\xc6\x4f\xe0\xbf\x17\xa0\xe4\xea\x73\x58\xc1\xeb\xd2\xef\xdc\xe1\xc9\x58\x89\xac\xb4\x75\xd5\x2f\xca\x58\x4c\xf3\x94\x32\x17\x82\x65\xbc\x8e\x7f\x57\x14\x14\xab\x72\xbd\xe8\xce\x65\xae\xc1\x44\x78\xdd\x32\xc1\x5c\x58\xc8\xf5\xea\xd1\x99\x1c\xf9\xbb\xe6\x0a\x12\x0b\x0f\xcd\x25\xc4\xc2\x91\x80\xd2\x77\x6b\x47\x97\xd9\xf4\xe4\xe4\xb0\x50\xed\x63\x43\xa3\x08\x3a\xc5\x66\xc1\xeb\x62\x7c\x7d\x30\x55\xcb\xd5\x36\x3d\xa2\xab\x78\xd1\x6c\x19\xdb\xd7\x4d\x91\x95\x68\xbe\x81\x16\x6e\x02\x87\xba\x6a\x83\x6d\xee\x95\x81\xbb\x02\xfe\x86\xac\x3a\xda\x1a\x2f\x81\x83\x49\xc0\x16\x34\x03\x18\x66\x8f\xcb\x72\x2f\xca\x1c\xe3\xe9\x8c\xbe\xd1\x18\x20\x58\xde\x2c\x1c\xed\x7e\x74\xc5\x25\x4d\x91\x17\xd7\x74\x6c\x6d\x3f\x76\x1e\x3d\xda\xa8\x5f\x7e\x6d\x39\xa3\xf9\x86\x6c\xae\x1c\x15\xb5\x2c\xa4\x6a\x25\x4c\x5e\x51\x26\x4e\x31\x36\x36\xab\xaa\xbc\x04\xc4\xe9\x40\x72\xcd\xeb\xac\xf1\x49\xbc\xe1\xc7\xde\x7a\x36\x3b\x94\xca\xaa\xee\x35\x68\x3b\x78\xb5\x21\x7e\x9a\x6f\x37\x58\xcf\x4d\xc4\x69\x02\xcd\x8a\x8b\x54\xc6\xb8\x7b\xa9\x83\x58\x7e\xdd\x21\x56\x5d\x62\x5f\x72\x53\xde\xcc\x13\x88\x82\x42\xf6\x7f\xd8\x5e\xea\x2e\x43\x79\x5b\xd0\x36\x31\x49\xfc\xa9\xbb\x27\x9a\x96\xdf\x2a\xc8\xdd\x95\xe1\xe0\x8c\xb6\x7d\xc2\xc9\xdb\x42\xc8\x12\x75\xd9\x78\xa3\x42\xdf\xa2\x78\x6d\xee\x5b\xa1\x96\x91\x23\x2d\x29\x4b\x30\xff\x62\x0a\xd4\x08\xe2\xae\x56\x01\xde\x99\x8e\xb1\xd8\xdf\xe2\x21\x16\x8a\x5b\x55\x2d\x1f\xa4\x68\x35\x05\xed\xfd\x1b\xf7\x3a\x0a\x62\x2f\x1e\x76\x31\xb1\x41\x1c\xc5\x61\x34\x8a\x46\x67\x6d\xae\x8a\x47\xd0\xfc\x21\xef\x47\xbb\xd1\x65\xac\x8c\x82\x75\x2c\x2c\x87\x2f\x51\x37\x67\x64\x34\xac\x4d\xf3\x3d\xaf\x6c\x8d\xc9\xfe\x4a\x4d\x85\x47\xbe\x4e\x72\x3a\x5d\x79\xc9\xa8\x31\x1b\xf8\x82\x5e\x61\x0d\xdf\x6d\x00\x87\xa2\x30\xaa\x2d\x3d\x82\xd6\x22\x6b\x29\x0b\xb5\xe4\x62\x92\x94\x95\xd7\x5c\x5e\xcd\xe8\x95\x00\xc5\x1d\x1a\xc3\xab\x62\xd1\x51\xc2\x28\x20\x53\xa6\x5e\x2d\x83\xc8\x2f\xe3\xa4\xc3\x30\x4b\x0a\x51\x00\x77\x9a\x2d\x96\xd4\xb6\x81\xac\x97\xe2\x02\xd5\x60\xbe\x71\xcf\x40\xb7\x92\xaf\x3d\xd0\xd6\xe9\x65\x3c\x56\x86\xaf\x49\x9b\x0a\x6a\xf7\x9a\x5e\x60\x14\x5b\xd6\x38\x4c\x61\xf1\x6b\x2d\x32\x53\x1e\x1f\x2e\x95\xdb\xe5\x86\x1c\x08\x11\xaf\x82\x72\x18\x36\x0b\x1e\x09\xfe\x25\x36\xe0\x76\x8a\xd9\x9e\xd4\xe2\xca\xad\x8e\x17\xd7\x63\x14\xc6\xa3\x91\xe5\x18\xd4\x91\x4e\x27\xaa\x68\x5e\x7a\x57\xc4\x17\xe1\x87\x39\x38\x91\xce\xe9\x5d\x23\x6d\x5e\x56\x99\x40\x2a\x6a\x22\x42\x4b\x5e\x69\xa5\xab\x57\x71\xf5\x29\xb8\xb2\xe5\x0e\x3f\xa6\x4f\x75\x1d\x48\x56\xbe\xec\x11\x42\x0f\x97\xf1\xcb\xa4\x7a\xae\x23\x90\x7b\xcb\x3a\x4b\x1d\x42\xa7\x29\xa5\x1a\x71\xc0\xc8\x2f\x76\x0c\x07\xa7\xb2\x10\x51\xbf\x17\xae\xc7\x09\x42\xeb\x05\x51\xcd\x76\x8d\xa3\x0f\x10\xbb\x76\x80\x79\x63\xde\x74\xb7\xf1\x50\xcd\x95\x4f\x86\xa3\x4e\x89\xf7\x39\x6b\x9a\xda\xa0\xb0\xdf\xb9\xdf\xf9\xda\x97\x18\x76\x88\x6f\x61\x5b\x7f\xee\x06\x06\xcb\xd2\xac\x51\x29\x2e\x2b\xe1\x5f\x59\xd4\x46\x28\xae\x96\x96\x53\xd8\xfd\x35\x98\x07\xa9\xd1\xd5\x09\x9f\xb4\xf1\x8e\x58\x6d\x16\x69\xa0\x44\xd9\x61\x70\x8e\xb5\x7b\xb1\xae\x16\x42\xa7\x51\xf4\x9c\x5d\x93\xfd\xba\x14\xdd\x60\x96\x3b\x9f\x98\x02\xa0\x19\x7d\x56\x0b\x62\x49\xee\x99\x21\x02\x24\xb0\xce\xae\x22\x99\x6c\x63\xff\x72\x98\x78\x05\x6c\xb3\x37\x02\x8e\x2b\x1c\x73\x6d\xfb\x51\xfd\xed\xb4\x6c\xd3\x56\xf6\xd7\x62\x41\xae\x5a\x34\x7c\x02\xb2\x12\x7d\x5b\x23\x04\x56\x2c\x22\xe9\x08\x19\xbd\x14\xcb\x50\xad\x60\x0e\x08\x2f\x44\xad\xd7\x42\x72\x49\xf6\xca\x50\x58\xd2\x05\xea\x16\xd6\x86\xd2\x92\x5e\x10\x48\x6f\x64\x38\xae\x7d\xac\x7d\x6c\x61\xf7\xd0\x0b\x31\x71\x42\xf1\x25\x5f\xcb\xdc\xdf\xf6\x24\x13\x80\xd8\xa1\xb4\x8b\x26\xe9\x11\x53\x7b\x7f\x89\xfb\x94\x16\xa0\x45\x44\x3a\xfe\x04\x7b\x53\x1e\x55\xb9\x9a\x4d\x73\xef\x79\x03\x9b\xe6\x64\xc7\xc2\x28\x48\x1e\xf5\x2b\xb3\xec\xbb\x46\x51\xdf\x97\xee\x70\x4b\xb1\xc6\x2e\xb0\x44\x18\xf8\x04\xbb\x0a\xd3\x38\x48\xaa\x05\x79\x31\x69\x80\xe5\x9d\x82\xdd\x7e\xe3\xf9\x55\x46\x3e\xe7\x26\xa6\xe6\x18\xa7\x30\xd8\x48\x48\x93\xa7\x6c\x62\x4a\xd4\x45\x3a\x2c\xf9\xde\x24\x31\x19\x45\xe1\x63\xdc\x26\x44\x13\x8d\xb5\x31\x46\xb6\xa6\x8f\x95\x7a\xff\x82\x3a\xa6\x64\xb1\xb8\xba\x20\x95\x94\xab\xc3\x64\x02\x01\xa0\x6e\xa4\xfd\x4e\x39\x90\x11\xca\xc0\xb4\x95\x69\x33\x55\x6a\x35\xdb\x5b\x2b\xb9\x15\xa9\x79\x68\x02\xc6\x85\x26\xea\xa0\xe6\x68\xca\xeb\xed\xfa\xa2\xab\x69\x53\x58\x7f\xa2\xc4\x5d\x20\x79\xaa\x42\x3a\xe2\x54\xe0\x08\xe2\xb7\xc4\xb9\x29\xe8\x94\xe4\xd5\xc3\x2a\x9b\x52\x79\xb3\xd8\x35\xf2\x22\xac\x08\x61\xc3\x6d\x42\x28\x7b\x2a\x2e\x55\xfd\x62\xa3\x5c\x3b\xca\xa0\x95\x28\x45\xeb\xdd\x90\x3c\x37\x9b\x48\x54\x5d\x99\xfc\x9a\x8e\xf0\xbe\x84\xfe\x5b\x7e\x59\x52\x65\x85\x51\xbc\x30\x79\x4e\xa1\x43\x2b\xf5\xee\x49\x76\x00\x3c\xde\xe9\x43\x63\xb0\x96\xc1\x63\x88\x2a\x0c\x2e\x59\xbc\xa0\xfa\xea\x58\x5e\x83\x59\x5e\xb0\x07\x94\x53\xa0\x19\x04\x5c\xee\x15\x92\x07\x2a\xcf\xa8\x6d\xc5\x78\xca\x2c\x79\x99\x1d\x00\x33\x99\x79\x97\x4d\xd1\x98\xb7\x1c\x9a\x88\x52\x6e\x01\x46\x43\x67\x97\xc3\x2a\xea\x0c\x50\x85\x25\x09\x49\x5b\x4e\x1c\xa2\xc9\x31\x7e\xe0\xf6\xb3\x07\x23\x67\x76\x31\x06\x19\xa1\x03\xca\x17\xf8\xf4\x61\x3c\x99\x80\x2f\x0b\x6f\x90\x30\x4e\x32\xe2\xb3\x8b\xc9\xcd\x1d\x1d\xee\xa9\xd5\x04\x1b\xa6\x0e\xe9\x5e\x07\xa7\x14\x1a\xff\x04\x5c\x88\x4e\x72\x5a\x64\x41\x12\x35\x92\x6d\x3c\x23\x27\xad\xac\xf5\x88\x47\xa3\x7a\xd4\x61\x89\x3b\x3a\xcc\x7c\xcb\x22\x16\xdd\x11\xf4\x0e\xe1\x35\x64\x38\xe0\xcf\x23\xfb\x99\x99\x82\x91\xb9\x1b\xa7\xe6\x0e\x27\xd1\x0e\xa3\x2e\xaa\xd8\x34\x1b\xf5\xd3\x69\x66\xb3\x6c\x51\x54\x7f\x63\xbd\x4a\x2a\x28\x91\x29\x9c\x56\x1c\x3e\xed\xd5\x88\xa0\x47\x97\xb6\xb4\xae\x6d\x79\x9b\x69\xe2\x81\x5c\x81\x2b\x62\xdc\xe5\x69\x90\xd9\x42\xfa\x08\xf7\x52\x98\x37\x54\x1a\x0f\xc4\xdf\x18\xb0\x16\x68\x73\x59\x8e\x01\xda\x4d\x17\xd4\xe4\x22\x19\x34\x53\x90\xf3\x64\xb2\x7c\xcc\xc1\x05\x4a\x1f\x01\x25\x35\x74\x9e\xc2\xd9\xec\x2c\x75\xcc\x44\xa9\x86\x87\xf1\xfc\x48\x2d\xa4\xe8\xd5\xb4\xda\x26\xcd\x80\xa2\xe2\x4e\x19\x5f\xe6\x2c\x4f\x63\xc9\x1d\x01\xcb\x22\x7e\xad\xae\x0f\x37\x44\x89\x13\x0a\x71\xf3\x37\x93\x86\xeb\x1e\xf5\xe3\x84\x56\xc9\x3e\x41\x36\x3a\x6e\x41\x69\xda\x85\x73\x29\x8f\x33\xdb\xf2\x2d\xae\x91\x56\xdc\xd0\x61\xbb\xf3\x03\xc1\x74\x5d\xda\x9e\x57\x57\x8f\x33\x97\xaa\x26\xfb\xf2\xe7\xa1\x2f\x2f\x95\x0e\x1e\xd4\xe6\x75\x85\x78\xc3\xe2\xab\xa5\x49\x7e\xc4\x87\xfa\xd1\x8a\x1a\xec\xdb\x37\x0c\x6a\x87\xfb\xd5\x60\xe7\xdb\xd1\x27\x52\x38\x68\xbb\xeb\x6c\x69\x53\xee\x9a\x64\x17\x00\x0f\x62\x0b\x17\x57\x24\xec\xe9\xf0\x0a\x19\x83\x3d\xd3\x6d\xcf\xe6\xdd\x49\xc5\x58\xda\xb7\x42\x97\xd6\x70\x8b\x55\x30\x58\x33\x86\x24\xb0\x2a\xe6\x15\x7d\x89\xfd\x3a\x43\x0e\x00\x51\x98\x1f\xb5\x7d\x49\x8f\x5f\x40\x03\xb2\xcd\x63\x32\x10\xac\x60\x1c\x52\xe9\x6c\x4d\x0d\x33\x15\xe8\x36\xbd\x89\xf1\xc4\x77\x0b\x7d\xf0\x9f\xc0\x8f\xef\x58\x41\xfc\xb9\x33\xe6\xcf\x51\x4f\x6c\x62\x86\x4d\x15\xc5\xb7\x62\x8c\x77\x8e\x62\x51\x51\x7c\x57\x8c\xbb\xa6\x9e\xf8\x93\xf3\xee\x4f\xae\x2c\xfe\xf4\x5b\x45\x47\xb1\xed\xb1\x9c\xd0\xee\x6e\xef\xa8\xa5\x0f\xb7\xdf\x5f\x98\xb6\x0e\x79\x7c\x6b\xee\x1e\x65\x0a\xf2\x5c\x95\x27\x32\x5d\xca\x29\x2d\x59\xfe\x4a\x48\x4a\x19\x7c\xae\x49\x29\xef\x3c\x07\x65\xd3\xdc\x93\x4a\xce\xc9\x02\x62\xc5\xf4\x93\x5a\xda\x49\x5e\xd1\x92\x78\x12\xf5\xa3\x39\x70\xf1\x53\x4d\x3e\xf9\x22\x59\x9e\x77\x1c\x43\x0a\xca\xfc\x78\x7d\x38\x1b\x42\x96\x87\xd9\xe4\x66\x34\x9e\x80\xaa\x8f\x6e\x5a\xec\x14\x6f\x38\xf2\xb2\xd8\xf6\x9b\x6a\x41\xad\x61\x85\x31\x89\xd7\x7b\xf0\xfe\xe3\x46\x31\x76\x90\x64\x6b\x39\xf8\xa5\xc0\xd4\xd0\x46\xf0\x6a\x00\x33\xa8\x13\xf1\x1e\xf8\xf7\x2e\x67\xf0\x89\x60\x44\x2a\xeb\x05\x58\x3d\xf2\x71\x9a\x7d\x00\x04\xca\x21\x4c\xaf\x26\x13\xcb\x42\x11\x18\xe4\xcb\x44\x8a\x77\x64\x8a\xe4\xc9\xe7\xa4\x5c\xc9\x6d\x55\x6c\x1f\x8e\x07\xf3\x64\x7e\x53\xa5\x23\x97\xf2\x83\x5a\x41\x61\xb6\x50\xa6\xf5\x04\xe1\x82\x77\x99\xcc\xfc\x78\x0a\x6e\x7d\x4a\x00\x57\x25\xa2\x83\x9e\x67\xb4\x18\x61\xb4\x38\x9d\x35\xc2\xfe\xf1\x18\xc3\xe8\x1e\x27\xfc\x0c\x40\x99\xc3\x10\x62\xa1\x3c\xa8\x18\x54\x38\x55\x96\xc7\x01\xe4\x72\xd7\x8c\x6c\x5c\xf3\x71\x4a\x9a\x79\x45\x15\x22\xe3\x6c\x41\x19\xf8\xe5\x0d\xe4\x7a\xa0\xd9\x8c\x73\x04\x6a\xb4\xa0\xe7\x6a\x38\x6d\x0a\xa0\x20\x73\xdd\x41\xd4\x40\x98\xa8\x17\x37\xa7\x94\x84\x15\xe9\xa6\xc4\x24\x51\xf6\x17\x1b\x67\x93\x74\xdd\x79\x84\x99\xb2\x1e\x75\x6a\xb5\x89\x16\x2d\xd9\xf2\x7c\x96\x96\xfa\xc8\x4b\xa5\x3f\x1a\xbf\x7c\xac\x0c\x67\x48\xd1\x57\x0c\xb2\xf9\xbc\x5a\x83\x18\x5e\x26\x1f\xa6\x16\x63\x63\xc1\x10\xa4\xb4\x7a\x36\x73\xe2\xad\xab\x77\x17\x84\xeb\x9a\xf3\x4a\x94\x63\x21\x31\x1f\x76\xee\xca\xcb\x1b\xd3\x3f\x18\x11\x60\x66\x52\xdc\xf5\x6b\x2c\x1c\x4b\xe7\x66\x9c\xbe\xe1\x4d\x9e\x27\x8b\xa3\x0f\x53\x46\xf6\x37\xad\x47\x50\xf3\x51\x5b\xf8\x3c\xc1\x23\x6e\x82\xbc\x3c\xbc\xa8\xec\x07\xad\x55\x3a\xdd\x86\x5a\xff\xef\xe2\xea\x12\x44\x2d\x52\x79\x2d\x01\xe1\x94\x6d\x7d\xc9\xfc\xdd\x15\x8c\xee\xc2\x9a\x54\xa1\xfe\x38\xe5\x1e\xb7\x90\xcd\x25\xe7\xe8\x63\xaa\x14\xe6\x93\x4e\x57\xa9\xe1\xf2\x40\xea\xa0\xb2\x1f\x58\xb6\x1d\xa1\x18\x87\x04\x6a\x90\xd4\x09\xbb\x08\x25\xed\xb0\xe9\x30\x30\xb9\xd8\x04\xd0\xba\xcf\x14\x8e\xde\x36\xa6\xba\x8b\x2b\x65\x21\x33\x4d\xaa\xf2\xbe\x9a\xd2\x71\xc0\x09\xa4\xab\xb6\x18\x02\x75\x8b\xcf\x47\x9e\xc1\x86\x4c\x30\xbf\x86\x03\x51\x1a\x43\xca\x21\x90\x56\xfb\x4b\xe6\x95\x3c\x62\x39\x99\x0c\xca\x36\xf9\x9d\x45\x25\x2f\xa2\xe5\xd6\xab\x96\x80\xd6\x08\x2e\xb9\x3c\x4e\x76\x71\xe5\xf2\x08\xea\xd2\x1a\xe1\xef\xd4\x75\x62\xa5\x1a\x5e\x7c\x15\xb2\x29\x73\x57\xb7\xcc\x95\x73\xc4\x98\x19\x4b\x12\x80\x24\x85\x26\xf4\xe4\x94\xb4\x98\x5d\x64\x34\xf5\x14\x04\xcd\x9d\x3a\x37\xb3\xab\xb9\x30\xb3\x4f\x40\x9c\xa5\xc0\xd7\x3e\xaf\x5d\x50\x77\x74\x2e\xdb\xcb\x18\x03\x58\x5b\x5b\xbb\xf3\xed\xae\x12\x8d\x5a\x73\xba\x3d\xbb\x04\x61\xe7\x32\x97\x7b\x98\xbc\x73\x0b\x71\x4a\x02\x86\x1a\x26\x45\xa6\xba\x40\x4d\xe4\x1d\x4f\x29\x5b\x9d\x74\xff\xac\x2b\xbf\xac\x38\xee\xd8\x88\x72\x89\x2d\xfa\x67\x5c\xe3\x22\xe2\x21\xbf\x6c\x7b\x49\xe4\x74\x50\xc6\x56\xd4\xe3\x5b\x75\x5e\x4c\xdf\xac\x65\x80\xed\xd2\x2d\x16\xa6\xf3\x8d\x5c\xfc\x8c\x90\x7e\x0e\x58\xfd\xd4\x20\x75\x91\x65\x93\xdd\x75\x3e\xcc\xa6\x8f\x96\x54\x7e\xe6\xee\x8e\x52\xf0\xc2\xc9\x6c\x76\xe9\x24\x03\x22\xe8\x98\xa5\x13\x7b\x97\x1f\x71\x68\x8f\xec\x1d\x46\x2e\x2a\x5a\x95\xfb\x29\xde\xd6\xc8\xab\x55\x6b\xf1\x88\xc3\x09\xf6\x14\xed\x5f\x9a\xac\x1b\xd3\xc6\x37\x9c\x90\x03\xfc\x3d\x70\x3c\x84\x0b\xb1\x8d\xf9\x1e\x82\x2f\x6a\xec\x64\x50\xac\x72\x23\x93\x73\x91\xa8\xc2\x11\xe7\xa7\x46\x7b\x32\xf3\x19\xd9\x78\xbb\x3f\x25\x3b\x02\x78\xde\x6a\xb1\x08\x4b\x23\x0b\x15\xe2\xbc\x97\x83\x30\x85\xa7\x11\xc6\x0f\x6a\x38\x44\xf2\x7a\x3a\x1e\x8d\x87\xc9\x74\xc9\x02\x4a\x8e\x69\xef\x11\x24\x6d\xc7\x74\x4c\xfe\x51\xf2\x20\xa6\x67\x65\xf9\xcd\x1d\x84\x8d\x29\x36\x6f\xbf\x38\x51\x86\x47\x36\xbd\xaa\x18\x6b\xb3\x59\x98\x18\x29\xed\x06\xa3\x72\xd0\xb2\x3b\x4b\xf5\x22\xfb\x67\x2b\x1b\x7b\xc1\x16\xc6\xa2\xfd\xaf\x0f\xe0\x8d\x7b\xed\xba\xae\xe7\xfa\x6e\x00\x0e\xe7\x6e\xe8\x46\x6e\xec\x76\xcf\xee\x0d\x70\xc7\x89\x6b\x87\x5e\x61\xe1\xeb\xf8\x8c\x14\x56\xec\x07\xe6\x10\x8c\xcb\x95\x3f\xd0\x7f\xc9\xc1\x0f\x62\xf6\x1a\x7c\xf3\xf9\xf4\x7e\xb3\x69\x50\x14\x16\xf8\xaf\x64\x34\xc4\x7f\xd6\x36\x26\xd5\x01\x50\xf2\x98\x64\xd3\x77\xcb\x73\x6a\x7a\x64\xe5\x22\xf5\x63\xc6\xe4\x0b\xa5\x59\xa4\x98\xdd\xe9\x70\x96\x02\xbd\x67\xf4\x87\x4e\xee\xf8\xba\x3c\xf6\xa7\x20\x00\x52\x76\x6d\x3f\xbb\x5e\x3d\x80\x4c\xad\xd5\xde\x38\xb8\x4b\x4e\xac\x35\x22\xbb\x18\xe2\x1a\xb4\x9b\x57\x51\x86\xe4\xf5\x72\xd4\x6b\x14\xcd\x85\x4d\x85\x35\x96\x0b\x9f\x2a\x42\xdc\x64\xb8\x4b\xc3\xb7\x54\x10\xd0\x90\x4c\xe9\x94\xed\xf9\x2a\x05\x59\x98\xbf\x9d\x90\xa4\x7b\xd8\x7c\xc0\x4f\x19\x37\x94\x28\x13\xd3\xfc\x36\xbd\xd7\xad\x8b\x4b\x1d\xda\x10\xd8\x79\x3c\x7e\x86\x78\xe3\xdb\x53\x9a\x61\x49\x9d\x29\xd1\xc0\xce\xcd\xc2\x91\xc0\xc0\xfe\x58\x60\x58\x1b\xa6\x82\x89\x08\x75\x90\x93\x98\xbd\xf4\x78\x71\x92\xf3\x18\x85\xe7\x58\x80\x9f\xa8\x2c\x89\xc2\xcf\xeb\x14\x6f\x44\x27\x64\x70\x9d\xec\x1a\x23\x49\x42\x28\x64\x43\x0c\xac\x21\x94\x69\x59\xee\x53\x87\xa6\x20\x29\x0a\xe2\x7f\xb7\x04\x4a\xc7\xfa\x20\x92\x42\x58\xd7\x1b\xb0\xd3\x5b\x12\x8a\x5d\x8e\x2f\xb2\x92\x03\x8a\x14\x92\x5d\xee\xae\x3d\x29\x84\x3c\x38\x50\x14\x74\xfe\x62\x16\xde\x8c\x79\x54\x6c\xf8\xa7\xe5\x87\xce\x13\xa7\x35\xa6\x18\x7f\xeb\xf4\x88\xfc\xce\xa3\x65\x5b\xa5\x78\x0a\x47\xed\xf1\x63\x67\x2c\xc2\x6d\xff\xb1\x99\x37\xfd\xdd\x77\xbc\x0d\x43\x79\xd1\x68\x0d\xc1\xdf\xba\x2d\xc9\x63\x4a\x17\xd7\xad\xc6\xd4\x1e\xe5\xbe\x6e\xf7\x37\x9d\xe2\x60\xd7\xc9\x18\xfc\x95\x7d\xb3\x7d\xbc\xa9\xa2\x69\xca\xb1\x92\x8c\xa7\x70\xd9\x6c\x4d\x46\x58\x97\x9d\xd2\x18\x1c\x20\x04\x2a\x82\xe1\x78\xb9\xb8\x2b\x86\x95\xaf\x2e\x0b\x93\x82\xcf\x75\x43\xf7\x1a\x58\xd3\x87\x3a\x6c\x69\x61\x65\x32\x66\x86\x61\x10\xd5\xde\x08\x18\x3c\xce\xfc\x26\x2e\x1d\xfa\x1f\x92\x7e\xe0\x03\xe9\x2f\x14\xbe\x60\x20\x78\x45\x94\xda\x74\xe0\xde\x77\x6d\x98\x8d\x27\x79\xcd\xa7\x4e\xd8\x38\x01\xa8\x76\xfe\xad\xb5\xf3\x58\xcc\x91\x0a\xc7\xdf\x55\xed\x3e\xd9\x71\x57\xa6\x85\xc2\x79\x57\xa5\x85\xaa\x73\xae\x0c\x16\x4f\x6a\x14\x57\x39\xfa\x79\xf1\xe4\xbc\x56\xa4\x11\x66\x7e\xef\x5a\x4d\xea\xa0\x5e\xf3\x29\x90\xc4\x86\xd1\x78\x32\xe1\x61\x67\x99\x9b\x04\x9e\xb7\xaa\x85\x12\x7e\x98\x9b\x9a\x0e\xbd\x32\x28\xab\x8b\x4f\xad\x59\x66\x90\x6a\x11\xca\x5d\x19\x9f\xd5\x38\x82\x31\x57\x10\xcf\x7e\xd2\xa2\x25\x64\x32\x99\x9a\x8f\x58\x32\x7b\x28\x1e\xa8\xe0\xeb\x5c\xbd\x21\xbf\x78\xfb\xc1\x1e\x65\xfe\xed\x07\x88\x94\xfe\xf6\xc3\x86\xed\xfb\x6f\xb0\xcd\x5c\x07\x49\x9a\xc5\xbd\x81\x67\x2b\x96\x2c\x7e\x75\x64\xce\x51\x72\x4f\x50\xe3\xee\x8e\xb6\xda\x72\xaf\xfb\x6e\xdc\x27\x03\xdd\x82\x17\xb0\xa7\xef\x91\xff\xda\x64\xa7\xc2\x17\x84\x61\xb9\xd7\x9e\x8b\xdb\x3d\x20\xb0\x61\x03\xf6\x81\x02\x0b\xe3\xc8\xa5\xc0\x3e\xe8\xc0\x3e\xd4\x05\x86\xc3\x9b\x2d\xae\xd0\xd3\xa7\x85\x68\x10\x1e\x89\x35\x09\x24\x1c\xe9\xd2\xfa\xac\xee\xd3\x4d\xac\xc3\xfe\x2b\x2f\x4b\x36\x4e\x77\x2d\xb2\x96\xc1\x31\x65\x45\xbf\xa5\xf6\x36\x9c\xda\xc8\x76\xee\xac\x45\xce\x7f\x39\x9e\xb3\xee\x3c\xf1\xea\x88\x28\x05\xce\xa1\x8a\x1b\x1d\x67\x3e\x4c\x86\xe7\x19\xcb\xae\x53\x2d\x70\x40\xcd\xb7\x40\x8f\xf3\x56\x8b\x56\x85\xa3\x92\x82\x24\xec\x26\xd2\x60\x98\xaf\x98\x68\xd5\x4d\x02\xab\x45\xcb\x23\x41\x76\x07\xdd\x0d\x4b\x42\x31\x9a\xc3\xa7\x25\xca\xe7\xf0\x9d\x3f\x1c\xb7\x66\x58\x73\xb8\x2a\xcc\xd7\x30\xde\x3a\x32\x05\xc8\x94\xa7\xef\xa9\xe7\x7c\xca\x8e\xf6\x55\x86\x34\xe4\x34\x89\x86\x34\xf4\x5f\xb3\x21\x0d\x39\x66\x16\x35\x01\x26\x70\x50\x70\x93\x02\x5d\xa3\xbf\xeb\xc5\xdf\xd4\xd5\x17\xe7\xa4\x6e\x5d\x15\x46\x8d\x93\x67\xc3\xa8\x9a\xb5\x5a\xbf\x2b\x46\x4e\x1a\x2b\x86\xd0\x64\xe3\x27\x1d\xed\xab\x13\x09\x19\x03\x67\xae\x7a\x4c\xfd\x50\xfb\xe4\xb9\x68\x7a\x8c\xa4\xb3\x5e\x04\x44\x06\x82\x48\xe3\xf3\xda\x79\xb6\x16\x95\x07\x3a\xcc\x91\x36\xa6\x07\xb9\x0f\xb7\x3c\xc4\x71\xec\xd8\x1a\x47\xb0\x00\x24\x6c\xe7\x6a\x1f\xb2\xd3\xb6\x6b\x56\x85\x76\xdc\x51\x79\x5d\x0b\x83\x10\xe0\x7e\x99\x8d\xa7\xad\x47\x8f\x56\x88\xb8\x29\x51\x38\x5d\x6f\x4d\x34\x3d\x7c\xa5\x50\xc2\xad\xbf\x60\x2c\xc2\xd3\x9f\x2f\x35\xf1\xc5\x46\x6d\xb6\xc5\x7a\xac\x1f\x29\x93\x56\x69\x96\x28\x85\xd6\x39\xe4\x47\x17\xfa\xc8\x8e\x32\x4d\x56\xcd\x87\x26\xa9\xe9\xe4\x46\xd9\x16\xea\x97\xe4\xc7\xa4\xab\x25\x40\xcd\x04\x76\xfa\x60\xba\x64\x9d\x5d\x83\x6c\xa1\xcb\x79\x6b\xdc\x21\x10\x3a\x98\x84\x2f\x57\x59\xc0\x8a\xea\xb5\x4d\x0e\xb8\x8d\xf7\x3c\x65\x98\x9e\x3a\x7e\x5d\xf7\xd9\x43\x42\x2f\x53\xaf\xde\xa6\xc5\xca\xf2\x7d\x4b\x3c\xae\xb6\x75\xb1\xea\x7f\xde\xee\x55\x17\x81\xbb\x5a\x53\x13\x6c\xcf\xbc\x87\x51\x5c\xfe\xa3\xb6\x31\x3a\x1c\x9f\xf1\x4e\x26\x21\x48\x77\x24\x3a\x75\x6b\x60\x78\x0c\x6f\xb7\x89\xd4\x88\x9b\x54\xdd\x0d\x49\x06\x78\x8b\x3d\x49\xa1\xdb\xd5\xb7\x25\x41\x8e\x8d\x16\xc3\x67\xbd\x39\xb1\x55\x44\xf7\x27\x79\xb9\xd5\xdf\xa2\x44\xad\x66\xbb\x94\xa8\x26\x36\x2a\xf1\xe6\xbe\xf7\x2a\xad\xe9\xaa\x5c\xce\x63\x49\x8b\x9e\xf7\x76\x6d\xc8\x08\x7a\x6b\xd9\x1a\xf3\x35\xa1\x6f\x55\x66\xdd\x62\xe3\xad\x4a\x43\xb8\xee\x4e\xf5\xfa\x74\xef\x49\xaf\xde\x46\x05\x37\x89\x62\x9b\x62\x0f\xab\x6d\x52\xb4\xd1\x3f\x6f\x8f\xaa\xd9\xfe\x5d\xad\xac\x2b\x32\x56\xe6\x0d\x0a\x46\xf1\x3e\xb7\xa7\xe5\xfc\xa6\xc4\xc0\x28\xcd\xe0\x88\xfe\xfa\xf8\x60\x9b\x7b\x3a\xb5\xb2\xc5\x30\xb9\xcc\x5a\x25\x1b\x67\x91\x2d\x3b\xc3\x64\x39\x3c\x77\x5a\x99\xc5\x80\x61\x3e\xfb\x80\x74\x8b\x19\x57\x5a\x8f\x5e\x24\x13\x70\x44\xcc\x52\x36\x0d\x90\xa7\xed\x51\xfb\xb6\x66\x08\x2a\x51\xad\xce\xbf\xd9\x5c\x35\x21\x93\xcf\x9a\x79\x23\x85\x51\xd6\x9d\x93\x61\x7d\xc6\xcd\xea\xd8\x8c\x01\x94\xad\xe1\x6a\xca\xa8\x87\x5a\x08\x28\x74\xc5\xe1\xb4\xdb\x8d\x53\xf0\x62\x2e\x4c\x42\x2d\x34\x9b\x99\xe4\x85\x6e\xcd\xc4\x2b\xd9\xc9\xde\x48\x29\xd1\x2e\xae\x20\x41\x71\xe6\x8c\x61\x44\xc1\xb6\x9b\xe6\x59\x4b\xf0\x7a\x9d\x74\x48\x78\x2c\xd4\xca\xed\xab\xe5\xe9\x54\x95\xfb\x34\xc7\x21\x75\xad\xca\x13\xc4\xff\x9a\x5d\x42\xd4\xc1\x4b\x9e\x34\x50\xcd\x0e\x2a\xd9\xb4\xb8\x26\x9f\x30\x36\x0e\x98\x69\x70\x4b\x8c\x82\xf0\x12\xb3\x7d\xae\x69\x06\x87\xd9\x5d\x99\x35\x8f\x36\xd2\x8f\x58\x12\x6d\x96\xc4\x14\x9c\x11\x96\x0b\xee\x15\xe3\x00\x05\xdf\xf6\x8e\x69\x60\x44\x9e\x26\xc4\xb5\x5f\x32\xd5\xca\xba\xcb\xcc\xfb\x1c\xb4\x52\x36\xd9\x0c\x60\x06\x4e\xe6\xa9\xa8\xed\xac\x3a\x53\xa2\xe5\xa7\x3b\xa4\x02\x17\xd6\xdd\xba\x92\xe6\x56\x4a\x64\x0f\x68\x83\xe7\x05\xb7\x8c\x34\xa3\x85\xfa\x9b\xa2\x08\xb2\x50\xc8\x3c\xce\x8c\x5d\x1c\xba\xe6\x99\x13\x00\xe5\x97\xd4\xa7\x24\x91\x2c\x28\xa9\x3d\x31\x72\xbc\xfb\x99\xcc\xb7\x14\x9d\xd6\xa3\x22\xbf\xaf\x55\xaf\x7a\x6f\x64\x25\xeb\x24\x33\x2f\xba\xd7\xe7\xe9\xe8\xe4\x80\xa2\xd2\x00\xb1\x60\xa2\xd6\x68\x91\x94\x8c\x2a\xe2\x44\x32\x5a\x93\x98\x32\x62\x58\x1d\x29\x6d\x0b\xbb\x66\x23\x5f\x6e\x4a\x64\x02\x56\x20\xda\xc7\x9b\x6a\x92\xf4\xba\x14\xcc\x73\x9d\x12\x42\x7a\x9f\x8c\x27\x18\xb1\x8b\xf2\x05\x64\x76\x76\xaa\x39\x95\x9c\x55\xc6\xd3\xf7\xb3\x5f\x09\x08\x2d\xc9\x70\x8b\x25\x07\xee\x38\x1f\xce\xc7\x44\x96\x30\xb1\xea\xc1\x4d\x09\xab\xb6\x2f\x94\xc1\x6c\x36\xc9\x92\x29\x59\x1f\xb3\x3d\xc8\x1a\xeb\xfc\x78\x9e\x2d\x69\x3c\x13\x9e\x8b\x16\xdd\xb5\x48\x71\x64\x14\xec\x55\xce\xb5\x05\xbb\x5e\x21\x1c\x88\xe0\xf4\x38\xe2\x77\x10\xf3\x83\x03\xdc\xa1\x84\x64\x5b\x33\x3c\x55\xae\x2d\x2e\x47\x43\x30\xe6\x4c\xc1\x6a\xac\x55\x5a\x54\x59\x7c\x74\xc0\x1b\xea\x4c\xd8\x12\xc9\x89\x7b\xc3\x5c\x86\x1b\xa7\xe1\xc8\xda\xd4\x2a\xf0\x51\x32\x34\xb3\xd1\xbd\xd0\x71\x08\x59\x61\x53\x4b\xc9\x5c\x56\x98\x43\xcf\x6b\x9b\x23\xfa\x6d\xcf\xae\xa6\x4b\x4e\x5f\x06\x66\x02\x40\xa7\x34\x91\xf0\x31\xc6\x2d\xde\x54\xf1\x7f\xaa\x35\xb9\x51\xe4\x45\xb6\x21\xe7\xae\x93\x04\x83\xd4\xb9\xba\xa4\x0e\x85\xc3\xc9\x55\x9a\x69\x74\x5f\xac\xa6\x61\x94\x1b\xb9\xc8\x1f\xea\xc7\xb6\x15\x58\xa4\xb3\x0f\x53\x19\x8f\xd9\x74\x72\x43\x88\x47\x2c\xca\x8e\xb1\xee\x04\xac\xff\x96\x56\x59\x0b\xf9\xc6\x3c\xbb\x48\xc6\x53\x55\xb8\xaa\xd7\xaf\x8b\xe4\xba\xa5\xf4\x0b\x2f\x4e\xc9\x41\xdd\x90\x99\xbd\x53\x7d\xa5\x2a\xe6\x9c\x6a\x1e\x6c\x53\x8e\x94\xcc\xf1\x50\x5a\xff\xd6\x91\x08\xd0\x46\x4f\x48\x1b\x56\x72\x82\xaf\x6a\x1f\xc7\xd3\x96\xdc\x24\xa1\xd5\x8e\x42\x67\x26\xf3\x49\x9e\xc1\xdb\x44\x24\x40\x77\x12\xc0\x72\xb7\x2d\xca\xe7\xa9\x9a\x85\xfd\xfe\x4e\x1e\x01\xf1\xf6\xb1\xb4\x9e\xac\x46\x13\x80\x59\x36\x87\xd3\xa4\xd8\x18\x9e\xe4\x07\x04\x74\x86\x2c\xf1\x6a\xd2\xf7\x20\xc1\x55\x4c\xb9\xea\x2b\xbc\xb4\x58\x0a\xac\x9c\xe1\xe3\x94\x9b\x35\xa9\xa2\x90\xcc\xc2\xf4\x64\x58\xf2\x88\x6e\x98\x05\x09\x25\x21\x96\x34\xd3\xd5\x54\xc9\x63\x8b\x96\x61\x6b\x57\x38\xa9\xf8\x77\xe4\xa6\x6f\x6b\xec\x56\x3b\x0b\x65\xa9\x93\xd7\x2d\xad\xdc\x2c\xbb\xe1\x9f\x64\xf2\xf6\xb6\xb0\x21\xe6\x98\x34\xb7\x78\x53\x79\x98\x38\x69\x5a\x32\xd1\xf3\x33\xf8\x79\xb2\xc0\x0c\xb9\xd6\x13\x77\x65\x2a\xf2\x9c\x5d\xcb\x3e\x50\x74\xd2\x19\x74\x1a\x76\x8d\xd0\xc4\x6c\x2a\x1d\x85\xbd\xd8\x69\x45\x9e\x8f\x96\xac\x6d\x93\xc9\x07\xad\xcc\x8f\xc1\xe2\xd1\x7c\x1e\xbe\x93\xa8\xaf\x65\x19\xc8\x4a\x03\xa6\x96\xb9\x9a\xd1\x41\x68\x90\x93\x7c\xd5\xe8\x76\xd0\x90\x43\x43\x24\x57\x05\xb9\xab\x6d\x43\x22\xe6\x40\x09\xdd\x76\xb2\xbf\xe5\x47\xb1\xd9\x49\xac\x2c\xd5\xf5\xca\x11\xd6\x78\x6c\xb5\xfa\x61\xd6\x4e\x32\x11\xde\xc3\xae\x21\x28\xaa\x21\x2a\x2c\xb1\x73\x4d\x0a\x5f\x38\x77\xaf\x32\x61\xf4\x72\x17\x2a\x12\x44\x58\x56\xf1\xa8\x25\x9e\x95\x28\x67\x14\xe6\x55\x94\x1a\xf4\xbd\x99\x0d\x87\x61\x63\xe6\x1b\xf2\x71\xb3\xb1\x7e\x7d\x99\x22\xcb\x90\x07\x1b\x0d\x75\x1b\x64\xec\xb3\x46\x10\xa6\xc0\xf5\x38\xc2\xb5\x5d\x88\x28\x2b\x62\xfe\x43\x95\xcb\xbb\xc1\x9c\x5f\x21\x5e\xad\x47\x0c\x29\x93\x2e\x45\x2d\x59\xad\x3a\xa1\x05\x65\x42\x51\xc6\xc0\xb2\x1e\x2d\x1a\x09\xa6\xb0\x51\x21\x18\xc8\x83\x8d\x2f\x08\xe9\x80\xaf\x09\x94\x74\x8e\xbd\x47\xdf\x89\x3d\x96\xe4\x66\x21\x70\xb1\x32\x48\xe7\xe5\x0c\x51\x4e\x96\x34\x5f\x3c\xab\x99\xc7\x0c\x85\xe0\x37\x19\x59\x30\x64\x18\xdf\x67\x13\x50\x59\xa4\x19\xa1\xab\x2b\x7c\xee\xa0\x9c\xfe\x64\x06\x01\x4a\xea\x44\x19\xad\x49\xa1\x4a\xa2\x07\x44\x29\x0f\xc8\x8d\x25\x9a\x6b\x2e\x60\x10\xee\x68\x67\x00\xf3\x22\x4a\x8e\x22\x99\x90\x45\x2d\x61\x29\x5d\x46\xe8\x25\xd5\xaa\xa9\x9e\x17\x6d\x44\xf7\x7b\x86\xf1\x2d\x1e\x88\xca\xc1\x54\xae\x95\x2a\x01\xbe\x01\x67\x95\x46\x88\x33\xd9\x3d\x69\x1e\xcc\x36\x7e\xa4\xbc\x6d\xf1\x48\xc9\xef\x88\x5c\x06\xe9\x2a\x6a\x89\xf9\x0b\xa6\xf1\x51\xe2\xdf\x27\x6c\xd2\x1e\x89\xc0\x49\x10\x15\x73\x3e\x92\xac\x85\xed\xab\x82\xf3\x57\xd6\x75\x4e\xb5\x74\xbb\x65\xf1\x11\xc1\xd4\x70\x02\x01\xa0\xc8\x68\xd5\x58\x04\x7b\x0c\x37\xe6\x1b\xac\xa3\xfc\x09\xee\xf1\xa8\xcc\xa4\x3b\x55\xd0\xae\xae\xbd\xa5\xbd\xda\xc7\x2e\xb5\x4c\xc2\x96\x5d\x3f\x27\x57\x55\x8c\x07\x11\xb4\xed\x7e\xaf\xb0\x2e\xcc\x01\x70\xb5\xf3\x22\x5b\x89\x78\x58\x54\xb3\x88\xe5\x19\x2e\x55\x0a\x6f\x7e\x6c\x34\xd2\x13\xb0\xe4\xfd\x17\x5b\xdb\x77\x4f\x4f\x20\x42\xf3\xa0\x14\xd0\x02\xa3\xab\x2f\x82\xa6\xf6\x2f\x92\x61\x2d\xba\x22\xe5\x6e\x43\x5b\xa2\xfa\xad\xe8\x0b\x72\xe8\x56\xd0\xd7\xf6\x5b\x44\x0b\xe6\x81\x12\x19\x6d\x84\xd6\x6d\x46\x6c\xa5\xc7\x5f\xa1\x49\xaa\xf0\x61\x00\x6c\xd0\x89\x81\xfd\xc8\xbd\x18\x78\xa6\x16\x0c\xe9\x0b\xea\x37\x1a\xd6\xf7\x2b\xfe\x9e\x0d\xf3\x46\x1e\xe9\x17\xc2\xfb\x86\x9f\x6b\x78\x5f\x86\x4c\x8b\x87\x23\x6e\xdf\x79\xbc\x5f\x0e\xb9\x69\xdc\x5f\x81\xa1\x1c\xff\xd7\x16\xf4\x57\x7c\xc7\xe0\xbf\xa6\x00\xba\xc5\x2b\x0a\x1e\x35\x36\x9f\x32\x73\x34\xd8\x8d\x5a\xe1\x69\x36\x2c\x2e\x30\xb6\x30\xb2\x71\x58\xcf\x44\x8b\x95\xe5\x46\x5a\xe2\x71\x35\x33\x2d\x56\xfd\xcf\xb3\xd3\xaa\x8b\xc0\x5d\x71\xca\x01\xb6\x67\x36\xd5\xa2\xb8\xfc\x05\x6c\x89\xbf\x2a\x7a\x32\x5e\x0a\xe1\x90\xfc\x6e\x1e\x7b\xc1\xe0\x22\x5e\xdf\x2a\x93\x8e\xf9\xaa\x06\xcb\x70\x4f\x10\xd8\x6d\x96\x49\x29\xcf\x60\xb4\x4c\xff\xb3\x99\x2e\xd3\xff\xac\x06\xcc\x1c\xb0\x9f\x03\x26\xf5\x1f\x3b\x5e\xdb\x60\x13\xcd\xbf\xd4\xb1\x8c\xe6\x80\x03\x0d\xb0\x6f\x05\xec\x1b\x01\x9b\x21\x13\x5a\x24\x4b\x00\x7d\x38\xe9\xb0\x7c\xf7\x1d\xfa\x4d\xfc\x41\x9f\x7d\x78\xee\xc1\x23\xa2\x60\x82\x22\xa6\xe2\x17\x3a\x15\xad\x5f\xc8\x5c\x90\xd6\x09\x5f\x47\x6c\x7e\x01\xaf\xc3\xb5\x6e\x24\xcd\x50\x7b\xc3\xf9\xa5\x24\xdc\x85\x34\xf7\xd4\x16\x9c\x90\x1f\xda\xcc\x6e\x2d\x5b\x2d\x8e\x30\x76\x3a\x06\x47\xcf\x80\x74\xfc\x97\x36\xeb\x69\x30\x6a\xd7\xf1\xee\x34\xa7\x14\x60\xb9\xbd\x0d\xc9\x00\xe8\x07\x40\x09\x9c\x84\x73\x74\xe2\x82\x33\x09\xc6\xd6\xcb\x8b\x9b\x8d\x83\xcf\xc7\x13\x72\x3e\x97\xfb\xc9\xc2\x05\xfc\xbd\x24\x5c\x45\x61\x58\xe4\x66\x9a\xf7\x99\x71\x56\x19\xea\x2d\xec\xe4\x15\x9e\xbc\xba\x9d\xa5\x60\xb5\x8d\x18\xfd\x67\x6d\x6a\xc9\x76\x08\x6a\xd7\x23\x6f\x25\xf5\xcd\x2d\x45\xad\x86\x9b\x83\xa8\x27\x0c\xe5\xc5\x1b\x61\x28\x5f\xcd\xf7\x8b\x5e\xf5\x19\x99\xf5\x45\xf6\x42\x2a\x98\xbf\x32\xc5\x35\xfb\x26\xff\x6c\xa5\xee\x52\xa0\xa6\x2d\xc0\xc6\x7f\x80\xfd\xc0\x0a\x65\x1d\x2c\xe5\x34\x6a\xc3\x6f\xf8\xc2\x66\xb6\xf9\xbf\xb4\x21\xfe\xcb\x2f\xf5\x62\x75\x1a\x58\xca\xc1\xbb\x29\x24\x8c\xf8\x54\x5c\x45\x02\x79\x30\x4d\xd1\xcf\x39\x9f\xee\x31\xbc\x39\x1a\x55\xf1\x0c\xa9\x1d\x0a\xe3\x1b\x22\xac\x3f\xf1\x2a\x78\x92\x4c\x61\x72\xed\x95\x18\xb1\x51\x24\x98\x8b\xb4\x97\x8b\xec\x70\x36\xbb\xcc\x97\x44\x47\xc7\xa1\x23\xcd\xa8\x22\x72\x68\x37\x9e\xc9\xe5\xba\xf3\x68\xeb\xd9\xf6\xce\xee\xde\xf7\xfb\x07\xff\xfd\xfc\xf0\xc5\xcb\xa3\x57\xff\xfb\xf8\xe4\xf4\xf5\x0f\x3f\xfe\xf4\xaf\xff\x93\x0c\x86\xe4\xf4\xf1\xee\x7c\xfc\xcb\xaf\x93\x8b\xe9\xec\xf2\x7f\xe6\x8b\xe5\xd5\xfb\x0f\xd7\x37\xbf\xb9\x9e\x1f\x84\x51\xdc\xed\xf5\x1f\x3f\xdd\x7c\xa4\x19\x2b\x8b\x45\xdb\x18\xd5\x7c\x88\x2d\x5e\x29\xb9\xe5\x86\x62\x61\x6a\x12\x85\xb4\x76\x4c\x6e\x2a\x30\xd3\x63\xcb\x7e\xc3\x1c\xbb\x16\x20\x24\x49\xcb\x23\xa7\x26\xd9\x81\x85\xec\xbe\x1e\x59\x28\xe0\xbd\x92\x0b\x4c\x7e\x91\xb8\x38\x50\xbf\x0e\xd0\xf6\x19\xdf\xe0\x65\x31\xcc\x00\x95\x0a\x44\x53\x25\x72\x0f\x38\xdb\x50\xf4\xff\xa0\x2d\xca\xbe\x35\xd3\xf2\xe0\x3d\x0e\x1b\xe2\xc7\x8f\x95\x0f\x82\x6c\xc5\x0f\x46\x91\x85\xd8\x92\xc6\xb0\x08\x1f\xf3\xdc\x3d\xfa\x21\x5f\xda\x23\x36\xac\x99\x7d\xa2\x87\xa3\xff\xc3\xd1\x5f\x71\xce\xf2\x62\xe7\xd9\x6e\x6d\x07\x2d\x2f\x26\x85\x25\x1f\x2d\x2f\x56\x9f\xf0\xeb\xea\x4e\x5b\x14\x99\x3f\xd7\x71\xab\x26\x0e\x77\xe8\xbc\xe5\xc5\x56\xef\x2d\x2f\xfe\x8f\xf2\x2e\xc6\xc1\xb8\xcd\x59\xdd\xb7\x0a\x34\xe0\x32\xf4\x8a\x1c\x4b\x96\x36\x27\x63\x2f\xb6\x38\x19\x1b\x0f\xd3\x39\xa6\x76\x2f\x63\xd1\x64\x5d\x57\x63\x09\xe8\x2d\x4e\x50\x3a\x11\xdf\xca\x59\x0d\x69\xb3\xe9\xda\xf8\xdc\x3d\xd6\xc8\xaa\x12\x2e\x6b\x7c\xf1\x35\xf2\x59\xc3\x4a\xcd\x7c\x8d\x79\x2d\x21\xdf\xf2\x17\xf7\xed\x69\xac\x36\x5c\xcf\xd1\xd8\x43\xd9\x47\x60\xa8\xba\x19\x83\x08\x94\x2f\x16\x1f\x16\x8b\x16\x84\xcd\x4e\xe1\x36\x29\x47\x1b\x9d\x6f\xeb\x87\xc2\x60\x64\x79\x58\x63\x0f\x93\xf6\xa9\xc3\x5b\xef\x53\x87\x9f\xc1\x3e\x75\xf8\x27\xec\x53\xc6\xe5\x74\xb8\xfb\xb0\x4d\xdd\xfd\x36\xb5\xf8\x90\x5c\xee\x4e\xd3\x71\x32\x6d\x35\xdd\xb1\xda\x5f\xe4\x96\x75\x78\x3f\x5b\xd6\xe1\x5f\x62\xcb\x22\x6b\x4c\xdd\xb4\x1e\x76\xac\xc2\x8e\x25\xad\x98\x46\x9b\xd7\x27\xdd\xbd\xc4\xbc\x48\xd8\x02\x20\xa5\x8f\x3c\x1a\x3e\x7e\x61\x77\x27\x74\x71\xbb\x2e\xfc\xc1\x8b\x15\xfa\x11\xba\xcf\xbe\xd2\x6f\xf9\xf2\xaf\x52\x17\x20\x61\xd9\xb5\x05\xf1\xad\xb4\x05\x2c\x47\xed\xa7\x54\x1a\x80\x1e\x4c\xbc\x5a\x9c\x27\x9e\xf6\xea\xfc\x22\x19\xde\xa3\x6a\xa1\xe3\xf0\x66\xf1\x17\xb6\xf6\x57\x50\x37\x14\xf2\xc5\xae\xa0\x8a\x50\x8c\x58\xa4\x2f\x2f\x76\x22\xac\x89\x26\x37\xe4\xc1\x24\xe3\xa1\x89\x33\x24\x0f\xc7\x2c\xd8\xd4\x0e\x56\xf4\x15\x9d\x7f\x21\x18\x39\x4b\xe2\x0d\x76\x8b\x68\xa3\xbd\xfb\xc3\xab\xb7\xb8\xe9\x9e\xce\x9e\x67\xb9\x30\xe8\x7c\xf8\xf0\x61\x6d\x76\x99\x4d\x17\x8b\xc9\xda\x6c\xfe\xee\x69\x3a\x1b\x2e\x9e\x62\x12\xee\xd9\x53\xad\xce\xf9\xf2\x62\x62\x50\x84\xec\xbe\xbf\x7c\xbe\xb3\x97\xa3\x2d\x9e\x6b\x06\x43\xa8\xf6\x01\xd1\xf6\xb8\x82\xf7\x0b\x4b\x79\x8e\x7b\x14\x0c\xcc\x02\x1e\xc6\x53\xee\xf6\x22\x85\x7b\xce\x5d\x5d\x42\xc2\xf9\xfc\x9e\xe2\xe9\x52\x80\x6f\x31\x52\x93\xc3\x62\xe8\x09\x52\xc8\x9c\x55\x61\x3b\x5e\x32\x5b\x64\x3d\x48\xb5\xf4\x81\xc0\xbd\xa4\x56\xa7\xb2\x77\x4e\x5d\xbf\x18\x6d\x77\x60\xc3\x43\x00\xf8\x3d\x34\x21\x55\xbe\xd2\xce\x21\xe6\xda\x97\x1c\x1f\xa5\xed\x8f\x2b\xbb\xdd\x58\x88\xf6\xbe\xfd\x70\x32\xa9\xd1\x3b\x30\xb3\xfe\x35\x1d\x15\xbc\x6f\x28\xcd\x57\xa4\x68\xaa\xae\xf8\x7b\x3e\x57\x3d\x2d\x9f\xdf\xaa\x60\x3a\xea\x34\xba\xae\xab\x03\x6e\xe8\x1d\x54\xe9\xf7\x53\x4f\xde\xdd\x81\x29\x41\x1a\x01\xaa\xc0\x44\xd8\x09\x66\x60\x85\x45\xbb\x8a\x95\x3e\xaf\x4b\x63\x01\x98\x00\x95\x54\x5e\x24\x93\xa5\xb3\x85\xff\x34\x17\x8b\x91\xba\x28\x79\xdf\x05\x79\x65\xb0\x79\x90\x49\x5e\xa3\x6e\x11\x59\x8b\x77\xa6\x83\xf8\x95\xe4\xad\xc1\xe2\x4a\x7e\x47\xb5\x66\x23\x81\x57\x9d\x62\x83\x78\x0b\x2b\x9d\x71\x0f\xb3\x11\x3c\x7a\xa9\x01\x79\x30\x13\xe5\x7c\x75\x34\x0a\x1c\x83\xa1\x05\xe8\x10\x3f\xc7\xb1\x31\xa5\x44\x6b\x14\xf4\x40\x00\x13\x3e\xc1\xe2\x8d\xf5\xb8\xcc\xf7\x18\xda\x23\xf6\x64\x28\x27\x31\x71\x5a\x34\x7f\x61\xc0\xf2\x7b\xb6\x31\x01\x78\xcd\xcc\x8b\x1a\xb3\x68\xb8\x72\x83\x96\xef\x38\x3e\xc6\xa3\x00\x88\x71\xe8\x39\x60\xbd\x60\x56\x5d\x96\x68\xd9\xea\x6b\x65\x25\x18\x43\xee\x04\xc2\xa0\x70\x62\x93\x8c\x82\x0b\xf4\xaa\x36\x2f\xfc\xe9\xcc\xb9\x38\xc5\xc0\x55\x24\xa9\xf1\xe8\x41\x49\x1a\xe8\xd2\xb4\x3f\x1a\xf6\x02\x59\xeb\x28\x94\x7b\x00\x96\xb8\xaa\x4b\x64\xc5\x62\xc6\x18\xdb\xd0\x46\x59\xaa\x25\xe9\x68\x38\xfd\x19\xa2\x5d\x88\x00\x73\xbc\x5e\x5d\x9b\xeb\x5a\x3c\x58\xf6\x3b\x5e\x89\xf7\x36\xe4\xbb\x77\xe8\x7d\x6b\xf0\x2b\x93\x7a\x53\x9f\x9b\x4b\x95\x14\xed\x86\xf4\x5e\xe5\xee\xf9\x07\x95\xab\x8b\x4d\x9b\xee\xd7\x36\xce\xde\xac\x5a\xc7\xb1\x88\x0d\xb7\x01\x53\x2a\x36\x08\x15\x72\x2e\xeb\xdb\xf6\x9c\xa2\x0b\x0b\x1b\x76\x55\x62\x41\xc7\x95\xf2\xfd\xee\xe3\x46\xc9\xf1\x9d\x42\x33\x9f\xdd\x3b\xfc\xf0\xb9\x1e\xf5\x3a\xfc\x48\xba\xde\xed\xc2\x99\xbe\xfb\x45\x9f\xe9\x87\xe3\x4b\xc2\x94\x9e\xdc\xb3\x89\x00\x9e\xde\xe5\xa6\xfe\x9c\x43\x7c\x31\x73\xe7\x9d\x9c\xe6\xb7\xb1\x63\xaf\x80\xe3\x2c\xc4\xa1\x5d\x7e\x69\x37\x21\xb0\x65\xc2\x50\x6a\xc0\x19\x2e\x59\x62\x25\xfa\x93\x33\x62\x56\x71\x0f\x5f\x2e\x59\x54\x05\x5a\xa4\x41\x3a\x0d\x38\xdd\xd0\xb9\x59\x12\x21\x1c\x4e\x91\x09\x7b\x76\x2e\x69\x9f\x98\x6f\x16\x4f\xb5\x91\xa4\xd9\x70\x7c\x91\x4c\xc8\xb1\x8c\x46\x8e\x4c\x6b\xdf\xdc\xc8\xa3\xf2\x91\xb5\xc2\x06\xee\x54\xa0\xa1\x36\xdb\x3c\x19\xc7\x2a\xf8\xdd\x6b\x7a\x8e\x7c\x4a\xa4\x5b\x1d\xb9\xf3\xcd\x2e\x76\x94\x9a\x16\x47\x2d\xb9\xcc\x5a\x3e\xbb\x79\x02\x09\x48\x05\xba\x5a\x26\x08\xc3\xf0\x4a\xe4\x23\xdf\x37\x34\x9c\x4e\xed\xe6\x61\x3c\x25\xac\xfc\x36\x73\xca\xc9\x43\x25\xba\x15\xe8\xec\xae\x88\x63\xa8\x31\x0a\x03\x7d\xac\x9c\x54\x02\x47\xcb\x1c\xc2\x26\x9f\x9c\x4d\x27\x6f\x83\x56\xd8\x30\x52\xcf\xb6\x42\x3d\x5c\x23\x90\x03\x5a\x97\x81\x7e\x34\xeb\xe6\xed\x3b\x6d\xde\x5d\x6d\xb7\x95\x36\x88\xf5\xc8\xef\x68\xca\xf3\xde\x83\xa9\xdd\x5f\x5d\xf7\x6d\xdb\x1d\x0b\x91\xcc\xcb\x34\xe1\x86\x7c\x54\xe0\x00\x2c\x34\xae\xc6\x44\x54\x50\x62\x53\x76\x54\xbd\x9b\x84\xf4\xe8\xf2\xba\xaa\x27\x73\x51\x49\x5c\x53\x15\x05\xb2\x3a\x3a\x2f\x67\x10\x6c\xe0\x8e\x94\x4a\x20\xff\xee\x9b\x03\x07\x61\x2f\x19\x9b\x30\x79\x22\x53\x47\xdf\xba\x1a\x43\xd9\x39\xd8\x12\x20\xd8\xa8\x33\x12\xfa\x22\xea\xa3\x20\x1e\x75\x0f\xf7\x12\x6f\xb7\xbb\x8c\x2f\x8b\x07\xa6\x8a\xf0\xb2\xf4\x50\x25\x45\x97\xd5\xc7\xc9\x6c\x88\x5f\xa2\x98\xa2\x1d\x7d\x26\xc5\xc5\x64\x5d\x2f\x8b\x8c\xa9\x55\xe2\xfa\x02\x1d\x96\x39\x4a\xe6\xd6\x64\x32\xfb\xe0\x24\xf3\xc1\x78\x39\x4f\xe6\x37\x0e\x53\x2f\x91\x9e\x18\xe2\x0e\xfe\x2a\x6b\x24\xfe\x69\x6c\xb8\x64\xa0\x74\x75\x4b\xbd\xd1\xaa\x70\x86\x04\x94\x4a\xdc\x20\x31\xfe\x1b\xea\x36\x66\x73\x42\xaa\x53\x08\x44\x3b\x4d\x9d\xd9\xd5\x12\x05\x88\xd4\x30\x07\x33\xaa\x63\xa4\x64\xc8\x1e\x68\x2b\x85\x80\x74\x5c\xe3\x27\xd7\x18\x1b\x6a\x34\x21\x81\x99\xa4\x95\x9c\x95\xe9\x23\xc7\x52\xc1\xb1\x54\xb0\xd0\xd8\x4f\x47\xc7\x38\x9f\xf4\x1a\x90\x74\x1a\x94\x27\x44\x28\x9a\xea\xcd\x1b\x93\x48\xa9\x73\x6c\x57\xac\x09\xbc\xdf\x8c\xcf\x9c\x9f\x21\xfb\x64\x34\xa4\xff\x33\xb9\xc3\x14\x0a\x07\x31\xfd\x5f\xb9\x66\x6c\xa6\xe9\xc4\xc6\xda\xb3\x89\x22\xff\x84\x38\x64\xb8\x03\xdd\x47\x14\x32\xc1\xc4\xef\x24\x12\x59\x49\xbe\x32\x13\x33\xde\xf8\xda\x1c\xcb\xcc\xc4\xc7\x2d\x7a\x52\x55\x5f\x9c\x2f\x98\xd5\x22\x90\xe1\x30\x7f\x36\xf1\xc7\x08\x32\x2c\xfa\x18\xe2\x35\xc6\x25\x36\xbc\xc3\x29\xaf\x13\x88\xac\x50\xfa\xee\x83\x91\xc9\x24\xc1\x5b\xa9\x0c\x3e\x76\x5f\xd1\xc3\x70\xa8\xff\xd3\xa3\x87\x55\x88\xa9\x4d\x44\x44\xe0\xa1\x39\x0d\x55\x46\x10\xb3\xd7\xa8\x8c\x22\x66\xaf\x7a\x4f\x91\xc4\x6e\xcf\xed\xb6\xa9\x7a\x1a\xc7\xdb\xb0\x1f\x83\x74\xb1\x6f\x0e\x8e\x56\x1a\x70\xac\x94\x63\xca\x63\xa5\x01\xcd\x25\x14\x2e\x69\xf0\x4b\x26\x81\x4a\xdb\x1a\x72\x8c\x80\x34\x5f\x12\x89\x83\xbf\xc5\x08\xae\xff\x45\x2b\xcc\xaf\xe3\xf0\x89\xe1\x35\x39\xcc\x3e\x01\x54\x52\xb4\x6d\x5d\x68\x5f\xc9\xb1\xf5\x09\xda\x34\x1a\xde\x53\x37\x4b\xed\xc3\x45\x1a\x55\x1b\xdf\x91\x57\xe4\x70\x65\x78\xe9\x87\xc5\x97\x91\xe7\x17\x5f\x06\x3d\x43\xc9\x40\x7b\x35\x1f\x5f\x66\x17\xa9\x17\xbb\x46\xdb\x3f\xe5\xd5\xe5\xe0\xd7\x74\xa4\xb7\x93\xbd\xbf\x24\x6f\xcb\xee\x1d\xd4\xae\xcf\xd2\xec\xc9\x70\x34\x30\xbe\x5e\xce\x2d\xaf\x9f\xbc\x9b\x24\xe9\x45\x32\x35\x7d\x9e\x99\x81\x65\x43\xfd\x35\x91\x6c\x9f\x24\xd3\xc5\xf8\xba\xef\x07\x86\x4f\xe3\xc5\xcc\x73\x3d\x3f\x36\x7f\xea\x77\xfb\x5d\xcf\xf0\xe9\xb7\x6c\x3e\x63\xae\xd7\x86\xaf\x53\xcb\x37\xaa\x23\x7b\x72\x9e\x5d\x6b\x1f\x92\x4c\x27\x2e\x1a\x77\x23\x2d\xbc\x9f\x0f\xf5\xc9\x9d\x27\x03\x72\xfe\x31\xbe\x7c\x32\xc9\xde\x25\xc3\x9b\xfb\xbe\x03\x12\xab\x07\x9f\xf4\x45\x83\x2f\xf3\xb5\x22\x1e\xd9\x12\xc1\x67\x58\x19\x9a\x59\x28\x5b\x07\xe2\xb7\x1f\x8a\xdf\x40\xf5\xfc\x37\x10\xbb\xf8\x4d\x7f\xe5\xa4\x9d\xdb\x97\xe2\x2f\x46\xc8\x14\x03\x4a\xbf\x85\x3b\x2c\x8a\x0e\xa7\x56\xe9\x89\x10\xa9\xf2\x24\x68\x33\x7f\x3b\x53\x6a\x00\x25\xd2\x66\x65\x02\x14\x6f\x04\xdd\xc9\x6f\x28\xb9\x89\x37\x32\x95\x89\x97\x53\xf5\x95\x44\x53\xf8\x0c\xa4\x84\x3f\x72\x0a\xa2\xa3\x32\x64\x03\xc5\xe8\x45\xfa\xcd\xc9\xa4\xa9\x22\x52\x51\x40\xca\xbc\xb6\xb9\x62\xd2\x1e\x8a\x8d\x75\x69\x3d\xf2\x3a\xe5\xda\xe4\x8e\x4a\x57\xeb\x51\xd8\x51\x08\x6f\x3d\x8a\x3a\xf9\xc4\xaf\x47\x71\x47\x1d\xbd\xf5\xa8\xab\xdf\x08\xeb\xa4\xbc\x1e\xbb\x1d\x46\xad\xeb\x31\xe2\x23\x28\x65\x3d\xf6\x3b\x32\xad\xac\xc7\x61\xc7\x44\x2d\xeb\x71\xd0\x91\x29\x64\x3d\x8e\x3a\x32\xfd\xac\xc7\x88\x97\x42\x33\xeb\x71\xb7\xa3\x53\xcd\x7a\xdc\xeb\xe8\x74\xb3\x1e\xf7\x3b\x05\x22\x59\xef\xba\x1d\x03\x39\xad\x77\x11\x7f\xb6\x24\xd6\xbb\x88\x3d\x23\x8d\xf5\x6e\xd8\x29\x10\xc7\x7a\x17\x11\x07\x32\x5a\xef\x22\xce\xf9\x3a\x5b\xef\xc6\xf2\x05\x7a\x27\x5f\xb2\xeb\x5d\x7e\xb5\x0e\x8b\x79\xbd\xdb\xef\xf0\xa5\xba\xde\x73\x3b\xf9\x12\x5e\xef\x79\x9d\x7c\x71\xaf\xf7\x10\x9d\x9c\x82\xd7\x7b\xd8\xb8\x60\x34\xeb\xbd\x90\x08\x1d\xb1\xfb\x70\x79\xf0\xe7\x5f\x1e\x6c\x9f\x67\xc3\x5f\xa1\x53\xb8\x52\xa8\x1b\x10\x4d\x73\xb6\xb8\xba\x84\x81\xc9\x58\x7c\x6a\xa9\xdf\x28\xc7\xd3\x90\xe6\xce\x37\x9b\xce\x23\x0e\xf9\x91\xc1\x22\x44\x38\x69\x7c\xaa\xc8\x00\xa2\x9d\xe3\x0c\x42\xae\xe3\x41\x6f\x3e\x7e\x87\x67\x32\x50\xdb\xe4\x60\x48\x07\xb3\x39\xaa\xae\x37\xb5\xf4\x1c\x12\x94\xad\xab\x77\x90\x3b\x4b\x2b\x00\x4a\x84\xf3\x64\x9a\x4e\x32\x65\xdc\x64\xd8\x03\x23\x64\xc5\xa6\x06\xab\x16\xdd\x01\x25\xdd\x37\x8d\x25\x4f\x4d\xa0\xc6\xd3\x65\x4f\xd2\xd0\x8f\xe4\xfa\x42\x31\xa1\xce\x4e\xf1\x98\x9f\xd7\xa0\x4a\xf8\xd7\x00\x15\x5f\xc8\xd8\x28\x87\x08\x23\x62\x33\x9a\xfe\x0b\x21\xbd\x1f\x67\x1f\x6c\x28\x5a\x9b\x97\x10\x3e\xe0\x28\x38\x7f\xfc\xa1\x96\xe7\x04\x87\x58\xa2\xce\x98\x57\x87\x48\x3b\x7f\x13\xb6\x23\xb8\xe8\xcc\xc0\x0b\x55\xdb\x85\x56\xac\x58\x79\xb1\x19\x2d\x7b\x4b\xcd\x6a\x90\x26\x02\xbf\x69\x13\xcd\x6a\xec\x4d\x66\xc9\x2a\x55\xe2\x10\xdf\xe7\xe5\x57\x24\xa5\x35\x4a\xc1\x1d\x47\x7e\x75\xb3\xcc\x8e\x30\x39\x50\xe1\xb5\x29\xef\xb2\x42\x7f\xfb\x74\xd1\xe5\x6d\xd5\x59\x11\x79\xe9\x66\x2a\x84\x1c\xda\x33\x81\x1b\x28\x14\x4c\x38\x1b\x34\x0b\xbb\xd7\x2c\xfb\x2a\x28\xac\x6f\xe9\x2e\x68\x42\xa5\x49\x3e\xed\xbc\x3e\x28\xe7\x57\x49\x9e\x9d\x9b\x73\x83\xb6\x0d\x55\xb5\xb9\xe3\xa8\x5a\x54\x30\xd6\x3c\xb5\x45\xc7\x61\x6e\x84\xa6\x8e\x28\xf3\x6d\xcc\x7a\x06\xa3\x09\xaf\x01\x9e\x33\x05\xa9\x4f\x66\xe6\xc5\x76\x13\x48\x74\xc2\x1a\x4e\xe6\xef\xae\x80\x85\x2f\xca\xfc\x15\x19\xbf\x5e\xbb\x9c\xcf\x96\x33\xc0\x51\xe6\xdc\x65\x86\x13\xfa\xee\x63\x56\xb0\xc4\xde\x83\xac\xf3\xe7\xc8\x3a\x18\x30\xfa\x4f\x88\x4b\x64\xcc\xa9\x54\xc3\x44\x02\xb7\x58\x7a\x8f\xe7\x2c\x73\xdd\x3a\x54\x39\x65\xcc\x42\x2a\x49\x55\x97\xda\xcd\x5f\xcb\x72\x41\x64\xe2\x2b\x71\x68\xe6\x22\xa7\xc0\x26\x36\xe9\xf0\xad\x25\x83\x05\xfd\xb1\x18\x4f\x59\x30\x56\x60\x19\xee\x35\x18\x10\xe3\x7f\xe0\xa9\xeb\x1a\x96\x57\xab\x6d\xb4\x50\x07\x7f\x48\xd5\x9a\xc2\x64\x00\xa2\x7b\x4d\x82\xa9\x14\xd5\x96\x16\x0d\x40\x78\xda\x9b\xd2\xdb\xb1\x5c\x13\x6c\xce\x55\xfc\xa6\xc8\x49\xdd\xeb\xb8\x1b\x46\x7e\xe0\x7a\x1d\xf2\x3b\x1b\x0d\xd3\x64\xd0\xeb\x77\x4c\x05\xfb\xbd\x41\x92\x0e\x47\x90\xd4\x90\x0c\x4c\xe0\x47\x61\x37\x56\xcb\x9d\x59\x6f\xc4\xb4\x34\x7a\x72\x2f\x5e\x88\x4c\x7a\xa6\xbd\xeb\x43\x72\xe9\x64\xe8\x5e\x5d\xbd\x87\x78\xb1\x7d\xc7\xb0\x5f\x5f\x8b\x2b\x6c\x44\xe2\x2d\xc0\xe3\xe9\x05\x9d\xb1\x25\x02\xef\x8b\xb7\x52\xe9\x17\x6f\xf8\xc3\x99\xc9\x25\x44\xfa\x0c\x04\x67\xf6\xd3\x68\xb5\x5a\x12\x4c\xea\x29\x0e\xce\xe1\xd2\x4b\xdc\xeb\xc2\xb6\xe6\x23\xee\xfc\x51\x13\x20\xa9\x6a\x00\x88\xa1\x8c\x15\x97\xf4\x22\xb8\xdb\x19\x87\xec\x2b\x37\x14\xe6\xeb\x7e\x65\x48\x5d\x47\x1a\x53\x32\x07\xee\xd9\x46\x49\x69\x4f\x2b\xed\x95\x96\xf6\xb5\xd2\x7e\x69\xe9\x40\x2b\x1d\x94\x96\x0e\xb5\xd2\x61\x69\xe9\x48\x2b\x1d\x95\x96\x8e\xb5\xd2\x71\x69\xe9\xae\x56\xba\x5b\x5a\xba\xa7\x95\xee\x95\x96\xee\x6b\xa5\xfb\xe5\xb3\xe3\x6a\xb3\x53\x31\x99\x9e\x56\xbc\x7c\x36\x3d\x5f\x2b\x5e\x3e\x9d\x5e\xa0\x15\x2f\x9f\x4f\x2f\xd4\x8a\x97\x4f\xa8\x17\x69\xc5\xa3\x33\x83\xc8\x4c\x18\xf2\xaf\x60\xec\x45\xaa\x12\x41\x73\x60\x12\x9b\x21\x8f\xe3\xfe\x1b\xe3\x40\x0d\xf0\x93\x71\x50\x86\xf8\xc9\x38\x00\x29\x7e\x0a\xce\xac\x77\x83\x78\x47\xac\x7e\x03\x24\xf6\xf6\x5a\x49\xc7\x19\x74\x9c\x61\xc7\x49\x3b\xd2\x02\xed\x38\x4e\xb7\x03\x5b\xa8\x7b\xa6\xf3\x86\x94\xd6\x23\xc5\x45\xd5\x7c\x84\x48\x1d\xcf\xef\x90\x7a\x5e\xa1\xde\x90\xd6\xa3\x2d\xd1\xaa\xf9\xa2\x85\x7a\x5d\xa8\xe7\x17\xea\x0d\x68\x3d\x81\x64\x22\xd5\x0b\x48\x3d\x1f\xdb\x0b\x0a\xf5\xca\xfa\x17\x8a\xfe\x85\x8d\xfa\x17\x89\xfe\x45\x8d\xfa\x17\x8b\xfe\xc5\x8d\xfa\xd7\x15\xfd\xeb\x36\xea\x5f\x4f\xf4\xaf\xd7\xa8\x7f\x7d\xd1\xbf\x7e\xa3\xfe\x79\x84\x60\x68\xff\x3c\xb7\x51\x07\x3d\x42\x31\xb4\x83\x9e\xd7\xa8\x87\x80\x25\xed\xa1\xe7\x37\x23\x51\x42\x33\x8c\x44\x83\x66\x7d\x0c\x45\x1f\xc3\x66\x7d\x8c\x44\x1f\x91\x6a\x8a\x9d\xfc\xfe\x7b\x4b\x27\x49\x07\x23\xda\xc9\xd8\xd8\x49\x52\xd1\xd8\x49\xa0\xb7\x3e\xad\xd8\x35\x76\x92\x54\x34\x77\x92\x34\x09\x1d\x25\x15\x7b\xc6\x4e\x92\x8a\xc6\x4e\x02\xc7\xf0\x5d\xac\xd8\x37\x4e\xa4\xad\x8f\x91\xe8\xa3\xef\x36\xea\x23\xd0\x1c\xed\xa3\xef\x35\xeb\x63\xc4\xfb\xe8\xfb\x8d\xfa\x18\x8a\x3e\xfa\x41\xa3\x3e\xf6\xf3\x3e\x86\xcd\xfa\x18\x8a\x3e\x46\x8d\xfa\x08\x8c\x91\xf5\x31\x6e\xd4\xc7\x5e\xde\xc7\x6e\xa3\x3e\xc2\xba\x62\x7d\xec\x35\xea\xa3\x2f\x68\xd5\xef\x37\xea\x63\x57\xf4\x31\x70\x1b\xf5\x11\x96\x3f\xed\x63\xe0\x99\x17\xe4\xfe\xbe\x9d\x58\x43\xc4\x35\x30\x73\x1d\x52\xd1\xd8\x49\x18\x56\x58\x5b\xa4\xa2\x99\xeb\x90\x8a\xf6\x05\x19\x63\x45\x33\xd7\x21\x15\xcd\x9d\x24\x78\xfa\x01\x56\x8c\x8c\x13\x69\xeb\xa3\x97\xf7\x31\x6e\xd4\xc7\x30\xef\x63\xb7\x51\x1f\x71\x22\x69\x1f\x7b\xcd\xfa\xe8\x8a\x3e\xf6\x9b\xf5\x91\x54\xa2\x7d\x0c\xdd\x46\x7d\x74\x45\x1f\x43\xaf\x51\x1f\x03\xd1\xc7\xd0\x6f\xd4\x47\x60\xe5\xb4\x8f\x61\xd0\xa8\x8f\x7d\x31\x8f\x61\xd8\xa8\x8f\xb0\x3c\x58\x1f\xa3\x66\xb4\x1a\x71\x5a\x0d\xe3\x46\x7d\xf4\xf3\x3e\x76\xcd\x0b\xf2\xe0\xc0\x2e\xa8\xc6\xb4\x93\x66\xae\x43\x2a\x1a\x3b\x89\x34\x87\x3c\x20\x34\x73\x1d\x52\xd1\x2e\x06\x44\x28\x02\x9a\xb9\x0e\xa9\x68\xec\x24\xf0\x0e\x1f\x87\x35\x32\x8b\x3a\xb6\x3e\xc2\x7c\xd0\x3e\x46\x7e\xa3\x3e\x06\xa2\x8f\x51\xd0\xac\x8f\xae\xe8\x63\xd8\xa8\x8f\x5e\xde\xc7\xa8\x51\x1f\x7b\x62\x1e\xa3\xb8\x51\x1f\x91\xe6\x68\x1f\xbb\x8d\xfa\x88\x22\x39\xed\x63\xaf\x59\x1f\xc9\xb0\xb2\x3e\xf6\x1b\xf5\x31\x14\x7d\x8c\xdd\x66\x7d\xf4\x78\x1f\x63\xaf\x51\x1f\x7d\xd1\xc7\xd8\x6f\xd4\xc7\xbe\x98\xc7\x38\x38\x33\x47\xc5\x59\x66\xf3\x8b\x2c\x1d\x83\xe5\x30\x6a\x23\xd1\x5d\x41\x2d\x07\x47\x5c\xd0\x87\xe1\xbf\x8f\x9d\x44\xd7\xb0\xd2\x32\x1e\x2b\xe3\x41\x99\x81\xb9\x8c\xcf\xca\xf8\x50\x66\x68\x2e\x13\xb0\x32\x01\x94\x49\x0b\xda\x5c\x4d\x55\xb9\x67\xb0\xd4\x6d\x18\xd0\x16\x33\xa5\x8b\x6c\xba\xe4\x61\xc3\x5c\x46\x84\xf2\x21\xbf\xed\xca\xb1\xe9\xb3\xf1\x72\x71\x3a\x5b\x26\x13\x01\x73\xba\x43\x6a\x50\x0f\x92\x6f\x9d\xde\x86\xa5\xce\x61\x36\x5a\x72\xe8\xc2\xe3\x04\xcb\xd7\x4f\x79\x25\xd0\x7c\x93\x83\x04\xdd\x61\x84\x17\x6f\xee\x75\xcf\xcd\xef\xdb\xf2\x12\x7f\x77\x02\xbf\x5d\xde\x17\x50\x0d\xa2\xda\x7d\x34\x99\xcd\xe6\x2d\xa9\x93\x4f\x15\xdd\xfb\x46\x19\x90\x43\x50\x75\x8b\xa7\x0d\x1b\xea\xad\x56\x2b\xc7\xed\xb1\x13\x87\x34\x5f\x5a\x1f\x83\x89\x92\x07\x54\xd8\x98\xf5\xb3\xbc\x2a\xc3\x39\x57\xce\xca\x6f\xeb\x6b\x67\x8b\xe0\x98\x6a\x56\x07\x57\xa5\x9b\x6d\xdf\xa6\xb3\x61\x9d\xce\x1e\x1a\x3b\x7b\xb8\x6a\x67\x0f\x8d\x9d\x3d\xac\xdb\xd9\x62\x6f\x65\x27\xaa\x96\xe8\x3e\x0f\x36\x85\x39\xf5\xcc\xfe\x83\x68\xf0\x4e\xdd\x18\xd0\x47\x71\xd1\x34\xaf\x7c\x85\x37\xa4\xa2\xf3\xde\x30\x2b\xc5\xed\xea\xf0\xa6\xf7\x1e\x61\xad\x6b\x0f\xf3\x4d\xc6\x3e\x5e\x61\xec\xbf\x31\xdf\x5d\xec\xb3\x5b\xb2\x56\x6b\x5f\xb9\x96\xd8\x6f\x7c\x1f\x41\x69\x61\x5f\xb9\x8b\xd8\xb7\x5e\x42\x54\xdf\x38\x1c\xb3\xdc\xc0\x38\x87\x2c\x02\x4f\x8a\x63\xda\x38\x59\x39\xba\x21\x94\xb2\x7a\x54\xb0\xa2\x53\xa6\xb8\xa1\xc3\xc7\xfc\xfa\xbf\xb0\xf1\xe2\xe7\xb7\x05\x5a\xb0\x79\x57\xf2\x08\x1a\xf0\xb5\x2c\x0c\x96\x40\x52\x53\x7d\x5d\x77\x1c\xb0\x3d\x50\x30\x47\x3e\x49\x5a\x4f\xc8\x4a\x68\xb5\x06\x64\x84\x71\x73\x6c\xfd\x1b\x7e\xa6\x6d\x60\x03\xd7\xe4\xcf\x72\xc3\x10\xb0\x78\x0a\xd3\xb4\xa0\x2b\x95\xc6\x29\x0f\x7c\xc2\xde\x17\x6d\xac\x36\xd0\x8c\xde\x04\x56\xda\xf9\xbf\x1e\x56\xb8\x1d\xb7\x86\xe4\xd7\xbf\xef\x07\x2b\xed\x10\x54\x89\xd5\xc0\xf9\x99\x48\x4e\x3f\x03\x62\x77\x8f\x8c\x26\x00\x56\x22\x03\x88\x10\x8c\xfe\xb8\xe3\xc1\x91\x6f\xab\x4f\x6c\x69\xd2\xa9\xef\x4e\x49\xa2\xf4\x3a\x41\xd6\xb8\x9f\x98\xe6\xa2\x88\xab\xa1\x76\x74\x33\x4b\xe9\x55\xc3\x9a\xb1\x75\x29\x8c\x5c\x5e\xec\x44\x06\xdf\xaf\xf2\xf2\x45\x87\xaf\x3c\xbe\x98\x72\x99\xcf\x53\x9f\xd3\xac\xe9\xe4\x93\xd1\x64\xe0\x64\xc5\x5c\xf5\x77\x35\x05\x2b\x85\x76\xa8\x9e\x38\xd9\xcb\xef\x2e\x26\x8e\x3a\x95\x89\x89\x80\x7c\xf2\x30\x19\x4a\x66\xf8\xe2\x7c\xb0\x62\xc5\x39\xc9\xb3\xd9\xd3\x79\x29\xcd\xc0\xce\x22\x5b\x5b\x2c\xa0\xfc\x87\x98\x6c\x9f\x75\x4c\x36\xba\xd8\xbe\x65\x71\x86\x9c\xbd\x2c\x4b\x07\xc9\xf0\x57\x16\x57\x13\x3c\x1c\x80\x4e\x81\x66\xc4\x7c\xe3\xcb\xed\xbd\x67\x20\x02\x19\xc4\x03\x34\x73\xc2\xaf\x8a\xb5\x1c\x5a\xb8\xd0\x56\x5e\x00\x00\x66\xcc\x23\x56\x3d\xa9\xb2\xb6\x3b\xa5\xb1\xca\xd1\x80\x8a\x3c\x17\x0d\x7e\x2e\x2d\xe6\x32\xcc\xcc\xb0\xc4\x64\xc6\x2e\x9a\xb2\x10\x54\x5c\x20\xa1\x8f\xa6\x7b\x66\x29\x94\x07\x2d\x24\x87\xf2\x50\xcb\xf3\x18\xe5\xcf\xb3\x1b\xc2\x2f\xb2\xe4\x62\x6b\x9a\xb2\xde\x19\xac\x23\x67\xcc\x2c\x56\x80\xeb\xb0\x06\xda\x46\x3f\xfd\x8b\x0c\x83\x8c\xa3\x31\x26\x9d\x27\x16\x2b\x13\xfd\xe7\xa7\x10\x09\x0a\x5f\x9b\xc5\xf7\xec\xfd\x33\x16\x33\x15\x5b\x5f\x5b\x4c\xc6\xc3\xac\xc5\x51\x10\x37\xf5\x02\x97\x76\x99\xe4\x05\xb3\xb4\x93\xfd\x55\x66\xed\x16\xa3\x8b\x86\xc3\xa4\x4a\xe3\xb1\xfd\x64\x74\x73\x9a\x77\x68\x90\x91\xb3\x01\xf3\xba\x07\x82\x18\xcf\xae\x16\xf5\x48\x46\x74\xb1\x96\x38\x5e\xd2\x9b\x56\x65\x17\x34\xdf\x88\xe2\x81\x0d\xcf\x7b\xef\xf3\x60\x2d\xef\x37\x54\xa3\x71\x39\x1c\x33\x6d\x5e\xb5\x1a\x1f\xbf\x37\x1e\x69\x44\x69\x88\x49\xf2\x9e\x4d\xa1\x6b\x59\x89\xb3\xf7\x99\x73\xf0\x03\x9e\x3f\x17\x57\x83\x05\xd9\x39\xc0\x6b\xc4\x7e\x7a\x46\x7c\x85\x03\x43\xa5\x01\xb4\x8e\x8f\x36\x21\xc5\x49\xc0\x53\x16\xa5\xf2\x8c\x0e\x34\x16\x6c\x09\x20\x1d\x47\xed\x0a\x98\x58\xd3\x82\xe5\x16\xa3\x83\xf2\xa8\x31\xd4\xf4\x3c\xb7\x10\xc4\x48\x30\xa2\x51\x3c\x47\x17\xe8\x85\x61\xc1\xc5\x89\xbd\x67\x65\x06\xd7\x7c\xd3\x69\x12\xa7\x2e\x0e\x1e\x84\x8f\xcf\x5d\xf8\x80\x9c\x1a\x19\x81\xf4\x3e\xa3\x62\xc8\xec\x0a\x44\x79\x49\xfc\x40\x35\x06\x11\x8b\x07\x13\xc6\x81\x9d\x9d\xb9\xf3\x6c\x3e\x4e\xc8\xb9\x9a\xba\x67\x3a\xa3\xf1\x24\x83\x70\x74\x43\x04\xc1\x43\x3e\x63\x04\x6c\x8d\x7e\x4e\x8f\xb1\xc8\x7f\x93\x4a\xfb\xf3\xab\xc1\x8d\xf3\xcb\x39\xfc\xb3\xf6\x21\x1b\xfc\xaf\x77\x17\xc9\x78\x02\x61\x8b\xcd\xf2\xce\xe9\x31\x6f\xae\x44\xec\x91\x0b\xd5\x96\x7e\xbe\xca\xf3\xbd\x4c\x87\x70\x50\xa0\x29\x93\xc8\x07\x18\x74\x24\x3d\x91\x0e\x09\x95\x44\x54\x29\xd4\xc6\x59\xa7\xbf\x7e\x27\xeb\x98\xb0\xa2\xf9\x68\x32\xfb\x00\x75\x70\xe3\xf3\x78\x3a\x50\xa8\xe7\xc5\xed\x7f\x40\xd9\x0d\xf1\xd9\x97\x3f\xf7\xf4\xaf\x01\xdb\xc3\x58\x63\x88\x27\xa2\x02\x60\x45\xbb\x84\x22\x78\xb3\xa4\x3d\x28\x82\x28\x63\xd3\xee\x86\xa8\xe2\xe7\x55\x44\x99\xaf\x10\x01\x5a\x88\x96\x0a\xd4\x52\xac\xd8\x57\x88\x0a\x2b\xf7\x11\xff\x06\x82\x94\x4b\x3c\x7e\x3c\x08\xa4\xef\xf8\x17\x2f\x43\x8b\x90\x12\xfe\xc6\xd7\xf6\x02\xe4\xbb\xc7\xbe\xc3\xdf\xd8\x71\xde\x28\x3e\x3c\xde\xc4\x9e\x7f\xf7\x1d\xcb\x07\x29\xbf\xf6\xa9\x0a\x50\x79\xcb\x10\x2a\xb6\x24\xaa\xb9\xd7\xae\xc7\xb4\x7e\x79\x51\xc6\xf5\xa0\x10\xbc\xfc\xa8\x53\x07\x5b\x1e\xad\x21\xfd\x57\xa5\x11\xf6\x92\xde\x20\x71\x52\xca\x5f\xb6\x19\xc1\x48\x53\x40\xa6\x0f\x76\x09\xbc\x89\x81\x14\x3b\x62\x21\xd1\xc5\x53\x58\x69\x8f\x20\x0a\xfb\x15\xf8\x28\xcc\xa6\x93\x1b\xba\x1c\x77\x7e\x3c\x3a\xde\x71\x7e\x21\x5b\x44\x0f\x61\xf2\x06\x3d\x13\x16\xf4\x2e\x4e\xed\x2c\xfb\xc6\xfb\xcb\xd7\x92\x72\x16\x10\xeb\x6a\xcd\xf2\xfa\x4f\x94\x39\x9b\x8a\x9c\xc5\x60\x33\xaa\x0c\x53\x6c\x95\xf1\x44\xd1\x2c\x1f\xb0\xd2\x04\x14\xba\xc4\x53\x22\xf5\xd0\x7c\x23\xc6\x46\xca\x65\xa0\x55\xe4\xa0\x1a\xb2\x10\x97\x0e\x80\xb0\x8b\x34\x4f\x59\xd1\x57\xba\x68\xc4\x3e\x4b\xb8\xaa\xaa\xe7\x26\x42\x91\x4d\x30\x5a\x51\x38\x6a\x2a\x20\x69\xf2\x5c\xf1\xd0\x95\xd3\xbd\x7c\xf6\x12\x4b\x63\x43\x93\xac\x44\x71\x49\xc0\xb2\x8a\x58\x52\xe1\x06\x92\x56\xf8\x20\x69\x7d\x19\x6a\x9e\x82\x7c\xb5\x66\x13\x77\xca\xe5\x9c\xa6\xea\x1d\x03\x4b\xd7\x79\xf9\x03\x13\xff\xeb\x31\xf1\xd2\xd3\xec\x3d\xb0\xec\x03\x52\x38\xc3\xc8\x0d\x0c\xb8\x06\x92\xc9\x21\xf9\xe4\x3e\x71\xa8\x31\x8d\xe5\x0b\xde\x96\xff\xe1\xb8\x5f\xd4\xe6\x50\x77\x57\xa8\x3e\x6f\x43\x99\x06\xbb\x40\xf4\xb0\x0b\x7c\x11\xbb\xc0\xee\x84\x0c\xe2\x7c\x36\x1d\x0f\x1d\x48\x1f\x3c\x98\xcd\xaa\x15\xfe\xbb\xdb\x65\x0a\x7f\xfa\xb5\xd1\x8e\x40\xaa\x28\x3b\x00\x3c\xdf\xd5\x0e\x20\xb3\x76\x95\x81\xa8\xf5\xca\xb4\x98\x80\x8f\xb2\x90\xee\x0b\xbf\x34\x5b\x09\x3f\x9e\x7a\x69\xbb\x5a\x6f\x86\x65\x1a\xac\xe3\xf8\x61\x1d\x7f\x11\xeb\xf8\xe8\x6a\x09\x89\x59\x6a\x5f\xda\x1d\x95\x5e\xda\x1d\x35\xbf\xb4\xd3\xa5\xba\x23\xed\x12\xef\xe8\xcf\xbd\x0e\xba\x77\xa9\xae\xa8\x9b\x17\x6f\xee\x56\xb2\x2b\x69\xe8\x73\x91\xee\xfe\x4a\x27\xec\x23\xed\x5a\xd3\x26\x44\x1d\xd5\xb8\xb4\x38\x6a\x78\x69\xf1\x90\xc5\xee\xcb\x60\xbe\x5b\x2f\x4f\x0e\x9c\x9f\xd6\xfa\x7e\xc0\x0d\xc4\x21\x67\x09\x59\xde\xef\x6e\x0a\xdc\x97\x14\x58\xdb\x9a\x2e\xc6\x3f\x41\x69\x91\x0b\x8e\xbc\x95\xd9\x1f\x68\x61\xa5\x8b\x50\xdb\x05\xe8\x42\xbd\x01\x85\x5a\x27\xb9\xc1\xaf\x62\x00\xbc\xa1\x16\x1d\xe8\x69\x45\x06\xb6\x84\x22\x48\x14\xe4\x40\x24\x7a\xa6\x05\xb3\x42\x5b\xbc\x57\xf4\x5b\x01\x18\x7d\xf1\x44\xc5\xec\xef\xda\xf7\x42\x6b\x34\xa6\xcd\x24\x59\xd0\xc8\x59\xce\xe5\x6c\x31\x56\x3d\xf0\xa1\x51\xf8\x0e\xf5\x5f\xcd\x78\x67\x45\x0b\x8f\x35\x8c\xc8\xf9\x4d\x6b\x84\x7c\xce\x9f\x71\xa0\x44\xb6\x11\xf5\x35\x65\x25\x72\x5b\x79\x48\x2d\xb5\x91\x3c\xa4\x96\x5c\xda\x14\x5c\x4b\xb5\xcc\x7e\xac\x01\xe2\x76\x88\xdc\x02\xf7\x6a\x6a\x20\x0e\x9d\x22\xbe\x27\x8c\x2e\x4f\x38\xaf\x4c\x15\x55\xe0\x8b\xd1\x2c\x9f\x39\xa9\xcf\x2d\x15\xcd\x27\x70\xfc\x65\x7d\xcf\x2f\x82\x8a\xfb\x4a\xc1\x43\x42\x03\x63\xe9\x2d\x59\x6e\x46\xbe\xc9\x97\x0b\x04\x52\x6d\xc4\x3b\x7b\x0f\xbc\xf3\x8b\xe0\x9d\x07\x27\x47\x0e\x86\xc4\xad\xc7\x3a\x0f\x58\x00\xdd\xdb\xb2\xce\x3f\x9d\x1d\xe6\x4b\xa2\x82\x1f\x1a\x58\x15\x4d\x07\x60\x8e\x40\xb7\x36\x4f\xa6\xe9\xec\xa2\x55\xe0\x80\xed\xf6\x9a\x26\x29\x95\xc3\x61\xa9\xc3\xde\x14\xb8\x9c\x1f\x12\x32\xf1\xda\xed\x07\x46\xa5\x33\x2a\x4e\x9c\x8d\x18\x55\xff\x81\x51\x7d\x29\x8c\xea\xe9\xc1\xee\xb6\x03\xb1\xba\x9f\x78\x0e\xa3\x0d\xe7\x45\xb6\x3c\x9f\xa5\x8e\x6f\xe3\x56\x18\xda\x7b\x55\x6e\x05\x2e\x87\xe8\x3f\xa8\x2e\x88\x1a\x5c\x80\xaf\x5e\xa8\x4d\xff\xe3\x8b\x56\x69\xe0\xff\x64\xf3\x19\xe6\x0e\x03\xfb\xbf\x79\xb6\x90\xf8\xa2\xd2\x11\x28\xc7\x7a\x0c\xcf\x05\xbc\x57\xe2\x05\x6c\x21\xfe\xc6\x70\x50\x57\xa3\xb5\x79\x04\x4d\xe1\x99\x17\x36\xb8\x21\x5d\x10\x22\xa1\xc2\xe3\x93\x27\xd8\x37\xdb\x28\xf2\xf5\xfe\xe4\x49\xcd\x05\x8e\xf3\xd9\x64\x81\x77\xdd\x87\x05\xfe\x65\x9c\xe2\x9c\xe9\x6c\x76\x59\x4f\x0c\x79\xc9\xc9\xd1\xba\xb2\x05\xb1\xdb\xd7\x44\x5e\xa4\x8c\xe6\x44\x53\x8d\x88\xce\x7b\x20\xba\x2f\x82\xe8\xfe\x8f\xc4\xfc\xca\x49\x4e\xe2\x81\x7f\xa2\xf0\x5b\xfb\xe0\x2c\x9f\x6f\x0b\x02\x70\xab\x55\x2e\x02\x43\x88\x76\xfd\xd5\x4a\x5b\x8c\xb9\xc7\xd5\x71\x05\x48\xd5\xd7\x00\x5f\x33\x31\x2f\x44\x0a\x40\xcd\x82\x28\xf3\xe1\x7c\x3c\xc9\x9c\xd6\x37\xad\xdc\xd7\x3a\x8f\xc1\x8d\x1e\x87\x85\x98\xdb\xc2\x84\xb3\xa0\xc8\x1c\x8b\x2d\x49\x53\x51\xea\x8e\xdd\x18\x8f\xb7\xce\xee\x25\x51\x50\x23\x5e\xf2\x65\x3b\x6e\x19\x72\x74\xd1\x24\x59\xf7\xcb\x57\xf2\x4c\x48\xd8\xda\x5f\x3d\x49\x78\xbd\x98\xd8\x85\x98\xd7\xe2\xcb\xc9\xfe\x96\x97\xc7\x7a\x86\xa7\x8d\xb2\x44\xe0\x26\x07\xd1\x57\xc9\x62\x01\x0b\xf9\x09\xa0\x96\x3a\x90\xb0\x77\x07\x0c\xc0\x69\x4e\xc8\x3d\x3e\x28\x7e\x79\xcc\xe9\x57\xcf\x9e\xef\xec\xf9\x79\x6b\xe2\xb9\x66\xe2\xf1\xed\xd9\x74\x34\x7e\x77\xc5\x32\x51\xce\x30\x2b\xe4\xa2\x2c\xbf\xe4\x1c\xb2\x50\x2e\x6f\x9c\xdf\xe9\xb1\x18\xbd\x49\x91\xf9\x82\x77\x28\x78\x96\x2e\xe0\x61\x3c\x65\xe9\x02\x96\x33\xe1\x4b\xb3\x46\xfa\x37\x4a\xae\x26\xcb\x75\x27\x74\x5a\x9e\xdf\xc3\x44\xca\x6d\x1b\x7c\x4b\x42\x73\x70\x70\xa2\x89\xcc\x73\x70\x30\xfe\x55\x68\x8e\x97\x2c\x79\xe6\x02\x41\xe5\x87\x7a\xe9\x03\x01\x4c\x6a\x41\x92\x26\x09\xb8\x67\x49\xff\x38\x1c\xbd\x5b\xb7\x8d\x32\xbb\xf8\x3a\xc1\x98\x33\xa4\xa7\x4f\x03\xbf\x63\x4a\xb7\x48\x51\xd7\x3e\xe5\x08\x29\x8d\x7f\x6c\x97\x25\x24\x2d\x4b\x20\x0f\xb3\x92\xe6\xa4\xc5\xd7\x5b\x75\x16\xd1\x23\xe4\x73\x1f\xa1\xab\x72\xc6\x50\x18\xbf\xa1\x89\x6e\xb8\xbf\x19\xdc\x83\xc1\x29\x26\x6f\xf4\x0e\x12\x83\xfe\x9a\x8e\x0a\x49\xe3\x29\xb5\xf3\xd3\xa3\x25\xc9\x67\x75\xc5\xdf\xf3\xc9\xea\xd1\xf4\x93\xb7\x06\xd3\x51\xa7\x91\x1c\x68\x75\xc0\x25\xd9\xeb\xc9\x78\x9b\x0d\x2f\x60\x22\x36\xc5\x4f\x4e\x78\x50\xbc\x6e\xe2\x5a\x76\x5d\x41\x3d\xe8\xea\xb2\xa0\x55\xf2\xcd\x5e\x32\xd8\x48\x2d\xfc\x61\xad\x66\xe5\x45\x32\x59\x92\x03\x0e\xfc\xd3\x3c\x11\x2d\x77\xa3\x91\xfc\xda\x6f\x43\x76\x34\x91\x7a\x3a\x5a\x63\x51\x49\x5a\xbc\x33\x1d\xc4\xcf\x3a\xa9\xac\xb8\x3c\xaf\x5a\xcd\x46\xb9\x5d\xd4\xa9\xdf\x30\x27\x3b\xb1\x24\x85\x65\x5e\xf6\xe8\xbb\xcf\x68\x15\xc8\x87\xf2\xa0\x8e\x98\x9d\xf6\x86\x35\x85\x8a\x31\x41\x39\xca\xa6\x74\xb0\x69\xba\xf9\x82\x3e\xa7\x6d\x73\x6b\x3d\x20\x32\xd6\xb5\xa9\xc6\x1b\x88\x3d\x83\xff\x79\x67\x0d\x03\xf5\xe4\xa8\x08\x61\x59\xbc\xb1\xe6\xaf\xcf\xb1\xe1\x95\xf2\x37\xd6\x4a\x7c\xcb\xdb\x84\x59\x59\x63\x4f\x26\x23\x8c\x7c\x6b\xa1\x45\xf3\x17\x15\x46\x16\x9a\x8f\x22\x15\xd4\xb5\x0e\xf2\xb8\x48\xdf\x71\x7c\x8c\xc6\x05\xa2\x93\x2c\xcf\x31\x4f\x96\x8d\x14\x98\xa7\xf1\xcd\x7b\xdd\x36\xe4\x48\xc0\x8a\x22\x35\xf0\x2a\xd9\x18\x10\xbe\x32\xc4\xc6\xd1\x2d\x16\x17\x59\x8c\xf2\x57\xac\xe3\x16\x44\x0e\xc4\x18\x9b\x41\x8f\xe5\x68\x76\x0c\xac\x01\x0b\xc5\x96\xc3\xa3\xb6\x1c\xd2\xf4\x59\x8d\x39\x28\x3f\x57\x9a\xc0\xd1\x13\x23\x2d\x7f\x34\x8d\x75\x9d\xf1\xae\x0c\x05\x65\xea\x2c\x1f\x7d\xf9\x9d\x39\x60\x95\xd4\xc4\x4f\x47\xc7\x6a\x77\xd0\x75\xca\xe0\x71\x5d\x18\xb7\x5f\xa8\x0d\xcc\x2f\xdc\x06\x46\x9a\x4d\xf2\xb6\x64\xf4\x30\x5e\xa1\xa8\xf1\xe6\x17\x34\x87\x29\x74\x84\xbc\xb7\x0c\xde\xc7\xe2\xeb\x8f\x86\x0e\x4a\xcc\x81\xe9\xa6\xb1\x51\xd3\x94\x68\x4c\xe2\x8d\x7b\xf6\xf8\x71\xb9\x49\x91\x04\x5c\x3a\xfa\x72\xbe\x61\x08\x62\xc6\xf6\xb2\xbc\x5e\x99\x01\xa5\x7c\x8c\xb8\xd5\x86\x5e\x27\xd8\x4c\xe9\x46\xde\x70\x13\xbf\x2b\xd1\x72\xbc\x60\xdb\xab\x22\x5c\x56\x47\xaf\x31\x88\x06\x77\x10\xc4\x86\x8a\x08\x42\x32\xa4\x42\xa1\x4d\x4c\x68\x56\xad\xe3\x58\x64\xd3\xdb\x80\x29\x95\x4d\xf3\x20\x3b\xe2\x28\x69\x13\x60\x3a\x8e\x2e\xa8\xb2\x61\x57\xc5\x62\x28\x54\x21\x3c\x7d\x2c\xb3\x45\xa3\xd0\xcc\x81\x7a\xf4\x14\xba\x3c\x27\x2c\xe8\x81\xbe\x6c\x1f\xfa\x06\x69\xdd\xab\x93\xa3\xdf\xaf\xee\xc8\x9a\x5e\xdb\x96\xf5\xfa\xaf\xa0\x5d\x3a\x41\xe3\xcc\x6d\x6e\xbc\x4b\x95\x48\xf2\xcb\x5a\xb9\xd3\x8e\xb3\xab\x05\x24\x4d\x63\xe1\xc0\x24\x74\x4e\x1c\x39\xd5\x22\x85\xa2\xbf\xf9\xde\x51\x33\xac\x49\xdb\xc2\x31\x66\x53\x76\x98\xa1\x2d\xb3\x31\x2e\x6a\x92\x44\x79\x8c\xb1\x32\x06\xdd\x01\x4d\xc0\xec\xbc\xcf\xe6\x0b\x8c\x5a\x76\x9e\x2c\x9d\x69\xf6\x0e\x1c\x2f\x08\xe7\x24\x6c\x78\xc8\x52\xaa\x2e\x99\xc2\x87\xbc\x9a\x8c\x97\xcb\x49\xf6\x84\x06\xb8\x5c\x53\x81\x66\xf3\x39\xe1\xd9\xe9\x2c\x5b\x4c\x1f\x2d\x9d\x64\x34\x82\xb0\x67\x50\x97\x22\xf5\x68\x01\x71\xc8\xae\x08\x5a\x37\x1d\x51\x71\x70\xb5\x24\x3c\x0a\x2b\xf1\x1a\xe3\xe5\x42\x04\x54\x18\x93\xf6\x98\x13\x37\xcd\xeb\x3a\x06\xfe\x0c\xce\x53\x89\xa2\xbf\x92\x15\x65\x74\x40\x0e\x69\xe7\x84\xba\x4c\x7b\x2b\xcf\xdf\xaa\x49\xdb\xca\x0f\x29\xcf\x65\x33\x68\xeb\x01\x23\xb7\xde\xc6\x53\xc3\xfb\xb2\xd3\xc2\x98\x9d\xd0\x60\xf7\xca\xac\xe7\xb4\x9f\x44\xbb\xf0\xcb\x90\x38\xee\xf9\x1b\xb8\x72\x6f\x3d\x87\x90\xca\x34\x58\x00\x44\x1e\x7c\xfe\x86\x5d\x05\x90\xe7\x8e\xa9\x92\x47\x2b\xb9\x6a\xa5\xa0\xbc\x92\x4f\x2b\x79\x6a\x25\xb7\xbc\x52\x40\x2b\xf9\x6a\x25\x4f\x54\xd2\x52\xd7\xd5\x19\x32\xee\x65\x68\x1b\xb4\x6d\x31\x68\xdb\xe6\x41\x2b\xe2\x23\x0d\x17\xeb\x13\xbd\x30\x81\x30\xa8\x34\xed\x20\x45\x9a\x06\x59\x85\xf0\xa8\xa3\x91\xa9\xbf\xc5\x89\x08\x54\xc8\x9e\x11\xb2\x5f\x0b\xb2\x6b\x1d\x78\x09\x86\x06\x39\xa8\x05\xd9\xb3\xcd\x4e\x47\x82\xa1\x41\x76\x35\xc8\xd5\x13\xb9\x9d\xcc\xe7\x37\xa0\x3d\x36\xf9\x26\x0d\x68\xfc\x8b\xa2\x26\x63\x49\x27\x1f\x58\xcf\xe2\x66\xb1\xcc\x2e\x88\x30\x79\x45\xa4\xc9\xf1\x85\x3e\xf7\x0d\x83\xf2\x42\x64\xad\x13\x58\x7d\xf6\xf8\xb1\x86\x88\xb7\x2f\x66\xe9\x78\x74\x43\x39\x21\xa5\xc3\x1a\x58\xf4\xec\x58\x6c\xbf\xa1\x8e\x03\x3f\xbd\xc1\x94\x97\x18\x6d\xa5\x7b\x56\x23\x49\xee\x0f\x84\x01\x2f\xaf\x2e\x6b\x7b\x74\x54\x1f\xf6\x09\x48\x74\xed\x28\x3b\xe1\x1f\xfc\xf0\x16\x92\xc9\x1d\xfc\x50\x4c\x8d\x26\x15\xf1\x68\x11\xef\xcc\x72\xd8\x17\x4b\x1a\xa7\x72\x71\x35\x78\x9f\x81\xa8\x60\x3b\xfa\xbb\x34\xf8\x31\xb6\x4d\xa3\x1f\x13\x0a\xc4\x27\x5b\xf4\x63\xb9\x38\x0b\x73\x2c\xca\xe7\xd7\xa1\xe6\x30\xc7\xa2\x59\x5f\x34\xeb\x29\xcd\x7a\x55\xcd\x7a\x6a\xb3\x5e\xb3\x66\x31\x8c\xce\xd8\xe5\x4b\x10\x80\x10\x54\x94\x15\x68\xab\x1a\x60\x55\x9f\x2f\x66\xac\xea\xaa\xcb\xd4\x32\x23\x8c\xac\xcb\x58\x2b\xa5\x56\x97\x9e\xeb\xdd\x0d\xd3\x47\x8f\x7e\xf4\x8c\x1f\x7d\xfa\xd1\x37\x7e\x0c\xe8\xc7\xc0\xf8\x31\x2c\x6b\x33\x2a\x6b\x33\x2e\x6b\xb3\x2b\xda\x2c\xd1\x48\xd5\xe2\x3c\x2b\x70\x9f\x9a\x1c\xc8\xa0\xa4\xf8\x78\xd7\xc9\x5d\xad\xca\x6b\x49\xfa\xa8\xc5\x99\xd5\x22\xe6\xde\xd9\xb7\x77\x1c\xdc\xdc\xcb\x0c\xb9\x90\x5a\xfa\x84\x86\x1a\xfa\x09\x89\xd0\x69\xfd\x04\x73\xcf\x57\x09\x3e\x8b\xbd\x77\x43\xaf\xe8\xd1\x8a\x3e\xab\xd8\xd5\x2a\x46\xd6\x8a\x3e\xad\x18\xb2\x8a\x9e\x56\xb1\x6b\xad\x18\xd0\x8a\xf1\x99\x40\x4d\xa9\xe8\xe5\x15\x6f\x1d\x5a\xde\x16\xa5\x9e\x22\xc2\x63\xc7\x9f\xb0\x94\xec\x2c\x78\x3c\x3e\xae\x12\x3d\x9e\xc3\x61\x0c\x4e\xc0\x31\xc5\x8f\x37\xe2\x6b\x74\xc2\x83\xff\x5e\xe8\xde\x74\x27\xe5\x5e\x74\x32\xf5\x0b\x3b\x9e\xfc\xe6\x56\xba\xa9\x7b\x4f\xbf\xc4\x21\xf9\x60\x8d\x1e\x29\x08\xb6\x55\xd3\x15\x4a\x59\x1f\x5f\x59\x44\x50\xcd\xe0\xe7\x24\x01\xe3\xd1\x49\x6a\x65\xb5\x0d\xe4\x87\xed\xb7\x74\x72\xb7\xf5\x78\x87\xaa\x27\x41\x32\x19\x5e\x4d\x60\x85\x41\x2e\x6a\x5b\xb3\xdb\x2c\x11\xcc\x36\x4d\x04\xe3\x5e\x87\x69\x80\xff\x87\x40\xe7\x54\x42\xd3\xf3\xb5\x6c\xb3\xbc\x30\xdb\x34\x2f\x8c\x7b\xcd\x6a\x04\x18\x53\x7e\x9b\x0b\xa8\x44\x60\xfc\x8e\x14\x7a\x2b\x3d\xff\x97\xe3\x39\xeb\xe4\x47\x11\xa2\xcf\x20\xfa\x14\x22\x03\x18\x32\x88\x9e\x06\xd1\xab\x01\x31\x60\x10\x83\x42\xb7\x5a\xb4\x1d\x05\xa2\x5f\x03\x62\xc8\x20\x86\xc6\x5e\x07\x1a\xc4\xa0\x06\xc4\x88\x41\x8c\x8c\xbd\x0e\x35\x88\x61\x0d\x88\x31\x83\x18\x1b\x7b\x1d\x69\x10\xa3\x1a\x10\xbb\x0c\x62\xd7\xd8\xeb\x58\x83\x18\x57\x42\xcc\xc5\x7e\x0a\x54\xa9\xde\xd5\xab\x6f\xd8\x68\x1a\x76\x9f\x77\x4f\x6e\xb1\x88\xa0\xd4\xbb\x6b\xe4\xd5\x63\xe8\xda\xb6\x21\x09\x07\x2a\x09\xa7\x64\x63\xba\x1a\x2e\x9d\xf3\xf1\xbb\x73\x27\x99\xa6\x0e\x04\x21\x4c\xe6\xef\xae\x30\xfc\x0b\xba\x39\xff\xcf\x55\x32\x2f\x24\xee\xc1\x06\x20\x0b\x11\x69\x85\x4b\x71\x06\xe5\xc1\xbb\x01\x2d\x42\x77\x09\xe3\xf1\x89\xf7\x59\xc1\x60\x9e\x2d\xae\x26\x4b\x30\x89\x29\x69\xfe\x9c\x6e\x01\x2d\x82\xc7\xb7\x04\x19\xea\xba\xe2\x75\x21\x25\x01\x7d\x35\x60\xaf\x22\x7c\x35\xc0\x57\x26\x24\x27\x14\x90\xd4\x15\x7a\x24\x24\xe5\xaf\x71\x86\xdb\x48\x10\xbc\x80\x10\x3b\xa5\x02\xa6\x44\x30\xd0\x21\xb8\xb3\xc2\x70\x92\xf2\xc7\xef\x29\x87\x23\x9f\x7f\x26\x8d\xd7\x65\x72\x66\xa5\xca\x4f\x8c\xc5\x7d\x4f\x59\x5c\x8b\xfc\xe8\xca\x07\xdf\xef\x25\xb1\xa0\xcd\x0a\xc4\x6a\x81\x38\x2f\xa0\xd3\xf3\x4f\x8c\x1b\x7e\x4f\xb9\x61\x8b\x36\x93\xef\xb7\xdf\x73\xfe\x87\xfb\xed\x63\x07\x5a\x2b\xc2\xf0\x19\x0c\x9f\xc3\xf0\x54\x04\xbc\x02\x86\xae\x5a\xc0\x2d\xc3\x30\x60\xd0\x03\x0e\xdd\x57\x31\xf4\x35\x0c\x3d\x03\x86\x21\x83\x11\x72\x18\x81\x8a\x40\x50\xc0\xd0\x57\x0b\xf8\x65\x18\x46\x0c\x7a\xc4\xa1\x87\x2a\x86\xa1\x86\x61\x60\xc0\x30\x66\x30\x62\x0e\x23\x52\x11\x88\x0a\x18\x86\x6a\x81\xb0\x0c\xc3\x2e\x83\xde\x3d\x53\x48\x44\x60\x18\x6b\x18\x46\x0a\x86\xb5\x12\x7f\x2c\x78\xd2\x09\xa1\x6b\xad\x91\x76\xa2\xea\xba\x8b\xc2\x5a\x42\x38\x71\xe9\xde\x49\xd6\xa4\xf2\x50\x0a\x4a\x1a\x07\x7a\x5b\x54\xbc\xbf\xba\x9c\x24\x80\x0d\x81\x66\x05\xc7\xe2\xcc\xb4\xf2\x96\x4d\x10\xc5\xc5\x55\x99\x52\x57\x4d\xde\x21\x97\x6c\x97\xdd\x41\xc9\x05\x6b\x1b\x23\x77\xd4\xbb\x91\xf5\x28\xec\xe4\x97\x22\xeb\x51\xdc\x61\x77\x25\xeb\xb1\x07\xf7\x55\xe1\xc3\x7d\xd5\xc3\x7d\xd5\xc3\x7d\x95\xe9\xbe\xca\x7a\x9f\xa3\xdf\xe4\x7c\x59\x77\x38\x77\x95\x15\xee\xb9\x38\x9a\x3f\x57\x8f\xe6\xcf\x57\x3d\x9a\x3f\x57\x8f\xe6\xcf\xcb\x8e\xe6\x55\x0a\xe6\x87\x9b\xaa\x87\x9b\xaa\x87\x9b\xaa\x87\x9b\xaa\x87\x9b\xaa\x87\x9b\xaa\x87\x9b\xaa\x87\x9b\xaa\x87\x9b\xaa\x87\x9b\xaa\x87\x9b\xaa\x87\x9b\xaa\x87\x9b\xaa\x87\x9b\xaa\x87\x9b\xaa\x87\x9b\xaa\x87\x9b\xaa\x87\x9b\xaa\x87\x9b\xaa\x87\x9b\xaa\x4f\x7b\x53\x75\x67\x77\x54\xab\xdd\x4e\xd5\xb9\x97\xaa\x71\x23\x75\x5f\x77\x51\xd1\xc3\x5d\xd4\xc3\x5d\xd4\x67\x7d\x17\x25\xdf\x1d\x6d\x87\x95\x8e\x4e\xf2\xcd\x11\x29\x9e\x5f\x1b\xe1\xc3\xfd\xdf\x19\x51\x2f\x4d\x71\x6b\x64\x0e\x2a\xc0\x3d\xb4\xcb\xae\x95\xd0\x8d\x53\xf6\x28\x96\x62\xa6\x9b\x63\x42\x2c\x06\xb3\xeb\x22\x9c\x13\x81\xce\x89\x7c\x4d\x57\x26\x4d\xfa\x51\x6c\x3f\x94\xb3\x43\xf7\xb8\x5a\x8d\x0b\x5e\xc9\x06\x3d\xae\xda\x62\x87\xfb\x8f\x57\x36\xcc\x06\x05\x86\x80\x47\x95\x80\x80\x65\xd2\x38\x6d\x94\x56\x82\xad\x8d\x8f\xfd\x1b\x05\x50\x31\x12\x9a\xf2\xb9\x10\x14\xcd\x74\xf6\x87\x5e\xb4\x7e\x21\xbb\xe2\x09\x15\xb5\x19\x84\x36\xa9\x0d\xbd\xfa\xda\xaa\xdd\x30\xe3\x8c\xfb\x46\x51\x43\x20\x4d\xc0\x89\xd9\x31\x1e\x5e\xc3\x8c\x57\x4f\xcf\xb6\x51\xc5\xcf\xb2\x6a\x08\xa2\xf9\x85\x65\x99\xbd\x3b\xb5\x1c\xd7\x84\xa0\x16\xa4\x90\x7f\x1d\xa6\xc7\xac\x32\x54\x5a\x16\x4e\xce\x64\x70\x2d\x0a\x11\xb7\x7e\xd2\x77\xcc\x00\x5b\x53\x31\x22\xad\xa7\x8d\x62\x18\x3e\x36\x7c\xda\x97\x5f\xf2\xe1\xac\xcc\x00\x8f\xca\x41\xb5\xfa\xaf\x32\x9e\xda\x7c\x88\xd5\x34\xa5\xcb\x68\x4a\x55\x6a\x53\xc3\x22\x1a\xa3\x06\x9d\x26\x8c\x63\x54\xf9\x95\x95\x84\x2d\x84\x6b\x24\xda\x12\x82\xb5\x13\x6b\x4e\xa8\x85\x70\x5a\x52\x67\xff\x80\xba\x2d\xb6\xa6\xa0\x0a\xc3\xeb\x2c\xcf\x6b\x30\xd5\xf3\x18\x68\xc7\xa7\xd7\x18\x07\xc5\x70\xa3\x95\x93\xfa\xb8\x70\x76\x87\xb1\x50\xe6\x8a\x89\x65\x0a\x76\x9f\xab\xdc\xbb\x1d\xde\x85\xd0\x4b\xa0\x34\x95\x78\x8b\x7b\xac\x26\xee\x6e\x87\xc6\xd8\x16\x78\x43\x33\xce\xd2\x15\x76\xf8\x9d\xf9\xec\x52\xd9\xe5\xd9\x0b\x1c\x84\x4f\x10\x15\x2f\x85\xe6\xd4\x40\x73\x9a\x9e\x1f\x26\x1e\x4a\x89\x50\x73\x8e\xd7\xf7\xcb\x62\xcd\x01\xea\x52\xd4\x2f\x6d\x15\x03\xa8\x75\x15\x84\x1a\x31\xae\x96\x10\x03\x6d\xf0\x82\xe5\x77\x18\x30\x9e\x25\x1b\xb8\x30\x7c\x01\xbc\x60\x17\xff\x27\x6e\xe6\x4f\x9e\x18\xf7\xf0\x06\xec\x5e\x57\x88\x97\xc5\x46\xf9\x93\x57\x1b\x0c\xd1\xdd\xac\x38\x84\xd4\x7c\xd5\x31\x9a\x2f\x5f\x79\x50\xa8\xfc\xa4\xb9\x1d\xde\xd7\x31\xf3\x76\xe9\xfa\x3e\xe5\xf9\xf2\xde\x4e\x81\x9f\x36\x88\x33\xb0\x2a\xb2\xb6\xe7\xef\x09\x39\xb7\x86\x6d\xc7\x77\x3d\xdf\x19\xdc\x38\xdb\xff\xdf\xff\x4d\xe7\xe3\xa1\xf3\x22\x5b\x4c\xc7\x93\x35\x67\x6b\x32\x71\xe6\xe3\x77\xe7\x64\x50\x58\xf9\x74\x8d\x40\x38\xce\xd2\x31\x84\xf7\x19\x5c\x21\x7c\xd0\xc7\x42\x50\x9e\xf1\xd4\x59\xcc\xae\xe6\xc3\x0c\xdf\x0c\xc6\xd3\x64\x7e\x03\xec\xe0\x82\x20\x43\xa3\x34\xcc\xf1\xdf\x19\x59\x8a\x17\xc8\xd3\x87\xc8\x59\x3b\x4e\x32\xcf\x20\xe4\xe6\xc5\x78\x09\xc1\x25\x08\x1b\x7d\x3f\x4e\x21\xca\x04\x04\x9d\x80\x75\x3a\x9a\x4d\x26\xb3\x0f\x10\x43\x7a\x38\x9b\xa6\x63\xba\x86\xa1\xd2\x05\x61\x64\x6c\xc5\x3f\x71\x54\xb4\x16\xa8\x18\xa6\xf8\x0c\x67\x29\x29\x7b\xb5\x58\xc2\x46\x4d\x16\x17\x02\x4d\x06\x10\x77\x7f\x38\xbb\xbc\xc1\x2e\x3a\xd3\xd9\x72\x3c\x24\x2b\x13\xe3\x0a\x4d\x08\x24\x00\x20\xb7\x37\x4d\x35\x64\x48\x73\x43\xb2\x56\x2f\xc8\x7a\xb2\xe1\x40\xda\x92\x06\x82\xe3\x40\x7a\x98\x5e\x0d\xb3\x3b\x47\xc3\x61\x5d\x4b\x67\xc3\x2b\x11\x07\x03\x6a\x3c\x25\x43\x4f\x63\x64\x5c\x90\x55\x3f\x1f\x27\x93\x45\x3e\xcc\x38\x37\x58\x4d\x42\x1d\xe6\xf9\x74\xff\xe0\xc4\x39\x39\xda\x3b\xfd\x71\xeb\x78\xd7\x21\xbf\x5f\x1d\x1f\xfd\x70\xb0\xb3\xbb\xe3\x3c\xfb\x97\x73\xba\xbf\xeb\x6c\x1f\xbd\xfa\xd7\xf1\xc1\xf7\xfb\xa7\xce\xfe\xd1\xe1\xce\xee\xf1\x89\xb3\xf5\x72\x87\xbc\x7d\x79\x7a\x7c\xf0\xec\xf5\xe9\x11\x79\xf1\xb7\xad\x13\x52\xf3\x6f\xf8\x61\xeb\xe5\xbf\x9c\xdd\x9f\x5e\x1d\xef\x9e\x9c\x38\x47\xc7\xce\xc1\x8b\x57\x87\x07\x04\x18\x81\x7e\xbc\xf5\xf2\xf4\x60\xf7\xa4\xe3\x1c\xbc\xdc\x3e\x7c\xbd\x73\xf0\xf2\xfb\x8e\x43\x00\x38\x2f\x8f\x4e\x9d\xc3\x83\x17\x07\xa7\xa4\xd8\xe9\x51\x07\x1b\x2d\x56\x73\x8e\xf6\x9c\x17\xbb\xc7\xdb\xfb\xe4\x71\xeb\xd9\xc1\xe1\xc1\xe9\xbf\xb0\xbd\xbd\x83\xd3\x97\xd0\xd6\x1e\x69\x6c\xcb\x79\xb5\x75\x7c\x7a\xb0\xfd\xfa\x70\xeb\xd8\x79\xf5\xfa\xf8\xd5\xd1\xc9\xae\x03\xdd\xda\x39\x38\xd9\x3e\xdc\x3a\x78\xb1\xbb\xb3\x46\x5a\x27\x2d\x3a\xbb\x3f\xec\xbe\x3c\x85\x90\xac\x87\x87\xc6\x5e\x02\xee\x4a\x1f\x9f\xed\x12\x24\xb7\x9e\x1d\xee\xd2\x96\x48\x2f\x77\x0e\x8e\x77\xb7\x4f\xa1\x3b\xf9\xaf\x6d\x32\x72\x04\xbf\xc3\x8e\x73\xf2\x6a\x77\xfb\x00\x7e\xec\xfe\xb4\x4b\x3a\xb3\x75\xfc\xaf\x0e\x83\x79\xb2\xfb\xbf\x5f\x93\x42\xe4\xa3\xb3\xb3\xf5\x62\xeb\x7b\xd2\xb7\x56\xc5\x90\x90\x39\xd9\x7e\x7d\xbc\xfb\x02\x70\x26\xe3\x70\xf2\xfa\xd9\xc9\xe9\xc1\xe9\xeb\xd3\x5d\xe7\xfb\xa3\xa3\x1d\x1c\xe8\x93\xdd\xe3\x1f\x0e\xb6\x77\x4f\x36\x9c\xc3\xa3\x13\x1c\xad\xd7\x27\xbb\x1d\xd2\xc2\xe9\x16\x36\x4c\x40\x90\xa1\x22\x9f\xc9\xef\x67\xaf\x4f\x0e\x70\xd0\x0e\x5e\x9e\xee\x1e\x1f\xbf\x7e\x75\x7a\x70\xf4\xb2\x4d\x7a\xfe\x23\x19\x16\x82\xe3\x16\xa9\xba\x83\xa3\x7b\xf4\x12\xbb\x4a\x46\xe8\xe8\xf8\x5f\x00\x14\xc6\x00\x07\xbf\xe3\xfc\xb8\xbf\x4b\xde\x1f\xc3\x80\xe2\x48\x6d\xc1\x10\x9c\x90\x11\xdb\x3e\x95\x8b\x91\xf6\xc8\x00\x9e\x4a\x7d\x74\x5e\xee\x7e\x7f\x78\xf0\xfd\xee\xcb\xed\x5d\xf8\x7a\x04\x50\x7e\x3c\x38\xd9\x6d\x93\xb9\x3a\x38\x81\x02\x07\xb4\xd9\x1f\xb7\x48\x9b\xaf\xb1\xcb\x30\x47\x04\x2b\xfa\x53\xa2\xd8\x0e\xce\xa4\x73\xb0\xe7\x6c\xed\xfc\x70\x00\x68\xb3\xc2\x64\xee\x4f\x0e\x18\x9d\xe0\x90\x6d\xef\xb3\xe1\x26\xeb\x98\x6c\x9d\x8a\xce\xeb\x45\xb2\x3c\xbf\x5b\xbd\x57\xbd\xa8\xd3\x34\xf0\xb1\x28\x42\x1f\x6b\x59\x67\xe3\x85\x5d\x32\x25\x4c\x7b\x09\x46\xda\x79\x95\xb7\xbf\x4d\x8c\xc1\x36\x73\x39\xca\xed\x38\x8e\x47\xfe\xf8\xe4\x4f\x40\xfe\x84\xe4\x4f\x44\xfe\xc4\xe4\x4f\x97\xfc\xe9\x91\x3f\xfd\x8e\xe3\x91\x72\x1e\x29\xe7\x91\x72\x1e\x29\xe7\x91\x72\x5e\x24\x59\x58\x76\x69\x5d\xf8\x06\xf0\xa0\x3c\xc0\xf0\x22\x0a\x17\xea\x61\x5b\x7d\x06\xdf\x67\x30\x3c\x6c\x23\x87\x13\xb0\xb6\x42\x86\x4b\x9f\xc1\xe8\x49\x78\x76\x19\xac\x98\xe1\xe2\x51\x98\x9e\x1c\x6b\xd9\x63\x75\x39\x2e\x2e\x85\x81\x78\x70\x3c\x03\x0a\x0b\xe0\x7b\x72\xbf\x65\x38\x21\xab\x1b\x31\xdc\xbb\x0c\x86\x2f\xe1\xe9\x31\x58\x3d\x86\x0b\xeb\xb7\x17\x9c\xb5\x37\xe4\xb9\x98\x57\xcc\x05\xc7\xa3\x2b\x8d\x95\xcf\x60\x72\x9c\x63\x75\x3c\xb0\x6f\x81\xd6\xf7\x98\xd5\x09\x72\x58\x58\x37\xca\x71\xe6\x30\xf8\x78\x60\x5b\x9e\xd6\x77\x2c\x14\x49\x1d\xec\x32\x04\xe3\x7c\x70\x05\x10\x5f\x1a\x68\x8a\x6c\x0e\xa8\xc7\xea\x48\x83\x85\x13\x13\xe5\x83\x2b\x60\x04\xd2\x40\x53\x64\x25\x84\x7c\x36\xb2\xae\x04\x8c\x8f\x46\x57\xcc\x9e\xa0\x50\x87\x8d\x0e\x45\x56\x9d\x8d\x45\xd5\xca\xa0\x28\xb2\xb1\x42\xf4\xe4\x96\x38\x6d\x05\xd2\x78\xc6\xf9\x37\x85\xa6\xc9\x3b\xf8\x84\x43\xc5\xe9\xb5\x9f\xd3\x1e\xa7\x29\x2f\x92\x86\xb5\xcb\xca\x2a\xf3\xe1\xe5\x44\x20\xe6\xa2\xcf\x0a\x72\xe2\xe9\x49\x65\x38\xe2\x5d\xfc\x2d\x9f\xa5\xc4\x5a\x0e\xf3\xaa\xbc\x7d\xb1\xe6\xe5\x35\xd1\x53\x40\xe6\xa0\xf8\xfa\x8c\x72\xda\x17\xfd\xf4\x73\x14\xc4\x38\x31\x92\xa1\x70\x1d\x6d\x4a\xaa\x16\x08\x43\x4c\x19\xfc\x28\x47\x00\xfb\xd9\xcd\x17\x22\x36\x18\x32\x44\x62\x0d\xe9\x40\x1d\x7c\xd1\x69\x2f\x87\x23\xc6\x4e\x2c\x68\xfc\xae\xc0\x11\x0c\xc4\x93\x06\x29\xce\xdb\x15\x0b\x8f\x2d\x60\x2f\x30\xcc\x87\xe8\x80\x86\x38\x07\x24\x16\x9c\x2f\xfd\x1b\x89\x55\xac\x0e\x50\x64\x28\x17\xaa\x33\x23\x66\x32\xef\x14\xf9\xe3\x9c\x29\x59\xb2\xdf\x9e\xc3\x0a\x31\xcc\x87\x23\x42\x35\x93\xe5\xe5\x5e\x47\x5b\x3d\xbf\xdb\xef\xf7\xe1\x77\xbc\xbb\xd3\xdf\x7d\xb6\xe5\xc1\xef\xde\x9e\xf7\xec\xd9\xf6\xce\x36\xfc\xde\xea\x47\xc1\xde\x4e\xb8\xab\xce\xf7\xf9\xdc\xda\x40\xe4\x6e\xf9\xbd\x67\xbb\x31\x36\x40\xce\xaa\x3b\x9e\x1f\x62\x03\x3b\x5d\x37\xd8\xdd\x0b\xe0\x77\x77\x8b\x3c\xc5\xbb\xd8\x30\x47\xe8\xcc\xa8\x0f\x38\x3e\x78\xb5\xfb\x62\xc7\x8b\x5d\x0c\xbf\x5f\xa1\x43\x12\x65\x73\x2d\x92\xf4\x8a\xee\xca\xab\xde\x15\x51\x65\x22\x22\x61\x09\x82\x1d\x77\xc3\xc8\x0f\x5c\x1c\xc1\xdd\xbd\xed\x9d\xad\x67\x3d\xec\x60\xbf\xf7\x6c\x6b\x67\x7b\x6f\x17\x7e\x7b\x6e\xe0\x47\x61\x17\x07\x67\x3b\xd8\xf1\x77\xbd\x3d\xf7\xac\x7d\x5b\xa5\xfc\xed\xbc\x94\xbc\xb8\xdc\x6e\xb3\xdc\x1c\x9f\x62\x81\xba\xd7\xdc\x2c\xd2\x72\x7d\xf3\xe2\xad\x54\x9a\x5f\x1e\xbc\x1d\x9f\x35\xba\x53\x91\xea\x81\x96\xdb\x6c\x03\xda\x6a\xb5\xa4\xc6\x72\xc3\x07\xe9\x65\x33\xa3\xd2\x02\x40\x66\x57\xaa\x01\x2c\x5a\x97\x16\xc1\xb5\xcb\xe3\x10\x97\xda\x3a\xef\x3b\xe2\xfe\x01\x48\xd1\x7a\xe5\x88\x0c\x80\xb0\x01\x7b\x81\x39\x16\x98\x5b\x0b\xa0\xf8\x49\x84\x50\x7b\x01\x84\xf0\x9b\x1d\x02\x6e\xd3\x64\xb3\xb6\x17\x40\x08\x8b\xb9\x39\xa2\x35\x19\x07\xb2\xca\x7e\x85\x43\x33\x29\x3c\x06\xe9\xd8\x70\x49\x9b\x4c\x20\x1b\x55\xc7\x19\x92\x3f\x29\xf9\x93\x4d\x0c\x0d\x25\x73\x52\x88\xfc\x19\x92\x3f\x29\xf9\x93\xcd\xf5\xc6\x12\x40\x25\x01\x84\xf7\x8b\x2e\x23\x83\x39\x06\x1d\xc7\x8f\x9e\xfe\x71\x08\x1f\x87\xf4\xa3\xaf\x7f\x4c\xe1\x63\x4a\x3f\x06\xfa\x47\x3c\x30\x64\xf4\x63\xa8\x7f\x14\x69\xaa\x13\x35\x2f\xb5\xb8\xa4\xa9\x71\xf9\xdb\xc3\x7f\x1f\x6f\xc2\x2d\x91\x61\x6d\x83\xee\xb3\x45\xfa\xfc\x38\x5f\x53\x8f\x7f\x9b\xbc\x19\x9f\x9d\xb5\xff\x30\x39\x31\xa0\xd7\xce\x77\x5e\xdc\x06\x4d\x97\x0a\x88\xb4\xe1\x8c\xbc\x16\x99\x08\x32\x0f\xe9\x04\x4c\x8a\xce\x27\x66\xdf\x9b\x8f\x8e\x50\xc8\x8d\xbf\x0b\x7c\xaa\x6a\x33\x40\xf3\x75\x68\x5e\x25\xb4\xb0\x67\x85\x16\xe8\xd0\xfc\x4a\x68\x71\x68\x85\x16\xea\xd0\x82\x12\x68\xbf\x93\xa9\xa4\x10\x7b\xae\x15\x62\xa4\x43\x0c\xcf\xaa\x5d\x2c\xf8\x24\x2e\x8d\xd3\x05\x5f\x9c\xf9\x6c\x39\x69\x2d\x3b\x0b\x98\xd6\xb6\xa5\x50\x6b\xf9\x38\x9b\x98\xa7\x1c\x57\x44\x61\x49\x21\xf1\x4e\x90\xb2\x0d\x5f\x90\xdc\xb1\x5d\x58\x93\x9e\xd1\x0d\x08\xd7\xcb\x60\x62\x8c\xdb\x6e\xba\x49\xcc\xc9\x75\x4e\x86\x27\xa7\xd6\xf9\xea\xd4\x4a\x46\x7c\xde\x21\x0c\x21\x9d\xe3\x88\xcf\x6f\x47\xad\xa1\x0e\xed\xb6\xd4\xaa\x42\xbb\x15\xb5\xfa\x3a\xb4\x5b\x53\xab\xa7\x43\xbc\x63\x6a\x9d\xe3\xad\x75\x09\xb9\xce\x2d\xe4\x8a\x1c\x75\x6e\x22\x57\x64\xc4\xa6\x2f\xc8\xa2\x29\xb9\xce\xad\xe4\x8a\x1b\x80\xa9\x36\x6e\x0d\xcb\xca\x0d\xfd\x40\x4e\xc7\x80\x32\x24\x5a\xfd\x6a\x12\x26\xfc\x45\xfa\xb7\x4f\x4d\x73\x87\xc0\x99\x53\x43\x4f\xf7\x99\x09\xef\x3e\x35\xbf\x4d\xa1\x5c\x66\x2c\xe7\xb3\x72\x68\x48\x9b\x41\xb9\xc4\x58\x2e\x60\xe5\xd0\x58\x16\x77\x84\x81\xb1\x5c\xc8\xca\xa1\x61\xf2\x00\xca\x0d\x8d\xe5\xd0\x80\x59\x19\x16\x5d\xac\xdd\x63\xa9\x35\x6e\x61\x9e\x05\x49\x0a\x85\x30\x04\x0f\x1b\xe6\x32\xe5\x69\x18\x79\xc9\xe9\xb3\xf1\x72\x71\x3a\x5b\x22\xc7\xa3\x30\xa7\x3b\xa4\x06\xb5\xda\xfa\xd6\xe9\x6d\x58\xea\x1c\x66\xa3\x65\x21\x69\x23\x96\x2f\x74\x06\x72\x2d\x17\xb2\x10\x23\x0d\x8a\x5c\x8e\x39\x48\x90\x26\xa3\x33\xb0\x9a\xc0\xc4\xc2\xb9\x8d\x84\x28\xf1\x77\x87\x30\xa4\x0d\x1b\x24\x22\xb0\xe6\x45\x1f\x3b\xc0\x1f\x00\x64\xbf\x0d\xa0\x42\x58\xb7\x5e\x68\x11\xa0\x79\x55\x3a\x1c\xb9\xf0\x2c\xbd\xac\x2f\x3c\x17\x80\x31\xc1\x59\x03\x56\x25\x38\x9b\x3a\x2a\xe7\xe9\xc8\xf3\x61\xf2\x1c\x3b\x68\x1c\x63\x48\xdb\x01\x86\xfc\xb0\x02\x31\xbb\x0b\x75\xc8\x32\x1a\x4e\x5d\xd2\x93\x57\xab\x61\x4a\x23\x5c\xdd\xb2\x8c\xbe\x61\x92\xe2\x37\x1d\x59\x7c\xbf\xdd\xf9\x2d\xaa\x75\x7c\x33\x9f\xc8\xf6\xf1\x28\xb6\xff\xa6\xe1\x19\x6c\x5f\xb8\xeb\xed\x2b\xc7\xab\xfd\xc6\xe7\x2a\x4a\x21\xfb\xca\x99\x6a\xdf\x7a\x98\xaa\x36\x85\x3b\xa6\x37\xe1\x74\x72\x59\x06\x8b\x14\x07\xdb\x98\xbc\x45\x9b\x20\x85\x4d\x0d\x27\xb3\x69\x39\x83\x42\x53\x02\x28\x95\x6b\x17\xf0\xd1\x6e\x06\x41\x3f\xbf\x2d\x10\x09\xad\x57\xa4\x35\x86\x26\x7e\xb5\xa4\x97\xd1\x2c\xe2\xc8\xe6\x7c\xdd\x71\x6e\x3a\xce\x6f\xa6\x34\x1f\xad\xd6\x35\x7a\x76\xde\xe0\xdf\xbf\xe5\xd9\xda\x3f\x16\xe0\xf8\xe5\x70\x08\xa0\x7f\x10\x30\xd4\x9d\xfc\xdf\xf0\x40\xa0\xb5\x37\x6c\xd0\x82\x4a\x68\x00\xe8\xdf\x00\x31\x47\xcd\x02\x2b\xac\x86\xf5\x0f\x84\x80\xb8\xdd\x10\xd4\xfe\x8d\xc8\xd9\x21\x46\x75\xc6\x0c\x06\xed\x8f\x1c\x94\x05\x16\x8a\x12\xd7\x9d\xa9\x11\xd2\xf5\x77\xdf\x4d\x11\xab\x6b\x42\xdf\xad\xc0\x7f\x32\x95\x91\xaa\x61\x0d\xc3\x8d\x61\x68\x1e\xb8\x7a\xc6\x30\xd6\x6c\x3f\xcc\xbe\x05\x6d\x9e\xf8\x6f\x02\x1f\xe9\xb1\x76\x7e\x1e\x4b\xe9\x55\x13\xf3\xb0\x95\x91\x5b\xb2\x70\x05\x5e\xeb\x11\x43\xf1\x91\xc1\x0a\xc7\x5e\xeb\x03\xc7\xd6\x64\x6e\x53\x54\x0e\x6a\xa6\x36\xbc\x40\x3d\x53\x25\x3e\x39\x90\xb4\xef\x13\x4c\x4d\x49\x75\x30\x5d\x87\xaa\x8b\x8c\xf4\x67\x69\xc9\x9d\x64\x99\x50\x4c\x39\x78\x87\x13\x4a\x13\x19\x8a\xa9\xd9\x27\xcf\xf9\xf4\xc8\x26\x56\x86\x19\x52\x0a\x17\x67\x89\x7c\x35\xcc\xd4\x57\x1f\xe9\x3d\xb0\xc5\x34\x8a\x97\x34\x67\x27\x02\x13\xa7\xee\x83\x89\xd3\x5f\xc1\x65\xe5\xbe\xaf\xee\xb5\xc0\x6a\x52\xc3\xd6\x90\x69\x27\xfb\x5b\x4f\xbc\x1a\x37\x19\xc5\x5c\xd5\x77\x79\x7f\xb1\x89\xde\xa7\xf9\x15\x06\x44\xac\x6a\x19\x02\x50\xa9\x57\x1a\xd9\x68\x98\x26\x83\x5e\xbf\x63\x2a\xd8\xef\x0d\x92\x74\x38\xca\x94\x3b\x0e\x53\xc1\x61\x90\xfa\x99\x37\x72\xb5\x38\x4c\xed\x8d\x4f\x16\x2e\x64\xdf\xa8\x41\x5f\x49\xf7\x6c\x56\x17\x63\x32\x4c\xb3\xb2\x18\x05\x46\xb3\xaa\x18\x8f\xab\x66\x45\x31\x7c\xca\x84\x9a\xb8\xbe\x9e\xd8\xaa\x13\xb6\x9c\x16\x50\xef\xe3\x50\x0f\x53\x4b\x2c\x98\x1f\xa9\xe0\xaf\x86\xc0\x50\x7d\x4f\x75\x85\x92\x3d\xd3\x25\xf8\x39\x10\x80\xe4\x50\x0b\x71\x75\xd8\xcf\x5e\xfe\xd3\x0b\xa5\xdf\xb1\x2d\x77\x24\x43\xa9\x35\x45\xe7\x58\x7a\xb6\xc4\xe3\x43\xe0\x99\xc3\xd4\xd8\xdd\x74\x5a\xad\x04\x80\x44\x08\x24\xa1\x27\x99\x2e\x3a\xc8\x66\xe4\xcf\x8f\x66\x27\x1e\x3e\x6a\xbe\x6b\x1d\x35\x54\x9b\xb5\x5a\x03\x22\x96\x0e\xa9\x9c\x0b\x3f\x53\x84\xec\x5e\x47\x09\xbd\x85\xad\x50\xf1\x81\x37\x48\x55\x13\x03\x32\x5e\x43\xf2\x27\xa5\x90\xe3\x2c\xed\x67\x83\xc4\xab\x86\x1c\x37\x40\x9e\xe2\x0e\xbf\x86\xac\x17\x4f\xe0\x4a\xd7\xcd\xc2\x30\xf0\x43\x7b\x5b\x4f\xbf\x15\xcd\x81\x6e\xf1\xdb\xa7\xb5\xfb\x02\xf0\x83\xa8\x9f\x06\x99\x9f\xd4\x9c\x52\x58\x2f\xa9\x49\xdd\x07\xb7\x35\x26\x15\x9f\x83\x4d\x92\xd9\x0f\x5c\xd6\x4b\x9c\x7e\x63\x6e\x51\xb8\x2b\x32\xa9\x1f\xeb\x79\x6b\xd5\x52\x06\x32\x25\x1a\x57\xb6\x25\x6d\xc3\x2a\xcb\x15\x81\xa8\x30\x1c\x98\xcb\xf8\xb2\xb2\x70\x68\x2e\x13\xc8\x8a\xc2\xd4\x5c\x26\x94\x95\x84\x99\x5e\xe6\x41\xc1\xf7\xb9\x2a\xf8\x40\x16\x5e\x1b\x4d\x66\xb3\xb9\xac\x73\x7b\x8a\x1b\x35\xfb\xef\x56\x8d\x60\x2c\x84\x1c\x72\x85\x9e\xae\xa0\xa6\xbb\x27\x0d\x5d\x43\x3d\x90\x51\x5d\xf7\x25\x6a\x83\x1e\x54\x08\x05\x65\x00\x88\xcf\x8d\xb4\x07\x58\xa1\x4c\x71\xa0\x0a\xe4\xaa\xce\x00\xbe\x3d\xa8\x0b\xee\x54\x5d\x80\xf3\x51\x43\x53\x60\x9e\x96\x5c\x49\xc0\xa6\xc6\xee\x36\x05\x05\xec\x6a\x81\xde\x17\x1d\x60\x63\x71\x9e\xf8\x51\x7c\xdf\xb1\x31\x58\x2b\xff\x39\xea\x83\x82\x7a\x40\x3e\xc3\x93\xa1\x50\x4e\xf1\x92\x17\xb6\xae\x15\xf0\xc9\xde\x5f\x4b\x2f\x00\x05\x15\x98\xf8\x4c\x81\x7f\x52\xdd\xc0\xd0\x73\xa3\x7e\x96\xf6\xe0\xc8\x1f\xc4\xdd\x61\x1a\xb9\x5d\xfc\xed\x76\xdd\x34\xf5\xf0\xf7\x88\x9c\x0a\xa2\x7e\x60\xd6\x19\x8c\x46\x43\xd7\x1d\x04\xa8\x5c\x88\x7b\x51\xcf\x8b\x3c\xfa\x3b\x1c\xf5\x7b\xa3\x04\x01\x0c\xb2\x51\x12\x92\xff\x37\x50\x17\xd4\x92\x3c\x25\xb6\xcf\x86\x4e\xaa\x59\xe2\x45\x8b\x1c\x55\x88\x33\x4f\x36\x8b\xc2\x8b\xe5\x62\xe9\x61\x8b\xae\xd8\x71\x09\x1d\x37\xdd\xa4\xa1\x4a\xc5\x36\xad\xac\x8e\xc2\x46\x4d\xbe\x3e\x6c\xd5\x77\xbd\x55\xc3\xac\xd4\xdb\xac\x8d\x93\xa3\x6c\xd7\x74\x82\x4a\x37\x6c\xdf\x0f\xcd\x5b\x76\x27\xdf\x8e\xd6\xbb\x7d\xd8\xc0\xfb\x0f\x7a\xfd\x3f\x67\x63\xfe\xf2\xdc\xf2\x0e\x68\x12\x87\xf1\x6f\xc2\x2b\xd7\x99\xcf\x88\x00\x07\xce\xbd\xb2\xbf\x9e\xd4\x83\x7d\x3d\x75\xca\x73\xf5\x1a\x80\x2b\x6a\xb3\x1c\x06\xfd\xd2\xb2\xec\x53\xe2\xf5\x78\xf1\x6a\x3e\xbe\xc8\x5a\x53\xe3\x36\xb6\xf8\x9f\xf9\xf2\x25\x3f\xe7\xc3\x03\x29\x67\x33\x0e\xa6\xd3\x49\x0a\xfb\x1b\xfc\xf7\x77\x9b\x14\x02\x7f\x51\xa2\x1b\xfe\xa6\x35\x75\xfe\xce\x8a\xb5\xad\xfa\x42\xb6\x46\x47\x09\xa1\xac\x3a\x61\xc2\xcd\xe7\xf1\xf9\x55\x66\x89\x03\x23\xc5\xfe\x59\xee\xcd\x13\xfc\x9d\x4c\x40\xff\x60\x18\x20\x71\x85\x3f\x85\x58\x50\x53\x8c\xec\x09\x56\x41\xb2\xf2\x43\xd7\x64\x7d\xad\x86\xec\x99\xe2\x70\x69\xef\x70\x42\xb4\xf8\x3d\x1f\xce\xc7\x93\x8c\x34\x42\xbf\x7d\xe7\x30\x93\xcc\x82\xae\x58\xcc\xa6\x75\xa0\x05\x88\x9e\x75\x94\xf7\xdf\xd0\x42\x18\x76\xb4\x30\x10\x48\x0b\x97\xb3\x0f\xad\x69\xc7\xf1\x9c\xa7\x8e\xdf\x6e\xd7\x33\xd0\x7c\xde\x08\x6c\xd0\x36\x06\xcf\xa6\x20\x1e\x3f\xae\x50\x85\x4e\x95\x12\x5c\x42\x6a\xe5\x30\x57\xbf\x63\x03\xc1\xbb\x9e\x34\x5d\x94\xd0\xef\xfd\xa6\x6d\x7f\x6d\x31\x19\x0f\xb3\x96\xdb\x7e\xb8\xf5\xaa\x7d\xeb\x55\xf8\x34\xc2\x4f\x91\xe9\xd3\x3b\xfc\x14\x9b\x3e\x9d\xe3\xa7\xee\xad\xaf\xd1\xe2\xf0\xf3\xb9\x46\x7b\x97\x5c\x5c\x24\xee\xb5\xb8\x4c\xf3\x22\xdb\x55\x59\x5e\x1a\x0d\x8d\x5b\xbc\x26\x98\xde\xd1\x4b\x2f\xfe\x06\x94\xbc\x70\xf3\xe5\xfc\x6c\x35\xdb\x53\x2c\xf8\x24\x48\x5e\x58\x80\xe4\x81\x25\x5f\x2d\x48\x6a\xbd\xc0\xc8\x5e\x94\x9e\x78\x79\xbf\xfd\xca\x6e\x7b\x52\xb7\x3d\x8a\xac\xd4\x6d\xef\x9a\xc7\x9b\x6e\x37\xea\x36\x83\x14\x14\x21\xf5\x1b\x76\x9b\xd7\x73\xad\xfd\x66\x84\xc3\x26\xf1\x31\xeb\x39\x06\xf9\x65\x3d\x7c\x5c\x7a\x61\x6a\xbb\xfd\x1c\xa2\x57\x63\x2b\x73\xfe\xe1\x8c\xd0\xd8\xed\xdf\xf0\xf3\x9d\x2d\x85\xcb\x45\x82\x11\xe6\x12\x52\x66\x80\xc5\x13\x7a\x3b\xf8\xb3\xc3\xee\x09\x37\x2c\xed\x90\xa3\x33\x20\x2e\xae\x5a\xd9\x65\x1b\xbb\x6b\xa5\xa6\x7f\xf4\x0b\x19\xbc\xfc\x0b\x19\x5c\xf9\x93\x52\xc9\xbc\xb7\x89\xc6\x30\x4f\x0d\xe9\x18\xd0\x38\x8d\x9c\x9c\x61\xbd\x98\x41\xa4\x5f\x3c\xe9\x8b\xe7\xc9\x9f\xba\xd4\xb2\x95\x7e\x22\xab\xc4\xda\xb5\x25\xb4\x04\x66\xc7\xac\xd9\xc7\x30\xa8\x8f\x69\xa2\x37\xeb\xe5\x31\x56\x84\x0c\x3e\x6c\x60\x1e\xc3\xd0\x9a\x5a\x00\xc6\xf5\xce\x00\x00\x78\xdd\xc8\xf0\x1e\xd8\xa3\x49\xfc\xc2\xd8\x99\x98\x2d\xc1\x6b\x5b\x38\x4e\xd9\x15\xe9\xa0\xe1\x75\x68\x6b\x09\x03\xb1\xf4\x4d\x6d\xfd\xa7\x5c\x8d\xd2\x32\x11\x2b\x83\x21\xc3\x47\xe6\x32\x31\x2b\x83\x21\xc1\xdf\x99\xcb\x74\x59\x19\x5c\xf3\xe7\x0f\xd7\xb0\x0f\xd7\xb0\x0f\xd7\xb0\x0f\xd7\xb0\x5f\x88\x8e\x37\x8a\x1b\xeb\x78\x49\x95\x2a\x1d\xaf\x7c\x66\x2b\xea\x78\xa3\xf8\x41\xc7\x7b\xe7\x3a\x5e\x32\x2b\x35\x75\xbc\xa6\xc9\x51\x75\xbc\x38\x41\xe5\x46\xdb\xe2\xee\xcc\x7c\x35\xdb\x73\xbf\xe8\xab\xd9\xeb\x38\xfc\x24\x89\x0b\x44\x3b\x0f\x5a\x60\x55\x0b\x4c\x06\x06\xa1\x93\x7f\xa5\xf7\x3f\xc5\x21\x8b\xd2\x8d\x25\xd6\xa4\x38\xd1\x8d\x62\xba\x49\xfd\x3b\xde\x3f\x7a\x7b\xb4\xb7\x77\xb2\x7b\x7a\xa2\x6b\x8b\x5f\x1d\xbc\x3d\x78\xb9\xb3\xfb\xd3\x6e\x31\x2b\xf7\xf1\xd1\xeb\x97\x3b\x6f\x21\xe2\xe0\xe9\xd6\x4b\x51\xb3\xa0\x56\xde\xae\xa7\x56\x96\x6a\xcc\xcf\x67\x3c\x68\x8b\xa6\x93\xe6\x4d\xc3\xe9\xda\xeb\x38\x37\xb6\x50\xe5\x4b\xaa\x12\x59\x82\xfd\x6b\x48\xfe\x35\xa8\x44\xa4\x3e\xbf\xb9\x26\x92\x4a\x44\xd8\xcb\x0d\xf5\x1e\x5c\x72\x27\x4d\xfc\x45\x0e\x09\xa0\xa9\x24\xf2\x56\x1c\x1a\x45\xc7\xec\x03\xa4\xb2\xbb\x21\x05\xa2\x0d\xe3\xe7\x7f\x01\x54\x9f\xc0\x83\x76\x02\x68\xa7\x6d\x28\x7c\x4d\xd5\x72\x3f\x69\xaf\x6f\xe8\xeb\x7f\x95\x64\x6f\x62\xc3\x76\x39\x26\xdb\x02\xa4\x13\x30\x0c\x9a\x18\x99\x6b\x3a\x32\xd7\xd4\x41\xf3\xda\x30\x30\xa2\x28\x1d\x5d\xf2\x0f\x16\xbd\xb1\xa8\x95\x72\x02\x51\x87\xf1\x06\x13\xfc\x14\x7b\x0d\xe3\x1a\x95\x45\x61\x36\x93\x84\x7a\xcb\xa1\x52\xc3\xe1\xde\xc9\x31\xe0\x7a\xed\x7a\x1b\xe5\x59\xcc\xfc\xd0\x9e\xc6\x0c\x9b\xe0\xc4\xfa\x62\xf1\x41\xa3\x2d\x63\xb1\x43\x5e\xcc\x32\x84\x2c\xf3\xc4\x2f\xa4\xe9\x2e\xf9\xa7\x44\x33\x87\x7d\x40\xd7\x54\xaf\x5d\xa6\x55\x1b\x8c\x97\xaf\x66\x0b\x8c\xe3\x0a\x54\x85\xc9\x72\x7f\x01\x53\x64\xcf\xa2\x65\x02\xe0\x72\xa5\xef\x1c\x16\x2f\xc2\xa6\xf3\x29\x74\xf0\x67\xb2\xd6\xa0\x19\x09\x8a\xa5\x2d\xd5\xa2\x5a\x6e\xf5\x9f\x9b\xd8\xac\xdd\xb8\xba\xd0\xf2\x0b\xa9\x65\x05\xd4\x13\xc3\xb9\xa7\xe4\xea\xe0\xa3\xd9\x81\x98\x13\x16\xa4\xad\x44\x02\xaa\x98\x96\x9e\xdd\x12\x9d\x40\x83\x9b\x05\x82\xdd\x7b\xb2\x08\x67\x93\x9b\xe9\xec\x02\x32\xad\xcf\xde\x13\xf6\xfe\xfd\x5e\xcb\x6f\xaf\x3b\xd7\x3f\xf7\xc8\x2a\xb8\xfe\x39\xc6\xbf\x23\xfc\x1b\x32\x14\x7a\x66\x90\x8c\xa2\x69\xf3\xd4\x7f\xe0\x67\x30\x66\xf7\x1a\x6b\x62\x19\x84\x4d\x23\x7d\x54\x5c\x7a\x69\x0c\x9e\xea\xf8\xd8\xf6\xc3\x03\x8c\xe9\x73\xd6\x29\xd0\x4f\xbb\xe9\x6d\xca\x02\xd7\xcf\x32\xbb\x20\xe2\x0a\x44\x3d\x96\x33\xd1\xc1\x12\x38\x95\x37\x22\xeb\x2d\xa5\x31\xeb\x8c\x79\xf5\x9f\x1a\x7b\x56\x85\xb7\x76\xb7\x13\xd4\xbb\xd9\x09\x94\x7b\x9d\xa0\xec\x56\xe7\xee\x53\x09\xcc\xae\x96\x84\xe8\x0f\xf1\x68\xad\x94\x75\x50\x48\x4f\xb3\x05\x11\xfd\x52\x29\xd1\x00\x59\x77\x0b\x1e\x10\x9a\x56\x56\x4e\x0b\xbc\xf2\xd1\x74\xc2\xa7\x49\x8a\xc1\x9d\xcc\x21\x7b\x0a\x44\xd9\x23\x42\x72\xc7\x09\x7a\xe4\x57\xe4\xf9\x7a\x65\x96\xb3\x60\x1d\xbe\x95\x25\x2d\xe0\x87\x66\x6b\xde\x02\xb9\x77\x1a\xb4\x15\xf2\x17\xa0\xa6\x16\x33\x21\xf2\xb3\x37\x7f\x7a\x73\x56\x27\xc1\x91\xfd\x22\x06\xe1\x50\x92\x83\x6b\x38\x4e\x76\x78\x09\x57\x15\x4a\x00\x31\x11\xd9\x7a\x71\x07\x88\x5d\x17\x6e\x19\xc8\x58\x8a\x4c\x09\xf2\x48\x80\xf4\x12\xf8\xf7\x75\xa3\xb7\xa8\x35\x68\x46\xe5\x19\xef\xc4\x61\x32\x45\xdd\x8f\xd6\xb5\xa7\x70\xbb\x5d\xd4\xa1\x0d\x16\xb3\xf9\xa0\x7a\xfc\x35\xf0\xb7\x89\x24\xf8\xc2\x1f\x3b\xea\xa5\x18\x8c\xb5\x55\x6d\x4e\x8a\x7b\x86\xe2\xc0\xeb\xcb\x82\x59\x58\x53\x50\x43\xf3\xa5\xf1\x04\x7d\x25\x90\xa0\xbf\x4a\x04\x41\x5f\x09\x1d\xe8\xaf\x1e\x33\x90\x21\xec\x55\x61\xec\xa9\x28\x7b\x2b\xe1\xec\xa9\x48\x7b\x4d\xb0\x36\xce\x03\x25\x2e\xa1\x1a\x19\x4f\x97\x33\x4a\xcd\xe6\x99\x9e\x24\xa8\xcc\xe3\xcb\xd9\x30\x14\x50\x62\x0d\xf3\xcd\x12\x49\x06\x50\xb4\x95\x81\x54\xb4\xac\x4c\x8d\x58\x22\xb0\xc1\x2e\x2c\x6b\x80\x0a\xca\xb8\x0e\xe8\x4f\x2a\xf4\xe2\x6f\xfb\x12\x20\xdc\x7f\x99\x18\x6e\x6b\xea\x9f\x1a\x24\x60\x2f\xc6\x70\x04\x99\x5c\x5d\x4c\xb1\x73\x0b\xbb\x30\xcb\xc5\xec\x8e\x93\x4b\xd2\xc6\xc2\x0d\xcf\x24\xd6\x59\xca\x4f\x28\x1b\xf6\x5a\x5c\xf4\x14\xb3\x57\x56\xf6\x50\x2a\x4b\x66\xd1\x2a\x97\x5a\x47\xeb\xd4\x28\xe7\x98\x7a\x72\x0a\xb3\x70\xfa\xe6\xda\x86\xfb\xe9\x35\xa5\xb5\x4d\xec\x81\xbd\x10\x12\xdb\x26\xa2\x5e\xcf\xfa\x66\x35\x32\x28\x61\xab\x79\x8f\x42\xec\x52\x0b\xe6\x25\xc4\xe3\x61\xd9\x65\xf9\xe9\xb5\x97\x17\xf7\xea\x14\xa7\xf4\x45\x7e\x94\xcd\x23\x2b\x7b\x28\xca\xe2\x3c\x96\x92\xf7\xe2\x6a\x8e\x2b\x8a\xa6\x13\x01\x52\xaf\xa6\x72\xd2\x59\xc6\x0b\x08\xff\x62\xb8\x09\xd7\x60\xd6\x3e\xf3\x0f\x6e\x97\x60\xba\x3c\x14\xd0\x28\xd7\xa0\xd0\x0e\x35\x68\x2f\xaa\xa1\x7d\xd2\x45\xa5\xb0\xc2\x12\xfa\x2c\xb0\x44\x0b\x99\x36\x3b\xed\x1d\x9f\xcf\x9c\x57\xe3\x12\xca\x86\x26\x79\xce\x47\x6f\x43\x7a\xa4\xa2\x9c\x78\xbe\x35\xc9\x2b\x23\x27\xc0\x96\x91\x31\x14\xa2\x14\x54\xc5\x90\x78\xe9\xc3\xbc\xb4\x9d\x25\xe1\x66\x71\x3e\x3b\xa2\x52\xca\xa6\xa2\x0f\x93\xf1\xb2\x76\xf6\x78\x86\x82\x5e\x09\x8b\x87\x03\x74\xde\x44\xa5\xe2\x41\x5a\x2b\x2d\xde\x69\x42\xd2\x02\x02\x92\x36\xef\x1f\xa6\xd2\x0c\x7c\x22\xf9\xe6\xdf\xdb\x1b\x15\xc0\x0f\x73\xe0\x87\x36\xe0\x2f\x1a\x02\x57\x75\x1e\x79\x77\x6b\x69\x3c\xf4\x2e\x33\xac\x24\x30\xa8\xed\x28\x22\x17\x87\x2b\xf7\xfc\x45\x45\x1b\x87\x0d\xdb\x28\xdb\xdb\xe6\xc9\x74\x71\x39\x5b\x64\x55\x82\xc0\xe9\xab\xf1\x21\x5d\x18\xa7\x6f\x24\x85\x62\x4e\x87\xd6\x3d\x8f\x56\xac\xb3\xf1\xb1\x92\x55\xbb\x9f\x95\x7f\x5c\x8e\x9d\x64\x89\x5b\x20\xf2\x4b\xb3\x4c\x78\xea\x62\x1f\x5c\xdb\xe9\x00\xd7\xbd\x2b\x18\x80\xb1\xdc\xa9\xcb\xbb\x43\x4b\xdb\x96\x3c\x29\xc7\x3a\xc3\xca\x59\xf6\x2d\xd0\x76\x9d\x8f\xef\x64\x5b\x5f\x71\xcb\xa8\x66\x8d\x32\xfb\xe2\x7c\x58\xec\x2b\x1b\x0d\x36\xa3\x4a\x96\x2a\x66\x4a\x10\x5c\xfd\x2a\x64\x9b\xe5\x95\x5a\x92\x10\xd2\xae\xb3\x01\x52\x00\xbe\x06\xc0\x2f\x00\x28\x1d\x45\x90\x3d\xe0\xfe\xab\xce\x3e\xbb\x49\xbb\x28\x64\x8e\x7f\x33\xf4\xe9\x8b\x7f\x70\x64\xf0\xb1\x5d\x6b\x37\xe6\x10\xb9\xdc\x21\x20\xe2\x8b\x1c\x22\x79\xbc\xbd\x82\xf6\x60\x66\x3a\x91\x14\xe6\xdb\xba\xd2\x14\x35\x24\xec\x6f\x9a\x32\x13\xbf\x57\x1e\xda\x14\x28\xb6\x85\xa8\xc8\x2b\x6a\x0d\x58\x92\x25\x97\x1d\x9f\x97\x59\xd4\xa7\xb0\x8a\x2a\x1a\x67\x73\x45\x0d\xd4\x2b\xaa\x81\xbe\x45\xe5\xd5\xdd\xd9\x52\x79\xab\x98\x52\xa1\x91\xd4\x30\x1b\x4f\x54\x7b\x27\x0f\x74\x6b\x0a\xfe\x70\xb9\xa5\xbd\xc0\xc6\xf1\x82\x46\x58\x73\x7d\x26\x46\x50\xb7\xd6\xe1\xc9\x6a\x46\x8e\xbc\x51\x07\x49\x86\xa9\x57\x51\x9f\x6b\x01\x8b\x30\x9f\x1a\x8d\xe9\x4e\xfe\xe7\x2a\xcb\x7e\xcb\xcc\xee\xb3\x9c\xb8\x6b\xe5\xa2\x2f\x60\x71\x1b\x65\x61\xb5\x4e\xa8\xbe\x48\x6f\x13\xe7\x9b\xeb\x10\xf3\xf6\xec\x5a\x39\x49\x34\x64\x8a\x39\x59\xe0\x6c\xa4\x9b\x93\x40\x31\xf5\x9c\x0c\x6a\x55\xbd\x62\x3e\x14\xe5\x9d\x38\x2c\x74\xe2\x70\xd5\x4e\x1c\x16\x3a\x71\xd8\xac\x13\xe6\xa9\xa2\xa4\xcb\x16\xd9\x72\x06\x86\x7b\xf3\x71\xf6\x3e\x33\x18\x20\xa2\x8d\x35\xa7\xe8\xb5\xcb\xab\xc5\x39\x47\xc3\x34\x44\x86\x92\x2f\x8a\x25\x6f\x1f\x9e\xd8\xe0\x3d\x24\x9a\xee\x14\x97\x70\xfb\x9e\xad\x26\xed\xfc\x8b\x99\x50\x1a\xd8\x99\x70\x76\xda\xb8\xb3\xcb\x1c\xfe\x33\xb7\xcf\xac\xe3\x52\xf8\x60\xae\xd9\xd4\x5c\x33\x68\x6a\xac\x19\x54\x99\x6a\x06\x25\x86\x9a\xc1\x83\x99\xe6\x5d\x9b\x69\x06\x35\x8d\x34\x83\x0a\x13\xcd\xa0\x8e\x81\x66\x60\x77\xc3\x17\x86\x87\xeb\xbd\x10\xcc\x35\xbd\xbf\x88\xb9\xa6\x1e\x60\x27\xf2\xfc\x4f\x66\xc3\xc9\xc3\xed\x40\x9b\x5f\x56\xb8\x9d\x95\xac\x2d\xd9\xe7\xdc\xda\x93\x97\x69\x14\x90\x87\x0c\x96\x62\x16\x42\x9e\xad\x66\x26\xbd\x9a\x01\x79\xa0\xa0\x62\x6a\xd2\x63\x51\x2d\xc0\x04\xe3\xae\x5c\x88\xe5\xee\x5b\x63\xf2\x14\x8c\x1c\xdc\xeb\xe1\x60\x30\xe8\xa7\x51\xda\x91\x02\xf6\x10\xb2\x35\x94\x8c\xfd\x7e\xe2\xf7\xfd\x44\x0e\xe7\xd3\xee\xd4\x6a\xa4\xef\x45\x7d\xd7\x8b\x12\x39\xfa\x8f\xb9\x11\x2f\xf2\x47\xd9\x90\xc6\x0c\xe2\xb1\x81\x6a\x36\x12\x77\x83\xc0\x8f\x63\x1a\x56\x88\x45\x0e\x32\x37\xd2\xcb\x06\x61\x98\xf4\xba\x72\x5c\xa1\x9a\x8d\xa4\x03\x77\xe8\x67\x6e\x2a\x87\x21\x32\x37\x12\x76\x07\x51\xd8\xf3\x52\x39\x48\x51\xfb\x7e\xa3\x14\x01\x3d\xad\x18\xa5\xc8\x8b\x1f\xc2\x14\xdd\x91\x4c\xd4\x6b\x1c\xa6\x08\xaa\x54\xc9\x45\x32\xcf\x28\x4a\x46\xbd\x87\x30\x45\x77\x2f\x1b\xf5\xea\x86\x29\x32\x4e\x8e\x2a\x1f\xf5\x2a\xc3\x14\x91\x22\xa5\x61\x8a\x60\x1b\x5f\xef\xf9\x26\x69\xc9\x7f\x70\x6e\xf9\xfc\x9d\x5b\xee\xd6\xb1\xe5\x9e\x5c\x56\x56\x17\xa2\xb4\x24\x37\x0c\xd0\x5b\x6e\xc1\x6b\xc8\x75\xa3\x1a\xf9\xae\x25\x97\x97\x93\x9b\x16\x7b\xd9\x71\x92\xf9\xbb\xab\x8b\x6c\xba\x5c\xe8\x39\x79\x64\xf7\x19\x2d\x94\x52\xde\x84\xd6\x3c\xd9\x8d\xfd\x5e\xe2\x93\xed\x1a\x76\xe3\xb4\x4b\x1e\x32\xdf\x27\x64\x58\x28\xd7\xf5\x82\x6e\x18\xf6\x31\xcc\xa0\x1f\x64\xa3\x38\x1a\xa6\xb2\x68\x50\xa8\x30\x88\x86\xee\x68\x30\x1c\x61\x02\x84\x61\x98\x06\x03\x7f\x64\x02\x9c\xf5\x07\x51\x3a\x48\x22\xcc\x9e\xed\xf5\xfa\xe9\x60\x30\x2c\x05\x1c\xf4\xa3\x78\xe8\x47\x03\x14\x67\x82\xb0\x37\x88\x82\x9e\x09\x70\xd4\x1f\x79\x9e\x37\x42\x8c\x07\xb1\x1b\xa5\xae\xd7\x2f\x05\xdc\xf7\x83\x51\xcf\x4f\x30\xe5\x76\x32\xf2\xfa\x44\x8a\x19\x98\x00\x27\x03\x6f\x18\x65\x29\x62\x9c\x26\x71\xda\xf3\xbc\x5e\x29\xe0\xb4\xe7\x76\x93\x84\x8e\x71\x12\xb8\x81\xeb\x87\xc6\x31\xf6\xfc\x5e\x10\x0d\x68\xce\x88\x30\xea\xba\xf1\x68\x90\x95\x02\xf6\xc3\xc0\xeb\x45\x03\xcc\x1d\x11\x66\x59\x38\xf0\x7b\x43\xe3\x50\x44\xee\xb0\x9b\x0e\x31\x81\x78\x1a\x8d\x46\x83\x30\xf3\x4b\x01\x77\xfd\x41\x16\xa5\x5d\x1c\x8a\x91\xdf\x1d\xf4\xfa\xb1\x71\xf2\x7a\x6e\x9a\x0d\x3c\x9a\xbc\x22\x18\x78\x71\x3f\x1e\x78\xe5\x63\x3c\x48\x87\x6e\x4c\x23\x54\xfa\xd1\xb0\xeb\xf9\x41\x64\x02\x3c\xf4\xfa\x83\x91\x47\x11\x18\x8e\xe2\xbe\x1f\xf7\xc3\x52\xc0\x59\xd8\x1f\xc4\xfd\x21\x8e\x5d\x3f\x1b\x79\x61\x92\x1a\xc7\x38\x23\x63\x1a\x76\x7b\x98\x46\x9c\xec\x2c\x23\x3f\xca\x82\x52\xc0\xee\x88\xe0\x92\x0e\xb1\x42\x6f\xd0\x23\xd2\xfd\xc0\x88\xb1\x1f\xba\xc3\xc4\x1b\x0e\x31\x49\x7b\x37\x19\xf6\x87\x71\x54\x3e\x79\x69\xd6\xf7\x87\x31\x2e\x90\xa8\xef\x0f\x5c\xbf\x6b\x04\x1c\x26\xdd\xb0\x17\x26\x78\x46\x88\xb3\x24\xce\xc2\x5e\x39\xc6\xd1\x70\xe0\x26\x04\x65\x24\xfc\x34\xf4\x46\xe4\x2f\xe3\x92\x8e\xc9\xba\xef\xa5\x08\xb8\x17\x78\x5e\x14\x0c\xca\x31\xee\xf7\x82\x2c\x22\x07\x11\x5c\xd2\x59\x1c\xa7\xa3\xc4\xbc\x40\x08\xb4\x21\xf9\x8c\x93\x9c\x0e\xc2\xc0\xf7\xdc\x72\x5e\xe1\xba\x81\xdf\x1d\xf6\x68\xce\xf7\xd1\xc0\xf7\x02\x23\xb9\x0d\x46\x51\xbf\x3b\x1a\xb2\xf8\xa6\xd9\xc8\x25\x34\x5f\x0a\x78\x18\x67\xe4\xb8\x33\x42\xc2\x0f\xd2\xa4\xd7\x1b\x0d\x8d\x54\x91\x46\x49\xb7\xef\x85\x08\xb8\x1f\xb8\x49\xd2\xf5\xcb\x87\xc2\x8d\x87\x49\x1c\x44\x34\xbd\x0b\xc1\xbe\xe7\x9b\x17\x88\x17\x92\x93\x61\x9f\x9e\xbd\xdc\xc4\xcd\xe2\xac\x5b\x3e\x14\x64\xb1\x75\xdd\xa4\x87\xcc\x25\x8c\x53\xdf\x27\x27\x36\x23\xb9\x65\x1e\x0c\x13\x0e\x59\x34\xf4\xe3\x21\x59\x22\xa5\x80\xc3\x94\x10\x5b\x3a\x42\xaa\x88\x92\x61\xe8\x27\x59\x6a\xe4\x15\x41\x40\x56\xb5\x87\x43\xd6\x4f\xfb\xd1\x20\x48\x47\xa5\x80\xe3\xc8\x4d\xba\x41\x14\xd2\x05\x92\x8c\xe2\x20\xcd\xcc\xe4\x16\x27\x6e\x32\x40\xbe\x1d\x0c\xbb\xdd\x81\x9f\x94\xb3\xcd\x9e\x37\xf4\x49\xb7\x28\x77\xeb\x66\x69\x42\x68\xce\x04\xb8\xef\x77\x7d\x7f\x48\x87\xcc\x0b\x7b\x84\xa3\x04\x83\x52\xc0\x89\x3f\x18\x65\xbd\x84\xf2\xd9\xe1\xc8\x73\x83\x38\x34\xd3\xb1\x97\xc4\x71\x88\x18\x0f\xc8\x88\x05\xae\x5b\xce\xdd\x86\x7e\x38\xe8\x91\xf9\x43\x3e\xeb\x8e\x7a\x7d\x42\x54\x46\xee\xd6\x8d\x87\x91\x97\xe0\x18\xbb\x71\x14\x0e\xb2\xa0\x9c\x2a\x52\x8f\x8c\x43\xcf\xeb\x23\xe0\x38\x1b\x45\xbe\x67\xdc\xf3\xd2\xb8\xdf\x77\x63\x1f\xe7\x22\x8a\xe2\x28\xe9\x57\xac\xbc\x51\xe8\x66\x41\x44\xc7\x2e\xea\x12\x76\xec\xfa\x89\x91\x8e\x09\xd3\x4e\x5c\xda\xb3\xc0\x1f\x0c\x52\x6f\x50\x3e\x79\x5e\x3f\x09\x87\x9e\x87\x6c\x73\xd0\x4b\xfd\xd4\x1d\x1a\x31\xf6\xb2\x80\x0c\x86\x4b\xe9\xd8\x0b\xbd\x84\x6c\xe7\x15\x0b\x24\xec\x75\xbb\x21\xd2\x71\x3a\xea\x65\xd9\xa0\xdf\x37\x01\x0e\xc2\x81\x3b\x18\x0e\xb0\x67\x19\xd9\x4d\xc2\x5e\x05\xb9\x05\x7d\x6f\xe8\x0e\x07\x38\x29\xc3\x68\xd8\x8f\xc8\x02\x37\xf2\xe3\x2c\xed\x25\x49\x88\x6c\x33\x0b\x42\xaf\x97\x0c\xcb\xc9\x2d\x1a\xf4\x87\xc3\x24\x1c\xd1\x9d\x21\x0e\x48\x8f\x8d\x80\xe3\x9e\x9f\xc5\x23\xca\xac\xd2\x78\xe0\x0f\x7a\x49\xf9\x50\x90\x91\x20\x02\x4b\x86\x0b\x24\x22\xa2\x3d\x91\xb0\x8c\xbc\xa2\xdb\x4b\xa2\x38\xa0\x3b\x0d\x91\x18\xba\xfe\x28\x2e\xa7\x8a\x5e\x38\xec\x75\x7b\x1e\x95\x84\xbc\x11\x59\xb5\x5d\x23\xdb\xec\x0d\x87\x5d\xd7\xa7\x93\x47\x96\x4a\x18\xf4\xb3\x72\xd9\xad\xef\x0e\xb2\xd1\x68\x94\x50\x29\x32\x0e\xbc\xcc\x37\x52\x45\x12\x46\x84\xc3\x66\xb8\xf2\xd2\xac\xe7\x0f\xc8\x5e\x59\xbe\x83\x64\xa3\x7e\x12\x8c\xe8\xce\x40\x18\x1d\x59\x78\x66\xb9\x22\xee\x7a\xdd\xde\x88\x6e\x61\x01\xe1\xf2\x84\x98\xcb\x97\x74\xe2\x77\x83\x6c\x88\x63\x9c\x25\x7e\x1c\x7b\x7d\xe3\x18\xa7\x5e\x2f\x26\xd2\x01\x15\x6c\x80\x90\x7c\x55\x09\x58\x14\x44\x92\x34\xe9\xa6\x29\x2e\x90\x61\x9a\xb9\x44\x8e\x32\xb2\xcd\x51\xd4\x4d\xc3\x51\x77\xc4\x36\xdd\x2c\x25\xf8\x57\x6c\x4d\x23\x37\xee\x52\x79\xa1\xeb\x7b\x64\x97\x1f\x18\x97\xb4\x4b\xe8\x9b\x08\x85\xb8\x40\x12\xc2\x3f\xfb\xbd\xa4\x7c\x07\xf1\xc8\xae\xdc\xef\xb9\x21\x53\xdc\xf5\x5d\xc2\x91\x8d\x4b\x7a\xd0\xf5\xdc\x41\x40\xf9\x31\x91\x04\xc2\xae\x57\x3e\xc6\x7e\x2f\x1d\x74\xbb\xa3\x88\x52\x85\x1b\x76\xd3\x9e\x91\x1f\x07\xfe\x30\x21\xa4\x88\x54\x41\xa4\xad\xae\x1f\xf6\xcb\x17\x48\x30\xec\x67\x83\xcc\xc5\xa1\xf0\xc8\x92\x1e\x64\x03\xe3\xe4\x91\x45\x91\xc6\xdd\x21\xf6\x8c\xc8\x90\xae\x9b\x86\xe5\x74\x1c\x0e\x87\x51\x1a\x52\xc1\x9b\xb0\x8c\x2c\xf4\x07\xb1\xf9\x0c\xd2\x1d\xf9\xfd\x3e\x32\xab\x11\x11\x08\xbb\x19\xb0\xd7\x32\x5e\x31\x1a\x0e\x62\x22\x56\x21\x9f\x4d\x52\xf2\x33\x33\x62\x1c\x0f\xc3\xd0\xeb\xf7\x10\x70\x98\x84\xdd\xa8\xe7\x75\x99\x12\xf5\xac\xc4\x6d\xb5\x18\x05\xb4\xb1\x87\xaa\x2d\x0d\xda\x8f\x8a\x87\xea\xdb\xd5\x3c\x54\xe1\x96\xa1\xd6\xd5\x81\xe1\x3a\xe2\xee\xa3\x8f\xae\x7a\x75\x40\xe4\x9c\x7e\xc6\x15\xee\xc1\x60\x38\xec\xbb\x96\xab\x83\xc1\x80\xac\xd4\x8c\x6e\xbf\x84\xe9\x12\xb9\x53\x15\x5d\x4a\x1a\x09\x88\x6c\x3b\x22\x1c\x0c\x1b\xc9\xc8\x61\xb5\x07\x9c\xcc\x54\x32\x89\xc2\xd1\x28\x0a\x70\x15\x44\x23\x2f\x25\x3b\x41\x5d\xad\x7e\xe4\xb9\x59\xe4\x53\xe6\x93\xa4\x19\xd9\xa1\x52\xcb\xd5\x41\x7f\xe0\x46\x71\x8f\x12\xa4\x4f\x56\x44\x3c\xf4\x46\x35\x1b\xf1\x46\xbd\x20\xed\x53\x9a\x27\x47\x53\x6f\x40\x36\x3f\x73\x23\xe4\xa8\xeb\x0e\x53\x2a\x06\x79\x01\x59\x4c\x5e\xb7\xdf\xfe\x54\xf9\x10\x17\xab\x85\x86\xc5\x72\xae\x3d\xf2\xeb\xbe\x67\x0f\xfd\xba\xef\xdb\x63\xbf\xee\x07\xf6\xe0\xaf\xfb\xa1\x3d\xfa\xeb\x7e\x64\x0f\xff\xba\x1f\xdb\xe3\xbf\xee\x77\x2d\x01\x60\x69\x07\x31\x3c\xac\xd1\x0e\x9c\x7e\x9f\xd0\xef\x45\x67\x0f\x3a\x06\x58\xdd\xb3\x56\xf7\x26\xf4\xbb\xa5\xba\x8f\xd5\x7d\x6b\x75\x7f\x42\xbf\x5b\xaa\x07\x58\x3d\xb0\x56\x0f\x26\xf4\xbb\xa5\x7a\x88\xd5\x43\x6b\xf5\x70\x42\xbf\x5b\xaa\x47\x58\x3d\xb2\x56\x8f\x26\xf4\xbb\xa5\x7a\x8c\xd5\x63\x6b\xf5\x78\x42\xbf\x5b\xaa\x77\xb1\x7a\xd7\x5a\xbd\x3b\xa1\xdf\x0d\x66\x7d\x35\x83\x1e\x53\xca\x30\x01\x4f\x28\x51\x4c\x4c\x26\xb7\x94\x20\xce\x8d\xd6\xb8\xf8\xc9\x54\x6b\x48\xe9\xc0\x54\x6b\x48\x49\xc0\x54\x2b\xa5\xd3\x6f\xaa\x95\xd2\x99\x37\xd5\xca\xe8\xac\x9b\x6a\x65\x74\xc2\x4d\xb5\x46\x74\xb2\x4d\xb5\x46\x74\x9e\x4d\xb5\xde\xd1\x39\x36\xd5\x7a\x47\xa7\xd7\x54\xeb\x9c\x4e\xad\xa9\xd6\x39\x9d\xd5\xc9\x46\x43\xd7\xdd\x9a\xe9\x50\xad\xf1\xb4\x85\xf4\x31\xa6\xb1\x87\x6d\xee\xe6\xbb\xb8\x83\xf3\xeb\xb3\x95\x02\x45\xd3\x66\x60\x08\x7e\x1c\x73\x6f\x03\x39\x6a\x34\x91\x38\xfc\x33\x2c\xf9\x87\xd5\xa7\x97\xc2\x98\x50\x18\xcc\xbf\x40\x87\x81\x5e\xf3\x2b\x45\xa0\x86\x4b\x15\x8c\x46\x5c\x1d\xa0\xba\x51\x84\xea\xeb\x73\x11\xe7\xf8\xba\xca\x17\x8f\x15\x9b\x48\x35\xca\xfd\xf1\x68\xa9\x73\x25\x0a\xf6\x39\x0d\xfe\x2b\x07\xaf\x9e\x60\x88\x62\x1e\x0e\x58\x29\xd7\x2b\x94\x43\xa3\xd7\x9f\x1d\xb5\x58\xb7\x5d\x89\xc5\x44\xc1\x62\x52\xc4\xe2\xdc\x84\xc5\xa4\x88\xc5\xb9\x8c\x85\x5a\xae\x5b\x2c\x67\x89\x64\x2c\x4f\xa9\x57\x1d\x7b\xbb\x49\xf0\xed\x7c\x46\xbd\x7a\x33\xea\xe5\x33\xea\xd5\x9a\x51\xef\x5c\x09\xf0\x7d\xce\xa3\x70\x4b\x81\xb9\x27\x2c\x56\xb7\x34\x48\x1e\x1b\x61\xb5\x18\xda\x31\xf7\xa5\x29\xe5\xf0\xe2\xca\x29\xf5\x26\x0a\x1a\x13\x03\x1a\xe7\x26\x34\x26\x05\x34\xce\x15\x34\x54\x80\x71\x01\x9e\x1f\x97\xce\x69\xa3\xd8\xe1\x65\xac\xa4\x9b\x4f\x7b\xf7\xac\x94\xe9\x74\x29\xe7\xea\x56\x4d\x37\x29\x32\x61\x25\x4b\x7c\xc2\x69\x49\x2f\x96\x18\x49\x5c\xde\xbe\x17\x53\x04\xbc\xb8\x1a\x03\x2f\x9e\xf0\xb2\x95\x38\xe4\x9c\x66\x02\x83\x36\xee\x4e\x36\xaa\xf8\x37\x67\x39\x8f\xe9\xb0\x40\xcc\x36\x80\x03\x33\xe9\xb6\xc9\x26\xd0\xe2\xf0\xd8\x9b\xff\x72\x3c\x67\xdd\x71\xdb\x35\x98\xfa\x44\xcc\x60\x35\x1a\xf0\xf7\x63\xb1\x5a\x2c\x58\x78\xab\x62\x81\xa3\x58\x13\x07\x3a\x3b\x45\x0c\xe8\x4c\x14\xda\xb7\xc4\xc3\x17\xbb\x22\x01\xba\x61\x2d\xc3\x76\x3d\xd2\x50\xa3\x98\xf8\x2c\x28\x3e\x38\xf1\x8d\xce\x59\x58\x7c\x78\x30\xfb\xf1\xd1\x3a\x74\xed\x13\xe1\x89\xd4\x99\xb0\x3a\xf0\xf0\x6e\x52\x12\x4c\xff\x1c\xa3\xe9\x03\xe8\x01\x6d\x07\x7f\x0f\xe9\xef\x01\xfb\x6d\xaf\x3e\xc1\xea\xd0\xca\x80\x36\x89\xbf\x87\xf4\xf7\x80\xfd\x2e\x0f\xc9\x7f\x4e\x63\xf2\x33\x86\x43\xf7\x95\x64\x42\xc3\x4b\xb7\x69\xf0\x83\xe4\x9c\x47\xec\x67\x1f\x95\x98\xfd\xe7\x52\x16\x89\x84\xef\x3a\xa5\x91\xf9\xf1\x34\xd5\x12\x80\x58\x9b\xe7\x6a\x9b\x13\xa5\xcd\x73\xb5\xcd\x89\xdc\xe6\x79\x9d\x36\x3d\xda\xcf\x8c\x6d\x0d\xd4\xdf\x24\xa3\x9b\x42\x8f\x87\xfd\x3f\xe7\x49\x2b\xa4\x8f\x61\xfe\x11\xda\x0c\xf8\x37\x1a\x86\xbb\xbc\x4d\xda\x4f\x56\x98\xb7\x79\xae\xb6\x39\x51\xda\x3c\x57\xdb\x9c\xc8\x6d\x9e\xe7\x6d\x1a\xa5\xce\xea\x3c\x04\x66\x5c\x9f\x63\xf4\xa5\xe7\x76\x67\xaa\xe7\xb8\x78\x9f\x8f\xcb\xdc\xa8\x9e\x23\x33\x78\x3e\xb6\xb1\x50\x9a\x28\x01\xca\x9c\x4f\x04\x8a\x13\x5b\x62\x04\x9c\xad\xf3\xbc\x2f\x94\x5d\x2c\x3d\x99\x5d\x9c\xd7\xe1\x55\x79\xb3\xf0\x37\x8c\x48\x79\x9b\x4b\x6c\x6a\x78\x6e\x6a\x70\xb8\x52\x8b\xcf\xc7\x75\x5a\x7c\x3e\x36\xb5\xf8\x7c\xbc\x4a\x8b\x3f\xd6\x6a\xf1\x47\x63\x8b\x3f\x8e\x6b\x71\x60\xa0\x36\x3d\x79\x85\x2d\xc7\xc5\x44\x94\x9b\xd0\x82\x56\xec\x50\x0f\xc2\xb9\xd2\x63\xca\x1e\x11\x45\x5f\x46\x91\xc3\xaa\x87\xe6\xeb\xcb\x14\x3c\x93\x3e\x94\x9f\xf4\xd1\x76\x16\x37\x6b\x13\x7d\xe3\x71\xf3\x9d\x09\x6d\xdc\x80\x46\xa6\x3a\x78\xb0\x1d\x99\xea\xe0\x19\x3a\x33\xd5\xc1\x23\x74\x66\xaa\x83\x47\xf2\x56\x3a\xc1\xf4\x1d\x13\x5b\xfe\x0e\x3c\xd3\xb7\xd2\x73\x2c\x45\x87\x2e\x93\x47\x2e\x2d\x0c\x9a\x35\x13\x08\x40\x1a\x9a\x70\x44\x95\xc2\xd0\x84\x23\x6a\x2f\x06\xa6\x3a\xff\x7f\x7b\x57\xbf\xdc\xb6\x91\xe4\xff\xb6\x9f\x02\xc9\x55\x59\x54\x4c\xc9\x68\x00\x24\x87\x52\x9c\x5a\x5b\xb1\x2e\xb9\x73\xe2\x94\x94\xdc\xb9\xca\xe5\x6c\x40\x00\xb4\x98\x50\x24\x8b\xa4\x22\xe5\x12\x6f\xdd\x6b\xdc\xeb\xdd\x93\xdc\x74\xcf\x0c\x30\x00\xe6\x03\x94\xa5\xec\xe6\x36\x4e\xa5\x44\x00\x33\xdd\x3d\xdd\xbf\xee\x69\x0c\xe6\x83\x06\x2f\x26\xa6\x3a\x34\x4e\x92\x9a\xea\xd0\x30\x49\x6a\xaa\x43\xc3\x2e\x3d\x01\x75\x8e\x0c\x5b\x13\x68\xe0\xa6\x27\xf4\x80\xc8\x7a\x5c\xf5\x38\x42\x1b\x5b\xe8\xa4\x8e\x5b\x1f\x56\xa2\x8d\x19\x52\x66\xf0\x05\xe1\x3f\x6d\x65\x03\x5f\x94\x9b\x51\xf4\xbe\x20\xdc\xa7\x42\xd8\x2f\x42\x5d\xda\xb4\x2d\x6c\x93\x8e\x36\xca\x28\x18\x92\x8a\x26\x6d\x86\x50\x31\x24\xf5\x4c\x24\xc3\x5a\x24\x98\xf8\x19\x6a\xe3\x92\x82\x61\x44\x21\xb6\xcd\x30\xaa\x18\x46\x17\xaa\x5f\xea\x51\x79\x2d\xbc\xfa\x19\x6a\x23\x99\x82\x61\x8c\x0c\xf3\x36\xc3\xb8\x62\x18\x23\xaf\x5c\x32\x8c\x3d\xee\xd0\xa4\xa3\x8d\x7d\x0a\x86\x09\x32\x2c\xda\x0c\x93\x8a\x61\x82\xbc\x0a\xc9\x30\xd1\x19\x16\x7e\x86\xda\x68\xa9\x60\x38\x40\x86\xd3\x36\xc3\x41\xc5\x70\x80\xbc\xa6\x92\xe1\x40\x67\x38\xf5\x33\xd4\xc6\x57\x05\xc3\x21\xbd\x54\xb4\x19\x0e\x2b\x86\x94\xbd\xbf\x93\x0c\x87\xb5\x97\x08\x3f\x43\x6d\x44\x56\x30\x1c\x21\xc3\x8b\x36\xc3\x51\xc5\x90\x5e\x9b\x64\x9f\x8c\xe5\x5d\x49\xc0\xfe\x9f\x87\xe2\xdc\xd1\x46\x0e\x77\x70\x28\x0e\x60\x72\x2f\x4f\x36\x43\x62\xb4\x0f\x4b\x1c\xde\xf5\xb1\x38\x66\x36\xf0\x0f\x79\x30\xce\xc9\x72\xf1\x73\xb1\x16\xbb\xfc\xe2\xc2\x8a\x38\x3a\x98\xcc\xb6\x98\xa0\xe0\x7e\xbe\x38\x3f\x7b\x52\x4c\x97\xeb\x42\x4e\xa7\x6e\x59\x4d\x5b\x6b\xa2\x7d\xbb\xdb\x2e\x5f\xc7\xd1\x5d\x1c\xc4\xf3\x47\x3d\x82\x47\x97\xb3\xdc\x1f\xe4\x88\x03\x22\x4a\x9e\xc4\xd1\x9f\xab\x9b\x3c\x4b\x95\x06\x10\xed\xba\xba\x09\xab\x78\x56\x37\xd5\xa6\x35\xb4\x56\x37\xf1\xa7\x7f\xae\x6e\xba\xeb\xd5\x4d\x68\x95\x6e\xab\x9b\x8c\xc6\xa9\xad\x6e\x12\x06\x72\xae\x6e\x12\xeb\x68\x3b\xae\xfe\x8e\xff\xd0\xeb\x99\x8a\x45\x76\x30\x49\x37\xc5\x30\x69\x3c\xb8\xcc\x07\xcd\xa2\x3f\xaf\x7e\xca\xa7\x8d\x9b\xd9\x6c\xc5\x35\xfd\xbb\x2c\x89\xd2\x44\xa5\x6b\x94\x50\x3c\x10\x82\xd1\x6f\x5d\x9e\xff\x0f\x4b\xa7\xba\x9d\x09\x44\x93\x67\x4e\xa8\xe9\x65\x39\xed\x5e\xa7\x63\x7e\xbe\xe1\x6f\x7f\xd4\x8b\x9e\x5c\x2c\x67\x59\xc1\x13\xcf\xac\xbd\x72\xe9\x9b\x13\xa8\xaf\x5d\x1a\x8c\xfa\x41\x32\xe6\xff\x43\x3f\x88\x63\xdc\xb2\xbf\x1f\x00\xbf\xc7\x6f\x05\xa0\x4d\x35\x1a\xb0\x7e\x30\x08\x79\xb9\x88\x97\xc3\xad\xfd\x87\xbc\x1c\xbf\x07\xfc\x5e\xa4\x97\x1b\xe3\x66\xff\xbc\x1c\xa7\x85\xb3\x15\x23\x4e\x0b\xe7\x16\x01\xd2\xd7\xca\x0d\x79\x3d\x5c\xf4\x90\x70\x5a\x31\xa7\x35\xe4\xe5\x07\xbc\x3c\x2e\x17\x88\xc7\x5a\xc1\x98\x57\x8c\xf8\x43\xe0\x0f\xf9\xb3\x60\xc8\x2b\xe1\xbc\xf8\x84\x8e\x16\xd0\x0b\xa2\x24\xfc\x21\xce\xb0\x1d\x62\x41\x5e\x91\x7b\x7c\x90\xf0\x8a\xf1\x48\x2b\x18\x71\x71\x22\xfe\x10\xa7\xe3\xf3\x67\x41\xc4\x9b\x11\xf1\xca\x80\xe2\xb4\xa7\xfb\x35\xf5\x1a\x99\xf5\x1a\xd5\xf5\x8a\x52\xa0\x1e\xb1\xdd\x38\x75\x9c\xff\xe1\xcc\x34\x69\x25\x63\x6c\x16\x4a\x4b\x02\x85\xba\x94\xb1\x54\x1c\x4a\x85\x05\x78\x29\xbd\xb9\x38\x0b\x1c\xf5\x81\x0a\x26\xe9\xe3\xba\x21\xd0\xa0\xa8\x60\xd4\x5f\x3c\x12\x8a\x1d\x0c\x1a\xfa\x4a\x42\x69\xad\x81\xb0\x7e\xa2\x73\x40\xd3\x20\x34\x62\x34\xe9\x50\x98\x7d\xa0\xdb\x10\x4d\x80\x78\x40\x5c\xa0\x0d\x51\xb1\x2a\xab\xa9\x9d\x08\x75\x75\x79\x35\x4f\xe9\x98\x14\x4c\x2a\x37\x17\xb3\xe9\xd6\xa4\xc4\xe7\x5f\x7e\xfb\xd7\xf3\x2f\xbe\x3c\x15\x67\x4a\xa1\xc6\x38\x71\x6a\x3c\x6a\x88\x21\x22\xa5\x99\x48\xbb\x12\xa9\x20\xcd\x19\x49\xf4\x92\x42\x98\xce\xff\xfc\xf9\xab\xd7\x3c\x9b\x4e\x17\xb9\xdc\x1b\x7d\x45\x26\x15\xe7\x69\x18\xe4\xc0\xf2\x7f\xfd\xa6\x6e\xcf\x46\x4a\x19\xde\x84\x47\xf4\x32\xc2\xa2\x30\xec\x37\x9f\xa9\x77\x05\x51\xc4\x50\x20\xaa\x15\xe0\x45\xa2\x56\x91\x58\x2b\xd2\x7e\x9a\xe8\x4f\x0d\x0c\x06\x75\x06\x91\x81\xc1\xb0\x2e\xa4\xa9\xc8\xa8\xd1\x0e\x03\x23\x56\x13\xa4\x4d\x62\xdc\xe4\xd2\x26\x91\xea\x45\x4c\x05\x26\x4d\x6d\xb5\x8b\x64\x0d\x36\xad\x02\x79\xb3\x29\xed\x22\x85\x56\xa4\xcd\x61\x5a\x97\x32\xb2\x69\xc2\x5c\x1b\x98\xd7\x1e\x11\xf3\x30\x88\x99\x07\x55\x49\x93\x89\x01\x17\xcc\x8d\x9b\x21\xf3\x02\x73\xc4\x5c\xc0\x64\xcc\x6b\xef\x31\xf3\xd8\x3b\x65\x5e\x7b\x4f\x98\x17\xbc\x19\xf3\x5a\x3c\x67\x1e\xd4\x14\xcc\x8d\xee\x29\xf3\x3a\x08\x58\xcd\x25\xa3\x04\x98\x15\x19\x69\x4f\x2d\xc6\x8c\x6b\x45\x8c\xdc\x93\x3a\x15\x53\x1b\x07\x7a\x11\x23\x26\x74\x39\x0d\xcf\x47\x75\x31\x1c\xbe\x01\x0e\xf8\x8f\x9b\x92\x5a\x03\x05\x38\x2c\x3a\xa9\x37\x26\xb2\x05\x0a\xb0\x59\x34\x6f\x36\x26\xb4\xc5\x09\xb0\xa2\x66\xaa\x15\xb0\x45\x4c\x97\x2a\x80\x79\x55\x11\x31\xaf\xe9\x63\xe6\x36\x5b\xd2\x20\x61\x8b\x15\x2e\x75\x0f\x99\x0b\xc2\x23\xe6\x31\x06\x63\x1e\x4d\x8e\x99\x17\x5a\x29\x73\x1b\x74\xc2\xbc\x7e\x94\x31\xaf\xcd\x73\xe6\x32\x69\xc1\x3c\x2e\x34\x6d\x5a\x34\xaa\x0d\x12\x79\xb2\x0c\xee\xc4\x2c\x09\xc1\x1a\x41\x64\x19\x6b\x9a\x51\x1a\xd0\x16\x41\x14\x93\xd0\xc4\x24\xa9\x33\x31\x96\x19\xd4\xe9\x18\x85\x19\xd6\xe9\x18\xcb\x8c\xaa\x32\x10\xba\x7a\x58\x33\x8b\x71\x93\x05\x58\x83\xc8\x91\x96\x44\xd9\xa2\x88\x64\x04\xd6\x74\x43\x2a\xd6\x50\x20\x77\x25\x03\x85\x2e\x02\x58\x53\x8d\xca\x2a\xd6\xbc\xcb\xa9\x4c\x60\x9e\x56\x44\xcc\xa5\xed\x98\xf9\x95\x99\xb0\x86\xdd\xc1\x1a\x46\x9c\x0a\x1f\x32\x0f\x50\x47\xcc\x0f\x54\xc6\x3c\x46\x19\x33\x87\x51\x52\xe6\xf6\xa5\x09\xf3\x1b\x25\x63\x7e\x57\xc9\x99\x07\xc4\x05\xf3\xfb\xd2\x94\xb9\x11\x04\xce\x54\x16\xa0\x83\xdb\x43\xd4\xc1\x99\x20\xee\xe0\xf8\x90\x74\xc0\x33\x0c\x9c\xae\x0f\x43\x9f\x4b\xc2\xc8\x13\x0c\xf5\x14\xdc\x4c\x61\xec\x0b\x97\x90\xfa\xfc\x1e\x26\x1d\xa2\x25\x64\xbe\x40\x06\x79\x87\x60\x09\x45\x87\x50\x06\xd3\xd0\xef\xa2\x10\xfa\x42\x05\x80\xcf\x43\x21\xea\xe0\x20\x10\x7b\xbc\x0c\x92\x2e\x81\x6d\xd0\x21\xec\xc0\xd0\x19\xdd\x60\xd4\x21\x2c\x01\xeb\xe0\x8b\x30\xee\xe0\xf5\x90\x76\x88\xa6\x30\xf1\x45\x30\xc8\x5c\x21\x0c\x72\x5f\x58\x28\x3a\x84\x51\x98\x36\x22\xd4\x2e\xa9\x0a\x84\x89\x25\x18\x99\x45\x8e\x6a\x5a\x01\x6b\x8a\x22\x68\x9b\xa8\x27\xda\xf3\xd0\xf0\x7c\xd0\x30\x4e\x62\x49\x4c\x94\xd2\x4c\x3c\x46\xb5\x12\xfe\xee\xd8\x9e\x9b\x54\x5c\x6c\x99\x89\x6a\xa9\x2d\x2b\xa9\xa4\x48\x2c\x69\x49\xa5\xcd\xc4\x92\x97\x28\x6d\xd9\x52\x13\xa2\x60\x49\x4b\x64\x5d\xb3\x06\x5c\xcd\x03\xe6\x13\x3f\x62\x76\xa0\xc4\xcc\x07\x94\x84\xf9\x0c\x3d\x60\xee\xc6\x0f\x99\x1b\x4a\x23\xed\x79\x62\x49\x3f\xcc\xaa\x1b\x33\x97\xea\x52\xe6\x83\xd7\x84\xb9\x9d\x20\x63\x6e\xe8\xe4\xcc\x07\x8c\x82\xf9\x9c\x60\xca\x7c\x10\x87\xd0\x8b\x71\x00\x8f\xbb\x42\xe4\x41\x28\xc4\xde\x90\x01\x89\x13\xa9\x30\xf0\x3a\x3c\x0c\xbd\x51\x03\x46\xfe\xe1\x3e\x97\xc2\x61\xec\x0d\x19\x90\x3a\xbc\x11\x26\x9e\x70\x01\x99\x37\x6a\x81\x1e\x0e\x12\x5b\x82\x61\x87\x1d\x4c\xbd\x21\x49\xa6\x16\xce\x66\x82\xd3\xaf\x20\xf2\x87\x96\xd8\x11\x39\x20\xf1\xb8\x35\x0c\xbc\xb1\x05\x86\x4e\x07\x86\x91\x37\xb6\x01\xf3\x04\x1f\x18\x7b\x3d\x10\x52\x4f\x18\x80\x89\x37\x06\x42\xe6\x0d\x05\x90\x7b\xe3\x11\x14\x8e\x60\x07\xd3\x7a\x34\xda\x25\x7f\x60\xa1\x60\x69\x8e\x2d\x2a\xfb\xe4\x52\x5b\x52\x09\x25\x74\x62\x49\x24\x14\x0f\xfb\x60\xa9\x19\x44\x83\xba\x46\xcc\x39\x44\x35\x18\x97\x58\x86\x36\xaa\xf4\xcf\xde\x7f\xb2\xd0\x95\x41\x54\xb6\x35\xe7\x0f\xe2\xb9\x39\x77\xa8\xd4\x67\xfb\x82\x52\xa9\x27\xb1\x8c\x69\x48\x2f\xb5\x64\x0e\x0a\xde\xe6\xdc\xa1\x32\xb0\xa5\xfd\x4e\xfb\x02\xb3\x37\x2f\x62\x3e\xe1\x63\xe6\x53\x40\xc2\xdc\x26\x1e\x30\x5f\x13\x86\xcc\x8a\x9f\x11\xf3\x81\x8f\x31\x97\xfe\xc6\x75\xe6\xb6\x24\xc2\x81\x8e\x09\x73\x59\x2f\x63\x3e\xf4\xe5\xcc\x8d\xdf\x82\xb9\xdd\x6f\xca\x7c\x1e\x02\xa1\xc7\x45\x00\x3c\x5e\x08\x91\xd7\x0d\x21\x76\xf5\x14\x4e\x84\xc3\xc0\xeb\x22\x30\x0c\x7d\x76\x82\x51\xd8\x09\xe9\x6e\xa8\xc1\xd8\x1b\x2e\x20\xf5\x06\x3c\x98\x78\x62\x26\x64\xde\xb8\x01\xb9\x27\x2c\x41\xe1\x88\x4b\x30\x75\x86\x0d\x91\x3d\xb8\xdb\x00\x5e\xbf\x84\xc8\xee\x98\x10\x7b\xdc\x1e\x12\x0f\xf0\x61\xe0\xf5\x1d\x18\xfa\xa3\xdb\xc8\x11\xde\x80\xf9\x9d\x67\xec\x8c\x1f\x90\x7a\xe3\x1f\x4c\xbc\x41\x14\x32\x67\x10\x81\xdc\x1b\xa5\xa0\xf0\x84\x29\x98\xd6\xe3\xc8\x6e\xc9\x83\x31\xa6\x28\x79\x6d\x5f\x48\x4a\x69\x8c\x29\xc3\x91\x36\x5d\xc3\x98\x31\xc8\x02\x34\x9e\x62\xcc\x1b\xca\x9c\x8f\x99\xf3\x06\x22\x60\x2b\x30\xd2\x87\x6b\xac\x9d\x26\x38\x52\x86\x4a\x3e\x4b\xce\x50\xb5\x90\x99\x13\x07\x29\xa0\x59\x84\xac\x56\x20\x34\xa7\x0d\xf6\xef\x20\xa5\x71\x98\x39\x69\xa8\x94\x63\x1e\x73\x70\xd5\x07\xe6\x51\x6e\xc4\x42\x1b\x70\x62\xe6\x06\x4e\xc2\x5c\xc0\x19\x30\x0f\x2e\x86\xcc\xa3\xb5\x11\xf3\x40\x8f\x31\x8f\x69\xc7\xcc\xf5\xa9\xc3\x69\xd3\x09\x73\xa3\x36\x63\x1e\xd4\xe4\xcc\x63\xb9\x82\xb9\x81\x3b\x65\x2e\xd8\x43\xe8\x74\x5b\x80\xd0\x6a\x57\x88\x7c\x3e\x0d\xb1\xcf\x27\x21\xf1\x78\x35\x0c\x7c\x4e\x01\x43\x5f\xe4\x80\x91\xc7\xb7\xcb\x7e\xcf\x6a\x46\x18\xfb\x1c\x08\x52\x4f\x7c\x84\x89\x2f\x82\x40\xe6\x8c\x50\x90\xfb\x22\x0c\x14\xf6\xce\x79\xea\x89\x10\x94\x1f\xb8\x6d\x05\x1e\xa4\x41\xe4\xf1\x74\x88\x7d\xce\x0c\x89\xcf\x59\x61\xe0\x0b\x55\x43\x7b\x28\x82\x91\x2f\x58\x00\x73\xbb\xcb\xd8\xe7\xf0\x90\x5a\x83\x05\x4c\x7c\xbe\x0c\x99\x27\x5c\x40\xee\x0c\x96\x50\xf8\x42\x19\x4c\x1b\x01\x67\xb7\x4f\x12\xa2\x92\x29\x8a\x28\x9a\xa6\xbc\x40\xd4\x8d\x42\x5b\x66\xa0\x9e\x47\x26\xda\x49\xa5\x11\x23\xfd\x81\xde\x1e\x53\x56\x50\x3e\x65\xa6\x94\x40\x6b\x97\xb5\x57\x34\x66\x03\x9a\x50\xcc\x94\x0c\xc8\x59\x34\x96\x49\x11\x04\x50\x53\x06\xa0\xe9\x8a\x99\x12\x80\x92\x2c\x33\x25\x01\xb2\xad\xcc\x94\x00\x68\x5a\x36\xb5\xd4\x69\x24\x60\x6e\x23\x45\xcc\xd2\xa2\x98\xb9\xac\x93\x30\x57\x7b\x06\xcc\x8d\xba\x21\x73\x23\x63\xc4\xec\xfa\x60\xcc\x85\x8b\x31\xb3\xe3\x39\x65\x6e\xd3\x4f\x98\xdb\x86\x19\xb3\x60\x2a\x67\x6e\x13\x15\xcc\x85\xa9\x29\x73\x43\x19\x42\x8f\x1f\x01\x78\xc0\x07\x91\xc7\x53\x21\x76\x00\x10\x12\xa7\x9f\xc2\xc0\xe3\x8a\x30\x0c\x3d\x31\x68\xe4\xf4\xb9\x32\x83\xb5\xc8\x3e\xb6\x46\xed\xd4\xe6\xad\x30\xf1\x84\x36\xc8\x1c\x71\x11\x72\x4f\x0c\x81\xc2\xe3\xb3\x30\x75\x06\x37\xec\xd1\x2d\x82\x83\x13\x4a\x10\x39\x9d\x16\x62\x8f\x5f\x42\xe2\x71\x4c\x18\x38\x3c\x13\x86\x9e\x58\x03\x23\x6f\xb0\xf2\x78\x12\x8c\x3d\x3e\x0a\xa9\x23\x00\xc0\xc4\x19\xb5\x20\x73\x86\x16\xc8\x6d\xfe\x0f\x85\xcf\x85\xa7\xf5\xd0\xb3\x7b\xd7\x6d\xc0\x88\x12\x35\x09\xc1\xd0\x75\xcb\x54\xc3\xd0\x69\x4b\xa2\xa6\x6a\x49\x99\xe4\x98\x9e\x0e\x2c\xcd\x1f\x0a\x92\x86\x3e\xba\x4a\x99\xc0\xd2\x6f\x89\x06\x98\xba\xe9\xb2\xed\x60\xe8\xa3\x2b\x90\x83\xa1\x8f\xae\x1a\x61\x7a\x55\xd7\xf2\x38\x43\x37\x2d\x47\x5d\x0c\x5d\x74\xa9\x37\xd3\x4b\xba\x96\xf9\xb6\x5b\xea\x52\x03\x30\xcb\xa4\x10\xe6\xb2\x6f\xcc\x5c\x6d\x4c\x98\x03\x38\x03\xe6\x52\xde\x90\xb9\x5a\x32\x62\x36\xf5\x30\xe6\x80\xd5\x98\xb9\x4c\x9d\x32\x97\x45\x26\xcc\x01\x84\x8c\xd9\x60\x9e\x33\x17\x92\x0b\x66\x46\xec\x94\x39\x8c\x0c\xa1\xd3\xca\x00\x4e\x77\x8d\x9c\xfe\x0a\xb1\xd3\x57\x20\x71\xb9\x03\x0c\x9c\xae\x04\x43\xa7\x43\xc0\xc8\x15\x11\x64\x7f\x63\x7c\x34\x76\x46\x0b\x48\x5d\x1e\x03\x13\x4b\xd0\x80\xcc\x3a\xb1\xcb\xe9\xb9\x50\x38\x83\x02\x4c\xad\x11\x91\xf7\xb6\x2e\x41\xc1\xe9\x88\x10\xb9\xbd\x3b\xb6\x20\x0d\x12\xa7\xa3\xc1\xc0\xe5\xc2\x30\xb4\xfa\x21\x8c\x9c\x91\x01\x98\xd3\xfb\x61\xec\xf4\x45\x48\x99\xf5\xfd\xd8\x09\x85\xcc\x15\x1d\x20\xb7\x7a\x31\x14\xce\xc8\x01\x53\x2d\x38\xec\x36\x48\x1e\xe2\x2a\x08\xf3\x3a\xa5\xc8\x3c\x48\xae\x7d\xdc\x68\x87\x63\x51\xaf\x1d\x88\x25\x3d\xc3\xa3\x81\xa0\x17\x19\xe5\x18\x96\x0f\x4d\x41\x58\x4a\x62\xee\x67\x58\x68\x96\x7f\x5c\xb6\xdb\x14\x82\x85\x9c\xa6\x47\x93\x92\xa8\x41\xce\x4c\x2e\xf6\x68\x87\x5f\xcb\x60\x77\xb5\x8c\xc6\xd0\x89\x4a\x21\x22\xf7\x02\x4b\x66\x5f\x80\x09\x2e\x9d\xca\x32\x91\xcb\xfe\xb2\x4c\xec\xb2\xb5\xbc\x9f\xb8\x94\x2d\xcb\x0c\xec\x6a\x95\x25\x86\xde\x36\x8f\x2c\xd0\x92\x8f\x99\x4b\xa3\xb2\xcc\xd8\x66\x25\xf9\x3c\xb5\xa3\x54\x96\x98\xb8\xf0\x28\xcb\x64\xae\x95\x5e\x61\xee\x82\x91\x2c\x53\xb8\x20\x2a\xcb\x4c\xed\x1e\xaa\x32\x62\xa3\x63\x83\xab\x05\x10\x59\x94\x0c\xb1\x0d\x71\x90\xb8\x84\x85\x81\xcb\x2c\x30\x74\x29\x03\x46\x8e\x26\xda\xe2\xef\xd8\x6e\x42\x48\x5d\x48\x85\x89\x33\x1e\x66\x2e\x8f\x82\xdc\x8e\x6f\x28\x6c\xa0\x83\xa9\xdf\xbb\xaa\x97\x1b\x6b\x09\x70\xc7\x02\x88\xfc\x80\x83\xd8\xe7\x7d\x90\x38\xbd\x0f\x06\xfe\x20\xa0\x8c\xed\x6c\xee\xc8\x1f\x94\x80\xf9\x83\x1b\x8c\xfd\xd1\x40\xc1\xc1\xe5\x65\x02\x14\xd6\xa7\x99\x2f\xac\x09\x60\x38\xe4\x2c\x7c\x11\x47\x81\x84\xb8\xb4\x0e\x7a\xd2\xf7\x35\xf8\x2a\xdd\xfc\xb4\x09\xb6\x17\xe9\x36\xd8\x14\x73\xdc\x7d\x08\xf7\x23\xc2\xdd\x0b\x82\xd9\x62\xa5\x8e\x89\x28\x77\x34\xf8\xea\xd9\x79\xe3\xe0\xe2\x6a\x61\x62\x5f\x9b\xf8\x4f\x07\x28\xca\x0b\xfa\x2d\x2f\xfa\x7a\xc5\x50\xde\x15\x05\xc4\x85\xfa\x8d\x17\x7d\xad\x3d\x4d\xc9\xb5\x5d\x95\x3e\x7f\x71\x2e\x36\xc6\x0a\xc4\xc6\x2f\xee\x33\xaa\xb0\x74\x79\x40\x95\xb8\xd0\x76\x49\xb9\xed\x11\x55\xee\xad\xf5\x70\x73\x25\xb5\x05\x18\xff\x7d\x6c\x2c\xa1\xf6\xd5\xc3\xad\x97\x8c\xdb\xea\x21\x0f\x61\xa2\xc1\x10\x37\xc1\xd8\x04\x69\x96\xf1\x82\xb8\xfb\xeb\x76\x89\xdb\xb2\x18\xe9\xe2\x36\x71\xfa\x99\x62\xea\x9f\xe9\xec\x90\xc1\xd0\x7e\x76\x48\x45\xee\x9b\x25\x12\xe4\xfc\x70\xff\xe5\x83\x00\x0c\x7b\x94\x4a\xbe\x62\x7b\xfe\x9e\x6a\xdd\x9b\xaa\xbe\xdc\x8e\x0f\xff\xf4\x62\xe0\x54\xaa\x47\xb4\x0f\xdf\x7e\xf0\xa8\x45\xd8\xb0\x61\xe9\xb3\xcd\xa6\xb8\xc4\x73\xd4\x60\x18\x6c\xae\x26\x9c\x88\x41\xfd\xfc\xc1\xbf\xf3\x07\xa5\x09\xaa\x6b\xbb\x52\x16\xe7\x54\x48\xa8\x46\x5d\xe0\xb9\x26\xe5\x95\xfd\x88\x95\x13\xda\x71\x4a\xca\x63\xd9\x45\x5b\x51\x97\xb2\xbc\x91\x44\xdf\x4a\xa1\x6e\x71\x74\x0b\x47\xc4\x39\xed\x8a\xf2\x54\xdb\x04\xa5\xa4\x6b\x23\x29\x00\x95\x30\x23\xa0\x22\xc3\x4e\xbe\x06\xd4\x44\x89\x1d\x35\x75\x3e\xd3\xf5\xf2\x92\x02\xcc\x1c\xb7\x37\x8c\x18\x79\x06\x72\x36\x57\x14\xca\x79\xd3\x9b\x05\x4f\xc4\xd9\x10\x21\x6d\xe0\xa8\xc0\xd5\xeb\x71\x11\x25\x06\x71\x5f\x44\xa5\x81\x7d\x0e\xa1\x88\xbd\xa5\x3d\x1e\x09\x5b\x33\x7e\x63\xe8\x3a\x47\xa2\x29\xde\x7a\xf6\xee\xa2\xbb\x7c\x09\x6d\xdf\x59\x09\xb9\x5f\x93\x92\x53\xc1\xc7\x42\x56\xfe\x33\x4a\x2c\x02\xef\x1b\x24\xee\xb4\xb3\x3f\x36\x60\xb6\xc8\x8a\xa0\x48\xb3\x0b\x09\xbb\x60\xc6\xad\xb9\x5a\xcd\x67\x45\x8e\xb6\x4c\x17\xb8\x2b\x58\xba\xc8\xf9\xa5\xdc\x97\x91\xc2\x7b\xdf\xbc\x03\xf6\x85\x42\x6f\x90\xf1\x9a\x93\x22\x98\xac\x97\x3f\x15\x0b\xdc\x99\x6e\x19\x30\xb1\x29\xf0\x26\xd8\x64\xe9\x5c\x90\x17\x24\x37\x66\x6a\xd7\x17\xb3\x0c\x0f\x0e\x9c\x2f\xaf\x37\x44\x1a\xe9\xf2\x4a\x9c\xec\xd5\x86\xd7\xbf\xe6\xa1\x7a\x79\xb5\x15\x02\x6e\x78\x7c\x6d\x53\x91\x8a\xa6\xed\x35\x7b\xd5\x05\xee\x28\x4f\x3b\xc8\x57\xb7\x30\xa0\xc4\x60\xd2\x5c\x0d\xb9\x20\x90\x3b\x72\x03\x57\x92\xa5\x20\x56\xfd\xa6\x98\xd5\x9b\x09\x23\x7e\x12\xa0\xf5\x63\xb3\xa9\x6c\xed\x18\xe9\xed\x18\xbd\x95\x1b\x7b\xfe\xa6\xdf\xa2\x43\x01\x5a\x47\xed\xbc\x37\xed\xba\x49\x1b\x5f\x72\xd3\xfc\x5c\xac\x37\x85\x3d\x0a\xf2\x02\xe7\x8d\x40\x58\xbb\xd5\xa9\x83\x00\x47\x07\x51\x51\xd3\x35\xb6\x79\x03\x03\x04\xf4\xdb\x56\x53\xcc\x5b\x5c\x16\x8b\x0c\x77\x46\xdb\xe1\x28\x40\xb9\x63\xed\xf2\xa4\xac\x57\x15\xee\xd7\x43\xbe\x75\x0b\xdd\xbc\xb8\x0f\xae\x95\x46\x5c\x7b\xf7\x9e\xb8\x39\xf7\x95\x22\x4d\x49\xc7\xbf\x16\x5b\x3d\x4f\xab\x0b\x37\x27\xa2\xda\xd1\x58\x4d\x1b\x88\x62\xeb\x66\x31\x3a\x39\xeb\xd8\xb4\x3b\xf8\x6c\x3b\x4b\xe7\xfa\xd6\x57\xf5\x32\xc5\x4d\x76\x91\x2e\xde\x15\x2f\xcf\xaa\x6d\x51\xc5\xce\x63\x3c\xa9\x9b\x8a\xff\x9a\x90\x36\xd7\x11\xe7\x53\xd3\x1b\x2b\xff\x67\xab\x73\xf6\x52\xaf\x13\x11\x9f\x58\xfe\xeb\x56\x87\x09\xd9\x38\x17\xfc\xbf\xa3\x6c\x54\x67\x20\xff\xed\xef\x7a\x54\x9b\xd8\x3e\x8c\x3c\x4a\xfc\x14\x5e\x45\xbf\xfd\xc7\xb6\x6d\x3a\xe6\x13\x44\xce\x76\xec\x45\x09\x0c\x1d\x27\x96\xb2\xeb\x7a\xd9\xb5\x2c\x6b\x14\xf2\xb4\x98\x6d\xb6\xc5\xbc\x44\xb1\x99\xe2\x94\x1a\xdf\x2d\xb5\x60\xee\x00\x3d\xc5\x8e\x56\x6c\xb5\xc6\x63\x0e\x4f\x08\xa4\xb4\xdf\x57\xe1\x1a\x13\xc9\xf2\xd5\x85\xae\x69\x5b\xed\xb7\xdd\x02\x76\xc3\x91\xd6\x36\x4d\x35\x3c\x69\xae\xc4\x98\xfa\x03\xf8\x77\x8b\x7c\x19\x6c\xae\xd3\x95\x48\x3f\xe6\xe9\x66\x2b\xc0\xd0\x0e\xe1\x5b\xb7\xc9\x1a\xc2\xd6\x0d\xe6\x72\xfc\xad\x01\xc3\xb4\xa3\xf8\xae\xae\xde\x72\x8d\x3b\x73\xc1\xdb\xb8\xfa\x6d\x42\x8a\x27\x74\x19\xde\xc8\xb6\x01\x4f\x5e\x5a\x11\xb8\x0c\xb9\x6e\x93\xd5\x42\xae\xdd\x66\xb5\x2e\x83\x77\xef\x62\x0b\xe8\x21\x6e\x00\xad\x3f\x99\xfd\x6c\x79\xa0\xed\x1b\x3d\x34\xee\x1a\xcd\x1b\x82\x08\x94\x2f\x01\xeb\xe5\x66\x53\xa5\xe9\xb4\xe7\x21\x25\xc4\xf4\x5a\x2a\x6a\x94\x1d\x55\xa5\xb8\x9e\xea\xaf\x2e\xd3\xcd\x4f\x35\x97\x55\xd8\xed\xf5\x6a\x10\x45\x47\x54\xbd\xeb\xf7\xb5\xa6\xa3\xd3\x22\x15\x4d\x05\x35\xc8\x7e\x4f\x98\x7d\x60\x04\x3e\x3e\xc3\x8c\x4a\x50\x96\xa5\x94\xdf\xb5\xc4\x3e\x7b\xd9\x5d\xec\xb5\x5d\xec\xb9\x5b\xec\xb9\x43\xec\x75\x07\xb1\x9d\x9b\x48\x6f\xd4\x2e\xd2\x62\xf8\xa3\xdb\x3e\xd2\xbe\x4d\x98\x05\xad\x6d\x71\xb3\xd5\xb7\x62\xfe\xfc\xc5\xf9\xa1\x4c\xd0\x6a\x7b\x31\xf7\x83\x6c\xfa\xce\xb0\xb9\xf6\x6a\x9e\xa2\x10\x9c\x48\x93\x8a\x4c\xb8\x7a\x15\x1f\x13\xa1\x72\x67\xe7\xf6\x40\x4d\x7d\xd3\x6d\xfe\xdc\xb8\xe3\xf6\xb7\xeb\x19\x6f\xe5\xc1\x6e\x43\x44\xa2\x52\x6d\xa0\x48\xbf\xf5\xc7\x19\x2e\x92\x03\x11\x28\xf6\x8c\x76\x28\xcd\x0a\xe3\xae\xfe\x79\xb1\xc1\x2d\x7d\xd1\x2e\x42\xab\x2f\x84\x8d\x97\xeb\x5e\x75\xce\xba\x3c\x38\x5e\xb1\x3e\xdc\xcc\x67\x59\xd1\xc3\x0d\x73\xf7\x5b\x67\x61\x94\x64\xa3\x5b\x92\xc5\x0d\x6b\x1d\x64\xe3\x5b\x92\xc5\x6d\x7d\xf7\xed\x07\x69\xdc\xfa\xdd\x83\xeb\xef\x50\xaf\xac\xd5\xb0\x6a\xe6\x50\x7f\xe7\xe8\x50\x21\xee\xc0\xe1\x6e\xde\x69\x90\xd7\x8e\xc2\x45\xbb\x36\x1f\x3a\x70\x30\xf7\x7a\x30\x8e\xee\xac\xdb\xfb\x07\x09\xab\x65\x74\xb9\x83\xe0\x5a\xd1\xda\x31\xc4\xda\x42\x5c\x3d\xd0\x96\xa5\x9c\xfb\xe7\x97\xa5\x1a\x5b\xe8\x6b\x1b\xb3\x1f\x0d\xa2\x7e\x63\x57\x7d\x6d\x73\xf7\xa3\x41\xd2\xaf\x76\x75\x3f\x1a\x0c\xfb\x72\xb3\xf7\xa3\x21\xe0\x86\xfb\xc9\x07\x6d\xb8\xff\x7b\xee\xb4\x7f\x6f\xfb\xe1\xff\x7d\x76\xb6\xa7\x93\x0a\xb8\x1c\xf9\xdd\x6e\x71\xff\x9c\x9b\xbd\xda\xb5\x9e\x5f\x68\xcf\x5e\xc7\x91\x73\x07\xfc\xb6\x2f\xdf\x0c\x93\x60\x91\x72\x1f\x5a\xe9\x5e\xfa\x44\x17\x03\x8b\xa0\x18\xe2\xef\xaf\xef\x4d\x64\x9e\xf1\xa8\x51\x1e\x61\x63\x22\xf3\x7a\x98\xa0\x1c\x24\x14\x27\x74\x28\x2f\x50\x7e\x43\x66\x50\x91\x16\xe4\xe5\x70\x0a\x0f\x4f\x3c\xe7\x0e\x16\xc5\xf5\xfc\x97\x40\xf8\x5a\x6e\x62\xac\x07\x14\x19\x6d\xe4\x69\x1e\x8b\xab\xcb\x49\xb1\x7e\x1f\xd0\xa9\x52\x74\xaa\x0a\xfe\x88\x23\x4a\xe7\x0f\x9d\x55\xf0\xac\x2a\xac\x81\x7f\x4d\x15\xea\x95\xeb\xd1\xad\x5d\x40\xe9\xe5\xa6\xd2\x8b\x8a\x08\x4a\x3d\xaa\x63\x16\x5f\xff\xc2\x28\x8c\xe9\xad\x2c\x09\x07\xe1\x30\xac\x8f\x77\x2a\x4d\x8b\xb1\xc5\x59\x2d\xa3\xc2\x16\xf6\x51\x6a\x73\x1f\x26\xcf\xd7\x32\x9c\xea\x49\x8f\xb1\xb5\x4f\x83\xfa\xe9\xdb\xfa\x9b\x79\xd3\x52\xcf\x67\xdb\xeb\x19\x07\xe8\xd7\xaf\xbe\xdd\x10\x05\x9f\x61\xd4\x41\x29\x12\x20\xef\x39\x94\xb8\x7d\x51\x2f\x07\xa4\x18\xd9\x93\xa4\x53\xde\x93\xf0\x27\xef\xf8\x0b\xf6\xe2\xdd\x1d\x28\x9e\x48\x15\xa8\x78\x69\x82\xc3\xc5\x72\xdb\xb3\x6a\x95\xbb\x2e\x7f\xee\xcb\x54\xe9\x4c\x16\xa1\xd0\xbf\x95\xda\x3d\x36\x16\x13\x8a\xfd\x9b\x52\xb2\x21\x25\x95\x9a\x91\x8a\x51\x68\xa8\xcc\x79\x5c\x93\xae\x96\x01\xd8\xac\xf2\xec\xeb\xcf\x35\xab\xd0\xe7\x04\xea\xb7\x57\xe9\x86\x3e\x2f\x74\xf2\xa1\xd2\x52\x44\x03\x5d\xa2\x34\x16\x4f\x03\x38\x0b\x45\xf7\x8e\x8d\xcf\x29\xdf\x8d\xe9\xc5\xb7\x9d\xca\xf0\xfc\xba\x97\x72\xeb\xf2\x0e\x57\x0a\xe2\x82\x01\x2f\xad\xc3\x00\x5b\xe8\x81\x42\xe5\x67\x8f\x84\x42\x7c\xa8\x28\x3d\x4f\x96\xff\xdd\xf0\xf1\xea\xec\xbe\xe1\xf1\xea\xec\x9e\xd0\xf1\xea\xec\x6e\xc0\xc1\xf3\x05\x1d\x1b\xfc\x4d\xa9\x3b\x34\x96\xeb\x5b\x23\xe3\xb7\x1d\x91\xf1\xdb\xef\x8c\x8c\xd7\xf7\x0f\x8d\xd7\xf7\x86\x8d\xd7\x77\x05\x8e\x9b\x06\x3a\x6e\x76\x82\xc7\xcd\x07\xe0\xe3\xfb\x1d\xf1\xf1\xfd\xef\x84\x0f\xfa\x28\xaf\x23\x63\x21\x46\x46\xe5\x0b\x21\x8e\x8a\x76\xcf\xca\x16\x84\x09\x71\xc5\x5f\x72\x4b\x4a\x74\x84\xcd\x5d\x81\x81\x88\xdd\x0d\x1c\x88\x54\x0d\x10\x74\xe7\x65\x2f\x1a\xb8\x70\x20\x0a\xe9\x50\x58\x98\x70\x80\xaf\x40\x8b\xe0\x53\x9c\xe2\x63\xf9\xd2\xa5\x21\xa5\x57\x41\xe5\xd3\x4f\x83\x05\x7d\x22\x2f\xc1\x20\xa6\x0e\x45\x78\x84\xa7\xf1\xb0\x7a\x33\x84\x90\x4e\x1b\x6b\xef\x03\xf5\xf2\xe4\x16\x48\x27\xc3\x9b\x71\x60\x38\x31\xb4\xc5\x3a\x34\xb0\xfb\x7b\xa2\x97\x86\xf2\xff\xe9\xe0\x7b\xd6\x1b\x79\xd1\x7b\x76\x47\xe8\x15\x76\xaf\x23\x55\x03\xaf\xc2\x73\x07\xf0\xb6\x22\x26\x91\xba\x05\x7e\x35\x2f\x28\xe9\xf8\x01\x2c\xd9\xff\xee\x08\x3e\x5b\x6e\x53\x3c\xbb\xf6\x7e\x03\xf0\x9a\xb8\xdc\x15\x84\x89\xda\xdd\x40\x58\x08\xa6\x43\x98\xdf\xf1\xc5\x5f\x2c\xe2\xc5\xaf\x6c\x11\xe1\x40\x46\xf5\xc5\x3e\xa6\x83\xd5\x9d\xb3\xde\x30\x69\xc1\xf2\x43\x0d\x76\x47\x31\xe7\x8f\x65\x31\x4f\xc8\xc1\x12\xbb\x1b\xec\xac\x65\xb0\x97\xb7\x31\xd8\xb3\x3c\xbf\xef\xcc\x37\xcd\xf3\x7b\xca\x7c\xc5\x91\xdf\x77\xf1\xce\x9c\x37\xde\x99\xf3\x9d\xde\x99\xf3\xce\xef\xcc\xcd\x1e\xe1\x71\x99\xc8\xd2\x84\x51\x73\xf2\x9b\xa5\xeb\x35\x0e\x78\xf6\x54\x1f\x22\x0e\x86\xaf\x75\x2b\xd5\xf1\xf0\xc7\xae\x94\x5b\xeb\x78\x1e\x57\x39\x37\xff\x4d\x3c\xa4\x0c\x1f\x1a\xd1\x5d\x5f\x57\x9e\x2d\xe4\x11\xe0\xdc\x9b\xb5\xb1\xcd\x8d\xe9\x84\xe3\xf5\x72\x55\xac\xb7\xbf\x04\xbf\xca\x23\x86\xa9\x20\xc1\xab\x24\xd1\x1a\x56\x94\x00\xd9\x1c\x9a\xe8\xa8\xb0\x52\x9e\x89\x5e\x8f\x2e\xfc\xf6\x62\x36\x9d\x65\xe9\x62\x1b\x4c\xe8\xf9\x6c\xa1\xf9\x06\x31\x75\x8c\xfe\x56\xe3\xd2\x4a\x18\x75\xe7\x0e\xc6\x81\xdb\x12\xd8\xdd\xb1\xa6\xae\xde\xab\x15\xc2\x32\x9d\xef\xd7\x74\xef\x55\x9c\x39\x20\x97\x9a\xd3\xc8\x76\x52\x62\x51\x37\xf3\x07\xf8\xea\xb5\xae\xea\x66\x2b\x6a\xdf\x7c\xeb\x3e\xfb\x81\xc4\xde\xb4\xea\xd3\xd7\xb2\x1d\x87\xb5\xfb\xbb\x52\x01\x06\x63\x48\x61\x42\x6b\x6a\x32\xc8\xa1\x80\xe9\x7e\x8b\xc8\xdb\x7f\xa2\xa6\xe2\xd1\xb6\x9d\x3f\x0f\x10\xe8\xfa\x25\x6c\x5b\x61\xf9\x5a\x4e\x9e\xa0\xb0\xa8\x2e\xc4\xdf\xdf\x7e\x33\x2c\xc0\xc0\xbc\xbf\xf4\x81\x8f\x9e\x06\xed\xaf\x60\xad\x6f\x0c\x65\xf1\xa7\xa5\x18\xcd\xb9\x80\xd6\xa4\xbd\x49\x40\xf8\xd0\xbc\x58\xbc\xe3\xdd\xe9\x27\x01\xeb\x38\x95\xba\x19\x68\x4e\x96\x38\x47\x5c\xbd\x1a\x6a\x61\x58\xc6\x07\xec\xb4\xd5\xea\x80\x4e\x81\x47\xf5\xda\xa5\x75\x6b\x5f\xe6\xf0\x70\xf8\x5a\x10\xdd\xdb\x04\x79\xba\x4d\x83\x74\xb3\x23\x9f\xce\x23\x59\xf5\x2f\x85\x37\x5a\x80\x3e\xdc\x2e\x5f\xc7\x91\xfd\x53\x08\x3d\xfe\x80\x39\x3b\x92\x57\x1d\x54\xc7\xf6\x72\x2f\x85\x31\x4b\x21\x95\x79\x4d\xb3\x78\x84\xd9\x0c\xb4\x44\x73\x3b\x4f\xde\xaf\xf3\x76\xaf\xf4\xaa\x3e\xe1\xa9\x5a\x6f\x66\xa6\x39\xb3\x4a\x86\xc3\xd5\xd5\xe6\xa2\xa7\x12\x29\xcc\x11\xf6\x3b\x97\x6e\xe4\x12\xa6\x79\xb2\x2a\x15\xd1\x0c\xac\x22\x88\xa2\xd9\xaf\xbb\x8d\x75\x22\x49\xcb\x2b\x88\x0c\x42\x32\x5b\xae\xa8\x93\xb4\xf4\xfd\xfe\xb4\xb5\x84\x7d\x11\x64\xf3\xe5\xa2\xb8\x03\x48\x13\x9d\x26\x96\xe9\xa6\x1d\xcb\xf4\xd8\x89\x65\x9d\x32\x65\x29\x42\xdc\x72\xe6\xab\x69\xa6\xeb\x09\x95\xff\x98\x80\xfd\xb1\xd0\x4c\x9b\xa8\x8a\xa5\x82\x5e\x3b\xcc\xaa\x59\x63\x76\x06\xb4\x86\x49\x7d\x58\x17\xc9\x89\x85\x4d\xe9\x42\xd7\x35\xff\xf1\xba\xc1\x75\x17\x1f\xb8\x96\x90\x57\xe4\xf9\x4f\x93\xda\xed\x50\xa5\xc2\xc7\xad\xb5\xb7\xef\x5d\xf3\x66\x1a\xb3\x65\xe4\xd4\x18\x9c\xef\x32\xe8\x32\xdf\xe5\xc9\x27\x1f\x05\x17\xdb\xed\x6a\x73\xf4\xe4\xc9\xe5\xf6\x62\x73\x38\x29\x9e\x5c\x6d\xa7\xec\xc7\x4d\xf0\x73\x74\x08\x87\x11\xcf\xcc\x82\xbf\x5c\xa6\xdc\x1c\x3c\x04\x73\xc4\x54\x13\x64\x68\x56\x88\x98\xec\xc1\x2d\xf1\x79\xb1\x15\xcb\xe1\x8a\x02\xd5\x3d\x4b\x27\x73\xee\x20\x3f\x48\x4e\x3f\x3c\x7c\x40\xd3\xf8\xf9\xd3\x17\xe5\xfc\x97\xd6\x4c\x9a\x60\x4f\x18\x6f\x0f\x27\xb5\xc8\xdb\xc7\x76\xf2\xc1\x0f\xa2\x39\x1a\xf1\xaf\xe8\x46\x45\xfb\x52\x5e\xd7\x49\xcb\xbb\x8f\x1e\x19\xe6\xe7\x3c\xad\x09\x59\x16\x76\x8a\xf1\x8e\x66\xce\xfc\xd0\x17\xb3\xf1\xbf\x5e\xe6\xc5\x21\xd7\x1f\x87\xd1\x73\x31\x95\x86\x27\xb9\x3c\x2f\xcf\xf8\xfd\x3e\x51\xc1\xd9\xd1\x57\x38\xb7\x67\x8b\xfd\xda\x0f\xa8\x47\xad\x0d\x72\x1e\x4e\xd9\x86\x77\xf2\xba\xde\x06\x71\xf7\x58\xcc\x49\xaa\xaa\x1d\x96\xa5\x9f\xea\xc4\x78\x86\xa2\x95\xb9\x9e\x2d\x72\x7c\xbb\xac\x95\x11\x53\x87\x50\x96\x40\xbf\x4d\x93\x7d\xb8\xd4\x9f\x1c\xdc\xd9\x3f\x8e\x22\xd1\xda\xcd\x76\xcd\x5f\xca\x4f\xb9\xd2\x4e\x2e\xd2\xf5\x09\xd7\x0f\x67\x7d\x4e\x37\x0f\xa7\xda\x5d\xa9\xfc\x6f\x53\x5c\x4f\x48\x3a\x6e\x42\x76\x75\xb5\xf8\x05\xf5\xfb\xf0\x41\x19\xc1\xae\xb2\x4d\x94\x17\x78\xb3\x27\xf8\x88\x06\xd2\xa7\x4d\x9a\x7c\xaf\xba\x40\xba\x95\x2d\xaf\x70\x8a\x9e\x1c\xb9\xa4\x5b\x73\x15\x2b\x44\xf5\x2a\x58\xd0\x53\x5a\xcf\xa8\x2e\xf8\x0b\xdb\x3a\xc5\x8b\xeb\x8b\x19\x07\x44\x4f\x51\xfb\x54\x12\x11\xac\x1f\x50\x9d\x8a\x60\x26\x9b\xf7\x6c\xab\x2a\xf0\xc0\x82\x54\xc8\xa6\xa2\xf0\x67\x5c\xa0\x9b\xcf\x59\x18\xa2\xcd\xc5\xad\x4f\xe9\xd6\xf3\xd3\x53\xbc\x65\xe1\x84\xea\xa2\xd7\xf5\xcd\xd5\x7a\xbd\xc4\x09\x1e\x7d\x42\x1d\x0e\x53\x14\xb4\xce\x93\xbf\x34\xde\x6c\x03\x14\x21\xcd\x38\x01\xaa\x44\xcd\xe8\x22\x1f\x09\xd8\x13\xc5\x1f\x71\x69\x4e\x4f\xc2\x70\x1f\x11\xca\x05\xa3\x9f\xbf\x62\x70\xc6\x01\x87\x92\x3f\x55\x7b\x20\x34\x2f\xba\xf2\x9e\x6c\x22\x12\x88\x4f\x4f\xf7\x69\x69\x66\x88\x6b\x5c\x35\xca\xf4\xe0\xb1\xda\x71\x48\x72\xaf\xb2\x60\xd9\xd4\xab\x05\x8f\x50\xd9\x05\x77\xb2\x92\xdf\x71\xb0\x5c\xf0\x97\xe2\x74\xb5\x2a\xa8\xdd\xbc\xcd\x88\x05\x5e\x74\xb6\xed\xe3\x8b\x66\x86\xd3\xc7\xf0\x6d\x13\x15\x51\x52\x2a\xcb\xa0\x92\xb6\x6a\x5e\x54\x49\x15\xbb\xfa\x54\xbb\x5c\xa5\xb3\x75\xbb\x65\xd4\x2e\x29\xeb\x03\xa9\xba\x83\x03\x29\xfb\xc3\x66\x03\x2c\x35\xb1\x20\xfe\x2f\xe3\xbd\x28\xa5\xbc\xf1\x36\xce\x50\x2c\xc8\x19\xa8\x17\xae\x7c\xa1\x44\xb9\xc8\x5b\xea\x20\xe7\x71\xa2\xb8\xe1\xcf\x0e\xc0\x08\xfb\xd2\x8f\xf6\xf6\x34\xf0\x3f\x7e\x2c\xaa\x59\xc0\x4f\x7c\xde\x50\x91\xb7\x4d\xb0\x23\x94\x4e\xd1\xe2\x42\x33\xe2\xee\xc1\x53\x65\xfe\x63\x4d\x5f\xc1\xe3\xa7\x86\xf8\xa1\x08\x7d\xf6\x19\x87\x92\x02\x10\x0e\x55\x91\x0f\x49\x93\x28\x49\x04\x58\xf9\xd3\x1a\x0e\x4b\xe5\x77\x60\x44\x04\x6d\x46\x2a\x95\xcf\x81\x99\xfd\x74\xce\x13\xa5\x74\xfd\x1f\x58\xab\x87\x76\xf8\x66\x39\x5b\x88\xd9\xd4\xa4\x80\xf2\x56\xdd\xe3\xab\xdb\xc2\xeb\x2b\xe5\x6c\x2f\x78\xdf\x12\xbc\xe0\x40\x5c\xf7\xa8\x55\x7b\x2f\x31\x15\xaa\xa0\xf9\xdd\xe3\x3d\x1c\xac\x53\x04\xf8\xcb\x8c\x88\xac\x3d\x18\xee\xf3\x8b\xef\xb8\x63\xac\x4f\xb8\x0f\xf0\x4c\xef\xb1\x20\x80\x90\x5f\xf0\xf0\x9f\xd2\x2a\x6d\x65\xec\x3d\x7c\xa8\x1a\xfa\xfe\x1e\x7a\x82\x4a\x4f\x94\x55\x63\x26\x5e\xa9\xa3\x2f\x3e\xb3\xc9\xce\x49\x68\xd9\x60\x8d\x9e\xae\xc0\xcf\x54\x1d\x61\x51\x1a\xaa\xbc\x61\xe1\x7e\xd3\x2e\xc2\x21\x4e\x54\x45\x93\x59\x34\xb2\x8f\x24\x38\x4f\x4f\x99\x8c\x75\x32\xcc\xc1\x01\x0e\x5e\x05\x1b\x9e\x62\x71\x8a\x14\xe8\xec\x82\x56\x3c\x14\x74\xa8\x23\xfc\xe5\x72\xb2\x9c\x97\x8e\x64\xe3\xcc\xc2\x3a\xe7\xa8\xcd\xb9\xa4\xe4\x57\xd2\x50\x28\x08\xa4\x82\x4e\xc2\x52\xa4\x72\xe2\xb1\x41\x08\x0a\xc3\xba\x10\x71\x5b\x08\x07\xe0\x8f\x77\x14\x12\x22\x21\x65\x28\xa5\x7c\x11\xd6\x48\x70\xdf\x34\xa3\x66\xd8\xa1\x31\x2f\xc2\x56\x63\x92\x0f\xd2\x28\x30\x29\xec\x48\x08\x7b\xda\x51\x58\x88\x76\x6d\x54\x55\xd2\x24\x55\xbd\xa1\x75\x0f\x28\xb1\x49\x14\x9a\x2e\x81\xa9\xbf\xec\x27\x9a\x49\x53\x49\x14\x53\xf7\x76\x72\xd5\xca\x9a\xaa\xf2\xde\x4e\xa5\x2c\xaa\x6e\xa0\x11\x44\xb4\xea\xd8\xb9\x54\x2d\xd6\x19\xeb\xbd\x8c\x46\x92\x6b\xcd\xee\xfa\x8d\x88\x5e\x55\xbb\xaf\x44\xb8\xd4\x3d\x37\x79\x7e\xb2\x5c\x6c\x67\x8b\x2b\x5a\x3c\x4b\xd6\xaf\x42\x11\x4a\xf2\x25\xb5\x9d\xf7\x10\x78\x71\x82\x89\x85\xa1\x37\xd8\xfb\x72\xc1\x03\xf7\x2c\xa7\x42\x42\xdb\x7b\xb2\x59\xa5\xbe\xeb\x5c\x02\x41\x90\x06\x0a\xde\x94\x7c\xde\x4a\x37\xc1\xaa\xe5\xcd\xc7\x8f\x31\x19\x57\x11\xaa\x41\xe6\x91\x08\x23\x22\x11\xc4\x28\xf9\xab\x16\x0c\x8d\xa5\xe3\xd3\x52\x30\xdc\x2c\x60\x1a\x5c\xf3\xb7\x79\x7c\x3b\x5a\x05\x98\xa9\xf2\x44\x6d\xfb\xbf\xff\xfd\x3f\xaa\x5b\xd2\x49\x90\xc4\x0f\x2d\x2d\x6f\x15\xdc\x6b\x05\x7f\x81\xde\x73\xf2\x82\x5e\x85\x72\x2c\x0c\x3a\x0c\x23\xfd\x22\xd6\x2f\x12\x03\x7c\xdb\xb6\xfa\x00\x53\xd5\x29\x3d\x6d\x5b\x5d\x6a\x76\x9a\xce\x69\xf1\x43\xa9\xc7\x33\x8e\xa4\x60\x3a\x5b\x6f\xb6\x4a\x4b\xd4\xac\xdd\xcd\xdc\xee\xdd\x82\xde\x62\xd9\x56\xef\x66\x5f\x61\x42\x30\x7a\x24\xed\x2f\x23\xab\x26\x6b\xa9\x5f\x25\x6b\xbb\x0f\x6b\xd0\x79\xa1\x00\x75\xa2\x48\x91\x59\x70\x65\xbd\xd1\x61\x8e\x9b\xf1\x40\x27\x06\xe2\x35\x83\xf6\xdc\x29\xad\x6b\x4a\xc0\x4a\xf4\x56\xf0\xd5\x62\x54\x3d\x81\xdf\x01\x82\x2a\xad\x17\x6d\x8f\x9b\xba\xbd\x4c\x7f\xe1\x28\xc8\xe6\x57\xf4\x12\x82\x2f\x17\xfa\x2b\x8d\x49\xcb\xa7\x4a\x3b\x2f\x76\xd0\x0e\x41\xf9\x76\x0a\x0c\xe5\x7b\x5a\x44\x73\x93\x04\x2f\x5d\xa1\xb1\x4d\xa1\x21\x25\x2f\x42\x61\xbe\xfc\xe0\x3e\x75\x9e\xf8\xf0\x76\xca\xa4\x46\x4f\xef\x56\xa3\x14\x32\x6e\xa9\xf4\x11\x29\x1d\x77\xcd\xac\xab\x9d\x3f\x3a\xe1\x77\x48\x23\x3d\x21\x83\xb8\x5b\xda\x23\xb1\xda\x83\xde\xa8\x0c\xef\x18\x10\xea\xaf\x60\x66\x4b\x28\x7d\x1a\xad\xf0\xdd\xb7\xa7\x07\x8c\xc7\x57\x1c\x29\x2b\xf2\x32\xf2\xaa\xb0\x29\x57\x60\x95\xd7\x14\xd0\xb4\x6b\x8a\x3f\xc7\x8d\x9c\x44\xe6\x1a\x55\x6f\x2c\xe4\x2b\xe9\xd5\x53\x12\xad\x98\x8a\x6a\xc4\x45\x0f\x80\x5a\x52\xa2\xc5\xd8\x6a\xf4\xa7\x96\xee\x54\xe3\x44\xdb\xcb\x95\x96\x8d\xf4\xf8\x25\x7f\x5c\xef\x4b\xf6\x83\x8f\x70\x60\x0d\x83\x72\x33\x3b\x91\x1f\x31\x78\xad\x66\x9e\xa1\xbd\xa0\x57\xa5\xf7\xef\x73\xf0\x0d\xd5\x8a\xcb\xd8\xb8\x0c\x7b\xb8\x0d\x14\x57\xf5\xde\x51\xb0\x47\x83\xbe\x7b\x7d\xbc\x2b\xe4\xe1\x37\xab\xac\x90\xee\x8b\xe6\xca\xfb\xe2\x82\xcb\x29\x07\xe9\xce\x97\x97\x05\xae\x3e\x0c\x26\x57\xb3\x79\x1e\x2c\x57\xdb\xd9\xe5\xec\xbf\x38\xfd\x7e\x30\x9f\xfd\x54\x04\xeb\xc3\x1f\xf9\x4f\x8a\x00\x34\xd2\xbe\x59\x15\x19\x4e\x02\x40\xe7\xcd\x67\x64\xf0\x55\xba\xdd\x16\xeb\xc5\x86\xe8\x51\x25\x1c\x88\x99\x2e\x71\x37\x30\x6e\xd3\x23\x31\xe6\x89\xf0\x6b\xac\x8b\x0c\xf6\x14\x68\xf6\xc4\xe0\x6e\xad\x00\xae\x8f\x6c\x8c\xa2\x96\x4b\x24\xf1\xd9\xc3\x07\xc2\x5c\x72\xd1\x64\x39\xcc\x5d\xef\xc0\xb0\xcd\x64\x3b\x32\x4e\xf5\x76\xd1\x18\x35\xfe\x48\xbb\x3e\x5c\x70\x0d\x7d\xcb\x45\xa9\x92\xb9\x6a\xac\x5a\xbe\x78\xcc\x16\xfa\xb8\x31\xce\xd2\x5f\xfe\xdb\x79\xf0\x73\x78\xc8\x0e\x43\x7a\x3d\xaf\x6a\x68\x6b\x49\x4b\x61\x64\x68\x54\x94\xd2\xf5\xf5\x45\x3a\x6f\x50\x1a\x1d\x86\x07\x62\x20\x66\xad\xe6\x46\x89\x55\x8c\xf2\xde\x45\xba\x79\x75\xbd\xf8\x46\x4d\x81\x79\x2a\x0b\x1d\xd6\xef\x53\xf1\xf2\x13\x09\xed\x1a\x27\x94\xa2\x22\x46\xbd\xb8\xf8\x3e\x84\xcf\x69\x21\xf1\x3e\xea\x46\xd7\x15\x6e\xef\xf8\x56\xb6\x84\x7e\xd7\x06\xbf\x1a\xed\x3a\xbb\x98\xf1\xec\x83\xf3\x4e\x79\x9e\x38\x09\xe4\x42\x55\x39\x6a\x7d\x28\x01\x2d\x75\xc2\xfd\x46\x2c\x51\xa5\xcf\x26\xef\xfb\xbf\xe2\x27\x91\x61\x97\x4f\x22\xad\x15\xbb\xaf\xbf\x7a\xf9\xc5\x76\xbb\x3a\xc3\x2e\x63\xb3\x2d\xa9\x7d\x3c\x99\xbd\x13\x93\x59\xb8\xd5\x3e\xee\x42\x79\x0f\x07\xfd\xf1\x7d\x89\xa3\x8f\x93\x69\x31\x7a\x3e\x7b\xf7\x35\x11\x3c\xc6\x06\xff\xb8\xb9\xc0\xa0\xcc\x99\x2c\xd7\xc5\xd1\x9c\x63\xf2\x61\xc9\x9a\x37\x3f\xee\xc4\x12\x8d\xf4\x9f\xbc\x30\x75\x3c\x62\x99\xf1\xde\xe1\x93\xf9\x6c\xf2\x04\x49\x60\x70\x7e\xc8\x59\xe5\xbc\x3f\x0a\x96\x3c\x0a\xac\x67\x3c\xf3\x90\x9f\x10\xd4\xf7\x8d\x87\xda\x12\x64\xf9\xe5\x00\x03\xdc\x5e\x39\xa1\x81\xbe\x47\xd4\x0a\x1c\x0a\x96\xf5\x52\x08\x10\xfa\x4c\xa6\x17\x21\xe1\x8e\x1f\xbe\x37\x68\x43\x3c\x91\x1f\xb6\x94\xc4\x1f\x1f\x45\xd1\xfb\xb7\xa8\x85\xfe\x1b\xa1\x85\xb7\xfb\xbc\x05\xff\x12\x6c\x96\x57\xeb\xac\xf8\x2a\x5d\xad\x38\xe2\xbf\x3b\x7b\xf9\x14\x1f\x1e\xcc\x69\x12\xe9\x8f\x9b\xc3\xcb\x74\xf5\xf0\xff\x00\x8e\x82\xd8\x6a\x71\x1f\x06\x00")
 
 func web3JsBytes() ([]byte, error) {
 	return bindataRead(
@@ -0,0 +1,215 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package VBGapi
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/common/hexutil"
+	"github.com/vbgloble/go-VGB/consensus"
+	"github.com/vbgloble/go-VGB/consensus/misc"
+	"github.com/vbgloble/go-VGB/core"
+	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/core/vm"
+	"github.com/vbgloble/go-VGB/log"
+	"github.com/vbgloble/go-VGB/params"
+	"github.com/vbgloble/go-VGB/rpc"
+)
+
+// PublicBundleAPI exposes mVBGods to submit and simulate transaction bundles,
+// ordered groups of transactions included atomically by the local miner.
+type PublicBundleAPI struct {
+	b Backend
+}
+
+// NewPublicBundleAPI creates a new RPC service for transaction bundles.
+func NewPublicBundleAPI(b Backend) *PublicBundleAPI {
+	return &PublicBundleAPI{b}
+}
+
+// SendBundleArgs represents the arguments to submit a transaction bundle.
+type SendBundleArgs struct {
+	Txs               []hexutil.Bytes `json:"txs"`
+	BlockNumber       hexutil.Uint64  `json:"blockNumber"`
+	MinTimestamp      *hexutil.Uint64 `json:"minTimestamp"`
+	MaxTimestamp      *hexutil.Uint64 `json:"maxTimestamp"`
+	RevertingTxHashes []common.Hash   `json:"revertingTxHashes"`
+}
+
+// SendBundle submits a bundle of signed transactions to be included togVBGer,
+// in order and contiguously, in the given block, or not at all.
+func (s *PublicBundleAPI) SendBundle(ctx context.Context, args SendBundleArgs) (common.Hash, error) {
+	txs, err := s.decodeBundle(args.Txs)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	bundle := &core.Bundle{
+		Txs:               txs,
+		BlockNumber:       uint64(args.BlockNumber),
+		RevertingTxHashes: args.RevertingTxHashes,
+	}
+	if args.MinTimestamp != nil {
+		bundle.MinTimestamp = uint64(*args.MinTimestamp)
+	}
+	if args.MaxTimestamp != nil {
+		bundle.MaxTimestamp = uint64(*args.MaxTimestamp)
+	}
+	if err := s.b.SendBundle(ctx, bundle); err != nil {
+		return common.Hash{}, err
+	}
+	log.Info("Submitted transaction bundle", "hash", bundle.Hash(), "number", bundle.BlockNumber, "txs", len(txs))
+	return bundle.Hash(), nil
+}
+
+// CallBundleArgs represents the arguments to simulate a transaction bundle.
+type CallBundleArgs struct {
+	Txs                    []hexutil.Bytes        `json:"txs"`
+	BlockNumber            *hexutil.Uint64        `json:"blockNumber"`
+	StateBlockNumberOrHash *rpc.BlockNumberOrHash `json:"stateBlockNumber"`
+	Coinbase               *common.Address        `json:"coinbase"`
+	Timestamp              *hexutil.Uint64        `json:"timestamp"`
+	RevertingTxHashes      []common.Hash          `json:"revertingTxHashes"`
+}
+
+// CallBundle simulates a bundle of signed transactions on top of the given state
+// block, by default the latest one, as if included in the block following it. It
+// fails if any of the transactions does, and reports the outcome otherwise.
+func (s *PublicBundleAPI) CallBundle(ctx context.Context, args CallBundleArgs) (map[string]interface{}, error) {
+	txs, err := s.decodeBundle(args.Txs)
+	if err != nil {
+		return nil, err
+	}
+	stateBlock := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+	if args.StateBlockNumberOrHash != nil {
+		stateBlock = *args.StateBlockNumberOrHash
+	}
+	statedb, parent, err := s.b.StateAndHeaderByNumberOrHash(ctx, stateBlock)
+	if statedb == nil || err != nil {
+		return nil, err
+	}
+	// Assemble the header of the block the bundle is simulated in
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		Number:     new(big.Int).Add(parent.Number, common.Big1),
+		GasLimit:   parent.GasLimit,
+		Time:       parent.Time + 1,
+		Difficulty: parent.Difficulty,
+		Coinbase:   parent.Coinbase,
+	}
+	if args.BlockNumber != nil {
+		header.Number = new(big.Int).SetUint64(uint64(*args.BlockNumber))
+	}
+	if args.Timestamp != nil {
+		header.Time = uint64(*args.Timestamp)
+	}
+	if args.Coinbase != nil {
+		header.Coinbase = *args.Coinbase
+	}
+	config := s.b.ChainConfig()
+	if config.IsEIP1559(header.Number) {
+		header.BaseFee = bundleBaseFee(config, parent, header)
+	}
+	bundle := &core.Bundle{
+		Txs:               txs,
+		BlockNumber:       header.Number.Uint64(),
+		RevertingTxHashes: args.RevertingTxHashes,
+	}
+	var (
+		gp      = new(core.GasPool).AddGas(header.GasLimit)
+		gasUsed uint64
+	)
+	result, err := core.ApplyBundle(config, &chainContext{ctx, s.b}, &header.Coinbase, gp, statedb, header, bundle, 0, &gasUsed, vm.Config{})
+	if err != nil {
+		return nil, err
+	}
+	signer := types.MakeSigner(config, header.Number)
+
+	results := make([]map[string]interface{}, len(result.Receipts))
+	for i, receipt := range result.Receipts {
+		from, _ := types.Sender(signer, txs[i])
+		results[i] = map[string]interface{}{
+			"txHash":  receipt.TxHash,
+			"from":    from,
+			"to":      txs[i].To(),
+			"gasUsed": hexutil.Uint64(receipt.GasUsed),
+			"status":  hexutil.Uint(receipt.Status),
+			"logs":    receipt.Logs,
+		}
+	}
+	return map[string]interface{}{
+		"bundleHash":       bundle.Hash(),
+		"stateBlockNumber": (*hexutil.Big)(parent.Number),
+		"totalGasUsed":     hexutil.Uint64(result.GasUsed),
+		"coinbaseDiff":     (*hexutil.Big)(result.CoinbaseDiff),
+		"gasFees":          (*hexutil.Big)(result.GasFees),
+		"bundleGasPrice":   (*hexutil.Big)(result.GasPrice()),
+		"results":          results,
+	}, nil
+}
+
+// bundleBaseFee derives the base fee of the block a bundle is simulated in from
+// the state block, taken as its parent even if the block number is overridden.
+// The initial base fee applies if the simulated block is the first one past the
+// fork, or the state block has no base fee to derive from.
+func bundleBaseFee(config *params.ChainConfig, parent *types.Header, header *types.Header) *big.Int {
+	if parent.BaseFee == nil || !config.IsEIP1559(new(big.Int).Sub(header.Number, common.Big1)) {
+		return new(big.Int).SetUint64(params.InitialBaseFee)
+	}
+	return misc.CalcBaseFee(config, parent)
+}
+
+// decodeBundle decodes the transactions of a bundle, ensuring the fee of each
+// of them is _reasonable_.
+func (s *PublicBundleAPI) decodeBundle(encoded []hexutil.Bytes) (types.Transactions, error) {
+	if len(encoded) == 0 {
+		return nil, core.ErrEmptyBundle
+	}
+	txs := make(types.Transactions, len(encoded))
+	for i, enc := range encoded {
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalBinary(enc); err != nil {
+			return nil, fmt.Errorf("tx %d: %v", i, err)
+		}
+		if err := checkTxFee(tx.GasFeeCap(), tx.Gas(), s.b.RPCTxFeeCap()); err != nil {
+			return nil, fmt.Errorf("tx %d: %v", i, err)
+		}
+		txs[i] = tx
+	}
+	return txs, nil
+}
+
+// chainContext adapts a Backend to core.ChainContext for executing transactions
+// outside of the block processor.
+type chainContext struct {
+	ctx context.Context
+	b   Backend
+}
+
+func (c *chainContext) Engine() consensus.Engine {
+	return c.b.Engine()
+}
+
+func (c *chainContext) GVBGeader(hash common.Hash, number uint64) *types.Header {
+	header, err := c.b.HeaderByHash(c.ctx, hash)
+	if err != nil || header == nil || header.Number.Uint64() != number {
+		return nil
+	}
+	return header
+}
@@ -181,6 +181,21 @@ web3._extend({
 			call: 'admin_importChain',
 			params: 1
 		}),
+		new web3._extend.MVBGod({
+			name: 'setFinalized',
+			call: 'admin_setFinalized',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.MVBGod({
+			name: 'submitFinalityCheckpoint',
+			call: 'admin_submitFinalityCheckpoint',
+			params: 1
+		}),
+		new web3._extend.MVBGod({
+			name: 'reloadTxPolicy',
+			call: 'admin_reloadTxPolicy',
+		}),
 		new web3._extend.MVBGod({
 			name: 'sleepBlocks',
 			call: 'admin_sleepBlocks',
@@ -448,6 +463,17 @@ web3._extend({
 			call: 'debug_getBadBlocks',
 			params: 0,
 		}),
+		new web3._extend.MVBGod({
+			name: 'executionWitness',
+			call: 'debug_executionWitness',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.MVBGod({
+			name: 'executeStateless',
+			call: 'debug_executeStateless',
+			params: 2,
+		}),
 		new web3._extend.MVBGod({
 			name: 'storageRangeAt',
 			call: 'debug_storageRangeAt',
@@ -515,6 +541,21 @@ web3._extend({
 			params: 1,
 			inputFormatter: [web3._extend.formatters.inputTransactionFormatter]
 		}),
+		new web3._extend.MVBGod({
+			name: 'sendPrivateTransaction',
+			call: 'VBG_sendPrivateTransaction',
+			params: 1
+		}),
+		new web3._extend.MVBGod({
+			name: 'sendBundle',
+			call: 'VBG_sendBundle',
+			params: 1
+		}),
+		new web3._extend.MVBGod({
+			name: 'callBundle',
+			call: 'VBG_callBundle',
+			params: 1
+		}),
 		new web3._extend.MVBGod({
 			name: 'fillTransaction',
 			call: 'VBG_fillTransaction',
@@ -76,11 +76,11 @@ type Lightvbgloble struct {
 
 // New creates an instance of the light client.
 func New(stack *node.Node, config *VBG.Config) (*Lightvbgloble, error) {
-	chainDb, err := stack.OpenDatabase("lightchaindata", config.DatabaseCache, config.DatabaseHandles, "VBG/db/chaindata/")
+	chainDb, err := stack.OpenDatabase("lightchaindata", config.DatabaseCache, config.DatabaseHandles, "VBG/db/chaindata/", false)
 	if err != nil {
 		return nil, err
 	}
-	lespayDb, err := stack.OpenDatabase("lespay", 0, 0, "VBG/db/lespay")
+	lespayDb, err := stack.OpenDatabase("lespay", 0, 0, "VBG/db/lespay", false)
 	if err != nil {
 		return nil, err
 	}
@@ -38,6 +38,7 @@ import (
 type Backend interface {
 	BlockChain() *core.BlockChain
 	TxPool() *core.TxPool
+	BundlePool() *core.BundlePool
 }
 
 // Config is the configuration parameters of mining.
@@ -50,6 +51,7 @@ type Config struct {
 	GasPrice  *big.Int       // Minimum gas price for mining a transaction
 	Recommit  time.Duration  // The time interval for miner to re-create mining work.
 	Noverify  bool           // Disable remote mining solution verification(only useful in VBGash).
+	Ordering  string         // Transaction ordering strategy used to fill blocks (price, fifo or fair).
 }
 
 // Miner creates blocks and searches for proof-of-work values.
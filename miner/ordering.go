@@ -0,0 +1,201 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package miner
+
+import (
+	"bytes"
+	"container/heap"
+	"fmt"
+	"math/big"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/core/types"
+)
+
+// Names of the supported transaction ordering strategies.
+const (
+	OrderingPrice = "price" // Highest effective tip first
+	OrderingFIFO  = "fifo"  // First seen locally first
+	OrderingFair  = "fair"  // Round robin across senders, first seen first within a round
+)
+
+// TransactionOrdering is the sequence in which pending transactions are offered
+// to the worker when filling a block. Transactions of a single sender are always
+// offered in nonce order.
+type TransactionOrdering interface {
+	// Peek returns the next transaction to include, or nil if none are left.
+	Peek() *types.Transaction
+
+	// Shift replaces the current transaction with the next one from the same sender.
+	Shift()
+
+	// Pop removes the current transaction along with all subsequent ones from
+	// the same sender.
+	Pop()
+}
+
+// OrderingStrategy creates the transaction ordering used to fill a block from
+// the nonce sorted pending transactions of each sender.
+type OrderingStrategy interface {
+	Order(signer types.Signer, txs map[common.Address]types.Transactions, baseFee *big.Int) TransactionOrdering
+}
+
+// NewOrderingStrategy returns the ordering strategy with the given name, the
+// price ordering if none is specified.
+func NewOrderingStrategy(name string) (OrderingStrategy, error) {
+	switch name {
+	case "", OrderingPrice:
+		return priceOrdering{}, nil
+	case OrderingFIFO:
+		return fifoOrdering{}, nil
+	case OrderingFair:
+		return fairOrdering{}, nil
+	default:
+		return nil, fmt.Errorf("unknown transaction ordering %q, want %q, %q or %q", name, OrderingPrice, OrderingFIFO, OrderingFair)
+	}
+}
+
+// priceOrdering offers the transactions paying the highest effective tip first.
+type priceOrdering struct{}
+
+func (priceOrdering) Order(signer types.Signer, txs map[common.Address]types.Transactions, baseFee *big.Int) TransactionOrdering {
+	return types.NewTransactionsByPriceAndNonce(signer, txs, baseFee)
+}
+
+// fifoOrdering offers the transactions in the order they were first seen locally.
+type fifoOrdering struct{}
+
+func (fifoOrdering) Order(signer types.Signer, txs map[common.Address]types.Transactions, baseFee *big.Int) TransactionOrdering {
+	return newTransactionsByHeads(signer, txs, baseFee, func(a, b *orderedHead) bool {
+		return firstSeen(a.tx, b.tx)
+	})
+}
+
+// fairOrdering offers one transaction of each sender per round, so that no sender
+// can crowd out the others. Within a round, transactions are offered in the order
+// they were first seen locally.
+type fairOrdering struct{}
+
+func (fairOrdering) Order(signer types.Signer, txs map[common.Address]types.Transactions, baseFee *big.Int) TransactionOrdering {
+	return newTransactionsByHeads(signer, txs, baseFee, func(a, b *orderedHead) bool {
+		if a.round != b.round {
+			return a.round < b.round
+		}
+		return firstSeen(a.tx, b.tx)
+	})
+}
+
+// firstSeen reports whVBGer a was seen locally before b, falling back to the
+// transaction hash for a deterministic order.
+func firstSeen(a, b *types.Transaction) bool {
+	if !a.Time().Equal(b.Time()) {
+		return a.Time().Before(b.Time())
+	}
+	return bytes.Compare(a.Hash().Bytes(), b.Hash().Bytes()) < 0
+}
+
+// orderedHead is the next transaction of a sender along with the number of its
+// transactions already offered.
+type orderedHead struct {
+	tx    *types.Transaction
+	from  common.Address
+	round int
+}
+
+// orderedHeads implements the heap interface over the heads of each sender.
+type orderedHeads struct {
+	heads []*orderedHead
+	less  func(a, b *orderedHead) bool
+}
+
+func (h *orderedHeads) Len() int           { return len(h.heads) }
+func (h *orderedHeads) Less(i, j int) bool { return h.less(h.heads[i], h.heads[j]) }
+func (h *orderedHeads) Swap(i, j int)      { h.heads[i], h.heads[j] = h.heads[j], h.heads[i] }
+
+func (h *orderedHeads) Push(x interface{}) {
+	h.heads = append(h.heads, x.(*orderedHead))
+}
+
+func (h *orderedHeads) Pop() interface{} {
+	old := h.heads
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil
+	h.heads = old[0 : n-1]
+	return x
+}
+
+// transactionsByHeads is a nonce-honouring transaction ordering which offers the
+// heads of the senders in the order given by an arbitrary comparator.
+type transactionsByHeads struct {
+	txs     map[common.Address]types.Transactions // Per account nonce-sorted list of transactions
+	heads   *orderedHeads                         // Next transaction for each unique account
+	baseFee *big.Int                              // Current base fee
+}
+
+// newTransactionsByHeads creates a transaction ordering over the given nonce
+// sorted transactions. Transactions unable to pay the base fee are skipped
+// along with all subsequent ones of their sender.
+//
+// Note, the input map is reowned so the caller should not interact any more with
+// it after providing it to the constructor.
+func newTransactionsByHeads(signer types.Signer, txs map[common.Address]types.Transactions, baseFee *big.Int, less func(a, b *orderedHead) bool) *transactionsByHeads {
+	heads := &orderedHeads{heads: make([]*orderedHead, 0, len(txs)), less: less}
+	for from, accTxs := range txs {
+		acc, _ := types.Sender(signer, accTxs[0])
+		if _, err := accTxs[0].EffectiveGasTip(baseFee); acc != from || err != nil {
+			delete(txs, from)
+			continue
+		}
+		heads.heads = append(heads.heads, &orderedHead{tx: accTxs[0], from: from})
+		txs[from] = accTxs[1:]
+	}
+	heap.Init(heads)
+
+	return &transactionsByHeads{
+		txs:     txs,
+		heads:   heads,
+		baseFee: baseFee,
+	}
+}
+
+// Peek returns the next transaction in order.
+func (t *transactionsByHeads) Peek() *types.Transaction {
+	if t.heads.Len() == 0 {
+		return nil
+	}
+	return t.heads.heads[0].tx
+}
+
+// Shift replaces the current head with the next one from the same account.
+func (t *transactionsByHeads) Shift() {
+	head := t.heads.heads[0]
+	if txs := t.txs[head.from]; len(txs) > 0 {
+		if _, err := txs[0].EffectiveGasTip(t.baseFee); err == nil {
+			head.tx, head.round, t.txs[head.from] = txs[0], head.round+1, txs[1:]
+			heap.Fix(t.heads, 0)
+			return
+		}
+	}
+	heap.Pop(t.heads)
+}
+
+// Pop removes the current head, *not* replacing it with the next one from the
+// same account.
+func (t *transactionsByHeads) Pop() {
+	heap.Pop(t.heads)
+}
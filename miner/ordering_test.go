@@ -0,0 +1,105 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package miner
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/crypto"
+	"github.com/vbgloble/go-VGB/params"
+)
+
+// Tests that each ordering strategy offers the transactions in the expected
+// order, always honouring the nonces of the senders.
+func TestTransactionOrdering(t *testing.T) {
+	var (
+		signer  = types.HomesteadSigner{}
+		keys    = make([]*ecdsa.PrivateKey, 3)
+		ordered []*types.Transaction
+	)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+	}
+	// Create the transactions in a known order, spaced out to ensure distinct
+	// first seen times
+	create := func(key int, nonce uint64, price int64) *types.Transaction {
+		time.Sleep(time.Millisecond)
+		tx, _ := types.SignTx(types.NewTransaction(nonce, common.Address{}, big.NewInt(0), params.TxGas, big.NewInt(price), nil), signer, keys[key])
+		ordered = append(ordered, tx)
+		return tx
+	}
+	var (
+		b0 = create(1, 0, 1)
+		a0 = create(0, 0, 3)
+		a1 = create(0, 1, 3)
+		c0 = create(2, 0, 2)
+		b1 = create(1, 1, 1)
+		a2 = create(0, 2, 3)
+	)
+	pending := func() map[common.Address]types.Transactions {
+		txs := make(map[common.Address]types.Transactions)
+		for _, tx := range ordered {
+			from, _ := types.Sender(signer, tx)
+			txs[from] = append(txs[from], tx)
+		}
+		return txs
+	}
+	tests := []struct {
+		name string
+		pop  *types.Transaction // Transaction to pop instead of shift
+		want []*types.Transaction
+	}{
+		{name: OrderingPrice, want: []*types.Transaction{a0, a1, a2, c0, b0, b1}},
+		{name: OrderingFIFO, want: []*types.Transaction{b0, a0, a1, c0, b1, a2}},
+		{name: OrderingFair, want: []*types.Transaction{b0, a0, c0, a1, b1, a2}},
+		{name: OrderingFIFO, pop: a0, want: []*types.Transaction{b0, a0, c0, b1}},
+		{name: OrderingFair, pop: b0, want: []*types.Transaction{b0, a0, c0, a1, a2}},
+	}
+	for i, tt := range tests {
+		strategy, err := NewOrderingStrategy(tt.name)
+		if err != nil {
+			t.Fatalf("test %d: failed to create %s ordering: %v", i, tt.name, err)
+		}
+		var have []*types.Transaction
+
+		txs := strategy.Order(signer, pending(), nil)
+		for tx := txs.Peek(); tx != nil; tx = txs.Peek() {
+			have = append(have, tx)
+			if tx == tt.pop {
+				txs.Pop()
+			} else {
+				txs.Shift()
+			}
+		}
+		if len(have) != len(tt.want) {
+			t.Fatalf("test %d (%s): transaction count mismatch: have %d, want %d", i, tt.name, len(have), len(tt.want))
+		}
+		for j := range have {
+			if have[j] != tt.want[j] {
+				t.Errorf("test %d (%s): transaction %d mismatch: have %x, want %x", i, tt.name, j, have[j].Hash(), tt.want[j].Hash())
+			}
+		}
+	}
+	if _, err := NewOrderingStrategy("random"); err == nil {
+		t.Errorf("unknown ordering strategy accepted")
+	}
+}
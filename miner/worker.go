@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"errors"
 	"math/big"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -54,6 +55,11 @@ const (
 	// resubmitAdjustChanSize is the size of resubmitting interval adjustment channel.
 	resubmitAdjustChanSize = 10
 
+	// bundleProfitTolerance is the percentage of its simulated payment a bundle
+	// must still pay on top of the bundles merged before it, below which it is
+	// considered conflicting with them and skipped.
+	bundleProfitTolerance = 99
+
 	// miningLogAtDepth is the number of confirmations before logging successful mining.
 	miningLogAtDepth = 7
 
@@ -128,6 +134,7 @@ type worker struct {
 	engine      consensus.Engine
 	VBG         Backend
 	chain       *core.BlockChain
+	ordering    OrderingStrategy
 
 	// Feeds
 	pendingLogsFeed event.Feed
@@ -211,6 +218,14 @@ func newWorker(config *Config, chainConfig *params.ChainConfig, engine consensus
 		resubmitIntervalCh: make(chan time.Duration),
 		resubmitAdjustCh:   make(chan *intervalAdjust, resubmitAdjustChanSize),
 	}
+	// Resolve the strategy used to order transactions within blocks
+	ordering, err := NewOrderingStrategy(config.Ordering)
+	if err != nil {
+		log.Warn("Falling back to price transaction ordering", "err", err)
+		ordering = priceOrdering{}
+	}
+	worker.ordering = ordering
+
 	// Subscribe NewTxsEvent for tx pool
 	worker.txsSub = VBG.TxPool().SubscribeNewTxsEvent(worker.txsCh)
 	// Subscribe events for blockchain
@@ -492,7 +507,7 @@ func (w *worker) mainLoop() {
 					acc, _ := types.Sender(w.current.signer, tx)
 					txs[acc] = append(txs[acc], tx)
 				}
-				txset := types.NewTransactionsByPriceAndNonce(w.current.signer, txs)
+				txset := w.ordering.Order(w.current.signer, txs, w.current.header.BaseFee)
 				tcount := w.current.tcount
 				w.commitTransactions(txset, coinbase, nil)
 				// Only update the snapshot if any new transactons were added
@@ -643,7 +658,7 @@ func (w *worker) makeCurrent(parent *types.Block, header *types.Header) error {
 		return err
 	}
 	env := &environment{
-		signer:    types.NewEIP155Signer(w.chainConfig.ChainID),
+		signer:    types.MakeSigner(w.chainConfig, header.Number),
 		state:     state,
 		ancestors: mapset.NewSet(),
 		family:    mapset.NewSet(),
@@ -733,7 +748,7 @@ func (w *worker) commitTransaction(tx *types.Transaction, coinbase common.Addres
 	return receipt.Logs, nil
 }
 
-func (w *worker) commitTransactions(txs *types.TransactionsByPriceAndNonce, coinbase common.Address, interrupt *int32) bool {
+func (w *worker) commitTransactions(txs TransactionOrdering, coinbase common.Address, interrupt *int32) bool {
 	// Short circuit if current is nil
 	if w.current == nil {
 		return true
@@ -809,6 +824,11 @@ func (w *worker) commitTransactions(txs *types.TransactionsByPriceAndNonce, coin
 			log.Trace("Skipping account with hight nonce", "sender", from, "nonce", tx.Nonce())
 			txs.Pop()
 
+		case core.ErrTxTypeNotSupported:
+			// Pop the unsupported transaction without shifting in the next from the account
+			log.Trace("Skipping unsupported transaction type", "sender", from, "type", tx.Type())
+			txs.Pop()
+
 		case nil:
 			// Everything ok, collect the logs and shift in the next transaction from the same account
 			coalescedLogs = append(coalescedLogs, logs...)
@@ -823,7 +843,103 @@ func (w *worker) commitTransactions(txs *types.TransactionsByPriceAndNonce, coin
 		}
 	}
 
-	if !w.isRunning() && len(coalescedLogs) > 0 {
+	w.postPendingLogs(coalescedLogs)
+
+	// Notify resubmit loop to decrease resubmitting interval if current interval is larger
+	// than the user-specified one.
+	if interrupt != nil {
+		w.resubmitAdjustCh <- &intervalAdjust{inc: false}
+	}
+	return false
+}
+
+// simulatedBundle is a transaction bundle along with the outcome of executing it
+// in isolation on top of the pending state.
+type simulatedBundle struct {
+	bundle *core.Bundle
+	result *core.BundleResult
+}
+
+// commitBundles simulates the given bundles against the pending state and commits
+// the most profitable non-conflicting set of them. Bundles are merged greedily in
+// order of decreasing effective gas price, skipping any which fails, or whose
+// payment drops notably, on top of the ones already merged.
+//
+// Like commitTransactions, the return value reports whVBGer the work was aborted
+// by a new head and must be discarded.
+func (w *worker) commitBundles(bundles []*core.Bundle, coinbase common.Address, interrupt *int32) bool {
+	// Short circuit if current is nil
+	if w.current == nil {
+		return true
+	}
+	if w.current.gasPool == nil {
+		w.current.gasPool = new(core.GasPool).AddGas(w.current.header.GasLimit)
+	}
+	vmConfig := *w.chain.GetVMConfig()
+
+	// Simulate every bundle in isolation on a copy of the pending state to rank
+	// them by profitability
+	simulated := make([]*simulatedBundle, 0, len(bundles))
+	for _, bundle := range bundles {
+		if interrupt != nil && atomic.LoadInt32(interrupt) != commitInterruptNone {
+			return atomic.LoadInt32(interrupt) == commitInterruptNewHead
+		}
+		var (
+			gasPool = new(core.GasPool).AddGas(w.current.gasPool.Gas())
+			gasUsed = w.current.header.GasUsed
+		)
+		result, err := core.ApplyBundle(w.chainConfig, w.chain, &coinbase, gasPool, w.current.state.Copy(), w.current.header, bundle, w.current.tcount, &gasUsed, vmConfig)
+		if err != nil {
+			log.Trace("Discarding failing bundle", "hash", bundle.Hash(), "err", err)
+			continue
+		}
+		simulated = append(simulated, &simulatedBundle{bundle: bundle, result: result})
+	}
+	sort.SliceStable(simulated, func(i, j int) bool {
+		return simulated[i].result.GasPrice().Cmp(simulated[j].result.GasPrice()) > 0
+	})
+	// Merge the bundles into the pending block, most profitable first. As the state
+	// cannot be reverted across transactions, each is applied on a copy which only
+	// replaces the pending state if the bundle is accepted.
+	var coalescedLogs []*types.Log
+	for _, sim := range simulated {
+		// Abort on a new head, leave a resubmit to commitTransactions to notice
+		if interrupt != nil && atomic.LoadInt32(interrupt) != commitInterruptNone {
+			return atomic.LoadInt32(interrupt) == commitInterruptNewHead
+		}
+		var (
+			statedb = w.current.state.Copy()
+			gasPool = new(core.GasPool).AddGas(w.current.gasPool.Gas())
+			gasUsed = w.current.header.GasUsed
+		)
+		result, err := core.ApplyBundle(w.chainConfig, w.chain, &coinbase, gasPool, statedb, w.current.header, sim.bundle, w.current.tcount, &gasUsed, vmConfig)
+		if err != nil {
+			log.Trace("Skipping conflicting bundle", "hash", sim.bundle.Hash(), "err", err)
+			continue
+		}
+		floor := new(big.Int).Mul(sim.result.CoinbaseDiff, big.NewInt(bundleProfitTolerance))
+		floor.Div(floor, big.NewInt(100))
+		if result.CoinbaseDiff.Cmp(floor) < 0 {
+			log.Trace("Skipping conflicting bundle", "hash", sim.bundle.Hash(), "simulated", sim.result.CoinbaseDiff, "merged", result.CoinbaseDiff)
+			continue
+		}
+		w.current.state, w.current.gasPool, w.current.header.GasUsed = statedb, gasPool, gasUsed
+		w.current.txs = append(w.current.txs, sim.bundle.Txs...)
+		w.current.receipts = append(w.current.receipts, result.Receipts...)
+		w.current.tcount += len(sim.bundle.Txs)
+
+		for _, receipt := range result.Receipts {
+			coalescedLogs = append(coalescedLogs, receipt.Logs...)
+		}
+		log.Debug("Committed transaction bundle", "hash", sim.bundle.Hash(), "txs", len(sim.bundle.Txs), "gas", result.GasUsed, "payment", result.CoinbaseDiff)
+	}
+	w.postPendingLogs(coalescedLogs)
+	return false
+}
+
+// postPendingLogs publishes the logs of newly committed pending transactions.
+func (w *worker) postPendingLogs(logs []*types.Log) {
+	if !w.isRunning() && len(logs) > 0 {
 		// We don't push the pendingLogsEvent while we are mining. The reason is that
 		// when we are mining, the worker will regenerate a mining block every 3 seconds.
 		// In order to avoid pushing the repeated pendingLog, we disable the pending log pushing.
@@ -831,19 +947,13 @@ func (w *worker) commitTransactions(txs *types.TransactionsByPriceAndNonce, coin
 		// make a copy, the state caches the logs and these logs get "upgraded" from pending to mined
 		// logs by filling in the block hash when the block was mined by the local miner. This can
 		// cause a race condition if a log was "upgraded" before the PendingLogsEvent is processed.
-		cpy := make([]*types.Log, len(coalescedLogs))
-		for i, l := range coalescedLogs {
+		cpy := make([]*types.Log, len(logs))
+		for i, l := range logs {
 			cpy[i] = new(types.Log)
 			*cpy[i] = *l
 		}
 		w.pendingLogsFeed.Send(cpy)
 	}
-	// Notify resubmit loop to decrease resubmitting interval if current interval is larger
-	// than the user-specified one.
-	if interrupt != nil {
-		w.resubmitAdjustCh <- &intervalAdjust{inc: false}
-	}
-	return false
 }
 
 // commitNewWork generates several new sealing tasks based on the parent block.
@@ -872,6 +982,16 @@ func (w *worker) commitNewWork(interrupt *int32, noempty bool, timestamp int64)
 		Extra:      w.extra,
 		Time:       uint64(timestamp),
 	}
+	// Set baseFee and GasLimit if we are on an EIP-1559 chain
+	if w.chainConfig.IsEIP1559(header.Number) {
+		header.BaseFee = misc.CalcBaseFee(w.chainConfig, parent.Header())
+		parentGasLimit := parent.GasLimit()
+		if !w.chainConfig.IsEIP1559(parent.Number()) {
+			// Bump by 2x
+			parentGasLimit = parent.GasLimit() * params.ElasticityMultiplier
+		}
+		header.GasLimit = core.CalcGasLimit1559(parentGasLimit, w.config.GasCeil)
+	}
 	// Only set the coinbase if our consensus engine is running (avoid spurious block rewards)
 	if w.isRunning() {
 		if w.coinbase == (common.Address{}) {
@@ -939,6 +1059,12 @@ func (w *worker) commitNewWork(interrupt *int32, noempty bool, timestamp int64)
 		w.commit(uncles, nil, false, tstart)
 	}
 
+	// Place the bundles targeting this block ahead of any regular transaction
+	if bundles := w.VBG.BundlePool().Bundles(header.Number.Uint64(), header.Time); len(bundles) > 0 {
+		if w.commitBundles(bundles, w.coinbase, interrupt) {
+			return
+		}
+	}
 	// Fill the block with all available pending transactions.
 	pending, err := w.VBG.TxPool().Pending()
 	if err != nil {
@@ -948,10 +1074,20 @@ func (w *worker) commitNewWork(interrupt *int32, noempty bool, timestamp int64)
 	// Short circuit if there is no available pending transactions.
 	// But if we disable empty precommit already, ignore it. Since
 	// empty block is necessary to keep the liveness of the network.
-	if len(pending) == 0 && atomic.LoadUint32(&w.noempty) == 0 {
+	if len(pending) == 0 && w.current.tcount == 0 && atomic.LoadUint32(&w.noempty) == 0 {
 		w.updateSnapshot()
 		return
 	}
+	// Orderings other than by price define the sequence of the whole block, so
+	// locals get no precedence over remotes there
+	if _, ok := w.ordering.(priceOrdering); !ok {
+		txs := w.ordering.Order(w.current.signer, pending, header.BaseFee)
+		if w.commitTransactions(txs, w.coinbase, interrupt) {
+			return
+		}
+		w.commit(uncles, w.fullTaskHook, true, tstart)
+		return
+	}
 	// Split the pending transactions into locals and remotes
 	localTxs, remoteTxs := make(map[common.Address]types.Transactions), pending
 	for _, account := range w.VBG.TxPool().Locals() {
@@ -961,13 +1097,13 @@ func (w *worker) commitNewWork(interrupt *int32, noempty bool, timestamp int64)
 		}
 	}
 	if len(localTxs) > 0 {
-		txs := types.NewTransactionsByPriceAndNonce(w.current.signer, localTxs)
+		txs := w.ordering.Order(w.current.signer, localTxs, header.BaseFee)
 		if w.commitTransactions(txs, w.coinbase, interrupt) {
 			return
 		}
 	}
 	if len(remoteTxs) > 0 {
-		txs := types.NewTransactionsByPriceAndNonce(w.current.signer, remoteTxs)
+		txs := w.ordering.Order(w.current.signer, remoteTxs, header.BaseFee)
 		if w.commitTransactions(txs, w.coinbase, interrupt) {
 			return
 		}
@@ -1029,7 +1165,8 @@ func (w *worker) postSideBlock(event core.ChainSideEvent) {
 func totalFees(block *types.Block, receipts []*types.Receipt) *big.Float {
 	feesWei := new(big.Int)
 	for i, tx := range block.Transactions() {
-		feesWei.Add(feesWei, new(big.Int).Mul(new(big.Int).SetUint64(receipts[i].GasUsed), tx.GasPrice()))
+		minerFee, _ := tx.EffectiveGasTip(block.BaseFee())
+		feesWei.Add(feesWei, new(big.Int).Mul(new(big.Int).SetUint64(receipts[i].GasUsed), minerFee))
 	}
 	return new(big.Float).Quo(new(big.Float).SetInt(feesWei), new(big.Float).SetInt(big.NewInt(params.VBGer)))
 }
@@ -17,6 +17,7 @@
 package miner
 
 import (
+	"crypto/ecdsa"
 	"math/big"
 	"math/rand"
 	"sync/atomic"
@@ -61,6 +62,9 @@ var (
 	testUserKey, _  = crypto.GenerateKey()
 	testUserAddress = crypto.PubkeyToAddress(testUserKey.PublicKey)
 
+	testRemoteKey, _  = crypto.GenerateKey()
+	testRemoteAddress = crypto.PubkeyToAddress(testRemoteKey.PublicKey)
+
 	// Test transactions
 	pendingTxs []*types.Transaction
 	newTxs     []*types.Transaction
@@ -92,6 +96,7 @@ func init() {
 type testWorkerBackend struct {
 	db         VBGdb.Database
 	txPool     *core.TxPool
+	bundlePool *core.BundlePool
 	chain      *core.BlockChain
 	testTxFeed event.Feed
 	genesis    *core.Genesis
@@ -101,7 +106,10 @@ type testWorkerBackend struct {
 func newTestWorkerBackend(t *testing.T, chainConfig *params.ChainConfig, engine consensus.Engine, db VBGdb.Database, n int) *testWorkerBackend {
 	var gspec = core.Genesis{
 		Config: chainConfig,
-		Alloc:  core.GenesisAlloc{testBankAddress: {Balance: testBankFunds}},
+		Alloc: core.GenesisAlloc{
+			testBankAddress:   {Balance: testBankFunds},
+			testRemoteAddress: {Balance: testBankFunds},
+		},
 	}
 
 	switch e := engine.(type) {
@@ -141,6 +149,7 @@ func newTestWorkerBackend(t *testing.T, chainConfig *params.ChainConfig, engine
 		db:         db,
 		chain:      chain,
 		txPool:     txpool,
+		bundlePool: core.NewBundlePool(chainConfig, chain),
 		genesis:    &gspec,
 		uncleBlock: blocks[0],
 	}
@@ -148,6 +157,7 @@ func newTestWorkerBackend(t *testing.T, chainConfig *params.ChainConfig, engine
 
 func (b *testWorkerBackend) BlockChain() *core.BlockChain { return b.chain }
 func (b *testWorkerBackend) TxPool() *core.TxPool         { return b.txPool }
+func (b *testWorkerBackend) BundlePool() *core.BundlePool { return b.bundlePool }
 
 func (b *testWorkerBackend) newRandomUncle() *types.Block {
 	var parent *types.Block
@@ -501,3 +511,150 @@ func testAdjustInterval(t *testing.T, chainConfig *params.ChainConfig, engine co
 		t.Error("interval reset timeout")
 	}
 }
+
+func TestCommitBundles(t *testing.T) {
+	VBGash := VBGash.NewFaker()
+	defer VBGash.Close()
+
+	w, b := newTestWorker(t, VBGashChainConfig, VBGash, rawdb.NewMemoryDatabase(), 0)
+	defer w.close()
+
+	// Pay fees to a separate account, otherwise the bank pays itself
+	w.setVBGerbase(common.Address{0xc0})
+	b.txPool.AddLocals(newTxs)
+
+	// Submit two conflicting bundles, the more profitable one should be picked
+	// and placed ahead of the regular transactions
+	sign := func(nonce uint64, price int64) *types.Transaction {
+		tx, _ := types.SignTx(types.NewTransaction(nonce, testUserAddress, big.NewInt(1000), params.TxGas, big.NewInt(price), nil), types.HomesteadSigner{}, testBankKey)
+		return tx
+	}
+	loser := &core.Bundle{Txs: types.Transactions{sign(0, 10), sign(1, 10)}, BlockNumber: 1}
+	winner := &core.Bundle{Txs: types.Transactions{sign(0, 20)}, BlockNumber: 1}
+	for _, bundle := range []*core.Bundle{loser, winner} {
+		if err := b.bundlePool.Add(bundle); err != nil {
+			t.Fatalf("failed to add bundle: %v", err)
+		}
+	}
+	taskCh := make(chan *task, 1)
+	w.newTaskHook = func(task *task) {
+		if task.block.NumberU64() == 1 && len(task.receipts) > 0 {
+			select {
+			case taskCh <- task:
+			default:
+			}
+		}
+	}
+	w.skipSealHook = func(task *task) bool { return true }
+	w.start()
+
+	select {
+	case task := <-taskCh:
+		txs := task.block.Transactions()
+		if len(txs) != 2 {
+			t.Fatalf("transaction count mismatch: have %d, want 2", len(txs))
+		}
+		if txs[0].Hash() != winner.Txs[0].Hash() {
+			t.Errorf("first transaction mismatch: have %x, want bundle transaction %x", txs[0].Hash(), winner.Txs[0].Hash())
+		}
+		if txs[1].Hash() != newTxs[0].Hash() {
+			t.Errorf("second transaction mismatch: have %x, want pool transaction %x", txs[1].Hash(), newTxs[0].Hash())
+		}
+	case <-time.NewTimer(3 * time.Second).C:
+		t.Fatal("new task timeout")
+	}
+}
+
+// Tests that committing bundles is aborted by an interrupt, discarding the work on
+// a new head only.
+func TestCommitBundlesInterrupt(t *testing.T) {
+	VBGash := VBGash.NewFaker()
+	defer VBGash.Close()
+
+	w, _ := newTestWorker(t, VBGashChainConfig, VBGash, rawdb.NewMemoryDatabase(), 0)
+	defer w.close()
+
+	tx, _ := types.SignTx(types.NewTransaction(0, testUserAddress, big.NewInt(1000), params.TxGas, big.NewInt(10), nil), types.HomesteadSigner{}, testBankKey)
+	bundles := []*core.Bundle{{Txs: types.Transactions{tx}, BlockNumber: 1}}
+
+	// Hold the lock of the background work generation to own the pending block
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	parent := w.chain.CurrentBlock()
+	for _, signal := range []int32{commitInterruptNone, commitInterruptNewHead, commitInterruptResubmit} {
+		header := &types.Header{ParentHash: parent.Hash(), Number: big.NewInt(1), GasLimit: parent.GasLimit(), Time: parent.Time() + 1, Difficulty: big.NewInt(1)}
+		if err := w.makeCurrent(parent, header); err != nil {
+			t.Fatalf("failed to prepare pending block: %v", err)
+		}
+		interrupt := signal
+		if discard := w.commitBundles(bundles, common.Address{0xc0}, &interrupt); discard != (signal == commitInterruptNewHead) {
+			t.Errorf("signal %d: discard mismatch: have %v, want %v", signal, discard, signal == commitInterruptNewHead)
+		}
+		want := 0
+		if signal == commitInterruptNone {
+			want = 1
+		}
+		if w.current.tcount != want {
+			t.Errorf("signal %d: committed transaction count mismatch: have %d, want %d", signal, w.current.tcount, want)
+		}
+	}
+}
+
+// Tests that with an ordering other than by price, local and remote transactions
+// are included in the order they arrived, rather than locals first.
+func TestCommitOrderingInterleaved(t *testing.T) {
+	VBGash := VBGash.NewFaker()
+	defer VBGash.Close()
+
+	b := newTestWorkerBackend(t, VBGashChainConfig, VBGash, rawdb.NewMemoryDatabase(), 0)
+	config := *testConfig
+	config.Ordering = OrderingFIFO
+	w := newWorker(&config, VBGashChainConfig, VBGash, b, new(event.TypeMux), nil, false)
+	defer w.close()
+
+	// Pay fees to a separate account, otherwise the bank pays itself
+	w.setVBGerbase(common.Address{0xc0})
+
+	// Alternate arrivals of cheap local and expensive remote transactions
+	var (
+		start = time.Now()
+		want  []*types.Transaction
+	)
+	sign := func(key *ecdsa.PrivateKey, nonce uint64, price int64) *types.Transaction {
+		tx, _ := types.SignTx(types.NewTransaction(nonce, testUserAddress, big.NewInt(1000), params.TxGas, big.NewInt(price), nil), types.HomesteadSigner{}, key)
+		tx.SetTime(start.Add(time.Duration(len(want)) * time.Second))
+		want = append(want, tx)
+		return tx
+	}
+	for nonce := uint64(0); nonce < 2; nonce++ {
+		if err := b.txPool.AddLocal(sign(testBankKey, nonce, 1)); err != nil {
+			t.Fatalf("failed to add local transaction: %v", err)
+		}
+		if errs := b.txPool.AddRemotesSync([]*types.Transaction{sign(testRemoteKey, nonce, 10)}); errs[0] != nil {
+			t.Fatalf("failed to add remote transaction: %v", errs[0])
+		}
+	}
+	taskCh := make(chan *task, 1)
+	w.newTaskHook = func(task *task) {
+		if task.block.NumberU64() == 1 && len(task.receipts) == len(want) {
+			select {
+			case taskCh <- task:
+			default:
+			}
+		}
+	}
+	w.skipSealHook = func(task *task) bool { return true }
+	w.start()
+
+	select {
+	case task := <-taskCh:
+		for i, tx := range task.block.Transactions() {
+			if tx.Hash() != want[i].Hash() {
+				t.Errorf("transaction %d mismatch: have %x, want %x", i, tx.Hash(), want[i].Hash())
+			}
+		}
+	case <-time.NewTimer(3 * time.Second).C:
+		t.Fatal("new task timeout")
+	}
+}
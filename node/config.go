@@ -70,6 +70,25 @@ type Config struct {
 	// in memory.
 	DataDir string
 
+	// DBEngine is the key-value engine backing the node's databases, either
+	// "leveldb" or "pebble". If empty, the engine of a pre-existing database is
+	// reused and new databases default to leveldb.
+	DBEngine string `toml:",omitempty"`
+
+	// StateDBDir is the file system folder for dedicated key-value stores holding
+	// the state key space (trie nodes, snapshot, contract code) of the databases
+	// opened with a freezer, each residing in a subfolder named after its database.
+	// If empty, state data is kept togVBGer with the chain data.
+	StateDBDir string `toml:",omitempty"`
+
+	// StateDBEngine is the key-value engine backing the dedicated state stores. If
+	// empty, the engine of a pre-existing store or else DBEngine is used.
+	StateDBEngine string `toml:",omitempty"`
+
+	// StateDBCache is the percentage of a database's cache allowance assigned to
+	// its dedicated state store, the rest remaining with the chain data.
+	StateDBCache int `toml:",omitempty"`
+
 	// Configuration of peer-to-peer networking.
 	P2P p2p.Config
 
@@ -531,8 +531,9 @@ func (n *Node) EventMux() *event.TypeMux {
 
 // OpenDatabase opens an existing database with the given name (or creates one if no
 // previous can be found) from within the node's instance directory. If the node is
-// ephemeral, a memory database is returned.
-func (n *Node) OpenDatabase(name string, cache, handles int, namespace string) (VBGdb.Database, error) {
+// ephemeral, a memory database is returned. A read-only database is never created,
+// repaired or migrated.
+func (n *Node) OpenDatabase(name string, cache, handles int, namespace string, readonly bool) (VBGdb.Database, error) {
 	n.lock.Lock()
 	defer n.lock.Unlock()
 	if n.state == closedState {
@@ -544,7 +545,7 @@ func (n *Node) OpenDatabase(name string, cache, handles int, namespace string) (
 	if n.config.DataDir == "" {
 		db = rawdb.NewMemoryDatabase()
 	} else {
-		db, err = rawdb.NewLevelDBDatabase(n.ResolvePath(name), cache, handles, namespace)
+		db, err = rawdb.NewDiskDatabase(n.config.DBEngine, n.ResolvePath(name), cache, handles, namespace, readonly)
 	}
 
 	if err == nil {
@@ -557,8 +558,9 @@ func (n *Node) OpenDatabase(name string, cache, handles int, namespace string) (
 // creates one if no previous can be found) from within the node's data directory,
 // also attaching a chain freezer to it that moves ancient chain data from the
 // database to immutable append-only files. If the node is an ephemeral one, a
-// memory database is returned.
-func (n *Node) OpenDatabaseWithFreezer(name string, cache, handles int, freezer, namespace string) (VBGdb.Database, error) {
+// memory database is returned. A read-only database is never created, repaired
+// or migrated, and its freezer doesn't move data out of the key-value store.
+func (n *Node) OpenDatabaseWithFreezer(name string, cache, handles int, freezer, namespace string, readonly bool) (VBGdb.Database, error) {
 	n.lock.Lock()
 	defer n.lock.Unlock()
 	if n.state == closedState {
@@ -577,7 +579,16 @@ func (n *Node) OpenDatabaseWithFreezer(name string, cache, handles int, freezer,
 		case !filepath.IsAbs(freezer):
 			freezer = n.ResolvePath(freezer)
 		}
-		db, err = rawdb.NewLevelDBDatabaseWithFreezer(root, cache, handles, freezer, namespace)
+		if n.config.StateDBDir == "" {
+			db, err = rawdb.NewDiskDatabaseWithFreezer(n.config.DBEngine, root, cache, handles, freezer, namespace, readonly)
+			if err == nil {
+				if err = rawdb.CheckStateStore(db); err != nil {
+					db.Close()
+				}
+			}
+		} else {
+			db, err = n.openStateSplitDatabase(name, root, cache, handles, freezer, namespace, readonly)
+		}
 	}
 
 	if err == nil {
@@ -586,6 +597,41 @@ func (n *Node) OpenDatabaseWithFreezer(name string, cache, handles int, freezer,
 	return db, err
 }
 
+// openStateSplitDatabase opens a chain database with an attached freezer, along
+// with a dedicated key-value store for its state key space, splitting the cache
+// allowance and file handles between the two.
+func (n *Node) openStateSplitDatabase(name string, root string, cache, handles int, freezer, namespace string, readonly bool) (VBGdb.Database, error) {
+	dir := n.config.StateDBDir
+	if !filepath.IsAbs(dir) {
+		dir = n.ResolvePath(dir)
+	}
+	share := n.config.StateDBCache
+	if share <= 0 || share >= 100 {
+		share = 50
+	}
+	engine := n.config.StateDBEngine
+	if engine == "" && rawdb.PreexistingDatabase(filepath.Join(dir, name)) == "" {
+		engine = n.config.DBEngine
+	}
+	stateCache := cache * share / 100
+	state, err := rawdb.NewKeyValueDatabase(engine, filepath.Join(dir, name), stateCache, handles/2, namespace+"state/", readonly)
+	if err != nil {
+		return nil, err
+	}
+	chain, err := rawdb.NewDiskDatabaseWithFreezer(n.config.DBEngine, root, cache-stateCache, handles-handles/2, freezer, namespace, readonly)
+	if err != nil {
+		state.Close()
+		return nil, err
+	}
+	db, err := rawdb.NewDatabaseWithStateStore(chain, state, readonly)
+	if err != nil {
+		state.Close()
+		chain.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
 // ResolvePath returns the absolute path of a resource in the instance directory.
 func (n *Node) ResolvePath(x string) string {
 	return n.config.ResolvePath(x)
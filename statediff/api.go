@@ -0,0 +1,96 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package statediff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/rpc"
+)
+
+// PublicStateDiffAPI provides access to the state diffs of the local chain.
+type PublicStateDiffAPI struct {
+	service *Service
+}
+
+// NewPublicStateDiffAPI creates a new API definition for the state diff service.
+func NewPublicStateDiffAPI(service *Service) *PublicStateDiffAPI {
+	return &PublicStateDiffAPI{service: service}
+}
+
+// Stream creates a subscription that is fired with the state diff of every new
+// canonical block inserted into the local chain.
+func (api *PublicStateDiffAPI) Stream(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		diffs := make(chan *StateDiff, diffChanSize)
+		sub := api.service.SubscribeStateDiffs(diffs)
+		defer sub.Unsubscribe()
+
+		for {
+			select {
+			case diff := <-diffs:
+				notifier.Notify(rpcSub.ID, diff)
+			case <-sub.Err():
+				return
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+	return rpcSub, nil
+}
+
+// StateDiffAt returns the state diff introduced by the requested block. Merkle
+// proofs are attached if requested, defaulting to the service configuration.
+func (api *PublicStateDiffAPI) StateDiffAt(ctx context.Context, number rpc.BlockNumber, proofs *bool) (*StateDiff, error) {
+	chain := api.service.chain
+
+	var block *types.Block
+	switch number {
+	case rpc.PendingBlockNumber:
+		return nil, errors.New("state diff of the pending block is not supported")
+	case rpc.LatestBlockNumber:
+		block = chain.CurrentBlock()
+	case rpc.FinalizedBlockNumber:
+		header := chain.CurrentFinalizedHeader()
+		if header == nil {
+			return nil, errors.New("no block finalized yet")
+		}
+		block = chain.GetBlock(header.Hash(), header.Number.Uint64())
+	default:
+		block = chain.GetBlockByNumber(uint64(number))
+	}
+	if block == nil {
+		return nil, fmt.Errorf("block #%d not found", number)
+	}
+	withProofs := api.service.config.Proofs
+	if proofs != nil {
+		withProofs = *proofs
+	}
+	return api.service.stateDiff(block, withProofs)
+}
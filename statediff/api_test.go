@@ -0,0 +1,68 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package statediff
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vbgloble/go-VGB/consensus/VBGash"
+	"github.com/vbgloble/go-VGB/core"
+	"github.com/vbgloble/go-VGB/core/rawdb"
+	"github.com/vbgloble/go-VGB/core/vm"
+	"github.com/vbgloble/go-VGB/params"
+	"github.com/vbgloble/go-VGB/rpc"
+)
+
+// Tests that the state diff of the finalized block can be requested, failing
+// with a clear error until a block is finalized.
+func TestStateDiffAtFinalized(t *testing.T) {
+	var (
+		db      = rawdb.NewMemoryDatabase()
+		genesis = new(core.Genesis).MustCommit(db)
+		engine  = VBGash.NewFaker()
+	)
+	blocks, _ := core.GenerateChain(params.TestChainConfig, genesis, engine, db, 4, func(i int, gen *core.BlockGen) {
+		gen.SetCoinbase([20]byte{byte(i)})
+	})
+	cacheConfig := &core.CacheConfig{TrieCleanLimit: 256, TrieDirtyLimit: 256, TrieDirtyDisabled: true, TrieTimeLimit: 5 * time.Minute}
+	chain, err := core.NewBlockChain(db, cacheConfig, params.TestChainConfig, engine, vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create chain: %v", err)
+	}
+	defer chain.Stop()
+
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+	api := NewPublicStateDiffAPI(&Service{chain: chain, builder: NewBuilder(chain.StateCache(), nil)})
+
+	if _, err := api.StateDiffAt(context.Background(), rpc.FinalizedBlockNumber, nil); err == nil || err.Error() != "no block finalized yet" {
+		t.Fatalf("error mismatch: have %v, want %q", err, "no block finalized yet")
+	}
+	if err := chain.SetFinalized(blocks[1].Header()); err != nil {
+		t.Fatalf("failed to finalize block: %v", err)
+	}
+	diff, err := api.StateDiffAt(context.Background(), rpc.FinalizedBlockNumber, nil)
+	if err != nil {
+		t.Fatalf("failed to retrieve state diff: %v", err)
+	}
+	if diff.BlockHash != blocks[1].Hash() {
+		t.Errorf("block hash mismatch: have %x, want %x", diff.BlockHash, blocks[1].Hash())
+	}
+}
@@ -0,0 +1,327 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package statediff implements a service streaming the state changes introduced
+// by every block imported into the local chain.
+package statediff
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/common/hexutil"
+	"github.com/vbgloble/go-VGB/core/state"
+	"github.com/vbgloble/go-VGB/core/state/snapshot"
+	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/crypto"
+	"github.com/vbgloble/go-VGB/log"
+	"github.com/vbgloble/go-VGB/rlp"
+	"github.com/vbgloble/go-VGB/trie"
+)
+
+// emptyCodeHash is the known hash of the empty EVM bytecode.
+var emptyCodeHash = crypto.Keccak256Hash(nil)
+
+// StateDiff is the set of accounts, storage slots and contract code changed by
+// a single block.
+type StateDiff struct {
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	BlockHash   common.Hash    `json:"blockHash"`
+	ParentRoot  common.Hash    `json:"parentRoot"`
+	Root        common.Hash    `json:"root"`
+	Accounts    []*AccountDiff `json:"accounts"`
+}
+
+// AccountDiff is the new state of an account changed by a block. The key is the
+// hash of the account address, as used by the state trie. Deleted accounts are
+// reported without storage, their whole storage being implicitly wiped.
+type AccountDiff struct {
+	Key      common.Hash     `json:"key"`
+	Deleted  bool            `json:"deleted,omitempty"`
+	Nonce    hexutil.Uint64  `json:"nonce"`
+	Balance  *hexutil.Big    `json:"balance"`
+	Root     common.Hash     `json:"storageRoot"`
+	CodeHash common.Hash     `json:"codeHash"`
+	Code     hexutil.Bytes   `json:"code,omitempty"`
+	Storage  []*StorageDiff  `json:"storage"`
+	Proof    []hexutil.Bytes `json:"proof,omitempty"`
+}
+
+// StorageDiff is the new value of a storage slot changed by a block. The key is
+// the hash of the slot, as used by the storage trie. Deleted slots have an empty
+// value.
+type StorageDiff struct {
+	Key   common.Hash     `json:"key"`
+	Value hexutil.Bytes   `json:"value"`
+	Proof []hexutil.Bytes `json:"proof,omitempty"`
+}
+
+// proofList implements VBGdb.KeyValueWriter and collects the proofs as a list
+// of trie nodes.
+type proofList []hexutil.Bytes
+
+func (n *proofList) Put(key []byte, value []byte) error {
+	*n = append(*n, common.CopyBytes(value))
+	return nil
+}
+
+func (n *proofList) Delete(key []byte) error {
+	panic("not supported")
+}
+
+// Builder derives the state diffs between consecutive state roots, either from
+// the in-memory snapshot diff layers or by diffing the state tries.
+type Builder struct {
+	db    state.Database // State database to load the tries and code from
+	snaps *snapshot.Tree // Snapshot tree to take diff layers from, nil if disabled
+}
+
+// NewBuilder creates a state diff builder on top of the given state database
+// and optional snapshot tree.
+func NewBuilder(db state.Database, snaps *snapshot.Tree) *Builder {
+	return &Builder{db: db, snaps: snaps}
+}
+
+// Build derives the state diff introduced by the given block on top of the state
+// identified by parentRoot, optionally attaching Merkle proofs of all the changed
+// accounts and storage slots against the new state.
+func (b *Builder) Build(block *types.Block, parentRoot common.Hash, proofs bool) (*StateDiff, error) {
+	diff := &StateDiff{
+		BlockNumber: hexutil.Uint64(block.NumberU64()),
+		BlockHash:   block.Hash(),
+		ParentRoot:  parentRoot,
+		Root:        block.Root(),
+		Accounts:    []*AccountDiff{},
+	}
+	if parentRoot == block.Root() {
+		return diff, nil
+	}
+	oldTrie, err := trie.New(parentRoot, b.db.TrieDB())
+	if err != nil {
+		return nil, err
+	}
+	newTrie, err := trie.New(block.Root(), b.db.TrieDB())
+	if err != nil {
+		return nil, err
+	}
+	// Gather the changed accounts, preferring the snapshot diff layer if it's
+	// still around and falling back to diffing the account tries
+	var layer snapshot.DiffLayer
+	if b.snaps != nil {
+		layer = b.snaps.Diff(block.Root())
+		if layer != nil && layer.ParentRoot() != parentRoot {
+			layer = nil
+		}
+	}
+	var accounts map[common.Hash][]byte
+	if layer != nil {
+		if accounts, err = layerAccounts(layer); err != nil {
+			log.Debug("Failed to read snapshot diff layer", "root", block.Root(), "err", err)
+			layer, accounts = nil, nil
+		}
+	}
+	if accounts == nil {
+		if accounts, err = diffTries(oldTrie, newTrie); err != nil {
+			return nil, err
+		}
+	}
+	keys := make([]common.Hash, 0, len(accounts))
+	for key := range accounts {
+		keys = append(keys, key)
+	}
+	sortHashes(keys)
+
+	for _, key := range keys {
+		account, err := b.accountDiff(key, accounts[key], oldTrie, layer, proofs)
+		if err != nil {
+			return nil, err
+		}
+		if account == nil {
+			continue
+		}
+		if proofs {
+			var proof proofList
+			if err := newTrie.Prove(key[:], 0, &proof); err != nil {
+				return nil, err
+			}
+			account.Proof = proof
+		}
+		diff.Accounts = append(diff.Accounts, account)
+	}
+	return diff, nil
+}
+
+// accountDiff assembles the diff of a single account, given its full consensus
+// encoding in the new state (nil if deleted). Nil is returned if the account was
+// merely touched, without any of its fields or storage changing.
+func (b *Builder) accountDiff(key common.Hash, blob []byte, oldTrie *trie.Trie, layer snapshot.DiffLayer, proofs bool) (*AccountDiff, error) {
+	diff := &AccountDiff{Key: key, Storage: []*StorageDiff{}}
+	if blob == nil {
+		diff.Deleted = true
+		return diff, nil
+	}
+	var account state.Account
+	if err := rlp.DecodeBytes(blob, &account); err != nil {
+		return nil, err
+	}
+	diff.Nonce = hexutil.Uint64(account.Nonce)
+	diff.Balance = (*hexutil.Big)(account.Balance)
+	diff.Root = account.Root
+	diff.CodeHash = common.BytesToHash(account.CodeHash)
+
+	// Retrieve the previous version of the account to diff the storage and code
+	var (
+		oldRoot     = types.EmptyRootHash
+		oldCodeHash = emptyCodeHash
+	)
+	prev, err := oldTrie.TryGet(key[:])
+	if err != nil {
+		return nil, err
+	}
+	if prev != nil {
+		var old state.Account
+		if err := rlp.DecodeBytes(prev, &old); err != nil {
+			return nil, err
+		}
+		oldRoot, oldCodeHash = old.Root, common.BytesToHash(old.CodeHash)
+	}
+	if diff.CodeHash != oldCodeHash && diff.CodeHash != emptyCodeHash {
+		code, err := b.db.ContractCode(key, diff.CodeHash)
+		if err != nil {
+			return nil, err
+		}
+		diff.Code = code
+	}
+	// Gather the changed storage slots, preferring the snapshot diff layer unless
+	// the account was destructed, in which case the wiped slots are unknown to it
+	var slots map[common.Hash][]byte
+	if layer != nil {
+		if list, destructed := layer.StorageList(key); !destructed {
+			slots = make(map[common.Hash][]byte, len(list))
+			for _, slot := range list {
+				value, err := layer.Storage(key, slot)
+				if err != nil {
+					return nil, err
+				}
+				slots[slot] = value
+			}
+		}
+	}
+	if slots == nil && oldRoot != account.Root {
+		oldStorage, err := trie.New(oldRoot, b.db.TrieDB())
+		if err != nil {
+			return nil, err
+		}
+		newStorage, err := trie.New(account.Root, b.db.TrieDB())
+		if err != nil {
+			return nil, err
+		}
+		if slots, err = diffTries(oldStorage, newStorage); err != nil {
+			return nil, err
+		}
+	}
+	if bytes.Equal(prev, blob) && len(slots) == 0 {
+		return nil, nil
+	}
+	var storage *trie.Trie
+	if proofs && len(slots) > 0 {
+		if storage, err = trie.New(account.Root, b.db.TrieDB()); err != nil {
+			return nil, err
+		}
+	}
+	keys := make([]common.Hash, 0, len(slots))
+	for slot := range slots {
+		keys = append(keys, slot)
+	}
+	sortHashes(keys)
+
+	for _, slot := range keys {
+		entry := &StorageDiff{Key: slot, Value: hexutil.Bytes{}}
+		if blob := slots[slot]; len(blob) > 0 {
+			_, content, _, err := rlp.Split(blob)
+			if err != nil {
+				return nil, err
+			}
+			entry.Value = common.CopyBytes(content)
+		}
+		if storage != nil {
+			var proof proofList
+			if err := storage.Prove(slot[:], 0, &proof); err != nil {
+				return nil, err
+			}
+			entry.Proof = proof
+		}
+		diff.Storage = append(diff.Storage, entry)
+	}
+	return diff, nil
+}
+
+// layerAccounts returns the full consensus encoding of all the accounts changed
+// by a snapshot diff layer, nil for the deleted ones.
+func layerAccounts(layer snapshot.DiffLayer) (map[common.Hash][]byte, error) {
+	accounts := make(map[common.Hash][]byte)
+	for _, hash := range layer.AccountList() {
+		blob, err := layer.AccountRLP(hash)
+		if err != nil {
+			return nil, err
+		}
+		if len(blob) == 0 {
+			accounts[hash] = nil
+			continue
+		}
+		if accounts[hash], err = snapshot.FullAccountRLP(blob); err != nil {
+			return nil, err
+		}
+	}
+	return accounts, nil
+}
+
+// diffTries returns all the leaves which differ between two tries, mapped to
+// their value in the new trie (nil if deleted).
+func diffTries(oldTrie, newTrie *trie.Trie) (map[common.Hash][]byte, error) {
+	leaves := make(map[common.Hash][]byte)
+
+	// Collect the leaves created or modified in the new trie
+	diff, _ := trie.NewDifferenceIterator(oldTrie.NodeIterator(nil), newTrie.NodeIterator(nil))
+	it := trie.NewIterator(diff)
+	for it.Next() {
+		leaves[common.BytesToHash(it.Key)] = common.CopyBytes(it.Value)
+	}
+	if it.Err != nil {
+		return nil, it.Err
+	}
+	// Collect the leaves of the old trie that are missing from the new one
+	diff, _ = trie.NewDifferenceIterator(newTrie.NodeIterator(nil), oldTrie.NodeIterator(nil))
+	it = trie.NewIterator(diff)
+	for it.Next() {
+		key := common.BytesToHash(it.Key)
+		if _, ok := leaves[key]; !ok {
+			leaves[key] = nil
+		}
+	}
+	if it.Err != nil {
+		return nil, it.Err
+	}
+	return leaves, nil
+}
+
+// sortHashes sorts a list of hashes in ascending order.
+func sortHashes(hashes []common.Hash) {
+	sort.Slice(hashes, func(i, j int) bool {
+		return bytes.Compare(hashes[i][:], hashes[j][:]) < 0
+	})
+}
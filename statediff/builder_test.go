@@ -0,0 +1,125 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package statediff
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/core/rawdb"
+	"github.com/vbgloble/go-VGB/core/state"
+	"github.com/vbgloble/go-VGB/core/state/snapshot"
+	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/crypto"
+)
+
+// Tests that state diffs derived from snapshot diff layers and from diffing the
+// state tries are identical and contain all the modifications of a block.
+func TestBuildStateDiff(t *testing.T) {
+	var (
+		diskdb = rawdb.NewMemoryDatabase()
+		db     = state.NewDatabase(diskdb)
+
+		rich     = common.HexToAddress("0x01")
+		contract = common.HexToAddress("0x02")
+		doomed   = common.HexToAddress("0x03")
+		created  = common.HexToAddress("0x04")
+	)
+	// Create the parent state and persist it, so the snapshot can be generated
+	statedb, _ := state.New(types.EmptyRootHash, db, nil)
+	statedb.SetBalance(rich, big.NewInt(1000))
+	statedb.SetCode(contract, []byte{0x60, 0x00})
+	statedb.SetState(contract, common.HexToHash("0x01"), common.HexToHash("0x11"))
+	statedb.SetState(contract, common.HexToHash("0x02"), common.HexToHash("0x22"))
+	statedb.SetBalance(doomed, big.NewInt(1))
+
+	parentRoot, err := statedb.Commit(true)
+	if err != nil {
+		t.Fatalf("failed to commit parent state: %v", err)
+	}
+	if err := db.TrieDB().Commit(parentRoot, false, nil); err != nil {
+		t.Fatalf("failed to persist parent state: %v", err)
+	}
+	snaps, _ := snapshot.New(diskdb, db.TrieDB(), 16, parentRoot, false, true, false)
+
+	// Modify the state on top, tracking the changes in a snapshot diff layer
+	statedb, _ = state.New(parentRoot, db, snaps)
+	statedb.SetBalance(rich, big.NewInt(900))
+	statedb.SetState(contract, common.HexToHash("0x01"), common.HexToHash("0x33"))
+	statedb.SetState(contract, common.HexToHash("0x02"), common.Hash{})
+	statedb.Suicide(doomed)
+	statedb.SetCode(created, []byte{0x60, 0x01})
+
+	root, err := statedb.Commit(true)
+	if err != nil {
+		t.Fatalf("failed to commit child state: %v", err)
+	}
+	if snaps.Diff(root) == nil {
+		t.Fatalf("snapshot diff layer missing")
+	}
+	block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(1), Root: root})
+
+	fromTries, err := NewBuilder(db, nil).Build(block, parentRoot, true)
+	if err != nil {
+		t.Fatalf("failed to build state diff from tries: %v", err)
+	}
+	fromSnaps, err := NewBuilder(db, snaps).Build(block, parentRoot, true)
+	if err != nil {
+		t.Fatalf("failed to build state diff from snapshot: %v", err)
+	}
+	have, _ := json.Marshal(fromSnaps)
+	want, _ := json.Marshal(fromTries)
+	if string(have) != string(want) {
+		t.Fatalf("state diff mismatch:\nsnapshot: %s\ntries:    %s", have, want)
+	}
+	// Verify the contents of the state diff
+	accounts := make(map[common.Hash]*AccountDiff)
+	for _, account := range fromTries.Accounts {
+		if len(account.Proof) == 0 {
+			t.Errorf("account %x: missing proof", account.Key)
+		}
+		accounts[account.Key] = account
+	}
+	if len(accounts) != 4 {
+		t.Fatalf("account count mismatch: have %d, want 4", len(accounts))
+	}
+	if account := accounts[crypto.Keccak256Hash(rich[:])]; account == nil || account.Balance.ToInt().Int64() != 900 {
+		t.Errorf("rich account mismatch: %+v", account)
+	}
+	if account := accounts[crypto.Keccak256Hash(doomed[:])]; account == nil || !account.Deleted {
+		t.Errorf("doomed account mismatch: %+v", account)
+	}
+	if account := accounts[crypto.Keccak256Hash(created[:])]; account == nil || string(account.Code) != string([]byte{0x60, 0x01}) {
+		t.Errorf("created account mismatch: %+v", account)
+	}
+	account := accounts[crypto.Keccak256Hash(contract[:])]
+	if account == nil || len(account.Code) != 0 {
+		t.Fatalf("contract account mismatch: %+v", account)
+	}
+	slots := make(map[common.Hash][]byte)
+	for _, slot := range account.Storage {
+		slots[slot.Key] = slot.Value
+	}
+	if value := slots[crypto.Keccak256Hash(common.HexToHash("0x01").Bytes())]; string(value) != string([]byte{0x33}) {
+		t.Errorf("updated slot mismatch: have %x, want 33", value)
+	}
+	if value, ok := slots[crypto.Keccak256Hash(common.HexToHash("0x02").Bytes())]; !ok || len(value) != 0 {
+		t.Errorf("deleted slot mismatch: have %x, present %v", value, ok)
+	}
+}
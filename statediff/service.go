@@ -0,0 +1,185 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package statediff
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+
+	"github.com/vbgloble/go-VGB/core"
+	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/event"
+	"github.com/vbgloble/go-VGB/log"
+	"github.com/vbgloble/go-VGB/node"
+	"github.com/vbgloble/go-VGB/rpc"
+)
+
+const (
+	// chainEventChanSize is the size of channel listening to ChainEvent.
+	chainEventChanSize = 128
+
+	// diffChanSize is the size of the channel delivering state diffs to a single
+	// RPC subscription.
+	diffChanSize = 128
+)
+
+// errMissingParent is returned if the parent of a block to diff is unavailable.
+var errMissingParent = errors.New("parent block not found")
+
+// Config contains the configuration options of the state diff service.
+type Config struct {
+	File   string // Path of the file to append the state diffs to (empty = no file sink)
+	Proofs bool   // WhVBGer to attach Merkle proofs to the streamed state diffs
+}
+
+// Backend encompasses the functionality needed by the state diff service.
+type Backend interface {
+	BlockChain() *core.BlockChain
+}
+
+// Service derives the state diff of every canonical block inserted into the
+// local chain and streams it to RPC subscribers and an optional file sink.
+type Service struct {
+	config  Config
+	chain   *core.BlockChain
+	builder *Builder
+
+	feed  event.Feed
+	scope event.SubscriptionScope
+	file  *os.File // File sink the state diffs are appended to, nil if disabled
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New creates a state diff service and registers it, along with its APIs, with
+// the given node.
+func New(stack *node.Node, backend Backend, config Config) (*Service, error) {
+	chain := backend.BlockChain()
+	if chain == nil {
+		return nil, errors.New("state diffs require a full node")
+	}
+	service := &Service{
+		config:  config,
+		chain:   chain,
+		builder: NewBuilder(chain.StateCache(), chain.Snapshot()),
+		quit:    make(chan struct{}),
+	}
+	stack.RegisterAPIs([]rpc.API{{
+		Namespace: "statediff",
+		Version:   "1.0",
+		Service:   NewPublicStateDiffAPI(service),
+		Public:    true,
+	}})
+	stack.RegisterLifecycle(service)
+	return service, nil
+}
+
+// Start implements node.Lifecycle, opening the file sink and starting to follow
+// the chain.
+func (s *Service) Start() error {
+	if s.config.File != "" {
+		file, err := os.OpenFile(s.config.File, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		if err != nil {
+			return err
+		}
+		s.file = file
+	}
+	s.wg.Add(1)
+	go s.loop()
+
+	log.Info("State diff service started", "file", s.config.File, "proofs", s.config.Proofs)
+	return nil
+}
+
+// Stop implements node.Lifecycle, terminating the chain follower, all the active
+// subscriptions and closing the file sink.
+func (s *Service) Stop() error {
+	close(s.quit)
+	s.wg.Wait()
+	s.scope.Close()
+
+	if s.file != nil {
+		if err := s.file.Close(); err != nil {
+			return err
+		}
+	}
+	log.Info("State diff service stopped")
+	return nil
+}
+
+// loop derives the state diffs of the newly inserted canonical blocks and feeds
+// them to the subscribers and the file sink.
+func (s *Service) loop() {
+	defer s.wg.Done()
+
+	chainCh := make(chan core.ChainEvent, chainEventChanSize)
+	chainSub := s.chain.SubscribeChainEvent(chainCh)
+	defer chainSub.Unsubscribe()
+
+	var encoder *json.Encoder
+	if s.file != nil {
+		encoder = json.NewEncoder(s.file)
+	}
+	for {
+		select {
+		case ev := <-chainCh:
+			// Skip the diff derivation if nobody is interested in it
+			if encoder == nil && s.scope.Count() == 0 {
+				continue
+			}
+			diff, err := s.stateDiff(ev.Block, s.config.Proofs)
+			if err != nil {
+				log.Warn("Failed to derive state diff", "number", ev.Block.Number(), "hash", ev.Block.Hash(), "err", err)
+				continue
+			}
+			if encoder != nil {
+				if err := encoder.Encode(diff); err != nil {
+					log.Error("Failed to write state diff", "number", ev.Block.Number(), "hash", ev.Block.Hash(), "err", err)
+				}
+			}
+			s.feed.Send(diff)
+
+		case <-chainSub.Err():
+			return
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+// stateDiff derives the state diff introduced by the given block on top of its
+// parent. The genesis block is diffed against the empty state.
+func (s *Service) stateDiff(block *types.Block, proofs bool) (*StateDiff, error) {
+	parentRoot := types.EmptyRootHash
+	if block.NumberU64() > 0 {
+		parent := s.chain.GVBGeader(block.ParentHash(), block.NumberU64()-1)
+		if parent == nil {
+			return nil, errMissingParent
+		}
+		parentRoot = parent.Root
+	}
+	return s.builder.Build(block, parentRoot, proofs)
+}
+
+// SubscribeStateDiffs registers a subscription for the state diffs of the newly
+// inserted canonical blocks.
+func (s *Service) SubscribeStateDiffs(ch chan<- *StateDiff) event.Subscription {
+	return s.scope.Track(s.feed.Subscribe(ch))
+}
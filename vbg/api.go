@@ -33,7 +33,9 @@ import (
 	"github.com/vbgloble/go-VGB/core"
 	"github.com/vbgloble/go-VGB/core/rawdb"
 	"github.com/vbgloble/go-VGB/core/state"
+	"github.com/vbgloble/go-VGB/core/stateless"
 	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/core/vm"
 	"github.com/vbgloble/go-VGB/internal/VBGapi"
 	"github.com/vbgloble/go-VGB/rlp"
 	"github.com/vbgloble/go-VGB/rpc"
@@ -264,6 +266,59 @@ func (api *PrivateAdminAPI) ImportChain(file string) (bool, error) {
 	return true, nil
 }
 
+// SetFinalized marks the given canonical block final, rejecting any later reorg
+// which would revert it. The finalized block may be moved backwards too.
+func (api *PrivateAdminAPI) SetFinalized(number rpc.BlockNumber) (bool, error) {
+	var header *types.Header
+	switch number {
+	case rpc.PendingBlockNumber:
+		return false, errors.New("pending block can't be finalized")
+	case rpc.LatestBlockNumber:
+		header = api.VBG.blockchain.CurrentBlock().Header()
+	case rpc.FinalizedBlockNumber:
+		header = api.VBG.blockchain.CurrentFinalizedHeader()
+	default:
+		header = api.VBG.blockchain.GVBGeaderByNumber(uint64(number))
+	}
+	if header == nil {
+		return false, fmt.Errorf("block #%d not found", number)
+	}
+	if err := api.VBG.blockchain.SetFinalized(header); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// SubmitFinalityCheckpoint finalizes the block of a checkpoint signed by enough
+// of the configured finality signers. Checkpoints not beyond the current
+// finalized block are ignored.
+func (api *PrivateAdminAPI) SubmitFinalityCheckpoint(checkpoint FinalityCheckpoint) (bool, error) {
+	if err := checkpoint.verify(api.VBG.config.FinalitySigners, api.VBG.config.FinalityThreshold); err != nil {
+		return false, err
+	}
+	number := uint64(checkpoint.Number)
+	if finalized := api.VBG.blockchain.CurrentFinalizedHeader(); finalized != nil && finalized.Number.Uint64() >= number {
+		return false, nil
+	}
+	header := api.VBG.blockchain.GVBGeaderByNumber(number)
+	if header == nil || header.Hash() != checkpoint.Hash {
+		return false, fmt.Errorf("checkpoint block #%d [%x…] not in the canonical chain", number, checkpoint.Hash.Bytes()[:4])
+	}
+	if err := api.VBG.blockchain.SetFinalized(header); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ReloadTxPolicy reloads the transaction pool admission policy from its file,
+// applying it to all transactions added afterwards.
+func (api *PrivateAdminAPI) ReloadTxPolicy() (bool, error) {
+	if err := api.VBG.txPool.ReloadPolicy(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // PublicDebugAPI is the collection of vbgloble full node APIs exposed
 // over the public debugging endpoint.
 type PublicDebugAPI struct {
@@ -286,9 +341,12 @@ func (api *PublicDebugAPI) DumpBlock(blockNr rpc.BlockNumber) (state.Dump, error
 		return stateDb.RawDump(false, false, true), nil
 	}
 	var block *types.Block
-	if blockNr == rpc.LatestBlockNumber {
+	switch blockNr {
+	case rpc.LatestBlockNumber:
 		block = api.VBG.blockchain.CurrentBlock()
-	} else {
+	case rpc.FinalizedBlockNumber:
+		block = api.VBG.finalizedBlock()
+	default:
 		block = api.VBG.blockchain.GetBlockByNumber(uint64(blockNr))
 	}
 	if block == nil {
@@ -367,9 +425,12 @@ func (api *PublicDebugAPI) AccountRange(blockNrOrHash rpc.BlockNumberOrHash, sta
 			_, stateDb = api.VBG.miner.Pending()
 		} else {
 			var block *types.Block
-			if number == rpc.LatestBlockNumber {
+			switch number {
+			case rpc.LatestBlockNumber:
 				block = api.VBG.blockchain.CurrentBlock()
-			} else {
+			case rpc.FinalizedBlockNumber:
+				block = api.VBG.finalizedBlock()
+			default:
 				block = api.VBG.blockchain.GetBlockByNumber(uint64(number))
 			}
 			if block == nil {
@@ -399,6 +460,56 @@ func (api *PublicDebugAPI) AccountRange(blockNrOrHash rpc.BlockNumberOrHash, sta
 	return stateDb.IteratorDump(nocode, nostorage, incompletes, start, maxResults), nil
 }
 
+// ExecutionWitness re-executes the given block on top of its parent state and
+// returns the execution witness: every trie node, contract code and ancestor
+// header needed to verify the block statelessly.
+func (api *PrivateDebugAPI) ExecutionWitness(blockNr rpc.BlockNumber) (*stateless.ExtWitness, error) {
+	var block *types.Block
+	switch blockNr {
+	case rpc.LatestBlockNumber, rpc.PendingBlockNumber:
+		block = api.VBG.blockchain.CurrentBlock()
+	case rpc.FinalizedBlockNumber:
+		block = api.VBG.finalizedBlock()
+	default:
+		block = api.VBG.blockchain.GetBlockByNumber(uint64(blockNr))
+	}
+	if block == nil {
+		return nil, fmt.Errorf("block #%d not found", blockNr)
+	}
+	witness, err := api.VBG.blockchain.RecordWitness(block)
+	if err != nil {
+		return nil, err
+	}
+	return witness.ToExtWitness(), nil
+}
+
+// StatelessResult is the result of a debug_executeStateless API call.
+type StatelessResult struct {
+	StateRoot   common.Hash `json:"stateRoot"`
+	ReceiptRoot common.Hash `json:"receiptRoot"`
+	Valid       bool        `json:"valid"`
+}
+
+// ExecuteStateless executes an RLP encoded block solely against the state in
+// the given witness, reporting the derived roots and whVBGer they match the
+// ones committed to in the block header.
+func (api *PrivateDebugAPI) ExecuteStateless(blob hexutil.Bytes, ext stateless.ExtWitness) (*StatelessResult, error) {
+	block := new(types.Block)
+	if err := rlp.DecodeBytes(blob, block); err != nil {
+		return nil, fmt.Errorf("could not decode block: %v", err)
+	}
+	config := api.VBG.blockchain.Config()
+	root, receiptRoot, err := core.ExecuteStateless(config, vm.Config{}, api.VBG.engine, block, stateless.FromExtWitness(&ext))
+	if err != nil {
+		return nil, err
+	}
+	return &StatelessResult{
+		StateRoot:   root,
+		ReceiptRoot: receiptRoot,
+		Valid:       root == block.Root() && receiptRoot == block.ReceiptHash(),
+	}, nil
+}
+
 // StorageRangeResult is the result of a debug_storageRangeAt API call.
 type StorageRangeResult struct {
 	Storage storageMap   `json:"storage"`
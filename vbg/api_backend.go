@@ -70,6 +70,13 @@ func (b *VBGAPIBackend) HeaderByNumber(ctx context.Context, number rpc.BlockNumb
 	if number == rpc.LatestBlockNumber {
 		return b.VBG.blockchain.CurrentBlock().Header(), nil
 	}
+	if number == rpc.FinalizedBlockNumber {
+		header := b.VBG.blockchain.CurrentFinalizedHeader()
+		if header == nil {
+			return nil, errors.New("finalized block not found")
+		}
+		return header, nil
+	}
 	return b.VBG.blockchain.GVBGeaderByNumber(uint64(number)), nil
 }
 
@@ -104,6 +111,13 @@ func (b *VBGAPIBackend) BlockByNumber(ctx context.Context, number rpc.BlockNumbe
 	if number == rpc.LatestBlockNumber {
 		return b.VBG.blockchain.CurrentBlock(), nil
 	}
+	if number == rpc.FinalizedBlockNumber {
+		block := b.VBG.finalizedBlock()
+		if block == nil {
+			return nil, errors.New("finalized block not found")
+		}
+		return block, nil
+	}
 	return b.VBG.blockchain.GetBlockByNumber(uint64(number)), nil
 }
 
@@ -191,12 +205,14 @@ func (b *VBGAPIBackend) GetTd(ctx context.Context, hash common.Hash) *big.Int {
 	return b.VBG.blockchain.GetTdByHash(hash)
 }
 
-func (b *VBGAPIBackend) GetEVM(ctx context.Context, msg core.Message, state *state.StateDB, header *types.Header) (*vm.EVM, func() error, error) {
+func (b *VBGAPIBackend) GetEVM(ctx context.Context, msg core.Message, state *state.StateDB, header *types.Header, vmConfig *vm.Config) (*vm.EVM, func() error, error) {
 	vmError := func() error { return nil }
-
+	if vmConfig == nil {
+		vmConfig = b.VBG.blockchain.GetVMConfig()
+	}
 	txContext := core.NewEVMTxContext(msg)
 	context := core.NewEVMBlockContext(header, b.VBG.BlockChain(), nil)
-	return vm.NewEVM(context, txContext, state, b.VBG.blockchain.Config(), *b.VBG.blockchain.GetVMConfig()), vmError, nil
+	return vm.NewEVM(context, txContext, state, b.VBG.blockchain.Config(), *vmConfig), vmError, nil
 }
 
 func (b *VBGAPIBackend) SubscribeRemovedLogsEvent(ch chan<- core.RemovedLogsEvent) event.Subscription {
@@ -224,9 +240,26 @@ func (b *VBGAPIBackend) SubscribeLogsEvent(ch chan<- []*types.Log) event.Subscri
 }
 
 func (b *VBGAPIBackend) SendTx(ctx context.Context, signedTx *types.Transaction) error {
+	if b.VBG.config.DatabaseReadOnly {
+		return errReadOnly
+	}
 	return b.VBG.txPool.AddLocal(signedTx)
 }
 
+func (b *VBGAPIBackend) SendPrivateTx(ctx context.Context, signedTx *types.Transaction, expiry uint64, release bool) error {
+	if b.VBG.config.DatabaseReadOnly {
+		return errReadOnly
+	}
+	return b.VBG.txPool.AddPrivate(signedTx, expiry, release)
+}
+
+func (b *VBGAPIBackend) SendBundle(ctx context.Context, bundle *core.Bundle) error {
+	if b.VBG.config.DatabaseReadOnly {
+		return errReadOnly
+	}
+	return b.VBG.bundlePool.Add(bundle)
+}
+
 func (b *VBGAPIBackend) GetPoolTransactions() (types.Transactions, error) {
 	pending, err := b.VBG.txPool.Pending()
 	if err != nil {
@@ -264,6 +297,10 @@ func (b *VBGAPIBackend) TxPool() *core.TxPool {
 	return b.VBG.TxPool()
 }
 
+func (b *VBGAPIBackend) BlockChain() *core.BlockChain {
+	return b.VBG.BlockChain()
+}
+
 func (b *VBGAPIBackend) SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.Subscription {
 	return b.VBG.TxPool().SubscribeNewTxsEvent(ch)
 }
@@ -280,6 +317,10 @@ func (b *VBGAPIBackend) SuggestPrice(ctx context.Context) (*big.Int, error) {
 	return b.gpo.SuggestPrice(ctx)
 }
 
+func (b *VBGAPIBackend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return b.gpo.SuggestTipCap(ctx)
+}
+
 func (b *VBGAPIBackend) ChainDb() VBGdb.Database {
 	return b.VBG.ChainDb()
 }
@@ -0,0 +1,521 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package VBG
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/common/hexutil"
+	"github.com/vbgloble/go-VGB/consensus/VBGash"
+	"github.com/vbgloble/go-VGB/core/rawdb"
+	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/rpc"
+)
+
+// parityCallTracer is the name of the tracer whose output is flattened into
+// Parity style action traces.
+const parityCallTracer = "callTracer"
+
+// ParityTrace is a single flattened action trace, as returned by the trace_
+// namespace of Parity/Openvbgloble.
+type ParityTrace struct {
+	Action              ParityTraceAction  `json:"action"`
+	BlockHash           *common.Hash       `json:"blockHash,omitempty"`
+	BlockNumber         *uint64            `json:"blockNumber,omitempty"`
+	Error               string             `json:"error,omitempty"`
+	Result              *ParityTraceResult `json:"result"`
+	Subtraces           int                `json:"subtraces"`
+	TraceAddress        []int              `json:"traceAddress"`
+	TransactionHash     *common.Hash       `json:"transactionHash"`
+	TransactionPosition *uint64            `json:"transactionPosition"`
+	Type                string             `json:"type"`
+}
+
+// ParityTraceAction is the action of a call, create, suicide or reward trace.
+// Only the fields relevant for the trace type are populated.
+type ParityTraceAction struct {
+	Author        *common.Address `json:"author,omitempty"`
+	RewardType    string          `json:"rewardType,omitempty"`
+	Address       *common.Address `json:"address,omitempty"`
+	Balance       *hexutil.Big    `json:"balance,omitempty"`
+	CallType      string          `json:"callType,omitempty"`
+	From          *common.Address `json:"from,omitempty"`
+	Gas           *hexutil.Uint64 `json:"gas,omitempty"`
+	Init          *hexutil.Bytes  `json:"init,omitempty"`
+	Input         *hexutil.Bytes  `json:"input,omitempty"`
+	RefundAddress *common.Address `json:"refundAddress,omitempty"`
+	To            *common.Address `json:"to,omitempty"`
+	Value         *hexutil.Big    `json:"value,omitempty"`
+}
+
+// ParityTraceResult is the outcome of a successful call or create trace.
+type ParityTraceResult struct {
+	Address *common.Address `json:"address,omitempty"`
+	Code    *hexutil.Bytes  `json:"code,omitempty"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Output  *hexutil.Bytes  `json:"output,omitempty"`
+}
+
+// fromTo returns the sender and recipient of the trace, as matched by the
+// address filters of trace_filter.
+func (t *ParityTrace) fromTo() (from *common.Address, to *common.Address) {
+	switch t.Type {
+	case "call":
+		return t.Action.From, t.Action.To
+	case "create":
+		if t.Result != nil {
+			return t.Action.From, t.Result.Address
+		}
+		return t.Action.From, nil
+	case "suicide":
+		return t.Action.Address, t.Action.RefundAddress
+	case "reward":
+		return nil, t.Action.Author
+	}
+	return nil, nil
+}
+
+// TraceReplayResult is the result of replaying a single transaction with
+// trace_replayBlockTransactions.
+type TraceReplayResult struct {
+	Output          hexutil.Bytes  `json:"output"`
+	StateDiff       interface{}    `json:"stateDiff"`
+	Trace           []*ParityTrace `json:"trace"`
+	VmTrace         interface{}    `json:"vmTrace"`
+	TransactionHash common.Hash    `json:"transactionHash"`
+}
+
+// TraceFilterArgs are the arguments of trace_filter.
+type TraceFilterArgs struct {
+	FromBlock   *rpc.BlockNumber `json:"fromBlock"`
+	ToBlock     *rpc.BlockNumber `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *uint64          `json:"after"`
+	Count       *uint64          `json:"count"`
+}
+
+// matches checks whVBGer the trace satisfies the address filters.
+func (args *TraceFilterArgs) matches(trace *ParityTrace) bool {
+	from, to := trace.fromTo()
+	if len(args.FromAddress) > 0 && !containsAddress(args.FromAddress, from) {
+		return false
+	}
+	if len(args.ToAddress) > 0 && !containsAddress(args.ToAddress, to) {
+		return false
+	}
+	return true
+}
+
+// containsAddress checks whVBGer addr is part of the given address list.
+func containsAddress(list []common.Address, addr *common.Address) bool {
+	if addr == nil {
+		return false
+	}
+	for _, a := range list {
+		if a == *addr {
+			return true
+		}
+	}
+	return false
+}
+
+// callFrame is a single call reported by the call tracer.
+type callFrame struct {
+	Type    string         `json:"type"`
+	From    common.Address `json:"from"`
+	To      common.Address `json:"to"`
+	Value   *hexutil.Big   `json:"value"`
+	Gas     hexutil.Uint64 `json:"gas"`
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+	Input   hexutil.Bytes  `json:"input"`
+	Output  hexutil.Bytes  `json:"output"`
+	Error   string         `json:"error"`
+	Calls   []*callFrame   `json:"calls"`
+}
+
+// parityError converts an EVM execution error into its Parity counterpart.
+func parityError(err string) string {
+	switch {
+	case err == "execution reverted":
+		return "Reverted"
+	case err == "out of gas":
+		return "Out of gas"
+	case err == "invalid jump destination":
+		return "Bad jump destination"
+	case strings.HasPrefix(err, "invalid opcode"):
+		return "Bad instruction"
+	case strings.HasPrefix(err, "stack underflow"):
+		return "Stack underflow"
+	}
+	return err
+}
+
+// flattenCallFrame appends the Parity style traces of a call and all its
+// subcalls to traces, in depth first order.
+func flattenCallFrame(frame *callFrame, address []int, traces []*ParityTrace) []*ParityTrace {
+	trace := &ParityTrace{
+		Error:        parityError(frame.Error),
+		Subtraces:    len(frame.Calls),
+		TraceAddress: append([]int{}, address...),
+	}
+	from, to := frame.From, frame.To
+	value := frame.Value
+	if value == nil {
+		value = new(hexutil.Big)
+	}
+	switch frame.Type {
+	case "CREATE", "CREATE2":
+		gas, init := frame.Gas, frame.Input
+		trace.Type = "create"
+		trace.Action = ParityTraceAction{From: &from, Gas: &gas, Init: &init, Value: value}
+		if frame.Error == "" {
+			gasUsed, code := frame.GasUsed, frame.Output
+			trace.Result = &ParityTraceResult{Address: &to, Code: &code, GasUsed: gasUsed}
+		}
+	case "SELFDESTRUCT":
+		trace.Type = "suicide"
+		trace.Action = ParityTraceAction{Address: &from, RefundAddress: &to, Balance: value}
+	default:
+		gas, input := frame.Gas, frame.Input
+		trace.Type = "call"
+		trace.Action = ParityTraceAction{CallType: strings.ToLower(frame.Type), From: &from, To: &to, Gas: &gas, Input: &input, Value: value}
+		if frame.Error == "" {
+			gasUsed, output := frame.GasUsed, frame.Output
+			trace.Result = &ParityTraceResult{GasUsed: gasUsed, Output: &output}
+		}
+	}
+	traces = append(traces, trace)
+	for i, call := range frame.Calls {
+		traces = flattenCallFrame(call, append(address, i), traces)
+	}
+	return traces
+}
+
+// parityTxTraces converts the call tracer result of a single transaction into
+// its flat Parity style traces.
+func parityTxTraces(result json.RawMessage, block *types.Block, index int) ([]*ParityTrace, error) {
+	frame := new(callFrame)
+	if err := json.Unmarshal(result, frame); err != nil {
+		return nil, err
+	}
+	var (
+		traces   = flattenCallFrame(frame, nil, nil)
+		hash     = block.Hash()
+		number   = block.NumberU64()
+		txHash   = block.Transactions()[index].Hash()
+		position = uint64(index)
+	)
+	for _, trace := range traces {
+		trace.BlockHash, trace.BlockNumber = &hash, &number
+		trace.TransactionHash, trace.TransactionPosition = &txHash, &position
+	}
+	return traces, nil
+}
+
+// PrivateTraceAPI is the collection of Parity compatible tracing APIs exposed
+// over the private trace_ endpoint.
+type PrivateTraceAPI struct {
+	VBG   *vbgloble
+	debug *PrivateDebugAPI
+}
+
+// NewPrivateTraceAPI creates a new API definition for the Parity compatible
+// tracing mVBGods of the vbgloble service.
+func NewPrivateTraceAPI(VBG *vbgloble) *PrivateTraceAPI {
+	return &PrivateTraceAPI{VBG: VBG, debug: NewPrivateDebugAPI(VBG)}
+}
+
+// blockByNumber retrieves a canonical block by number.
+func (api *PrivateTraceAPI) blockByNumber(number rpc.BlockNumber) (*types.Block, error) {
+	var block *types.Block
+
+	switch number {
+	case rpc.PendingBlockNumber:
+		return nil, errors.New("tracing the pending block is not supported")
+	case rpc.LatestBlockNumber:
+		block = api.VBG.blockchain.CurrentBlock()
+	case rpc.FinalizedBlockNumber:
+		block = api.VBG.finalizedBlock()
+	default:
+		block = api.VBG.blockchain.GetBlockByNumber(uint64(number))
+	}
+	if block == nil {
+		return nil, fmt.Errorf("block #%d not found", number)
+	}
+	return block, nil
+}
+
+// rewardTraces returns the block and uncle reward traces of a block, if the
+// consensus engine pays any.
+func (api *PrivateTraceAPI) rewardTraces(block *types.Block) []*ParityTrace {
+	if _, ok := api.VBG.engine.(*VBGash.VBGash); !ok {
+		return nil
+	}
+	var (
+		hash   = block.Hash()
+		number = block.NumberU64()
+		traces []*ParityTrace
+	)
+	reward, uncleRewards := VBGash.BlockRewards(api.VBG.blockchain.Config(), block.Header(), block.Uncles())
+	add := func(author common.Address, kind string, value *big.Int) {
+		traces = append(traces, &ParityTrace{
+			Action:       ParityTraceAction{Author: &author, RewardType: kind, Value: (*hexutil.Big)(value)},
+			BlockHash:    &hash,
+			BlockNumber:  &number,
+			TraceAddress: []int{},
+			Type:         "reward",
+		})
+	}
+	add(block.Coinbase(), "block", reward)
+	for i, uncle := range block.Uncles() {
+		add(uncle.Coinbase, "uncle", uncleRewards[i])
+	}
+	return traces
+}
+
+// parityTraces converts the call tracer results of all the transactions in a
+// block into flat Parity style traces, followed by the reward traces.
+func (api *PrivateTraceAPI) parityTraces(block *types.Block, results []*txTraceResult) ([]*ParityTrace, error) {
+	traces := []*ParityTrace{}
+	for i, res := range results {
+		if res.Error != "" {
+			return nil, fmt.Errorf("tracing transaction %#x failed: %s", block.Transactions()[i].Hash(), res.Error)
+		}
+		txTraces, err := parityTxTraces(res.Result.(json.RawMessage), block, i)
+		if err != nil {
+			return nil, err
+		}
+		traces = append(traces, txTraces...)
+	}
+	return append(traces, api.rewardTraces(block)...), nil
+}
+
+// blockTraces returns the Parity style traces of all the transactions and
+// rewards in a block, either from the trace index or by reexecuting the block.
+func (api *PrivateTraceAPI) blockTraces(ctx context.Context, block *types.Block) ([]*ParityTrace, error) {
+	if blob := rawdb.ReadBlockTraces(api.VBG.chainDb, block.Hash(), block.NumberU64()); len(blob) > 0 {
+		var traces []*ParityTrace
+		if err := json.Unmarshal(blob, &traces); err != nil {
+			return nil, err
+		}
+		return traces, nil
+	}
+	tracer := parityCallTracer
+	results, err := api.debug.traceBlock(ctx, block, &TraceConfig{Tracer: &tracer})
+	if err != nil {
+		return nil, err
+	}
+	return api.parityTraces(block, results)
+}
+
+// Block returns the Parity style traces of all the transactions and rewards
+// of the requested block.
+func (api *PrivateTraceAPI) Block(ctx context.Context, number rpc.BlockNumber) ([]*ParityTrace, error) {
+	block, err := api.blockByNumber(number)
+	if err != nil {
+		return nil, err
+	}
+	return api.blockTraces(ctx, block)
+}
+
+// Transaction returns the Parity style traces of the requested transaction.
+func (api *PrivateTraceAPI) Transaction(ctx context.Context, hash common.Hash) ([]*ParityTrace, error) {
+	tx, blockHash, blockNumber, index := rawdb.ReadTransaction(api.VBG.chainDb, hash)
+	if tx == nil {
+		return nil, fmt.Errorf("transaction %#x not found", hash)
+	}
+	// Serve the transaction from the trace index if available
+	if blob := rawdb.ReadBlockTraces(api.VBG.chainDb, blockHash, blockNumber); len(blob) > 0 {
+		var traces []*ParityTrace
+		if err := json.Unmarshal(blob, &traces); err != nil {
+			return nil, err
+		}
+		var txTraces []*ParityTrace
+		for _, trace := range traces {
+			if trace.TransactionPosition != nil && *trace.TransactionPosition == index {
+				txTraces = append(txTraces, trace)
+			}
+		}
+		return txTraces, nil
+	}
+	// Otherwise reexecute the transaction with the call tracer
+	block := api.VBG.blockchain.GetBlockByHash(blockHash)
+	if block == nil {
+		return nil, fmt.Errorf("block %#x not found", blockHash)
+	}
+	tracer := parityCallTracer
+	res, err := api.debug.TraceTransaction(ctx, hash, &TraceConfig{Tracer: &tracer})
+	if err != nil {
+		return nil, err
+	}
+	return parityTxTraces(res.(json.RawMessage), block, int(index))
+}
+
+// ReplayBlockTransactions reexecutes all the transactions of the requested
+// block and returns their traces. Only the "trace" trace type is supported.
+func (api *PrivateTraceAPI) ReplayBlockTransactions(ctx context.Context, number rpc.BlockNumber, traceTypes []string) ([]*TraceReplayResult, error) {
+	for _, typ := range traceTypes {
+		if typ != "trace" {
+			return nil, fmt.Errorf("unsupported trace type %q", typ)
+		}
+	}
+	block, err := api.blockByNumber(number)
+	if err != nil {
+		return nil, err
+	}
+	tracer := parityCallTracer
+	results, err := api.debug.traceBlock(ctx, block, &TraceConfig{Tracer: &tracer})
+	if err != nil {
+		return nil, err
+	}
+	replays := make([]*TraceReplayResult, len(results))
+	for i, res := range results {
+		tx := block.Transactions()[i]
+		if res.Error != "" {
+			return nil, fmt.Errorf("tracing transaction %#x failed: %s", tx.Hash(), res.Error)
+		}
+		frame := new(callFrame)
+		if err := json.Unmarshal(res.Result.(json.RawMessage), frame); err != nil {
+			return nil, err
+		}
+		replays[i] = &TraceReplayResult{
+			Output:          frame.Output,
+			Trace:           flattenCallFrame(frame, nil, nil),
+			TransactionHash: tx.Hash(),
+		}
+	}
+	return replays, nil
+}
+
+// Filter returns the Parity style traces matching the given block range and
+// address filters. Blocks covered by the trace index are served without
+// reexecution, and only the blocks touching the filtered addresses are loaded.
+func (api *PrivateTraceAPI) Filter(ctx context.Context, args TraceFilterArgs) ([]*ParityTrace, error) {
+	head := api.VBG.blockchain.CurrentBlock().NumberU64()
+
+	resolve := func(number *rpc.BlockNumber, def uint64) (uint64, error) {
+		switch {
+		case number == nil:
+			return def, nil
+		case *number == rpc.PendingBlockNumber:
+			return 0, errors.New("tracing the pending block is not supported")
+		case *number == rpc.LatestBlockNumber:
+			return head, nil
+		case *number == rpc.FinalizedBlockNumber:
+			finalized := api.VBG.blockchain.CurrentFinalizedHeader()
+			if finalized == nil {
+				return 0, errors.New("finalized block not found")
+			}
+			return finalized.Number.Uint64(), nil
+		}
+		return uint64(*number), nil
+	}
+	from, err := resolve(args.FromBlock, 0)
+	if err != nil {
+		return nil, err
+	}
+	to, err := resolve(args.ToBlock, head)
+	if err != nil {
+		return nil, err
+	}
+	if to > head {
+		to = head
+	}
+	if from > to {
+		return nil, fmt.Errorf("invalid block range %d-%d", from, to)
+	}
+	numbers := api.filterCandidates(&args, from, to)
+
+	var (
+		traces  = []*ParityTrace{}
+		skipped uint64
+	)
+	for _, number := range numbers {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		block := api.VBG.blockchain.GetBlockByNumber(number)
+		if block == nil {
+			return nil, fmt.Errorf("block #%d not found", number)
+		}
+		blockTraces, err := api.blockTraces(ctx, block)
+		if err != nil {
+			return nil, err
+		}
+		for _, trace := range blockTraces {
+			if !args.matches(trace) {
+				continue
+			}
+			if args.After != nil && skipped < *args.After {
+				skipped++
+				continue
+			}
+			traces = append(traces, trace)
+			if args.Count != nil && uint64(len(traces)) >= *args.Count {
+				return traces, nil
+			}
+		}
+	}
+	return traces, nil
+}
+
+// filterCandidates returns the numbers of the blocks in the given range which
+// may contain traces matching the filter. Blocks covered by the trace index are
+// narrowed down to the ones touching the filtered addresses.
+func (api *PrivateTraceAPI) filterCandidates(args *TraceFilterArgs, from, to uint64) []uint64 {
+	var indexed uint64 // First block not covered by the trace index
+	if api.VBG.traceIndexer != nil {
+		sections, _, _ := api.VBG.traceIndexer.Sections()
+		indexed = sections * traceIndexSectionSize
+	}
+	var numbers []uint64
+	if (len(args.FromAddress) > 0 || len(args.ToAddress) > 0) && from < indexed {
+		end := to
+		if end >= indexed {
+			end = indexed - 1
+		}
+		// Only the addresses of a non-empty filter list need to be looked up,
+		// since every matching trace has to satisfy all the non-empty lists
+		addresses := args.FromAddress
+		if len(addresses) == 0 {
+			addresses = args.ToAddress
+		}
+		seen := make(map[uint64]struct{})
+		for _, addr := range addresses {
+			for _, number := range rawdb.ReadTraceAddressBlocks(api.VBG.chainDb, addr, from, end) {
+				if _, ok := seen[number]; !ok {
+					seen[number] = struct{}{}
+					numbers = append(numbers, number)
+				}
+			}
+		}
+		sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+		from = end + 1
+	}
+	for number := from; number <= to; number++ {
+		numbers = append(numbers, number)
+	}
+	return numbers
+}
@@ -0,0 +1,90 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package VBG
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/vbgloble/go-VGB/common"
+)
+
+// Tests that nested call tracer frames are flattened into Parity style traces
+// with the correct trace addresses and subtrace counts.
+func TestFlattenCallFrame(t *testing.T) {
+	blob := `{
+		"type": "CALL", "from": "0x0000000000000000000000000000000000000001", "to": "0x0000000000000000000000000000000000000002",
+		"value": "0x1", "gas": "0x100", "gasUsed": "0x10", "input": "0x", "output": "0x",
+		"calls": [{
+			"type": "CREATE", "from": "0x0000000000000000000000000000000000000002", "to": "0x0000000000000000000000000000000000000003",
+			"value": "0x0", "gas": "0x50", "gasUsed": "0x5", "input": "0x00", "output": "0x01",
+			"calls": [{
+				"type": "SELFDESTRUCT", "from": "0x0000000000000000000000000000000000000003", "to": "0x0000000000000000000000000000000000000001",
+				"value": "0x0", "gas": "0x0", "gasUsed": "0x0", "input": "0x"
+			}]
+		}, {
+			"type": "STATICCALL", "from": "0x0000000000000000000000000000000000000002", "to": "0x0000000000000000000000000000000000000004",
+			"gas": "0x20", "gasUsed": "0x20", "input": "0x", "error": "out of gas"
+		}]
+	}`
+	frame := new(callFrame)
+	if err := json.Unmarshal([]byte(blob), frame); err != nil {
+		t.Fatalf("failed to decode call frame: %v", err)
+	}
+	traces := flattenCallFrame(frame, nil, nil)
+
+	want := []struct {
+		typ       string
+		address   []int
+		subtraces int
+		err       string
+		from, to  common.Address
+	}{
+		{"call", []int{}, 2, "", common.HexToAddress("0x01"), common.HexToAddress("0x02")},
+		{"create", []int{0}, 1, "", common.HexToAddress("0x02"), common.HexToAddress("0x03")},
+		{"suicide", []int{0, 0}, 0, "", common.HexToAddress("0x03"), common.HexToAddress("0x01")},
+		{"call", []int{1}, 0, "Out of gas", common.HexToAddress("0x02"), common.HexToAddress("0x04")},
+	}
+	if len(traces) != len(want) {
+		t.Fatalf("trace count mismatch: have %d, want %d", len(traces), len(want))
+	}
+	for i, trace := range traces {
+		if trace.Type != want[i].typ {
+			t.Errorf("trace %d: type mismatch: have %s, want %s", i, trace.Type, want[i].typ)
+		}
+		if !reflect.DeepEqual(trace.TraceAddress, want[i].address) {
+			t.Errorf("trace %d: trace address mismatch: have %v, want %v", i, trace.TraceAddress, want[i].address)
+		}
+		if trace.Subtraces != want[i].subtraces {
+			t.Errorf("trace %d: subtraces mismatch: have %d, want %d", i, trace.Subtraces, want[i].subtraces)
+		}
+		if trace.Error != want[i].err {
+			t.Errorf("trace %d: error mismatch: have %q, want %q", i, trace.Error, want[i].err)
+		}
+		if (trace.Error == "" && trace.Type != "suicide") != (trace.Result != nil) {
+			t.Errorf("trace %d: result presence mismatch: error %q, result %v", i, trace.Error, trace.Result)
+		}
+		from, to := trace.fromTo()
+		if from == nil || *from != want[i].from {
+			t.Errorf("trace %d: from mismatch: have %v, want %x", i, from, want[i].from)
+		}
+		if to == nil || *to != want[i].to {
+			t.Errorf("trace %d: to mismatch: have %v, want %x", i, to, want[i].to)
+		}
+	}
+}
@@ -111,6 +111,8 @@ func (api *PrivateDebugAPI) TraceChain(ctx context.Context, start, end rpc.Block
 		from = api.VBG.miner.PendingBlock()
 	case rpc.LatestBlockNumber:
 		from = api.VBG.blockchain.CurrentBlock()
+	case rpc.FinalizedBlockNumber:
+		from = api.VBG.finalizedBlock()
 	default:
 		from = api.VBG.blockchain.GetBlockByNumber(uint64(start))
 	}
@@ -119,6 +121,8 @@ func (api *PrivateDebugAPI) TraceChain(ctx context.Context, start, end rpc.Block
 		to = api.VBG.miner.PendingBlock()
 	case rpc.LatestBlockNumber:
 		to = api.VBG.blockchain.CurrentBlock()
+	case rpc.FinalizedBlockNumber:
+		to = api.VBG.finalizedBlock()
 	default:
 		to = api.VBG.blockchain.GetBlockByNumber(uint64(end))
 	}
@@ -148,7 +152,7 @@ func (api *PrivateDebugAPI) traceChain(ctx context.Context, start, end *types.Bl
 
 	// Ensure we have a valid starting state before doing any work
 	origin := start.NumberU64()
-	database := state.NewDatabaseWithConfig(api.VBG.ChainDb(), &trie.Config{Cache: 16, Preimages: true})
+	database := api.stateDatabase()
 
 	if number := start.NumberU64(); number > 0 {
 		start = api.VBG.blockchain.GetBlock(start.ParentHash(), start.NumberU64()-1)
@@ -206,7 +210,7 @@ func (api *PrivateDebugAPI) traceChain(ctx context.Context, start, end *types.Bl
 				blockCtx := core.NewEVMBlockContext(task.block.Header(), api.VBG.blockchain, nil)
 				// Trace all the transactions contained within
 				for i, tx := range task.block.Transactions() {
-					msg, _ := tx.AsMessage(signer)
+					msg, _ := tx.AsMessage(signer, task.block.BaseFee())
 					res, err := api.traceTx(ctx, msg, blockCtx, task.statedb, config)
 					if err != nil {
 						task.results[i] = &txTraceResult{Error: err.Error()}
@@ -360,6 +364,8 @@ func (api *PrivateDebugAPI) TraceBlockByNumber(ctx context.Context, number rpc.B
 		block = api.VBG.miner.PendingBlock()
 	case rpc.LatestBlockNumber:
 		block = api.VBG.blockchain.CurrentBlock()
+	case rpc.FinalizedBlockNumber:
+		block = api.VBG.finalizedBlock()
 	default:
 		block = api.VBG.blockchain.GetBlockByNumber(uint64(number))
 	}
@@ -457,6 +463,13 @@ func (api *PrivateDebugAPI) traceBlock(ctx context.Context, block *types.Block,
 	if err != nil {
 		return nil, err
 	}
+	return api.traceBlockState(ctx, block, statedb, config)
+}
+
+// traceBlockState traces all the transactions contained within the block on top
+// of the given parent state. The state is advanced to include the effects of all
+// the transactions, but without the block and uncle rewards applied.
+func (api *PrivateDebugAPI) traceBlockState(ctx context.Context, block *types.Block, statedb *state.StateDB, config *TraceConfig) ([]*txTraceResult, error) {
 	// Execute all the transaction contained within the block concurrently
 	var (
 		signer = types.MakeSigner(api.VBG.blockchain.Config(), block.Number())
@@ -478,7 +491,7 @@ func (api *PrivateDebugAPI) traceBlock(ctx context.Context, block *types.Block,
 			defer pend.Done()
 			// Fetch and execute the next transaction trace tasks
 			for task := range jobs {
-				msg, _ := txs[task.index].AsMessage(signer)
+				msg, _ := txs[task.index].AsMessage(signer, block.BaseFee())
 				res, err := api.traceTx(ctx, msg, blockCtx, task.statedb, config)
 				if err != nil {
 					results[task.index] = &txTraceResult{Error: err.Error()}
@@ -495,7 +508,7 @@ func (api *PrivateDebugAPI) traceBlock(ctx context.Context, block *types.Block,
 		jobs <- &txTraceTask{statedb: statedb.Copy(), index: i}
 
 		// Generate the next state snapshot fast without tracing
-		msg, _ := tx.AsMessage(signer)
+		msg, _ := tx.AsMessage(signer, block.BaseFee())
 		txContext := core.NewEVMTxContext(msg)
 
 		vmenv := vm.NewEVM(blockCtx, txContext, statedb, api.VBG.blockchain.Config(), vm.Config{})
@@ -584,7 +597,7 @@ func (api *PrivateDebugAPI) standardTraceBlockToFile(ctx context.Context, block
 	for i, tx := range block.Transactions() {
 		// Prepare the trasaction for un-traced execution
 		var (
-			msg, _    = tx.AsMessage(signer)
+			msg, _    = tx.AsMessage(signer, block.BaseFee())
 			txContext = core.NewEVMTxContext(msg)
 			vmConf    vm.Config
 			dump      *os.File
@@ -648,6 +661,16 @@ func containsTx(block *types.Block, hash common.Hash) bool {
 	return false
 }
 
+// stateDatabase creates a state database to regenerate historical state in. If
+// the chain database is opened read-only, all regenerated state is kept in memory.
+func (api *PrivateDebugAPI) stateDatabase() state.Database {
+	config := &trie.Config{Cache: 16, Preimages: true}
+	if api.VBG.config.DatabaseReadOnly {
+		return state.NewVolatileDatabase(api.VBG.ChainDb(), config)
+	}
+	return state.NewDatabaseWithConfig(api.VBG.ChainDb(), config)
+}
+
 // computeStateDB retrieves the state database associated with a certain block.
 // If no state is locally available for the given block, a number of blocks are
 // attempted to be reexecuted to generate the desired state.
@@ -658,63 +681,8 @@ func (api *PrivateDebugAPI) computeStateDB(block *types.Block, reexec uint64) (*
 		return statedb, nil
 	}
 	// Otherwise try to reexec blocks until we find a state or reach our limit
-	origin := block.NumberU64()
-	database := state.NewDatabaseWithConfig(api.VBG.ChainDb(), &trie.Config{Cache: 16, Preimages: true})
-
-	for i := uint64(0); i < reexec; i++ {
-		block = api.VBG.blockchain.GetBlock(block.ParentHash(), block.NumberU64()-1)
-		if block == nil {
-			break
-		}
-		if statedb, err = state.New(block.Root(), database, nil); err == nil {
-			break
-		}
-	}
-	if err != nil {
-		switch err.(type) {
-		case *trie.MissingNodeError:
-			return nil, fmt.Errorf("required historical state unavailable (reexec=%d)", reexec)
-		default:
-			return nil, err
-		}
-	}
-	// State was available at historical point, regenerate
-	var (
-		start  = time.Now()
-		logged time.Time
-		proot  common.Hash
-	)
-	for block.NumberU64() < origin {
-		// Print progress logs if long enough time elapsed
-		if time.Since(logged) > 8*time.Second {
-			log.Info("Regenerating historical state", "block", block.NumberU64()+1, "target", origin, "remaining", origin-block.NumberU64()-1, "elapsed", time.Since(start))
-			logged = time.Now()
-		}
-		// Retrieve the next block to regenerate and process it
-		if block = api.VBG.blockchain.GetBlockByNumber(block.NumberU64() + 1); block == nil {
-			return nil, fmt.Errorf("block #%d not found", block.NumberU64()+1)
-		}
-		_, _, _, err := api.VBG.blockchain.Processor().Process(block, statedb, vm.Config{})
-		if err != nil {
-			return nil, fmt.Errorf("processing block %d failed: %v", block.NumberU64(), err)
-		}
-		// Finalize the state so any modifications are written to the trie
-		root, err := statedb.Commit(api.VBG.blockchain.Config().IsEIP158(block.Number()))
-		if err != nil {
-			return nil, err
-		}
-		if err := statedb.Reset(root); err != nil {
-			return nil, fmt.Errorf("state reset after block %d failed: %v", block.NumberU64(), err)
-		}
-		database.TrieDB().Reference(root, common.Hash{})
-		if proot != (common.Hash{}) {
-			database.TrieDB().Dereference(proot)
-		}
-		proot = root
-	}
-	nodes, imgs := database.TrieDB().Size()
-	log.Info("Historical state regenerated", "block", block.NumberU64(), "elapsed", time.Since(start), "nodes", nodes, "preimages", imgs)
-	return statedb, nil
+	database := api.stateDatabase()
+	return api.VBG.blockchain.StateAtBlock(block, reexec, database)
 }
 
 // TraceTransaction returns the structured logs created during the execution of EVM
@@ -768,10 +736,14 @@ func (api *PrivateDebugAPI) TraceCall(ctx context.Context, args VBGapi.CallArgs,
 		if err != nil {
 			return nil, err
 		}
+		header = block.Header()
 	}
 
 	// Execute the trace
-	msg := args.ToMessage(api.VBG.APIBackend.RPCGasCap())
+	msg, err := args.ToMessage(api.VBG.APIBackend.RPCGasCap(), header.BaseFee)
+	if err != nil {
+		return nil, err
+	}
 	vmctx := core.NewEVMBlockContext(header, api.VBG.blockchain, nil)
 	return api.traceTx(ctx, msg, vmctx, statedb, config)
 }
@@ -780,7 +752,7 @@ func (api *PrivateDebugAPI) TraceCall(ctx context.Context, args VBGapi.CallArgs,
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
 func (api *PrivateDebugAPI) traceTx(ctx context.Context, message core.Message, vmctx vm.BlockContext, statedb *state.StateDB, config *TraceConfig) (interface{}, error) {
-	// Assemble the structured logger or the JavaScript tracer
+	// Assemble the structured logger or the native/JavaScript tracer
 	var (
 		tracer    vm.Tracer
 		err       error
@@ -795,15 +767,15 @@ func (api *PrivateDebugAPI) traceTx(ctx context.Context, message core.Message, v
 				return nil, err
 			}
 		}
-		// Constuct the JavaScript tracer to execute with
-		if tracer, err = tracers.New(*config.Tracer); err != nil {
+		// Constuct the native or JavaScript tracer to execute with
+		if tracer, err = tracers.NewTracer(*config.Tracer); err != nil {
 			return nil, err
 		}
 		// Handle timeouts and RPC cancellations
 		deadlineCtx, cancel := context.WithTimeout(ctx, timeout)
 		go func() {
 			<-deadlineCtx.Done()
-			tracer.(*tracers.Tracer).Stop(errors.New("execution timeout"))
+			tracer.(tracers.ResultTracer).Stop(errors.New("execution timeout"))
 		}()
 		defer cancel()
 
@@ -814,7 +786,7 @@ func (api *PrivateDebugAPI) traceTx(ctx context.Context, message core.Message, v
 		tracer = vm.NewStructLogger(config.LogConfig)
 	}
 	// Run the transaction with tracing enabled.
-	vmenv := vm.NewEVM(vmctx, txContext, statedb, api.VBG.blockchain.Config(), vm.Config{Debug: true, Tracer: tracer})
+	vmenv := vm.NewEVM(vmctx, txContext, statedb, api.VBG.blockchain.Config(), vm.Config{Debug: true, Tracer: tracer, NoBaseFee: true})
 
 	result, err := core.ApplyMessage(vmenv, message, new(core.GasPool).AddGas(message.Gas()))
 	if err != nil {
@@ -835,7 +807,7 @@ func (api *PrivateDebugAPI) traceTx(ctx context.Context, message core.Message, v
 			StructLogs:  VBGapi.FormatLogs(tracer.StructLogs()),
 		}, nil
 
-	case *tracers.Tracer:
+	case tracers.ResultTracer:
 		return tracer.GetResult()
 
 	default:
@@ -864,7 +836,7 @@ func (api *PrivateDebugAPI) computeTxEnv(block *types.Block, txIndex int, reexec
 
 	for idx, tx := range block.Transactions() {
 		// Assemble the transaction call message and return if the requested offset
-		msg, _ := tx.AsMessage(signer)
+		msg, _ := tx.AsMessage(signer, block.BaseFee())
 		txContext := core.NewEVMTxContext(msg)
 		context := core.NewEVMBlockContext(block.Header(), api.VBG.blockchain, nil)
 		if idx == txIndex {
@@ -0,0 +1,103 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package VBG
+
+import (
+	"context"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/consensus/VBGash"
+	"github.com/vbgloble/go-VGB/core"
+	"github.com/vbgloble/go-VGB/core/rawdb"
+	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/core/vm"
+	"github.com/vbgloble/go-VGB/crypto"
+	"github.com/vbgloble/go-VGB/params"
+	"github.com/vbgloble/go-VGB/rpc"
+)
+
+// Tests that blocks can be traced on a read-only node even if their state needs
+// to be regenerated by executing contract deployments.
+func TestTraceBlockReadOnly(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// Create a chain deploying a contract, only persisting the genesis and head states
+	var (
+		key, _  = crypto.GenerateKey()
+		addr    = crypto.PubkeyToAddress(key.PublicKey)
+		signer  = types.HomesteadSigner{}
+		genesis = &core.Genesis{
+			Config: params.TestChainConfig,
+			Alloc:  core.GenesisAlloc{addr: {Balance: big.NewInt(params.VBGer)}},
+		}
+		// Init code returning a runtime which stores 1 into slot 0
+		code = common.FromHex("0x6005600c60003960056000f36001600055")
+	)
+	db, err := rawdb.NewLevelDBDatabase(dir, 0, 0, "", false)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	genesis.MustCommit(db)
+
+	gendb := rawdb.NewMemoryDatabase()
+	blocks, _ := core.GenerateChain(genesis.Config, genesis.MustCommit(gendb), VBGash.NewFaker(), gendb, 8, func(i int, gen *core.BlockGen) {
+		tx, _ := types.SignTx(types.NewContractCreation(gen.TxNonce(addr), new(big.Int), 100000, new(big.Int), code), signer, key)
+		gen.AddTx(tx)
+	})
+	chain, err := core.NewBlockChain(db, nil, genesis.Config, VBGash.NewFaker(), vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create chain: %v", err)
+	}
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+	chain.Stop()
+	db.Close()
+
+	// Reopen the chain read-only and trace a block with a missing parent state
+	if db, err = rawdb.NewLevelDBDatabase(dir, 0, 0, "", true); err != nil {
+		t.Fatalf("failed to open database read-only: %v", err)
+	}
+	defer db.Close()
+
+	var (
+		engine      = VBGash.NewFaker()
+		cacheConfig = &core.CacheConfig{TrieCleanLimit: 256, TrieDirtyLimit: 256, TrieTimeLimit: 5 * time.Minute, ReadOnly: true}
+	)
+	if chain, err = core.NewBlockChain(db, cacheConfig, genesis.Config, engine, vm.Config{}, nil, nil); err != nil {
+		t.Fatalf("failed to create read-only chain: %v", err)
+	}
+	defer chain.Stop()
+
+	api := NewPrivateDebugAPI(&vbgloble{config: &Config{DatabaseReadOnly: true}, chainDb: db, blockchain: chain, engine: engine})
+	results, err := api.TraceBlockByNumber(context.Background(), rpc.BlockNumber(5), nil)
+	if err != nil {
+		t.Fatalf("failed to trace block: %v", err)
+	}
+	if len(results) != 1 || results[0].Error != "" {
+		t.Fatalf("trace results mismatch: %+v", results)
+	}
+}
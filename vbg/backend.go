@@ -34,11 +34,13 @@ import (
 	"github.com/vbgloble/go-VGB/core"
 	"github.com/vbgloble/go-VGB/core/bloombits"
 	"github.com/vbgloble/go-VGB/core/rawdb"
+	"github.com/vbgloble/go-VGB/core/state/pruner"
 	"github.com/vbgloble/go-VGB/core/types"
 	"github.com/vbgloble/go-VGB/core/vm"
 	"github.com/vbgloble/go-VGB/VBG/downloader"
 	"github.com/vbgloble/go-VGB/VBG/filters"
 	"github.com/vbgloble/go-VGB/VBG/gasprice"
+	"github.com/vbgloble/go-VGB/VBG/protocols/snap"
 	"github.com/vbgloble/go-VGB/VBGdb"
 	"github.com/vbgloble/go-VGB/event"
 	"github.com/vbgloble/go-VGB/internal/VBGapi"
@@ -53,12 +55,17 @@ import (
 	"github.com/vbgloble/go-VGB/rpc"
 )
 
+// errReadOnly is returned when attempting to modify the chain of a node running
+// on a read-only database.
+var errReadOnly = errors.New("node is read only")
+
 // vbgloble implements the vbgloble full node service.
 type vbgloble struct {
 	config *Config
 
 	// Handlers
 	txPool          *core.TxPool
+	bundlePool      *core.BundlePool
 	blockchain      *core.BlockChain
 	protocolManager *ProtocolManager
 	dialCandidates  enode.Iterator
@@ -74,6 +81,8 @@ type vbgloble struct {
 	bloomIndexer      *core.ChainIndexer             // Bloom indexer operating during block imports
 	closeBloomHandler chan struct{}
 
+	traceIndexer *core.ChainIndexer // Trace indexer persisting block traces, nil if disabled
+
 	APIBackend *VBGAPIBackend
 
 	miner     *miner.Miner
@@ -113,17 +122,42 @@ func New(stack *node.Node, config *Config) (*vbgloble, error) {
 	}
 	log.Info("Allocated trie memory caches", "clean", common.StorageSize(config.TrieCleanCache)*1024*1024, "dirty", common.StorageSize(config.TrieDirtyCache)*1024*1024)
 
+	// A read-only node serves the existing database as is, nothing that could
+	// modify it may run
+	if config.DatabaseReadOnly {
+		config.SnapshotCache = 0
+		config.HistoryLimit = 0
+		config.TraceIndex = false
+		config.TxPool.Journal = ""
+	}
 	// Assemble the vbgloble object
-	chainDb, err := stack.OpenDatabaseWithFreezer("chaindata", config.DatabaseCache, config.DatabaseHandles, config.DatabaseFreezer, "VBG/db/chaindata/")
+	chainDb, err := stack.OpenDatabaseWithFreezer("chaindata", config.DatabaseCache, config.DatabaseHandles, config.DatabaseFreezer, "VBG/db/chaindata/", config.DatabaseReadOnly)
 	if err != nil {
 		return nil, err
 	}
-	chainConfig, genesisHash, genesisErr := core.SetupGenesisBlock(chainDb, config.Genesis)
-	if _, ok := genesisErr.(*params.ConfigCompatError); genesisErr != nil && !ok {
-		return nil, genesisErr
+	var (
+		chainConfig *params.ChainConfig
+		genesisHash common.Hash
+		genesisErr  error
+	)
+	if config.DatabaseReadOnly {
+		if chainConfig, genesisHash, genesisErr = core.LoadChainConfig(chainDb, config.Genesis); genesisErr != nil {
+			return nil, genesisErr
+		}
+	} else {
+		chainConfig, genesisHash, genesisErr = core.SetupGenesisBlock(chainDb, config.Genesis)
+		if _, ok := genesisErr.(*params.ConfigCompatError); genesisErr != nil && !ok {
+			return nil, genesisErr
+		}
 	}
 	log.Info("Initialised chain configuration", "config", chainConfig)
 
+	if !config.DatabaseReadOnly {
+		if err := pruner.RecoverPruning(stack.ResolvePath(""), chainDb, stack.ResolvePath(config.TrieCleanCacheJournal)); err != nil {
+			log.Error("Failed to recover state", "error", err)
+		}
+	}
+
 	VBG := &vbgloble{
 		config:            config,
 		chainDb:           chainDb,
@@ -149,6 +183,8 @@ func New(stack *node.Node, config *Config) (*vbgloble, error) {
 	if !config.SkipBcVersionCheck {
 		if bcVersion != nil && *bcVersion > core.BlockChainVersion {
 			return nil, fmt.Errorf("database version is v%d, GVBG %s only supports v%d", *bcVersion, params.VersionWithMeta, core.BlockChainVersion)
+		} else if (bcVersion == nil || *bcVersion < core.BlockChainVersion) && config.DatabaseReadOnly {
+			log.Warn("Skipping blockchain database version upgrade in read-only mode", "from", dbVer, "to", core.BlockChainVersion)
 		} else if bcVersion == nil || *bcVersion < core.BlockChainVersion {
 			log.Warn("Upgrade blockchain database version", "from", dbVer, "to", core.BlockChainVersion)
 			rawdb.WriteDatabaseVersion(chainDb, core.BlockChainVersion)
@@ -165,13 +201,20 @@ func New(stack *node.Node, config *Config) (*vbgloble, error) {
 			TrieCleanJournal:    stack.ResolvePath(config.TrieCleanCacheJournal),
 			TrieCleanRejournal:  config.TrieCleanCacheRejournal,
 			TrieCleanNoPrefetch: config.NoPrefetch,
+			ParallelImport:      config.ParallelImport,
+			FinalityDepth:       config.FinalityDepth,
 			TrieDirtyLimit:      config.TrieDirtyCache,
 			TrieDirtyDisabled:   config.NoPruning,
 			TrieTimeLimit:       config.TrieTimeout,
 			SnapshotLimit:       config.SnapshotCache,
 			Preimages:           config.Preimages,
+			HistoryLimit:        config.HistoryLimit,
+			ReadOnly:            config.DatabaseReadOnly,
 		}
 	)
+	if config.DatabaseReadOnly {
+		cacheConfig.TrieCleanJournal, cacheConfig.TrieCleanRejournal = "", 0
+	}
 	VBG.blockchain, err = core.NewBlockChain(chainDb, cacheConfig, chainConfig, VBG.engine, vmConfig, VBG.shouldPreserve, &config.TxLookupLimit)
 	if err != nil {
 		return nil, err
@@ -184,10 +227,19 @@ func New(stack *node.Node, config *Config) (*vbgloble, error) {
 	}
 	VBG.bloomIndexer.Start(VBG.blockchain)
 
+	if config.TraceIndex {
+		VBG.traceIndexer = NewTraceIndexer(VBG, traceIndexSectionSize, traceIndexConfirms)
+		VBG.traceIndexer.Start(VBG.blockchain)
+	}
+
 	if config.TxPool.Journal != "" {
 		config.TxPool.Journal = stack.ResolvePath(config.TxPool.Journal)
 	}
+	if config.TxPool.Store != "" {
+		config.TxPool.Store = stack.ResolvePath(config.TxPool.Store)
+	}
 	VBG.txPool = core.NewTxPool(config.TxPool, chainConfig, VBG.blockchain)
+	VBG.bundlePool = core.NewBundlePool(chainConfig, VBG.blockchain)
 
 	// Permit the downloader to use the trie cache allowance during fast sync
 	cacheLimit := cacheConfig.TrieCleanLimit + cacheConfig.TrieDirtyLimit + cacheConfig.SnapshotLimit
@@ -218,7 +270,9 @@ func New(stack *node.Node, config *Config) (*vbgloble, error) {
 
 	// Register the backend on the node
 	stack.RegisterAPIs(VBG.APIs())
-	stack.RegisterProtocols(VBG.Protocols())
+	if !config.DatabaseReadOnly {
+		stack.RegisterProtocols(VBG.Protocols())
+	}
 	stack.RegisterLifecycle(VBG)
 	return VBG, nil
 }
@@ -321,6 +375,10 @@ func (s *vbgloble) APIs() []rpc.API {
 			Namespace: "debug",
 			Version:   "1.0",
 			Service:   NewPrivateDebugAPI(s),
+		}, {
+			Namespace: "trace",
+			Version:   "1.0",
+			Service:   NewPrivateTraceAPI(s),
 		}, {
 			Namespace: "net",
 			Version:   "1.0",
@@ -424,6 +482,9 @@ func (s *vbgloble) SetVBGerbase(VBGerbase common.Address) {
 // is already running, this mVBGod adjust the number of threads allowed to use
 // and updates the minimum price required by the transaction pool.
 func (s *vbgloble) StartMining(threads int) error {
+	if s.config.DatabaseReadOnly {
+		return errReadOnly
+	}
 	// Update the thread count within the consensus engine
 	type threaded interface {
 		SetThreads(threads int)
@@ -486,6 +547,7 @@ func (s *vbgloble) Miner() *miner.Miner { return s.miner }
 func (s *vbgloble) AccountManager() *accounts.Manager  { return s.accountManager }
 func (s *vbgloble) BlockChain() *core.BlockChain       { return s.blockchain }
 func (s *vbgloble) TxPool() *core.TxPool               { return s.txPool }
+func (s *vbgloble) BundlePool() *core.BundlePool       { return s.bundlePool }
 func (s *vbgloble) EventMux() *event.TypeMux           { return s.eventMux }
 func (s *vbgloble) Engine() consensus.Engine           { return s.engine }
 func (s *vbgloble) ChainDb() VBGdb.Database            { return s.chainDb }
@@ -497,6 +559,16 @@ func (s *vbgloble) Synced() bool                       { return atomic.LoadUint3
 func (s *vbgloble) ArchiveMode() bool                  { return s.config.NoPruning }
 func (s *vbgloble) BloomIndexer() *core.ChainIndexer   { return s.bloomIndexer }
 
+// finalizedBlock retrieves the latest finalized block of the canonical chain, or
+// nil if no block was finalized yet.
+func (s *vbgloble) finalizedBlock() *types.Block {
+	header := s.blockchain.CurrentFinalizedHeader()
+	if header == nil {
+		return nil
+	}
+	return s.blockchain.GetBlock(header.Hash(), header.Number.Uint64())
+}
+
 // Protocols returns all the currently configured
 // network protocols to start.
 func (s *vbgloble) Protocols() []p2p.Protocol {
@@ -506,6 +578,10 @@ func (s *vbgloble) Protocols() []p2p.Protocol {
 		protos[i].Attributes = []enr.Entry{s.currentVBGEntry()}
 		protos[i].DialCandidates = s.dialCandidates
 	}
+	// Serve and sync state over the snap protocol if snapshots are enabled
+	if s.config.SnapshotCache > 0 {
+		protos = append(protos, snap.MakeProtocols((*snapHandler)(s.protocolManager), s.dialCandidates)...)
+	}
 	return protos
 }
 
@@ -526,7 +602,9 @@ func (s *vbgloble) Start() error {
 		maxPeers -= s.config.LightPeers
 	}
 	// Start the networking layer and the light server if requested
-	s.protocolManager.Start(maxPeers)
+	if !s.config.DatabaseReadOnly {
+		s.protocolManager.Start(maxPeers)
+	}
 	return nil
 }
 
@@ -534,11 +612,16 @@ func (s *vbgloble) Start() error {
 // vbgloble protocol.
 func (s *vbgloble) Stop() error {
 	// Stop all the peer-related stuff first.
-	s.protocolManager.Stop()
+	if !s.config.DatabaseReadOnly {
+		s.protocolManager.Stop()
+	}
 
 	// Then stop everything else.
 	s.bloomIndexer.Close()
 	close(s.closeBloomHandler)
+	if s.traceIndexer != nil {
+		s.traceIndexer.Close()
+	}
 	s.txPool.Stop()
 	s.miner.Stop()
 	s.blockchain.Stop()
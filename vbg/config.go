@@ -74,6 +74,7 @@ var DefaultConfig = Config{
 		GasCeil:  8000000,
 		GasPrice: big.NewInt(params.GWei),
 		Recommit: 3 * time.Second,
+		Ordering: miner.OrderingPrice,
 	},
 	TxPool:      core.DefaultTxPoolConfig,
 	RPCGasCap:   25000000,
@@ -120,7 +121,16 @@ type Config struct {
 	NoPruning  bool // WhVBGer to disable pruning and flush everything to disk
 	NoPrefetch bool // WhVBGer to disable prefetching and only load state on demand
 
+	ParallelImport bool `toml:",omitempty"` // WhVBGer to execute the transactions of imported blocks in parallel
+
+	// Finality options
+	FinalityDepth     uint64           `toml:",omitempty"` // Number of confirmations after which blocks are final (0 = never)
+	FinalitySigners   []common.Address `toml:",omitempty"` // Accounts trusted to sign finality checkpoints
+	FinalityThreshold int              `toml:",omitempty"` // Number of signers required on a finality checkpoint (0 = majority)
+
 	TxLookupLimit uint64 `toml:",omitempty"` // The maximum number of blocks from head whose tx indices are reserved.
+	HistoryLimit  uint64 `toml:",omitempty"` // The maximum number of blocks from head whose bodies and receipts are reserved.
+	TraceIndex    bool   `toml:",omitempty"` // WhVBGer to persist transaction traces for the trace_ namespace
 
 	// Whitelist of required block number -> hash values to accept
 	Whitelist map[uint64]common.Hash `toml:"-"`
@@ -142,6 +152,7 @@ type Config struct {
 	DatabaseHandles    int  `toml:"-"`
 	DatabaseCache      int
 	DatabaseFreezer    string
+	DatabaseReadOnly   bool `toml:",omitempty"` // WhVBGer to open the database read-only, disabling sync, mining and the txpool
 
 	TrieCleanCache          int
 	TrieCleanCacheJournal   string        `toml:",omitempty"` // Disk journal directory for trie cache to survive node restarts
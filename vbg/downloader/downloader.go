@@ -35,6 +35,7 @@ import (
 	"github.com/vbgloble/go-VGB/metrics"
 	"github.com/vbgloble/go-VGB/params"
 	"github.com/vbgloble/go-VGB/trie"
+	"github.com/vbgloble/go-VGB/VBG/protocols/snap"
 )
 
 var (
@@ -111,6 +112,9 @@ type Downloader struct {
 	stateDB    VBGdb.Database  // Database to state sync into (and deduplicate via)
 	stateBloom *trie.SyncBloom // Bloom filter for fast trie node and contract code existence checks
 
+	snapSync   bool         // WhVBGer to run state sync over the snap protocol
+	SnapSyncer *snap.Syncer // Snap state syncer, exported for the snap protocol handler
+
 	// Statistics
 	syncStatsChainOrigin uint64 // Origin block number where syncing started at
 	syncStatsChainHeight uint64 // Highest block number known when syncing started
@@ -202,6 +206,9 @@ type BlockChain interface {
 	// CurrentFastBlock retrieves the head fast block from the local chain.
 	CurrentFastBlock() *types.Block
 
+	// CurrentFinalizedHeader retrieves the finalized header from the local chain.
+	CurrentFinalizedHeader() *types.Header
+
 	// FastSyncCommitHead directly commits the head block to a certain entity.
 	FastSyncCommitHead(common.Hash) error
 
@@ -242,6 +249,7 @@ func New(checkpoint uint64, stateDb VBGdb.Database, stateBloom *trie.SyncBloom,
 			processed: rawdb.ReadFastTrieProgress(stateDb),
 		},
 		trackStateReq: make(chan *stateReq),
+		SnapSyncer:    snap.NewSyncer(stateDb),
 	}
 	go dl.qosTuner()
 	go dl.stateFetcher()
@@ -381,6 +389,15 @@ func (d *Downloader) synchronise(id string, hash common.Hash, td *big.Int, mode
 	if mode == FullSync && d.stateBloom != nil {
 		d.stateBloom.Close()
 	}
+	// If snap sync was requested, switch to fast sync mode with the state being
+	// retrieved via the snap scheduler instead of node by node.
+	if mode == SnapSync {
+		if !d.snapSync {
+			log.Warn("Enabling snapshot sync prototype")
+			d.snapSync = true
+		}
+		mode = FastSync
+	}
 	// Reset the queue, peer set and wake channels to clean any internal leftover state
 	d.queue.Reset(blockCacheMaxItems, blockCacheInitialItems)
 	d.peers.Reset()
@@ -797,6 +814,12 @@ func (d *Downloader) findAncestor(p *peerConnection, remoteHeader *types.Header)
 			floor = int64(d.genesis) - 1
 		}
 	}
+	// Never accept a fork point below the finalized block, as that would revert it
+	if mode != LightSync {
+		if finalized := d.blockchain.CurrentFinalizedHeader(); finalized != nil && floor < finalized.Number.Int64()-1 {
+			floor = finalized.Number.Int64() - 1
+		}
+	}
 
 	from, count, skip, max := calculateRequestSpan(remoteHeight, localHeight)
 
@@ -1721,7 +1744,7 @@ func (d *Downloader) processFastSyncContent() error {
 	}()
 
 	closeOnErr := func(s *stateSync) {
-		if err := s.Wait(); err != nil && err != errCancelStateFetch && err != errCanceled {
+		if err := s.Wait(); err != nil && err != errCancelStateFetch && err != errCanceled && err != snap.ErrCancelled {
 			d.queue.Close() // wake up Results
 		}
 	}
@@ -1929,6 +1952,32 @@ func (d *Downloader) DeliverNodeData(id string, data [][]byte) (err error) {
 	return d.deliver(id, d.stateCh, &statePack{id, data}, stateInMeter, stateDropMeter)
 }
 
+// DeliverSnapPacket is invoked from a peer's message handler when it transmits a
+// data packet for the local node to consume.
+func (d *Downloader) DeliverSnapPacket(peer *snap.Peer, packet snap.Packet) error {
+	switch packet := packet.(type) {
+	case *snap.AccountRangePacket:
+		hashes, accounts, err := packet.Unpack()
+		if err != nil {
+			return err
+		}
+		return d.SnapSyncer.OnAccounts(peer, packet.ID, hashes, accounts, packet.Proof)
+
+	case *snap.StorageRangesPacket:
+		hashset, slotset := packet.Unpack()
+		return d.SnapSyncer.OnStorage(peer, packet.ID, hashset, slotset, packet.Proof)
+
+	case *snap.ByteCodesPacket:
+		return d.SnapSyncer.OnByteCodes(peer, packet.ID, packet.Codes)
+
+	case *snap.TrieNodesPacket:
+		return d.SnapSyncer.OnTrieNodes(peer, packet.ID, packet.Nodes)
+
+	default:
+		return fmt.Errorf("unexpected snap packet type: %T", packet)
+	}
+}
+
 // deliver injects a new batch of data received from a remote node.
 func (d *Downloader) deliver(id string, destCh chan dataPack, packet dataPack, inMeter, dropMeter metrics.Meter) (err error) {
 	// Update the delivery metrics for both good and failed deliveries
@@ -207,6 +207,12 @@ func (dl *downloadTester) CurrentBlock() *types.Block {
 	return dl.genesis
 }
 
+// CurrentFinalizedHeader retrieves the finalized header from the canonical chain,
+// the tester never finalizing any.
+func (dl *downloadTester) CurrentFinalizedHeader() *types.Header {
+	return nil
+}
+
 // CurrentFastBlock retrieves the current head fast-sync block from the canonical chain.
 func (dl *downloadTester) CurrentFastBlock() *types.Block {
 	dl.lock.RLock()
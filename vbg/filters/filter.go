@@ -135,6 +135,18 @@ func (f *Filter) Logs(ctx context.Context) ([]*types.Log, error) {
 	}
 	head := header.Number.Uint64()
 
+	if finalized := rpc.FinalizedBlockNumber.Int64(); f.begin == finalized || f.end == finalized {
+		header, err := f.backend.HeaderByNumber(ctx, rpc.FinalizedBlockNumber)
+		if err != nil {
+			return nil, err
+		}
+		if f.begin == finalized {
+			f.begin = header.Number.Int64()
+		}
+		if f.end == finalized {
+			f.end = header.Number.Int64()
+		}
+	}
 	if f.begin == -1 {
 		f.begin = int64(head)
 	}
@@ -0,0 +1,102 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package VBG
+
+import (
+	"crypto/ecdsa"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/common/hexutil"
+	"github.com/vbgloble/go-VGB/crypto"
+)
+
+var (
+	errNoFinalitySigners      = errors.New("no finality signers configured")
+	errInsufficientSignatures = errors.New("insufficient checkpoint signatures")
+)
+
+// FinalityCheckpoint is a statement by a set of trusted signers that a block,
+// along with all its ancestors, is final.
+type FinalityCheckpoint struct {
+	Number     hexutil.Uint64  `json:"number"`
+	Hash       common.Hash     `json:"hash"`
+	Signatures []hexutil.Bytes `json:"signatures"`
+}
+
+// SigHash returns the hash signed by the checkpoint signers, being the keccak256
+// hash of "\x19Finality checkpoint:\n" followed by the 8 byte big endian block
+// number and the block hash.
+func (c *FinalityCheckpoint) SigHash() common.Hash {
+	var number [8]byte
+	binary.BigEndian.PutUint64(number[:], uint64(c.Number))
+	return crypto.Keccak256Hash([]byte("\x19Finality checkpoint:\n"), number[:], c.Hash[:])
+}
+
+// Sign adds a signature of the checkpoint with the given key.
+func (c *FinalityCheckpoint) Sign(key *ecdsa.PrivateKey) error {
+	sig, err := crypto.Sign(c.SigHash().Bytes(), key)
+	if err != nil {
+		return err
+	}
+	c.Signatures = append(c.Signatures, sig)
+	return nil
+}
+
+// verify checks that the checkpoint is signed by at least threshold distinct
+// accounts of the given signers. A zero threshold requires a majority of them.
+func (c *FinalityCheckpoint) verify(signers []common.Address, threshold int) error {
+	if len(signers) == 0 {
+		return errNoFinalitySigners
+	}
+	if threshold == 0 {
+		threshold = len(signers)/2 + 1
+	}
+	trusted := make(map[common.Address]bool, len(signers))
+	for _, signer := range signers {
+		trusted[signer] = true
+	}
+	var (
+		hash   = c.SigHash()
+		signed = make(map[common.Address]bool)
+	)
+	for i, sig := range c.Signatures {
+		if len(sig) != crypto.SignatureLength {
+			return fmt.Errorf("signature %d: invalid length %d", i, len(sig))
+		}
+		// Accept both the raw and the legacy 27/28 recovery ids
+		sig = common.CopyBytes(sig)
+		if sig[crypto.RecoveryIDOffset] >= 27 {
+			sig[crypto.RecoveryIDOffset] -= 27
+		}
+		pubkey, err := crypto.SigToPub(hash.Bytes(), sig)
+		if err != nil {
+			return fmt.Errorf("signature %d: %v", i, err)
+		}
+		signer := crypto.PubkeyToAddress(*pubkey)
+		if !trusted[signer] {
+			return fmt.Errorf("signature %d: untrusted signer %x", i, signer)
+		}
+		signed[signer] = true
+	}
+	if len(signed) < threshold {
+		return fmt.Errorf("%w: have %d, want %d", errInsufficientSignatures, len(signed), threshold)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package VBG
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"testing"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/crypto"
+)
+
+// Tests that finality checkpoints are only accepted if signed by enough distinct
+// trusted signers.
+func TestFinalityCheckpointVerify(t *testing.T) {
+	var (
+		keys    = make([]*ecdsa.PrivateKey, 4)
+		signers = make([]common.Address, 3)
+	)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		if i < len(signers) {
+			signers[i] = crypto.PubkeyToAddress(keys[i].PublicKey)
+		}
+	}
+	sign := func(keys ...*ecdsa.PrivateKey) *FinalityCheckpoint {
+		checkpoint := &FinalityCheckpoint{Number: 100, Hash: common.Hash{0x01}}
+		for _, key := range keys {
+			if err := checkpoint.Sign(key); err != nil {
+				t.Fatalf("failed to sign checkpoint: %v", err)
+			}
+		}
+		return checkpoint
+	}
+	// Majority of the signers by default
+	if err := sign(keys[0], keys[1]).verify(signers, 0); err != nil {
+		t.Errorf("majority signed checkpoint rejected: %v", err)
+	}
+	if err := sign(keys[0], keys[0]).verify(signers, 0); !errors.Is(err, errInsufficientSignatures) {
+		t.Errorf("duplicate signatures error mismatch: have %v, want %v", err, errInsufficientSignatures)
+	}
+	// Explicit threshold
+	if err := sign(keys[2]).verify(signers, 1); err != nil {
+		t.Errorf("threshold signed checkpoint rejected: %v", err)
+	}
+	if err := sign(keys[0], keys[1]).verify(signers, 3); !errors.Is(err, errInsufficientSignatures) {
+		t.Errorf("under threshold error mismatch: have %v, want %v", err, errInsufficientSignatures)
+	}
+	// Untrusted signers and tampered checkpoints
+	if err := sign(keys[0], keys[3]).verify(signers, 1); err == nil {
+		t.Errorf("checkpoint signed by untrusted account accepted")
+	}
+	checkpoint := sign(keys[0], keys[1])
+	checkpoint.Number++
+	if err := checkpoint.verify(signers, 0); err == nil {
+		t.Errorf("tampered checkpoint accepted")
+	}
+	if err := sign(keys[0]).verify(nil, 0); err != errNoFinalitySigners {
+		t.Errorf("missing signers error mismatch: have %v, want %v", err, errNoFinalitySigners)
+	}
+}
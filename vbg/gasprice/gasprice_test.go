@@ -55,24 +55,53 @@ func (b *testBackend) ChainConfig() *params.ChainConfig {
 	return b.chain.Config()
 }
 
-func newTestBackend(t *testing.T) *testBackend {
+func newTestBackend(t *testing.T, eip1559Block *big.Int) *testBackend {
 	var (
 		key, _ = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
 		addr   = crypto.PubkeyToAddress(key.PublicKey)
+		config = *params.TestChainConfig // needs copy because it is modified below
 		gspec  = &core.Genesis{
-			Config: params.TestChainConfig,
+			Config: &config,
 			Alloc:  core.GenesisAlloc{addr: {Balance: big.NewInt(math.MaxInt64)}},
 		}
-		signer = types.NewEIP155Signer(gspec.Config.ChainID)
 	)
+	if eip1559Block != nil {
+		config.EIP2718Block = big.NewInt(0)
+		config.EIP1559Block = eip1559Block
+	}
+	signer := types.LatestSigner(gspec.Config)
 	engine := VBGash.NewFaker()
 	db := rawdb.NewMemoryDatabase()
 	genesis, _ := gspec.Commit(db)
 
 	// Generate testing blocks
-	blocks, _ := core.GenerateChain(params.TestChainConfig, genesis, engine, db, 32, func(i int, b *core.BlockGen) {
+	blocks, _ := core.GenerateChain(gspec.Config, genesis, engine, db, 32, func(i int, b *core.BlockGen) {
 		b.SetCoinbase(common.Address{1})
-		tx, err := types.SignTx(types.NewTransaction(b.TxNonce(addr), common.HexToAddress("deadbeef"), big.NewInt(100), 21000, big.NewInt(int64(i+1)*params.GWei), nil), signer, key)
+
+		var (
+			txdata types.TxData
+			to     = common.HexToAddress("deadbeef")
+		)
+		if eip1559Block != nil && b.Number().Cmp(eip1559Block) >= 0 {
+			txdata = &types.DynamicFeeTx{
+				ChainID:   gspec.Config.ChainID,
+				Nonce:     b.TxNonce(addr),
+				To:        &to,
+				Value:     big.NewInt(100),
+				Gas:       21000,
+				GasFeeCap: big.NewInt(100 * params.GWei),
+				GasTipCap: big.NewInt(int64(i+1) * params.GWei),
+			}
+		} else {
+			txdata = &types.LegacyTx{
+				Nonce:    b.TxNonce(addr),
+				To:       &to,
+				Value:    big.NewInt(100),
+				Gas:      21000,
+				GasPrice: big.NewInt(int64(i+1) * params.GWei),
+			}
+		}
+		tx, err := types.SignNewTx(key, signer, txdata)
 		if err != nil {
 			t.Fatalf("failed to create tx: %v", err)
 		}
@@ -81,7 +110,7 @@ func newTestBackend(t *testing.T) *testBackend {
 	// Construct testing chain
 	diskdb := rawdb.NewMemoryDatabase()
 	gspec.Commit(diskdb)
-	chain, err := core.NewBlockChain(diskdb, nil, params.TestChainConfig, engine, vm.Config{}, nil, nil)
+	chain, err := core.NewBlockChain(diskdb, nil, gspec.Config, engine, vm.Config{}, nil, nil)
 	if err != nil {
 		t.Fatalf("Failed to create local chain, %v", err)
 	}
@@ -103,7 +132,7 @@ func TestSuggestPrice(t *testing.T) {
 		Percentile: 60,
 		Default:    big.NewInt(params.GWei),
 	}
-	backend := newTestBackend(t)
+	backend := newTestBackend(t, nil)
 	oracle := NewOracle(backend, config)
 
 	// The gas price sampled is: 32G, 31G, 30G, 29G, 28G, 27G
@@ -116,3 +145,36 @@ func TestSuggestPrice(t *testing.T) {
 		t.Fatalf("Gas price mismatch, want %d, got %d", expect, got)
 	}
 }
+
+func TestSuggestPriceEIP1559(t *testing.T) {
+	config := Config{
+		Blocks:     3,
+		Percentile: 60,
+		Default:    big.NewInt(params.GWei),
+	}
+	backend := newTestBackend(t, big.NewInt(16))
+	oracle := NewOracle(backend, config)
+
+	// The tips sampled are: 32G, 31G, 30G, 29G, 28G, 27G
+	tip, err := oracle.SuggestTipCap(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to retrieve recommended tip: %v", err)
+	}
+	expect := big.NewInt(params.GWei * int64(30))
+	if tip.Cmp(expect) != 0 {
+		t.Fatalf("Tip mismatch, want %d, got %d", expect, tip)
+	}
+	// The suggested gas price adds the latest base fee on top
+	got, err := oracle.SuggestPrice(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to retrieve recommended gas price: %v", err)
+	}
+	head := backend.CurrentHeader()
+	if head.BaseFee == nil {
+		t.Fatalf("Missing base fee in head header")
+	}
+	expect = new(big.Int).Add(expect, head.BaseFee)
+	if got.Cmp(expect) != 0 {
+		t.Fatalf("Gas price mismatch, want %d, got %d", expect, got)
+	}
+}
@@ -23,7 +23,13 @@ func (c Config) MarshalTOML() (interface{}, error) {
 		DiscoveryURLs           []string
 		NoPruning               bool
 		NoPrefetch              bool
+		ParallelImport          bool                   `toml:",omitempty"`
+		FinalityDepth           uint64                 `toml:",omitempty"`
+		FinalitySigners         []common.Address       `toml:",omitempty"`
+		FinalityThreshold       int                    `toml:",omitempty"`
 		TxLookupLimit           uint64                 `toml:",omitempty"`
+		HistoryLimit            uint64                 `toml:",omitempty"`
+		TraceIndex              bool                   `toml:",omitempty"`
 		Whitelist               map[uint64]common.Hash `toml:"-"`
 		LightServ               int                    `toml:",omitempty"`
 		LightIngress            int                    `toml:",omitempty"`
@@ -37,6 +43,7 @@ func (c Config) MarshalTOML() (interface{}, error) {
 		DatabaseHandles         int                    `toml:"-"`
 		DatabaseCache           int
 		DatabaseFreezer         string
+		DatabaseReadOnly        bool `toml:",omitempty"`
 		TrieCleanCache          int
 		TrieCleanCacheJournal   string        `toml:",omitempty"`
 		TrieCleanCacheRejournal time.Duration `toml:",omitempty"`
@@ -64,7 +71,13 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.DiscoveryURLs = c.DiscoveryURLs
 	enc.NoPruning = c.NoPruning
 	enc.NoPrefetch = c.NoPrefetch
+	enc.ParallelImport = c.ParallelImport
+	enc.FinalityDepth = c.FinalityDepth
+	enc.FinalitySigners = c.FinalitySigners
+	enc.FinalityThreshold = c.FinalityThreshold
 	enc.TxLookupLimit = c.TxLookupLimit
+	enc.HistoryLimit = c.HistoryLimit
+	enc.TraceIndex = c.TraceIndex
 	enc.Whitelist = c.Whitelist
 	enc.LightServ = c.LightServ
 	enc.LightIngress = c.LightIngress
@@ -78,6 +91,7 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.DatabaseHandles = c.DatabaseHandles
 	enc.DatabaseCache = c.DatabaseCache
 	enc.DatabaseFreezer = c.DatabaseFreezer
+	enc.DatabaseReadOnly = c.DatabaseReadOnly
 	enc.TrieCleanCache = c.TrieCleanCache
 	enc.TrieCleanCacheJournal = c.TrieCleanCacheJournal
 	enc.TrieCleanCacheRejournal = c.TrieCleanCacheRejournal
@@ -109,7 +123,13 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 		DiscoveryURLs           []string
 		NoPruning               *bool
 		NoPrefetch              *bool
+		ParallelImport          *bool                  `toml:",omitempty"`
+		FinalityDepth           *uint64                `toml:",omitempty"`
+		FinalitySigners         []common.Address       `toml:",omitempty"`
+		FinalityThreshold       *int                   `toml:",omitempty"`
 		TxLookupLimit           *uint64                `toml:",omitempty"`
+		HistoryLimit            *uint64                `toml:",omitempty"`
+		TraceIndex              *bool                  `toml:",omitempty"`
 		Whitelist               map[uint64]common.Hash `toml:"-"`
 		LightServ               *int                   `toml:",omitempty"`
 		LightIngress            *int                   `toml:",omitempty"`
@@ -123,6 +143,7 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 		DatabaseHandles         *int                   `toml:"-"`
 		DatabaseCache           *int
 		DatabaseFreezer         *string
+		DatabaseReadOnly        *bool `toml:",omitempty"`
 		TrieCleanCache          *int
 		TrieCleanCacheJournal   *string        `toml:",omitempty"`
 		TrieCleanCacheRejournal *time.Duration `toml:",omitempty"`
@@ -165,9 +186,27 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.NoPrefetch != nil {
 		c.NoPrefetch = *dec.NoPrefetch
 	}
+	if dec.ParallelImport != nil {
+		c.ParallelImport = *dec.ParallelImport
+	}
+	if dec.FinalityDepth != nil {
+		c.FinalityDepth = *dec.FinalityDepth
+	}
+	if dec.FinalitySigners != nil {
+		c.FinalitySigners = dec.FinalitySigners
+	}
+	if dec.FinalityThreshold != nil {
+		c.FinalityThreshold = *dec.FinalityThreshold
+	}
 	if dec.TxLookupLimit != nil {
 		c.TxLookupLimit = *dec.TxLookupLimit
 	}
+	if dec.HistoryLimit != nil {
+		c.HistoryLimit = *dec.HistoryLimit
+	}
+	if dec.TraceIndex != nil {
+		c.TraceIndex = *dec.TraceIndex
+	}
 	if dec.Whitelist != nil {
 		c.Whitelist = dec.Whitelist
 	}
@@ -207,6 +246,9 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.DatabaseFreezer != nil {
 		c.DatabaseFreezer = *dec.DatabaseFreezer
 	}
+	if dec.DatabaseReadOnly != nil {
+		c.DatabaseReadOnly = *dec.DatabaseReadOnly
+	}
 	if dec.TrieCleanCache != nil {
 		c.TrieCleanCache = *dec.TrieCleanCache
 	}
@@ -33,6 +33,7 @@ import (
 	"github.com/vbgloble/go-VGB/core/types"
 	"github.com/vbgloble/go-VGB/VBG/downloader"
 	"github.com/vbgloble/go-VGB/VBG/fetcher"
+	"github.com/vbgloble/go-VGB/VBG/protocols/snap"
 	"github.com/vbgloble/go-VGB/VBGdb"
 	"github.com/vbgloble/go-VGB/event"
 	"github.com/vbgloble/go-VGB/log"
@@ -65,6 +66,7 @@ type ProtocolManager struct {
 	forkFilter forkid.Filter // Fork ID filter, constant across the lifetime of the node
 
 	fastSync  uint32 // Flag whVBGer fast sync is enabled (gets disabled if we already have blocks)
+	snapSync  uint32 // Flag whVBGer fast sync should operate on top of the snap protocol
 	acceptTxs uint32 // Flag whVBGer we're considered synchronised (enables transaction processing)
 
 	checkpointNumber uint64      // Block number for the sync progress validator to cross reference
@@ -80,6 +82,9 @@ type ProtocolManager struct {
 	txFetcher    *fetcher.TxFetcher
 	peers        *peerSet
 
+	snapPeers map[string]*snap.Peer // Peers connected on the `snap` protocol
+	snapLock  sync.RWMutex          // Lock protecting the snap peer set
+
 	eventMux      *event.TypeMux
 	txsCh         chan core.NewTxsEvent
 	txsSub        event.Subscription
@@ -111,6 +116,7 @@ func NewProtocolManager(config *params.ChainConfig, checkpoint *params.TrustedCh
 		blockchain: blockchain,
 		chaindb:    chaindb,
 		peers:      newPeerSet(),
+		snapPeers:  make(map[string]*snap.Peer),
 		whitelist:  whitelist,
 		txsyncCh:   make(chan *txsync),
 		quitSync:   make(chan struct{}),
@@ -137,6 +143,9 @@ func NewProtocolManager(config *params.ChainConfig, checkpoint *params.TrustedCh
 		} else {
 			// If fast sync was requested and our database is empty, grant it
 			manager.fastSync = uint32(1)
+			if mode == downloader.SnapSync {
+				manager.snapSync = uint32(1)
+			}
 		}
 	}
 
@@ -777,9 +786,9 @@ func (pm *ProtocolManager) handleMsg(p *peer) error {
 			} else if err != nil {
 				return errResp(ErrDecode, "msg %v: %v", msg, err)
 			}
-			// Retrieve the requested transaction, skipping if unknown to us
+			// Retrieve the requested transaction, skipping if unknown to us or private
 			tx := pm.txpool.Get(hash)
-			if tx == nil {
+			if tx == nil || pm.txpool.IsPrivate(hash) {
 				continue
 			}
 			// If known, encode and queue for response packet
@@ -0,0 +1,82 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package VBG
+
+import (
+	"github.com/vbgloble/go-VGB/core"
+	"github.com/vbgloble/go-VGB/VBG/protocols/snap"
+	"github.com/vbgloble/go-VGB/p2p/enode"
+)
+
+// snapPeerInfo represents a short summary of the `snap` sub-protocol metadata known
+// about a connected peer.
+type snapPeerInfo struct {
+	Version uint `json:"version"` // Snapshot protocol version negotiated
+}
+
+// snapHandler implements the snap.Backend interface to handle the various network
+// packets that are sent as replies or broadcasts.
+type snapHandler ProtocolManager
+
+// Chain retrieves the blockchain object to serve data.
+func (h *snapHandler) Chain() *core.BlockChain { return h.blockchain }
+
+// RunPeer is invoked when a peer joins on the `snap` protocol. The peer is made
+// available to the snap syncer for the duration of the connection.
+func (h *snapHandler) RunPeer(peer *snap.Peer, handler snap.Handler) error {
+	h.peerWG.Add(1)
+	defer h.peerWG.Done()
+
+	h.snapLock.Lock()
+	if _, ok := h.snapPeers[peer.ID()]; ok {
+		h.snapLock.Unlock()
+		return errAlreadyRegistered
+	}
+	h.snapPeers[peer.ID()] = peer
+	h.snapLock.Unlock()
+
+	defer func() {
+		h.snapLock.Lock()
+		delete(h.snapPeers, peer.ID())
+		h.snapLock.Unlock()
+	}()
+	if err := h.downloader.SnapSyncer.Register(peer); err != nil {
+		peer.Log().Error("Snapshot peer registration failed", "err", err)
+		return err
+	}
+	defer h.downloader.SnapSyncer.Unregister(peer.ID())
+
+	// Handle incoming messages until the connection is torn down
+	return handler(peer)
+}
+
+// PeerInfo retrieves all known `snap` information about a peer.
+func (h *snapHandler) PeerInfo(id enode.ID) interface{} {
+	h.snapLock.RLock()
+	defer h.snapLock.RUnlock()
+
+	if p, ok := h.snapPeers[id.String()]; ok {
+		return &snapPeerInfo{Version: p.Version()}
+	}
+	return nil
+}
+
+// Handle is invoked from a peer's message handler when it receives a new remote
+// message that the handler couldn't consume and serve itself.
+func (h *snapHandler) Handle(peer *snap.Peer, packet snap.Packet) error {
+	return h.downloader.DeliverSnapPacket(peer, packet)
+}
@@ -94,6 +94,8 @@ type testTxPool struct {
 	pool   map[common.Hash]*types.Transaction // Hash map of collected transactions
 	added  chan<- []*types.Transaction        // Notification channel for new transactions
 
+	private map[common.Hash]bool // Transactions withheld from the network
+
 	lock sync.RWMutex // Protects the transaction pool
 }
 
@@ -147,6 +149,14 @@ func (p *testTxPool) Pending() (map[common.Address]types.Transactions, error) {
 	return batches, nil
 }
 
+// IsPrivate returns whVBGer the transaction is withheld from the network.
+func (p *testTxPool) IsPrivate(hash common.Hash) bool {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	return p.private[hash]
+}
+
 func (p *testTxPool) SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.Subscription {
 	return p.txFeed.Subscribe(ch)
 }
@@ -118,6 +118,10 @@ type txPool interface {
 	// The slice should be modifiable by the caller.
 	Pending() (map[common.Address]types.Transactions, error)
 
+	// IsPrivate returns whVBGer the transaction with the given hash must
+	// not be announced or propagated to the network.
+	IsPrivate(hash common.Hash) bool
+
 	// SubscribeNewTxsEvent should return an event subscription of
 	// NewTxsEvent and send events to the given channel.
 	SubscribeNewTxsEvent(chan<- core.NewTxsEvent) event.Subscription
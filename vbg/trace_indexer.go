@@ -0,0 +1,220 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package VBG
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/core"
+	"github.com/vbgloble/go-VGB/core/rawdb"
+	"github.com/vbgloble/go-VGB/core/state"
+	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/VBGdb"
+	"github.com/vbgloble/go-VGB/log"
+	"github.com/vbgloble/go-VGB/trie"
+)
+
+const (
+	// traceIndexSectionSize is the number of blocks in a single trace index section.
+	traceIndexSectionSize = 128
+
+	// traceIndexConfirms is the number of confirmation blocks before a trace
+	// index section is considered final and processed.
+	traceIndexConfirms = 64
+
+	// traceIndexThrottling is the time to wait between processing two consecutive
+	// index sections.
+	traceIndexThrottling = 100 * time.Millisecond
+
+	// traceIndexMemoryLimit is the amount of dirty trie nodes the running state
+	// of the indexer may accumulate before it's dropped and reloaded.
+	traceIndexMemoryLimit = 256 * 1024 * 1024
+
+	// traceIndexTimeout is the amount of time a single transaction can execute
+	// while being traced by the indexer.
+	traceIndexTimeout = "1m"
+)
+
+// TraceIndexer implements a core.ChainIndexer, persisting the Parity style
+// traces of every canonical block along with an index of the addresses they
+// touch, so that trace_filter can be served without reexecution.
+//
+// The indexer keeps the state of the last processed block around and applies
+// consecutive blocks on top of it, so historical state is only needed when
+// (re)starting a section, not for every block.
+type TraceIndexer struct {
+	api *PrivateTraceAPI
+	db  VBGdb.Database // Database instance to write index data and metadata into
+
+	batch    VBGdb.Batch    // Pending index data of the section being processed
+	database state.Database // Private state database holding the running state
+	statedb  *state.StateDB // State after the last processed block
+	head     common.Hash    // Hash of the last processed block
+	root     common.Hash    // Referenced state root in the private database
+}
+
+// NewTraceIndexer returns a chain indexer that persists the transaction traces
+// of the canonical chain.
+func NewTraceIndexer(VBG *vbgloble, size, confirms uint64) *core.ChainIndexer {
+	backend := &TraceIndexer{
+		api:      NewPrivateTraceAPI(VBG),
+		db:       VBG.chainDb,
+		database: state.NewDatabaseWithConfig(VBG.chainDb, &trie.Config{Cache: 16}),
+	}
+	table := rawdb.NewTable(VBG.chainDb, string(rawdb.TraceIndexPrefix))
+
+	return core.NewChainIndexer(VBG.chainDb, table, backend, size, confirms, traceIndexThrottling, "traces")
+}
+
+// release drops the running state of the indexer.
+func (t *TraceIndexer) release() {
+	if t.root != (common.Hash{}) {
+		t.database.TrieDB().Dereference(t.root)
+	}
+	t.statedb, t.head, t.root = nil, common.Hash{}, common.Hash{}
+}
+
+// Reset implements core.ChainIndexerBackend, starting a new trace index section.
+func (t *TraceIndexer) Reset(ctx context.Context, section uint64, lastSectionHead common.Hash) error {
+	if lastSectionHead != t.head {
+		t.release()
+	}
+	t.batch = t.db.NewBatch()
+	return nil
+}
+
+// Process implements core.ChainIndexerBackend, tracing a new block and adding
+// its traces into the index.
+func (t *TraceIndexer) Process(ctx context.Context, header *types.Header) error {
+	var (
+		bc     = t.api.VBG.blockchain
+		number = header.Number.Uint64()
+		hash   = header.Hash()
+	)
+	block := bc.GetBlock(hash, number)
+	if block == nil {
+		return fmt.Errorf("block #%d [%x…] not found", number, hash[:4])
+	}
+	// The genesis block has no transactions, only set up the running state
+	if number == 0 {
+		t.release()
+		if statedb, err := state.New(header.Root, t.database, nil); err == nil {
+			t.statedb, t.head = statedb, hash
+		}
+		return t.store(block, []*ParityTrace{})
+	}
+	// Retrieve the parent state, either the running one or from the database
+	statedb := t.statedb
+	if statedb == nil || t.head != header.ParentHash {
+		t.release()
+
+		parent := bc.GetBlock(header.ParentHash, number-1)
+		if parent == nil {
+			return fmt.Errorf("parent %#x not found", header.ParentHash)
+		}
+		var err error
+		if statedb, err = state.New(parent.Root(), t.database, nil); err != nil {
+			if statedb, err = t.api.debug.computeStateDB(parent, defaultTraceReexec); err != nil {
+				return err
+			}
+		}
+	}
+	// Trace all the transactions and apply the block rewards on top
+	tracer, timeout := parityCallTracer, traceIndexTimeout
+	results, err := t.api.debug.traceBlockState(ctx, block, statedb, &TraceConfig{Tracer: &tracer, Timeout: &timeout})
+	if err != nil {
+		t.release()
+		return err
+	}
+	traces, err := t.api.parityTraces(block, results)
+	if err != nil {
+		t.release()
+		return err
+	}
+	t.api.VBG.engine.Finalize(bc, types.CopyHeader(header), statedb, block.Transactions(), block.Uncles())
+
+	// Commit the state so it can be reused for the next block
+	root, err := statedb.Commit(bc.Config().IsEIP158(header.Number))
+	if err != nil {
+		t.release()
+		return err
+	}
+	if root != header.Root {
+		t.release()
+		return fmt.Errorf("state root mismatch in block #%d: have %x, want %x", number, root, header.Root)
+	}
+	if err := statedb.Reset(root); err != nil {
+		t.release()
+		return err
+	}
+	if statedb.Database() == t.database {
+		t.database.TrieDB().Reference(root, common.Hash{})
+		if t.root != (common.Hash{}) {
+			t.database.TrieDB().Dereference(t.root)
+		}
+		t.root = root
+	} else {
+		t.release()
+	}
+	t.statedb, t.head = statedb, hash
+
+	return t.store(block, traces)
+}
+
+// store adds the traces of a block and the addresses they touch into the
+// pending index batch.
+func (t *TraceIndexer) store(block *types.Block, traces []*ParityTrace) error {
+	blob, err := json.Marshal(traces)
+	if err != nil {
+		return err
+	}
+	rawdb.WriteBlockTraces(t.batch, block.Hash(), block.NumberU64(), blob)
+
+	for _, trace := range traces {
+		from, to := trace.fromTo()
+		if from != nil {
+			rawdb.WriteTraceAddress(t.batch, *from, block.NumberU64())
+		}
+		if to != nil {
+			rawdb.WriteTraceAddress(t.batch, *to, block.NumberU64())
+		}
+	}
+	return nil
+}
+
+// Commit implements core.ChainIndexerBackend, writing the traces of the section
+// out into the database.
+func (t *TraceIndexer) Commit() error {
+	if err := t.batch.Write(); err != nil {
+		return err
+	}
+	// Drop the running state if it accumulated too many dirty trie nodes
+	if nodes, imgs := t.database.TrieDB().Size(); nodes+imgs > traceIndexMemoryLimit {
+		log.Debug("Dropping trace indexer state", "memory", nodes+imgs)
+		t.release()
+	}
+	return nil
+}
+
+// Prune returns an empty error since we don't support pruning here.
+func (t *TraceIndexer) Prune(threshold uint64) error {
+	return nil
+}
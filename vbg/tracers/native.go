@@ -0,0 +1,126 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/vbgloble/go-VGB/core/vm"
+	"github.com/vbgloble/go-VGB/log"
+)
+
+// ResultTracer is a vm.Tracer which assembles a JSON result out of the traced
+// execution and which can be interrupted from the outside. Both the JavaScript
+// tracer and the native Go tracers implement it.
+type ResultTracer interface {
+	vm.Tracer
+
+	// GetResult returns the JSON encoded result of the trace, or any error
+	// encountered while tracing.
+	GetResult() (json.RawMessage, error)
+
+	// Stop terminates execution of the tracer at the first opportune moment.
+	Stop(err error)
+}
+
+// native contains all the built in Go tracers by name.
+var native = make(map[string]func() ResultTracer)
+
+// RegisterNative makes a Go tracer available under the given name. Native
+// tracers take precedence over JavaScript tracers of the same name.
+func RegisterNative(name string, ctor func() ResultTracer) {
+	native[name] = ctor
+}
+
+// NewTracer creates the tracer identified by code. If a native tracer was
+// registered under that name it is returned, otherwise code is handed to the
+// JavaScript engine, either as the name of a built in tracer or as a custom
+// tracer snippet.
+func NewTracer(code string) (ResultTracer, error) {
+	if ctor, ok := native[code]; ok {
+		return ctor(), nil
+	}
+	tracer, err := New(code)
+	if err != nil {
+		return nil, err
+	}
+	return tracer, nil
+}
+
+// interrupter implements the Stop mVBGod of the native tracers.
+type interrupter struct {
+	interrupt uint32 // Atomic flag to signal execution interruption
+	reason    error  // Textual reason for the interruption
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (i *interrupter) Stop(err error) {
+	i.reason = err
+	atomic.StoreUint32(&i.interrupt, 1)
+}
+
+// interrupted reports whVBGer the tracer was stopped, aborting the EVM if so.
+func (i *interrupter) interrupted(env *vm.EVM) bool {
+	if atomic.LoadUint32(&i.interrupt) > 0 {
+		env.Cancel()
+		return true
+	}
+	return false
+}
+
+// err returns the reason of the interruption, if any.
+func (i *interrupter) err() error {
+	if atomic.LoadUint32(&i.interrupt) > 0 {
+		return i.reason
+	}
+	return nil
+}
+
+// peek returns the nth-from-the-top element of the stack. Out of bound accesses
+// yield zero, similarly to the JavaScript tracer.
+func peek(stack *vm.Stack, idx int) *big.Int {
+	if len(stack.Data()) <= idx || idx < 0 {
+		log.Warn("Tracer accessed out of bound stack", "size", len(stack.Data()), "index", idx)
+		return new(big.Int)
+	}
+	return stack.Back(idx).ToBig()
+}
+
+// peekUint64 returns the nth-from-the-top element of the stack, saturated to
+// 64 bits so that oversized memory offsets are treated as out of bound.
+func peekUint64(stack *vm.Stack, idx int) uint64 {
+	val := peek(stack, idx)
+	if !val.IsUint64() {
+		return ^uint64(0)
+	}
+	return val.Uint64()
+}
+
+// memorySlice returns a copy of the requested range of memory. Out of bound
+// accesses yield nil, similarly to the JavaScript tracer.
+func memorySlice(memory *vm.Memory, begin, end uint64) []byte {
+	if end == begin {
+		return []byte{}
+	}
+	if end < begin || uint64(memory.Len()) < end {
+		log.Warn("Tracer accessed out of bound memory", "available", memory.Len(), "offset", begin, "end", end)
+		return nil
+	}
+	return memory.GetCopy(int64(begin), int64(end-begin))
+}
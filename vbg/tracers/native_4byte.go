@@ -0,0 +1,107 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/common/hexutil"
+	"github.com/vbgloble/go-VGB/core/vm"
+)
+
+func init() {
+	RegisterNative("4byteTracer", func() ResultTracer { return newFourByteTracer() })
+}
+
+// fourByteTracer is a native Go port of the JavaScript 4byteTracer, which
+// aggregates the 4byte mVBGod identifiers found in the calldata of all the
+// calls made by a transaction, along with the size of the supplied data.
+type fourByteTracer struct {
+	interrupter
+
+	ids map[string]int // Number of occurrences of each identifier and data size
+}
+
+// newFourByteTracer creates a native 4byte tracer.
+func newFourByteTracer() *fourByteTracer {
+	return &fourByteTracer{ids: make(map[string]int)}
+}
+
+// store saves the given identifier and data size.
+func (t *fourByteTracer) store(id []byte, size uint64) {
+	t.ids[hexutil.Encode(id)+"-"+strconv.FormatUint(size, 10)]++
+}
+
+// CaptureStart implements the Tracer interface to initialize the tracing operation.
+func (t *fourByteTracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	// Save the outer calldata also
+	if len(input) >= 4 {
+		t.store(input[:4], uint64(len(input)-4))
+	}
+	return nil
+}
+
+// CaptureState implements the Tracer interface to trace a single step of VM execution.
+func (t *fourByteTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, rStack *vm.ReturnStack, rData []byte, contract *vm.Contract, depth int, err error) error {
+	if t.interrupted(env) {
+		return nil
+	}
+	// Skip any opcodes that are not internal calls, otherwise find the stack
+	// position of the input memory offset
+	var ct int
+	switch op {
+	case vm.CALL, vm.CALLCODE:
+		ct = 3 // gas, addr, val, memin, meminsz, memout, memoutsz
+	case vm.DELEGATECALL, vm.STATICCALL:
+		ct = 2 // gas, addr, memin, meminsz, memout, memoutsz
+	default:
+		return nil
+	}
+	// Skip any pre-compile invocations, those are just fancy opcodes
+	if _, ok := vm.PrecompiledContractsIstanbul[common.BigToAddress(peek(stack, 1))]; ok {
+		return nil
+	}
+	// Gather internal call details
+	if inSz := peekUint64(stack, ct+1); inSz >= 4 {
+		inOff := peekUint64(stack, ct)
+		t.store(memorySlice(memory, inOff, inOff+4), inSz-4)
+	}
+	return nil
+}
+
+// CaptureFault implements the Tracer interface to trace an execution fault
+// while running an opcode.
+func (t *fourByteTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, rStack *vm.ReturnStack, contract *vm.Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *fourByteTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	return nil
+}
+
+// GetResult returns the JSON encoded identifier counts of the traced transaction.
+func (t *fourByteTracer) GetResult() (json.RawMessage, error) {
+	if err := t.err(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(t.ids)
+}
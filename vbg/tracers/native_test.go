@@ -0,0 +1,144 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/core"
+	"github.com/vbgloble/go-VGB/core/rawdb"
+	"github.com/vbgloble/go-VGB/core/types"
+	"github.com/vbgloble/go-VGB/core/vm"
+	"github.com/vbgloble/go-VGB/rlp"
+	"github.com/vbgloble/go-VGB/tests"
+)
+
+// runTracerTest executes the transaction of a tracer test case with the given
+// tracer attached and returns the decoded trace result.
+func runTracerTest(t *testing.T, test *callTracerTest, tracer ResultTracer) interface{} {
+	tx := new(types.Transaction)
+	if err := rlp.DecodeBytes(common.FromHex(test.Input), tx); err != nil {
+		t.Fatalf("failed to parse testcase input: %v", err)
+	}
+	signer := types.MakeSigner(test.Genesis.Config, new(big.Int).SetUint64(uint64(test.Context.Number)))
+	origin, _ := signer.Sender(tx)
+	txContext := vm.TxContext{
+		Origin:   origin,
+		GasPrice: tx.GasPrice(),
+	}
+	context := vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		Coinbase:    test.Context.Miner,
+		BlockNumber: new(big.Int).SetUint64(uint64(test.Context.Number)),
+		Time:        new(big.Int).SetUint64(uint64(test.Context.Time)),
+		Difficulty:  (*big.Int)(test.Context.Difficulty),
+		GasLimit:    uint64(test.Context.GasLimit),
+	}
+	_, statedb := tests.MakePreState(rawdb.NewMemoryDatabase(), test.Genesis.Alloc, false)
+	evm := vm.NewEVM(context, txContext, statedb, test.Genesis.Config, vm.Config{Debug: true, Tracer: tracer})
+
+	msg, err := tx.AsMessage(signer, nil)
+	if err != nil {
+		t.Fatalf("failed to prepare transaction for tracing: %v", err)
+	}
+	st := core.NewStateTransition(evm, msg, new(core.GasPool).AddGas(tx.Gas()))
+	if _, err = st.TransitionDb(); err != nil {
+		t.Fatalf("failed to execute transaction: %v", err)
+	}
+	res, err := tracer.GetResult()
+	if err != nil {
+		t.Fatalf("failed to retrieve trace result: %v", err)
+	}
+	var ret interface{}
+	if err := json.Unmarshal(res, &ret); err != nil {
+		t.Fatalf("failed to unmarshal trace result: %v", err)
+	}
+	// Execution time naturally differs between runs
+	if obj, ok := ret.(map[string]interface{}); ok {
+		delete(obj, "time")
+	}
+	return ret
+}
+
+// Tests that the native tracers produce the same output as their JavaScript
+// counterparts over all the datasets in the tracer test harness.
+func TestNativeTracers(t *testing.T) {
+	files, err := ioutil.ReadDir("testdata")
+	if err != nil {
+		t.Fatalf("failed to retrieve tracer test suite: %v", err)
+	}
+	for name := range native {
+		for _, file := range files {
+			if !strings.HasPrefix(file.Name(), "call_tracer_") {
+				continue
+			}
+			name, file := name, file // capture range variables
+			t.Run(name+"/"+camel(strings.TrimSuffix(strings.TrimPrefix(file.Name(), "call_tracer_"), ".json")), func(t *testing.T) {
+				t.Parallel()
+
+				blob, err := ioutil.ReadFile(filepath.Join("testdata", file.Name()))
+				if err != nil {
+					t.Fatalf("failed to read testcase: %v", err)
+				}
+				test := new(callTracerTest)
+				if err := json.Unmarshal(blob, test); err != nil {
+					t.Fatalf("failed to parse testcase: %v", err)
+				}
+				jst, err := New(name)
+				if err != nil {
+					t.Fatalf("failed to create JavaScript tracer: %v", err)
+				}
+				nt, err := NewTracer(name)
+				if err != nil {
+					t.Fatalf("failed to create native tracer: %v", err)
+				}
+				if _, ok := nt.(*Tracer); ok {
+					t.Fatalf("native tracer %s resolved to JavaScript", name)
+				}
+				want := runTracerTest(t, test, jst)
+				have := runTracerTest(t, test, nt)
+				if !reflect.DeepEqual(have, want) {
+					haveJSON, _ := json.MarshalIndent(have, "", " ")
+					wantJSON, _ := json.MarshalIndent(want, "", " ")
+					t.Fatalf("trace mismatch: \nhave %s\nwant %s", haveJSON, wantJSON)
+				}
+			})
+		}
+	}
+}
+
+// Tests that custom tracer code still falls back to the JavaScript engine.
+func TestNewTracerFallback(t *testing.T) {
+	tracer, err := NewTracer("{depths: [], step: function(log) { this.depths.push(log.getDepth()); }, fault: function() {}, result: function() { return this.depths; }}")
+	if err != nil {
+		t.Fatalf("failed to create tracer: %v", err)
+	}
+	if _, ok := tracer.(*Tracer); !ok {
+		t.Fatalf("custom tracer type mismatch: have %T, want %T", tracer, new(Tracer))
+	}
+	if _, err := NewTracer("bogus"); err == nil {
+		t.Fatalf("expected error for invalid tracer code")
+	}
+}
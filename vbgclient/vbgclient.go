@@ -28,7 +28,6 @@ import (
 	"github.com/vbgloble/go-VGB/common"
 	"github.com/vbgloble/go-VGB/common/hexutil"
 	"github.com/vbgloble/go-VGB/core/types"
-	"github.com/vbgloble/go-VGB/rlp"
 	"github.com/vbgloble/go-VGB/rpc"
 )
 
@@ -521,7 +520,7 @@ func (ec *Client) EstimateGas(ctx context.Context, msg vbgloble.CallMsg) (uint64
 // If the transaction was a contract creation use the TransactionReceipt mVBGod to get the
 // contract address after the transaction has been mined.
 func (ec *Client) SendTransaction(ctx context.Context, tx *types.Transaction) error {
-	data, err := rlp.EncodeToBytes(tx)
+	data, err := tx.MarshalBinary()
 	if err != nil {
 		return err
 	}
@@ -545,5 +544,8 @@ func toCallArg(msg vbgloble.CallMsg) interface{} {
 	if msg.GasPrice != nil {
 		arg["gasPrice"] = (*hexutil.Big)(msg.GasPrice)
 	}
+	if msg.AccessList != nil {
+		arg["accessList"] = msg.AccessList
+	}
 	return arg
 }
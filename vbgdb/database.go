@@ -81,6 +81,10 @@ type AncientReader interface {
 
 	// AncientSize returns the ancient size of the specified category.
 	AncientSize(kind string) (uint64, error)
+
+	// Tail returns the number of the first ancient item whose block history has
+	// not been pruned from the ancient store.
+	Tail() (uint64, error)
 }
 
 // AncientWriter contains the mVBGods required to write to immutable ancient data.
@@ -92,6 +96,10 @@ type AncientWriter interface {
 	// TruncateAncients discards all but the first n ancient data from the ancient store.
 	TruncateAncients(n uint64) error
 
+	// TruncateTail discards the block history of the first n ancient items from
+	// the ancient store, retaining the header chain.
+	TruncateTail(n uint64) error
+
 	// Sync flushes all in-memory ancient store data to disk.
 	Sync() error
 }
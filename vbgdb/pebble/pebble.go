@@ -0,0 +1,512 @@
+// Copyright 2020 The go-VGB Authors
+// This file is part of the go-VGB library.
+//
+// The go-VGB library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-VGB library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-VGB library. If not, see <http://www.gnu.org/licenses/>.
+
+// +build arm64 amd64
+// +build !openbsd
+
+// Package pebble implements the key-value database layer based on Pebble.
+package pebble
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/bloom"
+	"github.com/vbgloble/go-VGB/common"
+	"github.com/vbgloble/go-VGB/VBGdb"
+	"github.com/vbgloble/go-VGB/log"
+	"github.com/vbgloble/go-VGB/metrics"
+)
+
+const (
+	// minCache is the minimum amount of memory in megabytes to allocate to pebble
+	// read and write caching, split half and half.
+	minCache = 16
+
+	// minHandles is the minimum number of files handles to allocate to the open
+	// database files.
+	minHandles = 16
+
+	// metricsGatheringInterval specifies the interval to retrieve pebble database
+	// compaction, io and pause stats to report to the user.
+	metricsGatheringInterval = 3 * time.Second
+)
+
+// Database is a persistent key-value store based on the pebble storage engine.
+// Apart from basic data storage functionality it also supports batch writes and
+// iterating over the keyspace in binary-alphabetical order.
+type Database struct {
+	fn string     // filename for reporting
+	db *pebble.DB // Underlying pebble storage engine
+
+	compTimeMeter      metrics.Meter // Meter for measuring the total time spent in database compaction
+	compReadMeter      metrics.Meter // Meter for measuring the data read during compaction
+	compWriteMeter     metrics.Meter // Meter for measuring the data written during compaction
+	writeDelayNMeter   metrics.Meter // Meter for measuring the write delay number due to database compaction
+	writeDelayMeter    metrics.Meter // Meter for measuring the write delay duration due to database compaction
+	diskSizeGauge      metrics.Gauge // Gauge for tracking the size of all the levels in the database
+	diskReadMeter      metrics.Meter // Meter for measuring the effective amount of data read
+	diskWriteMeter     metrics.Meter // Meter for measuring the effective amount of data written
+	memCompGauge       metrics.Gauge // Gauge for tracking the number of memory compaction
+	level0CompGauge    metrics.Gauge // Gauge for tracking the number of table compaction in level0
+	nonlevel0CompGauge metrics.Gauge // Gauge for tracking the number of table compaction in non0 level
+	seekCompGauge      metrics.Gauge // Gauge for tracking the number of table compaction caused by read opt
+
+	quitLock sync.Mutex      // Mutex protecting the quit channel access
+	quitChan chan chan error // Quit channel to stop the metrics collection before closing the database
+
+	log log.Logger // Contextual logger tracking the database path
+
+	activeComp    int       // Current number of active compactions
+	compStartTime time.Time // The start time of the earliest currently-active compaction
+	compTime      int64     // Total time spent in compaction in ns (atomic)
+	level0Comp    uint32    // Total number of level-zero compactions (atomic)
+	nonLevel0Comp uint32    // Total number of non level-zero compactions (atomic)
+
+	writeDelayStartTime time.Time // The start time of the latest write stall
+	writeDelayCount     int64     // Total number of write stall counts (atomic)
+	writeDelayTime      int64     // Total time spent in write stalls in ns (atomic)
+}
+
+// onCompactionBegin is the pebble event hook invoked when a compaction starts.
+func (db *Database) onCompactionBegin(info pebble.CompactionInfo) {
+	if db.activeComp == 0 {
+		db.compStartTime = time.Now()
+	}
+	if info.Input[0].Level == 0 {
+		atomic.AddUint32(&db.level0Comp, 1)
+	} else {
+		atomic.AddUint32(&db.nonLevel0Comp, 1)
+	}
+	db.activeComp++
+}
+
+// onCompactionEnd is the pebble event hook invoked when a compaction finishes.
+func (db *Database) onCompactionEnd(info pebble.CompactionInfo) {
+	if db.activeComp == 1 {
+		atomic.AddInt64(&db.compTime, int64(time.Since(db.compStartTime)))
+	}
+	if db.activeComp > 0 {
+		db.activeComp--
+	}
+}
+
+// onWriteStallBegin is the pebble event hook invoked when writes are stalled.
+func (db *Database) onWriteStallBegin(b pebble.WriteStallBeginInfo) {
+	db.writeDelayStartTime = time.Now()
+	atomic.AddInt64(&db.writeDelayCount, 1)
+}
+
+// onWriteStallEnd is the pebble event hook invoked when writes are resumed.
+func (db *Database) onWriteStallEnd() {
+	atomic.AddInt64(&db.writeDelayTime, int64(time.Since(db.writeDelayStartTime)))
+}
+
+// New returns a wrapped pebble DB object. The namespace is the prefix that the
+// metrics reporting should use for surfacing internal stats. A read-only
+// database rejects all writes.
+func New(file string, cache int, handles int, namespace string, readonly bool) (*Database, error) {
+	// Ensure we have some minimal caching and file guarantees
+	if cache < minCache {
+		cache = minCache
+	}
+	if handles < minHandles {
+		handles = minHandles
+	}
+	logger := log.New("database", file)
+	logCtx := []interface{}{"cache", common.StorageSize(cache * 1024 * 1024), "handles", handles}
+	if readonly {
+		logCtx = append(logCtx, "readonly", "true")
+	}
+	logger.Info("Allocated cache and file handles", logCtx...)
+
+	// Two memory tables are configured which is identical to leveldb, a frozen
+	// one being flushed and a live one accepting writes. The memtable size is
+	// capped by the uint32 offsets used internally by pebble.
+	memTableLimit := 2
+	memTableSize := cache * 1024 * 1024 / 2 / memTableLimit
+	if maxMemTableSize := 4<<30 - 1; memTableSize > maxMemTableSize {
+		memTableSize = maxMemTableSize
+	}
+	pdb := &Database{
+		fn:       file,
+		log:      logger,
+		quitChan: make(chan chan error),
+	}
+	opts := &pebble.Options{
+		// Pebble has a single combined cache area and the write buffers are
+		// taken from this too. Assign all available memory allowance for cache.
+		Cache:                       pebble.NewCache(int64(cache * 1024 * 1024)),
+		MaxOpenFiles:                handles,
+		MemTableSize:                memTableSize,
+		MemTableStopWritesThreshold: memTableLimit,
+		MaxConcurrentCompactions:    func() int { return runtime.NumCPU() },
+		ReadOnly:                    readonly,
+		Levels: []pebble.LevelOptions{
+			{TargetFileSize: 2 * 1024 * 1024, FilterPolicy: bloom.FilterPolicy(10)},
+			{TargetFileSize: 2 * 1024 * 1024, FilterPolicy: bloom.FilterPolicy(10)},
+			{TargetFileSize: 2 * 1024 * 1024, FilterPolicy: bloom.FilterPolicy(10)},
+			{TargetFileSize: 2 * 1024 * 1024, FilterPolicy: bloom.FilterPolicy(10)},
+			{TargetFileSize: 2 * 1024 * 1024, FilterPolicy: bloom.FilterPolicy(10)},
+			{TargetFileSize: 2 * 1024 * 1024, FilterPolicy: bloom.FilterPolicy(10)},
+			{TargetFileSize: 2 * 1024 * 1024, FilterPolicy: bloom.FilterPolicy(10)},
+		},
+		EventListener: &pebble.EventListener{
+			CompactionBegin: pdb.onCompactionBegin,
+			CompactionEnd:   pdb.onCompactionEnd,
+			WriteStallBegin: pdb.onWriteStallBegin,
+			WriteStallEnd:   pdb.onWriteStallEnd,
+		},
+	}
+	// Disable seek compaction explicitly, same as for leveldb
+	opts.Experimental.ReadSamplingMultiplier = -1
+
+	db, err := pebble.Open(file, opts)
+	if err != nil {
+		return nil, err
+	}
+	pdb.db = db
+
+	// Assemble the wrapper with all the registered metrics
+	pdb.compTimeMeter = metrics.NewRegisteredMeter(namespace+"compact/time", nil)
+	pdb.compReadMeter = metrics.NewRegisteredMeter(namespace+"compact/input", nil)
+	pdb.compWriteMeter = metrics.NewRegisteredMeter(namespace+"compact/output", nil)
+	pdb.diskSizeGauge = metrics.NewRegisteredGauge(namespace+"disk/size", nil)
+	pdb.diskReadMeter = metrics.NewRegisteredMeter(namespace+"disk/read", nil)
+	pdb.diskWriteMeter = metrics.NewRegisteredMeter(namespace+"disk/write", nil)
+	pdb.writeDelayMeter = metrics.NewRegisteredMeter(namespace+"compact/writedelay/duration", nil)
+	pdb.writeDelayNMeter = metrics.NewRegisteredMeter(namespace+"compact/writedelay/counter", nil)
+	pdb.memCompGauge = metrics.NewRegisteredGauge(namespace+"compact/memory", nil)
+	pdb.level0CompGauge = metrics.NewRegisteredGauge(namespace+"compact/level0", nil)
+	pdb.nonlevel0CompGauge = metrics.NewRegisteredGauge(namespace+"compact/nonlevel0", nil)
+	pdb.seekCompGauge = metrics.NewRegisteredGauge(namespace+"compact/seek", nil)
+
+	// Start up the metrics gathering and return
+	go pdb.meter(metricsGatheringInterval)
+	return pdb, nil
+}
+
+// Close stops the metrics collection, flushes any pending data to disk and closes
+// all io accesses to the underlying key-value store.
+func (db *Database) Close() error {
+	db.quitLock.Lock()
+	defer db.quitLock.Unlock()
+
+	if db.quitChan != nil {
+		errc := make(chan error)
+		db.quitChan <- errc
+		if err := <-errc; err != nil {
+			db.log.Error("Metrics collection failed", "err", err)
+		}
+		db.quitChan = nil
+	}
+	return db.db.Close()
+}
+
+// Has retrieves if a key is present in the key-value store.
+func (db *Database) Has(key []byte) (bool, error) {
+	_, closer, err := db.db.Get(key)
+	if err == pebble.ErrNotFound {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	closer.Close()
+	return true, nil
+}
+
+// Get retrieves the given key if it's present in the key-value store.
+func (db *Database) Get(key []byte) ([]byte, error) {
+	dat, closer, err := db.db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	ret := common.CopyBytes(dat)
+	closer.Close()
+	return ret, nil
+}
+
+// Put inserts the given value into the key-value store.
+func (db *Database) Put(key []byte, value []byte) error {
+	return db.db.Set(key, value, pebble.NoSync)
+}
+
+// Delete removes the key from the key-value store.
+func (db *Database) Delete(key []byte) error {
+	return db.db.Delete(key, pebble.NoSync)
+}
+
+// NewBatch creates a write-only key-value store that buffers changes to its host
+// database until a final write is called.
+func (db *Database) NewBatch() VBGdb.Batch {
+	return &batch{
+		b: db.db.NewBatch(),
+	}
+}
+
+// NewIterator creates a binary-alphabetical iterator over a subset
+// of database content with a particular key prefix, starting at a particular
+// initial key (or after, if it does not exist).
+func (db *Database) NewIterator(prefix []byte, start []byte) VBGdb.Iterator {
+	iter := db.db.NewIter(&pebble.IterOptions{
+		LowerBound: append(common.CopyBytes(prefix), start...),
+		UpperBound: upperBound(prefix),
+	})
+	iter.First()
+	return &iterator{iter: iter, moved: true}
+}
+
+// Stat returns a particular internal stat of the database. The leveldb property
+// names are accepted as aliases to keep existing tooling working.
+func (db *Database) Stat(property string) (string, error) {
+	switch property {
+	case "pebble.stats", "leveldb.stats":
+		return db.db.Metrics().String(), nil
+
+	case "pebble.iostats", "leveldb.iostats":
+		var read, write uint64
+		metrics := db.db.Metrics()
+		for _, level := range metrics.Levels {
+			read += level.BytesRead
+			write += level.BytesCompacted + level.BytesFlushed
+		}
+		write += metrics.WAL.BytesWritten
+		return fmt.Sprintf("Read(MB):%.5f Write(MB):%.5f", float64(read)/1048576.0, float64(write)/1048576.0), nil
+
+	default:
+		return "", fmt.Errorf("unknown property: %s", property)
+	}
+}
+
+// Compact flattens the underlying data store for the given key range. In essence,
+// deleted and overwritten versions are discarded, and the data is rearranged to
+// reduce the cost of operations needed to access them.
+//
+// A nil start is treated as a key before all keys in the data store; a nil limit
+// is treated as a key after all keys in the data store. If both is nil then it
+// will compact entire data store.
+func (db *Database) Compact(start []byte, limit []byte) error {
+	// Pebble has no notion of an open ended range, so use a key larger than
+	// anything stored in the database. Trie nodes are keyed by 32 byte hashes,
+	// so only a hash collision could produce a larger key.
+	if limit == nil {
+		limit = bytes.Repeat([]byte{0xff}, 32)
+	}
+	return db.db.Compact(start, limit, true)
+}
+
+// Path returns the path to the database directory.
+func (db *Database) Path() string {
+	return db.fn
+}
+
+// meter periodically retrieves internal pebble counters and reports them to
+// the metrics subsystem.
+func (db *Database) meter(refresh time.Duration) {
+	var (
+		errc  chan error
+		timer = time.NewTimer(refresh)
+
+		// Storage for the previous iteration's counters to report deltas
+		compTimes        [2]int64
+		compReads        [2]int64
+		compWrites       [2]int64
+		writeDelayTimes  [2]int64
+		writeDelayCounts [2]int64
+		diskWrites       [2]int64
+	)
+	defer timer.Stop()
+
+	// Iterate ad infinitum and collect the stats
+	for i := 1; errc == nil; i++ {
+		var (
+			compRead  int64
+			compWrite int64
+			diskWrite int64
+
+			stats = db.db.Metrics()
+		)
+		for _, level := range stats.Levels {
+			compRead += int64(level.BytesRead)
+			compWrite += int64(level.BytesCompacted)
+			diskWrite += int64(level.BytesCompacted + level.BytesFlushed)
+		}
+		diskWrite += int64(stats.WAL.BytesWritten)
+
+		compTimes[i%2] = atomic.LoadInt64(&db.compTime)
+		compReads[i%2] = compRead
+		compWrites[i%2] = compWrite
+		writeDelayTimes[i%2] = atomic.LoadInt64(&db.writeDelayTime)
+		writeDelayCounts[i%2] = atomic.LoadInt64(&db.writeDelayCount)
+		diskWrites[i%2] = diskWrite
+
+		db.compTimeMeter.Mark(compTimes[i%2] - compTimes[(i-1)%2])
+		db.compReadMeter.Mark(compReads[i%2] - compReads[(i-1)%2])
+		db.compWriteMeter.Mark(compWrites[i%2] - compWrites[(i-1)%2])
+		db.writeDelayMeter.Mark(writeDelayTimes[i%2] - writeDelayTimes[(i-1)%2])
+		db.writeDelayNMeter.Mark(writeDelayCounts[i%2] - writeDelayCounts[(i-1)%2])
+		db.diskSizeGauge.Update(int64(stats.DiskSpaceUsage()))
+		db.diskReadMeter.Mark(0) // pebble doesn't track non-compaction reads
+		db.diskWriteMeter.Mark(diskWrites[i%2] - diskWrites[(i-1)%2])
+
+		db.memCompGauge.Update(stats.Flush.Count)
+		db.level0CompGauge.Update(int64(atomic.LoadUint32(&db.level0Comp)))
+		db.nonlevel0CompGauge.Update(int64(atomic.LoadUint32(&db.nonLevel0Comp)))
+		db.seekCompGauge.Update(stats.Compact.ReadCount)
+
+		// Sleep a bit, then repeat the stats collection
+		select {
+		case errc = <-db.quitChan:
+			// Quit requesting, stop hammering the database
+		case <-timer.C:
+			timer.Reset(refresh)
+			// Timeout, gather a new set of stats
+		}
+	}
+	errc <- nil
+}
+
+// batch is a write-only pebble batch that commits changes to its host database
+// when Write is called. A batch cannot be used concurrently.
+type batch struct {
+	b    *pebble.Batch
+	size int
+}
+
+// Put inserts the given value into the batch for later committing.
+func (b *batch) Put(key, value []byte) error {
+	b.b.Set(key, value, nil)
+	b.size += len(value)
+	return nil
+}
+
+// Delete inserts the a key removal into the batch for later committing.
+func (b *batch) Delete(key []byte) error {
+	b.b.Delete(key, nil)
+	b.size++
+	return nil
+}
+
+// ValueSize retrieves the amount of data queued up for writing.
+func (b *batch) ValueSize() int {
+	return b.size
+}
+
+// Write flushes any accumulated data to disk.
+func (b *batch) Write() error {
+	return b.b.Commit(pebble.NoSync)
+}
+
+// Reset resets the batch for reuse.
+func (b *batch) Reset() {
+	b.b.Reset()
+	b.size = 0
+}
+
+// Replay replays the batch contents.
+func (b *batch) Replay(w VBGdb.KeyValueWriter) error {
+	reader := b.b.Reader()
+	for {
+		kind, k, v, ok := reader.Next()
+		if !ok {
+			break
+		}
+		// The (k,v) slices might be overwritten if the batch is reset/reused,
+		// and the receiver should copy them if they are to be retained long-term.
+		switch kind {
+		case pebble.InternalKeyKindSet:
+			if err := w.Put(k, v); err != nil {
+				return err
+			}
+		case pebble.InternalKeyKindDelete:
+			if err := w.Delete(k); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unhandled operation, keytype: %v", kind)
+		}
+	}
+	return nil
+}
+
+// iterator is a wrapper of the pebble iterator implementing the missing
+// VBGdb.Iterator semantics: pebble positions the iterator on the first entry
+// upon creation, while VBGdb expects the first Next call to do so.
+type iterator struct {
+	iter     *pebble.Iterator
+	moved    bool
+	released bool
+}
+
+// Next moves the iterator to the next key/value pair. It returns whVBGer the
+// iterator is exhausted.
+func (it *iterator) Next() bool {
+	if it.moved {
+		it.moved = false
+		return it.iter.Valid()
+	}
+	return it.iter.Next()
+}
+
+// Error returns any accumulated error. Exhausting all the key/value pairs
+// is not considered to be an error.
+func (it *iterator) Error() error {
+	return it.iter.Error()
+}
+
+// Key returns the key of the current key/value pair, or nil if done. The caller
+// should not modify the contents of the returned slice, and its contents may
+// change on the next call to Next.
+func (it *iterator) Key() []byte {
+	return it.iter.Key()
+}
+
+// Value returns the value of the current key/value pair, or nil if done. The
+// caller should not modify the contents of the returned slice, and its contents
+// may change on the next call to Next.
+func (it *iterator) Value() []byte {
+	return it.iter.Value()
+}
+
+// Release releases associated resources. Release should always succeed and can
+// be called multiple times without causing error.
+func (it *iterator) Release() {
+	if !it.released {
+		it.iter.Close()
+		it.released = true
+	}
+}
+
+// upperBound returns the exclusive upper bound of the keys sharing a prefix, or
+// nil if there is none (empty or all 0xff prefix).
+func upperBound(prefix []byte) (limit []byte) {
+	for i := len(prefix) - 1; i >= 0; i-- {
+		c := prefix[i]
+		if c == 0xff {
+			continue
+		}
+		limit = make([]byte, i+1)
+		copy(limit, prefix)
+		limit[i] = c + 1
+		break
+	}
+	return limit
+}